@@ -0,0 +1,57 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import "context"
+
+type callerKey struct{}
+
+// Identifier is implemented by auth clients that know which caller they authorized
+type Identifier interface {
+	// Caller returns the name of the caller authorized with the context
+	Caller(ctx context.Context) (string, bool)
+}
+
+// Authorize authorizes the request with the client and returns a context that carries
+// the authorized caller. The caller is named by the client if it implements Identifier,
+// and is otherwise the common name of the peer identity verified by the transport.
+func Authorize(ctx context.Context, client Client) (context.Context, error) {
+	if err := client.Authorize(ctx); err != nil {
+		return ctx, err
+	}
+	caller, ok := "", false
+	if identifier, isIdentifier := client.(Identifier); isIdentifier {
+		caller, ok = identifier.Caller(ctx)
+	} else if peer, isPeer := PeerIdentityFromContext(ctx); isPeer {
+		caller, ok = peer.CommonName, true
+	}
+	if !ok {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, callerKey{}, caller), nil
+}
+
+// CallerFromContext returns the caller authorized by Authorize. The returned bool is
+// false if the request was not authorized or its caller is unknown.
+func CallerFromContext(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+	return caller, ok
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type identifierClient struct {
+	Client
+	caller string
+}
+
+func (c identifierClient) Caller(ctx context.Context) (string, bool) {
+	return c.caller, c.caller != ""
+}
+
+func TestAuthorize_PeerIdentity(t *testing.T) {
+	ctx, err := Authorize(context.Background(), NopClient)
+	require.NoError(t, err)
+	_, ok := CallerFromContext(ctx)
+	assert.False(t, ok, "Requests without a verified identity should have no caller")
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	ctx, err = Authorize(WithPeerIdentity(context.Background(), NewPeerIdentity(cert)), NopClient)
+	require.NoError(t, err)
+	caller, ok := CallerFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "billing", caller)
+}
+
+func TestAuthorize_Identifier(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	ctx := WithPeerIdentity(context.Background(), NewPeerIdentity(cert))
+	ctx, err := Authorize(ctx, identifierClient{Client: NopClient, caller: "payments"})
+	require.NoError(t, err)
+	caller, ok := CallerFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "payments", caller, "The auth client should name the caller")
+
+	ctx, err = Authorize(context.Background(), identifierClient{Client: NopClient})
+	require.NoError(t, err)
+	_, ok = CallerFromContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestAuthorize_Failure(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	ctx := WithPeerIdentity(context.Background(), NewPeerIdentity(cert))
+	ctx, err := Authorize(ctx, FakeFailureClient(nil))
+	assert.EqualError(t, err, ErrAuthorization)
+	_, ok := CallerFromContext(ctx)
+	assert.False(t, ok, "Unauthorized requests should have no caller")
+}
@@ -49,6 +49,17 @@
 // Access the authorization API by the service entity to authorize its callers. Context provided by a
 // request must have a signed certificate, which the caller received on authentication.
 //
+// Peer identity:
+// Transports that verify the caller, such as the HTTP module serving mutual TLS, store the
+// verified identity in the request context. Filters and auth clients read it with
+// auth.PeerIdentityFromContext.
+//
+// Authorized caller:
+// Modules authorize requests with auth.Authorize, which returns a context carrying the
+// authorized caller for filters and rate limits to read with auth.CallerFromContext. Auth
+// clients that implement auth.Identifier name the caller, otherwise it is the common name
+// of the verified peer identity.
+//
 //
 // Integrating custom auth service
 //
@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+type peerKey struct{}
+
+// PeerIdentity is the identity of a caller verified by the transport, such as
+// the client certificate presented over mutual TLS
+type PeerIdentity struct {
+	// CommonName is the common name of the certificate subject
+	CommonName string
+	// DNSNames are the DNS subject alternative names of the certificate
+	DNSNames []string
+	// Certificate is the verified leaf certificate of the caller
+	Certificate *x509.Certificate
+}
+
+// NewPeerIdentity creates the peer identity for a verified certificate
+func NewPeerIdentity(cert *x509.Certificate) PeerIdentity {
+	return PeerIdentity{
+		CommonName:  cert.Subject.CommonName,
+		DNSNames:    cert.DNSNames,
+		Certificate: cert,
+	}
+}
+
+// WithPeerIdentity returns a new context that carries the verified peer identity.
+// Transports set it so that filters and auth clients can authorize the caller.
+func WithPeerIdentity(ctx context.Context, peer PeerIdentity) context.Context {
+	return context.WithValue(ctx, peerKey{}, peer)
+}
+
+// PeerIdentityFromContext returns the verified peer identity carried by the context.
+// The returned bool is false if the transport did not verify the caller.
+func PeerIdentityFromContext(ctx context.Context) (PeerIdentity, bool) {
+	peer, ok := ctx.Value(peerKey{}).(PeerIdentity)
+	return peer, ok
+}
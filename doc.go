@@ -77,9 +77,9 @@
 //
 // • TChannel server
 //
-// Planned modules:
+// • Kafka consumer
 //
-// • Kafka ingester
+// Planned modules:
 //
 // • Delayed jobs
 //
@@ -112,6 +112,16 @@
 // By default, the metrics are not reported (using a
 // tally.NoopScope).
 //
+// Migrating to Tally 3
+//
+// UberFx depends on Tally 3, since YARPC 1.19, which provides the gRPC transport,
+// requires it. Reporters returned from a metrics.ScopeFunc implement
+// tally.CachedStatsReporter, which now also has AllocateHistogram. Reporters
+// that do not report histograms can return metrics.NopCachedHistogram from it.
+// Root scopes are created with tally.NewRootScope(tally.ScopeOptions{...}, interval),
+// and the snapshots of tally.TestScope key metrics by their name and tags, see
+// tally.KeyForPrefixedStringMap.
+//
 // Configuration
 //
 // UberFx introduces a simplified configuration model that provides a consistent
@@ -124,7 +134,7 @@
 //
 // Compatibility
 //
-// UberFx is compatible with Go 1.7 and above.
+// UberFx is compatible with Go 1.8 and above.
 //
 // License
 //
@@ -0,0 +1,41 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Command openapi writes the OpenAPI document of the simple example to stdout
+// without starting the service, for instance to generate clients:
+//
+//   go run ./examples/simple/cmd/openapi > openapi.json
+package main
+
+import (
+	"log"
+	"os"
+
+	"go.uber.org/fx/examples/simple/handlers"
+	"go.uber.org/fx/modules/uhttp"
+	"go.uber.org/fx/modules/uhttp/openapi"
+)
+
+func main() {
+	info := openapi.Info{Title: "simple", Version: "1.0.0"}
+	if err := uhttp.DumpOpenAPI(os.Stdout, handlers.Register, info); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -18,7 +18,9 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-package main
+// Package handlers holds the HTTP routes of the simple example, shared by the
+// service and the command that dumps their OpenAPI document.
+package handlers
 
 import (
 	"context"
@@ -41,17 +43,10 @@ func enforceHeader(r uhttp.Route) uhttp.Route {
 	return r.Headers("X-Uber-FX", "yass")
 }
 
-func registerHTTPers(service service.Host) []uhttp.RouteHandler {
+// Register returns the routes of the example
+func Register(service service.Host) []uhttp.RouteHandler {
 	handler := &exampleHandler{}
 	return []uhttp.RouteHandler{
 		uhttp.NewRouteHandler("/", handler),
 	}
 }
-
-type simpleFilter struct {
-}
-
-func (simpleFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next uhttp.Handler) {
-	io.WriteString(w, "Going through simpleFilter")
-	next.ServeHTTP(ctx, w, r)
-}
@@ -21,6 +21,7 @@
 package main
 
 import (
+	"go.uber.org/fx/examples/simple/handlers"
 	"go.uber.org/fx/modules/uhttp"
 	"go.uber.org/fx/service"
 	"go.uber.org/fx/ulog"
@@ -28,7 +29,7 @@ import (
 
 func main() {
 	svc, err := service.WithModules(
-		uhttp.New(registerHTTPers, []uhttp.Filter{simpleFilter{}}),
+		uhttp.New(handlers.Register, []uhttp.Filter{simpleFilter{}}),
 	).Build()
 
 	if err != nil {
@@ -99,3 +99,27 @@ type nopCachedTimer struct {
 
 func (nopCachedTimer) ReportTimer(interval time.Duration) {
 }
+
+func (nopCachedStatsReporter) AllocateHistogram(name string, tags map[string]string, buckets tally.Buckets) tally.CachedHistogram {
+	return NopCachedHistogram
+}
+
+// NopCachedHistogram is an implementation of tally.CachedHistogram
+var NopCachedHistogram tally.CachedHistogram = nopCachedHistogram{}
+
+type nopCachedHistogram struct {
+}
+
+func (nopCachedHistogram) ValueBucket(bucketLowerBound, bucketUpperBound float64) tally.CachedHistogramBucket {
+	return nopCachedHistogramBucket{}
+}
+
+func (nopCachedHistogram) DurationBucket(bucketLowerBound, bucketUpperBound time.Duration) tally.CachedHistogramBucket {
+	return nopCachedHistogramBucket{}
+}
+
+type nopCachedHistogramBucket struct {
+}
+
+func (nopCachedHistogramBucket) ReportSamples(value int64) {
+}
@@ -0,0 +1,291 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	errs "github.com/pkg/errors"
+	"github.com/uber-go/tally"
+)
+
+// Circuit breaker defaults
+const (
+	defaultCircuitMinRequests      = 20
+	defaultCircuitWindow           = 10 * time.Second
+	defaultCircuitOpenTimeout      = 5 * time.Second
+	defaultCircuitHalfOpenRequests = 1
+)
+
+// CircuitState is the state of the circuit breaker of a destination
+type CircuitState int
+
+const (
+	// CircuitClosed lets calls through and tracks their failures
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen lets a few trial calls through to decide whether to close again
+	CircuitHalfOpen
+	// CircuitOpen fails calls fast
+	CircuitOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitOpenError is returned for calls rejected while the circuit of their destination is open
+type CircuitOpenError struct {
+	Destination string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker is open for %q", e.Destination)
+}
+
+// IsCircuitOpen is true if the call failed fast because its circuit was open.
+// It looks through wrapped errors and the *url.Error returned by http.Client.
+func IsCircuitOpen(err error) bool {
+	err = errs.Cause(err)
+	if uerr, ok := err.(*url.Error); ok {
+		err = errs.Cause(uerr.Err)
+	}
+	_, ok := err.(*CircuitOpenError)
+	return ok
+}
+
+// CircuitBreakerConfig handles config for client-side circuit breaking
+type CircuitBreakerConfig struct {
+	// FailurePercent is the percentage of failed or slow calls within the window
+	// that opens the circuit, zero disables the circuit breaker
+	FailurePercent int `yaml:"failurePercent"`
+	// MinRequests is the number of calls within the window before the circuit
+	// can open, defaults to 20
+	MinRequests int `yaml:"minRequests"`
+	// Window is the period failures are counted over, defaults to 10s
+	Window time.Duration `yaml:"window"`
+	// SlowCallDuration counts calls that take longer as failures, zero disables it
+	SlowCallDuration time.Duration `yaml:"slowCallDuration"`
+	// OpenTimeout is how long the circuit stays open before trial calls are let through, defaults to 5s
+	OpenTimeout time.Duration `yaml:"openTimeout"`
+	// HalfOpenRequests is the number of trial calls that must succeed to close the circuit, defaults to 1
+	HalfOpenRequests int `yaml:"halfOpenRequests"`
+}
+
+// Validate checks the circuit breaker thresholds
+func (c CircuitBreakerConfig) Validate() error {
+	if c.FailurePercent < 0 || c.FailurePercent > 100 {
+		return fmt.Errorf("circuit breaker failurePercent %d must be between 0 and 100", c.FailurePercent)
+	}
+	if c.MinRequests < 0 || c.HalfOpenRequests < 0 {
+		return errors.New("circuit breaker request counts must not be negative")
+	}
+	if c.Window < 0 || c.SlowCallDuration < 0 || c.OpenTimeout < 0 {
+		return errors.New("circuit breaker durations must not be negative")
+	}
+	return nil
+}
+
+// Enabled is true if calls go through circuit breakers
+func (c CircuitBreakerConfig) Enabled() bool {
+	return c.FailurePercent > 0
+}
+
+// CircuitStateChangeFunc is called when the circuit of a destination changes state
+type CircuitStateChangeFunc func(destination string, from, to CircuitState)
+
+// ReportCircuitStateChange reports the new state of the circuit of a destination with
+// the circuitbreaker.state gauge and the circuitbreaker.transition counter
+func ReportCircuitStateChange(scope tally.Scope, destination string, to CircuitState) {
+	tagged := scope.Tagged(map[string]string{"destination": destination})
+	tagged.Gauge("circuitbreaker.state").Update(float64(to))
+	tagged.Tagged(map[string]string{"state": to.String()}).Counter("circuitbreaker.transition").Inc(1)
+}
+
+// CircuitBreakers keep a circuit breaker for every destination, such as a host or a procedure
+type CircuitBreakers struct {
+	cfg           CircuitBreakerConfig
+	now           func() time.Time
+	onStateChange CircuitStateChangeFunc
+
+	sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	state CircuitState
+	// generation changes with the state, so that calls let through in a previous
+	// state are not counted in the current one
+	generation  uint64
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	trials      int
+	successes   int
+}
+
+// NewCircuitBreakers creates circuit breakers for the config, onStateChange may be nil
+func NewCircuitBreakers(cfg CircuitBreakerConfig, onStateChange CircuitStateChangeFunc) *CircuitBreakers {
+	if cfg.MinRequests <= 0 {
+		cfg.MinRequests = defaultCircuitMinRequests
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = defaultCircuitWindow
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaultCircuitOpenTimeout
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = defaultCircuitHalfOpenRequests
+	}
+	if onStateChange == nil {
+		onStateChange = func(string, CircuitState, CircuitState) {}
+	}
+	return &CircuitBreakers{
+		cfg:           cfg,
+		now:           time.Now,
+		onStateChange: onStateChange,
+		circuits:      make(map[string]*circuit),
+	}
+}
+
+// Acquire lets a call to the destination through, or returns a *CircuitOpenError
+// if its circuit is open. Otherwise done must be called with the outcome once the
+// call completes, calls slower than SlowCallDuration count as failed.
+func (b *CircuitBreakers) Acquire(destination string) (done func(failed bool), err error) {
+	b.Lock()
+	now := b.now()
+	c := b.circuit(destination, now)
+	from := c.state
+	if c.state == CircuitOpen && now.Sub(c.openedAt) >= b.cfg.OpenTimeout {
+		c.setState(CircuitHalfOpen, now)
+	}
+	if c.state == CircuitOpen || (c.state == CircuitHalfOpen && c.trials >= b.cfg.HalfOpenRequests) {
+		b.Unlock()
+		b.notify(destination, from, c.state)
+		return nil, &CircuitOpenError{Destination: destination}
+	}
+	if c.state == CircuitHalfOpen {
+		c.trials++
+	}
+	generation := c.generation
+	to := c.state
+	b.Unlock()
+	b.notify(destination, from, to)
+
+	start := now
+	return func(failed bool) {
+		b.record(destination, generation, start, failed)
+	}, nil
+}
+
+// State returns the state of the circuit of the destination
+func (b *CircuitBreakers) State(destination string) CircuitState {
+	b.Lock()
+	defer b.Unlock()
+	if c, ok := b.circuits[destination]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+func (b *CircuitBreakers) record(destination string, generation uint64, start time.Time, failed bool) {
+	b.Lock()
+	now := b.now()
+	if b.cfg.SlowCallDuration > 0 && now.Sub(start) > b.cfg.SlowCallDuration {
+		failed = true
+	}
+	c := b.circuit(destination, now)
+	from := c.state
+	if c.generation != generation {
+		b.Unlock()
+		return
+	}
+	switch c.state {
+	case CircuitClosed:
+		c.requests++
+		if failed {
+			c.failures++
+		}
+		if c.requests >= b.cfg.MinRequests && c.failures*100 >= b.cfg.FailurePercent*c.requests {
+			c.setState(CircuitOpen, now)
+		}
+	case CircuitHalfOpen:
+		if failed {
+			c.setState(CircuitOpen, now)
+			break
+		}
+		c.successes++
+		if c.successes >= b.cfg.HalfOpenRequests {
+			c.setState(CircuitClosed, now)
+		}
+	}
+	to := c.state
+	b.Unlock()
+	b.notify(destination, from, to)
+}
+
+// circuit returns the circuit of the destination with its failure window rolled over
+func (b *CircuitBreakers) circuit(destination string, now time.Time) *circuit {
+	c, ok := b.circuits[destination]
+	if !ok {
+		c = &circuit{windowStart: now}
+		b.circuits[destination] = c
+	}
+	if c.state == CircuitClosed && now.Sub(c.windowStart) >= b.cfg.Window {
+		c.windowStart = now
+		c.requests = 0
+		c.failures = 0
+	}
+	return c
+}
+
+func (b *CircuitBreakers) notify(destination string, from, to CircuitState) {
+	if from != to {
+		b.onStateChange(destination, from, to)
+	}
+}
+
+func (c *circuit) setState(state CircuitState, now time.Time) {
+	c.state = state
+	c.generation++
+	c.windowStart = now
+	c.requests = 0
+	c.failures = 0
+	c.trials = 0
+	c.successes = 0
+	if state == CircuitOpen {
+		c.openedAt = now
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	errs "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type stateChange struct {
+	destination string
+	from, to    CircuitState
+}
+
+func newTestBreakers(cfg CircuitBreakerConfig) (*CircuitBreakers, *time.Time, *[]stateChange) {
+	now := time.Unix(0, 0)
+	var changes []stateChange
+	b := NewCircuitBreakers(cfg, func(destination string, from, to CircuitState) {
+		changes = append(changes, stateChange{destination, from, to})
+	})
+	b.now = func() time.Time { return now }
+	return b, &now, &changes
+}
+
+func call(t *testing.T, b *CircuitBreakers, destination string, failed bool) {
+	done, err := b.Acquire(destination)
+	require.NoError(t, err)
+	done(failed)
+}
+
+func TestCircuitBreakers_Opens(t *testing.T) {
+	b, now, changes := newTestBreakers(CircuitBreakerConfig{FailurePercent: 50, MinRequests: 4})
+	call(t, b, "users", true)
+	call(t, b, "users", false)
+	call(t, b, "users", true)
+	assert.Equal(t, CircuitClosed, b.State("users"), "Not enough requests yet")
+	call(t, b, "users", false)
+	assert.Equal(t, CircuitOpen, b.State("users"))
+	assert.Equal(t, []stateChange{{"users", CircuitClosed, CircuitOpen}}, *changes)
+
+	_, err := b.Acquire("users")
+	assert.Equal(t, &CircuitOpenError{Destination: "users"}, err)
+	assert.EqualError(t, err, `circuit breaker is open for "users"`)
+	call(t, b, "trips", true)
+	assert.Equal(t, CircuitClosed, b.State("trips"), "Destinations have their own circuit")
+
+	*now = now.Add(defaultCircuitOpenTimeout)
+	done, err := b.Acquire("users")
+	require.NoError(t, err)
+	assert.Equal(t, CircuitHalfOpen, b.State("users"))
+	_, err = b.Acquire("users")
+	assert.Error(t, err, "Only one trial call should be let through")
+	done(false)
+	assert.Equal(t, CircuitClosed, b.State("users"))
+	assert.Equal(t, []stateChange{
+		{"users", CircuitClosed, CircuitOpen},
+		{"users", CircuitOpen, CircuitHalfOpen},
+		{"users", CircuitHalfOpen, CircuitClosed},
+	}, *changes)
+}
+
+func TestCircuitBreakers_HalfOpenFailure(t *testing.T) {
+	b, now, _ := newTestBreakers(CircuitBreakerConfig{FailurePercent: 100, MinRequests: 1, HalfOpenRequests: 2})
+	call(t, b, "users", true)
+	require.Equal(t, CircuitOpen, b.State("users"))
+
+	*now = now.Add(defaultCircuitOpenTimeout)
+	call(t, b, "users", false)
+	assert.Equal(t, CircuitHalfOpen, b.State("users"), "Both trial calls must succeed")
+	call(t, b, "users", true)
+	assert.Equal(t, CircuitOpen, b.State("users"))
+}
+
+func TestCircuitBreakers_Window(t *testing.T) {
+	b, now, _ := newTestBreakers(CircuitBreakerConfig{FailurePercent: 50, MinRequests: 2, Window: time.Second})
+	call(t, b, "users", true)
+	*now = now.Add(time.Second)
+	call(t, b, "users", false)
+	assert.Equal(t, CircuitClosed, b.State("users"), "Failures of the previous window should be dropped")
+}
+
+func TestCircuitBreakers_SlowCalls(t *testing.T) {
+	b, now, _ := newTestBreakers(CircuitBreakerConfig{
+		FailurePercent:   100,
+		MinRequests:      1,
+		SlowCallDuration: time.Second,
+	})
+	done, err := b.Acquire("users")
+	require.NoError(t, err)
+	*now = now.Add(2 * time.Second)
+	done(false)
+	assert.Equal(t, CircuitOpen, b.State("users"))
+}
+
+func TestCircuitBreakers_StaleCalls(t *testing.T) {
+	b, now, _ := newTestBreakers(CircuitBreakerConfig{FailurePercent: 100, MinRequests: 1})
+	stale, err := b.Acquire("users")
+	require.NoError(t, err)
+	call(t, b, "users", true)
+	*now = now.Add(defaultCircuitOpenTimeout)
+	trial, err := b.Acquire("users")
+	require.NoError(t, err)
+	stale(false)
+	assert.Equal(t, CircuitHalfOpen, b.State("users"), "Calls let through while closed should not close the circuit")
+	trial(false)
+	assert.Equal(t, CircuitClosed, b.State("users"))
+}
+
+func TestCircuitBreakerConfig_Validate(t *testing.T) {
+	assert.NoError(t, CircuitBreakerConfig{}.Validate())
+	assert.False(t, CircuitBreakerConfig{}.Enabled())
+	assert.True(t, CircuitBreakerConfig{FailurePercent: 50}.Enabled())
+	assert.Error(t, CircuitBreakerConfig{FailurePercent: 101}.Validate())
+	assert.Error(t, CircuitBreakerConfig{MinRequests: -1}.Validate())
+	assert.Error(t, CircuitBreakerConfig{OpenTimeout: -time.Second}.Validate())
+}
+
+func TestIsCircuitOpen(t *testing.T) {
+	open := &CircuitOpenError{Destination: "users"}
+	assert.True(t, IsCircuitOpen(open))
+	assert.True(t, IsCircuitOpen(errs.Wrap(open, "call failed")))
+	assert.True(t, IsCircuitOpen(&url.Error{Op: "Get", URL: "http://users", Err: open}))
+	assert.False(t, IsCircuitOpen(errors.New("connection refused")))
+	assert.False(t, IsCircuitOpen(nil))
+}
+
+func TestCircuitState_String(t *testing.T) {
+	assert.Equal(t, "closed", CircuitClosed.String())
+	assert.Equal(t, "half-open", CircuitHalfOpen.String())
+	assert.Equal(t, "open", CircuitOpen.String())
+	assert.Equal(t, "CircuitState(7)", CircuitState(7).String())
+}
+
+func TestReportCircuitStateChange(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	ReportCircuitStateChange(scope, "users", CircuitOpen)
+	snapshot := scope.Snapshot()
+	for _, g := range snapshot.Gauges() {
+		assert.Equal(t, "circuitbreaker.state", g.Name())
+		assert.Equal(t, float64(CircuitOpen), g.Value())
+	}
+	require.Len(t, snapshot.Counters(), 1)
+	for _, c := range snapshot.Counters() {
+		assert.Equal(t, "circuitbreaker.transition", c.Name())
+		assert.Equal(t, map[string]string{"destination": "users", "state": "open"}, c.Tags())
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx/service"
+)
+
+// InitialOffset is where a consumer group starts when it has no committed offset
+type InitialOffset string
+
+const (
+	// OffsetNewest starts consuming from the messages produced after the group joins
+	OffsetNewest InitialOffset = "newest"
+	// OffsetOldest starts consuming from the oldest retained message
+	OffsetOldest InitialOffset = "oldest"
+)
+
+// BrokerCreateFunc creates the broker used by the module
+type BrokerCreateFunc func(host service.Host, config Config) (Broker, error)
+
+// Broker is the connection to a Kafka cluster
+type Broker interface {
+	// NewConsumer joins the consumer group and consumes the topics
+	NewConsumer(group string, topics []string, options ConsumerOptions) (Consumer, error)
+	// NewProducer creates a producer that publishes messages to the cluster
+	NewProducer(config ProducerConfig) (Producer, error)
+	// Close releases the connections held by the broker
+	Close() error
+}
+
+// ConsumerOptions configures a consumer created by a broker
+type ConsumerOptions struct {
+	InitialOffset InitialOffset
+	// CommitInterval is how often marked offsets are committed, zero leaves
+	// it to the broker default
+	CommitInterval time.Duration
+}
+
+// Consumer delivers messages of the topics the consumer group is assigned
+type Consumer interface {
+	// Messages returns the channel of consumed messages, it is closed when the consumer closes
+	Messages() <-chan *Message
+	// Errors returns the channel of consumer errors
+	Errors() <-chan error
+	// MarkOffset marks the message as processed, marked offsets are committed periodically
+	MarkOffset(msg *Message)
+	// CommitOffsets commits the marked offsets immediately
+	CommitOffsets() error
+	// Close commits the marked offsets and leaves the consumer group
+	Close() error
+}
+
+// Producer publishes messages to Kafka
+type Producer interface {
+	// Produce publishes the message and blocks until the broker acknowledges it
+	Produce(ctx context.Context, msg *Message) error
+	// Close flushes pending messages and releases the producer
+	Close() error
+}
@@ -0,0 +1,291 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
+
+	errs "github.com/pkg/errors"
+)
+
+// CommitStrategy controls when the offset of a message is committed
+type CommitStrategy string
+
+const (
+	// CommitAfterProcess marks the offset once the message is handled and commits
+	// marked offsets periodically, so messages are processed at least once
+	CommitAfterProcess CommitStrategy = "after"
+	// CommitBeforeProcess marks the offset before the message is handled, so
+	// messages are processed at most once
+	CommitBeforeProcess CommitStrategy = "before"
+	// CommitSync commits the offset of every message once it is handled
+	CommitSync CommitStrategy = "sync"
+)
+
+// Config handles config for Kafka consumer modules
+type Config struct {
+	modules.ModuleConfig
+	Brokers  []string       `yaml:"brokers"`
+	Group    string         `yaml:"group"`
+	Topics   []TopicConfig  `yaml:"topics"`
+	Producer ProducerConfig `yaml:"producer"`
+}
+
+// TopicConfig configures the consumption of a single topic
+type TopicConfig struct {
+	Name string `yaml:"name"`
+	// Group overrides the module consumer group for the topic
+	Group         string        `yaml:"group"`
+	InitialOffset InitialOffset `yaml:"initialOffset"`
+	Commit        CommitConfig  `yaml:"commit"`
+	Retry         RetryConfig   `yaml:"retry"`
+	DLQ           DLQConfig     `yaml:"dlq"`
+}
+
+// CommitConfig configures how offsets are committed
+type CommitConfig struct {
+	Strategy CommitStrategy `yaml:"strategy"`
+	Interval time.Duration  `yaml:"interval"`
+}
+
+// RetryConfig configures the topic failed messages are retried from
+type RetryConfig struct {
+	Topic      string `yaml:"topic"`
+	MaxRetries int    `yaml:"maxRetries"`
+}
+
+// DLQConfig configures the dead letter topic for messages that exhausted their retries
+type DLQConfig struct {
+	Topic string `yaml:"topic"`
+}
+
+// A Module is a module to consume Kafka topics
+type Module struct {
+	modules.ModuleBase
+	config    Config
+	log       ulog.Log
+	broker    Broker
+	handlers  map[string]Handler
+	stateMu   sync.RWMutex
+	consumers []*topicConsumer
+	producer  Producer
+	client    *moduleProducer
+	isRunning bool
+}
+
+var _ service.Module = &Module{}
+
+// NewModule returns a new Kafka consumer module. The create function is called
+// for every configured topic. The producer of the module is registered on the host,
+// see ProducerFromHost.
+func NewModule(createFunc HandlerCreateFunc, options ...modules.Option) service.ModuleCreateFunc {
+	return func(mi service.ModuleCreateInfo) ([]service.Module, error) {
+		mod, err := newModule(mi, createFunc, options...)
+		if err != nil {
+			return nil, errs.Wrap(err, "unable to instantiate Kafka module")
+		}
+		return []service.Module{mod}, nil
+	}
+}
+
+func newModule(
+	mi service.ModuleCreateInfo,
+	createFunc HandlerCreateFunc,
+	options ...modules.Option,
+) (*Module, error) {
+	if mi.Name == "" {
+		mi.Name = "kafka"
+	}
+	for _, opt := range options {
+		if err := opt(&mi); err != nil {
+			return nil, errs.Wrap(err, "unable to apply option to Kafka module")
+		}
+	}
+
+	stats.SetupKafkaMetrics(mi.Host.Metrics())
+
+	module := &Module{
+		ModuleBase: *modules.NewModuleBase(mi.Name, mi.Host, []string{}),
+		handlers:   make(map[string]Handler),
+		client:     &moduleProducer{},
+		log:        ulog.Logger().With("moduleName", mi.Name),
+	}
+
+	cfg := Config{Group: mi.Host.Name()}
+	if err := mi.Host.Config().Get(getConfigKey(mi.Name)).PopulateStruct(&cfg); err != nil {
+		return nil, errs.Wrap(err, "unable to load Kafka module configuration")
+	}
+	if err := validateConfig(cfg); err != nil {
+		return nil, err
+	}
+	module.config = cfg
+
+	middleware := append(defaultMiddleware(mi.Host), middlewareFromCreateInfo(mi)...)
+	for _, topic := range cfg.Topics {
+		handler, err := createFunc(topic.Name)
+		if err != nil {
+			return nil, errs.Wrapf(err, "unable to create handler for topic %q", topic.Name)
+		}
+		module.handlers[topic.Name] = buildChain(handler, middleware)
+	}
+
+	brokerFn := brokerFromCreateInfo(mi)
+	broker, err := brokerFn(mi.Host, cfg)
+	if err != nil {
+		return nil, errs.Wrap(err, "unable to create Kafka broker")
+	}
+	module.broker = broker
+	if resources := mi.Host.Resources(); resources != nil {
+		resources[producerResourceKey(mi.Name)] = module.client
+	}
+	return module, nil
+}
+
+func validateConfig(cfg Config) error {
+	if err := cfg.Producer.validate(); err != nil {
+		return err
+	}
+	for i, topic := range cfg.Topics {
+		if topic.Name == "" {
+			return fmt.Errorf("topic %d has no name", i)
+		}
+		switch topic.Commit.Strategy {
+		case "", CommitAfterProcess, CommitBeforeProcess, CommitSync:
+		default:
+			return fmt.Errorf("unknown commit strategy %q for topic %q", topic.Commit.Strategy, topic.Name)
+		}
+		switch topic.InitialOffset {
+		case "", OffsetNewest, OffsetOldest:
+		default:
+			return fmt.Errorf("unknown initial offset %q for topic %q", topic.InitialOffset, topic.Name)
+		}
+		if topic.Retry.MaxRetries > 0 && topic.Retry.Topic == "" {
+			return fmt.Errorf("retries are configured without a retry topic for topic %q", topic.Name)
+		}
+	}
+	return nil
+}
+
+// Start creates the producer, joins the consumer groups and begins consuming the topics
+func (m *Module) Start(ready chan<- struct{}) <-chan error {
+	ret := make(chan error, 1)
+	if m.IsRunning() {
+		ret <- errors.New("module is already running")
+		return ret
+	}
+
+	m.stateMu.Lock()
+	defer m.stateMu.Unlock()
+
+	producer, err := m.broker.NewProducer(m.config.Producer)
+	if err != nil {
+		ret <- errs.Wrap(err, "unable to create Kafka producer")
+		return ret
+	}
+	m.producer = producer
+
+	for _, topic := range m.config.Topics {
+		tc, err := m.newTopicConsumer(topic)
+		if err != nil {
+			m.stopConsumers()
+			m.producer.Close()
+			m.producer = nil
+			ret <- err
+			return ret
+		}
+		m.consumers = append(m.consumers, tc)
+		go tc.run()
+	}
+
+	m.client.set(&producerClient{Producer: m.producer})
+	m.log.Info("Module started", "topics", len(m.config.Topics))
+	m.isRunning = true
+	ready <- struct{}{}
+	ret <- nil
+	return ret
+}
+
+func (m *Module) newTopicConsumer(topic TopicConfig) (*topicConsumer, error) {
+	group := topic.Group
+	if group == "" {
+		group = m.config.Group
+	}
+	consumer, err := subscribe(m.broker, group, topic)
+	if err != nil {
+		return nil, err
+	}
+	return newTopicConsumer(topic, consumer, m.producer, m.handlers[topic.Name], m.log), nil
+}
+
+// Stop finishes handling the in-flight messages, commits their offsets,
+// leaves the consumer groups and closes the producer
+func (m *Module) Stop() error {
+	if !m.IsRunning() {
+		return nil
+	}
+
+	m.stateMu.Lock()
+	defer m.stateMu.Unlock()
+	m.isRunning = false
+
+	m.client.set(nil)
+	err := m.stopConsumers()
+	if m.producer != nil {
+		if perr := m.producer.Close(); perr != nil && err == nil {
+			err = perr
+		}
+		m.producer = nil
+	}
+	if berr := m.broker.Close(); berr != nil && err == nil {
+		err = berr
+	}
+	return err
+}
+
+func (m *Module) stopConsumers() error {
+	var err error
+	for _, tc := range m.consumers {
+		if cerr := tc.stop(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	m.consumers = nil
+	return err
+}
+
+// IsRunning returns whether the module is currently running
+func (m *Module) IsRunning() bool {
+	m.stateMu.RLock()
+	defer m.stateMu.RUnlock()
+	return m.isRunning
+}
+
+func getConfigKey(name string) string {
+	return fmt.Sprintf("modules.%s", name)
+}
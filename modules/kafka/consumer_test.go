@@ -0,0 +1,401 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHost struct {
+	service.Host
+	config config.Provider
+	roles  []string
+}
+
+func (h testHost) Config() config.Provider {
+	return h.config
+}
+
+func (h testHost) Roles() []string {
+	return h.roles
+}
+
+func createInfo(cfg string) service.ModuleCreateInfo {
+	return service.ModuleCreateInfo{
+		Host: testHost{
+			Host:   service.NopHost(),
+			config: config.NewYAMLProviderFromBytes([]byte(cfg)),
+		},
+	}
+}
+
+func withBroker(b Broker) modules.Option {
+	return WithBroker(func(service.Host, Config) (Broker, error) {
+		return b, nil
+	})
+}
+
+const _ordersConfig = `
+modules:
+  kafka:
+    brokers:
+      - localhost:9092
+    group: orders-service
+    topics:
+      - name: orders
+        initialOffset: oldest
+`
+
+// recorder is a handler that records the handled messages
+type recorder struct {
+	sync.Mutex
+	msgs    []*Message
+	handled chan *Message
+	err     error
+}
+
+func newRecorder(err error) *recorder {
+	return &recorder{handled: make(chan *Message, 100), err: err}
+}
+
+func (r *recorder) Handle(ctx context.Context, msg *Message) error {
+	r.Lock()
+	r.msgs = append(r.msgs, msg)
+	r.Unlock()
+	r.handled <- msg
+	return r.err
+}
+
+func (r *recorder) create(topic string) (Handler, error) {
+	return r, nil
+}
+
+func (r *recorder) wait(t *testing.T, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-r.handled:
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for messages to be handled")
+		}
+	}
+}
+
+func waitForMessages(t *testing.T, broker *InMemBroker, topic string, count int) {
+	deadline := time.Now().Add(time.Second)
+	for len(broker.Messages(topic)) < count {
+		if time.Now().After(deadline) {
+			require.FailNow(t, "timed out waiting for messages to be produced", "topic %q", topic)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func startModule(t *testing.T, m *Module) {
+	ready := make(chan struct{}, 1)
+	require.NoError(t, <-m.Start(ready))
+	<-ready
+	assert.True(t, m.IsRunning())
+}
+
+func produce(t *testing.T, b Broker, topic string, keys ...string) {
+	p, err := b.NewProducer(ProducerConfig{})
+	require.NoError(t, err)
+	for i, key := range keys {
+		require.NoError(t, p.Produce(context.Background(), &Message{
+			Topic: topic,
+			Key:   []byte(key),
+			Value: []byte(fmt.Sprintf("%s-%d", key, i)),
+		}))
+	}
+}
+
+func TestNewModule(t *testing.T) {
+	mods, err := NewModule(newRecorder(nil).create, withBroker(NewInMemBroker(1)))(createInfo(_ordersConfig))
+	require.NoError(t, err)
+	require.Len(t, mods, 1)
+	assert.Equal(t, "kafka", mods[0].Name())
+
+	m := mods[0].(*Module)
+	assert.Equal(t, []string{"localhost:9092"}, m.config.Brokers)
+	assert.Equal(t, "orders-service", m.config.Group)
+	require.Len(t, m.config.Topics, 1)
+	assert.Equal(t, OffsetOldest, m.config.Topics[0].InitialOffset)
+}
+
+func TestNewModule_DefaultSaramaBroker(t *testing.T) {
+	mods, err := NewModule(newRecorder(nil).create)(createInfo(_ordersConfig))
+	require.NoError(t, err)
+	assert.IsType(t, &saramaBroker{}, mods[0].(*Module).broker)
+}
+
+func TestNewModule_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     string
+		create  HandlerCreateFunc
+		options []modules.Option
+		err     string
+	}{
+		{
+			name: "unnamed topic",
+			cfg:  "modules:\n  kafka:\n    topics:\n      - initialOffset: oldest\n",
+			err:  "topic 0 has no name",
+		},
+		{
+			name: "unknown commit strategy",
+			cfg:  "modules:\n  kafka:\n    topics:\n      - name: orders\n        commit:\n          strategy: never\n",
+			err:  "unknown commit strategy",
+		},
+		{
+			name: "unknown initial offset",
+			cfg:  "modules:\n  kafka:\n    topics:\n      - name: orders\n        initialOffset: middle\n",
+			err:  "unknown initial offset",
+		},
+		{
+			name: "retries without topic",
+			cfg:  "modules:\n  kafka:\n    topics:\n      - name: orders\n        retry:\n          maxRetries: 3\n",
+			err:  "without a retry topic",
+		},
+		{
+			name: "no brokers",
+			cfg:  "modules:\n  kafka:\n    topics:\n      - name: orders\n",
+			err:  "no Kafka brokers are configured",
+		},
+		{
+			name: "handler error",
+			cfg:  _ordersConfig,
+			create: func(topic string) (Handler, error) {
+				return nil, errors.New("no handler")
+			},
+			err: "unable to create handler for topic \"orders\"",
+		},
+		{
+			name: "broker error",
+			cfg:  _ordersConfig,
+			options: []modules.Option{WithBroker(func(service.Host, Config) (Broker, error) {
+				return nil, errors.New("no broker")
+			})},
+			err: "unable to create Kafka broker",
+		},
+		{
+			name: "option error",
+			cfg:  _ordersConfig,
+			options: []modules.Option{func(*service.ModuleCreateInfo) error {
+				return errors.New("bad option")
+			}},
+			err: "unable to apply option",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			create := tt.create
+			if create == nil {
+				create = newRecorder(nil).create
+			}
+			_, err := NewModule(create, tt.options...)(createInfo(tt.cfg))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestModule_ConsumesPartitionsInOrder(t *testing.T) {
+	broker := NewInMemBroker(4)
+	r := newRecorder(nil)
+	m, err := newModule(createInfo(_ordersConfig), r.create, withBroker(broker))
+	require.NoError(t, err)
+
+	keys := []string{"a", "b", "c", "a", "b", "a", "d", "e", "a", "c"}
+	produce(t, broker, "orders", keys...)
+	startModule(t, m)
+	r.wait(t, len(keys))
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+
+	// Messages with the same key land on the same partition and keep their order
+	last := make(map[int32]int64)
+	for _, msg := range r.msgs {
+		if offset, ok := last[msg.Partition]; ok {
+			assert.True(t, msg.Offset > offset, "messages of a partition are handled in order")
+		}
+		last[msg.Partition] = msg.Offset
+	}
+	for partition, offset := range last {
+		committed, ok := broker.CommittedOffset("orders-service", "orders", partition)
+		assert.True(t, ok)
+		assert.Equal(t, offset+1, committed)
+	}
+}
+
+func TestModule_ResumesFromCommittedOffset(t *testing.T) {
+	broker := NewInMemBroker(1)
+	produce(t, broker, "orders", "a", "b")
+	broker.commit("orders-service", map[topicPartition]int64{{topic: "orders"}: 1})
+
+	r := newRecorder(nil)
+	m, err := newModule(createInfo(_ordersConfig), r.create, withBroker(broker))
+	require.NoError(t, err)
+	startModule(t, m)
+	r.wait(t, 1)
+	require.NoError(t, m.Stop())
+	require.Len(t, r.msgs, 1)
+	assert.Equal(t, []byte("b"), r.msgs[0].Key)
+}
+
+func TestModule_RetryAndDeadLetter(t *testing.T) {
+	cfg := `
+modules:
+  kafka:
+    topics:
+      - name: orders
+        commit:
+          strategy: sync
+        retry:
+          topic: orders-retry
+          maxRetries: 2
+        dlq:
+          topic: orders-dlq
+`
+	broker := NewInMemBroker(1)
+	r := newRecorder(errors.New("handler failed"))
+	m, err := newModule(createInfo(cfg), r.create, withBroker(broker))
+	require.NoError(t, err)
+	startModule(t, m)
+	defer m.Stop()
+
+	produce(t, broker, "orders", "a")
+	// The first attempt and two retries
+	r.wait(t, 3)
+	waitForMessages(t, broker, "orders-dlq", 1)
+
+	retries := broker.Messages("orders-retry")
+	require.Len(t, retries, 2)
+	assert.Equal(t, "1", retries[0].Headers[RetryCountHeader])
+	assert.Equal(t, "2", retries[1].Headers[RetryCountHeader])
+
+	dead := broker.Messages("orders-dlq")[0]
+	assert.Equal(t, []byte("a"), dead.Key)
+	assert.Equal(t, "2", dead.Headers[RetryCountHeader])
+	assert.Equal(t, "handler failed", dead.Headers[ErrorHeader])
+
+	committed, ok := broker.CommittedOffset(service.NopHost().Name(), "orders", 0)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), committed)
+}
+
+func TestModule_WithMiddleware(t *testing.T) {
+	broker := NewInMemBroker(1)
+	r := newRecorder(nil)
+	var topics []string
+	mw := MiddlewareFunc(func(ctx context.Context, msg *Message, next Handler) error {
+		topics = append(topics, msg.Topic)
+		return next.Handle(ctx, msg)
+	})
+	m, err := newModule(createInfo(_ordersConfig), r.create, withBroker(broker), WithMiddleware(mw))
+	require.NoError(t, err)
+	produce(t, broker, "orders", "a")
+	startModule(t, m)
+	r.wait(t, 1)
+	require.NoError(t, m.Stop())
+	assert.Equal(t, []string{"orders"}, topics)
+}
+
+func TestModule_StartTwice(t *testing.T) {
+	m, err := newModule(createInfo(_ordersConfig), newRecorder(nil).create, withBroker(NewInMemBroker(1)))
+	require.NoError(t, err)
+	startModule(t, m)
+	defer m.Stop()
+	assert.Error(t, <-m.Start(make(chan struct{}, 1)))
+}
+
+func TestModule_StartError(t *testing.T) {
+	broker := NewInMemBroker(1)
+	require.NoError(t, broker.Close())
+	m, err := newModule(createInfo(_ordersConfig), newRecorder(nil).create, withBroker(broker))
+	require.NoError(t, err)
+	err = <-m.Start(make(chan struct{}, 1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to create consumer for topic \"orders\"")
+	assert.False(t, m.IsRunning())
+	assert.NoError(t, m.Stop())
+}
+
+// subscribeFailureBroker creates producers but fails to subscribe, and records
+// whether the producers it created were closed
+type subscribeFailureBroker struct {
+	*InMemBroker
+	producers []*closeRecordingProducer
+}
+
+func (b *subscribeFailureBroker) NewConsumer(string, []string, ConsumerOptions) (Consumer, error) {
+	return nil, errors.New("subscribe failed")
+}
+
+func (b *subscribeFailureBroker) NewProducer(config ProducerConfig) (Producer, error) {
+	p, err := b.InMemBroker.NewProducer(config)
+	if err != nil {
+		return nil, err
+	}
+	rp := &closeRecordingProducer{Producer: p}
+	b.producers = append(b.producers, rp)
+	return rp, nil
+}
+
+type closeRecordingProducer struct {
+	Producer
+	closed bool
+}
+
+func (p *closeRecordingProducer) Close() error {
+	p.closed = true
+	return p.Producer.Close()
+}
+
+func TestModule_StartErrorClosesProducer(t *testing.T) {
+	cfg := `
+modules:
+  kafka:
+    topics:
+      - name: orders
+        retry:
+          topic: orders-retry
+`
+	broker := &subscribeFailureBroker{InMemBroker: NewInMemBroker(1)}
+	m, err := newModule(createInfo(cfg), newRecorder(nil).create, withBroker(broker))
+	require.NoError(t, err)
+	err = <-m.Start(make(chan struct{}, 1))
+	require.Error(t, err)
+	require.Len(t, broker.producers, 1)
+	assert.True(t, broker.producers[0].closed, "The producer should be closed when a subscription fails")
+	assert.Nil(t, m.producer)
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package kafka is the Kafka Module.
+//
+// The Kafka module consumes topics with consumer groups and hands every message
+// to a kafka.Handler created for its topic. Partitions are processed
+// concurrently, while the messages of a partition are handled in order.
+//
+//	package main
+//
+//	import (
+//	  "context"
+//
+//	  "go.uber.org/fx"
+//	  "go.uber.org/fx/modules/kafka"
+//	  "go.uber.org/fx/service"
+//	)
+//
+//	func main() {
+//	  svc, err := service.WithModules(
+//	    kafka.NewModule(newHandler),
+//	  ).Build()
+//
+//	  if err != nil {
+//	    log.Fatal("Could not initialize service: ", err)
+//	  }
+//
+//	  svc.Start(true)
+//	}
+//
+//	func newHandler(topic string) (kafka.Handler, error) {
+//	  return kafka.HandlerFunc(func(ctx context.Context, msg *kafka.Message) error {
+//	    fx.Logger(ctx).Info("Received message", "key", string(msg.Key))
+//	    return nil
+//	  }), nil
+//	}
+//
+// # Configuration
+//
+// Topics are configured under the module key. The consumer group defaults to the
+// service name and can be overridden per topic.
+//
+//	modules:
+//	  kafka:
+//	    brokers:
+//	      - localhost:9092
+//	    group: orders-service
+//	    topics:
+//	      - name: orders
+//	        initialOffset: oldest
+//	        commit:
+//	          strategy: after
+//	          interval: 1s
+//	        retry:
+//	          topic: orders-retry
+//	          maxRetries: 3
+//	        dlq:
+//	          topic: orders-dlq
+//
+// initialOffset is where a group without a committed offset starts, either
+// newest (the default) or oldest.
+//
+// The commit strategy controls the delivery guarantee:
+//
+// • after (the default) marks the offset once the message is handled and
+// commits marked offsets every interval, so messages are processed at least once.
+//
+// • before marks the offset before the message is handled, so messages are
+// processed at most once.
+//
+// • sync commits the offset of every message once it is handled.
+//
+// # Retries and dead letters
+//
+// When a handler returns an error and a retry topic is configured, the message
+// is published to the retry topic, which the module consumes with the same handler.
+// The fx-kafka-retry-count header counts the retries. Once a message runs out of
+// retries it is published to the dead letter topic with the last error in the
+// fx-kafka-error header. A panic in a handler is recovered and fails the message
+// like an error.
+//
+// Unless messages are processed at most once, the offset of a failed message is only
+// marked once it is published. Failed publishes are attempted again with a backoff of
+// up to 10s, which holds up the partition. Without a topic to publish to, the message
+// is handled again with the same backoff until it succeeds, unless messages are
+// processed at most once. When the module stops first, the offset stays unmarked and
+// the partition is consumed again from the message once the group rebalances.
+//
+// # Middleware
+//
+// Handlers are wrapped with middleware that injects the service context, reports
+// metrics for every topic and starts a span that follows from the tracing context
+// in the message headers. Custom middleware is added with kafka.WithMiddleware.
+//
+// # Testing
+//
+// kafka.NewInMemBroker is an in-process stand-in for a Kafka cluster. Pass it to
+// the module with the kafka.WithBroker option and publish messages with its producer.
+//
+//	broker := kafka.NewInMemBroker(4)
+//	svc, err := service.WithModules(
+//	  kafka.NewModule(newHandler, kafka.WithBroker(
+//	    func(service.Host, kafka.Config) (kafka.Broker, error) {
+//	      return broker, nil
+//	    },
+//	  )),
+//	).Build()
+package kafka
@@ -0,0 +1,317 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+var errBrokerClosed = errors.New("broker is closed")
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// InMemBroker is an in-process stand-in for a Kafka cluster, designed for use in tests.
+// Every consumer of a group is assigned all partitions of its topics. Random partitioning
+// is approximated with round-robin, and compression and batching settings have no effect.
+type InMemBroker struct {
+	sync.Mutex
+	partitions int
+	logs       map[string][][]*Message
+	committed  map[string]map[topicPartition]int64
+	next       uint32
+	// changed is closed and replaced every time a message is produced
+	changed chan struct{}
+	closed  bool
+}
+
+var _ Broker = &InMemBroker{}
+
+// NewInMemBroker creates an in-memory broker with the number of partitions per topic
+func NewInMemBroker(partitions int) *InMemBroker {
+	if partitions < 1 {
+		partitions = 1
+	}
+	return &InMemBroker{
+		partitions: partitions,
+		logs:       make(map[string][][]*Message),
+		committed:  make(map[string]map[topicPartition]int64),
+		changed:    make(chan struct{}),
+	}
+}
+
+// NewConsumer implements the Broker interface
+func (b *InMemBroker) NewConsumer(group string, topics []string, options ConsumerOptions) (Consumer, error) {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return nil, errBrokerClosed
+	}
+	c := &inMemConsumer{
+		broker:   b,
+		group:    group,
+		messages: make(chan *Message),
+		errors:   make(chan error),
+		marked:   make(map[topicPartition]int64),
+		position: make(map[topicPartition]int64),
+		done:     make(chan struct{}),
+	}
+	for _, topic := range topics {
+		log := b.topicLog(topic)
+		for p := range log {
+			tp := topicPartition{topic: topic, partition: int32(p)}
+			if offset, ok := b.committed[group][tp]; ok {
+				c.position[tp] = offset
+			} else if options.InitialOffset == OffsetOldest {
+				c.position[tp] = 0
+			} else {
+				c.position[tp] = int64(len(log[p]))
+			}
+		}
+	}
+	c.wg.Add(1)
+	go c.consume()
+	if options.CommitInterval > 0 {
+		c.wg.Add(1)
+		go c.autoCommit(options.CommitInterval)
+	}
+	return c, nil
+}
+
+// NewProducer implements the Broker interface
+func (b *InMemBroker) NewProducer(config ProducerConfig) (Producer, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return inMemProducer{broker: b, partitioner: config.Partitioner}, nil
+}
+
+// Close implements the Broker interface
+func (b *InMemBroker) Close() error {
+	b.Lock()
+	defer b.Unlock()
+	if !b.closed {
+		b.closed = true
+		close(b.changed)
+	}
+	return nil
+}
+
+// Messages returns the messages produced to the topic across all partitions
+func (b *InMemBroker) Messages(topic string) []*Message {
+	b.Lock()
+	defer b.Unlock()
+	var msgs []*Message
+	for _, partition := range b.logs[topic] {
+		msgs = append(msgs, partition...)
+	}
+	return msgs
+}
+
+// CommittedOffset returns the next offset the group consumes from the partition.
+// The returned bool is false if the group has not committed an offset.
+func (b *InMemBroker) CommittedOffset(group, topic string, partition int32) (int64, bool) {
+	b.Lock()
+	defer b.Unlock()
+	offset, ok := b.committed[group][topicPartition{topic: topic, partition: partition}]
+	return offset, ok
+}
+
+// topicLog returns the partitions of the topic, creating the topic if needed.
+// Must be called with the lock held.
+func (b *InMemBroker) topicLog(topic string) [][]*Message {
+	log, ok := b.logs[topic]
+	if !ok {
+		log = make([][]*Message, b.partitions)
+		b.logs[topic] = log
+	}
+	return log
+}
+
+func (b *InMemBroker) produce(msg *Message, partitioner Partitioner) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.closed {
+		return errBrokerClosed
+	}
+	log := b.topicLog(msg.Topic)
+	var partition int
+	switch {
+	case partitioner == PartitionerManual:
+		if msg.Partition < 0 || int(msg.Partition) >= len(log) {
+			return fmt.Errorf("partition %d does not exist", msg.Partition)
+		}
+		partition = int(msg.Partition)
+	case msg.Key == nil || partitioner == PartitionerRandom || partitioner == PartitionerRoundRobin:
+		partition = int(b.next % uint32(len(log)))
+		b.next++
+	default:
+		h := fnv.New32a()
+		h.Write(msg.Key)
+		partition = int(h.Sum32() % uint32(len(log)))
+	}
+	stored := msg.copy(msg.Topic)
+	stored.Partition = int32(partition)
+	stored.Offset = int64(len(log[partition]))
+	stored.Timestamp = time.Now()
+	log[partition] = append(log[partition], stored)
+	close(b.changed)
+	b.changed = make(chan struct{})
+	return nil
+}
+
+func (b *InMemBroker) commit(group string, offsets map[topicPartition]int64) {
+	b.Lock()
+	defer b.Unlock()
+	if b.committed[group] == nil {
+		b.committed[group] = make(map[topicPartition]int64)
+	}
+	for tp, offset := range offsets {
+		b.committed[group][tp] = offset
+	}
+}
+
+type inMemProducer struct {
+	broker      *InMemBroker
+	partitioner Partitioner
+}
+
+func (p inMemProducer) Produce(ctx context.Context, msg *Message) error {
+	return p.broker.produce(msg, p.partitioner)
+}
+
+func (p inMemProducer) Close() error {
+	return nil
+}
+
+type inMemConsumer struct {
+	broker   *InMemBroker
+	group    string
+	messages chan *Message
+	errors   chan error
+
+	markedMu sync.Mutex
+	marked   map[topicPartition]int64
+
+	// position is only accessed by the consume goroutine
+	position map[topicPartition]int64
+
+	closeOnce sync.Once
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func (c *inMemConsumer) Messages() <-chan *Message {
+	return c.messages
+}
+
+func (c *inMemConsumer) Errors() <-chan error {
+	return c.errors
+}
+
+func (c *inMemConsumer) MarkOffset(msg *Message) {
+	c.markedMu.Lock()
+	defer c.markedMu.Unlock()
+	c.marked[topicPartition{topic: msg.Topic, partition: msg.Partition}] = msg.Offset + 1
+}
+
+func (c *inMemConsumer) CommitOffsets() error {
+	c.markedMu.Lock()
+	defer c.markedMu.Unlock()
+	if len(c.marked) > 0 {
+		c.broker.commit(c.group, c.marked)
+		c.marked = make(map[topicPartition]int64)
+	}
+	return nil
+}
+
+func (c *inMemConsumer) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.wg.Wait()
+		close(c.messages)
+		close(c.errors)
+	})
+	return c.CommitOffsets()
+}
+
+func (c *inMemConsumer) consume() {
+	defer c.wg.Done()
+	for {
+		pending, changed := c.poll()
+		for _, msg := range pending {
+			select {
+			case c.messages <- msg:
+				c.position[topicPartition{topic: msg.Topic, partition: msg.Partition}] = msg.Offset + 1
+			case <-c.done:
+				return
+			}
+		}
+		if len(pending) > 0 {
+			continue
+		}
+		select {
+		case <-changed:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// poll returns the messages past the consumer position and a channel that is
+// closed once more messages are produced
+func (c *inMemConsumer) poll() ([]*Message, <-chan struct{}) {
+	c.broker.Lock()
+	defer c.broker.Unlock()
+	if c.broker.closed {
+		// No more messages are produced, block until the consumer is closed
+		return nil, nil
+	}
+	var pending []*Message
+	for tp, offset := range c.position {
+		partition := c.broker.logs[tp.topic][tp.partition]
+		if offset < int64(len(partition)) {
+			pending = append(pending, partition[offset:]...)
+		}
+	}
+	return pending, c.broker.changed
+}
+
+func (c *inMemConsumer) autoCommit(interval time.Duration) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.CommitOffsets()
+		case <-c.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, c Consumer) *Message {
+	select {
+	case msg := <-c.Messages():
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for a message")
+	}
+	return nil
+}
+
+func TestInMemBroker_Partitioning(t *testing.T) {
+	b := NewInMemBroker(3)
+	produce(t, b, "orders", "a", "a", "a")
+	msgs := b.Messages("orders")
+	require.Len(t, msgs, 3)
+	for i, msg := range msgs {
+		assert.Equal(t, msgs[0].Partition, msg.Partition, "messages with the same key share a partition")
+		assert.Equal(t, int64(i), msg.Offset)
+	}
+
+	p, err := b.NewProducer(ProducerConfig{})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, p.Produce(context.Background(), &Message{Topic: "events"}))
+	}
+	partitions := make(map[int32]bool)
+	for _, msg := range b.Messages("events") {
+		partitions[msg.Partition] = true
+	}
+	assert.Len(t, partitions, 3, "messages without a key are spread over the partitions")
+	assert.NoError(t, p.Close())
+}
+
+func TestInMemBroker_InitialOffset(t *testing.T) {
+	b := NewInMemBroker(1)
+	produce(t, b, "orders", "old")
+
+	oldest, err := b.NewConsumer("oldest", []string{"orders"}, ConsumerOptions{InitialOffset: OffsetOldest})
+	require.NoError(t, err)
+	defer oldest.Close()
+	newest, err := b.NewConsumer("newest", []string{"orders"}, ConsumerOptions{InitialOffset: OffsetNewest})
+	require.NoError(t, err)
+	defer newest.Close()
+
+	produce(t, b, "orders", "new")
+	assert.Equal(t, []byte("old"), receive(t, oldest).Key)
+	assert.Equal(t, []byte("new"), receive(t, oldest).Key)
+	assert.Equal(t, []byte("new"), receive(t, newest).Key)
+}
+
+func TestInMemBroker_CommitOffsets(t *testing.T) {
+	b := NewInMemBroker(1)
+	produce(t, b, "orders", "a", "b")
+	c, err := b.NewConsumer("group", []string{"orders"}, ConsumerOptions{InitialOffset: OffsetOldest})
+	require.NoError(t, err)
+
+	c.MarkOffset(receive(t, c))
+	_, ok := b.CommittedOffset("group", "orders", 0)
+	assert.False(t, ok, "marked offsets are not committed until CommitOffsets")
+	require.NoError(t, c.CommitOffsets())
+	offset, ok := b.CommittedOffset("group", "orders", 0)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), offset)
+
+	c.MarkOffset(receive(t, c))
+	require.NoError(t, c.Close())
+	offset, _ = b.CommittedOffset("group", "orders", 0)
+	assert.Equal(t, int64(2), offset, "close commits the marked offsets")
+	_, ok = <-c.Messages()
+	assert.False(t, ok)
+}
+
+func TestInMemBroker_CommitInterval(t *testing.T) {
+	b := NewInMemBroker(1)
+	produce(t, b, "orders", "a")
+	c, err := b.NewConsumer("group", []string{"orders"}, ConsumerOptions{
+		InitialOffset:  OffsetOldest,
+		CommitInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	c.MarkOffset(receive(t, c))
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, ok := b.CommittedOffset("group", "orders", 0); ok {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "timed out waiting for the commit")
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestInMemBroker_Close(t *testing.T) {
+	b := NewInMemBroker(0)
+	c, err := b.NewConsumer("group", []string{"orders"}, ConsumerOptions{})
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+	require.NoError(t, b.Close())
+
+	_, err = b.NewConsumer("group", []string{"orders"}, ConsumerOptions{})
+	assert.Error(t, err)
+	p, err := b.NewProducer(ProducerConfig{})
+	require.NoError(t, err)
+	assert.Error(t, p.Produce(context.Background(), &Message{Topic: "orders"}))
+	assert.NoError(t, c.Close())
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package stats
+
+import "github.com/uber-go/tally"
+
+const (
+	// TagModule is module tag for metrics
+	TagModule = "module"
+	// TagType is the type of operation
+	TagType = "type"
+	// TagTopic is the Kafka topic
+	TagTopic = "topic"
+)
+
+var (
+	// KafkaTags creates metrics scope with defined tags
+	KafkaTags = map[string]string{
+		TagModule: "kafka",
+	}
+
+	// KafkaConsumeScope is a scope for consumed messages
+	KafkaConsumeScope tally.Scope
+	// KafkaProduceScope is a scope for produced messages
+	KafkaProduceScope tally.Scope
+	// KafkaRetryScope is a scope for messages sent to retry topics
+	KafkaRetryScope tally.Scope
+	// KafkaDLQScope is a scope for messages sent to dead letter topics
+	KafkaDLQScope tally.Scope
+	// KafkaConsumerErrorCounter counts errors reported by consumers
+	KafkaConsumerErrorCounter tally.Counter
+	// KafkaCommitFailCounter counts failed offset commits
+	KafkaCommitFailCounter tally.Counter
+	// KafkaPanicCounter counts panics recovered from handlers
+	KafkaPanicCounter tally.Counter
+)
+
+// SetupKafkaMetrics allocates counters for necessary setup
+func SetupKafkaMetrics(scope tally.Scope) {
+	kafkaScope := scope.Tagged(KafkaTags)
+	KafkaConsumeScope = kafkaScope.Tagged(map[string]string{TagType: "consume"})
+	KafkaProduceScope = kafkaScope.Tagged(map[string]string{TagType: "produce"})
+	KafkaRetryScope = kafkaScope.Tagged(map[string]string{TagType: "retry"})
+	KafkaDLQScope = kafkaScope.Tagged(map[string]string{TagType: "dlq"})
+	KafkaConsumerErrorCounter = kafkaScope.Tagged(map[string]string{TagType: "consumer"}).Counter("fail")
+	KafkaCommitFailCounter = kafkaScope.Tagged(map[string]string{TagType: "commit"}).Counter("fail")
+	KafkaPanicCounter = kafkaScope.Tagged(map[string]string{TagType: "consume"}).Counter("panic")
+}
@@ -20,19 +20,51 @@
 
 package kafka
 
+import (
+	"context"
+	"time"
+)
+
 // HandlerCreateFunc creates a handler for a topic name
 type HandlerCreateFunc func(topic string) (Handler, error)
 
-// Handler handles a single transport-level request.
+// Handler handles messages consumed from a topic
 type Handler interface {
-	// Handle the given request, writing the response to the given
-	// ResponseWriter.
-	//
-	// An error may be returned in case of failures. BadRequestError must be
-	// returned for invalid requests. All other failures are treated as
-	// UnexpectedErrors.
-	// Handle(
-	// 	ctx context.Context,
-	// 	message consumer.Message,
-	// ) error
+	// Handle processes a single message. Messages of a partition are handled in order,
+	// and a returned error sends the message to the retry or dead letter topic when
+	// those are configured.
+	Handle(ctx context.Context, msg *Message) error
+}
+
+// HandlerFunc is an adaptor to call normal functions to handle messages
+type HandlerFunc func(ctx context.Context, msg *Message) error
+
+// Handle implements Handle from the Handler interface and simply delegates to the function
+func (f HandlerFunc) Handle(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+// Message is a single Kafka message
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   map[string]string
+	Timestamp time.Time
+}
+
+// copy returns a copy of the message that can be published to another topic
+func (m *Message) copy(topic string) *Message {
+	headers := make(map[string]string, len(m.Headers))
+	for k, v := range m.Headers {
+		headers[k] = v
+	}
+	return &Message{
+		Topic:   topic,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}
 }
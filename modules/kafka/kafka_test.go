@@ -19,3 +19,41 @@
 // THE SOFTWARE.
 
 package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlerFunc(t *testing.T) {
+	var handled *Message
+	h := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		handled = msg
+		return nil
+	})
+	msg := &Message{Topic: "orders"}
+	assert.NoError(t, h.Handle(context.Background(), msg))
+	assert.Equal(t, msg, handled)
+}
+
+func TestMessageCopy(t *testing.T) {
+	msg := &Message{
+		Topic:     "orders",
+		Partition: 3,
+		Offset:    42,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers:   map[string]string{"a": "b"},
+	}
+	c := msg.copy("orders-retry")
+	assert.Equal(t, "orders-retry", c.Topic)
+	assert.Equal(t, int32(0), c.Partition)
+	assert.Equal(t, int64(0), c.Offset)
+	assert.Equal(t, msg.Key, c.Key)
+	assert.Equal(t, msg.Value, c.Value)
+
+	c.Headers["a"] = "c"
+	assert.Equal(t, "b", msg.Headers["a"], "headers of the copy are independent")
+}
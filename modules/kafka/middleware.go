@@ -0,0 +1,132 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/service"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+)
+
+// Middleware applies logic around the handling of a message such as
+// adding tracing to the context
+type Middleware interface {
+	Handle(ctx context.Context, msg *Message, next Handler) error
+}
+
+// MiddlewareFunc is an adaptor to call normal functions as middleware
+type MiddlewareFunc func(ctx context.Context, msg *Message, next Handler) error
+
+// Handle implements Handle from the Middleware interface and simply delegates to the function
+func (f MiddlewareFunc) Handle(ctx context.Context, msg *Message, next Handler) error {
+	return f(ctx, msg, next)
+}
+
+type middlewareChain struct {
+	current      int
+	finalHandler Handler
+	middleware   []Middleware
+}
+
+func (mc middlewareChain) Handle(ctx context.Context, msg *Message) error {
+	if mc.current == len(mc.middleware) {
+		return mc.finalHandler.Handle(ctx, msg)
+	}
+	m := mc.middleware[mc.current]
+	mc.current++
+	return m.Handle(ctx, msg, mc)
+}
+
+func buildChain(finalHandler Handler, middleware []Middleware) Handler {
+	return middlewareChain{
+		finalHandler: finalHandler,
+		middleware:   middleware,
+	}
+}
+
+func defaultMiddleware(host service.Host) []Middleware {
+	return []Middleware{
+		contextMiddleware{host},
+		metricsMiddleware{},
+		tracingMiddleware{},
+	}
+}
+
+type contextMiddleware struct {
+	host service.Host
+}
+
+func (m contextMiddleware) Handle(ctx context.Context, msg *Message, next Handler) error {
+	ctx = fx.NewContext(ctx, m.host)
+	return next.Handle(ctx, msg)
+}
+
+// metricsMiddleware counts and times the handled messages of each topic
+type metricsMiddleware struct{}
+
+func (m metricsMiddleware) Handle(ctx context.Context, msg *Message, next Handler) error {
+	scope := stats.KafkaConsumeScope.Tagged(map[string]string{stats.TagTopic: msg.Topic})
+	scope.Counter("count").Inc(1)
+	stopwatch := scope.Timer("time").Start()
+	defer stopwatch.Stop()
+
+	err := next.Handle(ctx, msg)
+	if err != nil {
+		scope.Counter("fail").Inc(1)
+	}
+	return err
+}
+
+// tracingMiddleware starts a span for the message that follows from the span
+// propagated in the message headers
+type tracingMiddleware struct{}
+
+func (m tracingMiddleware) Handle(ctx context.Context, msg *Message, next Handler) error {
+	tracer := opentracing.GlobalTracer()
+	var opts []opentracing.StartSpanOption
+	spanCtx, err := tracer.Extract(opentracing.TextMap, opentracing.TextMapCarrier(msg.Headers))
+	if err != nil && err != opentracing.ErrSpanContextNotFound {
+		fx.Logger(ctx).Warn("Malformed inbound tracing context: ", "error", err.Error())
+	}
+	if spanCtx != nil {
+		opts = append(opts, opentracing.FollowsFrom(spanCtx))
+	}
+	span := tracer.StartSpan("kafka.consume", opts...)
+	defer span.Finish()
+	ext.Component.Set(span, "kafka")
+	span.SetTag("kafka.topic", msg.Topic)
+	span.SetTag("kafka.partition", msg.Partition)
+	span.SetTag("kafka.offset", msg.Offset)
+
+	ctx = opentracing.ContextWithSpan(ctx, span)
+	ctx = fx.WithContextAwareLogger(ctx, span)
+
+	err = next.Handle(ctx, msg)
+	if err != nil {
+		ext.Error.Set(span, true)
+	}
+	return err
+}
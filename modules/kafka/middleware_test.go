@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/service"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return MiddlewareFunc(func(ctx context.Context, msg *Message, next Handler) error {
+			order = append(order, name)
+			return next.Handle(ctx, msg)
+		})
+	}
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		order = append(order, "handler")
+		return nil
+	})
+	chain := buildChain(handler, []Middleware{record("first"), record("second")})
+	require.NoError(t, chain.Handle(context.Background(), &Message{}))
+	assert.Equal(t, []string{"first", "second", "handler"}, order)
+}
+
+func TestDefaultMiddleware(t *testing.T) {
+	host := service.NopHost()
+	var ctxHasSpan bool
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		ctxHasSpan = opentracing.SpanFromContext(ctx) != nil
+		assert.NotNil(t, fx.Logger(ctx))
+		return errors.New("handler failed")
+	})
+	chain := buildChain(handler, defaultMiddleware(host))
+	err := chain.Handle(context.Background(), &Message{Topic: "orders"})
+	assert.EqualError(t, err, "handler failed")
+	assert.True(t, ctxHasSpan)
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupKafkaMetrics(scope)
+	defer stats.SetupKafkaMetrics(service.NopHost().Metrics())
+
+	fail := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		return errors.New("handler failed")
+	})
+	chain := buildChain(fail, []Middleware{metricsMiddleware{}})
+	assert.Error(t, chain.Handle(context.Background(), &Message{Topic: "orders"}))
+
+	counters := make(map[string]int64)
+	for _, c := range scope.Snapshot().Counters() {
+		if c.Tags()[stats.TagTopic] == "orders" {
+			counters[c.Name()] = c.Value()
+		}
+	}
+	assert.Equal(t, map[string]int64{"count": 1, "fail": 1}, counters)
+}
+
+func TestTracingMiddleware_MalformedContext(t *testing.T) {
+	handled := false
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		handled = true
+		return nil
+	})
+	chain := buildChain(handler, []Middleware{contextMiddleware{service.NopHost()}, tracingMiddleware{}})
+	msg := &Message{Topic: "orders", Headers: map[string]string{"uber-trace-id": "malformed"}}
+	require.NoError(t, chain.Handle(context.Background(), msg))
+	assert.True(t, handled)
+}
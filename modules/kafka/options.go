@@ -0,0 +1,76 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/service"
+)
+
+const (
+	_brokerKey     = "kafkaBroker"
+	_middlewareKey = "kafkaMiddleware"
+)
+
+// WithBroker sets the function that creates the broker, the module connects
+// to the configured Kafka brokers by default
+func WithBroker(createFunc BrokerCreateFunc) modules.Option {
+	return func(mi *service.ModuleCreateInfo) error {
+		if mi.Items == nil {
+			mi.Items = make(map[string]interface{})
+		}
+		mi.Items[_brokerKey] = createFunc
+		return nil
+	}
+}
+
+// WithMiddleware adds custom middleware that is applied to every handled message
+func WithMiddleware(m ...Middleware) modules.Option {
+	return func(mi *service.ModuleCreateInfo) error {
+		middleware := middlewareFromCreateInfo(*mi)
+		middleware = append(middleware, m...)
+		if mi.Items == nil {
+			mi.Items = make(map[string]interface{})
+		}
+		mi.Items[_middlewareKey] = middleware
+		return nil
+	}
+}
+
+func brokerFromCreateInfo(mi service.ModuleCreateInfo) BrokerCreateFunc {
+	createFunc, ok := mi.Items[_brokerKey]
+	if !ok {
+		return newSaramaBroker
+	}
+
+	// Intentionally panic if programmer adds a different type to the data
+	return createFunc.(BrokerCreateFunc)
+}
+
+func middlewareFromCreateInfo(mi service.ModuleCreateInfo) []Middleware {
+	items, ok := mi.Items[_middlewareKey]
+	if !ok {
+		return nil
+	}
+
+	// Intentionally panic if programmer adds non-middleware slice to the data
+	return items.([]Middleware)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBroker(t *testing.T) {
+	mi := service.ModuleCreateInfo{}
+	assert.NotNil(t, brokerFromCreateInfo(mi), "the sarama broker is the default")
+
+	broker := NewInMemBroker(1)
+	require.NoError(t, withBroker(broker)(&mi))
+	b, err := brokerFromCreateInfo(mi)(service.NopHost(), Config{})
+	require.NoError(t, err)
+	assert.Equal(t, broker, b)
+}
+
+func TestWithMiddleware(t *testing.T) {
+	mi := service.ModuleCreateInfo{}
+	assert.Empty(t, middlewareFromCreateInfo(mi))
+
+	nop := MiddlewareFunc(func(ctx context.Context, msg *Message, next Handler) error {
+		return next.Handle(ctx, msg)
+	})
+	require.NoError(t, WithMiddleware(nop)(&mi))
+	require.NoError(t, WithMiddleware(nop, nop)(&mi))
+	assert.Len(t, middlewareFromCreateInfo(mi), 3)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/ulog"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RetryCountHeader holds the number of times a message was retried
+	RetryCountHeader = "fx-kafka-retry-count"
+	// ErrorHeader holds the error of the last failed attempt to handle a message
+	ErrorHeader = "fx-kafka-error"
+
+	// Messages buffered for each partition before consumption blocks
+	_partitionBufferSize = 64
+
+	// Backoff between attempts to handle or forward a failed message
+	_initialBackoff = 100 * time.Millisecond
+	_maxBackoff     = 10 * time.Second
+)
+
+// topicConsumer dispatches the messages of a consumer to a worker per partition,
+// so partitions are processed concurrently and messages of a partition in order
+type topicConsumer struct {
+	config   TopicConfig
+	consumer Consumer
+	producer Producer
+	handler  Handler
+	log      ulog.Log
+	backoff  time.Duration
+
+	partitions map[topicPartition]chan *Message
+	workers    sync.WaitGroup
+	done       chan struct{}
+	stopped    chan struct{}
+	stopOnce   sync.Once
+}
+
+// subscribe joins the consumer group for the topic and its retry topic
+func subscribe(broker Broker, group string, topic TopicConfig) (Consumer, error) {
+	topics := []string{topic.Name}
+	if topic.Retry.Topic != "" {
+		topics = append(topics, topic.Retry.Topic)
+	}
+	initialOffset := topic.InitialOffset
+	if initialOffset == "" {
+		initialOffset = OffsetNewest
+	}
+	consumer, err := broker.NewConsumer(group, topics, ConsumerOptions{
+		InitialOffset:  initialOffset,
+		CommitInterval: topic.Commit.Interval,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create consumer for topic %q", topic.Name)
+	}
+	return consumer, nil
+}
+
+func newTopicConsumer(
+	config TopicConfig, consumer Consumer, producer Producer, handler Handler, log ulog.Log,
+) *topicConsumer {
+	return &topicConsumer{
+		config:     config,
+		consumer:   consumer,
+		producer:   producer,
+		handler:    handler,
+		log:        log.With("topic", config.Name),
+		backoff:    _initialBackoff,
+		partitions: make(map[topicPartition]chan *Message),
+		done:       make(chan struct{}),
+		stopped:    make(chan struct{}),
+	}
+}
+
+func (tc *topicConsumer) run() {
+	defer close(tc.stopped)
+	defer tc.drain()
+	errCh := tc.consumer.Errors()
+	for {
+		select {
+		case <-tc.done:
+			return
+		case err, ok := <-errCh:
+			if !ok {
+				errCh = nil
+				continue
+			}
+			stats.KafkaConsumerErrorCounter.Inc(1)
+			tc.log.Error("Kafka consumer error", "error", err)
+		case msg, ok := <-tc.consumer.Messages():
+			if !ok {
+				return
+			}
+			tc.dispatch(msg)
+		}
+	}
+}
+
+func (tc *topicConsumer) dispatch(msg *Message) {
+	tp := topicPartition{topic: msg.Topic, partition: msg.Partition}
+	ch, ok := tc.partitions[tp]
+	if !ok {
+		ch = make(chan *Message, _partitionBufferSize)
+		tc.partitions[tp] = ch
+		tc.workers.Add(1)
+		go tc.work(ch)
+	}
+	select {
+	case ch <- msg:
+	case <-tc.done:
+		// The message is not marked, so it is consumed again once the group rebalances
+	}
+}
+
+// drain waits for the workers to handle the messages dispatched to them
+func (tc *topicConsumer) drain() {
+	for _, ch := range tc.partitions {
+		close(ch)
+	}
+	tc.workers.Wait()
+}
+
+func (tc *topicConsumer) work(ch <-chan *Message) {
+	defer tc.workers.Done()
+	for msg := range ch {
+		if !tc.process(msg) {
+			// Later offsets are not marked either, so that the commit does not skip the
+			// message, and the partition is consumed again from it once the group rebalances
+			for range ch {
+			}
+			return
+		}
+	}
+}
+
+// process handles the message and marks its offset once it is handled or forwarded
+// to the retry or dead letter topic. Failed forwards, and failed messages without a
+// topic to forward them to, are attempted again with backoff, which holds up the
+// partition. It returns false if the consumer stopped first, leaving the offset unmarked.
+func (tc *topicConsumer) process(msg *Message) bool {
+	atMostOnce := tc.config.Commit.Strategy == CommitBeforeProcess
+	if atMostOnce {
+		tc.consumer.MarkOffset(msg)
+	}
+
+	backoff := tc.backoff
+	err := tc.handle(msg)
+	// Messages processed at most once are not handled again
+	for err != nil && !atMostOnce && !tc.forwards(msg) {
+		if !tc.wait(&backoff) {
+			return false
+		}
+		err = tc.handle(msg)
+	}
+	for err != nil {
+		ferr := tc.handleFailure(msg, err)
+		if ferr == nil {
+			break
+		}
+		tc.log.Error("Unable to forward failed Kafka message",
+			"error", ferr, "partition", msg.Partition, "offset", msg.Offset)
+		if !tc.wait(&backoff) {
+			return false
+		}
+	}
+
+	switch tc.config.Commit.Strategy {
+	case CommitBeforeProcess:
+	case CommitSync:
+		tc.consumer.MarkOffset(msg)
+		if err := tc.consumer.CommitOffsets(); err != nil {
+			stats.KafkaCommitFailCounter.Inc(1)
+			tc.log.Error("Unable to commit Kafka offsets", "error", err)
+		}
+	default:
+		tc.consumer.MarkOffset(msg)
+	}
+	return true
+}
+
+// handle runs the handler, a panic in it is reported and returned as the handler error
+func (tc *topicConsumer) handle(msg *Message) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			stats.KafkaPanicCounter.Inc(1)
+			err = modules.RecoveryConfig{}.ReportPanic(context.Background(), p,
+				"topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+		}
+	}()
+	if err = tc.handler.Handle(context.Background(), msg); err != nil {
+		tc.log.Error("Unable to handle Kafka message",
+			"error", err, "partition", msg.Partition, "offset", msg.Offset)
+	}
+	return err
+}
+
+// wait sleeps for the backoff and doubles it, it returns false if the consumer stops first
+func (tc *topicConsumer) wait(backoff *time.Duration) bool {
+	select {
+	case <-tc.done:
+		return false
+	case <-time.After(*backoff):
+	}
+	if *backoff *= 2; *backoff > _maxBackoff {
+		*backoff = _maxBackoff
+	}
+	return true
+}
+
+// forwards tells whether a failed message is published to the retry or dead letter topic
+func (tc *topicConsumer) forwards(msg *Message) bool {
+	return tc.retries(msg) || tc.config.DLQ.Topic != ""
+}
+
+// retries tells whether a failed message is published to the retry topic
+func (tc *topicConsumer) retries(msg *Message) bool {
+	retries, _ := strconv.Atoi(msg.Headers[RetryCountHeader])
+	return tc.config.Retry.Topic != "" && retries < tc.config.Retry.MaxRetries
+}
+
+// handleFailure sends the message to the retry topic until it runs out of retries,
+// and to the dead letter topic after that. Messages are counted once they are published.
+func (tc *topicConsumer) handleFailure(msg *Message, handleErr error) error {
+	if tc.retries(msg) {
+		retries, _ := strconv.Atoi(msg.Headers[RetryCountHeader])
+		retry := msg.copy(tc.config.Retry.Topic)
+		retry.Headers[RetryCountHeader] = strconv.Itoa(retries + 1)
+		retry.Headers[ErrorHeader] = handleErr.Error()
+		if err := tc.producer.Produce(context.Background(), retry); err != nil {
+			return errors.Wrap(err, "unable to publish to the retry topic")
+		}
+		stats.KafkaRetryScope.Tagged(map[string]string{stats.TagTopic: tc.config.Name}).Counter("count").Inc(1)
+		return nil
+	}
+	if tc.config.DLQ.Topic != "" {
+		dead := msg.copy(tc.config.DLQ.Topic)
+		dead.Headers[ErrorHeader] = handleErr.Error()
+		if err := tc.producer.Produce(context.Background(), dead); err != nil {
+			return errors.Wrap(err, "unable to publish to the dead letter topic")
+		}
+		stats.KafkaDLQScope.Tagged(map[string]string{stats.TagTopic: tc.config.Name}).Counter("count").Inc(1)
+		return nil
+	}
+	return nil
+}
+
+// stop finishes handling the dispatched messages and closes the consumer
+func (tc *topicConsumer) stop() error {
+	var err error
+	tc.stopOnce.Do(func() {
+		close(tc.done)
+		<-tc.stopped
+		if cerr := tc.consumer.CommitOffsets(); cerr != nil {
+			stats.KafkaCommitFailCounter.Inc(1)
+			err = cerr
+		}
+		if cerr := tc.consumer.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	})
+	return err
+}
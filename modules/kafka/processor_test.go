@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+// fakeConsumer records the calls made by the topic consumer
+type fakeConsumer struct {
+	messages  chan *Message
+	errors    chan error
+	calls     []string
+	commitErr error
+}
+
+func newFakeConsumer() *fakeConsumer {
+	return &fakeConsumer{
+		messages: make(chan *Message),
+		errors:   make(chan error),
+	}
+}
+
+func (c *fakeConsumer) Messages() <-chan *Message { return c.messages }
+func (c *fakeConsumer) Errors() <-chan error      { return c.errors }
+func (c *fakeConsumer) MarkOffset(msg *Message)   { c.calls = append(c.calls, "mark") }
+func (c *fakeConsumer) CommitOffsets() error {
+	c.calls = append(c.calls, "commit")
+	return c.commitErr
+}
+func (c *fakeConsumer) Close() error {
+	c.calls = append(c.calls, "close")
+	return nil
+}
+
+type failingProducer struct{}
+
+func (failingProducer) Produce(ctx context.Context, msg *Message) error {
+	return errors.New("produce failed")
+}
+func (failingProducer) Close() error { return nil }
+
+// flakyProducer fails the first messages and records the published ones
+type flakyProducer struct {
+	failures int
+	produced []*Message
+}
+
+func (p *flakyProducer) Produce(ctx context.Context, msg *Message) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("produce failed")
+	}
+	p.produced = append(p.produced, msg)
+	return nil
+}
+func (p *flakyProducer) Close() error { return nil }
+
+func init() {
+	stats.SetupKafkaMetrics(service.NopHost().Metrics())
+}
+
+func TestProcess_CommitStrategies(t *testing.T) {
+	tests := []struct {
+		strategy CommitStrategy
+		calls    []string
+	}{
+		{"", []string{"handle", "mark"}},
+		{CommitAfterProcess, []string{"handle", "mark"}},
+		{CommitBeforeProcess, []string{"mark", "handle"}},
+		{CommitSync, []string{"handle", "mark", "commit"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			c := newFakeConsumer()
+			handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+				c.calls = append(c.calls, "handle")
+				return nil
+			})
+			cfg := TopicConfig{Name: "orders", Commit: CommitConfig{Strategy: tt.strategy}}
+			tc := newTopicConsumer(cfg, c, nil, handler, ulog.NopLogger)
+			tc.process(&Message{Topic: "orders"})
+			assert.Equal(t, tt.calls, c.calls)
+		})
+	}
+}
+
+func TestProcess_CommitError(t *testing.T) {
+	c := newFakeConsumer()
+	c.commitErr = errors.New("commit failed")
+	cfg := TopicConfig{Name: "orders", Commit: CommitConfig{Strategy: CommitSync}}
+	tc := newTopicConsumer(cfg, c, nil, newRecorder(nil), ulog.NopLogger)
+	tc.process(&Message{Topic: "orders"})
+	assert.Equal(t, []string{"mark", "commit"}, c.calls, "the message is marked even if the commit fails")
+}
+
+func TestHandleFailure_NoRetryOrDeadLetter(t *testing.T) {
+	tc := newTopicConsumer(TopicConfig{Name: "orders"}, newFakeConsumer(), nil, nil, ulog.NopLogger)
+	assert.NoError(t, tc.handleFailure(&Message{Topic: "orders"}, errors.New("failed")))
+}
+
+func TestHandleFailure_ProduceError(t *testing.T) {
+	cfg := TopicConfig{
+		Name:  "orders",
+		Retry: RetryConfig{Topic: "orders-retry", MaxRetries: 1},
+		DLQ:   DLQConfig{Topic: "orders-dlq"},
+	}
+	tc := newTopicConsumer(cfg, newFakeConsumer(), failingProducer{}, nil, ulog.NopLogger)
+
+	err := tc.handleFailure(&Message{Topic: "orders"}, errors.New("failed"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to publish to the retry topic")
+
+	exhausted := &Message{Topic: "orders-retry", Headers: map[string]string{RetryCountHeader: "1"}}
+	err = tc.handleFailure(exhausted, errors.New("failed"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to publish to the dead letter topic")
+}
+
+func TestProcess_ForwardErrorIsRetried(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupKafkaMetrics(scope)
+	defer stats.SetupKafkaMetrics(service.NopHost().Metrics())
+
+	c := newFakeConsumer()
+	producer := &flakyProducer{failures: 2}
+	cfg := TopicConfig{Name: "orders", DLQ: DLQConfig{Topic: "orders-dlq"}}
+	tc := newTopicConsumer(cfg, c, producer, newRecorder(errors.New("failed")), ulog.NopLogger)
+	tc.backoff = time.Millisecond
+
+	assert.True(t, tc.process(&Message{Topic: "orders"}))
+	require.Len(t, producer.produced, 1)
+	assert.Equal(t, "orders-dlq", producer.produced[0].Topic)
+	assert.Equal(t, []string{"mark"}, c.calls, "the message is marked once it is forwarded")
+	var forwarded int64
+	for _, c := range scope.Snapshot().Counters() {
+		if c.Name() == "count" && c.Tags()["type"] == "dlq" {
+			forwarded += c.Value()
+		}
+	}
+	assert.Equal(t, int64(1), forwarded, "only published messages are counted")
+}
+
+func TestProcess_StopWhileForwarding(t *testing.T) {
+	c := newFakeConsumer()
+	cfg := TopicConfig{Name: "orders", DLQ: DLQConfig{Topic: "orders-dlq"}}
+	tc := newTopicConsumer(cfg, c, failingProducer{}, newRecorder(errors.New("failed")), ulog.NopLogger)
+	close(tc.done)
+
+	assert.False(t, tc.process(&Message{Topic: "orders"}))
+	assert.Empty(t, c.calls, "the message is not marked until it is forwarded")
+}
+
+func TestProcess_HandledAgainWithoutTopics(t *testing.T) {
+	c := newFakeConsumer()
+	var attempts int
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		if attempts++; attempts < 3 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	tc := newTopicConsumer(TopicConfig{Name: "orders"}, c, nil, handler, ulog.NopLogger)
+	tc.backoff = time.Millisecond
+
+	assert.True(t, tc.process(&Message{Topic: "orders"}))
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []string{"mark"}, c.calls)
+
+	attempts = 0
+	c.calls = nil
+	tc.config.Commit.Strategy = CommitBeforeProcess
+	assert.True(t, tc.process(&Message{Topic: "orders"}))
+	assert.Equal(t, 1, attempts, "messages processed at most once are not handled again")
+	assert.Equal(t, []string{"mark"}, c.calls)
+}
+
+func TestProcess_Panic(t *testing.T) {
+	c := newFakeConsumer()
+	producer := &flakyProducer{}
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		panic("bad message")
+	})
+	cfg := TopicConfig{Name: "orders", DLQ: DLQConfig{Topic: "orders-dlq"}}
+	tc := newTopicConsumer(cfg, c, producer, handler, ulog.NopLogger)
+
+	assert.True(t, tc.process(&Message{Topic: "orders"}))
+	require.Len(t, producer.produced, 1)
+	assert.Equal(t, modules.ErrInternal.Error(), producer.produced[0].Headers[ErrorHeader])
+	assert.Equal(t, []string{"mark"}, c.calls)
+}
+
+func TestTopicConsumer_Stop(t *testing.T) {
+	c := newFakeConsumer()
+	handled := make(chan struct{})
+	handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+		close(handled)
+		return nil
+	})
+	tc := newTopicConsumer(TopicConfig{Name: "orders"}, c, nil, handler, ulog.NopLogger)
+	go tc.run()
+
+	c.errors <- errors.New("consumer error")
+	c.messages <- &Message{Topic: "orders"}
+	<-handled
+	require.NoError(t, tc.stop())
+	assert.Equal(t, []string{"mark", "commit", "close"}, c.calls)
+	assert.NoError(t, tc.stop(), "stop is idempotent")
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/service"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	errs "github.com/pkg/errors"
+)
+
+// Compression is the codec used to compress message batches
+type Compression string
+
+const (
+	// CompressionNone sends batches uncompressed
+	CompressionNone Compression = "none"
+	// CompressionGZIP compresses batches with gzip
+	CompressionGZIP Compression = "gzip"
+	// CompressionSnappy compresses batches with snappy
+	CompressionSnappy Compression = "snappy"
+	// CompressionLZ4 compresses batches with lz4
+	CompressionLZ4 Compression = "lz4"
+)
+
+// Partitioner chooses the partition a message is produced to
+type Partitioner string
+
+const (
+	// PartitionerHash sends messages with the same key to the same partition and spreads
+	// messages without a key randomly
+	PartitionerHash Partitioner = "hash"
+	// PartitionerRandom sends every message to a random partition
+	PartitionerRandom Partitioner = "random"
+	// PartitionerRoundRobin cycles through the partitions
+	PartitionerRoundRobin Partitioner = "roundrobin"
+	// PartitionerManual sends every message to the partition set on the message
+	PartitionerManual Partitioner = "manual"
+)
+
+// Acks is the number of replica acknowledgements the producer waits for
+type Acks string
+
+const (
+	// AcksAll waits for all in-sync replicas to commit the message
+	AcksAll Acks = "all"
+	// AcksLocal waits for the leader to commit the message
+	AcksLocal Acks = "local"
+	// AcksNone does not wait for a response
+	AcksNone Acks = "none"
+)
+
+// ProducerConfig configures producers, an empty value uses the defaults
+type ProducerConfig struct {
+	Compression Compression `yaml:"compression"`
+	Partitioner Partitioner `yaml:"partitioner"`
+	Acks        Acks        `yaml:"acks"`
+	// Idempotent makes sure retried sends are written exactly once per partition,
+	// it requires all acks
+	Idempotent bool        `yaml:"idempotent"`
+	MaxRetries int         `yaml:"maxRetries"`
+	Batch      BatchConfig `yaml:"batch"`
+}
+
+// BatchConfig configures how messages sent concurrently are batched. A batch is
+// flushed once any of the limits is reached.
+type BatchConfig struct {
+	Messages  int           `yaml:"messages"`
+	Bytes     int           `yaml:"bytes"`
+	Frequency time.Duration `yaml:"frequency"`
+}
+
+func (c ProducerConfig) validate() error {
+	switch c.Compression {
+	case "", CompressionNone, CompressionGZIP, CompressionSnappy, CompressionLZ4:
+	default:
+		return fmt.Errorf("unknown compression %q", c.Compression)
+	}
+	switch c.Partitioner {
+	case "", PartitionerHash, PartitionerRandom, PartitionerRoundRobin, PartitionerManual:
+	default:
+		return fmt.Errorf("unknown partitioner %q", c.Partitioner)
+	}
+	switch c.Acks {
+	case "", AcksAll:
+	case AcksLocal, AcksNone:
+		if c.Idempotent {
+			return errors.New("idempotent producers require all acks")
+		}
+	default:
+		return fmt.Errorf("unknown acks %q", c.Acks)
+	}
+	return nil
+}
+
+// ProducerFromHost returns the producer of the Kafka module registered on the host, the
+// module named kafka unless another name is given with modules.WithName. The producer uses
+// the brokers and the producer section of the module configuration, and propagates the
+// tracing context of the caller in the message headers. It is started and closed with the
+// module, so messages can be produced while the service runs.
+func ProducerFromHost(host service.Host, options ...modules.Option) (Producer, error) {
+	mi := service.ModuleCreateInfo{Name: "kafka", Host: host}
+	for _, opt := range options {
+		if err := opt(&mi); err != nil {
+			return nil, errs.Wrap(err, "unable to apply option to Kafka producer")
+		}
+	}
+	producer, ok := host.Resources()[producerResourceKey(mi.Name)].(*moduleProducer)
+	if !ok {
+		return nil, fmt.Errorf("no Kafka module %q is registered on the host", mi.Name)
+	}
+	return producer, nil
+}
+
+func producerResourceKey(name string) string {
+	return fmt.Sprintf("kafka.producer.%s", name)
+}
+
+// moduleProducer is the producer of a Kafka module, it produces through the producer
+// client of the module while the module runs
+type moduleProducer struct {
+	sync.RWMutex
+	client *producerClient
+}
+
+func (p *moduleProducer) set(client *producerClient) {
+	p.Lock()
+	defer p.Unlock()
+	p.client = client
+}
+
+// Produce implements the Producer interface
+func (p *moduleProducer) Produce(ctx context.Context, msg *Message) error {
+	p.RLock()
+	client := p.client
+	p.RUnlock()
+	if client == nil {
+		return errors.New("cannot produce when the Kafka module is not running")
+	}
+	return client.Produce(ctx, msg)
+}
+
+// Close is a no-op, the producer is closed when the module stops
+func (p *moduleProducer) Close() error {
+	return nil
+}
+
+// producerClient adds tracing and metrics to a broker producer
+type producerClient struct {
+	Producer
+}
+
+func (p *producerClient) Produce(ctx context.Context, msg *Message) error {
+	scope := stats.KafkaProduceScope.Tagged(map[string]string{stats.TagTopic: msg.Topic})
+	scope.Counter("count").Inc(1)
+	stopwatch := scope.Timer("time").Start()
+	defer stopwatch.Stop()
+
+	err := p.produce(ctx, msg)
+	if err != nil {
+		scope.Counter("fail").Inc(1)
+	}
+	return err
+}
+
+func (p *producerClient) produce(ctx context.Context, msg *Message) error {
+	var opts []opentracing.StartSpanOption
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		opts = append(opts, opentracing.ChildOf(parent.Context()))
+	}
+	tracer := opentracing.GlobalTracer()
+	span := tracer.StartSpan("kafka.produce", opts...)
+	defer span.Finish()
+	ext.Component.Set(span, "kafka")
+	span.SetTag("kafka.topic", msg.Topic)
+
+	// Inject into a copy of the headers so the caller can reuse the message
+	traced := *msg
+	traced.Headers = make(map[string]string, len(msg.Headers))
+	for k, v := range msg.Headers {
+		traced.Headers[k] = v
+	}
+	if err := tracer.Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(traced.Headers)); err != nil {
+		fx.Logger(ctx).Warn("Unable to inject tracing context into message", "error", err)
+	}
+
+	err := p.Producer.Produce(ctx, &traced)
+	if err != nil {
+		ext.Error.Set(span, true)
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/service"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ProducerConfig
+		err    string
+	}{
+		{name: "defaults", config: ProducerConfig{}},
+		{
+			name: "all options",
+			config: ProducerConfig{
+				Compression: CompressionSnappy,
+				Partitioner: PartitionerRoundRobin,
+				Acks:        AcksAll,
+				Idempotent:  true,
+			},
+		},
+		{name: "compression", config: ProducerConfig{Compression: "zip"}, err: "unknown compression"},
+		{name: "partitioner", config: ProducerConfig{Partitioner: "sticky"}, err: "unknown partitioner"},
+		{name: "acks", config: ProducerConfig{Acks: "some"}, err: "unknown acks"},
+		{
+			name:   "idempotent",
+			config: ProducerConfig{Acks: AcksLocal, Idempotent: true},
+			err:    "idempotent producers require all acks",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestProducerFromHost(t *testing.T) {
+	cfg := `
+modules:
+  events:
+    producer:
+      partitioner: manual
+`
+	broker := NewInMemBroker(2)
+	mi := createInfo(cfg)
+	mi.Name = "events"
+	m, err := newModule(mi, nil, withBroker(broker))
+	require.NoError(t, err)
+
+	p, err := ProducerFromHost(mi.Host, modules.WithName("events"))
+	require.NoError(t, err)
+	msg := &Message{Topic: "events", Partition: 1, Value: []byte("value")}
+	assert.Error(t, p.Produce(context.Background(), msg), "the producer starts with the module")
+
+	require.NoError(t, <-m.Start(make(chan struct{}, 1)))
+	require.NoError(t, p.Produce(context.Background(), msg))
+	assert.Error(t, p.Produce(context.Background(), &Message{Topic: "events", Partition: 2}))
+	assert.Nil(t, msg.Headers, "the message of the caller is not modified")
+
+	msgs := broker.Messages("events")
+	require.Len(t, msgs, 1)
+	assert.Equal(t, int32(1), msgs[0].Partition)
+	assert.Equal(t, []byte("value"), msgs[0].Value)
+
+	require.NoError(t, p.Close())
+	require.NoError(t, m.Stop())
+	assert.Error(t, p.Produce(context.Background(), msg), "the producer is closed with the module")
+}
+
+func TestProducerFromHost_Errors(t *testing.T) {
+	_, err := ProducerFromHost(service.NopHost())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no Kafka module "kafka" is registered`)
+
+	_, err = ProducerFromHost(service.NopHost(), func(*service.ModuleCreateInfo) error {
+		return errors.New("bad option")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to apply option")
+}
+
+func TestProducerClient_Tracing(t *testing.T) {
+	tracer := &injectingTracer{}
+	opentracing.InitGlobalTracer(tracer)
+	defer opentracing.InitGlobalTracer(opentracing.NoopTracer{})
+
+	broker := NewInMemBroker(1)
+	p, err := broker.NewProducer(ProducerConfig{})
+	require.NoError(t, err)
+	client := &producerClient{Producer: p}
+	defer client.Close()
+
+	require.NoError(t, client.Produce(context.Background(), &Message{Topic: "orders"}))
+	msgs := broker.Messages("orders")
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "injected", msgs[0].Headers["trace"])
+}
+
+// injectingTracer writes a fixed header for every injected span context
+type injectingTracer struct {
+	opentracing.NoopTracer
+}
+
+func (t *injectingTracer) Inject(sm opentracing.SpanContext, format interface{}, carrier interface{}) error {
+	carrier.(opentracing.TextMapCarrier).Set("trace", "injected")
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/fx/service"
+
+	"github.com/Shopify/sarama"
+	cluster "github.com/bsm/sarama-cluster"
+)
+
+// Headers require Kafka 0.11 or later
+var _kafkaVersion = sarama.V0_11_0_0
+
+// saramaBroker connects to a Kafka cluster with the sarama client
+type saramaBroker struct {
+	addrs    []string
+	clientID string
+}
+
+func newSaramaBroker(host service.Host, cfg Config) (Broker, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("no Kafka brokers are configured")
+	}
+	return &saramaBroker{
+		addrs:    cfg.Brokers,
+		clientID: host.Name(),
+	}, nil
+}
+
+func (b *saramaBroker) NewConsumer(group string, topics []string, options ConsumerOptions) (Consumer, error) {
+	config := cluster.NewConfig()
+	config.ClientID = b.clientID
+	config.Version = _kafkaVersion
+	config.Consumer.Return.Errors = true
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if options.InitialOffset == OffsetOldest {
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+	if options.CommitInterval > 0 {
+		config.Consumer.Offsets.CommitInterval = options.CommitInterval
+	}
+
+	consumer, err := cluster.NewConsumer(b.addrs, group, topics, config)
+	if err != nil {
+		return nil, err
+	}
+	c := &saramaConsumer{
+		consumer: consumer,
+		messages: make(chan *Message),
+		done:     make(chan struct{}),
+	}
+	go c.receive()
+	return c, nil
+}
+
+func (b *saramaBroker) NewProducer(cfg ProducerConfig) (Producer, error) {
+	config := saramaProducerConfig(cfg)
+	config.ClientID = b.clientID
+	producer, err := sarama.NewSyncProducer(b.addrs, config)
+	if err != nil {
+		return nil, err
+	}
+	return saramaProducer{producer}, nil
+}
+
+func (b *saramaBroker) Close() error {
+	return nil
+}
+
+func saramaProducerConfig(cfg ProducerConfig) *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = _kafkaVersion
+	// Produce waits for the result of every message
+	config.Producer.Return.Successes = true
+
+	switch cfg.Acks {
+	case AcksLocal:
+		config.Producer.RequiredAcks = sarama.WaitForLocal
+	case AcksNone:
+		config.Producer.RequiredAcks = sarama.NoResponse
+	default:
+		config.Producer.RequiredAcks = sarama.WaitForAll
+	}
+	if cfg.Idempotent {
+		config.Producer.Idempotent = true
+		// Ordering of retried batches is only guaranteed with a single request in flight
+		config.Net.MaxOpenRequests = 1
+	}
+	if cfg.MaxRetries > 0 {
+		config.Producer.Retry.Max = cfg.MaxRetries
+	}
+
+	switch cfg.Compression {
+	case CompressionGZIP:
+		config.Producer.Compression = sarama.CompressionGZIP
+	case CompressionSnappy:
+		config.Producer.Compression = sarama.CompressionSnappy
+	case CompressionLZ4:
+		config.Producer.Compression = sarama.CompressionLZ4
+	default:
+		config.Producer.Compression = sarama.CompressionNone
+	}
+
+	switch cfg.Partitioner {
+	case PartitionerRandom:
+		config.Producer.Partitioner = sarama.NewRandomPartitioner
+	case PartitionerRoundRobin:
+		config.Producer.Partitioner = sarama.NewRoundRobinPartitioner
+	case PartitionerManual:
+		config.Producer.Partitioner = sarama.NewManualPartitioner
+	default:
+		config.Producer.Partitioner = sarama.NewHashPartitioner
+	}
+
+	config.Producer.Flush.Messages = cfg.Batch.Messages
+	config.Producer.Flush.Bytes = cfg.Batch.Bytes
+	config.Producer.Flush.Frequency = cfg.Batch.Frequency
+	return config
+}
+
+type saramaConsumer struct {
+	consumer  *cluster.Consumer
+	messages  chan *Message
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *saramaConsumer) receive() {
+	defer close(c.messages)
+	for msg := range c.consumer.Messages() {
+		select {
+		case c.messages <- fromSaramaMessage(msg):
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *saramaConsumer) Messages() <-chan *Message {
+	return c.messages
+}
+
+func (c *saramaConsumer) Errors() <-chan error {
+	return c.consumer.Errors()
+}
+
+func (c *saramaConsumer) MarkOffset(msg *Message) {
+	c.consumer.MarkPartitionOffset(msg.Topic, msg.Partition, msg.Offset, "")
+}
+
+func (c *saramaConsumer) CommitOffsets() error {
+	return c.consumer.CommitOffsets()
+}
+
+func (c *saramaConsumer) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		err = c.consumer.Close()
+	})
+	return err
+}
+
+type saramaProducer struct {
+	producer sarama.SyncProducer
+}
+
+func (p saramaProducer) Produce(ctx context.Context, msg *Message) error {
+	_, _, err := p.producer.SendMessage(toSaramaMessage(msg))
+	return err
+}
+
+func (p saramaProducer) Close() error {
+	return p.producer.Close()
+}
+
+func fromSaramaMessage(msg *sarama.ConsumerMessage) *Message {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[string(h.Key)] = string(h.Value)
+	}
+	return &Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Timestamp: msg.Timestamp,
+	}
+}
+
+func toSaramaMessage(msg *Message) *sarama.ProducerMessage {
+	pm := &sarama.ProducerMessage{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Value:     sarama.ByteEncoder(msg.Value),
+	}
+	// Messages without a key are spread over the partitions
+	if msg.Key != nil {
+		pm.Key = sarama.ByteEncoder(msg.Key)
+	}
+	for k, v := range msg.Headers {
+		pm.Headers = append(pm.Headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+	return pm
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/fx/service"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaramaBroker(t *testing.T) {
+	_, err := newSaramaBroker(service.NopHost(), Config{})
+	assert.Error(t, err)
+
+	b, err := newSaramaBroker(service.NopHost(), Config{Brokers: []string{"localhost:9092"}})
+	require.NoError(t, err)
+	assert.NoError(t, b.Close())
+}
+
+func TestFromSaramaMessage(t *testing.T) {
+	now := time.Now()
+	msg := fromSaramaMessage(&sarama.ConsumerMessage{
+		Topic:     "orders",
+		Partition: 1,
+		Offset:    2,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Timestamp: now,
+		Headers:   []*sarama.RecordHeader{{Key: []byte("a"), Value: []byte("b")}},
+	})
+	assert.Equal(t, &Message{
+		Topic:     "orders",
+		Partition: 1,
+		Offset:    2,
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+		Headers:   map[string]string{"a": "b"},
+		Timestamp: now,
+	}, msg)
+}
+
+func TestToSaramaMessage(t *testing.T) {
+	pm := toSaramaMessage(&Message{
+		Topic:   "orders",
+		Key:     []byte("key"),
+		Value:   []byte("value"),
+		Headers: map[string]string{"a": "b"},
+	})
+	assert.Equal(t, "orders", pm.Topic)
+	assert.Equal(t, sarama.ByteEncoder("key"), pm.Key)
+	assert.Equal(t, sarama.ByteEncoder("value"), pm.Value)
+	assert.Equal(t, []sarama.RecordHeader{{Key: []byte("a"), Value: []byte("b")}}, pm.Headers)
+
+	pm = toSaramaMessage(&Message{Topic: "orders"})
+	assert.Nil(t, pm.Key, "messages without a key leave partitioning to the producer")
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/kafka/internal/stats"
+	"go.uber.org/fx/modules/task"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
+
+	errs "github.com/pkg/errors"
+)
+
+// TaskBackendConfig configures the Kafka task backend
+type TaskBackendConfig struct {
+	modules.ModuleConfig
+	Brokers  []string       `yaml:"brokers"`
+	Producer ProducerConfig `yaml:"producer"`
+	Topic    TopicConfig    `yaml:"topic"`
+}
+
+// taskBackend publishes tasks to a Kafka topic and runs the consumed tasks
+type taskBackend struct {
+	host      service.Host
+	config    TaskBackendConfig
+	group     string
+	broker    Broker
+	log       ulog.Log
+	stateMu   sync.RWMutex
+	producer  Producer
+	consumer  *topicConsumer
+	isRunning bool
+}
+
+var _ task.Backend = &taskBackend{}
+
+// NewTaskBackend returns a function that creates a task backend on top of Kafka, to be
+// passed to task.NewModule. Tasks are consumed only if the service runs one of the roles
+// configured for the task module, or if no roles are configured.
+func NewTaskBackend(options ...modules.Option) task.BackendCreateFunc {
+	return func(host service.Host) (task.Backend, error) {
+		return newTaskBackend(host, options...)
+	}
+}
+
+func newTaskBackend(host service.Host, options ...modules.Option) (*taskBackend, error) {
+	mi := service.ModuleCreateInfo{Name: "task", Host: host}
+	for _, opt := range options {
+		if err := opt(&mi); err != nil {
+			return nil, errs.Wrap(err, "unable to apply option to Kafka task backend")
+		}
+	}
+
+	stats.SetupKafkaMetrics(host.Metrics())
+
+	var cfg TaskBackendConfig
+	key := getConfigKey(mi.Name)
+	if err := host.Config().Get(key).PopulateStruct(&cfg); err != nil {
+		return nil, errs.Wrap(err, "unable to load Kafka task backend configuration")
+	}
+	// Embedded structs are not populated with the fields of the parent key
+	if err := host.Config().Get(key).PopulateStruct(&cfg.ModuleConfig); err != nil {
+		return nil, errs.Wrap(err, "unable to load Kafka task backend roles")
+	}
+	if cfg.Topic.Name == "" {
+		cfg.Topic.Name = fmt.Sprintf("%s-tasks", host.Name())
+	}
+	if cfg.Topic.InitialOffset == "" {
+		// Tasks published before the first worker joins the group must not be skipped
+		cfg.Topic.InitialOffset = OffsetOldest
+	}
+	brokerCfg := Config{
+		Brokers:  cfg.Brokers,
+		Group:    host.Name(),
+		Topics:   []TopicConfig{cfg.Topic},
+		Producer: cfg.Producer,
+	}
+	if err := validateConfig(brokerCfg); err != nil {
+		return nil, err
+	}
+
+	broker, err := brokerFromCreateInfo(mi)(host, brokerCfg)
+	if err != nil {
+		return nil, errs.Wrap(err, "unable to create Kafka broker")
+	}
+	group := cfg.Topic.Group
+	if group == "" {
+		group = brokerCfg.Group
+	}
+	return &taskBackend{
+		host:   host,
+		config: cfg,
+		group:  group,
+		broker: broker,
+		log:    ulog.Logger().With("moduleName", mi.Name),
+	}, nil
+}
+
+// Name implements the Module interface
+func (b *taskBackend) Name() string {
+	return "kafka"
+}
+
+// Encoder implements the Backend interface
+func (b *taskBackend) Encoder() task.Encoding {
+	return &task.GobEncoding{}
+}
+
+// Publish implements the Backend interface
+func (b *taskBackend) Publish(ctx context.Context, message []byte) error {
+	b.stateMu.RLock()
+	producer := b.producer
+	b.stateMu.RUnlock()
+	if producer == nil {
+		return errors.New("cannot publish when the backend is not running")
+	}
+	return producer.Produce(ctx, &Message{
+		Topic: b.config.Topic.Name,
+		Value: message,
+	})
+}
+
+// Start implements the Module interface
+func (b *taskBackend) Start(ready chan<- struct{}) <-chan error {
+	ret := make(chan error, 1)
+	if b.IsRunning() {
+		ret <- errors.New("module is already running")
+		return ret
+	}
+
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+
+	producer, err := b.broker.NewProducer(b.config.Producer)
+	if err != nil {
+		ret <- errs.Wrap(err, "unable to create Kafka producer")
+		return ret
+	}
+
+	if b.isWorker() {
+		consumer, err := subscribe(b.broker, b.group, b.config.Topic)
+		if err != nil {
+			producer.Close()
+			ret <- err
+			return ret
+		}
+		handler := HandlerFunc(func(ctx context.Context, msg *Message) error {
+			return task.Run(ctx, msg.Value)
+		})
+		chain := buildChain(handler, defaultMiddleware(b.host))
+		b.consumer = newTopicConsumer(b.config.Topic, consumer, producer, chain, b.log)
+		go b.consumer.run()
+	}
+
+	b.producer = &producerClient{Producer: producer}
+	b.isRunning = true
+	b.log.Info("Kafka task backend started", "topic", b.config.Topic.Name, "worker", b.consumer != nil)
+	ready <- struct{}{}
+	ret <- nil
+	return ret
+}
+
+// isWorker returns whether the service runs one of the roles of the task module
+func (b *taskBackend) isWorker() bool {
+	if len(b.config.Roles) == 0 {
+		return true
+	}
+	for _, role := range b.host.Roles() {
+		for _, r := range b.config.Roles {
+			if role == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Stop implements the Module interface
+func (b *taskBackend) Stop() error {
+	if !b.IsRunning() {
+		return nil
+	}
+
+	b.stateMu.Lock()
+	defer b.stateMu.Unlock()
+	b.isRunning = false
+
+	var err error
+	if b.consumer != nil {
+		err = b.consumer.stop()
+		b.consumer = nil
+	}
+	if perr := b.producer.Close(); perr != nil && err == nil {
+		err = perr
+	}
+	b.producer = nil
+	if berr := b.broker.Close(); berr != nil && err == nil {
+		err = berr
+	}
+	return err
+}
+
+// IsRunning implements the Module interface
+func (b *taskBackend) IsRunning() bool {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+	return b.isRunning
+}
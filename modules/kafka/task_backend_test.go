@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/modules/task"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const _taskConfig = `
+modules:
+  task:
+    roles:
+      - worker
+    topic:
+      name: tasks
+`
+
+var _taskRuns = make(chan string, 10)
+
+func RecordTask(ctx context.Context, input string) error {
+	_taskRuns <- input
+	return nil
+}
+
+func taskHost(roles ...string) service.Host {
+	return testHost{
+		Host:   service.NopHost(),
+		config: config.NewYAMLProviderFromBytes([]byte(_taskConfig)),
+		roles:  roles,
+	}
+}
+
+func TestTaskBackend_EnqueueAndRun(t *testing.T) {
+	broker := NewInMemBroker(2)
+	mi := service.ModuleCreateInfo{Host: taskHost("worker")}
+	mods, err := task.NewModule(NewTaskBackend(withBroker(broker)))(mi)
+	require.NoError(t, err)
+	require.NoError(t, task.Register(RecordTask))
+
+	ready := make(chan struct{}, 1)
+	require.NoError(t, <-mods[0].Start(ready))
+	defer mods[0].Stop()
+
+	require.NoError(t, task.Enqueue(RecordTask, context.Background(), "hello"))
+	select {
+	case input := <-_taskRuns:
+		assert.Equal(t, "hello", input)
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for the task to run")
+	}
+	assert.Len(t, broker.Messages("tasks"), 1)
+}
+
+func TestTaskBackend_PublishOnlyRole(t *testing.T) {
+	broker := NewInMemBroker(1)
+	b, err := newTaskBackend(taskHost("api"), withBroker(broker))
+	require.NoError(t, err)
+	assert.Equal(t, "kafka", b.Name())
+	assert.NotNil(t, b.Encoder())
+	assert.Error(t, b.Publish(context.Background(), []byte("task")), "publishing requires a running backend")
+
+	ready := make(chan struct{}, 1)
+	require.NoError(t, <-b.Start(ready))
+	assert.True(t, b.IsRunning())
+	assert.Error(t, <-b.Start(ready))
+	assert.Nil(t, b.consumer, "tasks are not consumed outside of the worker role")
+
+	require.NoError(t, b.Publish(context.Background(), []byte("task")))
+	assert.Len(t, broker.Messages("tasks"), 1)
+	require.NoError(t, b.Stop())
+	assert.False(t, b.IsRunning())
+	assert.NoError(t, b.Stop())
+}
+
+func TestTaskBackend_Defaults(t *testing.T) {
+	b, err := newTaskBackend(service.NopHost(), withBroker(NewInMemBroker(1)))
+	require.NoError(t, err)
+	assert.Equal(t, "dummy-tasks", b.config.Topic.Name)
+	assert.Equal(t, OffsetOldest, b.config.Topic.InitialOffset)
+	assert.Equal(t, "dummy", b.group)
+	assert.True(t, b.isWorker(), "all services are workers when no roles are configured")
+}
+
+func TestTaskBackend_Errors(t *testing.T) {
+	_, err := NewTaskBackend()(service.NopHost())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no Kafka brokers are configured")
+
+	_, err = NewTaskBackend(func(*service.ModuleCreateInfo) error {
+		return errors.New("bad option")
+	})(service.NopHost())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to apply option")
+
+	closed := NewInMemBroker(1)
+	require.NoError(t, closed.Close())
+	b, err := newTaskBackend(taskHost("worker"), withBroker(closed))
+	require.NoError(t, err)
+	err = <-b.Start(make(chan struct{}, 1))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to create consumer")
+}
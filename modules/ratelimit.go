@@ -0,0 +1,280 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/auth"
+)
+
+const (
+	// Number of route and caller pairs that get their own token bucket, the
+	// requests of further callers share the bucket of the route
+	defaultMaxBuckets = 10000
+
+	// How often the buckets are swept for idle ones once there are too many
+	evictionInterval = time.Second
+
+	// Factor the concurrency limit shrinks by when requests are slower than the target
+	backoffRatio = 0.9
+)
+
+var (
+	// ErrRateLimited is returned when the caller went over its rate limit
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrOverloaded is returned when a request is shed because too many are in flight
+	ErrOverloaded = errors.New("server overloaded")
+)
+
+// RateLimitConfig handles config for server-side rate limiting and load shedding
+type RateLimitConfig struct {
+	// Rate is the number of requests per second allowed for every route and
+	// caller pair, zero disables rate limiting
+	Rate int `yaml:"rate"`
+	// Burst is the number of requests allowed at once, defaults to the rate
+	Burst int `yaml:"burst"`
+	// Rules override the rate of the routes and callers they match
+	Rules []RateLimitRule `yaml:"rules"`
+	// LoadShedding rejects requests while too many are in flight
+	LoadShedding LoadSheddingConfig `yaml:"loadShedding"`
+}
+
+// RateLimitRule sets the rate of matching requests, an empty route or caller
+// matches all of them. Rules are matched in order and a zero rate means no limit.
+type RateLimitRule struct {
+	Route  string `yaml:"route"`
+	Caller string `yaml:"caller"`
+	Rate   int    `yaml:"rate"`
+	Burst  int    `yaml:"burst"`
+}
+
+// LoadSheddingConfig handles config for concurrency-based load shedding
+type LoadSheddingConfig struct {
+	// MaxConcurrency is the number of requests allowed in flight, zero disables load shedding
+	MaxConcurrency int `yaml:"maxConcurrency"`
+	// MinConcurrency is the lowest the adaptive limit goes, defaults to 1
+	MinConcurrency int `yaml:"minConcurrency"`
+	// TargetLatency makes the limit adaptive: it shrinks while requests take
+	// longer than the target and grows back up to MaxConcurrency otherwise
+	TargetLatency time.Duration `yaml:"targetLatency"`
+}
+
+// Validate checks the rate limits
+func (c RateLimitConfig) Validate() error {
+	if c.Rate < 0 || c.Burst < 0 {
+		return fmt.Errorf("rate limit must not be negative: rate %d, burst %d", c.Rate, c.Burst)
+	}
+	for _, rule := range c.Rules {
+		if rule.Rate < 0 || rule.Burst < 0 {
+			return fmt.Errorf("rate limit of route %q and caller %q must not be negative", rule.Route, rule.Caller)
+		}
+	}
+	shed := c.LoadShedding
+	if shed.MaxConcurrency < 0 || shed.MinConcurrency < 0 || shed.TargetLatency < 0 {
+		return errors.New("load shedding limits must not be negative")
+	}
+	if shed.MinConcurrency > shed.MaxConcurrency && shed.MaxConcurrency > 0 {
+		return fmt.Errorf("load shedding minConcurrency %d is over maxConcurrency %d",
+			shed.MinConcurrency, shed.MaxConcurrency)
+	}
+	return nil
+}
+
+// Enabled is true if requests are rate limited or shed
+func (c RateLimitConfig) Enabled() bool {
+	return c.Rate > 0 || len(c.Rules) > 0 || c.LoadShedding.MaxConcurrency > 0
+}
+
+// A Limiter admits requests within the rate limit of their route and caller
+// and while the server is not overloaded
+type Limiter struct {
+	cfg        RateLimitConfig
+	now        func() time.Time
+	maxBuckets int
+
+	sync.Mutex
+	buckets  map[bucketKey]*tokenBucket
+	evicted  time.Time
+	inFlight int
+	limit    float64
+}
+
+type bucketKey struct {
+	route  string
+	caller string
+}
+
+// NewLimiter creates a limiter for the config
+func NewLimiter(cfg RateLimitConfig) *Limiter {
+	if cfg.LoadShedding.MinConcurrency <= 0 {
+		cfg.LoadShedding.MinConcurrency = 1
+	}
+	return &Limiter{
+		cfg:        cfg,
+		now:        time.Now,
+		maxBuckets: defaultMaxBuckets,
+		buckets:    make(map[bucketKey]*tokenBucket),
+		limit:      float64(cfg.LoadShedding.MaxConcurrency),
+	}
+}
+
+// Acquire admits a request to the route from the caller. It returns ErrOverloaded
+// or ErrRateLimited if the request is rejected, otherwise release must be called
+// once the request is served.
+func (l *Limiter) Acquire(route, caller string) (release func(), err error) {
+	l.Lock()
+	defer l.Unlock()
+	shed := l.cfg.LoadShedding.MaxConcurrency > 0
+	if shed && l.inFlight >= int(l.limit) {
+		return nil, ErrOverloaded
+	}
+	if b := l.bucket(route, caller); b != nil && !b.take(l.now()) {
+		return nil, ErrRateLimited
+	}
+	if !shed {
+		return func() {}, nil
+	}
+	l.inFlight++
+	start := l.now()
+	return func() { l.release(l.now().Sub(start)) }, nil
+}
+
+// ConcurrencyLimit is the number of requests currently allowed in flight
+func (l *Limiter) ConcurrencyLimit() int {
+	l.Lock()
+	defer l.Unlock()
+	return int(l.limit)
+}
+
+// release adjusts the concurrency limit to the latency of a finished request:
+// it grows by one for every limit's worth of fast requests and shrinks on slow ones
+func (l *Limiter) release(latency time.Duration) {
+	l.Lock()
+	defer l.Unlock()
+	l.inFlight--
+	shed := l.cfg.LoadShedding
+	if shed.TargetLatency <= 0 {
+		return
+	}
+	if latency > shed.TargetLatency {
+		l.limit *= backoffRatio
+	} else {
+		l.limit += 1 / l.limit
+	}
+	if l.limit < float64(shed.MinConcurrency) {
+		l.limit = float64(shed.MinConcurrency)
+	}
+	if l.limit > float64(shed.MaxConcurrency) {
+		l.limit = float64(shed.MaxConcurrency)
+	}
+}
+
+// bucket returns the token bucket of the route and caller, or nil if they have no limit
+func (l *Limiter) bucket(route, caller string) *tokenBucket {
+	key := bucketKey{route: route, caller: caller}
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+	rate, burst := l.cfg.Rate, l.cfg.Burst
+	for _, rule := range l.cfg.Rules {
+		if (rule.Route == "" || rule.Route == route) && (rule.Caller == "" || rule.Caller == caller) {
+			rate, burst = rule.Rate, rule.Burst
+			break
+		}
+	}
+	if rate <= 0 {
+		return nil
+	}
+	if len(l.buckets) >= l.maxBuckets {
+		l.evictIdle()
+	}
+	if len(l.buckets) >= l.maxBuckets {
+		key.caller = ""
+		if b, ok := l.buckets[key]; ok {
+			return b
+		}
+	}
+	if burst <= 0 {
+		burst = rate
+	}
+	b := &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   l.now(),
+	}
+	l.buckets[key] = b
+	return b
+}
+
+// evictIdle drops the buckets that refilled up to their burst, since a new bucket
+// admits the same requests. Buckets are swept at most once per eviction interval.
+func (l *Limiter) evictIdle() {
+	now := l.now()
+	if now.Sub(l.evicted) < evictionInterval {
+		return
+	}
+	l.evicted = now
+	for key, b := range l.buckets {
+		if b.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimitCaller returns the caller a request is rate limited as: the caller authorized
+// by auth.Authorize. Callers are not trusted to name themselves, so requests without an
+// authorized caller share the bucket of their route.
+func RateLimitCaller(ctx context.Context) string {
+	caller, _ := auth.CallerFromContext(ctx)
+	return caller
+}
+
+// tokenBucket refills at rate tokens per second up to burst tokens
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// full is true if the bucket refilled up to its burst by now
+func (b *tokenBucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
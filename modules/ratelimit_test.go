@@ -0,0 +1,177 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func newTestLimiter(cfg RateLimitConfig) (*Limiter, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := NewLimiter(cfg)
+	l.now = clock.now
+	return l, clock
+}
+
+func TestLimiter_RateLimit(t *testing.T) {
+	l, clock := newTestLimiter(RateLimitConfig{Rate: 2})
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire("/users", "alice")
+		require.NoError(t, err)
+		release()
+	}
+	_, err := l.Acquire("/users", "alice")
+	assert.Equal(t, ErrRateLimited, err)
+
+	_, err = l.Acquire("/users", "bob")
+	assert.NoError(t, err, "Callers should have their own buckets")
+	_, err = l.Acquire("/orders", "alice")
+	assert.NoError(t, err, "Routes should have their own buckets")
+
+	clock.t = clock.t.Add(500 * time.Millisecond)
+	_, err = l.Acquire("/users", "alice")
+	assert.NoError(t, err, "Bucket should refill over time")
+	_, err = l.Acquire("/users", "alice")
+	assert.Equal(t, ErrRateLimited, err)
+}
+
+func TestLimiter_Rules(t *testing.T) {
+	l, _ := newTestLimiter(RateLimitConfig{
+		Rate: 1,
+		Rules: []RateLimitRule{
+			{Caller: "batch", Rate: 3},
+			{Route: "/health"},
+		},
+	})
+	for i := 0; i < 3; i++ {
+		_, err := l.Acquire("/users", "batch")
+		require.NoError(t, err)
+	}
+	_, err := l.Acquire("/users", "batch")
+	assert.Equal(t, ErrRateLimited, err)
+
+	for i := 0; i < 10; i++ {
+		_, err := l.Acquire("/health", "alice")
+		require.NoError(t, err, "Zero rate rule should not limit")
+	}
+}
+
+func TestLimiter_MaxBuckets(t *testing.T) {
+	l, clock := newTestLimiter(RateLimitConfig{Rate: 1})
+	l.maxBuckets = 1
+	_, err := l.Acquire("/users", "alice")
+	require.NoError(t, err)
+	_, err = l.Acquire("/users", "bob")
+	require.NoError(t, err)
+	_, err = l.Acquire("/users", "carol")
+	assert.Equal(t, ErrRateLimited, err, "Callers over the limit should share a bucket")
+	assert.Len(t, l.buckets, 2)
+
+	clock.t = clock.t.Add(time.Second)
+	_, err = l.Acquire("/users", "dave")
+	require.NoError(t, err)
+	assert.Len(t, l.buckets, 1, "Buckets that refilled should be evicted")
+	_, err = l.Acquire("/users", "dave")
+	assert.Equal(t, ErrRateLimited, err, "New callers should get their own bucket once idle ones are evicted")
+}
+
+func TestLimiter_LoadShedding(t *testing.T) {
+	l, _ := newTestLimiter(RateLimitConfig{LoadShedding: LoadSheddingConfig{MaxConcurrency: 2}})
+	first, err := l.Acquire("/users", "alice")
+	require.NoError(t, err)
+	_, err = l.Acquire("/users", "alice")
+	require.NoError(t, err)
+	_, err = l.Acquire("/users", "alice")
+	assert.Equal(t, ErrOverloaded, err)
+
+	first()
+	_, err = l.Acquire("/users", "alice")
+	assert.NoError(t, err)
+}
+
+func TestLimiter_AdaptiveConcurrency(t *testing.T) {
+	l, clock := newTestLimiter(RateLimitConfig{LoadShedding: LoadSheddingConfig{
+		MaxConcurrency: 10,
+		MinConcurrency: 2,
+		TargetLatency:  100 * time.Millisecond,
+	}})
+	serve := func(latency time.Duration) {
+		release, err := l.Acquire("/users", "alice")
+		require.NoError(t, err)
+		clock.t = clock.t.Add(latency)
+		release()
+	}
+
+	serve(time.Second)
+	assert.Equal(t, 9, l.ConcurrencyLimit(), "Slow requests should shrink the limit")
+	for i := 0; i < 50; i++ {
+		serve(time.Second)
+	}
+	assert.Equal(t, 2, l.ConcurrencyLimit(), "Limit should not go under the minimum")
+
+	for i := 0; i < 100; i++ {
+		serve(time.Millisecond)
+	}
+	assert.Equal(t, 10, l.ConcurrencyLimit(), "Fast requests should grow the limit up to the maximum")
+}
+
+func TestRateLimitConfig_Validate(t *testing.T) {
+	assert.NoError(t, RateLimitConfig{}.Validate())
+	assert.NoError(t, RateLimitConfig{Rate: 10, Rules: []RateLimitRule{{Route: "/users", Rate: 1}}}.Validate())
+	assert.Error(t, RateLimitConfig{Rate: -1}.Validate())
+	assert.Error(t, RateLimitConfig{Rules: []RateLimitRule{{Burst: -1}}}.Validate())
+	assert.Error(t, RateLimitConfig{LoadShedding: LoadSheddingConfig{MaxConcurrency: -1}}.Validate())
+	assert.Error(t, RateLimitConfig{LoadShedding: LoadSheddingConfig{MaxConcurrency: 1, MinConcurrency: 2}}.Validate())
+}
+
+func TestRateLimitConfig_Enabled(t *testing.T) {
+	assert.False(t, RateLimitConfig{}.Enabled())
+	assert.True(t, RateLimitConfig{Rate: 1}.Enabled())
+	assert.True(t, RateLimitConfig{LoadShedding: LoadSheddingConfig{MaxConcurrency: 1}}.Enabled())
+}
+
+func TestRateLimitCaller(t *testing.T) {
+	ctx := context.Background()
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing"}}
+	ctx = auth.WithPeerIdentity(ctx, auth.NewPeerIdentity(cert))
+	assert.Equal(t, "", RateLimitCaller(ctx), "Requests should be limited per route until authorized")
+
+	ctx, err := auth.Authorize(ctx, auth.NopClient)
+	require.NoError(t, err)
+	assert.Equal(t, "billing", RateLimitCaller(ctx))
+}
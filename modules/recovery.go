@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"go.uber.org/fx"
+)
+
+// RecoveryPolicy is what a module does once it has reported a panic in a handler
+type RecoveryPolicy string
+
+const (
+	// RecoveryRecover fails the request with ErrInternal and keeps serving
+	RecoveryRecover RecoveryPolicy = "recover"
+	// RecoveryPropagate panics again, which leaves the panic to the transport
+	// and crashes the process unless the transport recovers it
+	RecoveryPropagate RecoveryPolicy = "propagate"
+)
+
+// ErrInternal is returned to callers in place of a recovered panic, so that
+// the panic value and other internal details are not exposed
+var ErrInternal = errors.New("internal error")
+
+// RecoveryConfig handles config for recovering panics in handlers
+type RecoveryConfig struct {
+	Policy RecoveryPolicy `yaml:"policy"`
+}
+
+// Validate checks the recovery policy
+func (c RecoveryConfig) Validate() error {
+	switch c.Policy {
+	case "", RecoveryRecover, RecoveryPropagate:
+		return nil
+	}
+	return fmt.Errorf("unknown recovery policy: %q", c.Policy)
+}
+
+// ReportPanic logs the panic value recovered from a handler with its stack trace.
+// The entry is logged at error level with the context-aware logger, so it is sent
+// to Sentry with the trace and request fields when the Sentry hook is configured.
+// It returns ErrInternal, or panics again if the policy is to propagate.
+func (c RecoveryConfig) ReportPanic(ctx context.Context, p interface{}, keyVals ...interface{}) error {
+	keyVals = append(keyVals, "panic", fmt.Sprint(p), "stack", string(debug.Stack()))
+	fx.Logger(ctx).Error("Panic recovered", keyVals...)
+	if c.Policy == RecoveryPropagate {
+		panic(p)
+	}
+	return ErrInternal
+}
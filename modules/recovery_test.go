@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
+	"go.uber.org/fx/ulog/sentry"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withSentryContext(t *testing.T, fn func(ctx context.Context, capturer *sentry.MemCapturer)) {
+	hook, err := sentry.New("")
+	require.NoError(t, err)
+	capturer := &sentry.MemCapturer{}
+	hook.Capturer = capturer
+	logger := ulog.Builder().WithSentryHook(hook).Build()
+	host := service.NopHostConfigured(auth.NopClient, logger, opentracing.NoopTracer{})
+	fn(fx.NewContext(context.Background(), host), capturer)
+}
+
+func TestReportPanic(t *testing.T) {
+	withSentryContext(t, func(ctx context.Context, capturer *sentry.MemCapturer) {
+		err := RecoveryConfig{}.ReportPanic(ctx, "boom", "url", "/users")
+		assert.Equal(t, ErrInternal, err)
+
+		require.Len(t, capturer.Packets, 1)
+		packet := capturer.Packets[0]
+		assert.Equal(t, "Panic recovered", packet.Message)
+		assert.Equal(t, "boom", packet.Extra["panic"])
+		assert.Equal(t, "/users", packet.Extra["url"])
+		assert.Contains(t, packet.Extra["stack"], "TestReportPanic")
+	})
+}
+
+func TestReportPanic_Propagate(t *testing.T) {
+	withSentryContext(t, func(ctx context.Context, capturer *sentry.MemCapturer) {
+		cfg := RecoveryConfig{Policy: RecoveryPropagate}
+		assert.Panics(t, func() { cfg.ReportPanic(ctx, "boom") })
+		assert.Len(t, capturer.Packets, 1, "Panic should be reported before it propagates")
+	})
+}
+
+func TestRecoveryConfig_Validate(t *testing.T) {
+	assert.NoError(t, RecoveryConfig{}.Validate())
+	assert.NoError(t, RecoveryConfig{Policy: RecoveryRecover}.Validate())
+	assert.NoError(t, RecoveryConfig{Policy: RecoveryPropagate}.Validate())
+	assert.Error(t, RecoveryConfig{Policy: "ignore"}.Validate())
+}
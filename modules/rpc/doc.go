@@ -60,5 +60,208 @@
 //
 // This will spin up the service.
 //
+// Dispatcher groups
+//
+// All RPC modules share one dispatcher by default. Modules configured with a
+// dispatcher name share a separate dispatcher instead, with its own inbounds,
+// outbounds and middleware, so that one process can host several services such as
+// a public and an admin API. The dispatcher and its TChannel transports advertise
+// the advertiseName of its modules, or else the service name.
+//
+//   modules:
+//     public:
+//       dispatcher: public
+//       advertiseName: trips
+//       inbounds:
+//         - tchannel:
+//             port: 4040
+//     admin:
+//       dispatcher: admin
+//       advertiseName: trips-admin
+//       inbounds:
+//         - http:
+//             port: 8081
+//
+// The default middleware of a group is configured by its first module.
+// rpc.NamedDispatcher("admin") and rpc.NamedClientConfig("admin", "users")
+// return the dispatcher and client configs of a group once one of its modules
+// started, until the group is stopped. rpc.RegisterNamedDispatcher and
+// rpc.RegisterNamedStarter override how the dispatcher of a group is created and
+// started.
+//
+//
+// Outbounds
+//
+// Services called over YARPC are declared under outbounds, with the HTTP,
+// TChannel or gRPC transport and one or more peers. Calls are spread over the peers in
+// turn, and the service called defaults to the outbound name.
+//
+//   modules:
+//     yarpc:
+//       outbounds:
+//         - name: users
+//           transport: http
+//           peers: [users-1:8080, users-2:8080]
+//           middleware: [deadline]
+//         - name: trips
+//           service: trips-service
+//           transport: tchannel
+//           peer: trips:4040
+//
+// Thriftrw-generated clients are built from the shared dispatcher once a module
+// started:
+//
+//   cc, err := rpc.ClientConfig("users")
+//   if err != nil {
+//     return err
+//   }
+//   users := usersclient.New(cc)
+//
+// The middleware listed for an outbound applies to its calls only, in order, and is
+// added to the module with rpc.WithNamedOutboundMiddleware("deadline", m).
+//
+//
+// Outbound middleware
+//
+// Calls of every outbound go through the default middleware of the shared
+// dispatcher, and the transport starts their client span. Calls made without a
+// deadline get the outboundTimeout, the service authenticates
+// with the auth client, and calls are counted by the calls, success and
+// failures counters and timed by the latency timer, tagged with the service,
+// procedure and encoding. Unary calls to the idempotent procedures listed under
+// outboundRetry are retried when they fail, with a jittered exponential backoff
+// that stops at the deadline, and circuit breaking comes last.
+//
+//   modules:
+//     yarpc:
+//       outboundTimeout: 500ms
+//       outboundRetry:
+//         procedures: [getUser, listTrips]
+//         maxAttempts: 3
+//         initialBackoff: 50ms
+//         maxBackoff: 1s
+//
+// Custom middleware for all outbounds is added with rpc.WithOutboundMiddleware
+// and rpc.WithOnewayOutboundMiddleware, like inbound middleware, and runs before
+// the default middleware.
+//
+//
+// Panic recovery
+//
+// A panic in a handler is recovered and returned to the caller as an internal error. The
+// panic value and the stack are logged at error level together with the caller, service and
+// procedure, which also reports them to Sentry when the Sentry hook is configured, and the
+// panic counter is incremented.
+//
+//   modules:
+//     yarpc:
+//       recovery:
+//         policy: propagate
+//
+// The recover policy is the default. With propagate the panic is reported and then
+// re-raised. All RPC modules share one dispatcher, so the policy of the first module applies.
+//
+//
+// Rate limiting and load shedding
+//
+// Requests can be rate limited with a token bucket for every procedure and caller.
+// Requests are limited once authorized, and the caller is the one the auth client
+// authorized. The caller named in the request is not trusted, so requests without an
+// authorized caller share the bucket of their procedure. Load shedding caps the number of requests in flight, adapting the cap to
+// the target latency when one is set. Both are configured like in the HTTP module, and
+// the rate limits of the first module apply to the shared dispatcher.
+//
+//   modules:
+//     yarpc:
+//       rateLimit:
+//         rate: 100
+//         rules:
+//           - caller: batch-jobs
+//             rate: 10
+//         loadShedding:
+//           maxConcurrency: 500
+//           targetLatency: 200ms
+//
+// Rejected requests fail with a ResourceExhausted status and are counted by the
+// rejected counter, tagged with the ratelimit or loadshedding middleware.
+//
+//
+// Circuit breaking
+//
+// Outbound calls go through a circuit breaker for every service and procedure
+// called. The circuit opens once failurePercent of the calls within the window
+// failed or took longer than slowCallDuration, and calls then fail fast with a
+// *modules.CircuitOpenError until openTimeout passes. Trial calls are then let
+// through, and the circuit closes again once halfOpenRequests of them succeed.
+//
+//   modules:
+//     yarpc:
+//       circuitBreaker:
+//         failurePercent: 50
+//         minRequests: 20
+//         window: 10s
+//         slowCallDuration: 1s
+//         openTimeout: 5s
+//
+// State changes are logged and reported by the circuitbreaker.state gauge and the
+// circuitbreaker.transition counter, and rejected calls by the rejected counter,
+// all tagged with the circuitbreaker middleware and the destination.
+//
+//
+// gRPC
+//
+// Procedures are served over gRPC by a grpc inbound, and services are called over
+// gRPC by outbounds with the grpc transport, which support unary calls only.
+//
+//   modules:
+//     yarpc:
+//       inbounds:
+//         - grpc:
+//             port: 5050
+//       outbounds:
+//         - name: maps
+//           transport: grpc
+//           peer: maps:5050
+//
+// The default middleware is set on the shared dispatcher, so Protobuf procedures
+// built by protoc-gen-yarpc-go and returned from the module's create function get
+// the same context, auth and metrics middleware as Thrift procedures.
+//
+//
+// Metrics and error classification
+//
+// Every call served is counted by the calls counter and its duration is recorded by the
+// latency histogram, in exponential buckets from 1ms to about 30s, tagged with the
+// procedure, the caller and the encoding. The first 100 callers are tagged by name and
+// further callers as other. Calls that fail authorization return an InvalidArgument
+// status. Calls that succeed are counted
+// by success, and calls that fail by failures, which is also tagged with the fault,
+// client or server, and the kind of error:
+//
+//   Error                        Fault   Kind
+//   modules.ErrRateLimited       client  ratelimited
+//   modules.ErrOverloaded        server  overloaded
+//   recovered panic              server  panic
+//   context.DeadlineExceeded     server  timeout
+//   context.Canceled             client  canceled
+//   failed authorization         client  auth
+//   rpc.NewClientError(kind, _)  client  kind
+//   YARPC error, client code     client  code
+//   YARPC error, other code      server  code
+//   any other error              server  handler
+//
+// The client codes are InvalidArgument, NotFound, AlreadyExists, PermissionDenied,
+// Unauthenticated, FailedPrecondition and OutOfRange, and the kind is the name of
+// the code, such as not-found. Cancelled and DeadlineExceeded errors are reported
+// like their context errors.
+//
+// Handlers return rpc.NewClientError for invalid requests, so that they are not
+// reported as failures of the server. rpc.ClassifyError tells how an error is
+// reported. Every call is also logged at info level when request logging is on:
+//
+//   modules:
+//     yarpc:
+//       requestLogging: true
+//
 //
 package rpc
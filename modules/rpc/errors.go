@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
+
+	errs "github.com/pkg/errors"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+// Fault tells whether the caller or the server caused a call to fail
+type Fault string
+
+const (
+	// ClientFault is a call that failed because of the caller, such as a bad request
+	ClientFault Fault = "client"
+	// ServerFault is a call that failed because of the server
+	ServerFault Fault = "server"
+)
+
+// Rate limited and shed requests fail with a ResourceExhausted status, and
+// requests that fail authorization with an InvalidArgument status
+var (
+	errRateLimited  = yarpcerrors.Newf(yarpcerrors.CodeResourceExhausted, "%v", modules.ErrRateLimited)
+	errOverloaded   = yarpcerrors.Newf(yarpcerrors.CodeResourceExhausted, "%v", modules.ErrOverloaded)
+	errUnauthorized = yarpcerrors.Newf(yarpcerrors.CodeInvalidArgument, "%v", auth.ErrAuthorization)
+)
+
+// clientError marks an error as caused by the caller
+type clientError struct {
+	kind string
+	err  error
+}
+
+func (e *clientError) Error() string {
+	return e.err.Error()
+}
+
+// NewClientError marks the error as caused by the caller, with the kind of error it is
+// reported as, such as validation. Handlers return it for requests that are invalid.
+func NewClientError(kind string, err error) error {
+	return &clientError{kind: kind, err: err}
+}
+
+// ClassifyError returns who caused the error of a failed call, and the kind of
+// error it is reported as. YARPC errors are classified by their status code.
+func ClassifyError(err error) (Fault, string) {
+	switch cause := errs.Cause(err); cause {
+	case modules.ErrRateLimited, errRateLimited:
+		return ClientFault, "ratelimited"
+	case modules.ErrOverloaded, errOverloaded:
+		return ServerFault, "overloaded"
+	case errUnauthorized:
+		return ClientFault, "auth"
+	case modules.ErrInternal:
+		return ServerFault, "panic"
+	case context.DeadlineExceeded:
+		return ServerFault, "timeout"
+	case context.Canceled:
+		return ClientFault, "canceled"
+	default:
+		if ce, ok := cause.(*clientError); ok {
+			return ClientFault, ce.kind
+		}
+		return classifyCode(yarpcerrors.FromError(cause).Code())
+	}
+}
+
+// classifyCode returns who caused an error with the status code, the codes of
+// requests that are invalid, unauthorized or conflict with the state of the
+// server are the fault of the caller
+func classifyCode(code yarpcerrors.Code) (Fault, string) {
+	switch code {
+	case yarpcerrors.CodeUnknown:
+		return ServerFault, "handler"
+	case yarpcerrors.CodeCancelled:
+		return ClientFault, "canceled"
+	case yarpcerrors.CodeDeadlineExceeded:
+		return ServerFault, "timeout"
+	case yarpcerrors.CodeInvalidArgument,
+		yarpcerrors.CodeNotFound,
+		yarpcerrors.CodeAlreadyExists,
+		yarpcerrors.CodePermissionDenied,
+		yarpcerrors.CodeUnauthenticated,
+		yarpcerrors.CodeFailedPrecondition,
+		yarpcerrors.CodeOutOfRange:
+		return ClientFault, code.String()
+	default:
+		return ServerFault, code.String()
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/fx/modules"
+
+	errs "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err   error
+		fault Fault
+		kind  string
+	}{
+		{modules.ErrRateLimited, ClientFault, "ratelimited"},
+		{modules.ErrOverloaded, ServerFault, "overloaded"},
+		{errRateLimited, ClientFault, "ratelimited"},
+		{errOverloaded, ServerFault, "overloaded"},
+		{errUnauthorized, ClientFault, "auth"},
+		{modules.ErrInternal, ServerFault, "panic"},
+		{context.DeadlineExceeded, ServerFault, "timeout"},
+		{context.Canceled, ClientFault, "canceled"},
+		{NewClientError("validation", errors.New("missing name")), ClientFault, "validation"},
+		{errs.Wrap(NewClientError("auth", errors.New("denied")), "wrapped"), ClientFault, "auth"},
+		{errors.New("boom"), ServerFault, "handler"},
+	}
+	for _, tt := range tests {
+		fault, kind := ClassifyError(tt.err)
+		assert.Equal(t, tt.fault, fault, tt.err.Error())
+		assert.Equal(t, tt.kind, kind, tt.err.Error())
+	}
+}
+
+func TestClassifyError_Codes(t *testing.T) {
+	tests := []struct {
+		code  yarpcerrors.Code
+		fault Fault
+		kind  string
+	}{
+		{yarpcerrors.CodeInvalidArgument, ClientFault, "invalid-argument"},
+		{yarpcerrors.CodeNotFound, ClientFault, "not-found"},
+		{yarpcerrors.CodeAlreadyExists, ClientFault, "already-exists"},
+		{yarpcerrors.CodePermissionDenied, ClientFault, "permission-denied"},
+		{yarpcerrors.CodeUnauthenticated, ClientFault, "unauthenticated"},
+		{yarpcerrors.CodeFailedPrecondition, ClientFault, "failed-precondition"},
+		{yarpcerrors.CodeOutOfRange, ClientFault, "out-of-range"},
+		{yarpcerrors.CodeCancelled, ClientFault, "canceled"},
+		{yarpcerrors.CodeDeadlineExceeded, ServerFault, "timeout"},
+		{yarpcerrors.CodeUnknown, ServerFault, "handler"},
+		{yarpcerrors.CodeResourceExhausted, ServerFault, "resource-exhausted"},
+		{yarpcerrors.CodeAborted, ServerFault, "aborted"},
+		{yarpcerrors.CodeUnimplemented, ServerFault, "unimplemented"},
+		{yarpcerrors.CodeInternal, ServerFault, "internal"},
+		{yarpcerrors.CodeUnavailable, ServerFault, "unavailable"},
+		{yarpcerrors.CodeDataLoss, ServerFault, "data-loss"},
+	}
+	for _, tt := range tests {
+		err := yarpcerrors.Newf(tt.code, "failed")
+		fault, kind := ClassifyError(err)
+		assert.Equal(t, tt.fault, fault, tt.code.String())
+		assert.Equal(t, tt.kind, kind, tt.code.String())
+
+		fault, kind = ClassifyError(errs.Wrap(err, "wrapped"))
+		assert.Equal(t, tt.fault, fault, "Wrapped errors should be classified by their cause")
+		assert.Equal(t, tt.kind, kind)
+	}
+}
+
+func TestNewClientError(t *testing.T) {
+	err := NewClientError("validation", errors.New("missing name"))
+	assert.EqualError(t, err, "missing name")
+}
@@ -22,25 +22,30 @@ package rpc
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"go.uber.org/fx"
 	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/modules/rpc/internal/stats"
 	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
 	"go.uber.org/yarpc/api/transport"
 )
 
+const (
+	// Number of callers that get their own metrics, the calls of further
+	// callers are tagged as other
+	defaultMaxCallers = 100
+	otherCaller       = "other"
+)
+
 type contextInboundMiddleware struct {
 	service.Host
 }
 
 func (f contextInboundMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, handler transport.UnaryHandler) error {
-	stopwatch := stats.RPCHandleTimer.
-		Tagged(map[string]string{stats.TagProcedure: req.Procedure}).
-		Timer(req.Procedure).
-		Start()
-	defer stopwatch.Stop()
-
 	ctx = fx.NewContext(ctx, f.Host)
 	return handler.Handle(ctx, req, resw)
 }
@@ -54,6 +59,238 @@ func (f contextOnewayInboundMiddleware) HandleOneway(ctx context.Context, req *t
 	return handler.HandleOneway(ctx, req)
 }
 
+// observabilityInboundMiddleware reports the calls, successes, failures and latency of
+// every procedure, caller and encoding, and logs calls if request logging is on
+type observabilityInboundMiddleware struct {
+	logRequests bool
+	callers     *callerTags
+}
+
+func (m observabilityInboundMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, handler transport.UnaryHandler) error {
+	start := time.Now()
+	err := handler.Handle(ctx, req, resw)
+	observe(ctx, req, m.callers, m.logRequests, time.Since(start), err)
+	return err
+}
+
+type observabilityOnewayInboundMiddleware struct {
+	logRequests bool
+	callers     *callerTags
+}
+
+func (m observabilityOnewayInboundMiddleware) HandleOneway(ctx context.Context, req *transport.Request, handler transport.OnewayHandler) error {
+	start := time.Now()
+	err := handler.HandleOneway(ctx, req)
+	observe(ctx, req, m.callers, m.logRequests, time.Since(start), err)
+	return err
+}
+
+// callerTags caps the number of callers that metrics are tagged with, since
+// callers name themselves in requests
+type callerTags struct {
+	max int
+
+	sync.RWMutex
+	callers map[string]bool
+}
+
+func newCallerTags() *callerTags {
+	return &callerTags{
+		max:     defaultMaxCallers,
+		callers: make(map[string]bool),
+	}
+}
+
+// tag returns the caller, or other once the maximum number of callers is tagged
+func (c *callerTags) tag(caller string) string {
+	c.RLock()
+	known := c.callers[caller]
+	c.RUnlock()
+	if known {
+		return caller
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if c.callers[caller] {
+		return caller
+	}
+	if len(c.callers) >= c.max {
+		return otherCaller
+	}
+	c.callers[caller] = true
+	return caller
+}
+
+func observe(ctx context.Context, req *transport.Request, callers *callerTags, logRequests bool, latency time.Duration, err error) {
+	scope := stats.RPCCallScope.Tagged(map[string]string{
+		stats.TagProcedure: req.Procedure,
+		stats.TagCaller:    callers.tag(req.Caller),
+		stats.TagEncoding:  string(req.Encoding),
+	})
+	scope.Counter("calls").Inc(1)
+	scope.Histogram("latency", stats.LatencyBuckets).RecordDuration(latency)
+
+	var fault Fault
+	var kind string
+	if err == nil {
+		scope.Counter("success").Inc(1)
+	} else {
+		fault, kind = ClassifyError(err)
+		scope.Tagged(map[string]string{
+			stats.TagFault: string(fault),
+			stats.TagError: kind,
+		}).Counter("failures").Inc(1)
+	}
+
+	if !logRequests {
+		return
+	}
+	keyVals := []interface{}{
+		"procedure", req.Procedure,
+		"caller", req.Caller,
+		"encoding", string(req.Encoding),
+		"latency", latency.String(),
+	}
+	if err == nil {
+		fx.Logger(ctx).Info("RPC call served", keyVals...)
+		return
+	}
+	keyVals = append(keyVals, "fault", string(fault), "errorType", kind, "error", err.Error())
+	fx.Logger(ctx).Info("RPC call failed", keyVals...)
+}
+
+type panicInboundMiddleware struct {
+	recovery modules.RecoveryConfig
+}
+
+func (p panicInboundMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, handler transport.UnaryHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = reportPanic(ctx, p.recovery, req, r)
+		}
+	}()
+	return handler.Handle(ctx, req, resw)
+}
+
+type panicOnewayInboundMiddleware struct {
+	recovery modules.RecoveryConfig
+}
+
+func (p panicOnewayInboundMiddleware) HandleOneway(ctx context.Context, req *transport.Request, handler transport.OnewayHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = reportPanic(ctx, p.recovery, req, r)
+		}
+	}()
+	return handler.HandleOneway(ctx, req)
+}
+
+func reportPanic(ctx context.Context, recovery modules.RecoveryConfig, req *transport.Request, p interface{}) error {
+	stats.RPCPanicCounter.Inc(1)
+	return recovery.ReportPanic(ctx, p,
+		"caller", req.Caller,
+		"service", req.Service,
+		"procedure", req.Procedure,
+	)
+}
+
+type rateLimitInboundMiddleware struct {
+	limiter *modules.Limiter
+}
+
+func (m rateLimitInboundMiddleware) Handle(ctx context.Context, req *transport.Request, resw transport.ResponseWriter, handler transport.UnaryHandler) error {
+	release, err := acquire(ctx, m.limiter, req)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler.Handle(ctx, req, resw)
+}
+
+type rateLimitOnewayInboundMiddleware struct {
+	limiter *modules.Limiter
+}
+
+func (m rateLimitOnewayInboundMiddleware) HandleOneway(ctx context.Context, req *transport.Request, handler transport.OnewayHandler) error {
+	release, err := acquire(ctx, m.limiter, req)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler.HandleOneway(ctx, req)
+}
+
+// acquire admits the request within the rate limit of its procedure and authorized caller.
+// The caller named in the request is not trusted, so it does not pick the bucket.
+func acquire(ctx context.Context, limiter *modules.Limiter, req *transport.Request) (func(), error) {
+	release, err := limiter.Acquire(req.Procedure, modules.RateLimitCaller(ctx))
+	stats.RPCConcurrencyLimitGauge.Update(float64(limiter.ConcurrencyLimit()))
+	switch err {
+	case nil:
+		return release, nil
+	case modules.ErrOverloaded:
+		stats.RPCLoadShedCounter.Inc(1)
+		return nil, errOverloaded
+	default:
+		stats.RPCRateLimitCounter.Inc(1)
+		return nil, errRateLimited
+	}
+}
+
+type circuitBreakerOutboundMiddleware struct {
+	breakers *modules.CircuitBreakers
+}
+
+func (m circuitBreakerOutboundMiddleware) Call(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	done, err := acquireCircuit(m.breakers, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := out.Call(ctx, req)
+	done(err != nil)
+	return resp, err
+}
+
+type circuitBreakerOnewayOutboundMiddleware struct {
+	breakers *modules.CircuitBreakers
+}
+
+func (m circuitBreakerOnewayOutboundMiddleware) CallOneway(ctx context.Context, req *transport.Request, out transport.OnewayOutbound) (transport.Ack, error) {
+	done, err := acquireCircuit(m.breakers, req)
+	if err != nil {
+		return nil, err
+	}
+	ack, err := out.CallOneway(ctx, req)
+	done(err != nil)
+	return ack, err
+}
+
+// acquireCircuit lets the call through unless the circuit of its procedure is open
+func acquireCircuit(breakers *modules.CircuitBreakers, req *transport.Request) (func(failed bool), error) {
+	destination := circuitDestination(req)
+	done, err := breakers.Acquire(destination)
+	if err != nil {
+		stats.RPCCircuitBreakerScope.Tagged(map[string]string{"destination": destination}).Counter("rejected").Inc(1)
+		return nil, err
+	}
+	return done, nil
+}
+
+// circuitDestination is the key calls are tracked under: the service and procedure called
+func circuitDestination(req *transport.Request) string {
+	return req.Service + "/" + req.Procedure
+}
+
+// newCircuitBreakers creates the breakers of outbound calls, which log and report state changes
+func newCircuitBreakers(cfg modules.CircuitBreakerConfig) *modules.CircuitBreakers {
+	return modules.NewCircuitBreakers(cfg, func(destination string, from, to modules.CircuitState) {
+		ulog.Logger().Warn("Circuit breaker state changed",
+			"destination", destination, "from", from.String(), "to", to.String())
+		modules.ReportCircuitStateChange(stats.RPCCircuitBreakerScope, destination, to)
+	})
+}
+
 type authInboundMiddleware struct {
 	service.Host
 }
@@ -79,12 +316,11 @@ func (a authOnewayInboundMiddleware) HandleOneway(ctx context.Context, req *tran
 }
 
 func authorize(ctx context.Context, host service.Host) (context.Context, error) {
-	if err := host.AuthClient().Authorize(ctx); err != nil {
+	ctx, err := auth.Authorize(ctx, host.AuthClient())
+	if err != nil {
 		stats.RPCAuthFailCounter.Inc(1)
 		fx.Logger(ctx).Error(auth.ErrAuthorization, "error", err)
-		// TODO(anup): GFM-255 update returned error to transport.BadRequestError (user error than server error)
-		// https://github.com/yarpc/yarpc-go/issues/687
-		return nil, err
+		return nil, errUnauthorized
 	}
 	return ctx, nil
 }
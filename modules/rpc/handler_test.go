@@ -22,15 +22,22 @@ package rpc
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"testing"
 
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/modules/rpc/internal/stats"
 	"go.uber.org/fx/service"
 	"go.uber.org/thriftrw/wire"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
 )
 
 type fakeEnveloper struct {
@@ -79,7 +86,8 @@ func TestInboundMiddleware_authFailure(t *testing.T) {
 		Host: service.NopHostAuthFailure(),
 	}
 	err := unary.Handle(context.Background(), &transport.Request{}, nil, &fakeUnaryHandler{t: t})
-	assert.EqualError(t, err, "Error authorizing the service")
+	assert.True(t, yarpcerrors.IsInvalidArgument(err))
+	assert.Equal(t, auth.ErrAuthorization, yarpcerrors.ErrorMessage(err))
 
 }
 
@@ -96,7 +104,231 @@ func TestOnewayInboundMiddleware_authFailure(t *testing.T) {
 		Host: service.NopHostAuthFailure(),
 	}
 	err := oneway.HandleOneway(context.Background(), &transport.Request{}, &fakeOnewayHandler{t: t})
-	assert.EqualError(t, err, "Error authorizing the service")
+	assert.True(t, yarpcerrors.IsInvalidArgument(err))
+}
+
+func TestInboundMiddleware_observability(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupRPCMetrics(scope)
+	defer stats.SetupRPCMetrics(service.NopHost().Metrics())
+
+	unary := observabilityInboundMiddleware{logRequests: true, callers: newCallerTags()}
+	req := &transport.Request{Caller: "alice", Procedure: "hello", Encoding: "json"}
+	err := unary.Handle(context.Background(), req, nil, &fakeUnaryHandler{t: t})
+	assert.EqualError(t, err, "handle")
+	err = unary.Handle(context.Background(), req, nil, okUnaryHandler{})
+	assert.NoError(t, err)
+
+	snapshot := scope.Snapshot()
+	var latencies int64
+	for _, h := range snapshot.Histograms() {
+		if h.Name() == "latency" {
+			for _, n := range h.Durations() {
+				latencies += n
+			}
+		}
+	}
+	assert.Equal(t, int64(2), latencies, "Latency should be reported by a histogram")
+	assert.Empty(t, snapshot.Timers())
+
+	counters := snapshot.Counters()
+	assert.Equal(t, int64(2), counterValue(counters, "calls", map[string]string{"procedure": "hello", "caller": "alice", "encoding": "json"}))
+	assert.Equal(t, int64(1), counterValue(counters, "success", map[string]string{"procedure": "hello"}))
+	assert.Equal(t, int64(1), counterValue(counters, "failures", map[string]string{"fault": "server", "error": "handler"}))
+}
+
+func TestInboundMiddleware_observabilityCallerCap(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupRPCMetrics(scope)
+	defer stats.SetupRPCMetrics(service.NopHost().Metrics())
+
+	callers := newCallerTags()
+	callers.max = 1
+	unary := observabilityInboundMiddleware{callers: callers}
+	for _, caller := range []string{"alice", "bob", "carol", "alice"} {
+		req := &transport.Request{Caller: caller, Procedure: "hello"}
+		assert.NoError(t, unary.Handle(context.Background(), req, nil, okUnaryHandler{}))
+	}
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counterValue(counters, "calls", map[string]string{"caller": "alice"}))
+	assert.Equal(t, int64(2), counterValue(counters, "calls", map[string]string{"caller": "other"}),
+		"Callers over the limit should share a tag")
+	assert.Equal(t, int64(0), counterValue(counters, "calls", map[string]string{"caller": "bob"}))
+}
+
+func TestOnewayInboundMiddleware_observability(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupRPCMetrics(scope)
+	defer stats.SetupRPCMetrics(service.NopHost().Metrics())
+
+	oneway := observabilityOnewayInboundMiddleware{callers: newCallerTags()}
+	req := &transport.Request{Caller: "alice", Procedure: "hello"}
+	handler := rateLimitOnewayInboundMiddleware{modules.NewLimiter(modules.RateLimitConfig{Rate: 1})}
+	for i := 0; i < 2; i++ {
+		oneway.HandleOneway(context.Background(), req, onewayChain{handler, &fakeOnewayHandler{t: t}})
+	}
+
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counterValue(counters, "calls", map[string]string{"procedure": "hello"}))
+	assert.Equal(t, int64(1), counterValue(counters, "failures", map[string]string{"fault": "server", "error": "handler"}))
+	assert.Equal(t, int64(1), counterValue(counters, "failures", map[string]string{"fault": "client", "error": "ratelimited"}))
+}
+
+func TestInboundMiddleware_authFailureIsClientFault(t *testing.T) {
+	unary := authInboundMiddleware{
+		Host: service.NopHostAuthFailure(),
+	}
+	err := unary.Handle(context.Background(), &transport.Request{}, nil, &fakeUnaryHandler{t: t})
+	fault, kind := ClassifyError(err)
+	assert.Equal(t, ClientFault, fault)
+	assert.Equal(t, "auth", kind)
+}
+
+// counterValue sums the counters with the name that have all the tags
+func counterValue(counters map[string]tally.CounterSnapshot, name string, tags map[string]string) int64 {
+	var sum int64
+	for _, c := range counters {
+		if c.Name() != name {
+			continue
+		}
+		matched := true
+		for k, v := range tags {
+			if c.Tags()[k] != v {
+				matched = false
+			}
+		}
+		if matched {
+			sum += c.Value()
+		}
+	}
+	return sum
+}
+
+type okUnaryHandler struct{}
+
+func (okUnaryHandler) Handle(context.Context, *transport.Request, transport.ResponseWriter) error {
+	return nil
+}
+
+type onewayChain struct {
+	middleware rateLimitOnewayInboundMiddleware
+	handler    transport.OnewayHandler
+}
+
+func (c onewayChain) HandleOneway(ctx context.Context, req *transport.Request) error {
+	return c.middleware.HandleOneway(ctx, req, c.handler)
+}
+
+func TestInboundMiddleware_panic(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	unary := panicInboundMiddleware{}
+	err := unary.Handle(context.Background(), &transport.Request{Procedure: "hello"}, nil, panicUnaryHandler{})
+	assert.Equal(t, modules.ErrInternal, err)
+
+	err = unary.Handle(context.Background(), &transport.Request{}, nil, &fakeUnaryHandler{t: t})
+	assert.EqualError(t, err, "handle")
+
+	unary.recovery.Policy = modules.RecoveryPropagate
+	assert.Panics(t, func() {
+		unary.Handle(context.Background(), &transport.Request{}, nil, panicUnaryHandler{})
+	})
+}
+
+func TestOnewayInboundMiddleware_panic(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	oneway := panicOnewayInboundMiddleware{}
+	err := oneway.HandleOneway(context.Background(), &transport.Request{Procedure: "hello"}, panicOnewayHandler{})
+	assert.Equal(t, modules.ErrInternal, err)
+
+	oneway.recovery.Policy = modules.RecoveryPropagate
+	assert.Panics(t, func() {
+		oneway.HandleOneway(context.Background(), &transport.Request{}, panicOnewayHandler{})
+	})
+}
+
+func TestInboundMiddleware_rateLimit(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	unary := rateLimitInboundMiddleware{modules.NewLimiter(modules.RateLimitConfig{Rate: 1})}
+	req := &transport.Request{Caller: "alice", Procedure: "hello"}
+	err := unary.Handle(context.Background(), req, nil, &fakeUnaryHandler{t: t})
+	assert.EqualError(t, err, "handle")
+
+	err = unary.Handle(context.Background(), req, nil, &fakeUnaryHandler{t: t})
+	assert.True(t, yarpcerrors.IsResourceExhausted(err))
+	fault, kind := ClassifyError(err)
+	assert.Equal(t, ClientFault, fault)
+	assert.Equal(t, "ratelimited", kind)
+
+	req = &transport.Request{Caller: "bob", Procedure: "hello"}
+	err = unary.Handle(context.Background(), req, nil, &fakeUnaryHandler{t: t})
+	assert.True(t, yarpcerrors.IsResourceExhausted(err), "Callers should not get their own bucket by naming themselves")
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}}
+	ctx, err := auth.Authorize(auth.WithPeerIdentity(context.Background(), auth.NewPeerIdentity(cert)), auth.NopClient)
+	require.NoError(t, err)
+	err = unary.Handle(ctx, req, nil, &fakeUnaryHandler{t: t})
+	assert.EqualError(t, err, "handle", "Authorized callers should be limited separately")
+}
+
+func TestOnewayInboundMiddleware_loadShedding(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	limiter := modules.NewLimiter(modules.RateLimitConfig{
+		LoadShedding: modules.LoadSheddingConfig{MaxConcurrency: 1},
+	})
+	oneway := rateLimitOnewayInboundMiddleware{limiter}
+	err := oneway.HandleOneway(context.Background(), &transport.Request{}, &fakeOnewayHandler{t: t})
+	assert.EqualError(t, err, "oneway handle", "Request should be released once handled")
+
+	release, err := limiter.Acquire("hello", "alice")
+	assert.NoError(t, err)
+	defer release()
+	err = oneway.HandleOneway(context.Background(), &transport.Request{}, &fakeOnewayHandler{t: t})
+	assert.True(t, yarpcerrors.IsResourceExhausted(err))
+	assert.Equal(t, errOverloaded, err)
+}
+
+func TestOutboundMiddleware_circuitBreaker(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	breakers := newCircuitBreakers(modules.CircuitBreakerConfig{FailurePercent: 100, MinRequests: 1})
+	unary := circuitBreakerOutboundMiddleware{breakers}
+	req := &transport.Request{Service: "users", Procedure: "get"}
+	_, err := unary.Call(context.Background(), req, fakeOutbound{})
+	assert.EqualError(t, err, "call")
+	assert.Equal(t, modules.CircuitOpen, breakers.State("users/get"))
+
+	_, err = unary.Call(context.Background(), req, fakeOutbound{})
+	assert.True(t, modules.IsCircuitOpen(err))
+
+	oneway := circuitBreakerOnewayOutboundMiddleware{breakers}
+	_, err = oneway.CallOneway(context.Background(), req, fakeOutbound{})
+	assert.True(t, modules.IsCircuitOpen(err))
+	_, err = oneway.CallOneway(context.Background(), &transport.Request{Service: "users", Procedure: "put"}, fakeOutbound{})
+	assert.EqualError(t, err, "oneway call")
+}
+
+type fakeOutbound struct {
+	transport.Outbound
+}
+
+func (fakeOutbound) Call(context.Context, *transport.Request) (*transport.Response, error) {
+	return nil, errors.New("call")
+}
+
+func (fakeOutbound) CallOneway(context.Context, *transport.Request) (transport.Ack, error) {
+	return nil, errors.New("oneway call")
+}
+
+type panicUnaryHandler struct{}
+
+func (panicUnaryHandler) Handle(context.Context, *transport.Request, transport.ResponseWriter) error {
+	panic("unary boom")
+}
+
+type panicOnewayHandler struct{}
+
+func (panicOnewayHandler) HandleOneway(context.Context, *transport.Request) error {
+	panic("oneway boom")
 }
 
 type fakeUnaryHandler struct {
@@ -20,17 +20,31 @@
 
 package stats
 
-import "github.com/uber-go/tally"
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+)
 
 const (
 	//TagModule is module tag for metrics
 	TagModule = "module"
-	// TagType is either request or response
+	// TagType is request, response or client for outbound calls
 	TagType = "type"
 	// TagProcedure is the procedure name
 	TagProcedure = "procedure"
 	//TagMiddleware is middleware type
 	TagMiddleware = "middleware"
+	// TagService is the service called
+	TagService = "service"
+	// TagCaller is the service that made the call
+	TagCaller = "caller"
+	// TagEncoding is the encoding of the call
+	TagEncoding = "encoding"
+	// TagFault is client or server, whoever caused the call to fail
+	TagFault = "fault"
+	// TagError is the kind of error the call failed with
+	TagError = "error"
 )
 
 // HTTPTags creates metrics scope with defined tags
@@ -44,13 +58,37 @@ var (
 
 	// RPCAuthFailCounter counts auth failures
 	RPCAuthFailCounter tally.Counter
-	// RPCHandleTimer is a turnaround time for rpc handler
-	RPCHandleTimer tally.Scope
+	// RPCPanicCounter counts panics recovered in rpc handlers
+	RPCPanicCounter tally.Counter
+	// RPCRateLimitCounter counts requests rejected over their rate limit
+	RPCRateLimitCounter tally.Counter
+	// RPCLoadShedCounter counts requests shed while the server is overloaded
+	RPCLoadShedCounter tally.Counter
+	// RPCConcurrencyLimitGauge is the number of requests allowed in flight
+	RPCConcurrencyLimitGauge tally.Gauge
+	// RPCCallScope reports calls, successes, failures and latency by procedure
+	RPCCallScope tally.Scope
+	// RPCOutboundScope reports outbound calls, successes, failures, retries and latency
+	RPCOutboundScope tally.Scope
+	// RPCOutboundAuthFailCounter counts outbound calls that failed to authenticate
+	RPCOutboundAuthFailCounter tally.Counter
+	// RPCCircuitBreakerScope reports the circuit breakers of outbound calls
+	RPCCircuitBreakerScope tally.Scope
+
+	// LatencyBuckets are the bounds of the latency histograms, from 1ms to about 30s
+	LatencyBuckets = tally.MustMakeExponentialDurationBuckets(time.Millisecond, 2, 16)
 )
 
 // SetupRPCMetrics allocates counters for necessary setup
 func SetupRPCMetrics(scope tally.Scope) {
 	rpcTagsScope := scope.Tagged(RPCTags)
 	RPCAuthFailCounter = rpcTagsScope.Tagged(map[string]string{TagMiddleware: "auth"}).Counter("fail")
-	RPCHandleTimer = rpcTagsScope.Tagged(RPCTags)
+	RPCPanicCounter = rpcTagsScope.Counter("panic")
+	RPCRateLimitCounter = rpcTagsScope.Tagged(map[string]string{TagMiddleware: "ratelimit"}).Counter("rejected")
+	RPCLoadShedCounter = rpcTagsScope.Tagged(map[string]string{TagMiddleware: "loadshedding"}).Counter("rejected")
+	RPCConcurrencyLimitGauge = rpcTagsScope.Gauge("concurrency.limit")
+	RPCCallScope = rpcTagsScope
+	RPCOutboundScope = scope.Tagged(map[string]string{TagModule: "rpc", TagType: "client"})
+	RPCOutboundAuthFailCounter = RPCOutboundScope.Tagged(map[string]string{TagMiddleware: "auth"}).Counter("fail")
+	RPCCircuitBreakerScope = rpcTagsScope.Tagged(map[string]string{TagMiddleware: "circuitbreaker"})
 }
@@ -0,0 +1,268 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/service"
+
+	errs "github.com/pkg/errors"
+	"go.uber.org/yarpc/api/middleware"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
+	"go.uber.org/yarpc/transport/http"
+	tch "go.uber.org/yarpc/transport/tchannel"
+)
+
+const _namedOutboundMiddlewareKey = "yarpcNamedOutboundMiddleware"
+
+// Outbound configures how to call a single service. Calls are spread over the peers in turn.
+type Outbound struct {
+	// Name is the key clients are built with, it is also the service called unless Service is set
+	Name    string
+	Service string
+	// Transport is http, tchannel or grpc
+	Transport string
+	// Peer is a single host:port, Peers lists several
+	Peer  string
+	Peers []string
+	// Middleware are the names of middleware added with WithNamedOutboundMiddleware,
+	// applied in order to the calls of this outbound only
+	Middleware []string
+}
+
+// OutboundMiddleware is unary and oneway middleware for calls to a single outbound,
+// either may be nil
+type OutboundMiddleware struct {
+	Unary  middleware.UnaryOutbound
+	Oneway middleware.OnewayOutbound
+}
+
+// WithNamedOutboundMiddleware makes the middleware available to outbounds that list its name
+func WithNamedOutboundMiddleware(name string, m OutboundMiddleware) modules.Option {
+	return func(mci *service.ModuleCreateInfo) error {
+		named := namedOutboundMiddlewareFromCreateInfo(*mci)
+		copied := make(map[string]OutboundMiddleware, len(named)+1)
+		for k, v := range named {
+			copied[k] = v
+		}
+		copied[name] = m
+		mci.Items[_namedOutboundMiddlewareKey] = copied
+		return nil
+	}
+}
+
+func namedOutboundMiddlewareFromCreateInfo(mci service.ModuleCreateInfo) map[string]OutboundMiddleware {
+	items, ok := mci.Items[_namedOutboundMiddlewareKey]
+	if !ok {
+		return nil
+	}
+
+	// Intentionally panic if programmer adds non-middleware map to the data
+	return items.(map[string]OutboundMiddleware)
+}
+
+// Iterate over all outbounds and prepare corresponding transports
+func prepareOutbounds(
+	outbounds []Outbound,
+	serviceName string,
+	named map[string]OutboundMiddleware,
+) (map[string]transport.Outbounds, error) {
+	transportsOut := make(map[string]transport.Outbounds, len(outbounds))
+	for _, out := range outbounds {
+		if out.Name == "" {
+			return nil, errs.New("outbound name is required")
+		}
+		if _, ok := transportsOut[out.Name]; ok {
+			return nil, fmt.Errorf("outbound %q is configured more than once", out.Name)
+		}
+		peers := out.Peers
+		if out.Peer != "" {
+			peers = append([]string{out.Peer}, peers...)
+		}
+		if len(peers) == 0 {
+			return nil, fmt.Errorf("outbound %q has no peers", out.Name)
+		}
+
+		var unary []transport.UnaryOutbound
+		switch out.Transport {
+		case "http":
+			t := http.NewTransport()
+			for _, peer := range peers {
+				if !strings.Contains(peer, "://") {
+					peer = "http://" + peer
+				}
+				unary = append(unary, t.NewSingleOutbound(peer))
+			}
+		case "tchannel":
+			t, err := tch.NewChannelTransport(tch.ServiceName(serviceName))
+			if err != nil {
+				return nil, errs.Wrap(err, "can't create tchannel transport")
+			}
+			for _, peer := range peers {
+				unary = append(unary, t.NewSingleOutbound(peer))
+			}
+		case "grpc":
+			t := grpc.NewTransport()
+			for _, peer := range peers {
+				unary = append(unary, t.NewSingleOutbound(peer))
+			}
+		default:
+			return nil, fmt.Errorf("outbound %q has unsupported transport %q, use http, tchannel or grpc", out.Name, out.Transport)
+		}
+
+		outbounds := transport.Outbounds{ServiceName: out.Service}
+		if outbounds.ServiceName == "" {
+			outbounds.ServiceName = out.Name
+		}
+		peered := &peerOutbound{outbounds: unary}
+		outbounds.Unary = peered
+		if peered.supportsOneway() {
+			outbounds.Oneway = peered
+		}
+		// Wrap in reverse so that the first listed middleware runs first
+		for i := len(out.Middleware) - 1; i >= 0; i-- {
+			name := out.Middleware[i]
+			m, ok := named[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown middleware %q for outbound %q", name, out.Name)
+			}
+			if m.Unary != nil {
+				outbounds.Unary = unaryOutboundWithMiddleware{outbounds.Unary, m.Unary}
+			}
+			if m.Oneway != nil && outbounds.Oneway != nil {
+				outbounds.Oneway = onewayOutboundWithMiddleware{outbounds.Oneway, m.Oneway}
+			}
+		}
+		transportsOut[out.Name] = outbounds
+	}
+	return transportsOut, nil
+}
+
+// peerOutbound sends calls to its outbounds in turn
+type peerOutbound struct {
+	outbounds []transport.UnaryOutbound
+	next      uint32
+}
+
+func (o *peerOutbound) pick() transport.UnaryOutbound {
+	n := atomic.AddUint32(&o.next, 1)
+	return o.outbounds[int(n)%len(o.outbounds)]
+}
+
+func (o *peerOutbound) supportsOneway() bool {
+	for _, out := range o.outbounds {
+		if _, ok := out.(transport.OnewayOutbound); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *peerOutbound) Call(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+	return o.pick().Call(ctx, req)
+}
+
+func (o *peerOutbound) CallOneway(ctx context.Context, req *transport.Request) (transport.Ack, error) {
+	return o.pick().(transport.OnewayOutbound).CallOneway(ctx, req)
+}
+
+func (o *peerOutbound) Start() error {
+	for _, out := range o.outbounds {
+		if err := out.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *peerOutbound) Stop() error {
+	var firstErr error
+	for _, out := range o.outbounds {
+		if err := out.Stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (o *peerOutbound) IsRunning() bool {
+	for _, out := range o.outbounds {
+		if !out.IsRunning() {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *peerOutbound) Transports() []transport.Transport {
+	var transports []transport.Transport
+	for _, out := range o.outbounds {
+		transports = append(transports, out.Transports()...)
+	}
+	return transports
+}
+
+// unaryOutboundWithMiddleware applies the middleware to the calls of the outbound
+type unaryOutboundWithMiddleware struct {
+	transport.UnaryOutbound
+	m middleware.UnaryOutbound
+}
+
+func (o unaryOutboundWithMiddleware) Call(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+	return o.m.Call(ctx, req, o.UnaryOutbound)
+}
+
+// onewayOutboundWithMiddleware applies the middleware to the oneway calls of the outbound
+type onewayOutboundWithMiddleware struct {
+	transport.OnewayOutbound
+	m middleware.OnewayOutbound
+}
+
+func (o onewayOutboundWithMiddleware) CallOneway(ctx context.Context, req *transport.Request) (transport.Ack, error) {
+	return o.m.CallOneway(ctx, req, o.OnewayOutbound)
+}
+
+// ClientConfig returns the config thriftrw-generated clients are built with, such as
+// usersclient.New(cc), for an outbound of the started default dispatcher
+func ClientConfig(outbound string) (cc transport.ClientConfig, err error) {
+	return NamedClientConfig("", outbound)
+}
+
+// NamedClientConfig returns the client config for an outbound of the started dispatcher
+// with the name
+func NamedClientConfig(dispatcher, outbound string) (cc transport.ClientConfig, err error) {
+	d := NamedDispatcher(dispatcher)
+	if d == nil {
+		return nil, errs.New("YARPC dispatcher is not started yet")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			cc, err = nil, fmt.Errorf("no outbound %q is configured", outbound)
+		}
+	}()
+	return d.ClientConfig(outbound), nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/rpc/internal/stats"
+	"go.uber.org/fx/service"
+	"go.uber.org/yarpc/api/transport"
+
+	errs "github.com/pkg/errors"
+)
+
+// Outbound call defaults
+const (
+	defaultOutboundTimeout     = time.Second
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 50 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+)
+
+// RetryConfig retries unary outbound calls to procedures that are safe to call more than once
+type RetryConfig struct {
+	// Procedures lists the idempotent procedures that are retried, "*" retries all of them.
+	// Retries are off while it is empty.
+	Procedures []string `yaml:"procedures"`
+	// MaxAttempts is the number of attempts including the first one, defaults to 3
+	MaxAttempts int `yaml:"maxAttempts"`
+	// InitialBackoff is the upper bound of the wait before the first retry, defaults to 50ms.
+	// It doubles with every retry up to MaxBackoff, and the actual wait is picked at random
+	// below the bound to spread retries out.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	// MaxBackoff caps the wait between attempts, defaults to 1s
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+}
+
+// Enabled tells whether any procedure is retried
+func (c RetryConfig) Enabled() bool {
+	return len(c.Procedures) > 0
+}
+
+// Validate checks the retry limits
+func (c RetryConfig) Validate() error {
+	if c.MaxAttempts < 0 {
+		return fmt.Errorf("retry maxAttempts %d must not be negative", c.MaxAttempts)
+	}
+	if c.InitialBackoff < 0 || c.MaxBackoff < 0 {
+		return fmt.Errorf("retry backoff must not be negative")
+	}
+	return nil
+}
+
+func (c RetryConfig) withDefaults() RetryConfig {
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = defaultRetryMaxAttempts
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = defaultRetryInitialBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = defaultRetryMaxBackoff
+	}
+	return c
+}
+
+// deadlineOutboundMiddleware sets the default timeout on calls made without a deadline
+type deadlineOutboundMiddleware struct {
+	timeout time.Duration
+}
+
+func (m deadlineOutboundMiddleware) Call(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.timeout)
+	defer cancel()
+	return out.Call(ctx, req)
+}
+
+type deadlineOnewayOutboundMiddleware struct {
+	timeout time.Duration
+}
+
+func (m deadlineOnewayOutboundMiddleware) CallOneway(ctx context.Context, req *transport.Request, out transport.OnewayOutbound) (transport.Ack, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.timeout)
+	defer cancel()
+	return out.CallOneway(ctx, req)
+}
+
+func withDefaultDeadline(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// authOutboundMiddleware authenticates the service with the auth client before every call
+type authOutboundMiddleware struct {
+	service.Host
+}
+
+func (a authOutboundMiddleware) Call(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	authCtx, err := authenticate(ctx, a.Host)
+	if err != nil {
+		return nil, err
+	}
+	return out.Call(authCtx, req)
+}
+
+type authOnewayOutboundMiddleware struct {
+	service.Host
+}
+
+func (a authOnewayOutboundMiddleware) CallOneway(ctx context.Context, req *transport.Request, out transport.OnewayOutbound) (transport.Ack, error) {
+	authCtx, err := authenticate(ctx, a.Host)
+	if err != nil {
+		return nil, err
+	}
+	return out.CallOneway(authCtx, req)
+}
+
+func authenticate(ctx context.Context, host service.Host) (context.Context, error) {
+	// The auth client needs to know what service it is to authenticate
+	authClient := host.AuthClient()
+	authCtx := authClient.SetAttribute(ctx, auth.ServiceAuth, host.Name())
+	authCtx, err := authClient.Authenticate(authCtx)
+	if err != nil {
+		stats.RPCOutboundAuthFailCounter.Inc(1)
+		fx.Logger(ctx).Error(auth.ErrAuthentication, "error", err)
+		return nil, err
+	}
+	return authCtx, nil
+}
+
+// metricsOutboundMiddleware reports the calls, successes, failures and latency of
+// every service and procedure called
+type metricsOutboundMiddleware struct{}
+
+func (metricsOutboundMiddleware) Call(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	start := time.Now()
+	resp, err := out.Call(ctx, req)
+	observeOutbound(req, time.Since(start), outboundErrorKind(resp, err))
+	return resp, err
+}
+
+type metricsOnewayOutboundMiddleware struct{}
+
+func (metricsOnewayOutboundMiddleware) CallOneway(ctx context.Context, req *transport.Request, out transport.OnewayOutbound) (transport.Ack, error) {
+	start := time.Now()
+	ack, err := out.CallOneway(ctx, req)
+	observeOutbound(req, time.Since(start), outboundErrorKind(nil, err))
+	return ack, err
+}
+
+// outboundErrorKind is the kind of error a call failed with, empty if it succeeded
+func outboundErrorKind(resp *transport.Response, err error) string {
+	if err == nil {
+		if resp != nil && resp.ApplicationError {
+			return "application"
+		}
+		return ""
+	}
+	if modules.IsCircuitOpen(err) {
+		return "circuitopen"
+	}
+	switch errs.Cause(err) {
+	case context.DeadlineExceeded:
+		return "timeout"
+	case context.Canceled:
+		return "canceled"
+	default:
+		return "transport"
+	}
+}
+
+func observeOutbound(req *transport.Request, latency time.Duration, errorKind string) {
+	scope := stats.RPCOutboundScope.Tagged(map[string]string{
+		stats.TagService:   req.Service,
+		stats.TagProcedure: req.Procedure,
+		stats.TagEncoding:  string(req.Encoding),
+	})
+	scope.Counter("calls").Inc(1)
+	scope.Timer("latency").Record(latency)
+	if errorKind == "" {
+		scope.Counter("success").Inc(1)
+		return
+	}
+	scope.Tagged(map[string]string{stats.TagError: errorKind}).Counter("failures").Inc(1)
+}
+
+// retryOutboundMiddleware retries calls to idempotent procedures that failed with an
+// error, until the attempts run out or the deadline passes. Calls rejected by an open
+// circuit breaker are not retried.
+type retryOutboundMiddleware struct {
+	cfg        RetryConfig
+	procedures map[string]bool
+}
+
+func newRetryOutboundMiddleware(cfg RetryConfig) retryOutboundMiddleware {
+	procedures := make(map[string]bool, len(cfg.Procedures))
+	for _, p := range cfg.Procedures {
+		procedures[p] = true
+	}
+	return retryOutboundMiddleware{cfg: cfg.withDefaults(), procedures: procedures}
+}
+
+func (m retryOutboundMiddleware) retryable(req *transport.Request) bool {
+	return m.procedures["*"] || m.procedures[req.Procedure]
+}
+
+func (m retryOutboundMiddleware) Call(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+	if !m.retryable(req) {
+		return out.Call(ctx, req)
+	}
+
+	// The body is read again on every attempt
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, errs.Wrap(err, "unable to read the request body")
+		}
+	}
+
+	backoff := m.cfg.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		attemptReq := *req
+		attemptReq.Body = bytes.NewReader(body)
+		resp, err := out.Call(ctx, &attemptReq)
+		if err == nil || attempt >= m.cfg.MaxAttempts || modules.IsCircuitOpen(err) {
+			return resp, err
+		}
+
+		wait := time.Duration(rand.Int63n(int64(backoff) + 1))
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		select {
+		case <-ctx.Done():
+			return resp, err
+		case <-time.After(wait):
+		}
+
+		// Only the response of the last attempt is returned
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+
+		stats.RPCOutboundScope.Tagged(map[string]string{
+			stats.TagService:   req.Service,
+			stats.TagProcedure: req.Procedure,
+		}).Counter("retries").Inc(1)
+		fx.Logger(ctx).Info("Retrying RPC call",
+			"service", req.Service, "procedure", req.Procedure, "attempt", attempt+1, "error", err)
+
+		if backoff *= 2; backoff > m.cfg.MaxBackoff {
+			backoff = m.cfg.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/rpc/internal/stats"
+	"go.uber.org/fx/service"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+// recordingOutbound records the calls made and fails the first ones with the errors
+type recordingOutbound struct {
+	transport.Outbound
+	errs   []error
+	ctxs   []context.Context
+	bodies []string
+}
+
+func (o *recordingOutbound) Call(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+	return &transport.Response{}, o.record(ctx, req)
+}
+
+func (o *recordingOutbound) CallOneway(ctx context.Context, req *transport.Request) (transport.Ack, error) {
+	return nil, o.record(ctx, req)
+}
+
+func (o *recordingOutbound) record(ctx context.Context, req *transport.Request) error {
+	o.ctxs = append(o.ctxs, ctx)
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		o.bodies = append(o.bodies, string(body))
+	}
+	if len(o.errs) >= len(o.ctxs) {
+		return o.errs[len(o.ctxs)-1]
+	}
+	return nil
+}
+
+func TestOutboundMiddleware_deadline(t *testing.T) {
+	out := &recordingOutbound{}
+	req := &transport.Request{}
+	_, err := deadlineOutboundMiddleware{time.Second}.Call(context.Background(), req, out)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = deadlineOnewayOutboundMiddleware{time.Second}.CallOneway(ctx, req, out)
+	require.NoError(t, err)
+
+	require.Len(t, out.ctxs, 2)
+	deadline, ok := out.ctxs[0].Deadline()
+	require.True(t, ok, "Calls without a deadline should get the default one")
+	assert.True(t, deadline.Before(time.Now().Add(time.Second)))
+	deadline, ok = out.ctxs[1].Deadline()
+	require.True(t, ok)
+	assert.True(t, deadline.After(time.Now().Add(time.Second)), "Deadlines set by the caller should be kept")
+}
+
+func TestOutboundMiddleware_auth(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	out := &recordingOutbound{}
+	_, err := authOutboundMiddleware{service.NopHost()}.Call(context.Background(), &transport.Request{}, out)
+	assert.NoError(t, err)
+
+	_, err = authOutboundMiddleware{service.NopHostAuthFailure()}.Call(context.Background(), &transport.Request{}, out)
+	assert.EqualError(t, err, "Error authenticating the request")
+	_, err = authOnewayOutboundMiddleware{service.NopHostAuthFailure()}.CallOneway(context.Background(), &transport.Request{}, out)
+	assert.EqualError(t, err, "Error authenticating the request")
+	assert.Len(t, out.ctxs, 1, "Calls should not be made without authentication")
+}
+
+func TestOutboundMiddleware_metrics(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	stats.SetupRPCMetrics(scope)
+	defer stats.SetupRPCMetrics(service.NopHost().Metrics())
+
+	out := &recordingOutbound{errs: []error{context.DeadlineExceeded, nil}}
+	req := &transport.Request{Service: "users", Procedure: "get", Encoding: "thrift"}
+	metricsOutboundMiddleware{}.Call(context.Background(), req, out)
+	metricsOutboundMiddleware{}.Call(context.Background(), req, out)
+	metricsOnewayOutboundMiddleware{}.CallOneway(context.Background(), req, out)
+
+	counters := scope.Snapshot().Counters()
+	tags := map[string]string{"service": "users", "procedure": "get", "encoding": "thrift", "type": "client"}
+	assert.Equal(t, int64(3), counterValue(counters, "calls", tags))
+	assert.Equal(t, int64(2), counterValue(counters, "success", tags))
+	assert.Equal(t, int64(1), counterValue(counters, "failures", map[string]string{"error": "timeout"}))
+}
+
+func TestOutboundErrorKind(t *testing.T) {
+	assert.Equal(t, "", outboundErrorKind(&transport.Response{}, nil))
+	assert.Equal(t, "application", outboundErrorKind(&transport.Response{ApplicationError: true}, nil))
+	assert.Equal(t, "circuitopen", outboundErrorKind(nil, &modules.CircuitOpenError{Destination: "users/get"}))
+	assert.Equal(t, "canceled", outboundErrorKind(nil, context.Canceled))
+	assert.Equal(t, "transport", outboundErrorKind(nil, errors.New("connection refused")))
+}
+
+func TestOutboundMiddleware_retry(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	m := newRetryOutboundMiddleware(RetryConfig{Procedures: []string{"get"}, InitialBackoff: time.Millisecond})
+	failure := errors.New("connection refused")
+
+	out := &recordingOutbound{errs: []error{failure, failure}}
+	req := &transport.Request{Procedure: "get", Body: strings.NewReader("body")}
+	_, err := m.Call(context.Background(), req, out)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"body", "body", "body"}, out.bodies, "The body should be sent on every attempt")
+
+	out = &recordingOutbound{errs: []error{failure, failure, failure, failure}}
+	_, err = m.Call(context.Background(), &transport.Request{Procedure: "get"}, out)
+	assert.Equal(t, failure, err)
+	assert.Len(t, out.ctxs, 3, "Calls should stop after the max attempts")
+
+	out = &recordingOutbound{errs: []error{failure}}
+	_, err = m.Call(context.Background(), &transport.Request{Procedure: "put"}, out)
+	assert.Equal(t, failure, err)
+	assert.Len(t, out.ctxs, 1, "Procedures that are not idempotent should not be retried")
+
+	out = &recordingOutbound{errs: []error{&modules.CircuitOpenError{Destination: "users/get"}}}
+	_, err = m.Call(context.Background(), &transport.Request{Procedure: "get"}, out)
+	assert.True(t, modules.IsCircuitOpen(err))
+	assert.Len(t, out.ctxs, 1, "Calls rejected by an open circuit should not be retried")
+}
+
+// closeRecordingBody records whether the response body of an attempt was closed
+type closeRecordingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeRecordingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+// failingBodyOutbound fails the first calls with responses that have a body
+type failingBodyOutbound struct {
+	transport.Outbound
+	failures int
+	bodies   []*closeRecordingBody
+}
+
+func (o *failingBodyOutbound) Call(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+	body := &closeRecordingBody{Reader: strings.NewReader("response")}
+	o.bodies = append(o.bodies, body)
+	if len(o.bodies) <= o.failures {
+		return &transport.Response{Body: body}, errors.New("unavailable")
+	}
+	return &transport.Response{Body: body}, nil
+}
+
+func TestOutboundMiddleware_retryClosesFailedResponses(t *testing.T) {
+	stats.SetupRPCMetrics(service.NopHost().Metrics())
+	m := newRetryOutboundMiddleware(RetryConfig{Procedures: []string{"get"}, InitialBackoff: time.Millisecond})
+	out := &failingBodyOutbound{failures: 2}
+	resp, err := m.Call(context.Background(), &transport.Request{Procedure: "get"}, out)
+	require.NoError(t, err)
+	require.Len(t, out.bodies, 3)
+	assert.True(t, out.bodies[0].closed, "Failed attempts should close their response body")
+	assert.True(t, out.bodies[1].closed, "Failed attempts should close their response body")
+	assert.False(t, out.bodies[2].closed, "The returned response should stay open")
+	assert.Equal(t, out.bodies[2], resp.Body)
+}
+
+func TestOutboundMiddleware_retryStopsAtDeadline(t *testing.T) {
+	m := newRetryOutboundMiddleware(RetryConfig{Procedures: []string{"*"}, InitialBackoff: 24 * time.Hour, MaxBackoff: 24 * time.Hour})
+	failure := errors.New("connection refused")
+	out := &recordingOutbound{errs: []error{failure, failure}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := m.Call(ctx, &transport.Request{Procedure: "get"}, out)
+	assert.Equal(t, failure, err)
+	assert.Len(t, out.ctxs, 1, "Retries should not wait past the deadline")
+}
+
+func TestRetryConfig(t *testing.T) {
+	assert.False(t, RetryConfig{}.Enabled())
+	assert.True(t, RetryConfig{Procedures: []string{"*"}}.Enabled())
+	assert.NoError(t, RetryConfig{}.Validate())
+	assert.Error(t, RetryConfig{MaxAttempts: -1}.Validate())
+	assert.Error(t, RetryConfig{MaxBackoff: -time.Second}.Validate())
+
+	cfg := RetryConfig{}.withDefaults()
+	assert.Equal(t, defaultRetryMaxAttempts, cfg.MaxAttempts)
+	assert.Equal(t, defaultRetryInitialBackoff, cfg.InitialBackoff)
+	assert.Equal(t, defaultRetryMaxBackoff, cfg.MaxBackoff)
+}
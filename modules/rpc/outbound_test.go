@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/middleware"
+	"go.uber.org/yarpc/api/transport"
+)
+
+func TestPrepareOutbounds(t *testing.T) {
+	outbounds, err := prepareOutbounds([]Outbound{
+		{Name: "users", Transport: "http", Peer: "users:8080"},
+		{Name: "trips", Service: "trips-service", Transport: "tchannel", Peers: []string{"a:4040", "b:4040"}},
+		{Name: "maps", Transport: "grpc", Peer: "maps:5050"},
+	}, "test", nil)
+	require.NoError(t, err)
+	require.Len(t, outbounds, 3)
+
+	users := outbounds["users"]
+	assert.Equal(t, "users", users.ServiceName)
+	assert.NotNil(t, users.Unary)
+	assert.NotNil(t, users.Oneway, "HTTP outbounds support oneway calls")
+
+	trips := outbounds["trips"]
+	assert.Equal(t, "trips-service", trips.ServiceName)
+	assert.Len(t, trips.Unary.(*peerOutbound).outbounds, 2)
+	assert.Nil(t, trips.Oneway, "TChannel outbounds do not support oneway calls")
+
+	maps := outbounds["maps"]
+	assert.Equal(t, "maps", maps.ServiceName)
+	assert.NotNil(t, maps.Unary)
+	assert.Nil(t, maps.Oneway, "gRPC outbounds do not support oneway calls")
+}
+
+func TestPrepareOutbounds_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		outbound Outbound
+		err      string
+	}{
+		{"no name", Outbound{Transport: "http", Peer: "a:80"}, "outbound name is required"},
+		{"no peers", Outbound{Name: "users", Transport: "http"}, `outbound "users" has no peers`},
+		{"bad transport", Outbound{Name: "users", Peer: "a:80"}, "unsupported transport"},
+		{"unknown middleware", Outbound{Name: "users", Transport: "http", Peer: "a:80", Middleware: []string{"magic"}},
+			`unknown middleware "magic"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := prepareOutbounds([]Outbound{tt.outbound}, "test", nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	_, err := prepareOutbounds([]Outbound{
+		{Name: "users", Transport: "http", Peer: "a:80"},
+		{Name: "users", Transport: "http", Peer: "b:80"},
+	}, "test", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "configured more than once")
+}
+
+func TestPrepareOutbounds_Middleware(t *testing.T) {
+	var calls []string
+	named := map[string]OutboundMiddleware{
+		"first": {Unary: recordingOutboundMiddleware("first", &calls)},
+		"second": {
+			Unary:  recordingOutboundMiddleware("second", &calls),
+			Oneway: recordingOnewayOutboundMiddleware("second", &calls),
+		},
+	}
+	outbounds, err := prepareOutbounds([]Outbound{
+		{Name: "users", Transport: "http", Peer: "a:80", Middleware: []string{"first", "second"}},
+	}, "test", named)
+	require.NoError(t, err)
+
+	users := outbounds["users"]
+	_, err = users.Unary.Call(context.Background(), &transport.Request{})
+	assert.Error(t, err)
+	_, err = users.Oneway.CallOneway(context.Background(), &transport.Request{})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"first", "second", "oneway second"}, calls, "First listed middleware should run first")
+}
+
+func TestPeerOutbound(t *testing.T) {
+	a := &fakePeerOutbound{name: "a"}
+	b := &fakePeerOutbound{name: "b"}
+	o := &peerOutbound{outbounds: []transport.UnaryOutbound{a, b}}
+	assert.True(t, o.supportsOneway())
+	for i := 0; i < 4; i++ {
+		_, err := o.Call(context.Background(), &transport.Request{})
+		require.NoError(t, err)
+	}
+	_, err := o.CallOneway(context.Background(), &transport.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, a.calls, "Calls should go to the peers in turn")
+	assert.Equal(t, 3, b.calls, "Calls should go to the peers in turn")
+
+	assert.NoError(t, o.Start())
+	assert.True(t, o.IsRunning())
+	b.stopErr = errors.New("stop failed")
+	assert.EqualError(t, o.Stop(), "stop failed")
+	assert.False(t, o.IsRunning())
+	assert.Empty(t, o.Transports())
+
+	b.startErr = errors.New("start failed")
+	assert.EqualError(t, o.Start(), "start failed")
+}
+
+func TestYARPCModule_Outbounds(t *testing.T) {
+	cfg := []byte(`
+modules:
+  yarpc:
+    outbounds:
+      - name: users
+        transport: http
+        peers: [users:8080]
+        middleware: [noop]
+`)
+	mci := service.ModuleCreateInfo{
+		Host: testHost{
+			Host:   service.NopHost(),
+			config: config.NewYAMLProviderFromBytes(cfg),
+		},
+		Items: map[string]interface{}{},
+	}
+	noop := WithNamedOutboundMiddleware("noop", OutboundMiddleware{Unary: middleware.NopUnaryOutbound})
+	mod, err := newYARPCModule(mci, func(*YARPCModule) {}, noop)
+	require.NoError(t, err)
+	assert.Contains(t, mod.config.transports.outbounds, "users")
+
+	c := dispatcherController{}
+	c.addConfig(mod.config)
+	c.addConfig(mod.config)
+	_, err = c.mergeConfigs("test")
+	require.Error(t, err, "Outbounds should not be configured twice")
+	assert.Contains(t, err.Error(), "more than one module")
+}
+
+func TestClientConfig(t *testing.T) {
+	controller := _controllers.get("")
+	dispatcher := controller.dispatcher
+	defer func() { controller.dispatcher = dispatcher }()
+
+	controller.dispatcher = nil
+	_, err := ClientConfig("users")
+	assert.EqualError(t, err, "YARPC dispatcher is not started yet")
+
+	outbounds, err := prepareOutbounds([]Outbound{{Name: "users", Transport: "http", Peer: "a:80"}}, "test", nil)
+	require.NoError(t, err)
+	controller.dispatcher = yarpc.NewDispatcher(yarpc.Config{Name: "test", Outbounds: outbounds})
+	cc, err := ClientConfig("users")
+	require.NoError(t, err)
+	assert.Equal(t, "users", cc.Service())
+	assert.Equal(t, "test", cc.Caller())
+
+	_, err = ClientConfig("trips")
+	assert.EqualError(t, err, `no outbound "trips" is configured`)
+}
+
+func recordingOutboundMiddleware(name string, calls *[]string) middleware.UnaryOutbound {
+	return middleware.UnaryOutboundFunc(func(ctx context.Context, req *transport.Request, out transport.UnaryOutbound) (*transport.Response, error) {
+		*calls = append(*calls, name)
+		return out.Call(ctx, req)
+	})
+}
+
+func recordingOnewayOutboundMiddleware(name string, calls *[]string) middleware.OnewayOutbound {
+	return middleware.OnewayOutboundFunc(func(ctx context.Context, req *transport.Request, out transport.OnewayOutbound) (transport.Ack, error) {
+		*calls = append(*calls, "oneway "+name)
+		return out.CallOneway(ctx, req)
+	})
+}
+
+type fakePeerOutbound struct {
+	name     string
+	calls    int
+	running  bool
+	startErr error
+	stopErr  error
+}
+
+func (o *fakePeerOutbound) Call(context.Context, *transport.Request) (*transport.Response, error) {
+	o.calls++
+	return &transport.Response{}, nil
+}
+
+func (o *fakePeerOutbound) CallOneway(context.Context, *transport.Request) (transport.Ack, error) {
+	o.calls++
+	return nil, nil
+}
+
+func (o *fakePeerOutbound) Start() error {
+	o.running = o.startErr == nil
+	return o.startErr
+}
+
+func (o *fakePeerOutbound) Stop() error {
+	o.running = false
+	return o.stopErr
+}
+
+func (o *fakePeerOutbound) IsRunning() bool                   { return o.running }
+func (o *fakePeerOutbound) Transports() []transport.Transport { return nil }
@@ -60,7 +60,7 @@ func newYARPCThriftModule(
 	reg := func(mod *YARPCModule) {
 		_setupMu.Lock()
 		defer _setupMu.Unlock()
-		Dispatcher().Register(registrants)
+		mod.Dispatcher().Register(registrants)
 	}
 
 	return newYARPCModule(mi, reg, options...)
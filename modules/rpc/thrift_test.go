@@ -21,17 +21,30 @@
 package rpc
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"go.uber.org/fx"
+	"go.uber.org/fx/auth"
 	"go.uber.org/fx/config"
 	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/rpc/internal/stats"
 	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/raw"
 	"go.uber.org/yarpc/encoding/thrift"
+	tch "go.uber.org/yarpc/transport/tchannel"
 )
 
 type testHost struct {
@@ -76,12 +89,167 @@ modules:
 	testInitRunModule(t, gopher[0], mci)
 }
 
+func TestThriftModule_SeparateDispatchers(t *testing.T) {
+	cfg := []byte(`
+modules:
+  public:
+    dispatcher: public
+    advertiseName: api
+    inbounds:
+     - http:
+         port: 0
+  admin:
+    dispatcher: admin
+    advertiseName: api-admin
+    inbounds:
+     - tchannel:
+         port: 0
+`)
+	host := testHost{
+		Host:   service.NopHost(),
+		config: config.NewYAMLProviderFromBytes(cfg),
+	}
+
+	public, err := ThriftModule(okCreate)(service.ModuleCreateInfo{Name: "public", Host: host})
+	require.NoError(t, err)
+	admin, err := ThriftModule(okCreate)(service.ModuleCreateInfo{Name: "admin", Host: host})
+	require.NoError(t, err)
+
+	for _, mod := range []service.Module{public[0], admin[0]} {
+		require.NoError(t, <-mod.Start(make(chan struct{}, 1)))
+		defer func(mod service.Module) {
+			assert.NoError(t, mod.Stop())
+		}(mod)
+	}
+
+	require.NotNil(t, NamedDispatcher("public"))
+	require.NotNil(t, NamedDispatcher("admin"))
+	assert.Equal(t, "api", NamedDispatcher("public").Name())
+	assert.Equal(t, "api-admin", NamedDispatcher("admin").Name())
+	assert.Equal(t, NamedDispatcher("public"), public[0].(*YARPCModule).Dispatcher())
+	assert.Equal(t, NamedDispatcher("admin"), admin[0].(*YARPCModule).Dispatcher())
+
+	inbound := admin[0].(*YARPCModule).config.transports.inbounds[0].(*tch.ChannelInbound)
+	assert.Equal(t, "api-admin", inbound.Channel().ServiceName(), "TChannel should advertise the name")
+}
+
 func TestThriftModule_BadOptions(t *testing.T) {
 	modCreate := ThriftModule(okCreate, errorOption)
 	_, err := modCreate(mch())
 	assert.Error(t, err)
 }
 
+func TestThriftModule_BadRecoveryPolicy(t *testing.T) {
+	cfg := []byte(`
+modules:
+  yarpc:
+    recovery:
+      policy: ignore
+`)
+	mci := service.ModuleCreateInfo{
+		Host: testHost{
+			Host:   service.NopHost(),
+			config: config.NewYAMLProviderFromBytes(cfg),
+		},
+	}
+	_, err := ThriftModule(okCreate)(mci)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown recovery policy")
+}
+
+func TestThriftModule_BadRateLimit(t *testing.T) {
+	cfg := []byte(`
+modules:
+  yarpc:
+    rateLimit:
+      rate: -1
+`)
+	mci := service.ModuleCreateInfo{
+		Host: testHost{
+			Host:   service.NopHost(),
+			config: config.NewYAMLProviderFromBytes(cfg),
+		},
+	}
+	_, err := ThriftModule(okCreate)(mci)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not be negative")
+}
+
+func TestThriftModule_BadCircuitBreaker(t *testing.T) {
+	cfg := []byte(`
+modules:
+  yarpc:
+    circuitBreaker:
+      failurePercent: 200
+`)
+	mci := service.ModuleCreateInfo{
+		Host: testHost{
+			Host:   service.NopHost(),
+			config: config.NewYAMLProviderFromBytes(cfg),
+		},
+	}
+	_, err := ThriftModule(okCreate)(mci)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failurePercent")
+}
+
+func TestThriftModule_GRPC(t *testing.T) {
+	port := freePort(t)
+	cfg := []byte(fmt.Sprintf(`
+modules:
+  grpc:
+    dispatcher: grpc
+    advertiseName: echo
+    inbounds:
+      - grpc:
+          port: %d
+    outbounds:
+      - name: echo
+        transport: grpc
+        peer: 127.0.0.1:%d
+`, port, port))
+	authClient := &recordingAuthClient{Client: auth.NopClient}
+	logger := ulog.NopLogger.With("transport", "grpc")
+	host := testHost{
+		Host:   service.NopHostConfigured(authClient, logger, opentracing.NoopTracer{}),
+		config: config.NewYAMLProviderFromBytes(cfg),
+	}
+
+	var handledInFxContext bool
+	echo := func(_ service.Host) ([]transport.Procedure, error) {
+		return raw.Procedure("echo", func(ctx context.Context, body []byte) ([]byte, error) {
+			handledInFxContext = fx.Logger(ctx) == logger
+			return body, nil
+		}), nil
+	}
+	mods, err := ThriftModule(echo)(service.ModuleCreateInfo{Name: "grpc", Host: host})
+	require.NoError(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	stats.SetupRPCMetrics(scope)
+	defer stats.SetupRPCMetrics(service.NopHost().Metrics())
+
+	mod := mods[0]
+	require.NoError(t, <-mod.Start(make(chan struct{}, 1)))
+	defer func() {
+		assert.NoError(t, mod.Stop())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client := raw.New(NamedDispatcher("grpc").ClientConfig("echo"))
+	body, err := client.Call(ctx, "echo", []byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(body))
+
+	assert.True(t, handledInFxContext, "Procedures served over gRPC should get the fx context")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authClient.authorized), "Calls over gRPC should be authorized")
+	counters := scope.Snapshot().Counters()
+	served := map[string]string{"type": "request", "procedure": "echo", "caller": "echo", "encoding": "raw"}
+	assert.Equal(t, int64(1), counterValue(counters, "calls", served), "Calls over gRPC should be observed")
+	assert.Equal(t, int64(1), counterValue(counters, "success", served))
+}
+
 func TestThrfitModule_Error(t *testing.T) {
 	modCreate := ThriftModule(badCreateService)
 	mods, err := modCreate(service.ModuleCreateInfo{})
@@ -103,6 +271,25 @@ func testInitRunModule(t *testing.T, mod service.Module, mci service.ModuleCreat
 	assert.Error(t, <-c)
 }
 
+// freePort returns a port that was free when it was picked
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// recordingAuthClient counts the calls it authorizes
+type recordingAuthClient struct {
+	auth.Client
+	authorized int32
+}
+
+func (c *recordingAuthClient) Authorize(ctx context.Context) error {
+	atomic.AddInt32(&c.authorized, 1)
+	return c.Client.Authorize(ctx)
+}
+
 func mch() service.ModuleCreateInfo {
 	return service.ModuleCreateInfo{
 		Host: service.NopHost(),
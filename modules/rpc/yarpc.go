@@ -23,7 +23,9 @@ package rpc
 import (
 	"errors"
 	"fmt"
+	"net"
 	"sync"
+	"time"
 
 	"go.uber.org/fx/modules"
 	"go.uber.org/fx/modules/rpc/internal/stats"
@@ -34,60 +36,81 @@ import (
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/middleware"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/http"
 	tch "go.uber.org/yarpc/transport/tchannel"
 )
 
 // YARPCModule is an implementation of a core RPC module using YARPC.
-// All the YARPC modules share the same dispatcher and middleware.
-// Dispatcher will start when any created module calls Start().
+// The YARPC modules of a dispatcher group share the same dispatcher and middleware.
+// Dispatcher will start when any created module of the group calls Start().
 type YARPCModule struct {
 	modules.ModuleBase
-	register  registerServiceFunc
-	config    yarpcConfig
-	log       ulog.Log
-	stateMu   sync.RWMutex
-	isRunning bool
+	register   registerServiceFunc
+	config     yarpcConfig
+	controller *dispatcherController
+	log        ulog.Log
+	stateMu    sync.RWMutex
+	isRunning  bool
 }
 
 var (
-	_dispatcherMu sync.Mutex
-
-	// Function to create a dispatcher
-	_dispatcherFn = defaultYARPCDispatcher
-
-	// Function to start a dispatcher
-	_starterFn = defaultYARPCStarter
-
 	_ service.Module = &YARPCModule{}
 
-	// Controller represents a collection of all YARPC configs
-	// that are stored together to create a shared dispatcher.
-	// The YARPC team advised it to be a 'singleton' to control
-	// the lifecycle of all of the in/out bound traffic.
-	_controller dispatcherController
+	// Controllers represent the collections of YARPC configs of every
+	// dispatcher group, stored together to create a shared dispatcher.
+	// The YARPC team advised a dispatcher to be a 'singleton' to control
+	// the lifecycle of all of the in/out bound traffic of a service.
+	_controllers = dispatcherControllers{controllers: make(map[string]*dispatcherController)}
 )
 
 type registerServiceFunc func(module *YARPCModule)
 
 type transports struct {
 	inbounds []transport.Inbound
+	// grpcAddrs are the addresses of the gRPC inbounds, which are listened on
+	// when the dispatcher starts
+	grpcAddrs []string
+	outbounds map[string]transport.Outbounds
 }
 
 type yarpcConfig struct {
 	modules.ModuleConfig
 
+	// Dispatcher names the group of modules that share a dispatcher, the modules
+	// without one share the default dispatcher
+	Dispatcher string `yaml:"dispatcher"`
+	// AdvertiseName is the service name of the dispatcher, defaults to the service name
+	AdvertiseName string `yaml:"advertiseName"`
+
 	inboundMiddleware       []middleware.UnaryInbound
 	onewayInboundMiddleware []middleware.OnewayInbound
 
+	outboundMiddleware       []middleware.UnaryOutbound
+	onewayOutboundMiddleware []middleware.OnewayOutbound
+
 	transports transports
 	Inbounds   []Inbound
+	Outbounds  []Outbound
+	// Recovery controls what happens when a handler panics
+	Recovery modules.RecoveryConfig `yaml:"recovery"`
+	// RateLimit rejects requests over their rate limit and sheds load
+	RateLimit modules.RateLimitConfig `yaml:"rateLimit"`
+	// CircuitBreaker fails outbound calls fast while their procedure keeps failing
+	CircuitBreaker modules.CircuitBreakerConfig `yaml:"circuitBreaker"`
+	// RequestLogging logs every call served
+	RequestLogging bool `yaml:"requestLogging"`
+	// OutboundTimeout is the deadline of outbound calls made without one, defaults to 1s
+	OutboundTimeout time.Duration `yaml:"outboundTimeout"`
+	// OutboundRetry retries failed unary outbound calls to idempotent procedures
+	OutboundRetry RetryConfig `yaml:"outboundRetry"`
 }
 
 // Inbound is a union that configures how to configure a single inbound.
 type Inbound struct {
 	TChannel *Address
 	HTTP     *Address
+	GRPC     *Address
 }
 
 // Address is a struct that have a required port for tchannel/http transports.
@@ -96,6 +119,42 @@ type Address struct {
 	Port int
 }
 
+// dispatcherControllers holds the controller of every dispatcher group
+type dispatcherControllers struct {
+	sync.Mutex
+	controllers map[string]*dispatcherController
+}
+
+// Returns the controller of the dispatcher group, creating it on first use
+func (c *dispatcherControllers) get(name string) *dispatcherController {
+	c.Lock()
+	defer c.Unlock()
+
+	controller, ok := c.controllers[name]
+	if !ok {
+		controller = &dispatcherController{}
+		c.controllers[name] = controller
+	}
+	return controller
+}
+
+// Replaces the stopped controller of the dispatcher group with a new one that keeps
+// its dispatcher and starter functions, so that modules created later start a new dispatcher
+func (c *dispatcherControllers) release(name string, stopped *dispatcherController) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.controllers[name] != stopped {
+		return
+	}
+	stopped.RLock()
+	defer stopped.RUnlock()
+	c.controllers[name] = &dispatcherController{
+		dispatcherFn: stopped.dispatcherFn,
+		starterFn:    stopped.starterFn,
+	}
+}
+
 // Stores a collection of all modules configs with a shared dispatcher
 // that are safe to call from multiple go routines. All the configs must
 // share the same AdvertiseName and represent a single service.
@@ -111,6 +170,11 @@ type dispatcherController struct {
 
 	configs    []*yarpcConfig
 	dispatcher *yarpc.Dispatcher
+	listeners  []net.Listener
+
+	// Functions to create and start the dispatcher, the defaults unless registered
+	dispatcherFn yarpcDispatcherFn
+	starterFn    yarpcStarterFn
 }
 
 // Adds the config to the controller
@@ -121,17 +185,68 @@ func (c *dispatcherController) addConfig(config yarpcConfig) {
 	c.configs = append(c.configs, &config)
 }
 
-// Adds the default middleware: context propagation and auth.
+// Adds the default middleware: context propagation, observability, panic recovery, rate limiting
+// and auth for inbound calls, and deadlines, auth, metrics, retries and circuit breaking
+// for outbound calls. Modules share the dispatcher, so the request logging, recovery policy,
+// rate limits, outbound timeout, retries and circuit breaker of the first module apply.
 func (c *dispatcherController) addDefaultMiddleware(host service.Host) {
+	var recovery modules.RecoveryConfig
+	var rateLimit modules.RateLimitConfig
+	var circuitBreaker modules.CircuitBreakerConfig
+	var retry RetryConfig
+	var logRequests bool
+	outboundTimeout := defaultOutboundTimeout
+	c.RLock()
+	if len(c.configs) > 0 {
+		logRequests = c.configs[0].RequestLogging
+		retry = c.configs[0].OutboundRetry
+		if c.configs[0].OutboundTimeout > 0 {
+			outboundTimeout = c.configs[0].OutboundTimeout
+		}
+		recovery = c.configs[0].Recovery
+		rateLimit = c.configs[0].RateLimit
+		circuitBreaker = c.configs[0].CircuitBreaker
+	}
+	c.RUnlock()
+
+	callers := newCallerTags()
 	cfg := yarpcConfig{
 		inboundMiddleware: []middleware.UnaryInbound{
 			contextInboundMiddleware{host},
-			authInboundMiddleware{host},
+			observabilityInboundMiddleware{logRequests, callers},
+			panicInboundMiddleware{recovery},
 		},
 		onewayInboundMiddleware: []middleware.OnewayInbound{
 			contextOnewayInboundMiddleware{host},
-			authOnewayInboundMiddleware{host},
+			observabilityOnewayInboundMiddleware{logRequests, callers},
+			panicOnewayInboundMiddleware{recovery},
+		},
+		outboundMiddleware: []middleware.UnaryOutbound{
+			deadlineOutboundMiddleware{outboundTimeout},
+			authOutboundMiddleware{host},
+			metricsOutboundMiddleware{},
 		},
+		onewayOutboundMiddleware: []middleware.OnewayOutbound{
+			deadlineOnewayOutboundMiddleware{outboundTimeout},
+			authOnewayOutboundMiddleware{host},
+			metricsOnewayOutboundMiddleware{},
+		},
+	}
+	cfg.inboundMiddleware = append(cfg.inboundMiddleware, authInboundMiddleware{host})
+	cfg.onewayInboundMiddleware = append(cfg.onewayInboundMiddleware, authOnewayInboundMiddleware{host})
+	// Requests are limited once authorized, so that the limit applies to their caller
+	if rateLimit.Enabled() {
+		limiter := modules.NewLimiter(rateLimit)
+		cfg.inboundMiddleware = append(cfg.inboundMiddleware, rateLimitInboundMiddleware{limiter})
+		cfg.onewayInboundMiddleware = append(cfg.onewayInboundMiddleware, rateLimitOnewayInboundMiddleware{limiter})
+	}
+	if retry.Enabled() {
+		cfg.outboundMiddleware = append(cfg.outboundMiddleware, newRetryOutboundMiddleware(retry))
+	}
+	if circuitBreaker.Enabled() {
+		breakers := newCircuitBreakers(circuitBreaker)
+		cfg.outboundMiddleware = append(cfg.outboundMiddleware, circuitBreakerOutboundMiddleware{breakers})
+		cfg.onewayOutboundMiddleware = append(cfg.onewayOutboundMiddleware, circuitBreakerOnewayOutboundMiddleware{breakers})
 	}
 
 	c.addConfig(cfg)
@@ -150,32 +265,86 @@ func (c *dispatcherController) Start(host service.Host) error {
 			return
 		}
 
-		_dispatcherMu.Lock()
-		defer _dispatcherMu.Unlock()
-		if c.dispatcher, err = _dispatcherFn(host, cfg); err != nil {
+		c.RLock()
+		dispatcherFn, starterFn := c.dispatcherFn, c.starterFn
+		c.RUnlock()
+		if dispatcherFn == nil {
+			dispatcherFn = defaultYARPCDispatcher
+		}
+		if starterFn == nil {
+			starterFn = defaultYARPCStarter
+		}
+
+		var inbounds []transport.Inbound
+		if inbounds, c.listeners, err = c.listenGRPC(); err != nil {
+			c.startError = err
+			return
+		}
+		cfg.Inbounds = append(cfg.Inbounds, inbounds...)
+
+		if c.dispatcher, err = dispatcherFn(host, cfg); err != nil {
+			c.closeListeners()
 			c.startError = err
 			return
 		}
 
-		c.startError = _starterFn(c.dispatcher)
+		if c.startError = starterFn(c.dispatcher); c.startError != nil {
+			c.closeListeners()
+		}
 	})
 
 	return c.startError
 }
 
+// listenGRPC opens the listeners of the gRPC inbounds, which serve an open listener
+// rather than an address. Listening once the dispatcher starts keeps the modules that
+// fail or never start from holding their ports.
+func (c *dispatcherController) listenGRPC() ([]transport.Inbound, []net.Listener, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var inbounds []transport.Inbound
+	var listeners []net.Listener
+	for _, cfg := range c.configs {
+		for _, addr := range cfg.transports.grpcAddrs {
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				for _, l := range listeners {
+					l.Close()
+				}
+				return nil, nil, errs.Wrap(err, "can't listen for grpc inbound")
+			}
+			listeners = append(listeners, listener)
+			inbounds = append(inbounds, grpc.NewTransport().NewInbound(listener))
+		}
+	}
+	return inbounds, listeners, nil
+}
+
+// closeListeners closes the gRPC listeners, which the inbounds already closed
+// if they were started and stopped
+func (c *dispatcherController) closeListeners() {
+	for _, l := range c.listeners {
+		l.Close()
+	}
+	c.listeners = nil
+}
+
 // Return the result of the dispatcher Stop() on the first call.
 // No-op on subsequent calls.
 // TODO: update readme/docs/examples GFM(339)
 func (c *dispatcherController) Stop() error {
 	c.stop.Do(func() {
 		c.stopError = c.dispatcher.Stop()
+		c.closeListeners()
 	})
 
 	return c.stopError
 }
 
 // Merge all the YARPC configs in the collection: transports and middleware are going to be shared.
-// The name comes from the first config in the collection and is the same among all configs.
+// The name is the AdvertiseName of the configs, which must be the same among all configs,
+// or else the given service name.
 func (c *dispatcherController) mergeConfigs(name string) (conf yarpc.Config, err error) {
 	c.RLock()
 	defer c.RUnlock()
@@ -186,14 +355,39 @@ func (c *dispatcherController) mergeConfigs(name string) (conf yarpc.Config, err
 	}
 
 	conf.Name = name
+	var advertiseName string
+	for _, cfg := range c.configs {
+		if cfg.AdvertiseName == "" {
+			continue
+		}
+		if advertiseName != "" && advertiseName != cfg.AdvertiseName {
+			return conf, fmt.Errorf("modules of a dispatcher advertise different names %q and %q",
+				advertiseName, cfg.AdvertiseName)
+		}
+		advertiseName = cfg.AdvertiseName
+		conf.Name = advertiseName
+	}
 
-	// Collect all Inbounds and middleware from all configs
+	// Collect all Inbounds, Outbounds and middleware from all configs
 	var inboundMiddleware []middleware.UnaryInbound
 	var onewayInboundMiddleware []middleware.OnewayInbound
+	var outboundMiddleware []middleware.UnaryOutbound
+	var onewayOutboundMiddleware []middleware.OnewayOutbound
 	for _, cfg := range c.configs {
 		conf.Inbounds = append(conf.Inbounds, cfg.transports.inbounds...)
+		for name, out := range cfg.transports.outbounds {
+			if _, ok := conf.Outbounds[name]; ok {
+				return conf, fmt.Errorf("outbound %q is configured by more than one module", name)
+			}
+			if conf.Outbounds == nil {
+				conf.Outbounds = yarpc.Outbounds{}
+			}
+			conf.Outbounds[name] = out
+		}
 		inboundMiddleware = append(inboundMiddleware, cfg.inboundMiddleware...)
 		onewayInboundMiddleware = append(onewayInboundMiddleware, cfg.onewayInboundMiddleware...)
+		outboundMiddleware = append(outboundMiddleware, cfg.outboundMiddleware...)
+		onewayOutboundMiddleware = append(onewayOutboundMiddleware, cfg.onewayOutboundMiddleware...)
 	}
 
 	// Build the inbound middleware
@@ -202,6 +396,12 @@ func (c *dispatcherController) mergeConfigs(name string) (conf yarpc.Config, err
 		Oneway: yarpc.OnewayInboundMiddleware(onewayInboundMiddleware...),
 	}
 
+	// Build the outbound middleware
+	conf.OutboundMiddleware = yarpc.OutboundMiddleware{
+		Unary:  yarpc.UnaryOutboundMiddleware(outboundMiddleware...),
+		Oneway: yarpc.OnewayOutboundMiddleware(onewayOutboundMiddleware...),
+	}
+
 	return conf, nil
 }
 
@@ -235,27 +435,59 @@ func newYARPCModule(
 	if err := config.PopulateStruct(&module.config); err != nil {
 		return nil, errs.Wrap(err, "can't read inbounds")
 	}
+	if err := module.config.Recovery.Validate(); err != nil {
+		return nil, err
+	}
+	if err := module.config.RateLimit.Validate(); err != nil {
+		return nil, err
+	}
+	if err := module.config.CircuitBreaker.Validate(); err != nil {
+		return nil, err
+	}
+	if err := module.config.OutboundRetry.Validate(); err != nil {
+		return nil, err
+	}
+
+	// TChannel transports advertise the name of the dispatcher
+	serviceName := module.config.AdvertiseName
+	if serviceName == "" {
+		serviceName = mi.Host.Name()
+	}
 
 	// iterate over inbounds
-	transportsIn, err := prepareInbounds(module.config.Inbounds, mi.Host.Name())
+	transportsIn, grpcAddrs, err := prepareInbounds(module.config.Inbounds, serviceName)
 	if err != nil {
 		return nil, errs.Wrap(err, "can't process inbounds")
 	}
 
 	module.config.transports.inbounds = transportsIn
+	module.config.transports.grpcAddrs = grpcAddrs
+	module.config.transports.outbounds, err = prepareOutbounds(
+		module.config.Outbounds, serviceName, namedOutboundMiddlewareFromCreateInfo(mi))
+	if err != nil {
+		return nil, errs.Wrap(err, "can't process outbounds")
+	}
 	module.config.inboundMiddleware = inboundMiddlewareFromCreateInfo(mi)
 	module.config.onewayInboundMiddleware = onewayInboundMiddlewareFromCreateInfo(mi)
+	module.config.outboundMiddleware = outboundMiddlewareFromCreateInfo(mi)
+	module.config.onewayOutboundMiddleware = onewayOutboundMiddlewareFromCreateInfo(mi)
 
-	_controller.addConfig(module.config)
+	module.controller = _controllers.get(module.config.Dispatcher)
+	module.controller.addConfig(module.config)
 
-	module.log.Info("Module successfuly created", "inbounds", module.config.Inbounds)
+	module.log.Info("Module successfuly created",
+		"dispatcher", module.config.Dispatcher,
+		"inbounds", module.config.Inbounds,
+		"outbounds", module.config.Outbounds,
+	)
 
 	return module, nil
 }
 
-// Iterate over all inbounds and prepare corresponding transports
-func prepareInbounds(inbounds []Inbound, serviceName string) (transportsIn []transport.Inbound, err error) {
-	transportsIn = make([]transport.Inbound, 0, 2*len(inbounds))
+// Iterate over all inbounds and prepare corresponding transports, the gRPC inbounds
+// are returned as the addresses to listen on
+func prepareInbounds(inbounds []Inbound, serviceName string) (transportsIn []transport.Inbound, grpcAddrs []string, err error) {
+	transportsIn = make([]transport.Inbound, 0, 3*len(inbounds))
 	for _, in := range inbounds {
 		if h := in.HTTP; h != nil {
 			transportsIn = append(
@@ -269,14 +501,18 @@ func prepareInbounds(inbounds []Inbound, serviceName string) (transportsIn []tra
 				tch.ListenAddr(fmt.Sprintf(":%d", t.Port)))
 
 			if err != nil {
-				return nil, errs.Wrap(err, "can't create tchannel transport")
+				return nil, nil, errs.Wrap(err, "can't create tchannel transport")
 			}
 
 			transportsIn = append(transportsIn, chn.NewInbound())
 		}
+
+		if g := in.GRPC; g != nil {
+			grpcAddrs = append(grpcAddrs, fmt.Sprintf(":%d", g.Port))
+		}
 	}
 
-	return transportsIn, nil
+	return transportsIn, grpcAddrs, nil
 }
 
 // Start begins serving requests with YARPC.
@@ -290,8 +526,7 @@ func (m *YARPCModule) Start(readyCh chan<- struct{}) <-chan error {
 	m.stateMu.Lock()
 	defer m.stateMu.Unlock()
 
-	// TODO(alsam) allow services to advertise with a name separate from the host name.
-	if err := _controller.Start(m.Host()); err != nil {
+	if err := m.controller.Start(m.Host()); err != nil {
 		ret <- errs.Wrap(err, "unable to start dispatcher")
 		return ret
 	}
@@ -314,7 +549,9 @@ func (m *YARPCModule) Stop() error {
 	m.stateMu.Lock()
 	defer m.stateMu.Unlock()
 	m.isRunning = false
-	return _controller.Stop()
+	err := m.controller.Stop()
+	_controllers.release(m.config.Dispatcher, m.controller)
+	return err
 }
 
 // IsRunning returns whether a module is running
@@ -328,9 +565,16 @@ type yarpcDispatcherFn func(service.Host, yarpc.Config) (*yarpc.Dispatcher, erro
 
 // RegisterDispatcher allows you to override the YARPC dispatcher registration
 func RegisterDispatcher(dispatchFn yarpcDispatcherFn) {
-	_dispatcherMu.Lock()
-	defer _dispatcherMu.Unlock()
-	_dispatcherFn = dispatchFn
+	RegisterNamedDispatcher("", dispatchFn)
+}
+
+// RegisterNamedDispatcher overrides the YARPC dispatcher registration of the dispatcher
+// group with the name
+func RegisterNamedDispatcher(name string, dispatchFn yarpcDispatcherFn) {
+	c := _controllers.get(name)
+	c.Lock()
+	defer c.Unlock()
+	c.dispatcherFn = dispatchFn
 }
 
 func defaultYARPCDispatcher(_ service.Host, cfg yarpc.Config) (*yarpc.Dispatcher, error) {
@@ -341,17 +585,35 @@ type yarpcStarterFn func(dispatcher *yarpc.Dispatcher) error
 
 // RegisterStarter allows you to override the YARPC dispatcher start, e.g. attach some metrics with start.
 func RegisterStarter(startFn yarpcStarterFn) {
-	_dispatcherMu.Lock()
-	defer _dispatcherMu.Unlock()
-	_starterFn = startFn
+	RegisterNamedStarter("", startFn)
+}
+
+// RegisterNamedStarter overrides the YARPC dispatcher start of the dispatcher group with the name
+func RegisterNamedStarter(name string, startFn yarpcStarterFn) {
+	c := _controllers.get(name)
+	c.Lock()
+	defer c.Unlock()
+	c.starterFn = startFn
 }
 
 func defaultYARPCStarter(dispatcher *yarpc.Dispatcher) error {
 	return dispatcher.Start()
 }
 
-// Dispatcher returns a dispatcher that can be used to create clients.
+// Dispatcher returns the default dispatcher that can be used to create clients.
 // It should be called after at least one module have been started, otherwise it will be nil.
 func Dispatcher() *yarpc.Dispatcher {
-	return _controller.dispatcher
+	return NamedDispatcher("")
+}
+
+// NamedDispatcher returns the dispatcher of the modules configured with the dispatcher name.
+// It should be called after at least one module of the group have been started, otherwise it will be nil.
+func NamedDispatcher(name string) *yarpc.Dispatcher {
+	return _controllers.get(name).dispatcher
+}
+
+// Dispatcher returns the dispatcher the module shares with its dispatcher group,
+// nil until the module is started
+func (m *YARPCModule) Dispatcher() *yarpc.Dispatcher {
+	return m.controller.dispatcher
 }
@@ -29,6 +29,9 @@ import (
 const (
 	_interceptorKey       = "yarpcUnaryInboundMiddleware"
 	_onewayInterceptorKey = "yarpcOnewayInboundMiddleware"
+
+	_outboundMiddlewareKey       = "yarpcUnaryOutboundMiddleware"
+	_onewayOutboundMiddlewareKey = "yarpcOnewayOutboundMiddleware"
 )
 
 // WithInboundMiddleware adds custom YARPC inboundMiddleware to the module
@@ -52,6 +55,26 @@ func WithOnewayInboundMiddleware(i ...middleware.OnewayInbound) modules.Option {
 	}
 }
 
+// WithOutboundMiddleware adds custom YARPC outbound middleware for the calls of every outbound
+func WithOutboundMiddleware(o ...middleware.UnaryOutbound) modules.Option {
+	return func(mci *service.ModuleCreateInfo) error {
+		outboundMiddleware := outboundMiddlewareFromCreateInfo(*mci)
+		outboundMiddleware = append(outboundMiddleware, o...)
+		mci.Items[_outboundMiddlewareKey] = outboundMiddleware
+		return nil
+	}
+}
+
+// WithOnewayOutboundMiddleware adds custom YARPC outbound middleware for the oneway calls of every outbound
+func WithOnewayOutboundMiddleware(o ...middleware.OnewayOutbound) modules.Option {
+	return func(mci *service.ModuleCreateInfo) error {
+		outboundMiddleware := onewayOutboundMiddlewareFromCreateInfo(*mci)
+		outboundMiddleware = append(outboundMiddleware, o...)
+		mci.Items[_onewayOutboundMiddlewareKey] = outboundMiddleware
+		return nil
+	}
+}
+
 func inboundMiddlewareFromCreateInfo(mci service.ModuleCreateInfo) []middleware.UnaryInbound {
 	items, ok := mci.Items[_interceptorKey]
 	if !ok {
@@ -71,3 +94,23 @@ func onewayInboundMiddlewareFromCreateInfo(mci service.ModuleCreateInfo) []middl
 	// Intentionally panic if programmer adds non-middleware slice to the data
 	return items.([]middleware.OnewayInbound)
 }
+
+func outboundMiddlewareFromCreateInfo(mci service.ModuleCreateInfo) []middleware.UnaryOutbound {
+	items, ok := mci.Items[_outboundMiddlewareKey]
+	if !ok {
+		return nil
+	}
+
+	// Intentionally panic if programmer adds non-middleware slice to the data
+	return items.([]middleware.UnaryOutbound)
+}
+
+func onewayOutboundMiddlewareFromCreateInfo(mci service.ModuleCreateInfo) []middleware.OnewayOutbound {
+	items, ok := mci.Items[_onewayOutboundMiddlewareKey]
+	if !ok {
+		return nil
+	}
+
+	// Intentionally panic if programmer adds non-middleware slice to the data
+	return items.([]middleware.OnewayOutbound)
+}
@@ -72,3 +72,32 @@ func TestWithOnewayInboundMiddleware_PanicsBadData(t *testing.T) {
 		opt(mc)
 	})
 }
+
+func TestWithOutboundMiddleware_OK(t *testing.T) {
+	mc := &service.ModuleCreateInfo{
+		Items: make(map[string]interface{}),
+	}
+	require.NoError(t, WithOutboundMiddleware(middleware.NopUnaryOutbound)(mc))
+	require.NoError(t, WithOutboundMiddleware(middleware.NopUnaryOutbound)(mc))
+	assert.Equal(t, 2, len(outboundMiddlewareFromCreateInfo(*mc)))
+}
+
+func TestWithOnewayOutboundMiddleware_OK(t *testing.T) {
+	mc := &service.ModuleCreateInfo{
+		Items: make(map[string]interface{}),
+	}
+	require.NoError(t, WithOnewayOutboundMiddleware(middleware.NopOnewayOutbound)(mc))
+	assert.Equal(t, 1, len(onewayOutboundMiddlewareFromCreateInfo(*mc)))
+}
+
+func TestWithOutboundMiddleware_PanicsBadData(t *testing.T) {
+	opt := WithOutboundMiddleware(middleware.NopUnaryOutbound)
+	mc := &service.ModuleCreateInfo{
+		Items: map[string]interface{}{
+			_outboundMiddlewareKey: "foo",
+		},
+	}
+	assert.Panics(t, func() {
+		opt(mc)
+	})
+}
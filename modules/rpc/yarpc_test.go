@@ -21,11 +21,18 @@
 package rpc
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"testing"
+	"time"
 
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/service"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
 	"go.uber.org/yarpc/transport/http"
 )
@@ -40,6 +47,40 @@ func TestRegisterStarter_OK(t *testing.T) {
 	RegisterStarter(defaultYARPCStarter)
 }
 
+func TestRegisterNamedDispatcher(t *testing.T) {
+	t.Parallel()
+	RegisterNamedDispatcher("failing", func(service.Host, yarpc.Config) (*yarpc.Dispatcher, error) {
+		return nil, errors.New("no dispatcher")
+	})
+	c := _controllers.get("failing")
+	c.addConfig(yarpcConfig{})
+	assert.EqualError(t, c.Start(service.NopHost()), "no dispatcher")
+	assert.Nil(t, _controllers.get("not-failing").dispatcherFn, "Other groups should create the default dispatcher")
+}
+
+func TestDispatcherControllers_release(t *testing.T) {
+	t.Parallel()
+	var started int
+	RegisterNamedStarter("restarted", func(d *yarpc.Dispatcher) error {
+		started++
+		return d.Start()
+	})
+	c := _controllers.get("restarted")
+	c.addConfig(yarpcConfig{})
+	require.NoError(t, c.Start(service.NopHost()))
+	require.NoError(t, c.Stop())
+	_controllers.release("restarted", c)
+
+	renewed := _controllers.get("restarted")
+	assert.False(t, c == renewed, "A stopped group should get a new controller")
+	assert.Nil(t, renewed.dispatcher)
+	renewed.addConfig(yarpcConfig{})
+	require.NoError(t, renewed.Start(service.NopHost()))
+	assert.Equal(t, 2, started, "The registered starter should be kept")
+	assert.NoError(t, renewed.Stop())
+	_controllers.release("restarted", renewed)
+}
+
 func TestDispatcher(t *testing.T) {
 	t.Parallel()
 	c := dispatcherController{}
@@ -48,6 +89,112 @@ func TestDispatcher(t *testing.T) {
 	assert.NoError(t, c.Start(host))
 }
 
+func TestDefaultMiddlewareRecovery(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{Recovery: modules.RecoveryConfig{Policy: modules.RecoveryPropagate}})
+	c.addDefaultMiddleware(service.NopHost())
+	assert.Contains(t, c.configs[1].inboundMiddleware, panicInboundMiddleware{c.configs[0].Recovery})
+	assert.Contains(t, c.configs[1].onewayInboundMiddleware, panicOnewayInboundMiddleware{c.configs[0].Recovery})
+}
+
+func TestDefaultMiddlewareRateLimit(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{})
+	c.addDefaultMiddleware(service.NopHost())
+	assert.Len(t, c.configs[1].inboundMiddleware, 4, "Rate limiting should be off by default")
+
+	c = dispatcherController{}
+	c.addConfig(yarpcConfig{RateLimit: modules.RateLimitConfig{Rate: 10}})
+	c.addDefaultMiddleware(service.NopHost())
+	require.Len(t, c.configs[1].inboundMiddleware, 5)
+	assert.IsType(t, authInboundMiddleware{}, c.configs[1].inboundMiddleware[3])
+	assert.IsType(t, rateLimitInboundMiddleware{}, c.configs[1].inboundMiddleware[4],
+		"Requests should be rate limited once authorized")
+	assert.IsType(t, rateLimitOnewayInboundMiddleware{}, c.configs[1].onewayInboundMiddleware[4])
+}
+
+func TestDefaultOutboundMiddleware(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{})
+	c.addDefaultMiddleware(service.NopHost())
+	out := c.configs[1].outboundMiddleware
+	require.Len(t, out, 3)
+	assert.Equal(t, deadlineOutboundMiddleware{defaultOutboundTimeout}, out[0])
+	assert.IsType(t, authOutboundMiddleware{}, out[1])
+	assert.IsType(t, metricsOutboundMiddleware{}, out[2])
+	assert.Len(t, c.configs[1].onewayOutboundMiddleware, 3)
+
+	c = dispatcherController{}
+	c.addConfig(yarpcConfig{
+		OutboundTimeout: time.Minute,
+		OutboundRetry:   RetryConfig{Procedures: []string{"get"}},
+	})
+	c.addDefaultMiddleware(service.NopHost())
+	out = c.configs[1].outboundMiddleware
+	require.Len(t, out, 4)
+	assert.Equal(t, deadlineOutboundMiddleware{time.Minute}, out[0])
+	assert.IsType(t, retryOutboundMiddleware{}, out[3])
+	assert.Len(t, c.configs[1].onewayOutboundMiddleware, 3, "Oneway calls should not be retried")
+}
+
+func TestDefaultMiddlewareObservability(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{RequestLogging: true})
+	c.addDefaultMiddleware(service.NopHost())
+	unary := c.configs[1].inboundMiddleware[1].(observabilityInboundMiddleware)
+	oneway := c.configs[1].onewayInboundMiddleware[1].(observabilityOnewayInboundMiddleware)
+	assert.True(t, unary.logRequests)
+	assert.True(t, oneway.logRequests)
+	assert.True(t, unary.callers == oneway.callers, "Unary and oneway calls should share the caller tags")
+}
+
+func TestDefaultMiddlewareCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{})
+	c.addDefaultMiddleware(service.NopHost())
+	assert.Len(t, c.configs[1].outboundMiddleware, 3, "Circuit breaking should be off by default")
+
+	c = dispatcherController{}
+	c.addConfig(yarpcConfig{CircuitBreaker: modules.CircuitBreakerConfig{FailurePercent: 50}})
+	c.addDefaultMiddleware(service.NopHost())
+	require.Len(t, c.configs[1].outboundMiddleware, 4)
+	assert.IsType(t, circuitBreakerOutboundMiddleware{}, c.configs[1].outboundMiddleware[3])
+	assert.IsType(t, circuitBreakerOnewayOutboundMiddleware{}, c.configs[1].onewayOutboundMiddleware[3])
+
+	conf, err := c.mergeConfigs("test")
+	require.NoError(t, err)
+	assert.NotNil(t, conf.OutboundMiddleware.Unary)
+	assert.NotNil(t, conf.OutboundMiddleware.Oneway)
+}
+
+func TestMergeConfigsAdvertiseName(t *testing.T) {
+	t.Parallel()
+	c := dispatcherController{}
+	c.addConfig(yarpcConfig{})
+	c.addConfig(yarpcConfig{AdvertiseName: "api"})
+	conf, err := c.mergeConfigs("service")
+	require.NoError(t, err)
+	assert.Equal(t, "api", conf.Name)
+
+	c.addConfig(yarpcConfig{AdvertiseName: "admin"})
+	_, err = c.mergeConfigs("service")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "advertise different names")
+}
+
+func TestDispatcherControllers(t *testing.T) {
+	t.Parallel()
+	c := dispatcherControllers{controllers: make(map[string]*dispatcherController)}
+	assert.True(t, c.get("public") == c.get("public"), "Modules of a group should share a controller")
+	assert.False(t, c.get("public") == c.get("admin"))
+	assert.False(t, c.get("public") == c.get(""))
+}
+
 func TestBindToBadPortReturnsError(t *testing.T) {
 	t.Parallel()
 	c := dispatcherController{}
@@ -61,6 +208,38 @@ func TestBindToBadPortReturnsError(t *testing.T) {
 	assert.Error(t, c.Start(service.NopHost()))
 }
 
+func assertPortFree(t *testing.T, addr string, msg string) {
+	l, err := net.Listen("tcp", addr)
+	if assert.NoError(t, err, msg) {
+		l.Close()
+	}
+}
+
+func TestGRPCInbound_ListensOnStart(t *testing.T) {
+	port := freePort(t)
+	addr := fmt.Sprintf(":%d", port)
+	inbounds, grpcAddrs, err := prepareInbounds([]Inbound{{GRPC: &Address{Port: port}}}, "test")
+	require.NoError(t, err)
+	assert.Empty(t, inbounds)
+	assert.Equal(t, []string{addr}, grpcAddrs)
+	assertPortFree(t, addr, "Modules should not listen before they start")
+
+	c := dispatcherController{starterFn: func(*yarpc.Dispatcher) error {
+		return errors.New("start failed")
+	}}
+	c.addConfig(yarpcConfig{transports: transports{grpcAddrs: grpcAddrs}})
+	assert.EqualError(t, c.Start(service.NopHost()), "start failed")
+	assertPortFree(t, addr, "Listeners should be closed when the dispatcher fails to start")
+
+	c = dispatcherController{}
+	c.addConfig(yarpcConfig{transports: transports{grpcAddrs: grpcAddrs}})
+	require.NoError(t, c.Start(service.NopHost()))
+	_, err = net.Listen("tcp", addr)
+	assert.Error(t, err, "Started dispatchers should listen")
+	require.NoError(t, c.Stop())
+	assertPortFree(t, addr, "Listeners should be closed when the dispatcher stops")
+}
+
 func TestMergeOfEmptyConfigCollectionReturnsError(t *testing.T) {
 	t.Parallel()
 	c := dispatcherController{}
@@ -27,6 +27,7 @@
 // Backend
 //
 // Backends are messaging transports used by the framework to guarantee durability.
+// The Kafka module (../kafka) provides a backend that publishes tasks to a Kafka topic.
 //
 // Usage
 //
@@ -77,12 +78,98 @@
 // Users are free to define their own backends and encodings for message passing.
 //
 //
+// Results
+//
+// Functions may also return a value along with the error. Call task.EnqueueWithResult
+// to get a task.Future for the value, which can be polled or waited on with a timeout.
+// Results are saved in a result store, which is in-memory by default and evicts results an
+// hour after they are stored. A file-backed store is available, and users can provide their
+// own with the task.WithResultStore option.
+//
+//   func main() {
+//     svc, err := service.WithModules(
+//       task.NewModule(newBackend, task.WithResultStore(newResultStore)),
+//     ).Build()
+//     if err := task.Register(computeTotal); err != nil {
+//       ulog.Logger().Fatal("could not register task", "error", err)
+//     }
+//     svc.Start()
+//   }
+//
+//   func newResultStore(host service.Host) (task.ResultStore, error) {
+//     return task.NewFileResultStore("/var/tmp/results")
+//   }
+//
+//   func handleRequest(ctx context.Context, orderID string) (int, error) {
+//     future, err := task.EnqueueWithResult(computeTotal, ctx, orderID)
+//     if err != nil {
+//       return 0, err
+//     }
+//     total, err := future.Wait(ctx, 5*time.Second)
+//     if err != nil {
+//       return 0, err
+//     }
+//     return total.(int), nil
+//   }
+//
+//   func computeTotal(ctx context.Context, orderID string) (int, error) {
+//     // calculate the total for the order
+//     return 42, nil
+//   }
+//
+//
+// Workflows
+//
+// Tasks can be composed into workflows. task.Chain runs tasks one after another and
+// passes the value returned by each step as the last argument of the next step. The
+// chain stops at the first failure. task.Group runs tasks in parallel and collects
+// their values in order, and task.Chord runs a group followed by a callback that takes
+// the collected values as a []interface{}. The callback is skipped if any member fails.
+// Enqueuing a workflow returns a task.Future for the value of the final task.
+//
+//   func processOrder(ctx context.Context, orderID string) (*task.Future, error) {
+//     return task.Chord(
+//       []task.Signature{
+//         task.NewSignature(computeTotal, orderID),
+//         task.NewSignature(computeShipping, orderID),
+//       },
+//       task.NewSignature(sumCharges),
+//     ).Enqueue(ctx)
+//   }
+//
+//   func sumCharges(ctx context.Context, charges []interface{}) (int, error) {
+//     // add up the charges returned by the group
+//     return 0, nil
+//   }
+//
+// Workflow state travels with the task messages and the result store, so workflows
+// survive worker restarts. The chord callback is enqueued by the last member to finish
+// and may run more than once if members complete concurrently, so it should be idempotent.
+//
+//
+// Panic recovery
+//
+// A panic in a task function fails the task like a returned error would: the task returns
+// internal error, a failed result is stored for task.EnqueueWithResult callers and
+// the rest of a chain is not run. The panic value and the stack are logged at error level
+// together with the function name, which also reports them to Sentry when the Sentry hook
+// is configured, and the panic counter is incremented.
+//
+//   modules:
+//     task:
+//       recovery:
+//         policy: propagate
+//
+// The recover policy is the default. With propagate the panic is reported and then
+// re-raised, stopping the worker.
+//
+//
 // Async function requirements
 //
 // For the function to be invoked asynchronously, the following criteria must be met:
 // * The first input argument should be of type
-// context.Context (https://golang.org/pkg/context/#Context)* The function should return only one value, which should be an error. The caller does not receive a
-// return value from the called function.
+// context.Context (https://golang.org/pkg/context/#Context)* The function should return an error, optionally preceded by a result value. The result value
+// is only available to callers that use task.EnqueueWithResult.
 // * The function should not take variadic arguments as input (support for this is coming soon).
 // * If functions take in an interface, the implementation must be registered on startup.
 //
@@ -63,25 +63,56 @@ var fnLookup = fnRegister{fnNameMap: make(map[string]interface{})}
 type fnSignature struct {
 	FnName string
 	Args   []interface{}
+	// TaskID is set when the caller expects the result to be stored
+	TaskID string
+	// Next holds the remaining steps of a chain, run once this task succeeds
+	Next []fnSignature
+	// Group is set when the task is a member of a group
+	Group *groupState
 }
 
 // Execute executes the function
 func (s *fnSignature) Execute(ctx context.Context) ([]reflect.Value, error) {
 	stats.TaskExecutionCount.Inc(1)
+	fn, ok := fnLookup.getFn(s.FnName)
+	if !ok {
+		return nil, fmt.Errorf("function: %q not found. Did you forget to register?", s.FnName)
+	}
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
 	targetArgs := make([]reflect.Value, 0, len(s.Args)+1)
 	targetArgs = append(targetArgs, reflect.ValueOf(ctx))
-	for _, arg := range s.Args {
+	for i, arg := range s.Args {
+		if arg == nil && i+1 < fnType.NumIn() {
+			// A nil argument, such as the result of a previous chain step, has no type
+			targetArgs = append(targetArgs, reflect.Zero(fnType.In(i+1)))
+			continue
+		}
 		targetArgs = append(targetArgs, reflect.ValueOf(arg))
 	}
-	if fn, ok := fnLookup.getFn(s.FnName); ok {
-		fnValue := reflect.ValueOf(fn)
-		return fnValue.Call(targetArgs), nil
-	}
-	return nil, fmt.Errorf("function: %q not found. Did you forget to register?", s.FnName)
+	return fnValue.Call(targetArgs), nil
 }
 
 // Enqueue sends a func before sending to the task queue
 func Enqueue(fn interface{}, args ...interface{}) error {
+	return enqueue(fn, "", args)
+}
+
+// EnqueueWithResult sends a func to the task queue and returns a Future that
+// can be used to poll or wait for the value returned by the function
+func EnqueueWithResult(fn interface{}, args ...interface{}) (*Future, error) {
+	taskID, err := newTaskID()
+	if err != nil {
+		stats.TaskPublishFail.Inc(1)
+		return nil, err
+	}
+	if err := enqueue(fn, taskID, args); err != nil {
+		return nil, err
+	}
+	return newFuture(taskID, GlobalResultStore(), GlobalBackend().Encoder()), nil
+}
+
+func enqueue(fn interface{}, taskID string, args []interface{}) error {
 	// Is function registered
 	fnName := getFunctionName(fn)
 	_, ok := fnLookup.getFn(fnName)
@@ -97,7 +128,11 @@ func Enqueue(fn interface{}, args ...interface{}) error {
 	}
 	// Publish function to the backend
 	ctx := args[0].(context.Context)
-	s := fnSignature{FnName: fnName, Args: args[1:]}
+	return publish(ctx, fnSignature{FnName: fnName, Args: args[1:], TaskID: taskID})
+}
+
+// publish encodes the signature and sends it to the backend
+func publish(ctx context.Context, s fnSignature) error {
 	sBytes, err := GlobalBackend().Encoder().Marshal(s)
 	if err != nil {
 		stats.TaskPublishFail.Inc(1)
@@ -120,8 +155,15 @@ func Register(fn interface{}) error {
 	if ok {
 		return nil
 	}
+	types := make([]reflect.Type, 0, fnType.NumIn()+1)
 	for i := 0; i < fnType.NumIn(); i++ {
-		argType := fnType.In(i)
+		types = append(types, fnType.In(i))
+	}
+	// The result value is encoded as well when the function returns one
+	if fnType.NumOut() == 2 {
+		types = append(types, fnType.Out(0))
+	}
+	for _, argType := range types {
 		// Interfaces cannot be registered, their implementations should be
 		// https://golang.org/pkg/encoding/gob/#Register
 		if argType.Kind() != reflect.Interface {
@@ -145,21 +187,60 @@ func Run(ctx context.Context, message []byte) error {
 		return errors.Wrap(err, "unable to decode the message")
 	}
 	// TODO (madhu): Do we need a timeout here?
-	retValues, err := s.Execute(ctx)
+	value, hasValue, fnErr, err := execute(ctx, &s)
 	if err != nil {
 		stats.TaskExecuteFail.Inc(1)
 		return err
 	}
-	// Assume only an error will be returned since that is verified before adding to fnRegister
-	return castToError(retValues[0])
+	if s.TaskID != "" {
+		if err := storeResult(ctx, s.TaskID, value, fnErr); err != nil {
+			stats.TaskExecuteFail.Inc(1)
+			return err
+		}
+	}
+	if err := continueWorkflow(ctx, &s, value, hasValue, fnErr); err != nil {
+		stats.TaskExecuteFail.Inc(1)
+		return err
+	}
+	return fnErr
+}
+
+// execute runs the task function and returns its result. A panic in the function is
+// reported and returned as the function error, so it fails the task like any other error.
+func execute(ctx context.Context, s *fnSignature) (value interface{}, hasValue bool, fnErr error, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			stats.TaskPanicCount.Inc(1)
+			value, hasValue = nil, false
+			fnErr = recoveryConfig().ReportPanic(ctx, p, "task", s.FnName)
+		}
+	}()
+	retValues, err := s.Execute(ctx)
+	if err != nil {
+		return nil, false, nil, err
+	}
+	// The error is always the last return value since that is verified before adding to fnRegister
+	fnErr = castToError(retValues[len(retValues)-1])
+	if len(retValues) == 2 {
+		return retValues[0].Interface(), true, fnErr, nil
+	}
+	return nil, false, fnErr, nil
 }
 
 func validateFnAgainstArgs(fnType reflect.Type, args []interface{}) error {
-	if fnType.NumIn() != len(args) {
-		return fmt.Errorf("expected %d function arg(s) but found %d", fnType.NumIn(), len(args))
+	argTypes := make([]reflect.Type, 0, len(args))
+	for _, arg := range args {
+		argTypes = append(argTypes, reflect.TypeOf(arg))
+	}
+	return validateFnAgainstTypes(fnType, argTypes)
+}
+
+func validateFnAgainstTypes(fnType reflect.Type, argTypes []reflect.Type) error {
+	if fnType.NumIn() != len(argTypes) {
+		return fmt.Errorf("expected %d function arg(s) but found %d", fnType.NumIn(), len(argTypes))
 	}
 	for i := 0; i < fnType.NumIn(); i++ {
-		argType := reflect.TypeOf(args[i])
+		argType := argTypes[i]
 		if !argType.AssignableTo(fnType.In(i)) {
 			// TODO(madhu): Is it useful to show the arg index or the arg value in the error msg?
 			return fmt.Errorf(
@@ -171,7 +252,8 @@ func validateFnAgainstArgs(fnType reflect.Type, args []interface{}) error {
 	return nil
 }
 
-// validateFnFormat verifies that the type is a function type that returns only an error
+// validateFnFormat verifies that the type is a function type that returns an error,
+// optionally preceded by a result value
 func validateFnFormat(fnType reflect.Type) error {
 	if fnType.Kind() != reflect.Func {
 		return fmt.Errorf("expected a func as input but was %s", fnType.Kind())
@@ -185,14 +267,15 @@ func validateFnFormat(fnType reflect.Type) error {
 	if !isContext(fnType.In(0)) {
 		return fmt.Errorf("expected first argument to be context.Context but found %s", fnType.In(0))
 	}
-	if fnType.NumOut() != 1 {
+	if fnType.NumOut() < 1 || fnType.NumOut() > 2 {
 		return fmt.Errorf(
-			"expected function to return only error but found %d return values", fnType.NumOut(),
+			"expected function to return error or a value and error but found %d return values",
+			fnType.NumOut(),
 		)
 	}
-	if !isError(fnType.Out(0)) {
+	if errType := fnType.Out(fnType.NumOut() - 1); !isError(errType) {
 		return fmt.Errorf(
-			"expected function to return error but found %d", fnType.Out(0).Kind(),
+			"expected function to return error but found %d", errType.Kind(),
 		)
 	}
 	return nil
@@ -23,13 +23,16 @@ package task
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/service"
 )
 
@@ -68,10 +71,17 @@ func TestRegisterWithFirstArgumentNotContext(t *testing.T) {
 }
 
 func TestRegisterWithMultipleReturnValues(t *testing.T) {
-	fn := func(ctx context.Context) (string, error) { return "", nil }
+	fn := func(ctx context.Context) (string, string, error) { return "", "", nil }
 	err := Register(fn)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "return only error but found")
+	assert.Contains(t, err.Error(), "return error or a value and error but found 3")
+}
+
+func TestRegisterWithValueNotFollowedByError(t *testing.T) {
+	fn := func(ctx context.Context) (error, string) { return nil, "" }
+	err := Register(fn)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "return error but found")
 }
 
 func TestRegisterFnDoesNotReturnError(t *testing.T) {
@@ -165,12 +175,15 @@ func TestEnqueueSimpleFn(t *testing.T) {
 	assert.Contains(t, err.Error(), "Simple error")
 
 	snapshot := _testScope.(tally.TestScope).Snapshot()
+	execution := func(name string) string {
+		return tally.KeyForPrefixedStringMap(name, map[string]string{"module": "task", "type": "execution"})
+	}
 	timers := snapshot.Timers()
 	counters := snapshot.Counters()
 
-	assert.True(t, counters["count"].Value() > 0)
-	assert.True(t, counters["fail"].Value() > 0)
-	assert.NotNil(t, timers["time"].Values())
+	assert.True(t, counters[execution("count")].Value() > 0)
+	assert.True(t, counters[execution("fail")].Value() > 0)
+	assert.NotNil(t, timers[execution("time")].Values())
 }
 
 func TestEnqueueMapFn(t *testing.T) {
@@ -220,6 +233,69 @@ func TestEnqueueWithStructFnWithError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEnqueueWithResultFn(t *testing.T) {
+	require.NoError(t, Register(WithResult))
+	future, err := EnqueueWithResult(WithResult, _ctx, Car{Brand: "honda", Year: 2017})
+	require.NoError(t, err)
+	require.NoError(t, <-_errorCh)
+	value, err := future.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "honda 2017", value)
+}
+
+func TestEnqueueWithResultFnError(t *testing.T) {
+	require.NoError(t, Register(WithResult))
+	future, err := EnqueueWithResult(WithResult, _ctx, Car{Brand: "infinity", Year: 2017})
+	require.NoError(t, err)
+	require.Error(t, <-_errorCh)
+	_, err = future.Wait(_ctx, time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Complex error")
+}
+
+func TestEnqueueWithResultErrorOnlyFn(t *testing.T) {
+	require.NoError(t, Register(OnlyContext))
+	future, err := EnqueueWithResult(OnlyContext, _ctx)
+	require.NoError(t, err)
+	require.NoError(t, <-_errorCh)
+	value, err := future.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestEnqueueWithResultWithoutRegister(t *testing.T) {
+	fn := func(ctx context.Context) (int, error) { return 0, nil }
+	future, err := EnqueueWithResult(fn, _ctx)
+	require.Error(t, err)
+	assert.Nil(t, future)
+}
+
+func TestEnqueuePanicFn(t *testing.T) {
+	require.NoError(t, Register(Panicking))
+	future, err := EnqueueWithResult(Panicking, _ctx)
+	require.NoError(t, err)
+	assert.Equal(t, modules.ErrInternal, <-_errorCh)
+	_, err = future.Wait(_ctx, time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "internal error")
+
+	var panics int64
+	for _, c := range _testScope.(tally.TestScope).Snapshot().Counters() {
+		if c.Name() == "panic" {
+			panics += c.Value()
+		}
+	}
+	assert.True(t, panics > 0)
+}
+
+func TestExecutePanicPropagate(t *testing.T) {
+	defer setRecoveryConfig(recoveryConfig())
+	setRecoveryConfig(modules.RecoveryConfig{Policy: modules.RecoveryPropagate})
+	require.NoError(t, Register(Panicking))
+	s := fnSignature{FnName: getFunctionName(Panicking)}
+	assert.Panics(t, func() { execute(_ctx, &s) })
+}
+
 func OnlyContext(ctx context.Context) error {
 	return nil
 }
@@ -240,6 +316,17 @@ func WithStruct(ctx context.Context, car Car) error {
 	return nil
 }
 
+func Panicking(ctx context.Context) error {
+	panic("task exploded")
+}
+
+func WithResult(ctx context.Context, car Car) (string, error) {
+	if car.Brand == "infinity" {
+		return "", errors.New("Complex error")
+	}
+	return fmt.Sprintf("%s %d", car.Brand, car.Year), nil
+}
+
 func TestCastToError(t *testing.T) {
 	s := make(map[string]string)
 	err := castToError(reflect.ValueOf(s))
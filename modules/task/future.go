@@ -0,0 +1,108 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// How often Wait checks the result store for a result
+const _resultPollInterval = 10 * time.Millisecond
+
+// ErrResultTimeout is returned by Future.Wait when the task does not complete in time
+var ErrResultTimeout = errors.New("timed out waiting for the task result")
+
+// Future is a handle to the result of a task enqueued with EnqueueWithResult
+type Future struct {
+	taskID  string
+	store   ResultStore
+	encoder Encoding
+}
+
+func newFuture(taskID string, store ResultStore, encoder Encoding) *Future {
+	return &Future{
+		taskID:  taskID,
+		store:   store,
+		encoder: encoder,
+	}
+}
+
+// TaskID returns the ID the result is stored under
+func (f *Future) TaskID() string {
+	return f.taskID
+}
+
+// Poll returns the result of the task without blocking. The returned bool is false
+// if the task has not completed yet. Once completed, the error is the one returned
+// by the task function.
+func (f *Future) Poll(ctx context.Context) (interface{}, bool, error) {
+	rBytes, ok, err := f.store.Get(ctx, f.taskID)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "unable to read the task result")
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	var result taskResult
+	if err := f.encoder.Unmarshal(rBytes, &result); err != nil {
+		return nil, false, errors.Wrap(err, "unable to decode the task result")
+	}
+	if result.Failed {
+		return result.Value, true, errors.New(result.Error)
+	}
+	return result.Value, true, nil
+}
+
+// Wait blocks until the task completes and returns its result. ErrResultTimeout is
+// returned if the task does not complete within the timeout.
+func (f *Future) Wait(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(_resultPollInterval)
+	defer ticker.Stop()
+
+	for {
+		value, ok, err := f.Poll(ctx)
+		if ok || err != nil {
+			return value, err
+		}
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			return nil, ErrResultTimeout
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func newTaskID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate the task ID")
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type errResultStore struct{}
+
+func (errResultStore) Put(ctx context.Context, taskID string, result []byte) error {
+	return errors.New("put failed")
+}
+
+func (errResultStore) Get(ctx context.Context, taskID string) ([]byte, bool, error) {
+	return nil, false, errors.New("get failed")
+}
+
+func TestFuturePollNotReady(t *testing.T) {
+	f := newFuture("pending", NewInMemResultStore(), gobEncoding)
+	assert.Equal(t, "pending", f.TaskID())
+	value, ok, err := f.Poll(_ctx)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+}
+
+func TestFuturePollStoreError(t *testing.T) {
+	f := newFuture("id", errResultStore{}, gobEncoding)
+	_, ok, err := f.Poll(_ctx)
+	require.Error(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), "unable to read the task result")
+}
+
+func TestFuturePollDecodeError(t *testing.T) {
+	store := NewInMemResultStore()
+	require.NoError(t, store.Put(_ctx, "id", []byte("garbage")))
+	f := newFuture("id", store, gobEncoding)
+	_, _, err := f.Poll(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to decode the task result")
+}
+
+func TestFutureWaitTimeout(t *testing.T) {
+	f := newFuture("pending", NewInMemResultStore(), gobEncoding)
+	_, err := f.Wait(_ctx, 3*_resultPollInterval)
+	assert.Equal(t, ErrResultTimeout, err)
+}
+
+func TestFutureWaitContextCanceled(t *testing.T) {
+	f := newFuture("pending", NewInMemResultStore(), gobEncoding)
+	ctx, cancel := context.WithCancel(_ctx)
+	cancel()
+	_, err := f.Wait(ctx, time.Second)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestFutureWaitForResult(t *testing.T) {
+	store := NewInMemResultStore()
+	f := newFuture("id", store, gobEncoding)
+	go func() {
+		time.Sleep(2 * _resultPollInterval)
+		rBytes, err := gobEncoding.Marshal(taskResult{Value: "done"})
+		if err == nil {
+			store.Put(_ctx, "id", rBytes)
+		}
+	}()
+	value, err := f.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "done", value)
+}
+
+func TestNewTaskID(t *testing.T) {
+	id1, err := newTaskID()
+	require.NoError(t, err)
+	id2, err := newTaskID()
+	require.NoError(t, err)
+	assert.Len(t, id1, 32)
+	assert.NotEqual(t, id1, id2)
+}
@@ -48,6 +48,8 @@ var (
 	TaskExecutionTime tally.Timer
 	// TaskPublishTime is a publish time for tasks
 	TaskPublishTime tally.Timer
+	// TaskPanicCount counts panics recovered in task functions
+	TaskPanicCount tally.Counter
 )
 
 // SetupTaskMetrics allocates counters for necessary setup
@@ -60,4 +62,5 @@ func SetupTaskMetrics(scope tally.Scope) {
 
 	TaskExecutionTime = taskTagsScope.Tagged(map[string]string{TagType: "execution"}).Timer("time")
 	TaskPublishTime = taskTagsScope.Tagged(map[string]string{TagType: "publish"}).Timer("time")
+	TaskPanicCount = taskTagsScope.Tagged(map[string]string{TagType: "execution"}).Counter("panic")
 }
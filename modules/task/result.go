@@ -0,0 +1,215 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/service"
+
+	"github.com/pkg/errors"
+)
+
+// ResultStore persists the encoded results of tasks so that callers can retrieve them
+type ResultStore interface {
+	// Put stores the encoded result for the task ID
+	Put(ctx context.Context, taskID string, result []byte) error
+	// Get returns the encoded result for the task ID. The returned bool is false
+	// if no result has been stored yet.
+	Get(ctx context.Context, taskID string) ([]byte, bool, error)
+}
+
+// ResultStoreCreateFunc creates a result store implementation
+type ResultStoreCreateFunc func(host service.Host) (ResultStore, error)
+
+var (
+	_globalResultStoreMu sync.RWMutex
+	_globalResultStore   ResultStore = NewInMemResultStore()
+)
+
+// GlobalResultStore returns global instance of the result store
+func GlobalResultStore() ResultStore {
+	_globalResultStoreMu.RLock()
+	defer _globalResultStoreMu.RUnlock()
+	return _globalResultStore
+}
+
+func setGlobalResultStore(store ResultStore) {
+	_globalResultStoreMu.Lock()
+	defer _globalResultStoreMu.Unlock()
+	_globalResultStore = store
+}
+
+// taskResult is the value stored in the result store once a task completes
+type taskResult struct {
+	Value  interface{}
+	Failed bool
+	Error  string
+}
+
+func storeResult(ctx context.Context, taskID string, value interface{}, fnErr error) error {
+	result := taskResult{Value: value}
+	if fnErr != nil {
+		result.Failed = true
+		result.Error = fnErr.Error()
+	}
+	rBytes, err := GlobalBackend().Encoder().Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "unable to encode the task result")
+	}
+	if err := GlobalResultStore().Put(ctx, taskID, rBytes); err != nil {
+		return errors.Wrap(err, "unable to store the task result")
+	}
+	return nil
+}
+
+// How long the in-memory store keeps a result before it is evicted
+const _inMemResultTTL = time.Hour
+
+type inMemResult struct {
+	result  []byte
+	expires time.Time
+}
+
+type inMemResultStore struct {
+	sync.RWMutex
+	results   map[string]inMemResult
+	ttl       time.Duration
+	nextSweep time.Time
+	now       func() time.Time
+}
+
+// NewInMemResultStore creates a result store that keeps results in memory. Results
+// are only visible within the process, so it is meant for tests and in-memory backends.
+// Results are evicted an hour after they are stored.
+func NewInMemResultStore() ResultStore {
+	return &inMemResultStore{
+		results: make(map[string]inMemResult),
+		ttl:     _inMemResultTTL,
+		now:     time.Now,
+	}
+}
+
+func (s *inMemResultStore) Put(ctx context.Context, taskID string, result []byte) error {
+	s.Lock()
+	defer s.Unlock()
+	now := s.now()
+	s.sweep(now)
+	s.results[taskID] = inMemResult{result: result, expires: now.Add(s.ttl)}
+	return nil
+}
+
+func (s *inMemResultStore) Get(ctx context.Context, taskID string) ([]byte, bool, error) {
+	s.RLock()
+	defer s.RUnlock()
+	r, ok := s.results[taskID]
+	if !ok || !s.now().Before(r.expires) {
+		return nil, false, nil
+	}
+	return r.result, true, nil
+}
+
+// sweep evicts the expired results, at most once per TTL so that puts stay cheap
+func (s *inMemResultStore) sweep(now time.Time) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+	for id, r := range s.results {
+		if !now.Before(r.expires) {
+			delete(s.results, id)
+		}
+	}
+	s.nextSweep = now.Add(s.ttl)
+}
+
+type fileResultStore struct {
+	dir string
+}
+
+// NewFileResultStore creates a result store that writes each result to a file in the directory.
+// The directory is created if it does not exist.
+func NewFileResultStore(dir string) (ResultStore, error) {
+	if err := os.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return nil, errors.Wrap(err, "unable to create the result directory")
+	}
+	return &fileResultStore{dir: dir}, nil
+}
+
+func (s *fileResultStore) Put(ctx context.Context, taskID string, result []byte) error {
+	if err := validateTaskID(taskID); err != nil {
+		return err
+	}
+	// Write to a temporary file first so readers never observe a partial result
+	tmp, err := ioutil.TempFile(s.dir, ".tmp-"+taskID)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(result); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(taskID))
+}
+
+func (s *fileResultStore) Get(ctx context.Context, taskID string) ([]byte, bool, error) {
+	if err := validateTaskID(taskID); err != nil {
+		return nil, false, err
+	}
+	result, err := ioutil.ReadFile(s.path(taskID))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+func (s *fileResultStore) path(taskID string) string {
+	return filepath.Join(s.dir, taskID)
+}
+
+// validateTaskID only accepts letters, digits, dashes and underscores, so that an ID
+// decoded from a message cannot name a file outside of the directory
+func validateTaskID(taskID string) error {
+	if taskID == "" {
+		return errors.New("task ID must not be empty")
+	}
+	for _, c := range taskID {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return fmt.Errorf("invalid task ID %q", taskID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemResultStore(t *testing.T) {
+	testResultStore(t, NewInMemResultStore())
+}
+
+func TestInMemResultStoreEviction(t *testing.T) {
+	now := time.Now()
+	store := NewInMemResultStore().(*inMemResultStore)
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Put(_ctx, "old", []byte("result")))
+	now = now.Add(_inMemResultTTL)
+	_, ok, err := store.Get(_ctx, "old")
+	require.NoError(t, err)
+	assert.False(t, ok, "Expired results should not be returned")
+
+	require.NoError(t, store.Put(_ctx, "new", []byte("result")))
+	assert.Len(t, store.results, 1, "Expired results should be evicted")
+	_, ok, err = store.Get(_ctx, "new")
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestFileResultStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "task-results")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileResultStore(filepath.Join(dir, "nested"))
+	require.NoError(t, err)
+	testResultStore(t, store)
+}
+
+func TestFileResultStoreInvalidTaskID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "task-results")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileResultStore(filepath.Join(dir, "nested"))
+	require.NoError(t, err)
+	for _, id := range []string{"", "../escaped", "a/b", ".."} {
+		assert.Error(t, store.Put(_ctx, id, []byte("result")), id)
+		_, _, err := store.Get(_ctx, id)
+		assert.Error(t, err, id)
+	}
+	_, err = os.Stat(filepath.Join(dir, "escaped"))
+	assert.True(t, os.IsNotExist(err), "Results should not be written outside of the directory")
+}
+
+func TestFileResultStoreBadDirectory(t *testing.T) {
+	f, err := ioutil.TempFile("", "task-results")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+
+	_, err = NewFileResultStore(filepath.Join(f.Name(), "nested"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to create the result directory")
+}
+
+func testResultStore(t *testing.T, store ResultStore) {
+	_, ok, err := store.Get(_ctx, "missing")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Put(_ctx, "id", []byte("result")))
+	result, ok, err := store.Get(_ctx, "id")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("result"), result)
+
+	require.NoError(t, store.Put(_ctx, "id", []byte("overwritten")))
+	result, _, err = store.Get(_ctx, "id")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("overwritten"), result)
+}
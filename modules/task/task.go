@@ -27,9 +27,15 @@ import (
 	"go.uber.org/fx/modules/task/internal/stats"
 	"go.uber.org/fx/service"
 
+	"github.com/pkg/errors"
 	"github.com/uber-go/tally"
 )
 
+const (
+	_resultStoreKey = "taskResultStore"
+	_recoveryKey    = "modules.task.recovery"
+)
+
 type globalBackend struct {
 	backend Backend
 	sync.RWMutex
@@ -41,6 +47,9 @@ var (
 	_asyncMod        service.Module
 	_asyncModErr     error
 	_once            sync.Once
+
+	_recoveryMu sync.RWMutex
+	_recovery   modules.RecoveryConfig
 )
 
 // SetupTaskMetrics sets up default counters and timers for task execution
@@ -57,30 +66,63 @@ func GlobalBackend() Backend {
 }
 
 // NewModule creates an async task queue module
-func NewModule(createFunc BackendCreateFunc) service.ModuleCreateFunc {
+func NewModule(createFunc BackendCreateFunc, options ...modules.Option) service.ModuleCreateFunc {
 	return func(mi service.ModuleCreateInfo) ([]service.Module, error) {
-		mod, err := newAsyncModuleSingleton(mi, createFunc)
+		mod, err := newAsyncModuleSingleton(mi, createFunc, options...)
 		return []service.Module{mod}, err
 	}
 }
 
+// WithResultStore sets the store used to save the results of tasks
+// enqueued with EnqueueWithResult
+func WithResultStore(createFunc ResultStoreCreateFunc) modules.Option {
+	return func(mi *service.ModuleCreateInfo) error {
+		if mi.Items == nil {
+			mi.Items = make(map[string]interface{})
+		}
+		mi.Items[_resultStoreKey] = createFunc
+		return nil
+	}
+}
+
 func newAsyncModuleSingleton(
-	mi service.ModuleCreateInfo, createFunc BackendCreateFunc,
+	mi service.ModuleCreateInfo, createFunc BackendCreateFunc, options ...modules.Option,
 ) (service.Module, error) {
 	_once.Do(func() {
-		_asyncMod, _asyncModErr = newAsyncModule(mi, createFunc)
+		_asyncMod, _asyncModErr = newAsyncModule(mi, createFunc, options...)
 	})
 	return _asyncMod, _asyncModErr
 }
 
 func newAsyncModule(
-	mi service.ModuleCreateInfo, createFunc BackendCreateFunc,
+	mi service.ModuleCreateInfo, createFunc BackendCreateFunc, options ...modules.Option,
 ) (service.Module, error) {
+	for _, opt := range options {
+		if err := opt(&mi); err != nil {
+			return nil, errors.Wrap(err, "unable to apply option to task module")
+		}
+	}
 	SetupTaskMetrics(mi.Host.Metrics())
+	var recovery modules.RecoveryConfig
+	if err := mi.Host.Config().Get(_recoveryKey).PopulateStruct(&recovery); err != nil {
+		return nil, errors.Wrap(err, "unable to load the task recovery configuration")
+	}
+	if err := recovery.Validate(); err != nil {
+		return nil, err
+	}
+	setRecoveryConfig(recovery)
 	backend, err := createFunc(mi.Host)
 	if err != nil {
 		return nil, err
 	}
+	if storeFunc, ok := mi.Items[_resultStoreKey]; ok {
+		// Intentionally panic if programmer adds a different type to the data
+		store, err := storeFunc.(ResultStoreCreateFunc)(mi.Host)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create the result store")
+		}
+		setGlobalResultStore(store)
+	}
 	_globalBackendMu.Lock()
 	_globalBackend = backend
 	_globalBackendMu.Unlock()
@@ -90,6 +132,18 @@ func newAsyncModule(
 	}, nil
 }
 
+func recoveryConfig() modules.RecoveryConfig {
+	_recoveryMu.RLock()
+	defer _recoveryMu.RUnlock()
+	return _recovery
+}
+
+func setRecoveryConfig(recovery modules.RecoveryConfig) {
+	_recoveryMu.Lock()
+	defer _recoveryMu.Unlock()
+	_recovery = recovery
+}
+
 // BackendCreateFunc creates a backend implementation
 type BackendCreateFunc func(host service.Host) (Backend, error)
 
@@ -27,6 +27,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"go.uber.org/fx/config"
 	"go.uber.org/fx/service"
 
 	"github.com/stretchr/testify/assert"
@@ -69,6 +70,69 @@ func TestMemBackendModuleWorkflowWithContext(t *testing.T) {
 	require.Error(t, <-errChan)
 }
 
+func TestNewModuleWithResultStore(t *testing.T) {
+	store := NewInMemResultStore()
+	defer restoreGlobals(GlobalBackend(), GlobalResultStore())
+	_, err := newAsyncModule(
+		service.ModuleCreateInfo{Host: service.NopHost()},
+		_nopBackendFn,
+		WithResultStore(func(host service.Host) (ResultStore, error) { return store, nil }),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, store, GlobalResultStore())
+}
+
+func TestNewModuleResultStoreError(t *testing.T) {
+	mod, err := newAsyncModule(
+		_mi,
+		_nopBackendFn,
+		WithResultStore(func(host service.Host) (ResultStore, error) { return nil, errors.New("store err") }),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to create the result store")
+	require.Nil(t, mod)
+}
+
+func TestNewModuleOptionError(t *testing.T) {
+	mod, err := newAsyncModule(_mi, _nopBackendFn, func(_ *service.ModuleCreateInfo) error {
+		return errors.New("bad option")
+	})
+	require.Error(t, err)
+	require.Nil(t, mod)
+}
+
+func TestNewModuleBadRecoveryPolicy(t *testing.T) {
+	cfg := []byte(`
+modules:
+  task:
+    recovery:
+      policy: ignore
+`)
+	mi := service.ModuleCreateInfo{
+		Host: testHost{Host: service.NopHost(), config: config.NewYAMLProviderFromBytes(cfg)},
+	}
+	mod, err := newAsyncModule(mi, _nopBackendFn)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown recovery policy")
+	require.Nil(t, mod)
+}
+
+type testHost struct {
+	service.Host
+	config config.Provider
+}
+
+func (h testHost) Config() config.Provider {
+	return h.config
+}
+
+func restoreGlobals(backend Backend, store ResultStore) {
+	_globalBackendMu.Lock()
+	_globalBackend = backend
+	_globalBackendMu.Unlock()
+	setGlobalResultStore(store)
+}
+
 func createModule(t *testing.T, b BackendCreateFunc) Backend {
 	createFn := NewModule(b)
 	assert.NotNil(t, createFn)
@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.uber.org/fx/modules/task/internal/stats"
+
+	"github.com/pkg/errors"
+)
+
+var _resultsType = reflect.TypeOf([]interface{}{})
+
+// Signature is a call to a registered function that can be composed into a workflow
+type Signature struct {
+	fn   interface{}
+	args []interface{}
+}
+
+// NewSignature creates a Signature for the function. The context.Context is provided
+// when the workflow is enqueued and should not be passed in args.
+func NewSignature(fn interface{}, args ...interface{}) Signature {
+	return Signature{
+		fn:   fn,
+		args: args,
+	}
+}
+
+// build validates the signature against the types of the context and any arguments that
+// are appended at execution time, and returns it with a new task ID
+func (s Signature) build(ctxType reflect.Type, extra ...reflect.Type) (fnSignature, error) {
+	fnName := getFunctionName(s.fn)
+	if _, ok := fnLookup.getFn(fnName); !ok {
+		return fnSignature{}, fmt.Errorf("function: %q not found. Did you forget to register?", fnName)
+	}
+	argTypes := make([]reflect.Type, 0, len(s.args)+len(extra)+1)
+	argTypes = append(argTypes, ctxType)
+	for _, arg := range s.args {
+		argTypes = append(argTypes, reflect.TypeOf(arg))
+	}
+	argTypes = append(argTypes, extra...)
+	if err := validateFnAgainstTypes(reflect.TypeOf(s.fn), argTypes); err != nil {
+		return fnSignature{}, errors.Wrapf(err, "invalid arguments for function: %q", fnName)
+	}
+	taskID, err := newTaskID()
+	if err != nil {
+		return fnSignature{}, err
+	}
+	return fnSignature{FnName: fnName, Args: s.args, TaskID: taskID}, nil
+}
+
+// resultTypes returns the type of the value the function returns, if any
+func (s Signature) resultTypes() []reflect.Type {
+	fnType := reflect.TypeOf(s.fn)
+	if fnType.NumOut() == 2 {
+		return []reflect.Type{fnType.Out(0)}
+	}
+	return nil
+}
+
+// Workflow is a composition of tasks that is enqueued as a unit. The state of a
+// workflow travels with its messages and results, so it is durable as long as the
+// backend and result store are.
+type Workflow interface {
+	// Enqueue sends the first tasks of the workflow to the backend and returns a
+	// Future for the final result of the workflow
+	Enqueue(ctx context.Context) (*Future, error)
+}
+
+type chain struct {
+	steps []Signature
+}
+
+// Chain creates a workflow that runs the tasks one after another. The value returned by
+// each task is passed as the last argument to the next one. The chain stops at the
+// first task that fails, and the Future returns that error.
+func Chain(steps ...Signature) Workflow {
+	return &chain{steps: steps}
+}
+
+func (c *chain) Enqueue(ctx context.Context) (*Future, error) {
+	if len(c.steps) == 0 {
+		stats.TaskPublishFail.Inc(1)
+		return nil, errors.New("chain requires at least one task")
+	}
+	sigs := make([]fnSignature, 0, len(c.steps))
+	var extra []reflect.Type
+	for _, step := range c.steps {
+		s, err := step.build(reflect.TypeOf(ctx), extra...)
+		if err != nil {
+			stats.TaskPublishFail.Inc(1)
+			return nil, err
+		}
+		sigs = append(sigs, s)
+		extra = step.resultTypes()
+	}
+	first := sigs[0]
+	first.Next = sigs[1:]
+	if err := publish(ctx, first); err != nil {
+		return nil, err
+	}
+	return newFuture(sigs[len(sigs)-1].TaskID, GlobalResultStore(), GlobalBackend().Encoder()), nil
+}
+
+type group struct {
+	members  []Signature
+	callback *Signature
+}
+
+// Group creates a workflow that runs the tasks in parallel. The Future returns the
+// values of all the tasks as a []interface{} in the order the tasks were given, or
+// the error of a failed task.
+func Group(members ...Signature) Workflow {
+	return &group{members: members}
+}
+
+// Chord creates a workflow that runs the tasks in parallel and then runs the callback
+// with their values as a []interface{} last argument. The callback is skipped if any
+// of the tasks fail. The Future returns the result of the callback.
+func Chord(members []Signature, callback Signature) Workflow {
+	return &group{members: members, callback: &callback}
+}
+
+func (g *group) Enqueue(ctx context.Context) (*Future, error) {
+	if len(g.members) == 0 {
+		stats.TaskPublishFail.Inc(1)
+		return nil, errors.New("group requires at least one task")
+	}
+	// Group results are collected into a slice that has to be encoded
+	if err := GlobalBackend().Encoder().Register([]interface{}{}); err != nil {
+		return nil, errors.Wrap(err, "unable to register the group results for encoding")
+	}
+	groupID, err := newTaskID()
+	if err != nil {
+		stats.TaskPublishFail.Inc(1)
+		return nil, err
+	}
+	state := &groupState{ID: groupID}
+	resultID := groupID
+	if g.callback != nil {
+		callback, err := g.callback.build(reflect.TypeOf(ctx), _resultsType)
+		if err != nil {
+			stats.TaskPublishFail.Inc(1)
+			return nil, err
+		}
+		state.Callback = &callback
+		resultID = callback.TaskID
+	}
+	sigs := make([]fnSignature, 0, len(g.members))
+	for _, member := range g.members {
+		s, err := member.build(reflect.TypeOf(ctx))
+		if err != nil {
+			stats.TaskPublishFail.Inc(1)
+			return nil, err
+		}
+		s.Group = state
+		state.MemberIDs = append(state.MemberIDs, s.TaskID)
+		sigs = append(sigs, s)
+	}
+	for _, s := range sigs {
+		if err := publish(ctx, s); err != nil {
+			return nil, err
+		}
+	}
+	return newFuture(resultID, GlobalResultStore(), GlobalBackend().Encoder()), nil
+}
+
+// groupState tracks the members of a group and the callback to run once they complete
+type groupState struct {
+	ID        string
+	MemberIDs []string
+	Callback  *fnSignature
+}
+
+// continueWorkflow publishes the next tasks of the workflow the executed task belongs to
+func continueWorkflow(ctx context.Context, s *fnSignature, value interface{}, hasValue bool, fnErr error) error {
+	if len(s.Next) > 0 {
+		if fnErr != nil {
+			// The Future of a chain waits on the last step, so the failure is stored there
+			return storeResult(ctx, s.Next[len(s.Next)-1].TaskID, nil, fnErr)
+		}
+		next := s.Next[0]
+		if hasValue {
+			next.Args = append(next.Args, value)
+		}
+		next.Next = s.Next[1:]
+		return publish(ctx, next)
+	}
+	if s.Group != nil {
+		return completeGroup(ctx, s.Group)
+	}
+	return nil
+}
+
+// completeGroup collects the results of the group members once all of them are
+// stored. Members may complete concurrently on different workers, so the callback
+// is run at least once.
+func completeGroup(ctx context.Context, g *groupState) error {
+	results := make([]interface{}, 0, len(g.MemberIDs))
+	var groupErr error
+	for _, id := range g.MemberIDs {
+		rBytes, ok, err := GlobalResultStore().Get(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, "unable to read the group results")
+		}
+		if !ok {
+			// Other members are still running
+			return nil
+		}
+		var result taskResult
+		if err := GlobalBackend().Encoder().Unmarshal(rBytes, &result); err != nil {
+			return errors.Wrap(err, "unable to decode the group results")
+		}
+		if result.Failed && groupErr == nil {
+			groupErr = errors.New(result.Error)
+		}
+		results = append(results, result.Value)
+	}
+	if g.Callback == nil {
+		return storeResult(ctx, g.ID, results, groupErr)
+	}
+	if groupErr != nil {
+		return storeResult(ctx, g.Callback.TaskID, nil, groupErr)
+	}
+	callback := *g.Callback
+	callback.Args = append(callback.Args, results)
+	return publish(ctx, callback)
+}
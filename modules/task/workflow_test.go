@@ -0,0 +1,231 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package task
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func registerWorkflowFns(t *testing.T) {
+	for _, fn := range []interface{}{AddOne, FailWithInt, Sum, Describe} {
+		require.NoError(t, Register(fn))
+	}
+}
+
+func AddOne(ctx context.Context, n int) (int, error) {
+	return n + 1, nil
+}
+
+func FailWithInt(ctx context.Context, n int) (int, error) {
+	return 0, errors.New("Int error")
+}
+
+func Sum(ctx context.Context, results []interface{}) (int, error) {
+	sum := 0
+	for _, r := range results {
+		sum += r.(int)
+	}
+	return sum, nil
+}
+
+func Describe(ctx context.Context, s string) error {
+	return nil
+}
+
+// withWorkflowBackend runs the test against a fresh in-memory backend and returns
+// the channel the backend reports execution errors on
+func withWorkflowBackend(t *testing.T) (<-chan error, func()) {
+	registerWorkflowFns(t)
+	backend, store := GlobalBackend(), GlobalResultStore()
+	b := NewInMemBackend(service.NopHost())
+	errCh := b.Start(make(chan struct{}))
+	_globalBackendMu.Lock()
+	_globalBackend = b
+	_globalBackendMu.Unlock()
+	return errCh, func() {
+		b.Stop()
+		restoreGlobals(backend, store)
+	}
+}
+
+func drainErrors(t *testing.T, errCh <-chan error, count int) []error {
+	var errs []error
+	for i := 0; i < count; i++ {
+		select {
+		case err := <-errCh:
+			errs = append(errs, err)
+		case <-time.After(time.Second):
+			require.FailNow(t, "timed out waiting for task execution")
+		}
+	}
+	return errs
+}
+
+func TestChain(t *testing.T) {
+	errCh, stop := withWorkflowBackend(t)
+	defer stop()
+	future, err := Chain(
+		NewSignature(AddOne, 1),
+		NewSignature(AddOne),
+		NewSignature(AddOne),
+	).Enqueue(_ctx)
+	require.NoError(t, err)
+	for _, err := range drainErrors(t, errCh, 3) {
+		require.NoError(t, err)
+	}
+	value, err := future.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 4, value)
+}
+
+type chainUser struct {
+	Name string
+}
+
+func DescribeUser(ctx context.Context, u *chainUser) (string, error) {
+	if u == nil {
+		return "anonymous", nil
+	}
+	return u.Name, nil
+}
+
+func TestChainNilPreviousResult(t *testing.T) {
+	require.NoError(t, Register(DescribeUser))
+	fnName := getFunctionName(DescribeUser)
+	s := fnSignature{FnName: fnName, Args: []interface{}{nil}}
+	var results []reflect.Value
+	require.NotPanics(t, func() {
+		var err error
+		results, err = s.Execute(_ctx)
+		require.NoError(t, err)
+	}, "A nil result of the previous step should be passed as the zero value")
+	require.Len(t, results, 2)
+	assert.Equal(t, "anonymous", results[0].Interface())
+}
+
+func TestChainStopsOnFailure(t *testing.T) {
+	errCh, stop := withWorkflowBackend(t)
+	defer stop()
+	future, err := Chain(
+		NewSignature(AddOne, 1),
+		NewSignature(FailWithInt),
+		NewSignature(AddOne),
+	).Enqueue(_ctx)
+	require.NoError(t, err)
+	errs := drainErrors(t, errCh, 2)
+	require.NoError(t, errs[0])
+	require.Error(t, errs[1])
+	_, err = future.Wait(_ctx, time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Int error")
+}
+
+func TestChainValidation(t *testing.T) {
+	registerWorkflowFns(t)
+	_, err := Chain().Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one task")
+
+	_, err = Chain(NewSignature(AddOne, 1), NewSignature(Describe)).Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "from type: int to type: string")
+
+	fn := func(ctx context.Context) error { return nil }
+	_, err = Chain(NewSignature(fn)).Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestGroup(t *testing.T) {
+	errCh, stop := withWorkflowBackend(t)
+	defer stop()
+	future, err := Group(NewSignature(AddOne, 1), NewSignature(AddOne, 2)).Enqueue(_ctx)
+	require.NoError(t, err)
+	for _, err := range drainErrors(t, errCh, 2) {
+		require.NoError(t, err)
+	}
+	value, err := future.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{2, 3}, value)
+}
+
+func TestGroupValidation(t *testing.T) {
+	registerWorkflowFns(t)
+	_, err := Group().Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one task")
+
+	_, err = Group(NewSignature(AddOne, "one")).Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "from type: string to type: int")
+}
+
+func TestChord(t *testing.T) {
+	errCh, stop := withWorkflowBackend(t)
+	defer stop()
+	future, err := Chord(
+		[]Signature{NewSignature(AddOne, 1), NewSignature(AddOne, 2)},
+		NewSignature(Sum),
+	).Enqueue(_ctx)
+	require.NoError(t, err)
+	for _, err := range drainErrors(t, errCh, 3) {
+		require.NoError(t, err)
+	}
+	value, err := future.Wait(_ctx, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, 5, value)
+}
+
+func TestChordSkipsCallbackOnFailure(t *testing.T) {
+	errCh, stop := withWorkflowBackend(t)
+	defer stop()
+	future, err := Chord(
+		[]Signature{NewSignature(FailWithInt, 1), NewSignature(AddOne, 2)},
+		NewSignature(Sum),
+	).Enqueue(_ctx)
+	require.NoError(t, err)
+	failed := 0
+	for _, err := range drainErrors(t, errCh, 2) {
+		if err != nil {
+			failed++
+		}
+	}
+	assert.Equal(t, 1, failed)
+	_, err = future.Wait(_ctx, time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Int error")
+}
+
+func TestChordValidation(t *testing.T) {
+	registerWorkflowFns(t)
+	_, err := Chord([]Signature{NewSignature(AddOne, 1)}, NewSignature(AddOne)).Enqueue(_ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "from type: []interface {} to type: int")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"bufio"
+	"context"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+type routeKey struct{}
+
+// withRouteTemplate stores the path template the request was routed to
+func withRouteTemplate(ctx context.Context, template string) context.Context {
+	return context.WithValue(ctx, routeKey{}, template)
+}
+
+// routeTemplate returns the path template of the route serving the request,
+// such as /users/{id}, which unlike the path has a bounded number of values
+func routeTemplate(ctx context.Context) string {
+	template, _ := ctx.Value(routeKey{}).(string)
+	return template
+}
+
+// AccessLogConfig handles config for the access log
+type AccessLogConfig struct {
+	Disabled bool `yaml:"disabled"`
+	// SampleRate is the fraction of requests that are logged, all of them by
+	// default. Server errors are always logged.
+	SampleRate float64 `yaml:"sampleRate"`
+	// Suppress lists the route templates that are never logged, such as /health
+	Suppress []string `yaml:"suppress"`
+	// Headers lists the request headers that are logged. Other headers are left
+	// out so that credentials and other sensitive values stay out of the logs.
+	Headers []string `yaml:"headers"`
+}
+
+// accessLogFilter logs every request once the response has been written
+type accessLogFilter struct {
+	sampleRate float64
+	suppress   map[string]struct{}
+	headers    []string
+	sample     func() float64
+}
+
+func newAccessLogFilter(cfg AccessLogConfig) accessLogFilter {
+	f := accessLogFilter{
+		sampleRate: cfg.SampleRate,
+		suppress:   make(map[string]struct{}, len(cfg.Suppress)),
+		sample:     rand.Float64,
+	}
+	if f.sampleRate <= 0 {
+		f.sampleRate = 1
+	}
+	for _, route := range cfg.Suppress {
+		f.suppress[route] = struct{}{}
+	}
+	for _, header := range cfg.Headers {
+		f.headers = append(f.headers, http.CanonicalHeaderKey(header))
+	}
+	return f
+}
+
+func (f accessLogFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	route := routeTemplate(ctx)
+	if _, ok := f.suppress[route]; ok {
+		next.ServeHTTP(ctx, w, r)
+		return
+	}
+
+	start := time.Now()
+	rw := newResponseWriter(w)
+	defer func() {
+		// Log panics as server errors and leave them to the panic filter
+		p := recover()
+		if p != nil {
+			rw.status = http.StatusInternalServerError
+		}
+		f.log(ctx, r, route, rw, time.Since(start))
+		if p != nil {
+			panic(p)
+		}
+	}()
+	next.ServeHTTP(ctx, rw, r)
+}
+
+func (f accessLogFilter) log(ctx context.Context, r *http.Request, route string, rw *responseWriter, latency time.Duration) {
+	if rw.status < http.StatusInternalServerError && f.sampleRate < 1 && f.sample() >= f.sampleRate {
+		return
+	}
+	// The context-aware logger already carries the trace and span IDs
+	keyVals := []interface{}{
+		"method", r.Method,
+		"route", route,
+		"path", r.URL.Path,
+		"status", rw.status,
+		"bytes", rw.bytes,
+		"latency", latency,
+		"peer", r.RemoteAddr,
+	}
+	for _, header := range f.headers {
+		if value := r.Header.Get(header); value != "" {
+			keyVals = append(keyVals, "header."+header, value)
+		}
+	}
+	fx.Logger(ctx).Info("HTTP request", keyVals...)
+}
+
+// responseWriter records the status and size of the response
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func newResponseWriter(w http.ResponseWriter) *responseWriter {
+	return &responseWriter{ResponseWriter: w, status: http.StatusOK}
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+// Flush lets streaming handlers flush through the recorder
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack lets websocket and other upgraded connections through the recorder
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return hijack(w.ResponseWriter)
+}
+
+// CloseNotify reports when the client goes away
+func (w *responseWriter) CloseNotify() <-chan bool {
+	return closeNotify(w.ResponseWriter)
+}
+
+// Push initiates an HTTP/2 server push
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	return push(w.ResponseWriter, target, opts)
+}
+
+// hijack, closeNotify and push forward the optional interfaces of the
+// http.ResponseWriter that a filter wraps, which would otherwise hide them
+func hijack(w http.ResponseWriter) (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := w.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, http.ErrNotSupported
+}
+
+// closeNotify returns a channel that never fires if the writer can't tell
+func closeNotify(w http.ResponseWriter) <-chan bool {
+	if n, ok := w.(http.CloseNotifier); ok {
+		return n.CloseNotify()
+	}
+	return nil
+}
+
+func push(w http.ResponseWriter, target string, opts *http.PushOptions) error {
+	if p, ok := w.(http.Pusher); ok {
+		return p.Push(target, opts)
+	}
+	return http.ErrNotSupported
+}
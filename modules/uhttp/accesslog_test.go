@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/testutils"
+	"go.uber.org/fx/ulog"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/zap"
+)
+
+func withAccessLog(t *testing.T, cfg AccessLogConfig, handler HandlerFunc, fn func(serve func(*http.Request) *httptest.ResponseRecorder, buf *testutils.TestBuffer)) {
+	testutils.WithInMemoryLogger(t, nil, func(zapLogger zap.Logger, buf *testutils.TestBuffer) {
+		logger := ulog.Builder().SetLogger(zapLogger).Build()
+		host := service.NopHostConfigured(auth.NopClient, logger, opentracing.NoopTracer{})
+		chain := newFilterChainBuilder(host).AddFilters(
+			contextFilter{host},
+			newAccessLogFilter(cfg),
+		).Build(handler)
+		fn(func(r *http.Request) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			chain.ServeHTTP(withRouteTemplate(context.Background(), "/users/{id}"), w, r)
+			return w
+		}, buf)
+	})
+}
+
+func writeBody(status int, body string) HandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	}
+}
+
+func TestAccessLog_Fields(t *testing.T) {
+	cfg := AccessLogConfig{Headers: []string{"x-request-id"}}
+	withAccessLog(t, cfg, writeBody(http.StatusCreated, "created"), func(serve func(*http.Request) *httptest.ResponseRecorder, buf *testutils.TestBuffer) {
+		r := httptest.NewRequest("POST", "http://users/users/42", nil)
+		r.Header.Set("X-Request-Id", "abc")
+		r.Header.Set("Authorization", "secret")
+		assert.Equal(t, http.StatusCreated, serve(r).Code)
+
+		require.Len(t, buf.Lines(), 1)
+		line := buf.Lines()[0]
+		for _, field := range []string{
+			"HTTP request", "POST", "/users/{id}", "/users/42", "201", "7", "latency", r.RemoteAddr, "abc",
+		} {
+			assert.Contains(t, line, field)
+		}
+		assert.NotContains(t, line, "secret", "Headers that are not allowed should not be logged")
+	})
+}
+
+func TestAccessLog_Suppress(t *testing.T) {
+	cfg := AccessLogConfig{Suppress: []string{"/users/{id}"}}
+	withAccessLog(t, cfg, writeBody(http.StatusOK, "ok"), func(serve func(*http.Request) *httptest.ResponseRecorder, buf *testutils.TestBuffer) {
+		assert.Equal(t, http.StatusOK, serve(httptest.NewRequest("GET", "http://users/users/42", nil)).Code)
+		assert.Empty(t, buf.Lines())
+	})
+}
+
+func TestAccessLog_Sampling(t *testing.T) {
+	f := newAccessLogFilter(AccessLogConfig{})
+	assert.Equal(t, 1.0, f.sampleRate, "All requests should be logged by default")
+
+	testutils.WithInMemoryLogger(t, nil, func(zapLogger zap.Logger, buf *testutils.TestBuffer) {
+		logger := ulog.Builder().SetLogger(zapLogger).Build()
+		host := service.NopHostConfigured(auth.NopClient, logger, opentracing.NoopTracer{})
+		f := newAccessLogFilter(AccessLogConfig{SampleRate: 0.5})
+		sample := 0.9
+		f.sample = func() float64 { return sample }
+
+		serve := func(status int) {
+			chain := newFilterChainBuilder(host).AddFilters(contextFilter{host}, f).Build(writeBody(status, ""))
+			chain.ServeHTTP(context.Background(), httptest.NewRecorder(), httptest.NewRequest("GET", "http://users/", nil))
+		}
+		serve(http.StatusOK)
+		assert.Empty(t, buf.Lines(), "Request should be sampled out")
+
+		serve(http.StatusBadGateway)
+		assert.Len(t, buf.Lines(), 1, "Server errors should always be logged")
+
+		sample = 0.1
+		serve(http.StatusOK)
+		assert.Len(t, buf.Lines(), 2)
+	})
+}
+
+func TestAccessLog_Panic(t *testing.T) {
+	panics := func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		panic("oops")
+	}
+	withAccessLog(t, AccessLogConfig{}, panics, func(serve func(*http.Request) *httptest.ResponseRecorder, buf *testutils.TestBuffer) {
+		assert.Panics(t, func() { serve(httptest.NewRequest("GET", "http://users/", nil)) })
+		require.Len(t, buf.Lines(), 1)
+		assert.Contains(t, buf.Lines()[0], "500")
+	})
+}
+
+func TestAccessLog_Module(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    accessLog:
+      disabled: true
+`, registerNothing)
+	for _, f := range m.fcb.filters {
+		_, ok := f.(accessLogFilter)
+		assert.False(t, ok, "Access log should be disabled")
+	}
+
+	withModule(t, registerNothing, nil, nil, false, func(m *Module) {
+		var found bool
+		for _, f := range m.fcb.filters {
+			_, ok := f.(accessLogFilter)
+			found = found || ok
+		}
+		assert.True(t, found, "Access log should be enabled by default")
+	})
+}
+
+func TestRouteTemplate(t *testing.T) {
+	assert.Equal(t, "", routeTemplate(context.Background()))
+	withModule(t, func(service.Host) []RouteHandler {
+		return makeSingleHandler("/users/{id}", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, routeTemplate(ctx))
+		})
+	}, nil, nil, false, func(m *Module) {
+		assert.Equal(t, "/users/{id}", get(t, _defaultHTTPClient, getURL(m)+"/users/42"))
+	})
+}
+
+// upgradableRecorder implements the optional writer interfaces on top of a recorder
+type upgradableRecorder struct {
+	*httptest.ResponseRecorder
+	hijacked bool
+	closed   chan bool
+	pushed   []string
+}
+
+func (r *upgradableRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	r.hijacked = true
+	return nil, nil, nil
+}
+
+func (r *upgradableRecorder) CloseNotify() <-chan bool {
+	return r.closed
+}
+
+func (r *upgradableRecorder) Push(target string, opts *http.PushOptions) error {
+	r.pushed = append(r.pushed, target)
+	return nil
+}
+
+func TestResponseWriter_Interfaces(t *testing.T) {
+	inner := &upgradableRecorder{ResponseRecorder: httptest.NewRecorder(), closed: make(chan bool)}
+	w := newResponseWriter(inner)
+
+	_, _, err := w.Hijack()
+	assert.NoError(t, err)
+	assert.True(t, inner.hijacked)
+	assert.Equal(t, (<-chan bool)(inner.closed), w.CloseNotify())
+	assert.NoError(t, w.Push("/app.js", nil))
+	assert.Equal(t, []string{"/app.js"}, inner.pushed)
+}
+
+func TestResponseWriter_InterfacesNotSupported(t *testing.T) {
+	w := newResponseWriter(httptest.NewRecorder())
+
+	_, _, err := w.Hijack()
+	assert.Equal(t, http.ErrNotSupported, err)
+	assert.Nil(t, w.CloseNotify())
+	assert.Equal(t, http.ErrNotSupported, w.Push("/app.js", nil))
+}
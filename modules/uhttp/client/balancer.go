@@ -0,0 +1,336 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Load balancing policies
+const (
+	RoundRobin   = "roundRobin"
+	LeastPending = "leastPending"
+	PowerOfTwo   = "powerOfTwo"
+)
+
+// Load balancing defaults
+const (
+	defaultRefreshInterval     = 30 * time.Second
+	defaultConsecutiveFailures = 5
+	defaultEjectionDuration    = 30 * time.Second
+	defaultMaxEjectionPercent  = 50
+)
+
+// LoadBalancerConfig handles config for balancing requests across the instances of a service
+type LoadBalancerConfig struct {
+	// Service is the host name in request URLs that is resolved to instances, all
+	// requests are balanced if it is empty. Named clients default to the host of their base URL.
+	Service string `yaml:"service"`
+	// Policy is roundRobin, leastPending or powerOfTwo, defaults to roundRobin
+	Policy string `yaml:"policy"`
+	// RefreshInterval is how often instances are resolved again, defaults to 30s
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+	// Static lists the instances
+	Static []string `yaml:"static"`
+	// DNS is the name whose SRV records list the instances
+	DNS string `yaml:"dns"`
+	// File is the path of a file listing the instances, one per line. It is
+	// watched, so changes are picked up within a second.
+	File string `yaml:"file"`
+	// OutlierDetection ejects failing instances
+	OutlierDetection OutlierDetectionConfig `yaml:"outlierDetection"`
+}
+
+// OutlierDetectionConfig handles config for ejecting instances that keep failing.
+// Errors and 5xx responses count as failures.
+type OutlierDetectionConfig struct {
+	// ConsecutiveFailures ejects an instance, defaults to 5
+	ConsecutiveFailures int `yaml:"consecutiveFailures"`
+	// EjectionDuration is how long an instance is ejected for, defaults to 30s
+	EjectionDuration time.Duration `yaml:"ejectionDuration"`
+	// MaxEjectionPercent caps the percentage of instances ejected at once, defaults to 50
+	MaxEjectionPercent int `yaml:"maxEjectionPercent"`
+}
+
+// Enabled is true if an instance source is set
+func (c LoadBalancerConfig) Enabled() bool {
+	return len(c.Static) > 0 || c.DNS != "" || c.File != ""
+}
+
+// A Balancer is a transport that sends the requests to a service to one of its
+// instances, which are resolved through discovery. Discoveries that are a Watcher
+// are watched until the balancer is closed.
+type Balancer struct {
+	cfg       LoadBalancerConfig
+	discovery Discovery
+	next      http.RoundTripper
+	pick      func(instances []*instance) *instance
+	now       func() time.Time
+	stop      chan struct{}
+	closeOnce sync.Once
+
+	sync.Mutex
+	instances  []*instance
+	refreshed  time.Time
+	refreshing bool
+	// stale is set when the watched discovery changed since the last refresh
+	stale bool
+	// refreshErr is the error of the last refresh, and resolved is signaled
+	// when a refresh finishes
+	refreshErr error
+	resolved   *sync.Cond
+	counter    int
+	rand       *rand.Rand
+}
+
+type instance struct {
+	addr         string
+	pending      int
+	failures     int
+	ejectedUntil time.Time
+}
+
+// NewBalancer creates a balancer sending requests through the next transport
+func NewBalancer(cfg LoadBalancerConfig, discovery Discovery, next http.RoundTripper) (*Balancer, error) {
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+	od := &cfg.OutlierDetection
+	if od.ConsecutiveFailures <= 0 {
+		od.ConsecutiveFailures = defaultConsecutiveFailures
+	}
+	if od.EjectionDuration <= 0 {
+		od.EjectionDuration = defaultEjectionDuration
+	}
+	if od.MaxEjectionPercent <= 0 {
+		od.MaxEjectionPercent = defaultMaxEjectionPercent
+	}
+	b := &Balancer{
+		cfg:       cfg,
+		discovery: discovery,
+		next:      next,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	b.resolved = sync.NewCond(&b.Mutex)
+	switch cfg.Policy {
+	case "", RoundRobin:
+		b.pick = b.roundRobin
+	case LeastPending:
+		b.pick = b.leastPending
+	case PowerOfTwo:
+		b.pick = b.powerOfTwo
+	default:
+		return nil, fmt.Errorf("unknown load balancing policy %q", cfg.Policy)
+	}
+	if w, ok := discovery.(Watcher); ok {
+		go w.Watch(b.stop, b.changed)
+	}
+	return b, nil
+}
+
+// Close stops watching the discovery
+func (b *Balancer) Close() error {
+	b.closeOnce.Do(func() { close(b.stop) })
+	return nil
+}
+
+// changed resolves the instances again once the watched discovery changed. If a
+// refresh is already in flight, the next request resolves them again.
+func (b *Balancer) changed() {
+	b.Lock()
+	b.stale = true
+	b.Unlock()
+	b.refresh()
+}
+
+// RoundTrip sends the request to an instance of the service
+func (b *Balancer) RoundTrip(r *http.Request) (*http.Response, error) {
+	if b.cfg.Service != "" && r.URL.Host != b.cfg.Service {
+		return b.next.RoundTrip(r)
+	}
+	if err := b.refresh(); err != nil {
+		return nil, err
+	}
+	inst, err := b.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	// Round trippers must not modify the request of the caller
+	copied := *r
+	u := *r.URL
+	u.Host = inst.addr
+	copied.URL = &u
+	resp, err := b.next.RoundTrip(&copied)
+	b.release(inst, err != nil || resp.StatusCode >= http.StatusInternalServerError)
+	return resp, err
+}
+
+// refresh resolves the instances again once the refresh interval passed or the
+// watched discovery changed. Instances
+// that are still listed keep their state, and on failure the previous ones stay in use.
+// Until instances are known, requests wait for the refresh in flight instead of failing.
+func (b *Balancer) refresh() error {
+	b.Lock()
+	if b.refreshing && len(b.instances) == 0 {
+		for b.refreshing {
+			b.resolved.Wait()
+		}
+		err := b.refreshErr
+		b.Unlock()
+		return err
+	}
+	if b.refreshing || (len(b.instances) > 0 && !b.stale && b.now().Sub(b.refreshed) < b.cfg.RefreshInterval) {
+		b.Unlock()
+		return nil
+	}
+	b.refreshing = true
+	b.stale = false
+	b.Unlock()
+
+	addrs, err := b.discovery.Instances()
+	if err == nil && len(addrs) == 0 {
+		err = errors.New("discovery returned no instances")
+	}
+
+	b.Lock()
+	defer b.Unlock()
+	defer b.resolved.Broadcast()
+	b.refreshing = false
+	b.refreshed = b.now()
+	b.refreshErr = nil
+	if err != nil {
+		if len(b.instances) > 0 {
+			return nil
+		}
+		b.refreshErr = errors.Wrapf(err, "unable to resolve the instances of %q", b.cfg.Service)
+		return b.refreshErr
+	}
+	current := make(map[string]*instance, len(b.instances))
+	for _, inst := range b.instances {
+		current[inst.addr] = inst
+	}
+	instances := make([]*instance, 0, len(addrs))
+	for _, addr := range addrs {
+		inst, ok := current[addr]
+		if !ok {
+			inst = &instance{addr: addr}
+		}
+		instances = append(instances, inst)
+	}
+	b.instances = instances
+	return nil
+}
+
+// acquire picks an instance among the ones that are not ejected, or among all of
+// them if they all are
+func (b *Balancer) acquire() (*instance, error) {
+	b.Lock()
+	defer b.Unlock()
+	if len(b.instances) == 0 {
+		return nil, fmt.Errorf("no instances of %q are known yet", b.cfg.Service)
+	}
+	now := b.now()
+	available := make([]*instance, 0, len(b.instances))
+	for _, inst := range b.instances {
+		if !now.Before(inst.ejectedUntil) {
+			available = append(available, inst)
+		}
+	}
+	if len(available) == 0 {
+		available = b.instances
+	}
+	inst := b.pick(available)
+	inst.pending++
+	return inst, nil
+}
+
+// release ejects the instance once it failed too many times in a row, unless
+// too many instances are already ejected
+func (b *Balancer) release(inst *instance, failed bool) {
+	b.Lock()
+	defer b.Unlock()
+	inst.pending--
+	if !failed {
+		inst.failures = 0
+		return
+	}
+	inst.failures++
+	od := b.cfg.OutlierDetection
+	if inst.failures < od.ConsecutiveFailures {
+		return
+	}
+	now := b.now()
+	ejected := 0
+	for _, other := range b.instances {
+		if now.Before(other.ejectedUntil) {
+			ejected++
+		}
+	}
+	if (ejected+1)*100 > od.MaxEjectionPercent*len(b.instances) {
+		return
+	}
+	inst.failures = 0
+	inst.ejectedUntil = now.Add(od.EjectionDuration)
+}
+
+func (b *Balancer) roundRobin(instances []*instance) *instance {
+	b.counter++
+	return instances[b.counter%len(instances)]
+}
+
+// leastPending picks the instance waiting for the fewest responses, starting the
+// search at the next instance in turn so ties are spread out
+func (b *Balancer) leastPending(instances []*instance) *instance {
+	b.counter++
+	best := instances[b.counter%len(instances)]
+	for i := 1; i < len(instances); i++ {
+		inst := instances[(b.counter+i)%len(instances)]
+		if inst.pending < best.pending {
+			best = inst
+		}
+	}
+	return best
+}
+
+// powerOfTwo picks the instance waiting for fewer responses out of two random ones
+func (b *Balancer) powerOfTwo(instances []*instance) *instance {
+	if len(instances) == 1 {
+		return instances[0]
+	}
+	i := b.rand.Intn(len(instances))
+	j := b.rand.Intn(len(instances) - 1)
+	if j >= i {
+		j++
+	}
+	if instances[j].pending < instances[i].pending {
+		return instances[j]
+	}
+	return instances[i]
+}
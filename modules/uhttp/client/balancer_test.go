@@ -0,0 +1,320 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTransport records the hosts requests were sent to and fails for some of them
+type recordingTransport struct {
+	sync.Mutex
+	hosts   []string
+	failing map[string]bool
+}
+
+func (tr *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	tr.Lock()
+	defer tr.Unlock()
+	tr.hosts = append(tr.hosts, r.URL.Host)
+	if tr.failing[r.URL.Host] {
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func newTestBalancer(t *testing.T, cfg LoadBalancerConfig, discovery Discovery) (*Balancer, *recordingTransport) {
+	tr := &recordingTransport{failing: map[string]bool{}}
+	b, err := NewBalancer(cfg, discovery, tr)
+	require.NoError(t, err)
+	return b, tr
+}
+
+func roundTrip(t *testing.T, b *Balancer, rawurl string) error {
+	req, err := http.NewRequest(http.MethodGet, rawurl, nil)
+	require.NoError(t, err)
+	_, err = b.RoundTrip(req)
+	return err
+}
+
+func TestBalancer_RoundRobin(t *testing.T) {
+	b, tr := newTestBalancer(t, LoadBalancerConfig{Service: "users"}, StaticDiscovery("a:80", "b:80", "c:80"))
+	for i := 0; i < 6; i++ {
+		require.NoError(t, roundTrip(t, b, "http://users/get"))
+	}
+	require.NoError(t, roundTrip(t, b, "http://trips/get"))
+	assert.Equal(t, []string{"b:80", "c:80", "a:80", "b:80", "c:80", "a:80", "trips"}, tr.hosts,
+		"Only requests to the service should be balanced")
+}
+
+func TestBalancer_LeastPending(t *testing.T) {
+	b, _ := newTestBalancer(t, LoadBalancerConfig{Policy: LeastPending}, StaticDiscovery("a:80", "b:80", "c:80"))
+	require.NoError(t, b.refresh())
+	first, err := b.acquire()
+	require.NoError(t, err)
+	second, err := b.acquire()
+	require.NoError(t, err)
+	third, err := b.acquire()
+	require.NoError(t, err)
+	assert.Len(t, map[string]bool{first.addr: true, second.addr: true, third.addr: true}, 3)
+
+	b.release(second, false)
+	next, err := b.acquire()
+	require.NoError(t, err)
+	assert.Equal(t, second.addr, next.addr)
+}
+
+func TestBalancer_PowerOfTwo(t *testing.T) {
+	b, _ := newTestBalancer(t, LoadBalancerConfig{Policy: PowerOfTwo}, StaticDiscovery("a:80", "b:80"))
+	require.NoError(t, b.refresh())
+	busy, err := b.acquire()
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		inst, err := b.acquire()
+		require.NoError(t, err)
+		assert.NotEqual(t, busy.addr, inst.addr, "The instance with fewer pending requests should win")
+		b.release(inst, false)
+	}
+
+	single, _ := newTestBalancer(t, LoadBalancerConfig{Policy: PowerOfTwo}, StaticDiscovery("a:80"))
+	require.NoError(t, roundTrip(t, single, "http://users"))
+}
+
+func TestBalancer_OutlierDetection(t *testing.T) {
+	b, tr := newTestBalancer(t, LoadBalancerConfig{
+		OutlierDetection: OutlierDetectionConfig{ConsecutiveFailures: 2, EjectionDuration: time.Minute},
+	}, StaticDiscovery("a:80", "b:80"))
+	now := time.Unix(0, 0)
+	b.now = func() time.Time { return now }
+	tr.failing["a:80"] = true
+	tr.failing["b:80"] = true
+	for i := 0; i < 4; i++ {
+		roundTrip(t, b, "http://users")
+	}
+	var ejected, healthy []string
+	for _, inst := range b.instances {
+		if now.Before(inst.ejectedUntil) {
+			ejected = append(ejected, inst.addr)
+		} else {
+			healthy = append(healthy, inst.addr)
+		}
+	}
+	require.Len(t, ejected, 1, "At most half of the instances should be ejected")
+
+	tr.hosts = nil
+	delete(tr.failing, healthy[0])
+	for i := 0; i < 3; i++ {
+		require.NoError(t, roundTrip(t, b, "http://users"))
+	}
+	assert.Equal(t, []string{healthy[0], healthy[0], healthy[0]}, tr.hosts, "Ejected instance should not get requests")
+
+	now = now.Add(time.Minute)
+	tr.hosts = nil
+	roundTrip(t, b, "http://users")
+	roundTrip(t, b, "http://users")
+	assert.Contains(t, tr.hosts, ejected[0], "Instance should come back once the ejection ends")
+}
+
+func TestBalancer_AllEjected(t *testing.T) {
+	b, _ := newTestBalancer(t, LoadBalancerConfig{}, StaticDiscovery("a:80"))
+	require.NoError(t, b.refresh())
+	b.instances[0].ejectedUntil = time.Now().Add(time.Hour)
+	inst, err := b.acquire()
+	require.NoError(t, err)
+	assert.Equal(t, "a:80", inst.addr)
+}
+
+func TestBalancer_Refresh(t *testing.T) {
+	addrs := []string{"a:80", "b:80"}
+	var lookupErr error
+	discovery := DiscoveryFunc(func() ([]string, error) { return addrs, lookupErr })
+	b, _ := newTestBalancer(t, LoadBalancerConfig{RefreshInterval: time.Second}, discovery)
+	now := time.Unix(0, 0)
+	b.now = func() time.Time { return now }
+	require.NoError(t, b.refresh())
+	b.instances[0].failures = 3
+
+	addrs = []string{"a:80", "c:80"}
+	require.NoError(t, b.refresh())
+	assert.Len(t, b.instances, 2, "Instances should not be resolved before the interval")
+
+	now = now.Add(time.Second)
+	require.NoError(t, b.refresh())
+	require.Len(t, b.instances, 2)
+	assert.Equal(t, "c:80", b.instances[1].addr)
+	assert.Equal(t, 3, b.instances[0].failures, "Instances still listed should keep their state")
+
+	now = now.Add(time.Second)
+	lookupErr = errors.New("lookup failed")
+	require.NoError(t, b.refresh(), "Previous instances should stay in use")
+	assert.Len(t, b.instances, 2)
+}
+
+// watchedDiscovery is a Watcher whose changes are triggered by the test
+type watchedDiscovery struct {
+	Discovery
+	watching chan func()
+	stopped  chan struct{}
+}
+
+func (d watchedDiscovery) Watch(stop <-chan struct{}, changed func()) {
+	d.watching <- changed
+	<-stop
+	close(d.stopped)
+}
+
+func TestBalancer_Watch(t *testing.T) {
+	var mu sync.Mutex
+	addrs := []string{"a:80"}
+	discovery := watchedDiscovery{
+		Discovery: DiscoveryFunc(func() ([]string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return addrs, nil
+		}),
+		watching: make(chan func(), 1),
+		stopped:  make(chan struct{}),
+	}
+	b, _ := newTestBalancer(t, LoadBalancerConfig{RefreshInterval: time.Hour}, discovery)
+	changed := <-discovery.watching
+	require.NoError(t, b.refresh())
+
+	mu.Lock()
+	addrs = []string{"b:80"}
+	mu.Unlock()
+	changed()
+	b.Lock()
+	require.Len(t, b.instances, 1)
+	assert.Equal(t, "b:80", b.instances[0].addr, "Instances should be resolved again once changed")
+	b.Unlock()
+
+	require.NoError(t, b.Close())
+	require.NoError(t, b.Close())
+	select {
+	case <-discovery.stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Closing the balancer should stop watching")
+	}
+}
+
+func TestBalancer_WaitsForFirstResolution(t *testing.T) {
+	var (
+		started, release chan struct{}
+		once             *sync.Once
+		addrs            []string
+		lookupErr        error
+	)
+	discovery := DiscoveryFunc(func() ([]string, error) {
+		once.Do(func() { close(started) })
+		<-release
+		return addrs, lookupErr
+	})
+
+	for _, tt := range []struct {
+		addrs []string
+		err   error
+	}{
+		{addrs: []string{"a:80"}},
+		{err: errors.New("lookup failed")},
+	} {
+		started, release, once = make(chan struct{}), make(chan struct{}), &sync.Once{}
+		addrs, lookupErr = tt.addrs, tt.err
+		b, _ := newTestBalancer(t, LoadBalancerConfig{Service: "users"}, discovery)
+
+		first := make(chan error)
+		go func() { first <- roundTrip(t, b, "http://users") }()
+		<-started
+		second := make(chan error)
+		go func() { second <- roundTrip(t, b, "http://users") }()
+		// Give the second request the time to wait for the first resolution
+		time.Sleep(10 * time.Millisecond)
+
+		close(release)
+		if tt.err == nil {
+			assert.NoError(t, <-first)
+			assert.NoError(t, <-second, "Requests should wait for the first resolution")
+		} else {
+			assert.Error(t, <-first)
+			err := <-second
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "lookup failed", "Waiting requests should get the resolution error")
+		}
+	}
+}
+
+func TestBalancer_NoInstances(t *testing.T) {
+	b, _ := newTestBalancer(t, LoadBalancerConfig{Service: "users"}, StaticDiscovery())
+	err := roundTrip(t, b, "http://users")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "discovery returned no instances")
+
+	_, err = b.acquire()
+	assert.Error(t, err)
+}
+
+func TestBalancer_BadPolicy(t *testing.T) {
+	_, err := NewBalancer(LoadBalancerConfig{Policy: "random"}, StaticDiscovery("a:80"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown load balancing policy "random"`)
+}
+
+func TestFactory_LoadBalancer(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Host))
+	}))
+	defer svr.Close()
+	u, err := url.Parse(svr.URL)
+	require.NoError(t, err)
+
+	f := newTestFactory(`
+clients:
+  users:
+    baseURL: http://users/
+    loadBalancer:
+      policy: leastPending
+      static: [` + u.Host + `]
+  bad:
+    loadBalancer:
+      static: [a:80]
+      dns: users.example.com
+`)
+	c, err := f.Client("users")
+	require.NoError(t, err)
+	resp, err := c.Get("get")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	balancer := c.Transport.(executionChain).finalTransport.(*Balancer)
+	assert.Equal(t, "users", balancer.cfg.Service)
+
+	_, err = f.Client("bad")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one of static, dns and file")
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
+)
+
+// CircuitBreakerFilter fails requests fast with a *modules.CircuitOpenError while
+// the circuit of their host is open. Errors and 5xx responses count as failures.
+// State changes are logged and reported with the circuitbreaker.state gauge and
+// circuitbreaker.transition counter, tagged with the destination host.
+func CircuitBreakerFilter(info auth.CreateAuthInfo, cfg modules.CircuitBreakerConfig) Filter {
+	scope := info.Metrics().Tagged(map[string]string{"module": "http", "type": "client"})
+	log := info.Logger()
+	breakers := modules.NewCircuitBreakers(cfg, func(destination string, from, to modules.CircuitState) {
+		log.Warn("Circuit breaker state changed",
+			"destination", destination, "from", from.String(), "to", to.String())
+		modules.ReportCircuitStateChange(scope, destination, to)
+	})
+	return FilterFunc(func(ctx context.Context, req *http.Request, next Executor,
+	) (resp *http.Response, err error) {
+		destination := req.URL.Host
+		done, err := breakers.Acquire(destination)
+		if err != nil {
+			scope.Tagged(map[string]string{"destination": destination}).Counter("circuitbreaker.rejected").Inc(1)
+			return nil, err
+		}
+		resp, err = next.Execute(ctx, req)
+		done(err != nil || resp.StatusCode >= http.StatusInternalServerError)
+		return resp, err
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/fx/modules"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerFilter(t *testing.T) {
+	srv := &statusServer{statuses: []int{500, 500}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	f := CircuitBreakerFilter(fakeAuthInfo{yaml: _testYaml}, modules.CircuitBreakerConfig{
+		FailurePercent: 100,
+		MinRequests:    2,
+	})
+	cl := &http.Client{Transport: newExecutionChain([]Filter{f}, http.DefaultTransport)}
+	for i := 0; i < 2; i++ {
+		resp, err := cl.Get(svr.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+
+	_, err := cl.Get(svr.URL)
+	require.Error(t, err)
+	assert.True(t, modules.IsCircuitOpen(err))
+	assert.Equal(t, 2, srv.attempts(), "Open circuit should fail fast")
+}
+
+func TestRetryFilter_CircuitOpen(t *testing.T) {
+	srv := &statusServer{statuses: []int{503, 503, 503}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	retry, _ := newRetryClient(RetryConfig{MaxAttempts: 3})
+	breaker := CircuitBreakerFilter(fakeAuthInfo{yaml: _testYaml}, modules.CircuitBreakerConfig{
+		FailurePercent: 100,
+		MinRequests:    1,
+	})
+	retry.Transport = newExecutionChain(
+		[]Filter{retry.Transport.(executionChain).filters[0], breaker}, http.DefaultTransport,
+	)
+	_, err := retry.Get(svr.URL)
+	require.Error(t, err)
+	assert.True(t, modules.IsCircuitOpen(err))
+	assert.Equal(t, 1, srv.attempts(), "Rejected requests should not be retried")
+}
@@ -39,7 +39,7 @@ type Executor interface {
 
 // Filter applies filters on client requests and request's context such as
 // adding tracing to the context. Filters must call next.Execute() at most once, calling it twice and more
-// will lead to an undefined behavior
+// will lead to an undefined behavior, unless the response of the previous call was closed as RetryFilter does
 type Filter interface {
 	Apply(ctx context.Context, r *http.Request, next Executor) (resp *http.Response, err error)
 }
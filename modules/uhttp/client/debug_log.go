@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.uber.org/fx/auth"
+
+	"github.com/pkg/errors"
+	"github.com/uber-go/zap"
+)
+
+const (
+	defaultDebugLogMaxBodyBytes = 4096
+	redacted                    = "[REDACTED]"
+)
+
+// Headers that are always redacted
+var defaultRedactHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// DebugLogConfig handles config for logging requests and responses at debug level
+type DebugLogConfig struct {
+	// MaxBodyBytes is the number of body bytes logged, defaults to 4096
+	MaxBodyBytes int `yaml:"maxBodyBytes"`
+	// RedactHeaders are logged as [REDACTED], on top of Authorization,
+	// Proxy-Authorization, Cookie and Set-Cookie
+	RedactHeaders []string `yaml:"redactHeaders"`
+	// RedactPatterns are regular expressions whose matches in bodies are logged as [REDACTED]
+	RedactPatterns []string `yaml:"redactPatterns"`
+}
+
+// DebugLogFilter logs the headers and the beginning of the bodies of requests and
+// responses at debug level. Bodies are only read when debug logging is enabled, and
+// are passed on in full.
+func DebugLogFilter(info auth.CreateAuthInfo, cfg DebugLogConfig) (Filter, error) {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = defaultDebugLogMaxBodyBytes
+	}
+	redactHeaders := make(map[string]bool)
+	for _, h := range append(defaultRedactHeaders, cfg.RedactHeaders...) {
+		redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	var patterns []*regexp.Regexp
+	for _, p := range cfg.RedactPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid redact pattern %q", p)
+		}
+		patterns = append(patterns, re)
+	}
+	d := debugLogger{
+		maxBodyBytes:  cfg.MaxBodyBytes,
+		redactHeaders: redactHeaders,
+		patterns:      patterns,
+	}
+
+	log := info.Logger()
+	return FilterFunc(func(ctx context.Context, req *http.Request, next Executor,
+	) (resp *http.Response, err error) {
+		if !log.Check(zap.DebugLevel, "HTTP client request").OK() {
+			return next.Execute(ctx, req)
+		}
+
+		var body string
+		if req.Body != nil {
+			copied := *req
+			body, copied.Body = d.peek(req.Body)
+			req = &copied
+		}
+		log.Debug("HTTP client request",
+			"method", req.Method,
+			"url", req.URL.String(),
+			"headers", d.headers(req.Header),
+			"body", body,
+		)
+
+		resp, err = next.Execute(ctx, req)
+		if err != nil {
+			log.Debug("HTTP client request failed", "url", req.URL.String(), "error", err)
+			return resp, err
+		}
+		body, resp.Body = d.peek(resp.Body)
+		log.Debug("HTTP client response",
+			"url", req.URL.String(),
+			"status", resp.StatusCode,
+			"headers", d.headers(resp.Header),
+			"body", body,
+		)
+		return resp, nil
+	}), nil
+}
+
+type debugLogger struct {
+	maxBodyBytes  int
+	redactHeaders map[string]bool
+	patterns      []*regexp.Regexp
+}
+
+// peek reads the beginning of the body and returns it redacted, along with a body
+// that reads the whole content again
+func (d debugLogger) peek(body io.ReadCloser) (string, io.ReadCloser) {
+	prefix, err := ioutil.ReadAll(io.LimitReader(body, int64(d.maxBodyBytes)))
+	rest := io.MultiReader(bytes.NewReader(prefix), body)
+	if err != nil {
+		rest = io.MultiReader(bytes.NewReader(prefix), errReader{err})
+	}
+	logged := string(prefix)
+	for _, re := range d.patterns {
+		logged = re.ReplaceAllString(logged, redacted)
+	}
+	return logged, readCloser{Reader: rest, Closer: body}
+}
+
+func (d debugLogger) headers(h http.Header) map[string]string {
+	logged := make(map[string]string, len(h))
+	for k, v := range h {
+		if d.redactHeaders[http.CanonicalHeaderKey(k)] {
+			logged[k] = redacted
+			continue
+		}
+		logged[k] = strings.Join(v, ", ")
+	}
+	return logged
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/testutils"
+	"go.uber.org/fx/ulog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/zap"
+)
+
+type loggingAuthInfo struct {
+	fakeAuthInfo
+	log ulog.Log
+}
+
+func (i loggingAuthInfo) Logger() ulog.Log {
+	return i.log
+}
+
+func withDebugLogClient(t *testing.T, cfg DebugLogConfig, transport http.RoundTripper, fn func(*http.Client, *testutils.TestBuffer)) {
+	testutils.WithInMemoryLogger(t, nil, func(zapLogger zap.Logger, buf *testutils.TestBuffer) {
+		info := loggingAuthInfo{fakeAuthInfo{yaml: _testYaml}, ulog.Builder().SetLogger(zapLogger).Build()}
+		f, err := DebugLogFilter(info, cfg)
+		require.NoError(t, err)
+		fn(&http.Client{Transport: newExecutionChain([]Filter{f}, transport)}, buf)
+	})
+}
+
+func TestDebugLogFilter(t *testing.T) {
+	var received string
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = string(body)
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.Write([]byte(`{"token":"s3cret","name":"response body"}`))
+	}))
+	defer svr.Close()
+
+	cfg := DebugLogConfig{
+		MaxBodyBytes:   30,
+		RedactHeaders:  []string{"x-api-key"},
+		RedactPatterns: []string{`"token":"[^"]*"`},
+	}
+	withDebugLogClient(t, cfg, http.DefaultTransport, func(cl *http.Client, buf *testutils.TestBuffer) {
+		req, err := http.NewRequest(http.MethodPost, svr.URL, strings.NewReader("request body that is longer than the limit"))
+		require.NoError(t, err)
+		req.Header.Set("X-Api-Key", "key")
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("X-Request-Id", "abc")
+		resp, err := cl.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"token":"s3cret","name":"response body"}`, string(body), "Body should be passed on in full")
+		assert.Equal(t, "request body that is longer than the limit", received)
+
+		lines := buf.Lines()
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"body":"request body that is longer th"`)
+		assert.Contains(t, lines[0], `"X-Api-Key":"[REDACTED]"`)
+		assert.Contains(t, lines[0], `"Authorization":"[REDACTED]"`)
+		assert.Contains(t, lines[0], `"X-Request-Id":"abc"`)
+		assert.Contains(t, lines[1], `"status":200`)
+		assert.Contains(t, lines[1], `"Set-Cookie":"[REDACTED]"`)
+		assert.NotContains(t, lines[1], "s3cret")
+		assert.Contains(t, lines[1], "[REDACTED]")
+	})
+}
+
+func TestDebugLogFilter_Error(t *testing.T) {
+	withDebugLogClient(t, DebugLogConfig{}, errTransport{}, func(cl *http.Client, buf *testutils.TestBuffer) {
+		_, err := cl.Get("http://users")
+		require.Error(t, err)
+		lines := buf.Lines()
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[1], "HTTP client request failed")
+	})
+}
+
+func TestDebugLogFilter_Disabled(t *testing.T) {
+	f, err := DebugLogFilter(fakeAuthInfo{yaml: _testYaml}, DebugLogConfig{})
+	require.NoError(t, err)
+	body := ioutil.NopCloser(errReader{errors.New("should not be read")})
+	req, err := http.NewRequest(http.MethodPost, "http://users", body)
+	require.NoError(t, err)
+	_, err = f.Apply(req.Context(), req, newExecutionChain(nil, nopTransport{}))
+	assert.NoError(t, err)
+}
+
+func TestDebugLogFilter_BadPattern(t *testing.T) {
+	_, err := DebugLogFilter(fakeAuthInfo{yaml: _testYaml}, DebugLogConfig{RedactPatterns: []string{"("}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid redact pattern")
+}
+
+func TestDebugLogger_PeekError(t *testing.T) {
+	d := debugLogger{maxBodyBytes: 10}
+	logged, body := d.peek(ioutil.NopCloser(errReader{errors.New("broken")}))
+	assert.Empty(t, logged)
+	_, err := ioutil.ReadAll(body)
+	assert.EqualError(t, err, "broken")
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Discovery resolves the addresses, as host:port, a service can be reached at
+type Discovery interface {
+	Instances() ([]string, error)
+}
+
+// A Watcher is a Discovery that notices when its instances change, so that the
+// balancer resolves them right away instead of at the next refresh
+type Watcher interface {
+	Discovery
+	// Watch calls changed whenever the instances may have changed, until stop is closed
+	Watch(stop <-chan struct{}, changed func())
+}
+
+// How often the instances file is checked for changes
+const fileWatchInterval = time.Second
+
+// DiscoveryFunc is an adaptor to use functions as Discovery
+type DiscoveryFunc func() ([]string, error)
+
+// Instances calls the function
+func (f DiscoveryFunc) Instances() ([]string, error) {
+	return f()
+}
+
+// StaticDiscovery always returns the addresses
+func StaticDiscovery(addrs ...string) Discovery {
+	return DiscoveryFunc(func() ([]string, error) {
+		return addrs, nil
+	})
+}
+
+// DNSDiscovery resolves the SRV records of the name, such as _http._tcp.users.example.com
+func DNSDiscovery(name string) Discovery {
+	return dnsDiscovery{name: name, lookupSRV: net.LookupSRV}
+}
+
+type dnsDiscovery struct {
+	name      string
+	lookupSRV func(service, proto, name string) (string, []*net.SRV, error)
+}
+
+func (d dnsDiscovery) Instances() ([]string, error) {
+	_, records, err := d.lookupSRV("", "", d.name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to look up SRV records of %q", d.name)
+	}
+	addrs := make([]string, 0, len(records))
+	for _, r := range records {
+		addrs = append(addrs, net.JoinHostPort(strings.TrimSuffix(r.Target, "."), strconv.Itoa(int(r.Port))))
+	}
+	return addrs, nil
+}
+
+// FileDiscovery reads the addresses from a file with one address per line, blank
+// lines and lines starting with # are skipped. The file is watched: it is checked
+// every second and the balancer resolves the instances again as soon as it changed.
+func FileDiscovery(path string) Discovery {
+	return &fileDiscovery{path: path, interval: fileWatchInterval}
+}
+
+type fileDiscovery struct {
+	path     string
+	interval time.Duration
+
+	sync.Mutex
+	version fileVersion
+	addrs   []string
+}
+
+// fileVersion tells versions of a file apart by their modification time and size,
+// since modification times can be as coarse as a second
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+func (v fileVersion) equal(other fileVersion) bool {
+	return v.modTime.Equal(other.modTime) && v.size == other.size
+}
+
+func statVersion(path string) (fileVersion, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileVersion{}, err
+	}
+	return fileVersion{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// Watch checks the file at every interval and calls changed once it was created,
+// modified or removed since it was last read or checked
+func (d *fileDiscovery) Watch(stop <-chan struct{}, changed func()) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	d.Lock()
+	last, existed := d.version, d.addrs != nil
+	d.Unlock()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		version, err := statVersion(d.path)
+		exists := err == nil
+		if exists != existed || (exists && !version.equal(last)) {
+			changed()
+		}
+		last, existed = version, exists
+	}
+}
+
+func (d *fileDiscovery) Instances() ([]string, error) {
+	d.Lock()
+	defer d.Unlock()
+	version, err := statVersion(d.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the instances file")
+	}
+	if d.addrs != nil && version.equal(d.version) {
+		return d.addrs, nil
+	}
+	content, err := ioutil.ReadFile(d.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the instances file")
+	}
+	addrs := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addrs = append(addrs, line)
+	}
+	d.addrs = addrs
+	d.version = version
+	return addrs, nil
+}
+
+// discovery creates the discovery of the config, exactly one source must be set
+func (c LoadBalancerConfig) discovery() (Discovery, error) {
+	var sources []Discovery
+	if len(c.Static) > 0 {
+		sources = append(sources, StaticDiscovery(c.Static...))
+	}
+	if c.DNS != "" {
+		sources = append(sources, DNSDiscovery(c.DNS))
+	}
+	if c.File != "" {
+		sources = append(sources, FileDiscovery(c.File))
+	}
+	if len(sources) != 1 {
+		return nil, fmt.Errorf("exactly one of static, dns and file must be set for load balancing, got %d", len(sources))
+	}
+	return sources[0], nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticDiscovery(t *testing.T) {
+	addrs, err := StaticDiscovery("a:80", "b:80").Instances()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:80", "b:80"}, addrs)
+}
+
+func TestDNSDiscovery(t *testing.T) {
+	d := DNSDiscovery("_http._tcp.users").(dnsDiscovery)
+	d.lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		assert.Equal(t, "_http._tcp.users", name)
+		return "", []*net.SRV{
+			{Target: "a.users.", Port: 8080},
+			{Target: "b.users.", Port: 8081},
+		}, nil
+	}
+	addrs, err := d.Instances()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.users:8080", "b.users:8081"}, addrs)
+
+	d.lookupSRV = func(service, proto, name string) (string, []*net.SRV, error) {
+		return "", nil, errors.New("no such host")
+	}
+	_, err = d.Instances()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to look up SRV records")
+}
+
+func TestFileDiscovery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discovery")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "instances")
+
+	d := FileDiscovery(path)
+	_, err = d.Instances()
+	assert.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("# users\na:80\n\n  b:80  \n"), 0600))
+	addrs, err := d.Instances()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a:80", "b:80"}, addrs)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("c:80\n"), 0600))
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(path, future, future))
+	addrs, err = d.Instances()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c:80"}, addrs, "File should be read again once it changed")
+}
+
+func TestFileDiscovery_Watch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discovery")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "instances")
+	require.NoError(t, ioutil.WriteFile(path, []byte("a:80\n"), 0600))
+
+	d := &fileDiscovery{path: path, interval: time.Millisecond}
+	_, err = d.Instances()
+	require.NoError(t, err)
+	changed := make(chan struct{}, 1)
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		d.Watch(stop, func() {
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		})
+		close(stopped)
+	}()
+	waitChanged := func(msg string) {
+		select {
+		case <-changed:
+		case <-time.After(time.Second):
+			assert.Fail(t, msg)
+		}
+	}
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("a:80\nb:80\n"), 0600))
+	waitChanged("Changes to the file should be noticed")
+	require.NoError(t, os.Remove(path))
+	waitChanged("Removing the file should be noticed")
+
+	close(stop)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Watch should return once stopped")
+	}
+}
+
+func TestLoadBalancerConfig_Discovery(t *testing.T) {
+	assert.False(t, LoadBalancerConfig{}.Enabled())
+	_, err := LoadBalancerConfig{}.discovery()
+	assert.Error(t, err)
+
+	d, err := LoadBalancerConfig{DNS: "users"}.discovery()
+	require.NoError(t, err)
+	assert.IsType(t, dnsDiscovery{}, d)
+	d, err = LoadBalancerConfig{File: "instances"}.discovery()
+	require.NoError(t, err)
+	assert.IsType(t, &fileDiscovery{}, d)
+}
@@ -0,0 +1,391 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/service"
+
+	"github.com/pkg/errors"
+	"github.com/uber-go/tally"
+)
+
+// Config key named clients are defined under
+const clientsKey = "clients"
+
+// Resource key the factory of a host is shared under
+const factoryResourceKey = "uhttp.client.factory"
+
+// _factoryMu guards the creation of the factories shared on hosts
+var _factoryMu sync.Mutex
+
+// Default client timeout, same as the one of New
+const defaultClientTimeout = 2 * time.Minute
+
+// ClientConfig handles config for a named client under clients.<name>
+type ClientConfig struct {
+	// BaseURL is what relative request URLs are resolved against
+	BaseURL string `yaml:"baseURL"`
+	// Timeout bounds requests including retries and reading the body, defaults to 2m
+	Timeout time.Duration `yaml:"timeout"`
+	// Headers are added to requests that do not set them
+	Headers map[string]string `yaml:"headers"`
+	// Transport tunes connection pooling, dialing and proxying
+	Transport TransportConfig `yaml:"transport"`
+	// TLS configures the certificates used to verify servers and, for mutual TLS, the client certificate
+	TLS ClientTLSConfig `yaml:"tls"`
+	// LoadBalancer balances requests across the instances of the service
+	LoadBalancer LoadBalancerConfig `yaml:"loadBalancer"`
+	// Filters are the names of the filters applied after tracing and auth, in order
+	Filters []string `yaml:"filters"`
+}
+
+// TransportConfig handles config for the transport of a named client, zero values
+// keep the settings of http.DefaultTransport
+type TransportConfig struct {
+	MaxIdleConns          int           `yaml:"maxIdleConns"`
+	MaxIdleConnsPerHost   int           `yaml:"maxIdleConnsPerHost"`
+	IdleConnTimeout       time.Duration `yaml:"idleConnTimeout"`
+	DialTimeout           time.Duration `yaml:"dialTimeout"`
+	KeepAlive             time.Duration `yaml:"keepAlive"`
+	TLSHandshakeTimeout   time.Duration `yaml:"tlsHandshakeTimeout"`
+	ResponseHeaderTimeout time.Duration `yaml:"responseHeaderTimeout"`
+	ExpectContinueTimeout time.Duration `yaml:"expectContinueTimeout"`
+	DisableKeepAlives     bool          `yaml:"disableKeepAlives"`
+	DisableCompression    bool          `yaml:"disableCompression"`
+	// Proxy is the URL of the proxy requests go through, by default the
+	// HTTP_PROXY and HTTPS_PROXY environment variables are used
+	Proxy string `yaml:"proxy"`
+}
+
+// ClientTLSConfig handles config for calling servers over TLS
+type ClientTLSConfig struct {
+	// CAFile verifies servers instead of the system roots
+	CAFile string `yaml:"caFile"`
+	// CertFile and KeyFile are presented to servers that require mutual TLS
+	CertFile   string `yaml:"certFile"`
+	KeyFile    string `yaml:"keyFile"`
+	ServerName string `yaml:"serverName"`
+}
+
+// FilterFactory creates a filter of a named client. The info reports metrics tagged
+// with the client name and key is the config key of the client, such as clients.users.
+type FilterFactory func(info auth.CreateAuthInfo, key string) (Filter, error)
+
+// Factory builds the named clients defined in the config of the host. Clients are
+// built once and shared, so that they share connections, retry budgets and circuits.
+type Factory struct {
+	info auth.CreateAuthInfo
+
+	sync.Mutex
+	filters map[string]FilterFactory
+	clients map[string]*http.Client
+}
+
+// FactoryFromHost returns the factory of the named clients of the host, with the retry,
+// circuitBreaker, metrics and debugLog filters available to their filter lists. The
+// factory is created on first use and registered on the host, so that every caller
+// gets the same clients and the filters registered by any of them.
+func FactoryFromHost(host service.Host) *Factory {
+	_factoryMu.Lock()
+	defer _factoryMu.Unlock()
+	if f, ok := host.Resources()[factoryResourceKey].(*Factory); ok {
+		return f
+	}
+	f := newFactory(host)
+	host.Resources()[factoryResourceKey] = f
+	return f
+}
+
+func newFactory(info auth.CreateAuthInfo) *Factory {
+	return &Factory{
+		info: info,
+		filters: map[string]FilterFactory{
+			"retry":          retryFilterFactory,
+			"circuitBreaker": circuitBreakerFilterFactory,
+			"metrics":        metricsFilterFactory,
+			"debugLog":       debugLogFilterFactory,
+		},
+		clients: make(map[string]*http.Client),
+	}
+}
+
+// RegisterFilter makes a filter available to the filter lists of clients under the name
+func (f *Factory) RegisterFilter(name string, factory FilterFactory) {
+	f.Lock()
+	defer f.Unlock()
+	f.filters[name] = factory
+}
+
+// Client returns the client defined under clients.<name>
+func (f *Factory) Client(name string) (*http.Client, error) {
+	f.Lock()
+	defer f.Unlock()
+	if c, ok := f.clients[name]; ok {
+		return c, nil
+	}
+
+	key := clientsKey + "." + name
+	value := f.info.Config().Get(key)
+	if !value.HasValue() {
+		return nil, fmt.Errorf("no configuration for client %q under %s", name, key)
+	}
+	var cfg ClientConfig
+	if err := value.PopulateStruct(&cfg); err != nil {
+		return nil, errors.Wrapf(err, "unable to load the configuration of client %q", name)
+	}
+
+	info := namedClientInfo{
+		CreateAuthInfo: f.info,
+		scope:          f.info.Metrics().Tagged(map[string]string{"client": name}),
+	}
+	c, err := f.newClient(info, key, cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create client %q", name)
+	}
+	f.clients[name] = c
+	return c, nil
+}
+
+func (f *Factory) newClient(info auth.CreateAuthInfo, key string, cfg ClientConfig) (*http.Client, error) {
+	transport, err := newTransport(cfg.Transport, cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	defaults, err := defaultsFilter(cfg.BaseURL, cfg.Headers)
+	if err != nil {
+		return nil, err
+	}
+	var final http.RoundTripper = transport
+	if cfg.LoadBalancer.Enabled() {
+		if final, err = newBalancer(cfg.LoadBalancer, cfg.BaseURL, transport); err != nil {
+			return nil, err
+		}
+	}
+	filters := []Filter{defaults, tracingFilter(), authenticationFilter(info)}
+	for _, name := range cfg.Filters {
+		factory, ok := f.filters[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %q", name)
+		}
+		filter, err := factory(info, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create filter %q", name)
+		}
+		filters = append(filters, filter)
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultClientTimeout
+	}
+	return &http.Client{
+		Transport: newExecutionChain(filters, final),
+		Timeout:   timeout,
+	}, nil
+}
+
+// newBalancer creates the balancer of a named client, which balances the requests
+// to the host of the base URL by default
+func newBalancer(cfg LoadBalancerConfig, baseURL string, next http.RoundTripper) (*Balancer, error) {
+	if cfg.Service == "" && baseURL != "" {
+		base, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid base URL")
+		}
+		cfg.Service = base.Host
+	}
+	discovery, err := cfg.discovery()
+	if err != nil {
+		return nil, err
+	}
+	return NewBalancer(cfg, discovery, next)
+}
+
+// newTransport creates a transport with the settings of http.DefaultTransport
+// overridden by the config
+func newTransport(cfg TransportConfig, tlsCfg ClientTLSConfig) (*http.Transport, error) {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		DualStack: true,
+	}
+	if cfg.DialTimeout > 0 {
+		dialer.Timeout = cfg.DialTimeout
+	}
+	if cfg.KeepAlive > 0 {
+		dialer.KeepAlive = cfg.KeepAlive
+	}
+	t := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		ResponseHeaderTimeout: cfg.ResponseHeaderTimeout,
+		DisableKeepAlives:     cfg.DisableKeepAlives,
+		DisableCompression:    cfg.DisableCompression,
+	}
+	if cfg.MaxIdleConns > 0 {
+		t.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+	if cfg.TLSHandshakeTimeout > 0 {
+		t.TLSHandshakeTimeout = cfg.TLSHandshakeTimeout
+	}
+	if cfg.ExpectContinueTimeout > 0 {
+		t.ExpectContinueTimeout = cfg.ExpectContinueTimeout
+	}
+	if cfg.Proxy != "" {
+		proxy, err := url.Parse(cfg.Proxy)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid proxy URL")
+		}
+		t.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig, err := newClientTLSConfig(tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+	t.TLSClientConfig = tlsConfig
+	return t, nil
+}
+
+func newClientTLSConfig(cfg ClientTLSConfig) (*tls.Config, error) {
+	if cfg == (ClientTLSConfig{}) {
+		return nil, nil
+	}
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.CAFile != "" {
+		pem, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read the CA file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", cfg.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load the client certificate")
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// defaultsFilter resolves relative request URLs against the base URL and adds the
+// default headers the request does not set
+func defaultsFilter(baseURL string, headers map[string]string) (FilterFunc, error) {
+	var base *url.URL
+	if baseURL != "" {
+		var err error
+		if base, err = url.Parse(baseURL); err != nil {
+			return nil, errors.Wrap(err, "invalid base URL")
+		}
+	}
+	return func(ctx context.Context, req *http.Request, next Executor,
+	) (resp *http.Response, err error) {
+		if (base == nil || req.URL.IsAbs()) && len(headers) == 0 {
+			return next.Execute(ctx, req)
+		}
+		// Round trippers must not modify the request of the caller
+		copied := *req
+		if base != nil && !req.URL.IsAbs() {
+			copied.URL = base.ResolveReference(req.URL)
+			copied.Host = ""
+		}
+		copied.Header = make(http.Header, len(req.Header)+len(headers))
+		for k, v := range req.Header {
+			copied.Header[k] = v
+		}
+		for k, v := range headers {
+			if copied.Header.Get(k) == "" {
+				copied.Header.Set(k, v)
+			}
+		}
+		return next.Execute(ctx, &copied)
+	}, nil
+}
+
+func retryFilterFactory(info auth.CreateAuthInfo, key string) (Filter, error) {
+	cfg, err := LoadRetryConfig(info.Config(), key+".retry")
+	if err != nil {
+		return nil, err
+	}
+	return RetryFilter(cfg), nil
+}
+
+func circuitBreakerFilterFactory(info auth.CreateAuthInfo, key string) (Filter, error) {
+	var cfg modules.CircuitBreakerConfig
+	if err := info.Config().Get(key + ".circuitBreaker").PopulateStruct(&cfg); err != nil {
+		return nil, errors.Wrap(err, "unable to load the circuit breaker configuration")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return CircuitBreakerFilter(info, cfg), nil
+}
+
+func metricsFilterFactory(info auth.CreateAuthInfo, key string) (Filter, error) {
+	return MetricsFilter(info), nil
+}
+
+func debugLogFilterFactory(info auth.CreateAuthInfo, key string) (Filter, error) {
+	var cfg DebugLogConfig
+	if err := info.Config().Get(key + ".debugLog").PopulateStruct(&cfg); err != nil {
+		return nil, errors.Wrap(err, "unable to load the debug log configuration")
+	}
+	return DebugLogFilter(info, cfg)
+}
+
+// namedClientInfo tags the metrics of a named client with its name
+type namedClientInfo struct {
+	auth.CreateAuthInfo
+	scope tally.Scope
+}
+
+func (i namedClientInfo) Metrics() tally.Scope {
+	return i.scope
+}
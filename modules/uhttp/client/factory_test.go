@@ -0,0 +1,248 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newTestFactory(yaml string) *Factory {
+	return newFactory(fakeAuthInfo{yaml: []byte("name: test\n" + yaml)})
+}
+
+func TestFactory_Client(t *testing.T) {
+	var got *http.Request
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+	}))
+	defer svr.Close()
+
+	f := newTestFactory(`
+clients:
+  users:
+    baseURL: ` + svr.URL + `/api/
+    timeout: 5s
+    headers:
+      X-Team: rides
+      X-Source: default
+    transport:
+      maxIdleConnsPerHost: 7
+      dialTimeout: 1s
+      idleConnTimeout: 10s
+    filters: [retry, circuitBreaker]
+    retry:
+      maxAttempts: 2
+`)
+	c, err := f.Client("users")
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, c.Timeout)
+	chain := c.Transport.(executionChain)
+	assert.Len(t, chain.filters, 5)
+	transport := chain.finalTransport.(*http.Transport)
+	assert.Equal(t, 7, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 10*time.Second, transport.IdleConnTimeout)
+	assert.Equal(t, 100, transport.MaxIdleConns, "Unset values should keep the defaults")
+
+	req, err := http.NewRequest(http.MethodGet, "users/1", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Source", "caller")
+	resp, err := c.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "/api/users/1", got.URL.Path)
+	assert.Equal(t, "rides", got.Header.Get("X-Team"))
+	assert.Equal(t, "caller", got.Header.Get("X-Source"))
+	assert.Empty(t, req.Header.Get("X-Team"), "Request of the caller should not change")
+
+	same, err := f.Client("users")
+	require.NoError(t, err)
+	assert.True(t, c == same, "Clients should be built once")
+}
+
+type configHost struct {
+	service.Host
+	provider config.Provider
+}
+
+func (h configHost) Config() config.Provider {
+	return h.provider
+}
+
+func TestFactoryFromHost(t *testing.T) {
+	newHost := func() service.Host {
+		return configHost{
+			Host:     service.NopHost(),
+			provider: config.NewYAMLProviderFromBytes([]byte("name: test\nclients:\n  users:\n    baseURL: http://users/\n")),
+		}
+	}
+	host := newHost()
+
+	var wg sync.WaitGroup
+	clients := make([]*http.Client, 2)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c, err := FactoryFromHost(host).Client("users")
+			assert.NoError(t, err)
+			clients[i] = c
+		}(i)
+	}
+	wg.Wait()
+	require.NotNil(t, clients[0])
+	assert.True(t, clients[0] == clients[1], "Callers should share the named clients of the host")
+	assert.True(t, FactoryFromHost(host) == FactoryFromHost(host))
+
+	other, err := FactoryFromHost(newHost()).Client("users")
+	require.NoError(t, err)
+	assert.False(t, other == clients[0], "Hosts should not share clients")
+}
+
+func TestFactory_ClientErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{"missing", "", `no configuration for client "users"`},
+		{"bad config", "clients:\n  users:\n    timeout: soon\n", "unable to load the configuration"},
+		{"bad base URL", "clients:\n  users:\n    baseURL: \"%zz\"\n", "invalid base URL"},
+		{"bad proxy", "clients:\n  users:\n    transport:\n      proxy: \"%zz\"\n", "invalid proxy URL"},
+		{"unknown filter", "clients:\n  users:\n    filters: [magic]\n", `unknown filter "magic"`},
+		{"bad filter", "clients:\n  users:\n    filters: [circuitBreaker]\n    circuitBreaker:\n      failurePercent: 200\n", "failurePercent"},
+		{"bad retry", "clients:\n  users:\n    filters: [retry]\n    retry:\n      maxAttempts: many\n", "retry configuration"},
+		{"missing CA", "clients:\n  users:\n    tls:\n      caFile: /nonexistent\n", "unable to read the CA file"},
+		{"missing cert", "clients:\n  users:\n    tls:\n      certFile: /nonexistent\n", "unable to load the client certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestFactory(tt.yaml).Client("users")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestFactory_RegisterFilter(t *testing.T) {
+	svr := startServer()
+	defer svr.Close()
+
+	f := newTestFactory("clients:\n  users:\n    filters: [teapot]\n")
+	var gotKey string
+	f.RegisterFilter("teapot", func(info auth.CreateAuthInfo, key string) (Filter, error) {
+		gotKey = key
+		return FilterFunc(nil), nil
+	})
+	_, err := f.Client("users")
+	require.NoError(t, err)
+	assert.Equal(t, "clients.users", gotKey)
+}
+
+func TestFactory_TLS(t *testing.T) {
+	svr := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer svr.Close()
+
+	dir, err := ioutil.TempDir("", "client-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cert := svr.TLS.Certificates[0]
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(cert.PrivateKey.(*rsa.PrivateKey)),
+	})
+	require.NoError(t, ioutil.WriteFile(caFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+
+	f := newTestFactory(`
+clients:
+  secure:
+    tls:
+      caFile: ` + caFile + `
+      certFile: ` + certFile + `
+      keyFile: ` + keyFile + `
+  insecure:
+    baseURL: ` + svr.URL + `
+`)
+	c, err := f.Client("secure")
+	require.NoError(t, err)
+	transport := c.Transport.(executionChain).finalTransport.(*http.Transport)
+	assert.Len(t, transport.TLSClientConfig.Certificates, 1)
+	resp, err := c.Get(svr.URL)
+	require.NoError(t, err, "Server should be verified with the CA file")
+	resp.Body.Close()
+
+	c, err = f.Client("insecure")
+	require.NoError(t, err)
+	_, err = c.Get(svr.URL)
+	assert.Error(t, err, "Server should not be verified with the system roots")
+}
+
+func TestFactory_MetricsTaggedByClient(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	f := newFactory(scopedAuthInfo{
+		fakeAuthInfo: fakeAuthInfo{yaml: []byte("name: test\nclients:\n  users:\n    filters: [counting]\n")},
+		scope:        scope,
+	})
+	f.RegisterFilter("counting", func(info auth.CreateAuthInfo, key string) (Filter, error) {
+		info.Metrics().Counter("built").Inc(1)
+		return FilterFunc(nil), nil
+	})
+	_, err := f.Client("users")
+	require.NoError(t, err)
+	counters := scope.Snapshot().Counters()
+	require.Len(t, counters, 1)
+	for _, c := range counters {
+		assert.Equal(t, "built", c.Name())
+		assert.Equal(t, map[string]string{"client": "users"}, c.Tags())
+	}
+}
+
+type scopedAuthInfo struct {
+	fakeAuthInfo
+	scope tally.Scope
+}
+
+func (i scopedAuthInfo) Metrics() tally.Scope {
+	return i.scope
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/auth"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// Destination reported for hosts over the limit
+	otherDestination = "other"
+
+	// Number of destination hosts that get their own metrics
+	defaultMaxDestinations = 100
+)
+
+// MetricsFilter reports the client.requests counter tagged with the status class,
+// or error when no response was received, and the client.latency timer. Metrics are
+// tagged with the destination host and method, and with the client name for named clients.
+func MetricsFilter(info auth.CreateAuthInfo) Filter {
+	m := &clientMetrics{
+		scope:           info.Metrics().Tagged(map[string]string{"module": "http", "type": "client"}),
+		maxDestinations: defaultMaxDestinations,
+		destinations:    make(map[string]bool),
+		stats:           make(map[statsKey]*requestStats),
+	}
+	return FilterFunc(func(ctx context.Context, req *http.Request, next Executor,
+	) (resp *http.Response, err error) {
+		rs := m.get(req.URL.Host, req.Method)
+		start := time.Now()
+		resp, err = next.Execute(ctx, req)
+		rs.latency.Record(time.Since(start))
+		status := "error"
+		if err == nil {
+			status = fmt.Sprintf("%dxx", resp.StatusCode/100)
+		}
+		rs.scope.Tagged(map[string]string{"status": status}).Counter("client.requests").Inc(1)
+		return resp, err
+	})
+}
+
+type statsKey struct {
+	destination string
+	method      string
+}
+
+type requestStats struct {
+	scope   tally.Scope
+	latency tally.Timer
+}
+
+// clientMetrics holds the metrics of every destination and method called by a client
+type clientMetrics struct {
+	scope           tally.Scope
+	maxDestinations int
+
+	sync.RWMutex
+	destinations map[string]bool
+	stats        map[statsKey]*requestStats
+}
+
+// get returns the metrics of the destination and method, creating them on first use
+func (m *clientMetrics) get(destination, method string) *requestStats {
+	key := statsKey{destination: destination, method: method}
+	m.RLock()
+	rs, ok := m.stats[key]
+	m.RUnlock()
+	if ok {
+		return rs
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if rs, ok := m.stats[key]; ok {
+		return rs
+	}
+	tagged := key
+	if !m.destinations[destination] {
+		if len(m.destinations) >= m.maxDestinations {
+			tagged.destination = otherDestination
+		} else {
+			m.destinations[destination] = true
+		}
+	}
+	rs, ok = m.stats[tagged]
+	if !ok {
+		scope := m.scope.Tagged(map[string]string{"destination": tagged.destination, "method": method})
+		rs = &requestStats{
+			scope:   scope,
+			latency: scope.Timer("client.latency"),
+		}
+		m.stats[tagged] = rs
+	}
+	// Destinations over the limit are also stored under their own key, so that
+	// their later requests find the shared metrics under the read lock
+	m.stats[key] = rs
+	return rs
+}
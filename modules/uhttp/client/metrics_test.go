@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func counterValue(scope tally.TestScope, name string, tags map[string]string) int64 {
+	for _, c := range scope.Snapshot().Counters() {
+		if c.Name() != name {
+			continue
+		}
+		matched := true
+		for k, v := range tags {
+			if c.Tags()[k] != v {
+				matched = false
+			}
+		}
+		if matched {
+			return c.Value()
+		}
+	}
+	return 0
+}
+
+func TestMetricsFilter(t *testing.T) {
+	srv := &statusServer{statuses: []int{http.StatusOK, http.StatusNotFound}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+	host, err := url.Parse(svr.URL)
+	require.NoError(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	f := MetricsFilter(scopedAuthInfo{fakeAuthInfo: fakeAuthInfo{yaml: _testYaml}, scope: scope})
+	cl := &http.Client{Transport: newExecutionChain([]Filter{f}, http.DefaultTransport)}
+	for i := 0; i < 2; i++ {
+		resp, err := cl.Get(svr.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	errClient := &http.Client{Transport: newExecutionChain([]Filter{f}, &countingErrTransport{})}
+	_, err = errClient.Post("http://users", "text/plain", nil)
+	require.Error(t, err)
+
+	tags := map[string]string{"destination": host.Host, "method": "GET", "module": "http", "type": "client"}
+	tags["status"] = "2xx"
+	assert.Equal(t, int64(1), counterValue(scope, "client.requests", tags))
+	tags["status"] = "4xx"
+	assert.Equal(t, int64(1), counterValue(scope, "client.requests", tags))
+	assert.Equal(t, int64(1), counterValue(scope, "client.requests",
+		map[string]string{"destination": "users", "method": "POST", "status": "error"}))
+
+	timers := 0
+	for _, timer := range scope.Snapshot().Timers() {
+		assert.Equal(t, "client.latency", timer.Name())
+		timers++
+	}
+	assert.Equal(t, 2, timers)
+}
+
+func TestClientMetrics_MaxDestinations(t *testing.T) {
+	m := &clientMetrics{
+		scope:           tally.NoopScope,
+		maxDestinations: 1,
+		destinations:    make(map[string]bool),
+		stats:           make(map[statsKey]*requestStats),
+	}
+	users := m.get("users", "GET")
+	assert.True(t, users == m.get("users", "GET"))
+	assert.False(t, users == m.get("users", "PUT"))
+	other := m.get("trips", "GET")
+	assert.True(t, other == m.get("rides", "GET"), "Hosts over the limit should share metrics")
+	assert.True(t, other == m.stats[statsKey{otherDestination, "GET"}])
+	assert.True(t, other == m.stats[statsKey{"trips", "GET"}],
+		"Hosts over the limit should be found under their own key")
+	assert.Len(t, m.destinations, 1)
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/modules"
+
+	"github.com/pkg/errors"
+)
+
+// Retry defaults
+const (
+	defaultMaxAttempts          = 3
+	defaultInitialBackoff       = 50 * time.Millisecond
+	defaultMaxBackoff           = time.Second
+	defaultIdempotencyKeyHeader = "Idempotency-Key"
+	defaultBudgetMaxTokens      = 10
+	defaultBudgetTokenPercent   = 10
+)
+
+var (
+	// Methods that are safe to send more than once
+	defaultRetryMethods = []string{
+		http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete,
+	}
+	defaultRetryStatuses = []int{
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+	}
+)
+
+// RetryConfig handles config for retrying failed requests
+type RetryConfig struct {
+	// MaxAttempts is the number of attempts including the first one, defaults to 3
+	MaxAttempts int `yaml:"maxAttempts"`
+	// InitialBackoff is the upper bound of the wait before the first retry, defaults to 50ms.
+	// It doubles with every retry up to MaxBackoff, and the actual wait is picked at random
+	// below the bound to spread retries out.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	// MaxBackoff caps the wait between attempts, defaults to 1s
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+	// AttemptTimeout bounds every attempt, the deadline of the request context
+	// bounds all of them together
+	AttemptTimeout time.Duration `yaml:"attemptTimeout"`
+	// Methods lists the idempotent methods that are retried, defaults to
+	// GET, HEAD, OPTIONS, TRACE, PUT and DELETE
+	Methods []string `yaml:"methods"`
+	// IdempotencyKeyHeader makes requests with any method retried when they carry
+	// the header, defaults to Idempotency-Key
+	IdempotencyKeyHeader string `yaml:"idempotencyKeyHeader"`
+	// Statuses lists the response status codes that are retried, defaults to 502, 503 and 504
+	Statuses []int `yaml:"statuses"`
+	// Budget limits retries when most requests fail, to avoid retry storms
+	Budget RetryBudgetConfig `yaml:"budget"`
+}
+
+// RetryBudgetConfig handles config for the retry budget. Every failed attempt takes
+// a token and every successful one gives back a fraction of a token, and retries are
+// only made while more than half of the tokens are left.
+type RetryBudgetConfig struct {
+	// MaxTokens defaults to 10
+	MaxTokens int `yaml:"maxTokens"`
+	// TokenPercent is the percentage of a token given back on success, defaults to 10
+	TokenPercent int `yaml:"tokenPercent"`
+}
+
+// LoadRetryConfig reads the retry config at the key of the provider, such as clients.users.retry
+func LoadRetryConfig(provider config.Provider, key string) (RetryConfig, error) {
+	var cfg RetryConfig
+	if err := provider.Get(key).PopulateStruct(&cfg); err != nil {
+		return cfg, errors.Wrap(err, "unable to load the retry configuration")
+	}
+	return cfg, nil
+}
+
+func (c RetryConfig) withDefaults() RetryConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = defaultInitialBackoff
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = defaultMaxBackoff
+	}
+	if len(c.Methods) == 0 {
+		c.Methods = defaultRetryMethods
+	}
+	if c.IdempotencyKeyHeader == "" {
+		c.IdempotencyKeyHeader = defaultIdempotencyKeyHeader
+	}
+	if len(c.Statuses) == 0 {
+		c.Statuses = defaultRetryStatuses
+	}
+	if c.Budget.MaxTokens <= 0 {
+		c.Budget.MaxTokens = defaultBudgetMaxTokens
+	}
+	if c.Budget.TokenPercent <= 0 {
+		c.Budget.TokenPercent = defaultBudgetTokenPercent
+	}
+	return c
+}
+
+// RetryFilter retries idempotent requests that failed with an error or a retryable
+// status. Requests rejected by an open circuit breaker are not retried, so RetryFilter
+// goes before CircuitBreakerFilter. Requests with a body are only retried if the body can be read again,
+// which is the case for bodies created by http.NewRequest.
+func RetryFilter(cfg RetryConfig) Filter {
+	cfg = cfg.withDefaults()
+	methods := make(map[string]bool, len(cfg.Methods))
+	for _, m := range cfg.Methods {
+		methods[m] = true
+	}
+	statuses := make(map[int]bool, len(cfg.Statuses))
+	for _, s := range cfg.Statuses {
+		statuses[s] = true
+	}
+	return &retryFilter{
+		cfg:      cfg,
+		methods:  methods,
+		statuses: statuses,
+		budget:   newRetryBudget(cfg.Budget),
+		sleep:    sleep,
+	}
+}
+
+type retryFilter struct {
+	cfg      RetryConfig
+	methods  map[string]bool
+	statuses map[int]bool
+	budget   *retryBudget
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+func (f *retryFilter) Apply(ctx context.Context, r *http.Request, next Executor) (*http.Response, error) {
+	retryable := f.idempotent(r) && (r.Body == nil || r.GetBody != nil)
+	for attempt := 1; ; attempt++ {
+		resp, err := f.attempt(ctx, r, next)
+		if modules.IsCircuitOpen(err) {
+			return nil, err
+		}
+		failed := err != nil || f.statuses[resp.StatusCode]
+		f.budget.record(failed)
+		if !failed || !retryable || attempt >= f.cfg.MaxAttempts || ctx.Err() != nil || !f.budget.allow() {
+			return resp, err
+		}
+
+		backoff := f.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(backoff).After(deadline) {
+			// The next attempt could not complete before the request deadline
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if err := f.sleep(ctx, backoff); err != nil {
+			return nil, err
+		}
+		if r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, errors.Wrap(err, "unable to read the request body again for a retry")
+			}
+			copied := *r
+			copied.Body = body
+			r = &copied
+		}
+	}
+}
+
+// attempt executes the request within the attempt timeout. The attempt context is
+// canceled once the response body is closed, so the body can be read in full.
+func (f *retryFilter) attempt(ctx context.Context, r *http.Request, next Executor) (*http.Response, error) {
+	if f.cfg.AttemptTimeout <= 0 {
+		return next.Execute(ctx, r)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, f.cfg.AttemptTimeout)
+	resp, err := next.Execute(attemptCtx, r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (f *retryFilter) idempotent(r *http.Request) bool {
+	return f.methods[r.Method] || r.Header.Get(f.cfg.IdempotencyKeyHeader) != ""
+}
+
+// backoff picks a random wait below the capped exponential bound of the attempt
+func (f *retryFilter) backoff(attempt int) time.Duration {
+	bound := f.cfg.InitialBackoff << uint(attempt-1)
+	if bound > f.cfg.MaxBackoff || bound <= 0 {
+		bound = f.cfg.MaxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(bound))) + 1
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// retryBudget is shared by the requests of a client
+type retryBudget struct {
+	sync.Mutex
+	tokens    float64
+	maxTokens float64
+	refill    float64
+}
+
+func newRetryBudget(cfg RetryBudgetConfig) *retryBudget {
+	return &retryBudget{
+		tokens:    float64(cfg.MaxTokens),
+		maxTokens: float64(cfg.MaxTokens),
+		refill:    float64(cfg.TokenPercent) / 100,
+	}
+}
+
+func (b *retryBudget) record(failed bool) {
+	b.Lock()
+	defer b.Unlock()
+	if failed {
+		b.tokens--
+		if b.tokens < 0 {
+			b.tokens = 0
+		}
+		return
+	}
+	b.tokens += b.refill
+	if b.tokens > b.maxTokens {
+		b.tokens = b.maxTokens
+	}
+}
+
+func (b *retryBudget) allow() bool {
+	b.Lock()
+	defer b.Unlock()
+	return b.tokens > b.maxTokens/2
+}
@@ -0,0 +1,280 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/config"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusServer replies with the statuses in order and records request bodies
+type statusServer struct {
+	sync.Mutex
+	statuses []int
+	bodies   []string
+	delay    time.Duration
+}
+
+func (s *statusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.Lock()
+	attempt := len(s.bodies)
+	s.bodies = append(s.bodies, string(body))
+	status := http.StatusOK
+	if attempt < len(s.statuses) {
+		status = s.statuses[attempt]
+	}
+	s.Unlock()
+	if attempt == 0 && s.delay > 0 {
+		time.Sleep(s.delay)
+	}
+	w.WriteHeader(status)
+	w.Write([]byte("attempt"))
+}
+
+func (s *statusServer) attempts() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.bodies)
+}
+
+func newRetryClient(cfg RetryConfig) (*http.Client, *retryFilter) {
+	f := RetryFilter(cfg).(*retryFilter)
+	f.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return &http.Client{Transport: newExecutionChain([]Filter{f}, http.DefaultTransport)}, f
+}
+
+func TestRetryFilter_RetriesIdempotent(t *testing.T) {
+	srv := &statusServer{statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{})
+	resp, err := cl.Get(svr.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, srv.attempts())
+}
+
+func TestRetryFilter_MaxAttempts(t *testing.T) {
+	srv := &statusServer{statuses: []int{503, 503, 503, 503}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{MaxAttempts: 2})
+	resp, err := cl.Get(svr.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "attempt", string(body))
+	assert.Equal(t, 2, srv.attempts())
+}
+
+func TestRetryFilter_NotRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+	}{
+		{"post", http.MethodPost, []int{503}},
+		{"client error", http.MethodGet, []int{400}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &statusServer{statuses: tt.statuses}
+			svr := httptest.NewServer(srv)
+			defer svr.Close()
+
+			cl, _ := newRetryClient(RetryConfig{})
+			req, err := http.NewRequest(tt.method, svr.URL, strings.NewReader("body"))
+			require.NoError(t, err)
+			resp, err := cl.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, tt.statuses[0], resp.StatusCode)
+			assert.Equal(t, 1, srv.attempts())
+		})
+	}
+}
+
+func TestRetryFilter_IdempotencyKeyReplaysBody(t *testing.T) {
+	srv := &statusServer{statuses: []int{503}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{})
+	req, err := http.NewRequest(http.MethodPost, svr.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	req.Header.Set("Idempotency-Key", "abc")
+	resp, err := cl.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"payload", "payload"}, srv.bodies)
+}
+
+func TestRetryFilter_NetworkError(t *testing.T) {
+	f := RetryFilter(RetryConfig{MaxAttempts: 4}).(*retryFilter)
+	f.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+	transport := &countingErrTransport{}
+	cl := &http.Client{Transport: newExecutionChain([]Filter{f}, transport)}
+	_, err := cl.Get("http://localhost")
+	require.Error(t, err)
+	assert.Equal(t, 4, transport.count)
+}
+
+func TestRetryFilter_Budget(t *testing.T) {
+	srv := &statusServer{statuses: []int{503, 503, 503, 503, 503, 503, 503, 503}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{MaxAttempts: 10, Budget: RetryBudgetConfig{MaxTokens: 4}})
+	resp, err := cl.Get(svr.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	// Retries stop once half of the tokens are spent
+	assert.Equal(t, 2, srv.attempts())
+}
+
+func TestRetryBudget(t *testing.T) {
+	b := newRetryBudget(RetryBudgetConfig{MaxTokens: 2, TokenPercent: 50})
+	assert.True(t, b.allow())
+	b.record(true)
+	assert.False(t, b.allow())
+	b.record(true)
+	b.record(true)
+	assert.False(t, b.allow())
+	b.record(false)
+	b.record(false)
+	assert.False(t, b.allow())
+	b.record(false)
+	assert.True(t, b.allow())
+	for i := 0; i < 10; i++ {
+		b.record(false)
+	}
+	assert.Equal(t, 2.0, b.tokens)
+}
+
+func TestRetryFilter_AttemptTimeout(t *testing.T) {
+	srv := &statusServer{delay: 500 * time.Millisecond}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{AttemptTimeout: 50 * time.Millisecond})
+	resp, err := cl.Get(svr.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "attempt", string(body))
+	assert.Equal(t, 2, srv.attempts())
+}
+
+func TestRetryFilter_StopsAtDeadline(t *testing.T) {
+	srv := &statusServer{statuses: []int{503, 503}}
+	svr := httptest.NewServer(srv)
+	defer svr.Close()
+
+	cl, _ := newRetryClient(RetryConfig{InitialBackoff: time.Hour, MaxBackoff: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+	require.NoError(t, err)
+	resp, err := cl.Do(req.WithContext(ctx))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, srv.attempts())
+}
+
+func TestRetryBackoff(t *testing.T) {
+	f := RetryFilter(RetryConfig{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 25 * time.Millisecond}).(*retryFilter)
+	for i := 0; i < 100; i++ {
+		assert.True(t, f.backoff(1) <= 10*time.Millisecond)
+		assert.True(t, f.backoff(2) <= 20*time.Millisecond)
+		assert.True(t, f.backoff(10) <= 25*time.Millisecond)
+		assert.True(t, f.backoff(100) > 0)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	assert.NoError(t, sleep(context.Background(), time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, sleep(ctx, time.Hour))
+}
+
+func TestLoadRetryConfig(t *testing.T) {
+	provider := config.NewYAMLProviderFromBytes([]byte(`
+clients:
+  users:
+    retry:
+      maxAttempts: 5
+      attemptTimeout: 200ms
+      methods: [GET]
+      statuses: [429, 503]
+      budget:
+        maxTokens: 20
+`))
+	cfg, err := LoadRetryConfig(provider, "clients.users.retry")
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.MaxAttempts)
+	assert.Equal(t, 200*time.Millisecond, cfg.AttemptTimeout)
+	assert.Equal(t, []string{"GET"}, cfg.Methods)
+	assert.Equal(t, []int{429, 503}, cfg.Statuses)
+	assert.Equal(t, 20, cfg.Budget.MaxTokens)
+
+	cfg = cfg.withDefaults()
+	assert.Equal(t, defaultMaxBackoff, cfg.MaxBackoff)
+	assert.Equal(t, defaultBudgetTokenPercent, cfg.Budget.TokenPercent)
+
+	provider = config.NewYAMLProviderFromBytes([]byte(`
+retry:
+  maxAttempts: many
+`))
+	_, err = LoadRetryConfig(provider, "retry")
+	assert.Error(t, err)
+}
+
+type countingErrTransport struct {
+	count int
+}
+
+func (tr *countingErrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tr.count++
+	return nil, errors.New("connection refused")
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/fx"
+)
+
+const (
+	// Responses smaller than this are not compressed unless configured
+	defaultMinCompressSize = 1024
+)
+
+// Content types compressed unless configured, matched as prefixes
+var defaultCompressTypes = []string{
+	"text/",
+	"application/json",
+	"application/javascript",
+	"application/xml",
+}
+
+// CompressionConfig handles config for compressing responses with gzip or deflate
+type CompressionConfig struct {
+	// MinSize is the size in bytes a response must reach to be compressed, defaults to 1024
+	MinSize int `yaml:"minSize"`
+	// ContentTypes lists the prefixes of the content types that are compressed,
+	// defaults to text and the JSON, JavaScript and XML application types
+	ContentTypes []string `yaml:"contentTypes"`
+	// Level is the compression level from 1 to 9, defaults to the gzip default
+	Level int `yaml:"level"`
+}
+
+// compressionFilter compresses responses with the encoding the client accepts
+type compressionFilter struct {
+	cfg CompressionConfig
+}
+
+func newCompressionFilter(cfg CompressionConfig) compressionFilter {
+	if cfg.MinSize <= 0 {
+		cfg.MinSize = defaultMinCompressSize
+	}
+	if len(cfg.ContentTypes) == 0 {
+		cfg.ContentTypes = defaultCompressTypes
+	}
+	if cfg.Level < gzip.BestSpeed || cfg.Level > gzip.BestCompression {
+		cfg.Level = gzip.DefaultCompression
+	}
+	return compressionFilter{cfg: cfg}
+}
+
+func (f compressionFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	w.Header().Add("Vary", "Accept-Encoding")
+	encoding := acceptedEncoding(r.Header.Get("Accept-Encoding"))
+	if encoding == "" || r.Method == http.MethodHead {
+		next.ServeHTTP(ctx, w, r)
+		return
+	}
+	cw := &compressWriter{ResponseWriter: w, cfg: f.cfg, encoding: encoding, status: http.StatusOK}
+	next.ServeHTTP(ctx, cw, r)
+	// Not deferred, so that a panic leaves the unstarted response to the panic filter
+	if err := cw.Close(); err != nil {
+		fx.Logger(ctx).Warn("Unable to finish compressed response", "error", err)
+	}
+}
+
+// acceptedEncoding picks gzip or deflate from the Accept-Encoding header, preferring gzip
+func acceptedEncoding(header string) string {
+	var deflate bool
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if len(fields) > 1 && strings.Replace(strings.TrimSpace(fields[1]), " ", "", -1) == "q=0" {
+			continue
+		}
+		switch name {
+		case "gzip":
+			return "gzip"
+		case "deflate":
+			deflate = true
+		}
+	}
+	if deflate {
+		return "deflate"
+	}
+	return ""
+}
+
+// compressWriter buffers the start of the response until it knows whether it is
+// worth compressing, then either compresses or passes through the rest of it
+type compressWriter struct {
+	http.ResponseWriter
+	cfg      CompressionConfig
+	encoding string
+
+	status  int
+	buf     []byte
+	decided bool
+	writer  io.WriteCloser
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if !w.decided {
+		w.status = status
+	}
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		return w.write(b)
+	}
+	w.buf = append(w.buf, b...)
+	if len(w.buf) >= w.cfg.MinSize {
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) write(b []byte) (int, error) {
+	if w.writer != nil {
+		return w.writer.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// decide starts the response, compressing it if the buffered start is large
+// enough and of a compressible type
+func (w *compressWriter) decide() error {
+	w.decided = true
+	h := w.Header()
+	if h.Get(ContentType) == "" && len(w.buf) > 0 {
+		h.Set(ContentType, http.DetectContentType(w.buf))
+	}
+	if len(w.buf) >= w.cfg.MinSize && h.Get("Content-Encoding") == "" && w.compressible(h.Get(ContentType)) &&
+		w.status != http.StatusNoContent && w.status != http.StatusNotModified {
+		h.Del(ContentLength)
+		h.Set("Content-Encoding", w.encoding)
+		if w.encoding == "gzip" {
+			w.writer, _ = gzip.NewWriterLevel(w.ResponseWriter, w.cfg.Level)
+		} else {
+			w.writer, _ = flate.NewWriter(w.ResponseWriter, w.cfg.Level)
+		}
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	buf := w.buf
+	w.buf = nil
+	_, err := w.write(buf)
+	return err
+}
+
+func (w *compressWriter) compressible(contentType string) bool {
+	for _, prefix := range w.cfg.ContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Flush sends the buffered response, which decides against compression if it is still small
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide()
+	}
+	if f, ok := w.writer.(interface {
+		Flush() error
+	}); ok {
+		f.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack hands the connection over, after which nothing is left to compress
+func (w *compressWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := hijack(w.ResponseWriter)
+	if err == nil {
+		w.decided = true
+		w.buf = nil
+	}
+	return conn, rw, err
+}
+
+// CloseNotify reports when the client goes away
+func (w *compressWriter) CloseNotify() <-chan bool {
+	return closeNotify(w.ResponseWriter)
+}
+
+// Push initiates an HTTP/2 server push
+func (w *compressWriter) Push(target string, opts *http.PushOptions) error {
+	return push(w.ResponseWriter, target, opts)
+}
+
+// Close sends what is left of the response
+func (w *compressWriter) Close() error {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return err
+		}
+	}
+	if w.writer != nil {
+		return w.writer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _largeBody = strings.Repeat("compress me ", 200)
+
+func serveCompressed(f Filter, acceptEncoding string, handler HandlerFunc) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/users", nil)
+	if acceptEncoding != "" {
+		r.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	f.Apply(context.Background(), w, r, handler)
+	return w
+}
+
+func writeChunked(body, contentType string) HandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		if contentType != "" {
+			w.Header().Set(ContentType, contentType)
+		}
+		w.WriteHeader(http.StatusCreated)
+		// Write in pieces to exercise the buffering
+		for i := 0; i < len(body); i += 100 {
+			end := i + 100
+			if end > len(body) {
+				end = len(body)
+			}
+			io.WriteString(w, body[i:end])
+		}
+	}
+}
+
+func TestCompressionFilter_Gzip(t *testing.T) {
+	w := serveCompressed(newCompressionFilter(CompressionConfig{}), "deflate, gzip", writeChunked(_largeBody, ContentTypeJSON))
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+
+	gr, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(gr)
+	require.NoError(t, err)
+	assert.Equal(t, _largeBody, string(body))
+}
+
+func TestCompressionFilter_Deflate(t *testing.T) {
+	w := serveCompressed(newCompressionFilter(CompressionConfig{Level: 9}), "deflate", writeChunked(_largeBody, ""))
+	assert.Equal(t, "deflate", w.Header().Get("Content-Encoding"))
+	assert.Contains(t, w.Header().Get(ContentType), "text/plain", "Content type should be detected")
+
+	body, err := ioutil.ReadAll(flate.NewReader(w.Body))
+	require.NoError(t, err)
+	assert.Equal(t, _largeBody, string(body))
+}
+
+func TestCompressionFilter_Skipped(t *testing.T) {
+	f := newCompressionFilter(CompressionConfig{})
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        HandlerFunc
+	}{
+		{"not accepted", "", writeChunked(_largeBody, ContentTypeJSON)},
+		{"refused", "gzip;q=0, br", writeChunked(_largeBody, ContentTypeJSON)},
+		{"small", "gzip", writeChunked("small", ContentTypeJSON)},
+		{"content type", "gzip", writeChunked(_largeBody, "image/png")},
+		{"already encoded", "gzip", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "br")
+			writeChunked(_largeBody, ContentTypeJSON)(ctx, w, r)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveCompressed(f, tt.acceptEncoding, tt.handler)
+			assert.Equal(t, http.StatusCreated, w.Code)
+			assert.NotEqual(t, "gzip", w.Header().Get("Content-Encoding"))
+			assert.Contains(t, _largeBody+"small", w.Body.String())
+			assert.NotEmpty(t, w.Body.String())
+		})
+	}
+}
+
+func TestCompressionFilter_Flush(t *testing.T) {
+	w := serveCompressed(newCompressionFilter(CompressionConfig{}), "gzip", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "event")
+		w.(http.Flusher).Flush()
+		assert.Equal(t, "event", w.(*compressWriter).ResponseWriter.(*httptest.ResponseRecorder).Body.String(),
+			"Flush should send the small buffered start uncompressed")
+		io.WriteString(w, _largeBody)
+	})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "event"+_largeBody, w.Body.String())
+}
+
+func TestCompressionFilter_Hijack(t *testing.T) {
+	inner := &upgradableRecorder{ResponseRecorder: httptest.NewRecorder(), closed: make(chan bool)}
+	f := newCompressionFilter(CompressionConfig{})
+	r := httptest.NewRequest(http.MethodGet, "/ws", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	f.Apply(context.Background(), inner, r, HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, (<-chan bool)(inner.closed), w.(http.CloseNotifier).CloseNotify())
+		assert.NoError(t, w.(http.Pusher).Push("/app.js", nil))
+		_, _, err := w.(http.Hijacker).Hijack()
+		assert.NoError(t, err)
+	}))
+	assert.True(t, inner.hijacked)
+	assert.Equal(t, []string{"/app.js"}, inner.pushed)
+	assert.False(t, inner.Flushed)
+	assert.Empty(t, inner.Header().Get("Content-Encoding"), "Nothing should be written after hijacking")
+}
+
+func TestAcceptedEncoding(t *testing.T) {
+	assert.Equal(t, "gzip", acceptedEncoding("gzip"))
+	assert.Equal(t, "gzip", acceptedEncoding("deflate, GZIP;q=0.5"))
+	assert.Equal(t, "deflate", acceptedEncoding("br, deflate"))
+	assert.Equal(t, "deflate", acceptedEncoding("gzip; q=0, deflate"))
+	assert.Equal(t, "", acceptedEncoding("identity"))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+// Methods allowed for cross-origin requests unless configured
+var defaultCORSMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost}
+
+// CORSConfig handles config for cross-origin resource sharing
+type CORSConfig struct {
+	// AllowedOrigins lists the origins allowed to make requests, such as
+	// https://example.com. A * allows any origin and https://*.example.com
+	// allows the subdomains of example.com.
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+	// AllowedMethods defaults to GET, HEAD and POST
+	AllowedMethods []string `yaml:"allowedMethods"`
+	// AllowedHeaders lists the request headers allowed beyond the simple ones
+	AllowedHeaders []string `yaml:"allowedHeaders"`
+	// ExposedHeaders lists the response headers readable by the browser
+	ExposedHeaders []string `yaml:"exposedHeaders"`
+	// AllowCredentials allows requests with cookies and other credentials from
+	// the listed origins. It can't be combined with the * origin.
+	AllowCredentials bool `yaml:"allowCredentials"`
+	// MaxAge is how long browsers can cache the result of a preflight request
+	MaxAge time.Duration `yaml:"maxAge"`
+}
+
+// Validate rejects credentials for any origin, which would let every site make
+// authenticated requests on behalf of the user
+func (c CORSConfig) Validate() error {
+	if !c.AllowCredentials {
+		return nil
+	}
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" {
+			return errors.New("cors: allowCredentials can't be combined with the * origin")
+		}
+	}
+	return nil
+}
+
+// corsFilter answers preflight requests and adds the CORS headers to the
+// responses of allowed origins
+type corsFilter struct {
+	cfg     CORSConfig
+	methods string
+	headers map[string]bool
+}
+
+func newCORSFilter(cfg CORSConfig) corsFilter {
+	if len(cfg.AllowedMethods) == 0 {
+		cfg.AllowedMethods = defaultCORSMethods
+	}
+	headers := make(map[string]bool, len(cfg.AllowedHeaders))
+	for _, h := range cfg.AllowedHeaders {
+		headers[http.CanonicalHeaderKey(h)] = true
+	}
+	return corsFilter{
+		cfg:     cfg,
+		methods: strings.Join(cfg.AllowedMethods, ", "),
+		headers: headers,
+	}
+}
+
+func (f corsFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		next.ServeHTTP(ctx, w, r)
+		return
+	}
+	w.Header().Add("Vary", "Origin")
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	allowed, wildcard := f.allowOrigin(origin)
+	if !allowed {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(ctx, w, r)
+		return
+	}
+
+	// The origin is only reflected if it is listed, so that credentials are
+	// never shared with an origin that just matched the wildcard
+	if wildcard {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+	if f.cfg.AllowCredentials && !wildcard {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		if len(f.cfg.ExposedHeaders) > 0 {
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(f.cfg.ExposedHeaders, ", "))
+		}
+		next.ServeHTTP(ctx, w, r)
+		return
+	}
+
+	if !f.allowMethod(r.Header.Get("Access-Control-Request-Method")) || !f.allowHeaders(r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Methods", f.methods)
+	if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+		w.Header().Set("Access-Control-Allow-Headers", requested)
+	}
+	if f.cfg.MaxAge > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(f.cfg.MaxAge/time.Second)))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowOrigin reports whether the origin is allowed, and whether it was only
+// allowed by the * wildcard
+func (f corsFilter) allowOrigin(origin string) (allowed bool, wildcard bool) {
+	for _, pattern := range f.cfg.AllowedOrigins {
+		if pattern == "*" {
+			wildcard = true
+			continue
+		}
+		if strings.EqualFold(pattern, origin) {
+			return true, false
+		}
+		// https://*.example.com matches the subdomains of example.com
+		if i := strings.Index(pattern, "*."); i > 0 {
+			scheme, domain := pattern[:i], pattern[i+1:]
+			if strings.HasPrefix(origin, scheme) && strings.HasSuffix(origin, domain) &&
+				len(origin) > len(scheme)+len(domain) {
+				return true, false
+			}
+		}
+	}
+	return wildcard, wildcard
+}
+
+func (f corsFilter) allowMethod(method string) bool {
+	for _, allowed := range f.cfg.AllowedMethods {
+		if strings.EqualFold(allowed, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f corsFilter) allowHeaders(r *http.Request) bool {
+	for _, h := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		h = strings.TrimSpace(h)
+		if h != "" && !f.headers[http.CanonicalHeaderKey(h)] {
+			return false
+		}
+	}
+	return true
+}
+
+// methodsOrPreflight matches requests with one of the methods, as well as the
+// preflight requests for them, so that routes restricted to some methods still
+// reach the CORS filter instead of the not found handler
+func methodsOrPreflight(methods []string) mux.MatcherFunc {
+	return func(r *http.Request, _ *mux.RouteMatch) bool {
+		method := r.Method
+		if method == http.MethodOptions {
+			if requested := r.Header.Get("Access-Control-Request-Method"); requested != "" {
+				method = requested
+			}
+		}
+		for _, allowed := range methods {
+			if strings.EqualFold(allowed, method) {
+				return true
+			}
+		}
+		return false
+	}
+}
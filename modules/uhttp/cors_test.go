@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func serveFilter(f Filter, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	f.Apply(context.Background(), w, r, HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "served")
+	}))
+	return w
+}
+
+func corsRequest(method, origin string, headers map[string]string) *http.Request {
+	r := httptest.NewRequest(method, "/users", nil)
+	if origin != "" {
+		r.Header.Set("Origin", origin)
+	}
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+	return r
+}
+
+func TestCORSFilter_SimpleRequest(t *testing.T) {
+	f := newCORSFilter(CORSConfig{
+		AllowedOrigins: []string{"https://example.com", "https://*.example.org"},
+		ExposedHeaders: []string{"X-Request-Id"},
+	})
+
+	w := serveFilter(f, corsRequest(http.MethodGet, "https://example.com", nil))
+	assert.Equal(t, "served", w.Body.String())
+	assert.Equal(t, "https://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Request-Id", w.Header().Get("Access-Control-Expose-Headers"))
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+
+	w = serveFilter(f, corsRequest(http.MethodGet, "https://api.example.org", nil))
+	assert.Equal(t, "https://api.example.org", w.Header().Get("Access-Control-Allow-Origin"))
+
+	for _, origin := range []string{"https://evil.com", "https://example.org", "http://api.example.org"} {
+		w = serveFilter(f, corsRequest(http.MethodGet, origin, nil))
+		assert.Equal(t, "served", w.Body.String())
+		assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"), "Origin %s should not be allowed", origin)
+	}
+
+	w = serveFilter(f, corsRequest(http.MethodGet, "", nil))
+	assert.Equal(t, "served", w.Body.String())
+	assert.Empty(t, w.Header().Get("Vary"))
+}
+
+func TestCORSFilter_AnyOrigin(t *testing.T) {
+	w := serveFilter(newCORSFilter(CORSConfig{AllowedOrigins: []string{"*"}}),
+		corsRequest(http.MethodGet, "https://example.com", nil))
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+
+	f := newCORSFilter(CORSConfig{AllowedOrigins: []string{"https://example.com", "*"}, AllowCredentials: true})
+	w = serveFilter(f, corsRequest(http.MethodGet, "https://example.com", nil))
+	assert.Equal(t, "https://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+
+	w = serveFilter(f, corsRequest(http.MethodGet, "https://evil.com", nil))
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"),
+		"Origins matching the wildcard should not be reflected")
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestCORSConfig_Validate(t *testing.T) {
+	assert.NoError(t, CORSConfig{AllowedOrigins: []string{"*"}}.Validate())
+	assert.NoError(t, CORSConfig{AllowedOrigins: []string{"https://example.com"}, AllowCredentials: true}.Validate())
+	assert.Error(t, CORSConfig{AllowedOrigins: []string{"https://example.com", "*"}, AllowCredentials: true}.Validate())
+}
+
+func TestCORSFilter_Preflight(t *testing.T) {
+	f := newCORSFilter(CORSConfig{
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedMethods: []string{"GET", "PUT"},
+		AllowedHeaders: []string{"content-type"},
+		MaxAge:         10 * time.Minute,
+	})
+
+	w := serveFilter(f, corsRequest(http.MethodOptions, "https://example.com", map[string]string{
+		"Access-Control-Request-Method":  "PUT",
+		"Access-Control-Request-Headers": "Content-Type",
+	}))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.String(), "Preflight should not reach the handler")
+	assert.Equal(t, "GET, PUT", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+
+	w = serveFilter(f, corsRequest(http.MethodOptions, "https://example.com", map[string]string{
+		"Access-Control-Request-Method": "DELETE",
+	}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serveFilter(f, corsRequest(http.MethodOptions, "https://example.com", map[string]string{
+		"Access-Control-Request-Method":  "GET",
+		"Access-Control-Request-Headers": "Authorization",
+	}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serveFilter(f, corsRequest(http.MethodOptions, "https://evil.com", map[string]string{
+		"Access-Control-Request-Method": "GET",
+	}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = serveFilter(f, corsRequest(http.MethodOptions, "https://example.com", nil))
+	assert.Equal(t, "served", w.Body.String(), "Plain OPTIONS requests should reach the handler")
+}
@@ -65,6 +65,292 @@
 // This allows service owners to easily find logs corresponding to a request within and even across services.
 //
 //
+// Routes
+//
+// Routes can be restricted to HTTP methods and headers, and carry filters of their own that
+// run after the module filters. Route groups share a path prefix and filters, and can be
+// nested. Routes flattens a group into the route handlers returned to the module:
+//
+//   func registerHTTP(service service.Host) []uhttp.RouteHandler {
+//     api := uhttp.NewRouteGroup("/api/v1", requireUser)
+//     api.Handle("/users/{id}", getUser, http.MethodGet)
+//     admin := api.Group("/admin", requireAdmin)
+//     admin.Add(uhttp.NewRouteHandler("/stats", stats).WithMethods(http.MethodGet))
+//
+//     return append(api.Routes(), uhttp.NewRouteHandler("/", home))
+//   }
+//
+//   func getUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+//     id := uhttp.PathParam(ctx, "id")
+//     // ...
+//   }
+//
+// Path parameters of the route template are available on the request context through
+// uhttp.PathParam and uhttp.PathParams. The table of registered routes is logged
+// when the module starts.
+//
+//
+// JSON handlers
+//
+// uhttp.JSONHandler adapts a typed function to a handler. The request body is decoded
+// from JSON and validated with the validate struct tags, and the reply is encoded as JSON:
+//
+//   type CreateUser struct {
+//     Name string `json:"name" validate:"nonzero"`
+//   }
+//
+//   func createUser(ctx context.Context, req *CreateUser) (*User, error) {
+//     if taken(req.Name) {
+//       return nil, uhttp.NewProblem(http.StatusConflict, "name is taken")
+//     }
+//     return &User{Name: req.Name}, nil
+//   }
+//
+//   uhttp.NewRouteHandler("/users", uhttp.JSONHandler(createUser))
+//
+// The request argument is optional. Returning a uhttp.Response sets the status and headers
+// of the reply. Errors are rendered as problem details (https://tools.ietf.org/html/rfc7807)
+// with the application/problem+json content type:
+//
+// • a uhttp.Problem is rendered as is
+//
+// • malformed and invalid requests get status 400, with the invalid fields under errors
+//
+// • requests that do not accept JSON get 406 and request bodies that are not JSON get 415
+//
+// • other errors get status 500 without any detail, so that internal errors are not exposed
+//
+//
+// OpenAPI
+//
+// The module can serve an OpenAPI 3 (https://swagger.io/specification/) document of its
+// routes, with a page that renders it with Swagger UI:
+//
+//   modules:
+//     http:
+//       openAPI:
+//         path: /openapi.json
+//         docsPath: /docs
+//         title: users
+//         version: 1.2.0
+//
+// Every route is documented with its methods and path parameters, and routes without
+// methods are documented as GET. Routes served by uhttp.JSONHandler also get the
+// schemas of their request and reply types, and of the problem details they return on
+// errors. Fields validated as nonzero are marked as required. Schemas are named after
+// the package path and name of their type, such as go.uber.org.fx.modules.uhttp.Problem.
+//
+// The docs page loads a copy of Swagger UI served by the module, so it works offline
+// and under a default-src 'self' content security policy. The copy is swagger-ui-dist
+// 3.5.0, licensed under the Apache License 2.0 that is next to it in internal/swaggerui.
+//
+// To generate clients without running the service, dump the document from a small
+// command that uses the same registration function as the service, as
+// examples/simple/cmd/openapi does:
+//
+//   func main() {
+//     info := openapi.Info{Title: "users", Version: "1.2.0"}
+//     if err := uhttp.DumpOpenAPI(os.Stdout, registerHTTP, info); err != nil {
+//       log.Fatal(err)
+//     }
+//   }
+//
+//
+// Graceful shutdown
+//
+// When the module stops, the /health endpoint starts returning 503 Service Unavailable
+// so that load balancers stop sending new requests. After the configured delay the listener
+// is closed and in-flight requests are given the shutdown timeout to complete. Connections
+// still open after the timeout are closed, and the requests that were still in flight are
+// counted in the shutdown.dropped metric.
+//
+//   modules:
+//     http:
+//       shutdown:
+//         delay: 5s
+//         timeout: 10s
+//
+// The delay defaults to zero and the timeout to 10 seconds.
+//
+//
+// TLS
+//
+// Requests are served over TLS when the tls section is configured. Setting a client CA
+// enables mutual TLS: callers must present a certificate signed by the CA, and the verified
+// identity is available to filters and the auth client through auth.PeerIdentityFromContext.
+//
+//   modules:
+//     http:
+//       tls:
+//         certFile: /etc/service/cert.pem
+//         keyFile: /etc/service/key.pem
+//         clientCAFile: /etc/service/ca.pem
+//         minVersion: "1.2"
+//         cipherSuites:
+//           - TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+//         reloadInterval: 1m
+//
+// The certificate, key and client CA files are checked for changes every reloadInterval
+// and reloaded without a restart. If a reload fails, the previous certificates stay in use
+// and the tls.reload.fail metric is incremented. The minimum version defaults to TLS 1.2.
+//
+//
+// Server limits and HTTP/2
+//
+// The server applies the timeouts and limits from the module configuration. The read and
+// write timeouts default to timeout; other unset limits use the net/http defaults.
+// Request bodies larger than maxBodyBytes are rejected with 413 Request Entity Too Large.
+//
+//   modules:
+//     http:
+//       timeout: 60s
+//       readHeaderTimeout: 5s
+//       idleTimeout: 2m
+//       maxHeaderBytes: 65536
+//       maxBodyBytes: 1048576
+//       disableKeepAlives: false
+//       http2:
+//         cleartext: true
+//         maxConcurrentStreams: 250
+//
+// HTTP/2 is negotiated for TLS connections unless http2.disabled is set. Cleartext HTTP/2
+// (h2c) is served next to HTTP/1.1 when http2.cleartext is set; only enable it for internal
+// traffic that stays within a trusted network.
+//
+//
+// Access log
+//
+// Every request is logged once the response is written, with the method, route template,
+// path, status, response size, latency and peer address. The log entry is written with the
+// context-aware logger, so it also carries the trace and span IDs. Request headers are only
+// logged when they are allow-listed, which keeps credentials out of the logs.
+//
+//   modules:
+//     http:
+//       accessLog:
+//         sampleRate: 0.1
+//         suppress:
+//           - /health
+//         headers:
+//           - X-Request-Id
+//
+// With a sample rate below 1 only that fraction of requests is logged, but server errors are
+// always logged. Routes listed under suppress are never logged. Set disabled: true to turn
+// the access log off.
+//
+//
+// Route metrics
+//
+// Requests are counted per route template, such as /users/{id}, rather than per path, so the
+// number of reported series stays bounded. For every route the module reports:
+//
+// • route.requests, tagged with the status class such as 2xx
+//
+// • route.latency, a timer of the time spent serving the request
+//
+// • route.inflight, a gauge of the requests being served
+//
+// • route.response.bytes, the size of the response bodies
+//
+// Paths that match no route are reported under the unknown route. At most maxRoutes
+// templates are reported individually, and requests to the remaining routes are reported
+// as unknown as well.
+//
+//   modules:
+//     http:
+//       metrics:
+//         maxRoutes: 100
+//
+//
+// Browser filters
+//
+// Filters for browser-facing services are turned on by their config sections:
+//
+// • securityHeaders sets X-Content-Type-Options: nosniff and X-Frame-Options: DENY
+// unless configured otherwise, plus a content security policy, referrer policy and, on
+// responses served over TLS, HSTS when configured
+//
+// • cors answers preflight requests and adds the CORS headers for the allowed origins.
+// A * allows any origin and https://*.example.com allows the subdomains of example.com.
+// allowCredentials can't be combined with *. Preflight requests are answered for the
+// routes restricted to the requested method
+//
+// • compression compresses responses with gzip or deflate, whichever the client accepts,
+// once they reach minSize bytes and if their content type starts with one of contentTypes
+//
+//   modules:
+//     http:
+//       securityHeaders:
+//         contentSecurityPolicy: default-src 'self'
+//         hsts:
+//           maxAge: 8760h
+//           includeSubdomains: true
+//       cors:
+//         allowedOrigins:
+//           - https://example.com
+//         allowedMethods: [GET, POST, PUT]
+//         allowedHeaders: [Content-Type]
+//         allowCredentials: true
+//         maxAge: 10m
+//       compression:
+//         minSize: 1024
+//         contentTypes: [text/, application/json]
+//
+// They run after the access log and before auth, so preflight requests are answered
+// without credentials and rejected requests still get the security headers.
+//
+//
+// Rate limiting and load shedding
+//
+// Requests can be rate limited with a token bucket for every route template and caller.
+// Requests are limited once authorized, and the caller is the one the auth client authorized,
+// by default the common name of the client certificate verified over mutual TLS. Callers
+// are not trusted to name themselves, so requests without an authorized caller share the
+// bucket of their route. Rules override the default rate for the routes and callers they
+// match, the first matching rule applies and a zero rate means no limit.
+//
+// Load shedding caps the number of requests in flight. With a target latency the cap is
+// adaptive: it shrinks while requests take longer than the target and grows back up to
+// maxConcurrency once they are fast again.
+//
+//   modules:
+//     http:
+//       rateLimit:
+//         rate: 100
+//         burst: 200
+//         rules:
+//           - route: /health
+//             rate: 0
+//           - caller: batch-jobs
+//             rate: 10
+//         loadShedding:
+//           maxConcurrency: 500
+//           minConcurrency: 50
+//           targetLatency: 200ms
+//
+// Rejected requests get a 429 Too Many Requests response and are counted by the
+// rejected counter, tagged with the ratelimit or loadshedding middleware. The
+// concurrency.limit gauge reports the current cap.
+//
+//
+// Panic recovery
+//
+// A panic in a handler or filter is recovered and answered with a 500 response that reads
+// Server error: internal error, so panic values never leak to callers. The panic value and the stack are
+// logged at error level together with the method and URL, which also reports them to Sentry
+// when the Sentry hook is configured, and the panic counter is incremented. Recovery runs
+// inside the request span, so the panic is logged with the trace and the span is tagged as
+// an error.
+//
+//   modules:
+//     http:
+//       recovery:
+//         policy: propagate
+//
+// The recover policy is the default. With propagate the panic is reported and then
+// re-raised, leaving it to net/http, which closes the connection.
+//
+//
 // HTTP Client
 //
 // The http client serves similar purpose as http module, but for making requests.
@@ -92,6 +378,144 @@
 //     client.Get("https://www.uber.com")
 //   }
 //
+// Named clients
+//
+// Clients can be defined under clients.<name> and built by a factory created
+// from the host. Every client has its own transport, TLS settings, default headers
+// and filters, which are applied after tracing and auth in the listed order.
+// Relative request URLs are resolved against the base URL, and the metrics of the
+// filters are tagged with the client name. Clients are built once and shared.
+//
+//   clients:
+//     users:
+//       baseURL: https://users.example.com/api/
+//       timeout: 10s
+//       headers:
+//         X-Team: rides
+//       transport:
+//         maxIdleConnsPerHost: 20
+//         dialTimeout: 1s
+//         responseHeaderTimeout: 2s
+//         proxy: http://proxy.example.com:8080
+//       tls:
+//         caFile: /etc/certs/ca.pem
+//         certFile: /etc/certs/client.pem
+//         keyFile: /etc/certs/client-key.pem
+//       filters: [metrics, retry, circuitBreaker]
+//       retry:
+//         maxAttempts: 3
+//       circuitBreaker:
+//         failurePercent: 50
+//
+//   clients := client.FactoryFromHost(svc)
+//   users, err := clients.Client("users")
+//   if err != nil {
+//     log.Fatal("Could not create client: ", err)
+//   }
+//   users.Get("users/42")
+//
+// The factory is shared by everything that uses the host, so every caller of
+// FactoryFromHost gets the same named clients, connections, retry budgets and
+// circuits. Unset transport values keep the settings of http.DefaultTransport.
+// Other filters are made available to the filter lists with Factory.RegisterFilter.
+//
+// Load balancing
+//
+// Named clients with a loadBalancer send the requests to the host of their base
+// URL to the instances of the service. Instances come from a static list, the SRV
+// records of a DNS name, or a file listing one address per line. They are resolved
+// again every refreshInterval, and the previous instances stay in use if that
+// fails. The file is also watched, so changes to it are picked up within a second
+// instead of at the next refresh. Requests sent while the first resolution is in
+// flight wait for it. The roundRobin, leastPending and
+// powerOfTwo policies pick the instance, and outlier detection ejects instances
+// after consecutive errors or 5xx responses.
+//
+//   clients:
+//     users:
+//       baseURL: http://users/
+//       loadBalancer:
+//         policy: powerOfTwo
+//         dns: _http._tcp.users.example.com
+//         refreshInterval: 30s
+//         outlierDetection:
+//           consecutiveFailures: 5
+//           ejectionDuration: 30s
+//           maxEjectionPercent: 50
+//
+// Other discovery sources implement client.Discovery and are used with
+// client.NewBalancer, which is an http.RoundTripper. Sources that also implement
+// client.Watcher are watched until the balancer is closed.
+//
+// Retries
+//
+// client.RetryFilter retries requests that failed with an error or with a
+// 502, 503 or 504 status. Only idempotent methods (GET, HEAD, OPTIONS, TRACE, PUT
+// and DELETE) and requests carrying an Idempotency-Key header are retried.
+// The wait between attempts grows exponentially up to a cap and is randomized
+// to spread retries out. A retry budget, shared by all requests of the client,
+// stops retries when most attempts fail, to avoid retry storms.
+//
+// The deadline of the request context bounds all attempts together, and
+// attemptTimeout bounds every attempt. The config is read per client:
+//
+//   clients:
+//     users:
+//       retry:
+//         maxAttempts: 3
+//         initialBackoff: 50ms
+//         maxBackoff: 1s
+//         attemptTimeout: 500ms
+//         statuses: [429, 503]
+//         budget:
+//           maxTokens: 10
+//           tokenPercent: 10
+//
+//   cfg, err := client.LoadRetryConfig(svc.Config(), "clients.users.retry")
+//   if err != nil {
+//     log.Fatal("Could not load retry config: ", err)
+//   }
+//   users := client.New(svc, client.RetryFilter(cfg))
+//
+// Circuit breaking
+//
+// client.CircuitBreakerFilter keeps a circuit breaker for every host. Errors and
+// 5xx responses count as failures, and so do responses slower than slowCallDuration.
+// The circuit opens once failurePercent of the requests within the window failed,
+// and requests then fail fast until openTimeout passes. modules.IsCircuitOpen
+// tells these errors apart. State changes are logged and reported by the
+// circuitbreaker.state gauge and the circuitbreaker.transition counter.
+//
+//   var cfg modules.CircuitBreakerConfig
+//   if err := svc.Config().Get("clients.users.circuitBreaker").PopulateStruct(&cfg); err != nil {
+//     log.Fatal("Could not load circuit breaker config: ", err)
+//   }
+//   users := client.New(svc, client.RetryFilter(retryCfg), client.CircuitBreakerFilter(svc, cfg))
+//
+// The retry filter goes first, so that requests rejected by an open circuit are not retried.
+//
+// Metrics and debug logging
+//
+// client.MetricsFilter reports the client.requests counter, tagged with the
+// status class or error when no response was received, and the client.latency
+// timer. Both are tagged with the destination host and the method, and with the
+// client name for named clients. Placed before the retry filter it counts
+// requests, placed after it counts attempts.
+//
+// client.DebugLogFilter logs the headers and the first maxBodyBytes of the
+// bodies of requests and responses at debug level. The Authorization,
+// Proxy-Authorization, Cookie and Set-Cookie headers are always redacted, and so
+// are the headers and body patterns listed in the config. Bodies are only read
+// when debug logging is enabled.
+//
+//   clients:
+//     users:
+//       filters: [metrics, debugLog, retry]
+//       debugLog:
+//         maxBodyBytes: 1024
+//         redactHeaders: [X-Api-Key]
+//         redactPatterns: ['"password":"[^"]*"']
+//
 // Benchmark results:
 //
 //   Current performance benchmark data:
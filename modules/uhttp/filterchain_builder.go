@@ -24,6 +24,7 @@ import (
 	"context"
 	"net/http"
 
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/service"
 )
 
@@ -50,16 +51,39 @@ type filterChainBuilder struct {
 	filters      []Filter
 }
 
-func defaultFilterChainBuilder(host service.Host) filterChainBuilder {
+func defaultFilterChainBuilder(host service.Host, cfg Config, routes *routeMetrics) filterChainBuilder {
 	fcb := newFilterChainBuilder(host)
-	return fcb.AddFilters(
+	fcb = fcb.AddFilters(
 		contextFilter{host},
-		panicFilter{},
+		routeMetricsFilter{routes},
 		metricsFilter{},
 		tracingServerFilter{},
-		authorizationFilter{
-			authClient: host.AuthClient(),
-		})
+		panicFilter{recovery: cfg.Recovery},
+	)
+	if !cfg.AccessLog.Disabled {
+		fcb = fcb.AddFilters(newAccessLogFilter(cfg.AccessLog))
+	}
+	if cfg.SecurityHeaders != nil {
+		fcb = fcb.AddFilters(newSecurityHeadersFilter(*cfg.SecurityHeaders))
+	}
+	if cfg.CORS != nil {
+		fcb = fcb.AddFilters(newCORSFilter(*cfg.CORS))
+	}
+	if cfg.Compression != nil {
+		fcb = fcb.AddFilters(newCompressionFilter(*cfg.Compression))
+	}
+	fcb = fcb.AddFilters(peerIdentityFilter{})
+	if cfg.MaxBodyBytes > 0 {
+		fcb = fcb.AddFilters(bodyLimitFilter{maxBytes: cfg.MaxBodyBytes})
+	}
+	fcb = fcb.AddFilters(authorizationFilter{
+		authClient: host.AuthClient(),
+	})
+	// Requests are limited once authorized, so that the limit applies to their caller
+	if cfg.RateLimit.Enabled() {
+		fcb = fcb.AddFilters(rateLimitFilter{limiter: modules.NewLimiter(cfg.RateLimit)})
+	}
+	return fcb
 }
 
 // NewFilterChainBuilder creates an empty filterChainBuilder for setup
@@ -69,10 +93,10 @@ func newFilterChainBuilder(host service.Host) filterChainBuilder {
 	}
 }
 
+// AddFilters returns a builder with the filters added. The filters are copied,
+// so builders that share a base chain can add filters independently.
 func (f filterChainBuilder) AddFilters(filters ...Filter) filterChainBuilder {
-	for _, filter := range filters {
-		f.filters = append(f.filters, filter)
-	}
+	f.filters = append(f.filters[:len(f.filters):len(f.filters)], filters...)
 	return f
 }
 
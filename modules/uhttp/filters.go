@@ -27,6 +27,7 @@ import (
 
 	"go.uber.org/fx"
 	"go.uber.org/fx/auth"
+	"go.uber.org/fx/modules"
 	"go.uber.org/fx/modules/uhttp/internal/stats"
 	"go.uber.org/fx/service"
 
@@ -79,34 +80,69 @@ func (f tracingServerFilter) Apply(ctx context.Context, w http.ResponseWriter, r
 	next.ServeHTTP(ctx, w, r)
 }
 
+// peerIdentityFilter exposes the client certificate verified over mutual TLS
+// to the filters and the auth client through the request context
+type peerIdentityFilter struct{}
+
+func (f peerIdentityFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		ctx = auth.WithPeerIdentity(ctx, auth.NewPeerIdentity(r.TLS.VerifiedChains[0][0]))
+		r = r.WithContext(ctx)
+	}
+	next.ServeHTTP(ctx, w, r)
+}
+
+// bodyLimitFilter rejects request bodies larger than the configured limit. Bodies
+// without a content length fail to read once they go over the limit.
+type bodyLimitFilter struct {
+	maxBytes int64
+}
+
+func (f bodyLimitFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	if r.ContentLength > f.maxBytes {
+		w.Header().Set(ContentType, ContentTypeText)
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		fmt.Fprintf(w, "Request body exceeds %d bytes", f.maxBytes)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, f.maxBytes)
+	next.ServeHTTP(ctx, w, r)
+}
+
 // authorizationFilter authorizes services based on configuration
 type authorizationFilter struct {
 	authClient auth.Client
 }
 
 func (f authorizationFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
-	if err := f.authClient.Authorize(ctx); err != nil {
+	ctx, err := auth.Authorize(ctx, f.authClient)
+	if err != nil {
 		stats.HTTPAuthFailCounter.Inc(1)
 		fx.Logger(ctx).Error(auth.ErrAuthorization, "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		fmt.Fprintf(w, "Unauthorized access: %+v", err)
 		return
 	}
-	next.ServeHTTP(ctx, w, r)
+	next.ServeHTTP(ctx, w, r.WithContext(ctx))
 }
 
-// panicFilter handles any panics and return an error
-// panic filter should be added at the end of filter chain to catch panics
-type panicFilter struct{}
+// panicFilter handles any panics and return an error. It is added right after
+// tracingServerFilter, so the panic is logged with the trace and marked on the span.
+type panicFilter struct {
+	recovery modules.RecoveryConfig
+}
 
 func (f panicFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
 	defer func() {
-		if err := recover(); err != nil {
-			fx.Logger(ctx).Error("Panic recovered serving request", "error", err, "url", r.URL)
+		if p := recover(); p != nil {
 			stats.HTTPPanicCounter.Inc(1)
+			if span := opentracing.SpanFromContext(ctx); span != nil {
+				ext.Error.Set(span, true)
+			}
+			err := f.recovery.ReportPanic(ctx, p, "method", r.Method, "url", r.URL.String())
 			w.Header().Add(ContentType, ContentTypeText)
 			w.WriteHeader(http.StatusInternalServerError)
-			fmt.Fprintf(w, "Server error: %+v", err)
+			fmt.Fprintf(w, "Server error: %v", err)
 		}
 	}()
 	next.ServeHTTP(ctx, w, r)
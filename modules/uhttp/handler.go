@@ -27,6 +27,8 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/fx/modules/uhttp/internal/stats"
 	"go.uber.org/fx/service"
+
+	"github.com/gorilla/mux"
 )
 
 // Handler is a context-aware extension of http.Handler.
@@ -54,13 +56,26 @@ func Wrap(host service.Host, handler Handler) http.Handler {
 type handlerWrapper struct {
 	host    service.Host
 	handler Handler
+	route   string
 }
 
 // ServeHTTP calls Handler.ServeHTTP(ctx, w, r) and injects a new service context for use.
 func (h *handlerWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := fx.NewContext(context.Background(), h.host)
+	if h.route != "" {
+		ctx = withRouteTemplate(ctx, h.route)
+	}
+	if vars := mux.Vars(r); len(vars) > 0 {
+		ctx = withPathParams(ctx, vars)
+	}
 	stopwatch := stats.HTTPMethodTimer.Timer(r.Method).Start()
 	defer stopwatch.Stop()
 
 	h.handler.ServeHTTP(ctx, w, r)
 }
+
+type notFoundHandler struct{}
+
+func (notFoundHandler) ServeHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	http.NotFound(w, r)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 )
 
 type healthHandler struct{}
@@ -32,3 +33,16 @@ func (h healthHandler) ServeHTTP(ctx context.Context, w http.ResponseWriter, r *
 	// TODO(ai) import more sophisticated health mechanism from internal libraries
 	fmt.Fprintf(w, "OK\n")
 }
+
+// drainingHealth fails the health check once the module starts shutting down,
+// so load balancers stop sending new requests while in-flight ones drain
+func (m *Module) drainingHealth(h Handler) Handler {
+	return HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&m.draining) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintf(w, "draining\n")
+			return
+		}
+		h.ServeHTTP(ctx, w, r)
+	})
+}
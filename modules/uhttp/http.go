@@ -21,11 +21,14 @@
 package uhttp
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	_ "net/http/pprof" // for automatic pprof
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/fx/modules"
@@ -50,6 +53,9 @@ const (
 	defaultTimeout = 60 * time.Second
 	defaultPort    = 3001
 
+	// How long Stop waits for in-flight requests before closing connections
+	defaultShutdownTimeout = 10 * time.Second
+
 	// Reporter timeout for tracking HTTP requests
 	defaultReportTimeout = 90 * time.Second
 
@@ -78,6 +84,9 @@ type Module struct {
 	handlers []RouteHandler
 	listenMu sync.RWMutex
 	fcb      filterChainBuilder
+	routes   *routeMetrics
+	draining int32
+	inFlight int64
 }
 
 var _ service.Module = &Module{}
@@ -88,6 +97,47 @@ type Config struct {
 	Port    int           `yaml:"port"`
 	Timeout time.Duration `yaml:"timeout"`
 	Debug   *bool         `yaml:"debug"`
+	// Server limits, zero values use the net/http defaults except for the
+	// read and write timeouts, which default to Timeout
+	ReadTimeout       time.Duration `yaml:"readTimeout"`
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout"`
+	MaxHeaderBytes    int           `yaml:"maxHeaderBytes"`
+	// MaxBodyBytes limits the size of request bodies when set
+	MaxBodyBytes      int64 `yaml:"maxBodyBytes"`
+	DisableKeepAlives bool  `yaml:"disableKeepAlives"`
+	// HTTP2 controls HTTP/2 over TLS and cleartext
+	HTTP2 HTTP2Config `yaml:"http2"`
+	// AccessLog controls the log entry written for every request
+	AccessLog AccessLogConfig `yaml:"accessLog"`
+	// Metrics controls the metrics reported for every route
+	Metrics MetricsConfig `yaml:"metrics"`
+	// SecurityHeaders adds security headers to every response when set
+	SecurityHeaders *SecurityHeadersConfig `yaml:"securityHeaders"`
+	// CORS allows cross-origin requests from browsers when set
+	CORS *CORSConfig `yaml:"cors"`
+	// Compression compresses responses when set
+	Compression *CompressionConfig `yaml:"compression"`
+	// RateLimit rejects requests over their rate limit and sheds load
+	RateLimit modules.RateLimitConfig `yaml:"rateLimit"`
+	// OpenAPI serves the OpenAPI document of the routes when set
+	OpenAPI *OpenAPIConfig `yaml:"openAPI"`
+	// Recovery controls what happens when a handler panics
+	Recovery modules.RecoveryConfig `yaml:"recovery"`
+	// Shutdown controls how in-flight requests are drained when the module stops
+	Shutdown ShutdownConfig `yaml:"shutdown"`
+	// TLS serves requests over TLS when set
+	TLS *TLSConfig `yaml:"tls"`
+}
+
+// ShutdownConfig handles config for draining connections on shutdown
+type ShutdownConfig struct {
+	// Delay is how long the health check fails before the listener is closed,
+	// which gives load balancers time to stop sending new requests
+	Delay time.Duration `yaml:"delay"`
+	// Timeout is how long to wait for in-flight requests before connections are closed
+	Timeout time.Duration `yaml:"timeout"`
 }
 
 // GetHandlersFunc returns a slice of registrants from a service host
@@ -124,21 +174,38 @@ func newModule(
 
 	handlers := addHealth(getHandlers(mi.Host))
 
-	// TODO (madhu): Add other middleware - logging, metrics.
+	err := mi.Host.Config().Get(getConfigKey(mi.Name)).PopulateStruct(cfg)
+	if err != nil {
+		ulog.Logger().Error("Error loading http module configuration", "error", err)
+	}
+	if cfg.Shutdown.Timeout <= 0 {
+		cfg.Shutdown.Timeout = defaultShutdownTimeout
+	}
+	if err := cfg.Recovery.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.RateLimit.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.CORS != nil {
+		if err := cfg.CORS.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.OpenAPI != nil {
+		handlers = addOpenAPI(handlers, cfg.OpenAPI.withDefaults(mi.Host.Name()))
+	}
+
+	routes := newRouteMetrics(stats.HTTPRouteScope, cfg.Metrics.MaxRoutes)
 	module := &Module{
 		ModuleBase: *modules.NewModuleBase(mi.Name, mi.Host, []string{}),
 		handlers:   handlers,
-		fcb:        defaultFilterChainBuilder(mi.Host),
+		fcb:        defaultFilterChainBuilder(mi.Host, *cfg, routes),
+		routes:     routes,
+		config:     *cfg,
 	}
-
 	module.fcb = module.fcb.AddFilters(filters...)
 
-	err := module.Host().Config().Get(getConfigKey(mi.Name)).PopulateStruct(cfg)
-	if err != nil {
-		ulog.Logger().Error("Error loading http module configuration", "error", err)
-	}
-	module.config = *cfg
-
 	module.log = ulog.Logger().With("moduleName", mi.Name)
 
 	for _, option := range options {
@@ -160,7 +227,27 @@ func (m *Module) Start(ready chan<- struct{}) <-chan error {
 	mux.Handle("/", router)
 
 	for _, h := range m.handlers {
-		router.Handle(h.Path, m.fcb.Build(h.Handler))
+		handler := h.Handler
+		if h.Path == healthPath {
+			handler = m.drainingHealth(handler)
+		}
+		route := router.Handle(h.Path, m.fcb.AddFilters(h.Filters...).Build(handler))
+		if len(h.Methods) > 0 && m.config.CORS != nil {
+			route.GorillaMux().MatcherFunc(methodsOrPreflight(h.Methods))
+		} else if len(h.Methods) > 0 {
+			route = route.Methods(h.Methods...)
+		}
+		if len(h.Headers) > 0 {
+			route.Headers(h.Headers...)
+		}
+	}
+	m.log.Info("HTTP routes registered", "routes", routeTable(m.handlers))
+
+	// Paths that match no route are reported under the unknown route
+	router.NotFoundHandler = &handlerWrapper{
+		host:    m.Host(),
+		handler: newFilterChainBuilder(m.Host()).AddFilters(routeMetricsFilter{m.routes}).Build(notFoundHandler{}),
+		route:   unknownRoute,
 	}
 
 	if m.config.Debug == nil || *m.config.Debug {
@@ -169,32 +256,49 @@ func (m *Module) Start(ready chan<- struct{}) <-chan error {
 
 	ret := make(chan error, 1)
 
+	var tlsConfig *tls.Config
+	if m.config.TLS != nil {
+		reloader, err := newCertReloader(*m.config.TLS, m.config.HTTP2.nextProtos(), m.log)
+		if err != nil {
+			ret <- errors.Wrap(err, "unable to load TLS configuration for HTTP module")
+			return ret
+		}
+		tlsConfig = reloader.TLSConfig()
+	}
+
 	// Set up the socket
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", m.config.Port))
 	if err != nil {
 		ret <- errors.Wrap(err, "unable to open TCP listener for HTTP module")
 		return ret
 	}
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
 
 	// finally, start the http server.
 	// TODO update log object to be accessed via http context #74
-	m.log.Info("Server listening on port", "port", m.config.Port)
+	m.log.Info("Server listening on port", "port", m.config.Port, "tls", tlsConfig != nil)
 
+	srv, err := m.newServer(m.trackInFlight(mux), tlsConfig)
 	if err != nil {
+		listener.Close()
 		ret <- err
 		return ret
 	}
 	m.listenMu.Lock()
 	m.listener = listener
-	m.srv = &http.Server{
-		Handler: mux,
-	}
+	m.srv = srv
+	atomic.StoreInt32(&m.draining, 0)
 	m.listenMu.Unlock()
 
 	go func() {
-		listener := m.accessListener()
 		ready <- struct{}{}
-		err := m.srv.Serve(listener)
+		err := srv.Serve(listener)
+		if err == http.ErrServerClosed {
+			// Stop was called, requests are drained there
+			err = nil
+		}
 		ret <- err
 		if err != nil {
 			m.log.Error("HTTP Serve error", "error", err)
@@ -203,22 +307,49 @@ func (m *Module) Start(ready chan<- struct{}) <-chan error {
 	return ret
 }
 
-// Stop shuts down an HTTP module
+// Stop shuts down an HTTP module gracefully. The health check starts failing first,
+// then the listener is closed and in-flight requests are given the shutdown timeout
+// to complete before the remaining connections are closed.
 func (m *Module) Stop() error {
 	m.listenMu.Lock()
-	defer m.listenMu.Unlock()
+	srv := m.srv
+	running := m.listener != nil
+	m.listener = nil
+	m.listenMu.Unlock()
+
+	if !running {
+		return nil
+	}
 
-	var err error
-	if m.listener != nil {
-		// TODO: Change to use https://tip.golang.org/pkg/net/http/#Server.Shutdown
-		// once we upgrade to Go 1.8
-		// GFM-258
-		err = m.listener.Close()
-		m.listener = nil
+	atomic.StoreInt32(&m.draining, 1)
+	if delay := m.config.Shutdown.Delay; delay > 0 {
+		m.log.Info("Failing health checks before shutdown", "delay", delay)
+		time.Sleep(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.config.Shutdown.Timeout)
+	defer cancel()
+	err := srv.Shutdown(ctx)
+	if err == context.DeadlineExceeded {
+		dropped := atomic.LoadInt64(&m.inFlight)
+		stats.HTTPShutdownDroppedCounter.Inc(dropped)
+		m.log.Warn("Closing connections with requests still in flight",
+			"timeout", m.config.Shutdown.Timeout, "inFlight", dropped)
+		err = srv.Close()
 	}
 	return err
 }
 
+// trackInFlight counts the requests being served so that Stop can report
+// the ones that did not complete before the shutdown timeout
+func (m *Module) trackInFlight(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&m.inFlight, 1)
+		defer atomic.AddInt64(&m.inFlight, -1)
+		h.ServeHTTP(w, r)
+	})
+}
+
 // Thread-safe access to the listener object
 func (m *Module) accessListener() net.Listener {
 	m.listenMu.RLock()
@@ -31,6 +31,7 @@ import (
 	"testing"
 	"time"
 
+	"go.uber.org/fx/config"
 	"go.uber.org/fx/modules"
 	"go.uber.org/fx/modules/uhttp/internal/stats"
 	"go.uber.org/fx/service"
@@ -38,6 +39,7 @@ import (
 	. "go.uber.org/fx/testutils"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
@@ -88,8 +90,139 @@ func TestHTTPModule_Panic_OK(t *testing.T) {
 		assert.NotNil(t, m)
 		makeRequest(m, "GET", "/", nil, func(r *http.Response) {
 			assert.Equal(t, http.StatusInternalServerError, r.StatusCode, "Expected 500 with panic wrapper")
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "Server error: internal error", string(body))
+		})
+	})
+}
+
+func TestHTTPModule_RecoveryPolicy(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    recovery:
+      policy: propagate
+`, registerPanic)
+	assert.Equal(t, modules.RecoveryPropagate, m.config.Recovery.Policy)
+
+	mi := service.ModuleCreateInfo{
+		Host: configHost{
+			Host:     service.NopHost(),
+			provider: config.NewYAMLProviderFromBytes([]byte("modules:\n  http:\n    recovery:\n      policy: ignore\n")),
+		},
+	}
+	_, err := newModule(mi, registerNothing, nil)
+	assert.Error(t, err)
+}
+
+func TestHTTPModule_BrowserFilters(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    securityHeaders:
+      contentSecurityPolicy: default-src 'self'
+    cors:
+      allowedOrigins:
+        - https://example.com
+    compression:
+      minSize: 1
+`, func(service.Host) []RouteHandler {
+		return makeSingleHandler("/", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, "hello")
 		})
 	})
+	defer startModule(t, m)()
+
+	req, err := http.NewRequest(http.MethodGet, getURL(m)+"/", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := _defaultHTTPClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "default-src 'self'", resp.Header.Get("Content-Security-Policy"))
+	assert.Equal(t, "https://example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+}
+
+func TestHTTPModule_CORSPreflightRestrictedRoute(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    cors:
+      allowedOrigins:
+        - https://example.com
+      allowedMethods: [PUT]
+`, func(service.Host) []RouteHandler {
+		return []RouteHandler{{
+			Path:    "/users/{id}",
+			Methods: []string{http.MethodPut},
+			Handler: HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, "updated")
+			}),
+		}}
+	})
+	defer startModule(t, m)()
+
+	do := func(method string, headers map[string]string) *http.Response {
+		req, err := http.NewRequest(method, getURL(m)+"/users/1", nil)
+		require.NoError(t, err)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := _defaultHTTPClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := do(http.MethodOptions, map[string]string{
+		"Origin":                        "https://example.com",
+		"Access-Control-Request-Method": http.MethodPut,
+	})
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "https://example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "PUT", resp.Header.Get("Access-Control-Allow-Methods"))
+
+	assert.Equal(t, http.StatusOK, do(http.MethodPut, nil).StatusCode)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodOptions, nil).StatusCode,
+		"Plain OPTIONS requests should not match a restricted route")
+	assert.Equal(t, http.StatusNotFound, do(http.MethodOptions, map[string]string{
+		"Origin":                        "https://example.com",
+		"Access-Control-Request-Method": http.MethodDelete,
+	}).StatusCode)
+}
+
+func TestPanicFilter_Propagate(t *testing.T) {
+	host := service.NopHost()
+	stats.SetupHTTPMetrics(host.Metrics())
+	chain := newFilterChainBuilder(host).AddFilters(
+		panicFilter{recovery: modules.RecoveryConfig{Policy: modules.RecoveryPropagate}},
+	).Build(HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	assert.Panics(t, func() { testServeHTTP(chain, host) })
+}
+
+func TestPanicFilter_TagsSpan(t *testing.T) {
+	tracer := mocktracer.New()
+	opentracing.InitGlobalTracer(tracer)
+	defer opentracing.InitGlobalTracer(opentracing.NoopTracer{})
+
+	host := service.NopHost()
+	stats.SetupHTTPMetrics(host.Metrics())
+	chain := defaultFilterChainBuilder(host, Config{}, newRouteMetrics(host.Metrics(), 0)).
+		Build(HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			panic("boom")
+		}))
+	response := testServeHTTP(chain, host)
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, true, spans[0].Tag("error"))
 }
 
 func TestHTTPModule_Tracer(t *testing.T) {
@@ -159,6 +292,83 @@ func TestHookupOptions_Error(t *testing.T) {
 	})
 }
 
+func TestStop_DrainsInFlightRequests(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	withModule(t, registerBlocking(started, release), nil, nil, false, func(m *Module) {
+		base := getURL(m)
+		responses := make(chan int, 1)
+		go func() {
+			r, err := _defaultHTTPClient.Get(base + "/")
+			if assert.NoError(t, err) {
+				responses <- r.StatusCode
+			}
+		}()
+		<-started
+
+		stopped := make(chan error, 1)
+		go func() { stopped <- m.Stop() }()
+		select {
+		case <-stopped:
+			assert.Fail(t, "Stop returned with a request in flight")
+		case <-time.After(50 * time.Millisecond):
+		}
+		assert.False(t, m.IsRunning())
+
+		close(release)
+		assert.NoError(t, <-stopped)
+		assert.Equal(t, http.StatusOK, <-responses)
+	})
+}
+
+func TestStop_ClosesConnectionsAfterTimeout(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	withModule(t, registerBlocking(started, release), nil, nil, false, func(m *Module) {
+		m.config.Shutdown.Timeout = 50 * time.Millisecond
+		before := droppedRequests(m)
+		base := getURL(m)
+		go func() {
+			_, err := _defaultHTTPClient.Get(base + "/")
+			assert.Error(t, err, "Connection should be closed by the shutdown")
+		}()
+		<-started
+
+		assert.NoError(t, m.Stop())
+		assert.Equal(t, before+1, droppedRequests(m))
+	})
+}
+
+func droppedRequests(m *Module) int64 {
+	for _, c := range m.Host().Metrics().(tally.TestScope).Snapshot().Counters() {
+		if c.Name() == "shutdown.dropped" {
+			return c.Value()
+		}
+	}
+	return 0
+}
+
+func TestStop_FailsHealthWhileDraining(t *testing.T) {
+	withModule(t, registerNothing, nil, nil, false, func(m *Module) {
+		m.config.Shutdown.Delay = 200 * time.Millisecond
+		base := getURL(m)
+		stopped := make(chan error, 1)
+		go func() { stopped <- m.Stop() }()
+
+		time.Sleep(50 * time.Millisecond)
+		r, err := _defaultHTTPClient.Get(base + "/health")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, r.StatusCode)
+		assert.NoError(t, <-stopped)
+	})
+}
+
+func TestStop_NotRunning(t *testing.T) {
+	withModule(t, registerNothing, nil, nil, false, func(m *Module) {
+		require.NoError(t, m.Stop())
+		assert.NoError(t, m.Stop(), "Second stop should be a no-op")
+	})
+}
+
 // TODO(ai) add a test for binding a bad port and get an error out of Start()
 
 func configOption() service.Option {
@@ -272,6 +482,16 @@ func registerCustomHealth(_ service.Host) []RouteHandler {
 	})
 }
 
+func registerBlocking(started chan<- struct{}, release <-chan struct{}) GetHandlersFunc {
+	return func(_ service.Host) []RouteHandler {
+		return makeSingleHandler("/", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			io.WriteString(w, "done")
+		})
+	}
+}
+
 func registerPanic(_ service.Host) []RouteHandler {
 	return makeSingleHandler("/", func(ctx context.Context, _ http.ResponseWriter, r *http.Request) {
 		panic("Intentional panic for:" + r.URL.Path)
@@ -296,6 +516,10 @@ func verifyMetrics(t *testing.T, scope tally.Scope) {
 	timers := snapshot.Timers()
 	counters := snapshot.Counters()
 
-	assert.NotNil(t, timers["GET"].Values())
-	assert.NotNil(t, counters["fail"].Value())
+	assert.NotNil(t, timers[tally.KeyForPrefixedStringMap("GET", stats.HTTPTags)].Values())
+	authTags := map[string]string{stats.TagMiddleware: "auth"}
+	for k, v := range stats.HTTPTags {
+		authTags[k] = v
+	}
+	assert.NotNil(t, counters[tally.KeyForPrefixedStringMap("fail", authTags)].Value())
 }
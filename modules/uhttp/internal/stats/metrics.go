@@ -31,6 +31,8 @@ const (
 	TagStatus = "status"
 	// TagMiddleware is the middleware tag
 	TagMiddleware = "middleware"
+	// TagRoute is the route template that served the request
+	TagRoute = "route"
 )
 
 // HTTPTags creates metrics scope with defined tags
@@ -48,6 +50,18 @@ var (
 	HTTPMethodTimer tally.Scope
 	// HTTPStatusCountScope is a scope for http status
 	HTTPStatusCountScope tally.Scope
+	// HTTPShutdownDroppedCounter counts requests still in flight when shutdown timed out
+	HTTPShutdownDroppedCounter tally.Counter
+	// HTTPTLSReloadFailCounter counts failures to reload TLS certificates
+	HTTPTLSReloadFailCounter tally.Counter
+	// HTTPRouteScope is a scope for metrics broken down by route
+	HTTPRouteScope tally.Scope
+	// HTTPRateLimitCounter counts requests rejected over their rate limit
+	HTTPRateLimitCounter tally.Counter
+	// HTTPLoadShedCounter counts requests shed while the server is overloaded
+	HTTPLoadShedCounter tally.Counter
+	// HTTPConcurrencyLimitGauge is the number of requests allowed in flight
+	HTTPConcurrencyLimitGauge tally.Gauge
 )
 
 // SetupHTTPMetrics allocates counters for necessary setup
@@ -60,4 +74,14 @@ func SetupHTTPMetrics(scope tally.Scope) {
 	HTTPMethodTimer = httpScope.Tagged(HTTPTags)
 
 	HTTPStatusCountScope = httpScope
+
+	HTTPShutdownDroppedCounter = httpScope.Counter("shutdown.dropped")
+
+	HTTPTLSReloadFailCounter = httpScope.Counter("tls.reload.fail")
+
+	HTTPRouteScope = httpScope.SubScope("route")
+
+	HTTPRateLimitCounter = httpScope.Tagged(map[string]string{TagMiddleware: "ratelimit"}).Counter("rejected")
+	HTTPLoadShedCounter = httpScope.Tagged(map[string]string{TagMiddleware: "loadshedding"}).Counter("rejected")
+	HTTPConcurrencyLimitGauge = httpScope.Gauge("concurrency.limit")
 }
@@ -0,0 +1,5788 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by gen.go. DO NOT EDIT.
+
+package swaggerui
+
+// Version is the swagger-ui-dist release of the assets
+const Version = "3.5.0"
+
+// The gzipped and base64 encoded assets, by name
+var _assets = map[string]string{
+	"swagger-ui.css": `
+H4sIAAAAAAAC/+w9aY/bOJbf91dou1Go1LSlUJcvIY3OpGd2CqhKZjsZzIVGQEu0rSpJ1Ep02RWh/vuC1GGKpGT5yHQWO3B3yqbI
+x8fH690y8i1crVCmb8JiiROiL2EcRs/zDylKtI8wyUc5THI9R1m49Hwc4Wz+vb1wTNd84ZpqxjaDaYqyYhsGZD03AbjyYrjTq5/O
+GKQ7L4bZKkzmQIMbgr0UBkGYrOZAs0C6a0PD6SLC/qNO4ErPkU9CnBRBmKcRfJ7rW7R4DIm+wDuvKYtzfRmhHV9Gf3tcZR1nIUrI
+/AllJPRh1HoWhFnZzTzBWUwfVhC5Jz6ONnHiKUs70T8fbRiFq2Tuo4SgbI9Wq5T90EOC4rwuqqlrgnTHCKyZ9T+ev8lynM1THJYw
+q85IBpM8ZIOCUaQZVu4pihY4C1CmLzAhOJ6b6U7LcRQGWrZawFfubDR2R1NzZNg3nSSZr/ETyooF9B9XGd4kwZy1BSP6MYDV3bJc
+oXn4Bc0th19QQHPTncev308hCaMo3MTaX9Fi4BrmejISrAco97U8hUnBTwel/txsJmJueuWfTlBa/rQqumjsyDR28h5QMYwijgqm
+Uw97i8LVmswdALwh2HK7zwQ1jBO3fgozGCOCss+fyXOKePSsdNf05KY7DbQ6+og3mY+0dzhA2p8zPIpxgvMU+qg1pDEAfb0/hWir
+R2GC6D+PRYorWmYogiR8Qh7B6dxOd155FFmtg8gdvhtceaZc9UwV3LI0aQ/lluH2yvcAgKpUz2AQbvI5nUh+4vI1DPCWwbDTncbv
+EHN24x2uokRNMwhc6GsEA5SdfzIdtRX4rsvv9Lwq9uvQkY+mIwDNl2GWEx0vdbYG22A1oIEjYBnQpytHWzvl9pfW1Omw5nBJULaH
+CBc5jjYEedV5qrMFE6ElmbvgqlqzpgWuvHW1wSmRcELoTfbdd+3VusRZPGffIkjQ317pLri68XoecUfwKoPPymEZYa5jeiLUBXq+
+iWOYPRddNwFd3R0UakCUl2e9EuvZmlZ3lReHiV4N2KW/OUTXecSW+dWIMhkjY3qj3jcUH0vcFjfegDpH4T46prIWwQWKfiOW4OUE
+TIWjnD+WJwA052j1RWfrljF3F7qK+9GrdmfrJuvZ6h2g1o54oQ66QJuxX36o5f7SY0TWOOi77dkchEnFZU8Bd9uOKbNHzxKCdqS9
+NNoXj93eXuxqYm1auwSI++iYUS+Xy4FDxinKIJucMBgNa5JCsj6i6ufPAUoz5EOCWqQdp9wePOtCBPvFAjS7lHXav87n7rc4C/RF
+huDjnP2rwyjqY+lO5bR+ilEQQu3VXpSbjKfp7qb41qfTSncvJ6w5kZ08ApMzmvKDGMjQWg1Dy53CF+dk+4lVynFnjPtsADzh1MBq
+fcRU4PpfTlxi7GQMkI9LKsxZR2Sd4c1qfQIJhcN9IAQqmGZhyrQiHAB76NV1jrDXhdRvredwhwkP9V89xTmpOdhquM7M92fAE/US
+E3tkAWdkOk4nc9iC2nmR87ds2dcJwJQ4D4EzXDg5Gs+NSMmlD01bpqTlWiNzbI6c6QBKboYRsuzqeFhKjAeAOY2MQ8AHKEIEiXjN
+bGQjmZLObDS26H8HCVmCHUZL1tlJ4JRoD4N0IkUH9LBC4sIcm3C5lMk5m4zMiTuy3AFbfIWGLcyyq+NhKTEeAOY0Mg4Bn0LirwW0
+XIBs35IIOQUjy5qMzNmQs5KCHUTKsrNToCmxHgToNHIO6YBCFfCaAdNSLEt65ZjTYcuSQh1EzLKvE4ApcR4C5zRSDoGPGQ+UC5iB
+wIVwIlPTHs3AyBxPDhOzgjuInmVvp8FTIj4Q1GlUHdIFx+7iFPoheZ4bY6+NKlrQj1ovN7OuhlxLe4lnAJXL/k4GWaiwHw7tNFr3
+9MIh6K9RjHJJBTqsmWZUX3QSkggd1IUtw4jQQexlkLJEDxPKxbWMt0xYshiYljWxNmdYe4VvMA0CJKwqAhenyQStnktVRjMsLwpz
+oufkOULzBCdI6lKLQlFpuVeQmQC0fs/asAXx+Vy9XomNaJeQpPmq/1J3Cg6D6LQgULUA8LJSk9OoB8x0V5sO2LxytoMOA6x1o0Ki
+Wu6FoH5Umyo5AVWvHAOUUr2OdgRlCYz0APt5f1W2wD+X1nlpitt24Ga1urU94QISr2pQ2to5YlydtfmhCepoq9/KfcmxpMcMJR0w
+klRUXStV5qcOoYvERd+GQjvkbwiqq9d1S6swpylnu6i3qWYsSMIfmPwB7kgH+AIHVJuUwbhhWv4P+bPI2LfKUBCSouVwAg62L+1L
+PNEGttFyFCGf1EZ2W3XNZShPcZKjXA+TRJjm3prSFpWeux27s7pvz92gdX+UU/mcE0g2udIAdDnwXOEmCbC/iVEiKNPNc60JM0A/
+PahQVW3e3rkWijkPMgeg2PtahGC9fxN04M7lz5/LFReET0YMs8cAb5PR0S0zlAQoQ8F9BUFp0S35qZDAKPSb44adsvsjW8EB8q4r
+LZ2h6TgOOo9SksnwGDKJt9MphNLSQnld/YtHAi8wElicNYDpbLEEjidaPja0lygUefDjRlcabS4xxhJSUeNsLkzgn4GZaIQ8CSmy
+Ls5dOp3ulp1GdZ1enFqaoS42sr2Vt+uQIJ2hMU+zkr0pzcv0W2Vdpr/VNmepkFWtOZH1c7pGSV66hFAOpFXQ+jHoSKFTvIzwVsLt
+sjtUpGTpZ7Kv/Z9hnOKMwIQcaGeUSgO6S4rWudpuV8rwOhXNYEj5FY7jt3gZ1S6l8ZYefLns938SPDfcG29YtX4U65L8m3Bm6sTu
+x0FuTN80J17vW7104nZLfvds7cQBkjVc9l55Yjcy0t6jcENFIR/mwiWQo+wJZXvg3+KCFlDUDB/H6YagQN9kUY2whWINHNNQ83GA
+qqOCulQ2ayZMmKtAyVXVw2+42L0PUkMpE8Uyd3YIlbLkG9mUXdg1XyqlZTlkvdRcmSgeCEa9t/9f7uV+EnXs5aGzReAiQhqpQ3ts
+gOIjmwaFchdw8UEuir2a4tUqi8MgiPbqUard5HXBDUd0/EgCta94tQCt44enSdpzTuPaBhZhyPraH43NWVAqEMbSeKQmTdEhx/Tu
+lpUSmds7QHEvqnXM1C+9cVBv1MkV4F6PdOZ1Pur0Sm897rhnzt4rPTRZoCXOUDFg1G0ZuZx3FuG2rpnL/Umus3tT4bIPkzAuRakM
+E/ZFM3MtTJZhEhKk0Y0Cs1FlgWNee8e3qL7PTdloU9rr3VH5X+kaT6vQnVbZyfgLdHwjsOhsnTeHI/Qfl9BH+lOYh4swon2uwyBA
+idf96OWnuvkjel5mkNqz6oEVBBfySmJP0SuTbLLkxusof3n56cLwuiRBNqXtM4QdUyge0MSgPzIc5Tr0fZSS2sBYHdYtg+UqQyg5
+ByYLG+NgcVyHMUGxaiYWVCuGssIFV40ZGLRo21GDR7NytdfWdnHmxoVGghknJNwl6kojZemcLkGVF2eYrFEWkqr75lePOyFV/h9w
+zOfj3izeN1N0vrVlf1w7lzcsYyd7xWQGJYUZSsilY2LOPncXJKH/63ncFR/oVHSS2v0zCHN60wa/FtWUJJjoMIrwFgXNEWfYUsNK
+J9QRVucKg7RvvIM15DH5MPFRJLq/LcdgzIs11QN+go4LoKDw5M7hhqxxFn5BBWOraq5DEDo8wb+13+lVRrWn31IzsowwJHN6O7Yn
+U+mCILR/WhXLMIrUnqa0bmV3K+Rrc19TTzdRjjRrfxl6A6ttwyjS/TVMVmjenP/9Eycr5xpSzgCyVGepiEIBrgoeTMvhlgLpCgUF
+tVg7sZlDsmWNWQTcsGovE3BVdEJuVjvXCNx4A+u9EHy5IYFBIwLtq/7fRP5aRBb3pE47TU9TMvCanw7A7OsJMfdtAJWQ56/DKCik
+a5ON1elHYR7BDgCsKftfOLDqgw2WtgE6DiEqq7rWE5y0/D1lZyYZlEGlDc4pzzzYYJMITQxHdGpIYRJUDnf5SPWscRUrBqPehkrP
++H7I7BbgwvOre0y21rcBC6Ttq1oFB3G3DaCfQ2gXx7FsJegJoJ8W6MWGEJwUgoMZ3hB6PcsELKsbYfIEozBQ3Xxr+IhopgjN9NSl
+h6IIvl8iyTmxkjyOYGuZzsKt04rIfoGV2abftrOc0I+2yaJXASRwHsZwhV7nT6sfdnHkLWCOxs7oz396b/3j+ffO4q+7jf8FhPBP
+vwD/Z/x0Zwd28Oza98/ukx/7T/cPb7f372ZfgtgPb//0j/QffwveLezV7Pbh7er+3dvn8v/bH27fvV3d/rzb/v1vv+Db//rv2e0j
+2N0/O+D+02r1/tlx3n+KHu4ebu37j2Bz//B35+7B335ovj/aH9693d6+c57fPzvP7x9Wm/sH/5nWuf/Iyt33D1F+9+l+8+HTW+f2
+5/vNh5/fP9x9bOoTWnf//dH+8PHtdvEOfLl7eNx+YH9XX/7+TPvzyd3DrfW++V72cfeR9vFIy3YM7sNq8/7LW7f+/uGT79z+/Pv7
++09vV/ef/phXYyP3z87u/c9/HN+G0x/+XNLphxuNab7YJGqlJldLsJ6hFEHC80Jl4hXQlalCrXm3hinoabXjZYwGEZimCGaUFy/P
+Jz3GX6RC4bdi8f8z3kQkTCP0a20BcFv+r65y7SoA/cs3b9v4KGl8LXbfHhXO2wHJpGHmbZ7gqFwBl8nVwzSu/6Q6jzcohmH066jj
+6TKMUOfDFOY5tR93VsgRzPx152OqR/i1kByMVStnKpySnCU/mNHPoVNyuRw2fd8UZfYa/okrrhoJ0XrP9CJ8qFKD+KGK1QAOVWMD
++ZfsZpXcSMEUJo2p4IQKhercDl7pZrqjR+mNd+D5iwWuRtOD8Kx+cPXjFxtcjajefnIYQ+cAhvXzFwdcjcYH4R0A10B7+enfFL0w
+RfnNQncIzBAULwA+mGSfzsdqZSmRRSLpEOS55AN5gC6dW6we2XyJ/U1eyPytInqybmP41I1AEYqyNw12+mRliDXpIMhXd7X018h/
+XOBd0crcVt5eCoGItzd1FNckBvSj7uyM1EgdANNCSIy3d9tSuL3WMyFWuuBy2qPG3S912a/N0GV5urfdD4xwPxplNrXO7HdKC3y1
+RcdcjFDLB2VaOSh0J1kQjYLC+kRT+unWStG9VFfqfdiXZYftk+bbiaSbV/FN8gGZ+zBCr4zZjacoO6K3OfuGgtaEycRigvB3CknY
+X8MsR+TNhiz16ejKfpc/rTQ2g2+uTXCtlTP45np6rdEcKL/HuzfXtjbRTKBNr7VdHCX5m+s1Ien89evtdmtsbQNnq9cWAID2cX1l
+/+HKfsdS2VBFxpvrK8t2TGfi/OGaFejZJkJvrtETSnAQXGvBm+v7sWHbtma6d7ZmmsZ4PIlMWqLTfx3N0qy7spiiYWtT+uzL9euy
+J9rplf2H7240F1ztBc42RYMQRnilb3b7pb0MdyjwvuhhEqDdfDabzVqhb6BOpQc8VQRQA1AzKO2DDKcK6L0AuwLnpjedXcU4gFGr
+n8a5QBpIy9NAuERrF5zy7qiZ67ErHc0nO17I4kkd6ntIPOlQClClgg1keV/UCnTWO0jU2gReNO7Bz6VPMaVQnUXQ4dkOWbPYA1ZL
+pWu8LcrW5h/5Lj42KqUPi3Zc4DTdSTdPHYl0KbcyFTbnpA5teByrdPrs8myvFtyF3RG7R6MZfoRzpLPiI/T1l1M+9SG3trl5t0D3
+vAMho/bxuaBOWSQxDvp1Pzb4Ckl/Wa+awcXoU0P06EAdEtTWMwjop8uPX2r5o+QzODwPGAOmE7xaRUh0uFOza+Mudq2aaHq2aYaN
+4gEJ36RLQTNMN9cQzJEeJt5JNU+tMTrQRad/1gwEaHXjdZVL7XSchUwDB64ob+F1PeieKMPHUQTTHAXdXmMdSLHibsiV56XKg1C0
+fXXE6h/FHx5m+2oW0nL2LKTlcDwkY8UdzXJ4DpEyfybQxndTw51pE8MxNdM2zIlmWpHuGO5Ucwx3dmcCzZxGY318iOuTzAyy62xJ
+xYdNnOoEs8x8CnGnz2dLBtCdbLHl+6kbDooHgK5ycPQ75XJV65SFZdE6TEjBOUmyr5GyNavagatpTKkzteSHWV8OholizaAO13zo
+V4JZ4JeQ3aWP5eOZtIlqwXMRlBoLZ1B5BpZV87pmHQ8iMqHSywVk3Hqg1ymsubtd8dIJdRvKdwn83mlvQGiDF9i5i2SfPS2BolIF
+ddE3RhzDYiiM5xLhznyhggLeSe94kOAc914LsblbdEUCKfL5nk/GYccpk0fT3QBAilivJsjluEy0w48d4N4cg9mB+ZkcB6zND3JJ
+i46CEkEZCNAGgqE62l5HnH214eH7fbnwG3hHLB/JyaoBYqgSjbndF6uwP47ZDy6gHxXoPQ76FmYJjapR9SOJW/twtaMQUaStpKfP
+j/SfWu5YZNBHOpNJWzeWys0pzXCqJzBGRY/E0BVaxxqzlVeTyYWKGijZxH3hy6wSZYEhKboTXbBgKVXCnJagrdeM97z+IgOp6ESY
+fwLJqFjXwBKjyAhOhwAQdnNts3b4/GClThrFCnilqJ5TTA5Iwuogt4sbrsrANAWB6rRwg+jUQ6G98tFqqSbHKPZ6MgFVYCm9SrCj
+vqfrQz4lgkaJS+DEnNEHMmrWzWVef5SLySG+Ugasng4lDwzl69hsOT9VD8x2QFKTVa7zHVDsNOo5Qx1wvmpS6M7I0P9swqzO1tOV
+nq6rlToaUn2d6eP2K632QnoN7TuPi56zXLeOn+vEBe0ISvIQJ6OuGuGAvD3nnCHyy3+4zjtfsHBhJDIUSIkHI6SIlJW19wSnC9h+
+jZAipKXKZKNqWv9VpyCs6sBvIqy+QYabDCbOi4fjwLfY7FpGJVGvWdpWz3nXS41vKWUpdNVmx3RqRoC3SYQhS6zQpOy7zEta7D0t
+bK8u4Sql0H+coyTYT1JT8rDJSbh8rg0zrAMdJcHwMRw4pisKye4mrjNZql4cVwczdHuU96NjlEe8/lu+ncpT3lTjlpfMqUOqotTa
+V9IJb0lkjFN/lshjkGI/ZL2DtcfD8qo/HHE6l0XLUUphi2Xlwu9jkG+VVhENPdc8z585lW23x9WLC6lpGZarwZ11BIXJEjf+3Ir0
+lPS5tqY9pkYMw0RMV9NfWxM93xTVo3AkF6aKslJKumCiwxJbU9HV2lIV2qpCR1XoFmfjxZLlNBFhZSzJUeqfc9MxyinmGV6w641w
+g7SNg+erChhV9N/OZmcuxzNfsWZ/DMInQTmtAGYsYI50hTeiIJtynm+XyXzYOe2GqM+xx5eSjTj48stye7h694DNtYnK3PuQlMeV
+KEjnm1TyinMngrfMJJg6M+sA5iyH30lvGZQOQBoaqC9Ich4T1ZbrZXZJvPH5QpFpUnG2IpZlPGaAk0MpoljDy/KHKmbgovwhj7PG
+BfILyYik24e1UyZWM0XH6tIL5ICbTR90tXa6s4856APGGdIqf2I33XV5YAhNm7fLy+JDb7uDAS09DcTYHss+MBeagbIMZ3lXfosO
+1fvFj9jcxynKtbXVx0acljIQp0gP0LLfjlqRQRfmzFIvT0VEjQ8j9JdUyHjEl8oscB1Vc5B16HlxVhvt6rc4CrUWXtk0V7xZ9utd
+rB0o8Jl/vh8DOY2I0G59Rmj/hRUdSszaNG29zP2r+bupArPK1VgoI2oq5/CpwmF8euPtMyUpU1KVFU25rblvarZDmn5rXFqz9guL
+XcmMmi0y6uQ97fiG/3j9u++1nC38e5imYbL6yy93b/aADD/PjRimv3v9vwMAaiJGMqGEAAA=
+`,
+	"swagger-ui-bundle.js": `
+H4sIAAAAAAAC/+z9aXvbtrYwgH4/v0LmyWGJCJIleUhCGdbO4LRpMzV2OimqDy1BFhsKVEHQiWvqfLt/7P6y+ywMJDhIdtK993nf
++7zd+4lFjAvTwsIad+Ypm4owZh7FAt048cUfdCocQsT1isbzFv28irlIXLeWs4xnaURH6k9XlyPCQ75j2iwKz+g8ZNR11d9usJyN
+1E9vPMEC+Zv6Hem/3dNPweUl5e9fPEnZLKKyH9qUuvbEIkxwPix0w6lIOWvlKRTdmN8t4XF0E849NuYTpAvCbzOe4VXAWyGBJHIT
++hxH/k4f60z/Zr0e6koUKk2DKPJCUxeHuPgtEA67EdnpFWlraJuRm7wN0V0SikV3ShgW3ZDYEJtu1lh0Z1YOZpijG9GN4SfKsjdy
+Hrtqct/yeEW5uJbFbqYxm4eXKQ8uIipHwdIl1V89fEmFz9cI2melnhWU1HVp9/ycJq/Ustfnl0KnQRqJtd+QaQ1y5jHsBA5mCDPo
+LiblPagL6pGseCxi2BTdRZC8+cTMmNRkQwVoY0Wc3VmYCAcLT3QT0h/0H6G1N7ZbxgzdOGlCW4ng4VQ4w2LXMu/RAK1xtbRdoE/3
+kNdQptSisKYI1lqYOakMMZx7Ox5thSwRAZvCfhcIiQWPP7UY/dQ6u17RE85j7jlPA8Zi0YLBtoLWNAqSpBUkrSDfzg5afxlQcktz
+wry9wSOEm3dZZbGpf6PH4dP12uNo2DAw61RRNcp5zD21e3pDdiS6EWWXYjFk7bbaVZyIMZsMebfYicT+yLKdPuZde9/CpDpXQZRS
+J2Qt7roe737iodB5CHtwwDRsyKOYdz/Sa8zRel1FA0IdHZ3MXJd61m6D3cllGuYIi/Xa27o/8vlh3sGjfYSLyfN3erctUD5v/M5r
+0LSmIWHeweEewjHhXohwINf4IcIJ4V6AcEqYt3+IcES4lzYuodmbBQLfMfhYuC5Lo2iHENG8UU/TFeWAtjlNEhjMMk1Ei4ZiQXnr
+gragdivm1s7FLdjZTtu7isNZq0cIESMnZQp1zRzf6+GoWEqBEBrSYoGI18OJle269uoBsksET6ci5v6N3DA+LWG8Pjb7BrBfGTf2
+1muEhet6selg5PVwbO8rAdfP+bns8fyciK8+hfuH/8xDmGMXaq3SOzqnnLKpWSq4IFuLIGHfiNYFpawVslCEQRQmdNbqtBJYSQ+V
+SgD+oTMHaSy+I7LMXNk7hGxZwbC0gq7btLVG1BdfckawPLo4xgFOcCrHG3oC4R19W0XDcG6BhCICs6AH/ypk4Tyksxb9PKUr2WQ8
+naac09mwBT2KBW2xmHWWpuCMXrUouwp5zJaUidY85rLQHHY0hVZbS5okwSVtBWzWCmazEJoNotaCRqt5GrU+BZyF7DLpOmhIo4RK
+KKdkbI1iglekN7QhFV1OV1Ewpd7ufyW7lw0UzXS8arcna4Rw1GXBkhLnBbsKeBgw0fopjKNAzvNabYSoO+fBkiZn8dt4Rfo4Wq8V
+0rC33tq6E3l9RXZs5Fm+HtHa69kgNi+evhjgaNlHWV/1U04DUcLCCGGrXNc61ISuLSKuODWxR9GI+m88iooC9rEKVIFXpQI24k1U
+gbNSgbDShevupKrc21K5uCi34+3ADUbHEZtYJYKGEtNSiaShxKpUIq2MJ8uSEhRRQwvzUgtTqwXalfiRAElYlFhBCSoXSmf3rPpz
+D90UXwu9rERkWW9YXPuvArHoLoPPXg9Tfft3BMJc7vLHnAfXHgPs1xuGR2wYttuIj8MJoeOwLSaGYORFPzML6vx8024S/qUAhR8E
+kGYoKIetdCU3ECQXjSyLa46lywvKLUxkqF1xfHzcAyziOG0Gd16W7Q8e7T86fDB4dEAIYeat8Dp4PRSEGeJCHPVGAGRb+KLo8So/
+sju9IvXSnCqV5cmxZJka1w4hQJEckQ5Drmshs3IBcUyYtSrXJQL6QnZgr9p5Q76w8i/KIFlzPGI+PeqNrAUVbYp8C+9TX2WGgBzs
+zfgZFg2unC6jn4V9bj8ZVK6mPSSqK+H3dZdjgVmxD0Zc78TQ50Tf6SGexQxu8zW29snHfMJ1MQWnLttbFyVPrJOy87h0iE7Ld3HD
+o5bKERU1nprnkiDQlAFckibqtWI1/7goDJv3Eyz3+BObZBkdf2QTNCzRYaTYoqbVoqlnFUj1trbgVGevqPHGqgGEmb315Nw/8ZCv
+UGlXxKf0Tw/5f5XAf3WHFroi/oFe05mqL5sLym3S7pzHyxMm+LVKeFnq5OzOYOp2adESdPKCzehn0/+fpabfFk17X9y0bAvJUQiZ
+VLT7It/t5wEgOEKx/NKoqboQ74pdoC/Bj/QakPlQtaFoLNPIOWQSYbUoai3+UQAgsSA8jJpgyLIqYnxeqSlvWVxOeBpMF5SMJ0Wt
+1w13zWnprnlSUCxPWZZ5T5nE/y+88QRZxV5apwdmrhsm8i9MvypPUXW7nJrM5w2ZJybzj4bMOsNJFoX10NgCDuDOhsfOyecVnQo6
+U5cYPGvMXLdUu6143hp/xK2rSYsywUOaYCj1EY6DLuK3nLaFJIqJ+LOYiPewE/4uHBIFJhu7+6vcXZbVp8Z1zdz8k4C5+2S8t1DA
+M3srlNe+vNh6BYtWfq7eNLR7PoWtDOMJC05FTEJDp/RxQHrD4IjEw8AwLBISjtko7gR+MJEzAReV8JJxf4L5KBn3Jn6AKTIoOmj3
+DWEQmB8WdfKeSRBmkpguYP3pFlgb4dStwwR89iwyHApTG+octPZxPProIf+TJzAfUQl7p4/puD9Ba1QHN+Yb4P2uRFSI0beewBQ7
+Dr5xHJ+ukf99CfF+WwxPVymfdgHIVt6WHDN85gnUXQarYkx2zW8V/xNoGIT8Hyp1X31BXWvHfW/tuBoightJtvk9oP+XYQJ86B8g
+51Up51WwgouiaPWH8h3tlR8zRGN/+wayC1jz90tOvlJFD9IdAhSEkKwZQ2bK3QnIOE/qbyAoNHn/Zt5I4giTq3vMS3sICyKsry+E
+Rm+wnSZeE+3SP9MgSrKsIVPkmTumHHAWign6tTxBZSBiTyCLhDZvhzxB6ASVkSeUqpyfL4JkUapkkkymlZhlQEDsEBLIrhP1kciP
+SH1EMAB7mexnTY8YIIqRqEeKfIFCcWjGcFQVoRLSxCuwaZdeUX7tlZlECrdwScJ6SC2kqRG67i9eCFgNXroeyzL47IGkBq2R6+a1
+gKDWbAQFeeVVhuwkPYrGPSgxyzuapJFw3dKnp1kmoWGaTQkdUiCCyFR2vQKe8JyIHFNRz2JTaPHOaEfKDzyB/HC08wugqO4lFR7H
+Vwwhf+cXz/rG+XJA432801/nk7kye4MQMi823T2Lry/ZaxZr/x5CFnpWJWGuFGWlnjQ2hWY9qvq7Pd9+cyEs001R2eHj/DH6mA0f
+M5lrvXB+a+YJah6TdW5+NI+/xkH8WBqEKQvD+M2Tj1FsRBWJoKtW0HoXsEvaurhu9RxADjTLergYmet6gvR3ewizYrxs1FejDS4S
+YAyII8CUDN7AmgxNRMALapiymSGGz6FXwqxZtKZN/pzSMPI80aFol3X6qN1vmstn+Vw+Y8Nntbmk1EM3ag4tuufxRSJ4MBWONZmC
+lhgkrPzJy58htS+H4+PjvtvvPdh7sN9/ONjP9gaD/mBwsP+g71r3SSzrGBqEZlnTI8YMpnc77lftlDE82tBqqdmdUk/9oaIkTQdD
+i80isTGALO+ILIMjBFsgb03LijIqWUhM3SQe+50UvJf7FA3pcfE9RHTXysbsd1JgMeoxtAYAEsFDdulY10H+Jj5+zkYBzKWfUI1O
+GycKUPnTeEaL+ibFU5VyornoI93aoohPJVimNPSfJ3oIDdVGs1jXP8HCtKCBltMWbac1VSfuggKvfkFnXXsLylFpwv4lG9Oc9quc
+Qjlu/IQRQl4z1/WeMNLDL0EuDantNpaViQApWN64rJSTzYL0sBLyUVvIJ8he/75o0+50EXCYqsfCYyjrWQtkI6C0ABgm7QWzrlhP
+kDdMYmiK8v0nihtHFaHjP9iknL3zVmG0UrHuSguRXyQnuUjIdZvT623abUW0BBFsN0Ha7XcMF0fYfQfz+o6RHsIvGHrDugkV6hpQ
+V5vV5BlzXXkUzxg0XNsDr2PWoZ8FZUlYPKySVsCplKYFURR/ojMQEQOvAAQO4dx7y9AmvYA/GL4pi8WqagKFmKyPFTdNrBXgLRvw
+L5vUKoFrMfmbiqMNrdRlIhKf373hbrBaRddKZSTglykIeBK0xpu3AhGVRZNDYfGMwpVQX7D3DGq2RNxKqGgFUrRUTHjLdNMCnK7W
+CIREqqdcaG2dvMggfjm3pt/jHko+hWK68CxYbqZBQlt9P0dZKQv/TOmLZ0OZ8ajImMVTOfSTiMIf160l5XWt63AqQflN4vP+bi+n
+AFaUz2O+lGvRCjSvNxSLVsBaIZuHLBS0BXduCV+t6G3cvk/UQ/6cKvFLpMQvkNaFxl+lQsq7Eov6M0QxHKXhlHpc3fSYd+cxPwmm
+C5smLtjeQp5PBlxs+F8h9KANvK4/S7yuhZFwwTaMPzHKXzwz9IomzIm1mDNqiK6mChehWAYrQ+LAsiaEFZWXWytP45SJjXWvttb9
+SK+/C5IFEWXArfqXX1r/2q59Xa59LoWAuvA5p1eUJ5SwguabfoRnF49j4boX1NO/bcEGLTEhpGBBvlckN6OQb5SK3cCs+BSHwCX2
+exik+fQKRNGF7IJWmTFlUeVfLH8YhJq9ikMFHRHw69zMDJNfcE0TLn8GkaCczuB9EVoiEVqgsvfALH3PyGfq9ewH7sd89uTuxiGg
+eDMnmj009S4ZaIBMvWtFpXNykk8cpgVg2FDlcpgxDhDeCRQJmJM6w1A/59perLJGQC1csVGn7/f9HlobjKiS7Yp9Le1bUM/udSxF
+O5MSn0nnjYwgL8QaXsKxeVjrxwKm9gz2MEU+H32mXog5kijBkvDkS4hDJWzPJ5iOaDddzWAhywX8WA2P+t7KSxBegfoMjOISSnI8
+DnFc4l2flij4CmvnEmjdStqVRco/tSBUmD0/QIRw+9l1pbvX0ggcTpAkmmMcEE+9oPK6fv7r+PiYIXfJcGIKcZ+bRJtzOIPm+0dH
+QdY/OkpwQAhJRmMDXnuhIJz4XkysyQgRDo6S0ZjieOKPY0xLM/PYOkE0yzwqq85RLiUOTVsUxxRefaAVMQElpt4wLpTGYsODDYgY
+x5NhSEKzdrTYwwEc+kAeenMsLTGZBUrRew96wkFZJJ2QHk5BSSIXsAyTo2iYtNs4PToifaQ1OMQ4mQxzEmjquskOIaCVFmYkxcE4
+brcnZJoDoyeZ4hAH1hy9KW2AghddBmum4BpCT0yCwo6Pj0kfBeNkQvouGwnZnxFf6D4DUF0N5e6VF0bc7pc6f6U6L83JeKKZ39yM
+3mKB83EwkbpkCRrGXoKyzEtJWma0FofhO2D+IoTD7ipNFl6az8UL1W9oi/toRf4sSZwl5Zf0GaUr140VhzdPAXaO4odKFaJCuGcf
+x6rCH1DoIJHNW/k5FAtomxVkfDlTEgdaxU6jihztAyspRCPhh9a18aI6EEZYdx4Cvmqao4ITuVZMCaanfUT9Ii/LLCSZZf0du9wm
+Esh6pHEiRmVOeI79gOuFGwCjCg0yX2hF4zWylHu1DpCilUANVytymD0D+hxsHE5yYgvq+yVM6LFxz76g39XuWwUCjonQPEe4zGLJ
+eDT4IATlBK3kaJYlIYQEI+on69+8MMsUR5cK7ITsKojCGbyO3gZi4ah1TYm+2HBEwtEV8xU3MJXcwCl5R70Il9d9SgiJRtSf6pui
+y+kyvqJeinwvVJQqRXJuUjy1RvhH6aboECq5Pft7/cODgweHB5gS7+HBw0eP9vYPHrkUtT16fDxw8yTJSpOJ+8gdHAwO9w/6eweY
+tqGhh7g/eOB66qN/aPX6vDavfET9haWkEI7FBIgUS7BL64IotbJtyerlriva8FIN8/tetUGHBQor0JdUSO1JDJYchYC/UCJ5nF4M
++IvhgHT6yJcfdJy0C1lVbImSy6RPDlFHQgQKMfaVSbureOUhTCuXjQKII435JaKTGC7QTJGY9BEOxwFAErTjHBJrdl5a3JWfNA9o
+GwtOMg7+hEoFdaT4XdyjCIeEyUNueoK64Uj43pTCzIXHPdcNj2Zs9DMFpUq8YBh6k2j9L+qxrojVqBAIs7ZgA3kMTsO/ZLOs/g4q
+od/ibAMOtyTUTQ+hb0sPob/sh1BJE8JQxLaQVxe2FfZK+FNxw7lExH6oM0vqe8WeYJJsSV03Vf368vDLnzDNx/Go58cdhiMSdHLy
+JzqeAY8mInDLWlwFELnCSTeL9gsbKpGqGHU6kR+226YF7rp8TCfrks6gutGhRoJTYgESkVADEh4fczwl/bYXyN8FhlEgTasgwV4e
+DiVoiRHwJmoDwkP8F4v8TghsEuBJwb6cVgYRy0FM/ajdhpIeTNk4nmDeWTActr346IgjtFZ6ojG8wGIeXoZwUqVIehVMQ3GNE3KP
+KvVy2j0XQRiZATD7vRHRKxqVlNF+rhDmhr4ov7C+L15YRuWlQ3FiYCHyt4GFCJzorgjDieqdcJwowEiIk+IKJTFOzKsiwIn9oOjj
+xJLHW0+yH+BJ9gMj8hT28ILZp+I7aklQPEGUoiHasbnOsEriOL/RxVHO/t52hYNa4S/qjMgj2YNj8IuifEW7rxLlkUBD0S5Wamiw
+pBw+Ds0rGsf6Yaj7FsewhtaiohEn31KPV16K8omI/BDywlJevsKqCDbvxMq7Ti1IiDVEd3rY/Uy9fEDY3nh5n/LVZz2ovi3tLEMm
+yCePegZR1w2O9FHMqZW5t5MYjnPpVlMUAhRgxz3VWmSdZSCHpzAjcvCdhem2Sil4KflerSFO85pkiguSOLHblBeAfIDGCBdVC/hc
+F66rtDSKTn9kEuTV51s9hdBToeVQ5pgAy0/dntQg6BH19e0Cy6gaTaJwCqSNNNqzlBtoLmSsb6VigyvsAIWO+kdHZvnaC2abCpkt
+yokpMGSuy497QwRUtBoO6Odyd8kAWZFF8Yi1sO8vBamQy+lB3pEBF97WofUYJBVHpSCx5UtVnRq9/eIS5gtI2BY4sQWYsc+OeqO4
+zfywzWCogVoqIHXiEhYIjklSTM00ogH3ildxmo8eR/mUTIFUaU+PekNt0vAX9SLXjUpbYDTWJymawDJxhNM2WTA8bZP+0VE6nLqu
+F7TJFIfwTwL/xG0yLbpewQLGCC/gb4KGi2NZsb1g27st9yfnc5ajniVZHK1Gcp8kQOYtjlcj3ZSs5M9gTmauuzheweGMXXdWah/d
+RHAGIswLSK9IhC9JOrw8XrDhJWwEdTqvyer4+PgSuBtX5EpvmesJ1C++4DVjvqD4AnDDhMzgtkygf29Jlq671IT947mg3OO4hxOE
+cHBMFijokAVO4J8UJjiSly0u1XpC5zGnslpQyEyC4zDLFkcrte1hVaOhgvycBMfHxylAHs698x1CFvobXXAafByeA0XQJh6sCLp/
+jnDaUX3r1RifT9aR6wbHoaRmoiocKQ46UzAYcd3F0apUxowwxQtZRG6UDuyRjsXCaGLUJZ0A52eEBDaSJkmOpkma8/Oi/AJY3u0C
+kCYyOMJLW88nP+BVxoV+ZOQP0cQwL1LCxsnEGMBFEvrjAMZJIi0aiL0UOBowLXfjaET51ATHhSkCKejsACHcwOy4V3oIAmnf82mn
+L3fi0dHC4pb/dqtgRIAW2o9USUTg498lEfmxxGhRkpmymjsV5i5u5qD/Ztn31HjpIzWhPpiRnYMoRHLXozDZzl0veoe5MOD9CrTb
+r4xQ4cHzHANdZ5NvTFTY6grbL4OVInihW5yQWHIG4BIn+S2SDAvet9TJSAtUH6hdRoCYN78H92P5a+SFJKgzhqx7Oe+CKt6iWCPM
+SVjSrN+0UUGnc4268yhceblOok21SevZ/IvYE2qVQsj3OIkNg0PAY1WyV+QQOv1RoGmNQG6SRF+uCK0NvlMmekzWgclLEHBo8xni
+JMYhMbWlYEDXhW4VaRvofWrKqW9deLgFN6kNjtVCKnpT7p+wjniQLxXA7EPKhfWKleplufJRmtAfqtr4VY36UHi0qXJj4fhLCgdf
+UjgRBa/imbAtU4rKdk0s5KYhTWb8WHSNgK6iZiwINVmWVN3qa1ObphZYWAvoYBEkxEZZecGQTaN0RhNPGf2bz+bCi8CUs7QJyRuB
+RV0Fm5T5H5pdugiTYV1tuxFD7gA7VmJIoCvgJYZFg+p0pR+pLELIZ4ufVdSRRW5R7OYWU3SHWlxRYfS4xr3JsPiZp4JqZ/6TiPx2
+b9b8llBesNE58y+YGlyxu9Iy3izvMF7aV3zz0prV4oAhiiI5/wRwca6gaVrXYrrQ1/ZVIMDkcElhvm2NrUbvsMYgq7FXmXt5bypT
+vvbWOCx1G/Ot/ZZm9zPD4faVDklsrXSoFlpXCIeaDa452AkBKdgwF4VznOAcYpCN4QQDJoWL3Daai0ShGMzKq8hyPMFK68macEGB
+Kqg88vDCqp19tUeTMjLa0pi+xWDjAduytEdsrX61ScSI+Z1+h6mzWN50rLrpdFlZcjtGKaEfVkUrrNg+fxudtHKBC95RkBXbhWzg
+1ZYwh6pVLO60RoaVjymILMvzxAuuf+3chTtw7lx3ZyffWKE+eOEXnV/dTuX4jmI/XCMc5kNqOMMWYwcmVpL82yc31q4CrNMLSbn6
+ZbuNJcd2FPtJR8pIgZGVrHG49VBbgFQPdYxssGoH2zBy1fQEdfmWmaWaiCoFMfeUpOP+ZGiPaIojy/zokxdiPop8GNkUUBQceIs4
+XlUQ94p6qBsk8Gy4iKiHts6nHDbP1SeKbRADcuk1ShPbfXg9YN4NkhfLpemmAGheAShQkhFp3zD6DfhOAGIZxkbgJDc5rAEHyh0U
+NYIGD6ox3InyTcdH4wC0GWKE4UbUShbksX1kwjLZXeCJExhFLG1SrLEtRF2aJgU+tg7lJgaVUpQeMRL6klmFL6UUOqzIkmJyLaXR
+OCDnnrxTYC/tEBJnWQAWKKb4QihVY/l6KNlaGCmnEVkEnXiYApvRdb2EpEe9Uc9PVXZURiHqAS1NuJNR4puncGIeqniHu+5r+UJM
+jkkPWA9lVGFjM2Cey2MtEBaytDhKRhq5t2Ngf7M1uL24CyUXKtSr7WqSkvZ6LixROnP2VFgbSxj5fyD1QnZ6OLoV3ZiXYJbteCkJ
+2u2jOF+uqN3GmmIEhMP8qNPHIbyd4TEJA1vjaCvWMeSjfH267l2GEfNiHCHRFWsEJ1ZDvAVryeEMUWjhrHY7Pc7n9qOHtJwsL6Jz
+eJZpYpL68OOcjcACMe30cfEOMyk2tSrJlchSndxOdn4B+SfNKu+yDaCi2Qa3Eo2JxTyxyMUEuW67Hbgul6ViQIjBFxCOXwJxzG2Q
+6yQnTsCmatNCS4FIfUHte4qW7ik6NIhN3VMpkTZjEQFLsmF1MiKc4hjkTPD+AUVeyXqE+8v3EhDBffRQlURdbqdivoRgkKjxTqsO
+FRU+JDulo7GRzoj05MHRT0iJ3ogKW4HUIjhSRXAgnH4BufElw4i5PY4GQqWK1ZofnDjG0+EsVsaVid4JuLwNeJaF5oSH5oSHGHCe
+fcJVSumED5X4QacNY7JSdM4KsuEGSolF6sAlj9afFmFEgZtr9kCxn4AWmWrEYZE9V9Y7J6cxxnTSncZsGoia0XDFtxCX7F1IRj4l
+fARuC/w/vZqlMPXHdCLphztqfpkLylh2l6RFfTtD87LhdEFeqvjB3HUDL9Umpq6beAVtm67Vha1s54u3Jh8Bn7vkpSPxqOF/F+4t
+kBQwzKNACMo8cLOnb/uwy+ksnVKv5oCsYFzmPnXq9E5+bdE2W68xtGupmP89BG87XMKRamSqzswmnG2WxQP3ZtGRQK5rPIDgqN1H
+vjTMkcomo1CR1UjuSpiRnVSyANQBk1RCf1i4aIKxJXdG8xVkzRWuHk/ucDEP0U0dT0u4bfZQlB8yqRoOR0bJgoAxC57vdrxE77Yj
+MFTeib0o3005tm63Jb4eJopqDoB4iKqAK05uQBLFJV4XF0oFuV9X1rtEbRfkaulsqleIKsKrrxC0RvaeLXo638bpsPgbrju4TzWX
+237e11fOupEk2RrfekvwYrPF4OcSToMncAyXAvjJ0ylUp6xBsBqXGQHbwMAVFtMFZG31FGGgCbMs+K8B7OuC6YQ1x2kU+sF/DUaf
+gJHUboMPRPPTMJ8UV8liPFzkqyqyzBPklUDDEubtSVAbV1cUszTmWOAQdJ5HMh3uEAHSjVzvzQws7iYxFxu5KuO9CWbjvQkCP0uD
+SYeNB9BMrqI6KmOyeCwm+iCQwdqvZxK4u+BRO3rlxQp9js7g11svtp1gCUs3KJ8JW5Hx1hkQ2IwcAK7j3WKYn4QnpCMPzOA8g3rs
+GpX01HqTtcV9v60lzHQblp1K5bBSqSZoay9y12X6SVvoR+QGxfBLXgBZxo8tRy0fDeOrmb6Th1PdYnyz3EtfqNIXWYmNVPGTmSud
+YMWdqxwYoVzT7Xgs57Mrjwf6dFKPmV0Ph0EKOwpYbyPkaEHT840jITB8/NQLR5aUxKfy0SDl3MUl00iwCZObyC0Xb54yNb413DOi
+m8RL2lwMhg/C70T7jqHy9DMt8IHFxWJzJ3K21ghVSbITURJ6ApdgJCqa4ra+0akwNpA7xnNK2Vi2ySvR+Afc+mnSEukqosrdkGWC
+Y7uQnFItPER4VnbZJqp+JJk68Ny3zUzsUmVhtycrvdJYwn+LLNm3ZQ4iKuaschfZhP3Ia073kO0arCiihHqwQf03tjvNoqa6OWUR
+yzSkvCz0WIz6Pj0SYPZlndkzUbgJf2p8RGn2x7Pb16VwEsVbUoelE4Uf9QoNoUW5trkJjWXlYV/j2OKLx7Lv2HZfwQtmaqN7C15y
+b8G9OB8FM35HgqqHtj+Ep8Q/ar4DfU/AuZfeWKWKAhQmAfzSfnClEX9C6FqtD0ilV9STAiTCN/pEvWcbGtqmZNwmoV5UsHK5Df11
+ScVb08ubOcytRVQre1NeUq2wOngnSudZjrNi3NZVac47Oo35zCnq/qGXS/DrG5FfuM9F9yJkMy9/GqL1NJB2zOjG0uZ7ritvMmMX
++AYc2G+wB1caG2uc2EWk6bJG+3qwhQ8TKlrwdGCt0DCqW1wOqOuYM0aFcnRpX4uvxW2qOn+Bqs4TUbJh/ut2jR1GQq2xwzRqatDd
+L7oW3WAmcVeJB/1ENKjt/1ZS239ZOfINGhWipFEhJCUmnxfwDRZLuasfqf1Llytx7Slv2cvgY9nlw58lSry8XX9kNaq80AdiRh+I
+WVtV2ITnXxYWpRwsDzn5U6n+2Fo/729dse9gxX4Waqm++zct1c82WHq7lBWrftoydYL/ran7zpo6BlPHOPlJeEKUp+7bW6fuF5i6
+7/XUwUc3ZckinIvHFWeo3zftTM7tnfnDLYpkIW80xV7QYPZ1pti/WLMQwyzEnPwgyqbYv5YWwZYxW2ptY3DlMGaTPASGfY+I4u3B
+EC5QtBXp4vR6eRFHietuy600ZGn/3CsfaUtz47dSzlhgavn1/FE02VDmi7TJc0bRAGXbGujc3oCwGihc+OTuOr8/ffO6q5LD+bX0
+CCk/ShuLFT22Fp7VTV6ClxXugLzp+/S4SuTEzJCcxvtXzXWRILCpMVMvWzC37PuFGa60hsk5AWwkKi9NDs5yeDthnnTthGPAlCjr
+VR+dnDQWspvLG5OFyk2oBkwGwrZrwaA8FYK8ghfn3v7e4FH/4d7BHsIq6eiof5CBCn6nf4D3D/sP9w8e9R5YuXs6dw8fQKon2nsP
+D/cHjwb9R4dZD/1OddHfoVj/EA8G+4cPB4ODohGVs4f3BoeH+w8fPeo9gpyQ5nUssJPKCv4u2oPDg/39vYMHh4/aHj06OtR2lDBk
+yTtl2sVsQQtLy4ahesZi6nH1J4Q/ohsmL4wr3Vh+SoYpCVSWcjJMEvn1OEniaRiI8IqSVKa84TOJXeBlpOoxLLqmFseie0oFUVo8
+ESPOP/5xfv7i1av3Z4+fvDw5f3F28k7+OP/HPxw8reX/cPLryTOVuapXfv3s5BeTPa9lv3n37OSdyV4wcoBnDHT9FwwvGZkxkMmB
+Hyh8yYy/bXC0fV36Ogd3UReM9PFnRgb4EyMN3q4UknJd9bdrXsX4o4TJfDr4hJFPLMs+suFna22MU6y6YpAzfqHrTpw1/tz94eTX
+U3LO8OfuT49fvj85JRfw++T12bsXJ6fkM8N2syFL4AlCKl3FKZ9u9CxU+Oda222NT9hkQ5U1pt4b2EZvuvG8ocwbGyvhN2VY6J8b
+W31zpwnSD8jzHG7nlP7ZunGws3Yq3dnqTHV8r9qRZVy3xCzJ2aGgvFCUIoXrF8XYyjl1tTeqLG+EG7g+vE38Gz3En9UdQrHAwOZt
+rBrz5ro/VepS7xV+g/Cr0uQa8ciWFT6DWmfNK3xWWuGzUtOFh/KNjZ/9jYUeO9iZOJVev2A6+xuq3mU6+3I638LEvG2emLeliXlb
+2fpi++4PE8h/jd9opPoKv5GI9C1+kyPXM4lUT2uI7/TkR4n0htZWAafl0uDEe4HPEH5hQVNSUyndkDDnoEQI/KrC5/tYK69QNPHF
+Gr9omMCNrEhitYN57uGh09feFQiXfhVyoY7HxmLEO6EfTnCoeDy5Ymi7b3EjX9xlGe8Awnb5QSs85jlrMMQauHYbLKWlX2lMvXf4
+FcLv7jK/dtiL+lwrd2ljKif5XTlQXFNAJbtWJZgc0Iz4XcMM3bZOqrWcfwwkveTj5FOm3NiQ0HZgw8diFHZiP54M7XUMJjgor2Bc
+OBCPm+HbuoJ3Am4b+7gEag5T+zgsuL8Y4JYLa4E3npuz9AecpT+aJraJI65NSO+klgGlh/ZoDR8Rc/I0Dysz9049jpBZtni448Wb
+uPnxVm7+H42z//dGEfNbhyF3iBxDaaE+5npcWxdQFBYCRfjFmEGMlU+eHqkatT6bz2G9nv/L1qt6fHJCkJOGOBMa5eVOiCysB3GC
+ioUqVOHLC81KCz00mzq2ZN8QbyjAutXAalLaWxZepPDzf+f6b5+SLRpb4XF+vs0OYJYigChp6oihHL7eAWvLeV6I+dhgbAndUzak
+3j3YHPfuRo6UfCuPnHd0RQPRGk8cP/8NTmwLF9htx3xDjbbTEuGSJi2g6+993V1snJWW6tc1/IsL4hfbJbfUZr9XfSFuxra29xYT
+2kkC4iuv33bT51LVsiPjsqJKN5uNIBTlUx7MjH5+M7/LWEY9v9Mv146CRLz4ghbyYdZauv22lAFA82liJQrG7oaVDzQrSBjW3OWW
+CxDz28kVfqQYpCPY9VyKMhUkyNcaKnanKtxA02SVYrneG1XmLm/zV4/qA/UYDtSPcKB+/LoDJd2rq/OkfubHSTpJbzvdbtekUDZr
+e1LfOPeVPnLAN3tRha58x0FtRx64H7/uwKmOc+L3vtW2KDfaeAoVuvJox2oN7RaNWN5KpEJ4PhvKcYX08j6PYiUrxj/ecnRLRzVv
+Sh9ZjxIpVcqTsSBwaO2EI6JCBv0IrmCQr37m4ibLRz3CeaqopcqRIVSdnvqpVLNTmhyJ0f+raIaQXr7/7XmTnktgzqwzmJ8wjfY7
+/TIItyAH2Y4GUxLPP3458awVq7i1K3FIRGkzsfvct74NOc0UOV3gkBDHNdJ5GLbBwxH3uUVE//iFRPTXArmdrA6H+RtNg4jjY5ZT
+1aDzFUjs8+OXY58fbdiUYE7+dN0iaoJMpWzmusWwTFG68n81R1jhqmeAqyhVLFFBMaXwg5kfXP+g+iUuS+RvcFlMvsy5UhB/w/Cr
+RiahPMDhMo1ctzMwBxq+PcvB/wCN8vQKZ5xmpIfBfEbTU4cHB3sHLsVc/8qVYdh93vY8qvjI93mb3fc0U/noqH8IoR+BUYzPcrFa
+mJzkLtfxW2ZjZ5Bml8U6Fdn0zRo7/3AweNPf6RWy7TwOztoDp/BN8/EzDT6+ClZDcITvvVFB03SaIhnBozz+gxHn/DwXU4NY6/zc
+GW5hwnp/MKJ+e38wpJp6zkj/EL9mZHBwgIsIAEPqrSgWFOEVrfBwrqSWacqU5kjOyMG9/Klxi2vuMnFgBQ4gA3myWbt/nDv4q/tV
+fxUmScguVUAxGZP3I72WWiNjcAmgPFbAb0zHrA0eYeE8rehXctNeBauCbbqid74gpcOTUfETinu5v1oo7otKg8mmBj/SnL2G6nVe
+sC1gKFO4F5Bju/osCtUmR7l7aMI2BRhXrFprRiMK/Wy8MyxA6lBcsRoYqjypRiLWFQD551svd4bqKVWncn9jOpFVG9t/wWo9gLcQ
+JsMMqbUyaqvv9Oil1hO2bfS5skZXxX1e6Uh6eLqVroNfflkpZeTl2YDf8p2k3A3psrYjC51kOdFR8yH9YpeBkm5uG4B6pQepW7TZ
+tPX64CK3ab1f5fukjiX6qLGpysZRNE9T7eGGPQVjbDDwbAzAIns07oS1NFodyLHIeWSTdSOg4Bp4y7yd0VvmzLgW/sLhmvalg2Ms
+Njb9759H6NV2zXz3+UxiLpq2z2/UuxCGEGmq9OS6GeFZFUVD1dKN1DBNciqazKA9KQv+FCSP1cny0EgG9WdJyukbOKwVdTKFgird
+5y1vvnXMwbeRgd2L8qBeaze3qd7cst1KbV7ygW2ur3FKpeZtUiGA93rz3bXpudD8ki8QoOsWv7v10G959/Cspx6EglBBIeAOEAjz
+GhjW7JAN3rCbVohC5IEcR1sYGlMbPSO/sj1yV0K2ApJSwQXIwuRVsCJzRTT/WZNhvXr8Vgnu/2LEHsfwLwYxSQDt/8X0fUz+Yvo+
+x/mvF4zk+S8YXmwjaao+8+2oINqJcpg76j8K5OMsnHu/eAyH43gCPr5zFi18963g7uWeG6/7wkOsASEhofILnpZhiUgPT0lqYImO
+pq6784vHcTqOJBjDqN1WN/mcREdTeBrPRzKzLz1u+onNtoVsGfvBA1vOOXJd6Y0TLDuBm5LmnFZ4iM5dFxyImsRj8nMRmI96FKc4
+N8adSfehZjsZ5dMlmY1Sf2EZM85HyUh67u30R0vtZWs5jiak9DHmOJz4S2WEJT8Qno08e2LIUtMAJhQHeNJb49nti14JFiZDNBjZ
+Q//oyPNMzHZ4QVGIKIFwTKzwNZZNiBe7ISjLFyFpxn9QSOz00URxTSDIhNCeT2ZfsDFyMJW/hJh6XIOpg16AmxeIlyAARIjtABEu
+IhtQcK+5Q7zITZXQZeq6YSmcidwRykT1D/CAmXb6CM9JMRq8INPRfLya5PHmIejKAtAAjKsAF9qHR/iiutmg05nrzvM99FPeuwwS
+MccRTvBMNgBbe+a68FI25V33lHrzcf/3VXHg1KeuMHPdfrX8LC86k6NbNm7OKzIdzUaRH/2e+lGW4kv5/Zx6c7zCM7xE/hP1e4n8
+10WqWf3lyLOmmlzZYYkurb0po2Nc4Uu0xsu/tznrG9PsS7XzwkKiOYqrm8/nlf7/2dtPo6/I3j1TEo0TZdHrujvThm13Qr1p825a
+2f698xpzUoSCkjtAYaqV63Y686PvimCXMOsRnuNEm2vO223ZwKJxL8zIc+rBRlzhRb7Ci5FXdEbm9gLPrAVeqm08Q2t89b925Vz9
+k5ZWrSLMLDPMdR1zx/SdaH6yvEXk5fG0IIQwwwpd235//xfus0RhkaktYlYBvqwR4XTc/z2a/J9wgV3VYFuiNb68fTvlki1mBSaD
+aRxZn/2Jzyut3bZFyhQJrE+5eT0dXjoKzZzlfSXI3iKJYh/AsvjpqGGSR16pOgmteamvmZpM36vtPLmNdTZCFSLMkONXTYlbWfpm
+33LSkyEt8ugcHFRgeE1xKQTW96QIfl259E2fyy8GRGHUzWBoy8sCBjgasQ69GXdtpYUCuMqu2KpFR611kpoV1xR/RvjabgDk8qRi
+wa+jS+Rh8bB+kKoweEMxLOzdhBwlDolQkph2GwbBdZ83xp2EOdUyRp7JzX2zcrNmsgIj+bel9XVEWEMjIU3GpYh9IybV0Iq2oTW5
+EJW2crNXdRM3NSKXQ4IUa4htAEzasDQ55IJ6MTwvpzETIUvpupxt/e6qWH+2MwKJ194z/DNoPu/u45/k3wH+Tn0PqfeSYkYRfkmb
+tRmh+ZJC48uv5TW/DBNRqG6+3M5sDueeR0kudEVS0EctQd8NbeuhW0EhGPnBYIPCVMh1jX99Cr7Qi+Cj+OWd+NPfld74L+/GTbbF
+yJpkk6CDmRCYjdns0U5fZaq7QyWvZEgCivuG21LqN2QJ5dv483n9Xh3ofwrL1p56/ZE7Rze8WuUffcFs7q76KZ2kfymj9yfqVYcC
+V2tdelNwAkVdiUUmbBDfMHTzC/WYDD+Si2iGRaSx3pAXEh3AtyrksWhzTMdc6QuW4Yub2Km/6A3Vw51+tYa2JNsyqLsMRMiBCNyp
+j6IpoLVxgz5mDWOowVMZRW0Im7jvv27mvr+8M/f919u47y/vytT+tZmp/fKfxdT+tcbUrqIb8KPfqKKU7w9aq/W12lqmTaWcpVW1
+qq3fojnQw5y8p8aD4W0ampZyJoH4TbkaAJM+VtabOt/mzgLoHgsCj5HQk6GIfmGF9wqp8FTRdOW1zr6aK/oz9Wzkh8vIz0Z9Ns+0
+wHt3Y5+q2QkTuDTJn4pb+m2NW/ryxemZYpd+z4g9wOH3DCKXAfL8PmeXfp+zS79nWrT6l/5RlHrBinKqgPkNZfST4S/zq0hTZc1v
+SDeir7/yn3mqFub8VfqE3LIU469KApQoBA1/WR8qpxAV/FX6lC0XcoC/7C/8V/1CV3FFarJT7e1fjPpHR8LPr8tSPJcqr4ITyR/R
+oU/48eYqRdwY7TIuBIYAIWAaAQGIeraDPE3QKALfC0kAURhs4AFmCNrEEAJhsuuW3Omt9bMgrMGbQDgZQ0HBqyZGxSO+N0yP+DCF
+GIcagHRCynFZIUBLAR0JpX+whimWYVk2zbBnphhl2Z2nudO/40TnlcLSxMaNExu7bmyDXJ7X2HV5E3ydfmm21bp9XyNNA11JU2u8
+DfEZIW5LaQIDRcT/wPAvWnnlN4pXFOHf7kqy//a1JLs28ixpify2nXC3lb6XwUqxIPMRFz7ytPJIFCZKeYRB9AxfVNr/p6oXADg6
+IhW2ereTkIwzUwFi48uACftl8NvdXgZMlPRMfvtCYSUMwhbWZpk1FDuj0vTt4siyKFK21+DjrRxcrSZ/3NDrJhmq1decx8uTwq6y
+YkxQa3fT/S19eFbv7trRF9YOLbWlTN1LU1DOHRZR1mVwwrtKQ5VXkaJhwsw9/xstzKlBNvojLY3VWB39VldIIg1bTg7vVzakHhdg
+kcbF3ZW6YMaNmi9al6tutT6TFdVrt1JN6rFtNnnUVU0pr1p9Q1AW9f7EgkT6MO30yjofJo4N+AfbBgPVABg3dUXMFoRFBRZYwS2i
+/NRCBptg4dtgYRVYwN+YXAgp1C+BsvUwY95wnGXLxXEuATZqDr2gQ4KvfY8RMXoqtP6Z5QQ/tAqHGMKuMh+oey6nD20AuoYLwnkZ
+HrQZ8k2mRXUfd7wE8y1hSFjFnEw5hhyF8r0C4+I+r7mD5KJ+SKkXCrB+CMUdjXOsIZhi8hCF4m+qklQWvAF/wwLDqAziDsWXWmJu
+mvnbrPfYNus9Xljv6Wci9WKB3yIcfxk+Ks1nLP7GXXi3uRT5TMb/nJn8O7OYz2BlJgN5KQQ2gMaZwXYUrbHSulz3nziT0rjw5lR4
+uSvV2BOWmhofKUIROKJAdJjvHvIN/aFmP/gXz74d4GS7HaIahrDsMuUAuRHmx17h3QkWLRxxMyRwZpp/9+G7L4PTqmgnYoODC74p
+I96UEWzKeCPAzYGQWvJvxde9H95p/Nt2iufD2zsf4pJnwSbL9rfiNhHCjsWGLy8LIw2djOlkWCW2R5WnjArSUe679koJ5xUyEFWb
+dd2iXfsBMrQkZZb7QcvtsvQ8B47f9E/yQhMe2gdcGbTk1mmpmiB8YzkLTNlHFn8C3fjrlvNNm7a/cVoxa33TNiurtn0xqJ1iVLp5
+Ew2uebprz2RGGqco0UTpsFGlNMtY3oNZMt9MC6tOScPTbNNWaXgu2FCpluxgXxWwxGawRBWsr3j7VVrYiuvKRCHzGtaj6nrYeh9w
+42yy4WH29q4Xwj8dBNoMwT/taWiv9eZ34AtLK/sOzz8tk73HiA328F7Opr2Xs2nvWUzZexuYsvc0DzVnpuYpUmbxl/WR55SZsvcs
+LmyJJVvKKbVmEkol6gzdezU2870GFvK9DSzke7eyg+9tZAff28oOpt5rgTlF+LW4IwftteJPyNCoGy4sDxJARUXFlpB1vtbFkSju
+y9d3vi8LpFupFcxmTbVemn1bwbI7vRr0m7lZ9UZypFhtZBMvr96EvhCrDaQsjNmXGetRQu8YpwRLKb0xtpKourdjMRmzrHyyla50
+qcLXWAW2USjN+dBdnan6VdLAgwio1akKmaA8oVNxx+nSWjW0iVUOc7jB93kogbLQ5h2l7KI+WoFuaJdeUX7d1FE5SiXKMlYEboZZ
+qQw/SS8ED/5vG/1m//Slwbvu9sFvkr9LqOQZ2uCjtKGZr5B7N3cjaku0wT7qvSjbR70Wd7SPsiqKhqpfRV69/jtvXUU11J+6dlxX
+j2Oesw1eiy/mnEMfGykm0UStvRb/JjZ6QSDpRpSb7LsSSQAoOMMT5Il6sf3W4O9OC79/ZMQe1vBHNv5N8eN+zKmqH3Oq6keb3jEf
+pVS563+0Kacfb6VFftxIi/y4lRb5kRlv4uQvob5gosifatSUD6n3XuDXAuH3d6VW3n8FtfJe/D0ZYYloeW95iIUl/FkNRnBidzMU
+3PBNBc9n4TuhvuQs/KQqMpiFb4VUVPz2rrPw7VfTX6BLWWgqfivuLPAEt9xS08pSXRwy16WdzhAxzSvKH0AjHWjFF5VOVpR+3Ago
+9OG6xW/VRrWFimKcvuGqRuWlA13R05X+qqoVcr1d6BmzWoOd/hB8sgxZp4OE8aebFwK3BTADvlg3v5htqS3FRU9E2EK22xQFfzAC
+rNqUPI6iOpaTMj91/9suZOScFEFTbHyXyCjh9prXg/430nMC2O1mWqiaC7ZeI7x5GoQ9DewLp0GxpL8Vt6okqv6kFkK/WqNBJdGu
+BfO6iaBoaqe6BtW2tBP9ct3tEMSrOwHwT1UmkOtRXoG7LMovwquC1aTTF869qgun2uXLKs6cgIwte3Oy3y+mOqupExZBcnDJmyEv
+KncYDu3tzgCfhToK7u1HmdtzFn7hHpbR9soz9je0934QNZtmicq+3qb527vQiDXPiQWeOC+YWSUt3dw5k0IwuX6jliErNUql5cgJ
+L60E2wBVzL8crFjScs0wbXbYyAtHnUr4ocsaSLGlCFoOnfitJPukZcP3WoLCa4Tf2eOnPyjSL+TEHuow5BBgAzZQyG8j2UK+iWQL
++TaSLeTWM6GsTSgBjvlQdI0Hd/IZ/wqI+EY7CreD+UwtA3sTiBhmUxb0LNZDRbuhUJdofmAwdKPCc6wRpmts++NuiCUEPYaanw+F
+vz/1N7uJrwfzs06d6zZ60oAmR+oPmD7IV4n6UN29ef0v6PDN65H50dCp8X2+YTp4oVYCpV8Fq4aCK716dqBZWVx5zLptoW+qNNBd
+FrJQE2oA6LeNAOWUeEOt98IL1OKPKpvLz7dEc83Xd6u5eZpja9c1l0rs9u197CE/KOcVY/bzvSO0zBpo+2D6saGDb+8wBtDBbqj6
+kt5h+PJJ4W+K8HARUXBMeX5eL2k/5MsECm0L3/Ira5+RylPmVbBaUY66f8Qh8xzckp4wnbZYYxUUumFQJ3rnX+m/Tfwy2FSGI+Vv
+IuXKzCxh+1/Vwau1tZ2/8UVmxfKG4sAbrMxP/VwxK/b7pnMlLVyV0M8QP8rqtXDQCvf7Tn+NMFtjxT9uXhkzXVNRiriAoBab+VX9
+Zousgnyp7liSa/IRCPpBwKyfD83dlsaNK+MUI6qCzwkZUxPD6peWqVybkiKo9chx2tR3sKNfO46D7zSh6IaNYM580SYUi7a07dqR
+0VLzxvmIW3FGfMdRKnbgvv0OW+ActsAyWDXPh1kGWwcPNLRkDNjaKtR3Dce568rqi/ZoMArJTs/nxRu0AVFLg+lw5IWwcTgRyOeE
+mrjNHKsCWpFPAvUuvFyIbfvDRmgFWZbvEx3fVr97eOnRo0tvOd2W2iTED4RXwPaJXdT2N5zvxjpKkK44+T8KY/uUxFw04QrTQYnn
+KzHpXXbFBeyKi1S8DJKNURLVq1bb2oXJCTCZGsrm+7RAtCW06+80oLV8zD1AaNJHRPM8zjw6KmFrLZRSjsTUbSGrP7lubmBVUjVX
+PlOb5rPwcYqNXpffqDw7lEG/VJAa2wTlRZGqkUA5iPNvAlUu4kL/zFbhblS2zZXIhEGrr+MNM6aRpJylYhvlWHPD0WG3oSqW4/4c
+9bMS6udkHGI2Udifqx5/oNVVsZh+zYgcrI4hHrWsX96eZV+PWw67bFoXLxpqHv4dW8sBLTVZH98dm/uBXmtW2zzkm4+gHMcVlIoC
+8eo2JH5dRuI61v025HGZ19B3r7UPN5CeQU56lgKsNuxANvMa9aPB3QYcM8PBUE29YP72aNw4JNLHpgzAHTZFcogJKzQGPQ6XqNR9
+0cqAMdiN+FcMlb04rQtLx0sep6tNiGReQiSLYDMFp9Tdrhiwka6YLPuCbSv9gpXKh8lpelGNTZszaBofboaqHFFfHfniKmlSui3L
+RVWPK8q/sE8NpumzSDE75CO9fjPfOHBzDrbSukrQcpdn7r0afl3jaMv1VrNc6MqjaKr9sBX2DUdbDlgyYZfB56bKn0VxzSyDz5v2
+2mdLHrvGy5BtbWtEIdnnTJW9tVEoD4SWOoac3kYCwP2ffAxXm58sspj0w70MPnsqbLOsUkHgNQS0YSWgqketFN3cz4swuoXgWtYJ
+ro/h6j0TYbQFW+WNVyivJ9fbe6sIzkXwkd4ySz1cnSeo9JXzBFWr8wRpd5inWfmygFq3TVLesjVJ5u2++ZSVTyRwiZ/GM7qZRoUS
+YH1UfJFYqTwi8NmtYq9zYvMuAz6OlDg04DL0YqC5pyDT0OwrSNOcRJNov/WJYJBsYkAG/ItCPwa8O10EIYN6+qqWaTETQcgSEhRG
+FcDUZPhmHoWrLe+MRJgRw9vtpPrg32LE0rhjSmxAScflN4Gm5cYxDifGqgVVbAoVFD/Q680gbO0eBluFguOwDgXHoe5f1sjXO+GE
+WeutqyV8PFXLnuTLbjxOJeV1V5xFk1pa+Mb9XgkmLFDbAV/yMgTxGpaQ45viDriNGdpHX8EM6RtmiDxAX0xGj4CKlpF5dFgOf4Nr
+Y3NnDWtB9iDksVC34YvtbeQX5rZ2vuB13f+a17WqtGqopWaryqCQGvikwMaZwD0lz2ZZNlAe/nZERacNZpke9UaFb8Pc81DBFCEl
+fF9MiQEbnIqxEfclhpgGwrNCUeMBwlZ12hYIWS+OO2yG/K2zaUNsfXIoM5ovfT300bYHgaXUAZOXZfmEqbjZWVZnIrguPbZky/4t
+jwpGCKGlN0UzhV73jeXV+x7V4LMcaPmdPHJSEfBGMnYF5as4odum7Nzm1sgaEQ2uaJ3TIL2gTRr2B0JYkI/CsxGsjDSLlQF2Vy+c
+Z1v26hn1dPR/9X2/iLF7Ypl8bKS4IbBRsdO3E9ewizr9r6Ta5Gb6a+v9qCcAM4a3zJRuBxTRGjCXXTSfqXFvoq7Uak+KTgLWhmW8
+ulLXD6/bs/4qvBDfbH8iay9nUo3pVrGAUVDf/iCy7MHXCIcVDXibDLHzcjolbDC+haG8wtb1iyDlDPNKylscVlIErVVjtFaP03LF
+GyPg8QWGkb7BT+MoonK0PqUY2CArii2B3m8US0nTS4qVtOpbqCn81wJbArz3Ar+j05jP/LcCyxhp/o9YxR7072Hw9CTZH9+f+t9J
+W8Eqo8hJE9pKBA+nwhmWhKrmd4uXRaxS3yJ5Fc/SiI6of6MNdny6LoLVhx5VQYPUnpR0xyrgiVRqgAvKdR0Vt9UhhHjWpeqkTIUW
+mjm+18PPu7p55MGNkXM38ghD69xvZd55bMEL761RCN2OaE4wgQDYv7HADewRjqihqItfHvIjeIyOJ0WlxKqke/BLIaooNcB3n4dy
+riQ0skGIoS8bpmj0JC8GW5wqijKBZz+sv4f8okCxPYpyPi1gSu2rM5coez38rphHimomEGJb5IuxmKwb1NcKrWUGjmnA4Z0SMyk/
+fkgKy3LAImuyahNA/TG1Jna6PRJHUXBVFNzZoRu2FN2ypeC+ylub37XbxZaxFKVmNmasrYAS19h86Mp8Cg9mFHMpcrxZWw0vv7xh
+7VzaNGk5Z2qcsnDLlIUIua7Xw2+LJIbDOpRX1iTZ+0x0Z2GygtOLpb32qQgEHTYUrCaxLYvDRsyjHkK+8MDs2zrZl8XFSHP15oKA
+MFeExykaceqLzdZBzkBOEm07DgJOPpLWBxZ/Le/zujCsLU6uQf/dMDE/LcvSopw68lp2TTUbtbzLBBoJfyxyh8uV2tpLnMfQiPnV
+hgsoz4uZmcVTSTBIB19G+uM4QykVEfSzeBozQZkJb3N8QPdQIcMp8mvLSEv8buVIGiekVAmnpIcjkoCB+5T08YrQbsgY5d+dvXoJ
+Euc5cAEIw4zMjx64rvPhgwPvjL4/HcpJnpJI1m+30wmOycpA2cc70yybHz+EOrBZplk23v1wutsVNBHeFOE+7uOd3fG9D58mRaLn
+7DryraQqMeS6Mf7G+UY+nGLsfOPoX8k47exP2rzNCHE6nQ+f96iD1df9XWcynk+kq7SV63pSLkvZ7OkijGZeQER3ymkg6ElEYd49
+J1kFzEGom1DxWAgeXqSCek4iriPq4LEzjaOY+63/PDg4GLbmMROdT1QKjFsXcTQbOthR/7fLQcpkPB/Nj/ZGA39+fDja9+fHe6M9
+v737uxd4F4k0icqiMEgyNssM0ZjBHssSb5kllAs0ylIRo+zCCxKa0cuQZXEceTRgaJRB7OjsWlCUTWU2nOpsEfBsQacf6SyLgiTJ
+opjRLF6uoixmici002KUzah3kV5eUp5Nw2UQZdMo4DSbe7BZM8rRKAtZKLLIo5eBoBkVFI1QNouzWZxeRDSj3nQRZ1EyCudZlFAv
+nKMRjENqgmYsXWZXlInsszelK5HRabbyonAaiizmAmWCemyWZCwJY5ZxhhCCrqNILHicXi6yIEpoFrIg8qJrAOUzDCcOoLZHg+kC
+Rh9SmLZ4maVs6sF+BwAvo/giiLLLWMTZZRrwWRZ682y58tRSJ5kFBPNCkUly1DuP2ZSiURaFMFupyAwVkQmP8nkwpRnlLIjQCI2y
+BGWRFywvZkFGRRZPP2Yxu0TZ0gunPI5gY2SSOsuUgiLKXgevM+YFS5qsoKVAhFc0o59FRj9lYZTFIkujCGWxJy+DbKXE6RnPUpFd
+Uc7DGUXZygumH4NLmgU8WCYZD69gXXgs6FRQmAgRT+MoSy+icIoy7gUh7BgvmMUsuoaFm2eXYSIoz4A4zf5MQ16MO5mmNFNkaCb4
+dQZ4BKEs8S6uYfGDiM4yGs2zRcxFFl4yOsvCv+T0BCKcZpKllUkLyCy9SKY8XIkMZD/ZNZsueMzCv+gs+xSK6QLBjC5XEQC/oCxb
+hEkGLg+SUcYDloSwaXhKM36dXa+oF8jzMaPzLJxlLFjSLJ4jlKUe86Z6m8/o3IOrEvZJzLKIJkmWKBhFGKEsoRnEcETZlRfwLOQi
+DaIsDmdZHAUihPX55E0XAT8XCiDKaQZvyywUC5R9jnl2HdJohu5pJLVCfm+CcFBCKgalnNHP4nU8o94KIYRDMnfd+dGD0dwP8Uph
+035/uDPu493xh92b9dj70GnfJ0fH/jD78KGLRzvuP/7Hwoa74w8TVPouY0uJLCGGzNHAdZ0jZ4cwhSynClNO8bQdtZNx2u5P5J/B
+hBDnw+e96U6n40AmIc7u/fzXroOd/4R6k3GnM58M0XDVJtP1ukRaXRRXV5X7ddy3VIzyzHF/MrI/fOcjvXYa7FWOB43VB3b1wcS6
+VYESV44xS2kqrJVHUZbtlM1Iajd9OK9d7yAnIHDBY3PlHvWLC1daoXnMMOWqDeKQSP83sRQcgP5RYnxywoMs996JIwJSG4t4NFxm
+D4E0OiZeSqKSQBoNoVE19VOSasXzFbTzrGhnCry+OVkBEAuyAkc1M3LhAb1nBG9YOcoO8RyhISfN9MsMjXJO4gz5XAXOmKF18fgL
+YIwJoes5oOroWj45d2LXjbpquopfHsoLhXMv0G5OknUhJ8+312eLr9bDPxcj83r4rw2Ph0+2BNOjXU5XUTCl3u6H7vj37ofdyf17
+u9hx7CofLdpS8eSdnfy1kWWOox4vkgB/2U0CForwL/qeR6h0GE6Mw5KmV2LJk6BFb8IbTVF7pc0pkw21XOZKltg8XRlqOQEOlOcM
+HPUisN8hklPmTBWF56Asu1kjc8R68DIkuoiuAkUaX7kIc+KxWnPNZQsDRq1cjkPCdbcQmQhGZ4aQZeG6OZSxwE7B4HCwts4ClS71
+YtFBkmOWENGlyTRYSSPRlyH7+DYQi5y4qyQmiyCK4k8noKolLTFF9yINo9nzmC+fBQKIQnhQUC5zRAwJkmVySgM+XRD1WgqWq4ie
+Thd0GShHUuEsEPQtXMmlbyEoZ1bKq5C9lPNgpwWfa2nfpuHM+nwGN0q4pFaStpssEp7EcUQDuzPgdlifL5igl5RbKa/T5UUp4VXI
+wmW6LAOnU1Y8Xj2VVy3UoJzH/GUMACzpMlZ839AyztC4DnbxKWHe3j7CTwn3ThF+TJjXf4DwM8K9xwi/Icx7dIDwK8K9NwifQeED
+hN8S7p0h/IIw72CA8DvCvRcI/0GYt3+I8HPCvT8g7nKYAFNL7SASYtFVD+lwfk1iLJTDXvgIsNKD+/5U71SSYCEZJWexfPuSFIsu
+i/kyiMK/qLwCSIShg+eMTOUP3ctKpaZsSubypyq8UJ2Dq9CZ+vlO8gAgmFA3uU4EXZ4tUvbxVTibRfRTwCm5wsIcIIAhTUB2DRHK
+YI9JF+PXWHQX4eVCUpPkHAtgNp1JhhS5wLAzk2kQPQ0SSj6XPmHtgUwin7CwkRb5qFp/PAV6HN5+PI6ikF2+o8kqZgklJ3LNXhPm
+PUT4CeHea4Rfwir0Bgj/CYu1v4fwX4R7fyL8HtZjcIDwz4R77xH+SX4PEP6OcO8nhL8lzBsM9hH+nnDvW4R/kIv9COFfCPd+QPhX
+2AuDHsL3CPd+Rfg3uTf6CP8IPfYRppRw70eEBSXMO3z0CGEGKYIizCnJ8RYOq/uvgdN9GxdgjWNKvGJDf2fKY2u/N7Rb55bfwkCB
+61GySNQNKtAN9RB08VgWSbqMfjqDi5GdcK6sReE/XD6Ed5FC3pGQGk8wr9fda6y7Z9fdg7qb2JuqmR1CyjnC5GQZXOW/WHVstrjy
+O275i2RFcCTtqE+M2WR4x8UdWRdzmHgUh8j3DNtt5ws4lVnWWOsWZh1yXbpDSKicpJTVlXOW2o7Xw/eszsAwW6joH6BEU0PKNatB
+emwkyo503NVapoloXdAWPIZaYhGwlq4Lav4BbUD99TaPNrR5Ka9XrptV1Z01Tmj9hqk6ddv9vTP6MGt7H7rwB43Mk4qi5q6CFpMN
+OWucNl1pG9q/tVnWClULzhqX7kp5dVZapa67422TLGwEfh5G1FnjKW24riudOIKnFHaWNKID9of+2Onpv9LcdFNPF6pVB2L61omF
++oDqFO+mlnXBNZ7TJsKk0nSYvA5ee5Cby5s2zo5pw4HAcFUaqL6y4xtvMhr3Oo+Cznxy83DdyX/vrzvjfudgkifsrTvjh4+Ci3KK
++d0frMdoPbnD5gNIHIgW10i11U+MQaB3OYyqEQcigDbSiRtbv+uxzDu4og2kaa35HdAtekcvTz6vAFNvnpl5DHR0a6UaUvZrl/L+
+LJPDf+uacl37C3MynsDD2nWdi3h2LZ9m6lESMgeN9G/5Ujj/vIwc5NtJDsKxKc+pZHzNHIQDwkzNRFL0UA0nJFBpS40wEU7zJI3s
+EI5MEhwfB+Gp+Z4DJSkchFdWO3op4FletJQnLkyinlJHMiUi1/XiLAuRmrsZMUeWEBK5boiXxJFUrk4oMzFC5Lomcim+Kpdsfu6r
+Glr3CF8SRyIv3dkW5IeviWOwjyrthVkmIxyGCJ8TR2PwIk/FC8QXxDFIuJpnwiR6syxbZtlVll1m2XWWnWfZRWEZohgSzju9oq05
+cOlaYdJisWiteHwVzuQ6y4AtCzWNn8kV9UIIKPvZdXULnxHEX1mpzleq3Ccyg3IrNPyUl/sky81VgY9kSSUDZfgxL/BRFkhUQ4kq
+d0JiKJeg4Ule7kSWS1W5VJU7JQGUS9HwNC93KsvZ667KPjWPLDi23lPiwKHrCEClEAV1NKdeiHwnBewlExYyYUUlQWKmb6h7eVqE
+UiwtpOrrMZlCNdnV41rdx1Zda51V1Wckyas+q1V9ZlW194Gq+4aked03tbpvrLrFzlY1X9mTk2/ooolX6rS9YN7YCQVdJg5Wh3gC
+mi11LzEF8tLtWgN9NWJE3nG+PQRITVVqsXSvZFzfFSQjzPIlvpHKXr7A8p3hM+k/Lh9bfgQVCGckyiflrDYpZ8hipuE6u6KuqvS1
+zNu/wbm9WQP0u5+Xkb58BTKWv5+XkeTWfl5GXXjB6kvv8zIiOvNmjXdo9949TueW/PN6RbNMxkldJvBjpRhJIZVfwWwWwqiD6G2e
+PvrmaPR5GbVAcTSMGXH63Z7Tomwaz4Bact6fPe88dEbHH5hmlLd+efWyRT/L2WxNlT9muHEpk+Zss5YSP37j5ww8TjSg3aV86u1+
+OL3/Ydf7cNpG93bRsBglAQPjdf6Q+s08QmdP5XX+y6uXJ6pfJJ9GRcmnJb5oUU+t+XMeL9W6q3oYIMMD+ZqwuVo1DcGbNRbkU8hm
+8aduFE+la45uIgvDyjlAMZoDIcCxYyK410cyZpLwHNexHNa0QtZiiBNQqDAFiIMwHc/oNJ7R9+9ePI2Xq5hRJjzwsI4mpDGnPzED
+b1EYgWTP2W/t0gHNdTdF6doaCRm+mHrCUrLHTirmnYcOsMTzVOciSOjhviNnyzAFb2IploNn+qlM8m+CaLUINuim5jf6wkFyIiMK
+Iwo49G/lyRD0YhHPtrajimxqyeRKj/vB5d3gq7SEFJ+hzBKto4zxJF9eeEO1dAYn8GYdFsblrus46q/QAbcZdoiDKasvLyq49f81
+6O1eYqftoIn2kgCjytneKsnVC1Nl6DbbYO7A4/p7W1um/v7e/Pxe40Z2coN6So7qCxFC6c2o9haotPFw6Vlj/pDAkM8dZYM/bORp
+1/vzepjRAuZL7eG3yiFvdF9a07EpNsbu7587OWZeg7pnbpbr7fdQ90k6n1N+mx6j2hLM2+tLEcCQdunnVcxFQsJbapaVHs1WEw2O
+7TAjDjAf5iGdtd7RYCpa8g5t/afTpm1n2LoKk1C0FkKs/N1dkOBfxPHH7mUoFulFN4x3OdTZncXTZFdW7CjUw7sLsYxGIbsKeBgw
+QZw2VZYwR0IFmW0TB14nyXgCefUNXVx4vN2foCFUaM1jeJnR1jyNotaSJklwSVsxb8HgIZ3FrLM0w5nRqxZlVyGPGTQkK8uKEtCk
+FbBZq7jYWgsareZp1PoUcBayy6TraD5ZEUWAoaGSuIXq1nFemPG1fgqlHDxmDtA/HLQUzuK38Yr0cbguVo5/0cqFc6mHCO80o4YP
+v63oBmfXK6pgc7QcKEhAam/dr7Dz6KwFXqKkaEzOV85ry9WvVHXg4+7e/w/Fouuotv7Dm6LWachmnLZOY75Ik//4B4hAWUJbr16c
+/cf93f9QE6UhuKTCCmpxer28iKMExya7pPZsFcRBvYSmQq5fJCcsXSpupHUOrHsXmMKSzLVnoczxVG6ozO0UXEzVE5GODybACnew
+cwBkYuMoXsOCehR05opGi4N1s8bKCXa/p4IPj51zp626koKTp4uAg3jCY2hCZDBHp9cf7O0fHD54+MjZ2dqr2OgqSoDGKSpwfGm4
+vFCdhcHO6PxyEf7xMVqyePUnT4RjiAlng5vuMZ0Quka4uXYxU+DsxCvNu3ezxhwVgBWy7iI+v4dGpTobLcwTnCpvlhHpD6OjmqVQ
+1G6jG6aBsdBGNCmIqKkiomLjvGQKwt50PJ0QNp5O5DYI0U1CQo8VlVakN1wd5f2s2m0UmAaS8Wqi2oBf0Iz8Wzwe0vUXnHSLpOhL
+pgyLZxSONuipX9radDMwkNdbGFQsHtaKw2/JZyKEOC2JnjugqChN5MBT0l0rSce1ea2KVny+9YeC0O45p0yKBXP0PUSU5JqUtKTV
+XjwFoaUh654v4kRq+xCBxfhqQlhFp93I8vOCQ+G6nnKB3IIa2MqTXnpQScHd8ObACcg8Ci4Tdwm45yn4A1GKR1AxQQYue0CQyynD
+IKiWOrIyZUh9MwOJ2lqAqqvhfEyLKWyPCY5I6KWoez6Lly+eSffsO/JRCi0NtW+hYBiQQKqrnIYXIFiEhrkX4Aihm9hLcYCGU619
+2KLrlefsDRwcofXajC0jjYNbl9Tr1atwfJWHqoHfFj4bT4Y7MkkW1MQnRfBwBEKXyZku6VtQYmeti+PLh2DcBKTt1QQNKYjCY9By
+YITnikGum3jcssWrqtwroFLLgFFNlwDCuFh5ED4J+XqsqOGHc0s+VVRwXZi/PXhLFYnFm7jYbpWJsXJQMTc6+a2chCyDpvehaVLK
+kG0NzcCt+YB9aoMxrMOxVliJef0+MEGZ9/AR8D2Zt3/QQ3hGPOY9QHjeffHs/PHZ2bsXT96fnZy/fvzqBOElkQzM83N5tkHww1kQ
+vdCE9T2nLU0tecBm8VIqnmgcs3eItL7TANiZYDT1NIoTmghTF97IAJyfKk8hldQIUuEXpJhcf4pXnEp/PMUO9ZM8UdaMccqKr2Bt
+3fuXZdxanFtT4EYzJXxn0D3o9p21xW/SL4vzc9iX5+eUMPRFRJm58ctbEYRL9n1IGy5V7fiw8bYVYz3jFAiEtQrVaeNcjcQEEZKF
+Y/clUGPshXDudeA6CXJbSGEHxoq9b97rSFjxSvbifNMWEAwrTFpLCs/+lqQSJYHciud5sKxfH7962ZL02TfIuHkWwaXxJ/sxZKB9
+A3+yTNnRLKQX1iSOpBRF/8qyJpdTuBwKhIjit1XBeojpGuaR+GZOhPVhQ7DidBZOAyEPnfldBnHFaUKZIKL4bRfI1U2upbzT/rSL
+SZX3x6BzSxPCPVFKUAURlmtT2KnmU7dhjSBLrpApp1cKfCKo9w68bxqXSPvf8fqgNxSQsQPVHezodZAq93qKHewUc+dgJ58mWVzP
+iIMde+QOduzxOROckLEDqjQBhzz6Z0rZFIotg9UKnveTzW9ZfZaHbINrh7WHpAYoA+OG56aIY5VwEMSPBtvuqyBCnqPSChK0MBbS
+mEDx6SRnWf1E1oONfRFuuJN9obCywA+hfmV6B4cDELFxL4RVUmpdsH0CYGfo+vas5F1TGwlKZRTwFAxKs3GT0myAU9ApSWy9jOGO
+x4kXkLSsKguToi64QOnJIrwDB6JQThGg+grmzMUEg2dBEBWW9Vm566a5PmvaqM8a6p0f51Q0W9dUgrRmS1XbuAiEGM6BlRsXo9Mv
+A8vyUkYBRsPinFnPaHjSR+GsFQgBFHBLxK0ZVYcj5YrDEGq9mJwx5SB4z9R3Ss7BOXiEPOfTx8QBTg7zBr09WGnm7fVQVz2QcdDk
+yCkeeg2v3WKjwRsNIjDDUy1w3Vh9BqPYD5HnqJa7ThuYWqibiJjTKgfCbJvSfA637n8zqv7D8qj6e/tqVIO9/+1R1Y5p05njWlcy
+JM1emLccYY/bZzI0v8oXVEE19oei/m4V8G6tCPTHYlJl/N/CNcndccJZBctIKSewGP2b12+wp9dNL9vBnsI7B4eAd5pqpTjCU7Bt
+c5PuczyXf7/FC/n3FM/k37d4Kf8+wVfy78/4ksxHoR+OBawi/CE3a4SvyWUxKHxO5iPuL0Z8LCa+x2VZ0LbOSwznEkFrT/6pmhsv
+Ijsr1z23hGbn4xQe5rLAZZaBXd0I0nw2Tif4cpxOwJik2JS56g4UGkEhf+m60Sj2ppgj/8p1IUPKg2tsfBspYa7Is0WYlOQl6CaR
+Bjtedf3RzTRIaKvnW05p6FCm9UtpnkAqeVBJLgRZRQrmeRrdED6isBvNJ5dY4ROwWHtT5M8avZBPR7FnLl258/AU+VM8c13Pu+xe
+aaugrPgNS41gAqcYNsM717123Z3rcQq6Kd41TvEUPEEMk+5z0sdJ91sywEn3lOzjpPuWPMRJ9wnpH+Kk+zPZg5z35BCy3pH+4CEu
+kEhy24PA0i7cqd776m8XXkBEupwZqRS/qVJCo7nrwr92Bfj2N1IjTY+PS/n4uLz98aFO645nAVOhXLJsRw/BmMDWU8qmmnDub6YB
+e5/QZ29e+Ryr3z/H/CPlSeO4VZ4ueHJFmdSwpgzKQxgHz/QYzGalbNfNc4QIpguZiXRDP4X0EyyQbMKUS6acUobD5AVTvfo7fP3V
+0g91VPUochvtmvW+1oAo+z5QilwjQzGWMy9yVdmRkytOw7WUeyfzcoFuS1pb+6LKRTNfQp1dZVtc3BxJTWKDU3VTe8nx4SjpHPo9
+4IseDqOjRDJC03HUOZwQmwsKBFFAgizjOCRhln3G6sUq74kKrtAuesY5LLnvlhQpU60piXMEMiokI4X+kNOetp3WfzvtoO38d+tT
+oBSJildKyCAzbDv/3XWQrzo0QZIt1ws7Rew91g0T03y5TK/knUHzFvNv7RcXjLULPlwo52NHDkgmRoR7aSkueoUQlCMBmBMYUDyX
+zyr4juA7SVerCMYlYkiL285/4xb9vJLmpJBC1UjXFrfA/AZuc4nVqTazNSANPk6LPRHV98RU74no+GAUdQ5gT6zIwXB1FEmm9XS8
+6hzYe2I1kUs5J+E4VtPBvLmejgVJ8Ixwb759ShZqStLKlMwapiQoT0nAWsVp+SNR/GWYIKmqU7uWKdoMBhTR6vxNncoHMUiQzjR0
+aQWS/FXRtfQtlmRe+EvBV6SHL8nS0GxXR5fDK0O3XZPqsVniK7VcbWfstK/azsTJD9BUhRW/tlGIHI8Z4PW62BcxqnhoacIUxZ4I
+6nsi0XsiOD4YBWpPpORgmB4Fw7TdRmD2X9oTqdoTkbIK+PK1AEbUmbUenuIpBVErodMYJK26K1RdKt60VPFdlmpKIttWzqzZivTw
+nExzuc3RfLgya7aordkUr9R0tp1W5xjG4Tnt6Xg1aTuoWD0dFH6xcfUW1uoJVGG26xw44Q7ciw6+sLVGUYndjTU1aaGBYqXD+krH
+xCirghOKYXoUyhWOgeoqra8FBXRQnocYZZkAPryoZTRvy2lJbKXgdl4FKxhbbjuMSm5u6uULu0eotsHis9m7jZrNUyon85TquJqo
+2cGNLFv4m7I6UzWLj7Lvm0pn4MBKdgc/TESnslObcg1DIUClBsOaqqeZyin/EmrgYJSoUx6RgxI1cFChBtTBMad8x5uWaRrlhktf
+BivCwTx+Xtz5jZfB3Lrwrctg1XAZhNsvA9U73AZm0AtLP2pG6HghwZ6phCWZWu6w8BWZ1RDyQs0iXDHtRYmaAaGQOb1X6w3H97K2
+LOG/8kIWhj6JrQsZLmM8I8nWNZg1X8iLr7mQmbqQi9vQzHCOea+sVbmUoj2A+tLci5eb78Wu076q3YnWFbi2lRBJRYG2OE++nY7j
+pvKDyajx0Pl2kWHjql9b5/jSo1V/Q0VOE8aDAVwo+8/PxDk6CljMrpdxmhwfO/gTuYnCRLyZ+ym47X0z96c4zjHdm7m/wgmF7LlJ
+PpWfC5wArnkz92fYsB/fzP0l5vLEvJn7VzhZBCvqX2PjDsq/hi6emq9zDD37sdd4BUFRyKuNxwIP8u+GsWEQULqGnq1RWa3dgpLV
+2GV7zRgYJ/RP1d2fqrs/ZXd/Ij09kKdQi4ObeH1NKHCN8omG6rdicuuB+ulrZAY1Pp+RwSoOH0WVt6MJuaXeplqtaQJqObxUOC6F
+sQEHczPdIhao0XngeGKH0LSjoW8SM6p/C8NOTS+KRkU10XaMsZWugIa3STPzkHFjNpH6lJg2uzCkuTwNPGyhox64M8y1RJfYudd3
+mqmzHY8ek4ODwaND16VH5ODB3v4e8FRl+uH+owcq/fCg13sA6YcHB3sHO0T9dUFUAr/2Symqck9WfAjf/b6SFKuM/r7M2evnRfuD
+BzKpf/AISXvJ436/v9/v95H6r6IXIW1WZXfohnYI/DrUni/kSNoePT7uwyuZHBzuDXptr98b7LmFckGThprNV2zKp3UiUAfSNK3G
+3jUWaHQNvNy9A0JAhqtrPwYHxq57aZRjJVPX+QzbVoz7E/AWGX+iHGzwPTSSOvYvmPBEoYTQP0R+Lb2PMAwz9RhCo8hjJXeSq+b9
+4da2xxWebqA1P5dcO9qU5bmx8RsV7VzgOQCgSJbbtB6XZPfDB2/84cOO85/3/sv9xkP327j7YdcfHpHj0T/GHya/n//3Tbb+n84E
+7V7iK7LreuOg89d/TuDfXufR5KaP9/prNNy9DPEl2f39Pz1v5H8eB525zn24zsb5T4R2Q3wNSiMPD8CybHfsHh07k118kf+8xJ/J
+jeM6vuMGyxX4YDuC35GAn8fw8xJ+fuN84zvun2kshs56aPT4pK+IMFE7R/qUAO+2MRbdlCmd7FfKe0SY/AS0ywkTobiGvSF9SMit
+Fs/o2zhkQrqQ0LMqy4U0kQ4kVEPfiWVEFlvEbj0l4DjU0rbDfSXgePAQBBwgsDqEN1NTdSnnwHMp20i7z6VsI+1+K2UbafdUyjbS
+7lt8Kf8+wddkNuL+cqQkF1KAAazvRlEGPiezBoHIBTkvymSZZ31B/nBmC0AiJQCZkp2FZKnn8o/rcTQBZ0bT0bXPEHzMyaXrTkeJ
+t9ICjQa+/mqUVPj6K+Sv8LVi0kd4hWGY7xE+H0eTHbJy3dg7xxGeI3zluhcm0YNfZAUyhu405pSEOJWM/VQy9lPJ2E8lYz9VjP1U
+MfZTxdhPa4z9dIv46oEWX/UeaPmV1G7YdXYva+Krwn2o1rKJpQVZQpwjp230FKWtBXNdLwFVcyCDvyHON1qL1zawCLDZ9qj9jfMN
+wknbOZZvm6Ndyc46dtZNWsplr7YMxJvAWcDc4923bd59fj/0KqZEgjjOmE486Cbnu4LYvYwps0xoVjMUzD1QgPKuAt+RXjAqQpJt
+QtialgQdGUUnv9Gx7P//SmAaybWGQHDq7RLaKhrrYdgVi0C8k0USwnHp+3kQJZRwb6ePyhlnPJXpvUr6a7AG4J5SICrXWITJJpWZ
+csnHmqAjzcpUWzRzLM46uilIO/0WTagAPwRxKloLzfW/oJS1ckuDtTWRDfVlIPu7thBq8mcKOlR5x+YdWaR4FPfkG1OWZFm2A6rf
+9RpTqxVcrQ76K6acTCk8z9hZWhQPkolyoXIB7bG+h2wPv7Eezwosja2JyOGz0rT3cVmWZ9nOCrluU51VqSVcbcICHSikxjGt7DHV
+R7SyR1QaT+Chm6XrLlzXW4Ii0sI4MJuRheUO74p0+nhmaQGjEuNbqW0vjVVlCKpQS1DfKFQrZrk6xVCxZBZkhmegp9tuXx2JIVq4
+7mJ8NenylIFHYuiwqLVeSHVxLGGENVjXvJCr2BYpM9qNUk5IhE2Joxt58iXFYCuhroc7FQuVaZM6TLHdRsVPnxUKVVPC1lB71VTb
+XtaR/eHzooUV4aChJN8GCzk9asgwHcO51Pk6C6cfG0wVi8jtdeMxuQ3r5s+oiPffH7K64osykGGdvs0CY5PhTGmaQY9q7hGG59Is
+93e0zLLQg9h1qUVO87SkKmiWS3OgCp1MuW6A6rsiFBElzgW4S6XcwfOu/gl6a/MuZVdgzzPvBvzyCmZq3s1NnJ3iI1GFYkYiKDub
+GYG3/I7ZlKof87n8y+kyvqKlMirpcRSZ1EQm02Uo5I8VpyvKyu3qtDdsWm4rypuoY/UxBOyUYtOqF5saDl7xeEqTxBQ2biCAgRhz
+IVEwnnenn2b1q8bZdWTeYhbyu3Uii27oIl0Gycd6J63e+p+vj1m+VIyxibQJjleUBaswt5Lb2ZEmB7bjyL1ur9tzUAWVl1pJPgXg
+InpbKwOnmRfTagyoY4cvCOcec13WBQn7KY3oVMQ8qSXIr++TuGgj9DYV8RAaTXPvJGUf3xR7PZwWupVg6IUZvnnDQ1+sEfI3VRSW
+ZhJoG8cR7YKlpee8eXy61/rEZcAvvzWN02jGvhGtS6q0Bhxldb9ef7GjSxWdDF4KfSRtyBLl8hA6lA/TMDlVKzOQ71NIzw2YfubB
+6nkAE3NNAi0aZV4PfNRwL2ogGS28b/YW8w4e9RAuwAOw7lyzf3vNu6k4Dh79E3QcN4XmlYKAkdfDoe2ITph1YJjmhpv+Tg940/Pw
+Ms2/P/FQ6N9r5EOUDsLwVqXFR2jY/G4BUgEOvWY6FgxH2nYMlgmY5jnuFIav9aeLReduVCBSjyFg5VkvpSwrXdBfokeuxydNqez9
+cJFensVx5PP1V0a9yZcq7p79+vbk2fnjd+8e/3p++v7t2zfvzkaD/v6D/Yd7h/sP/H7vwd6D/f7DwV6T4Qn30JHN0JVRehoUMkDS
+z4PrVqQdMw23du8po9z3IRMPjSo3mEGdywv+/JzExVWP/Nwa2nV1xdgDMsE49SKixBiOzURJQWcjACrcmc3/L4Jmq/ZVE8OSb55c
+H+Km5Fmoge1tJmieO0Yp22yIBYVHGm1J28JcSaLuOS6fx4LczgXNeXSuBnUirZdag72ZN/+NdvVVAYTFJT+COSui6XkuV1E5OHDd
+ku8QK2c0z6Hz6/M3mupZ9xcVKUXi0dJwLNWU5uGAX2inaVrzkUgfjb3mjd1cX88Go5cyBME3qPJmwHYYHJBnYHFEeiN1lPycU8dG
+tZFzXQh8Syilbr+UYD4r+iJySmRH8EaiWBz1Rj2/l83k4dhp3PgWkQ5262LYbjMkZSy9BrPdqXWSalAz7aaEZRkw81MxB08wO3E3
+TE70pge+fPMCmWPhWGujkInqJj833xhX8b3sSplvqMFylR4Q2oXLRJt2mLOhvKd4lNA85GOASkhiVYrTaLwD5hOoE0xvlnm48mbB
+hrxN+khq3g8ODlwx5pOGCZyXTqPoQkAI5boOMxl00Uo5YhvQ7DfxfJ5Q8Q2gEGCSgLQyTtksUY4Mym20PZ5lPbSpKTWsxqbM1V4I
+1ZlhMAPHYVRD2P6WTDjF9TTMEd54IYjNFwAlarlwOZCVPgKw4xR+kQ6x9O4uraJZGb2YWFkEq9wR9T2wNlxB6KMe7kE+lXqJqvnb
+sV33Qv7agPSyzNGXYshaufvJKiYT+XP3lwJuiRd6yFejl5eRalMKzqT3Ltf9zRPdWSCC/AKTpVXSutHW6fn2Swe3VCfYHoX+wK2Y
+q5u+E4UfjSi361T1p6SIknBv00Z8XJhZgSOiaSCo7rUVBfzSeOLUbiRbMshnq/fZaXPbTLl/iNpOC7a/tYF7GW1UzWrTHU1B9GAX
+ym69dkUZq76lirgD1ApSe+umaGDZWPkgBQspGB/WE2XgDbphM1nAFKd+WHdIK8fpgLFUHo2rAF6ebdNQz8JsO/3hEGnDFaEsVZwg
+mYah48vfUSBC1tcfFyEL+LWTW6dIaxV1DfjmZ0f/1pGHTdmfCkfXutY0GZha06QzKFroH0a0+OqoT93M4L7udEE/F3AcHx/3VbL2
+RGZyvrd6NQ8eoHBRA1SCeI7TFqgsb5EmluUAbfYzZacvGbQ5WhRZJo5A7u0J2HNCBc/VZ9sOVFZUYVnGSsWU9M9KwOyI9Eq1YcSk
+h4X6w46IKLJhbUGqSM0FXSwwRTe1yXtrQucztGk5dcln1ZJ6n+jsV9XsbVvnrFq4snCPa1B91XZ5YTVTWv9GJGkMsA0hAi5RKNAD
+Kore9o1xXd4Y8MAdwj/S0xuGfwgvaZdhrd4tsY99O+ml7MitVafBRp6KLtdDPjsuHnUjRooPnx118q+HckNZ3wgz0mZY+Z5mar+F
+o55PC34v0Coy3aS1GcLsuIBRWfHmkBYFO/3cHyc76qkXWalgb900LCGPTCy1AiQLCWELIxdWv/KQmWu80/cvrIkcNj9/dE3hAtnW
+TIw0Iu+CkCnF45VKJaNwtC0717sCoqixoBXOvVQYxjMWEzOixn16FUT5DW7ubzVsuKo1uVCOP1VstmZ1MemNKBjBhpVqMsHs/Qsm
++odPTjxxP1CqjgHY7eXrjNN8HYa51xVNhKvTSgjxOCmE6qXjA+EB1EFWsq38JBefnfwblR16D4CKNr+LrRWAReEuaB/AP2yXDCTY
+kfb5pNShO8qZV0RYrjsNl79HcYQIIbEnlLuG6ajnR50pKpxrTF0XbEwRjjrTdl96IjZczvuB3PMyJDkUizoE6mLoTua0oE/WTo8T
+eaiSTgrq22wYHZPeMOp0Ch3jlRRNkN5wfpQO5xZ07TnaUfCBvvAKbp4LCGgofTEbSIzBUadfCg9lHiSMqDgDHkNZZtwR5AeXDTng
+Fl2EI3QcSn8+IfI5CYfKt4S95vF/DXZID201rl/QzxZj4zjeHcg2491B8b4KSG8YHPFhux2oZUqIrfelfKYO7gdYKYUNc7/5SY4W
+giEds3YwIUnOLCkFu6q81X/wfvIELkaOsLKUKEW7qtX5Tj68KwVPagWLqkWp04bmvm9q7mlDwW9vgfVxhVUrcaTrMvvF86vErE+u
+BTWGkH4tST+coSmr9WemdSb1L7rLkOVHsfxKVj7nh+ERG6p1jAkdhxMcKNFrQuLjwd6j0b4fHw8GENkzPu4/6o8GvrzpwnZyRMrm
+50NNtyTahLrvx0f9AdxnAYmR2v/GYjqFvtr9Ce4PHhLi9R8N3BSuthXx9vpujI6ODrPDPTdFx1L10gvIqtTCXtFCJH8Mqk0VX5Fu
+uH8gG+4PMk82rfuI0PGgtw+drI6kdmaWrY5zNdNqx/sNHU/lj73tEBRf0wo8D3N4NGiRAW2KlBap666OlMLpQIO0XmuWazDyAqlg
+uocT0kd+YGp4gVY8xdqDdgB0d88FTdNMjhO0sqT6aQZpboDykgiH7eJsvvHs7fvGFqMV2EUckR/RZu1ULfZVOZgW+1AKb40DUvA+
+entlvfE55m3yI2pyTvaqTN05zvCW02AOQrsdIt4Igwf7EM5H3p9FH579C/rb1Nfbcl/5Gng7ldeMtwNvFWBisWOuyEZuQ+A4OCZi
+GEswYhS2yc8eHcdFp2HR6QvTaYE+bPyDdWu9YXxkQuIN4zYZoLB5cHwcT9qDg8P7fBy3+41dvrNYm/S/+jukl2UbGcKO4sAZcVIa
+MqGdiLbF8Sa23Rm/BkYmcDimIPhuXdBrsIjUzCIjKClA+qOgzHCgaOUyH6KZlaraa+R2PynzpRTbWxyHsJLBJt53VRRQZRWqVlib
+H+f0/ybx0Ix+NnU5ZNijfV7cbUI+L4TEJwdt0e7b+wh8CtU2e4fhARqGR7Hc4nDbhxPiCXdwcHB09PC+x0eh3++ECB0fH1ufRe+v
+673vDx7tPzp8MHh0ZxD2ayCIor+9TogAnqLPJ9b6xujm6ydxqJ9TXzPtL23y3xyLLDOw7eO97n5v8HCwt394uPfwYPDw4SHde4g7
+jckI39Pcd9PoYA/vI8za+0WPf27v8SHudx88enD4aK+/t//wcLDXP3hA93oPcac5vd7nwQDDK7b9sOjzL8ODJO89WmjwUoodp5Ab
+Hg3KjJKhyfiv/R3SGyLaJg5xGtj670umBYKHy9w1uWVXsPv7h6SdfUja98BHub0IP9v1j/qHI6enHUjkfE2//FnU/UnrpwF3qb9b
+cO8YLrA1DhWRFQMVViKogdkEz3PLMCNA6Bgu7APXZUdAmOybd/qNZC4dHO71H6EbT3TIHjru9F03Vvf4YO8R7j/qY3AIlXsnhRdI
+IF9E/AvqEFZqgB1JwuG2BnCpHlP0SNsLO5L+ODrq9zLWUS1pNoTrbm9Rkp5q7hgQl2rCRIf00VEPKTpNV2PIYm0MevtF2UG97PHx
+YdZ/NMCHey7LoF2rroQ6r7zXVLk/yAaDfQzNuId70EClJSXqZoaKQw2iaP0EA4frrVWc32DQ535jnw+zwX4Py95Np80AGEIuLjbp
+d2VHWOOJ9pOdkxMgdtQ+VEF8VtqQrCC6LH3Lb6sOoiUaLTY4zdVJd4pVkJu+tt8xJ+z4+CFsn/8aHBxiPegQmV8cDetj+t46tL92
+RVy8lf4qh1P+wTLmL+6QYXgkHeaEbXZMCmlPaLHR5E0ixmGbTeRbqYFs+cXGHKCVv969v/MfrfutswU1lMVSqvW0gCJqMaBv/0hw
+7kJf6zx2/6N1H6r9I0jFIuatVus55XGStB5fxOnHRTAL/6CL1tFcJv5D/enG/PK4dWSCAhRpsiHjGl76hm9p5/C/Sr9igLPB/ubw
+gYpVuvfwAHSMFHUidbBOo/iT/oTAry9en749eXp2/urxL+dPfj07OSUHvWZeHclZTbQpe9SY6lfUcwsv8Za4sm+74s3Fkjf5z0ZO
+Hp7HsV9XX9wfrNd4fyCf4PM49lAji5ECd0PK1lxXsYDNt9cHK8xC4NPsWB2L7sciwB735HaO4+gUwrI+BPQTd8+D9HKjFUCz+BXT
+NVas2E3aYIHRfofE9Samqme1afXUOI1xYwHcJHVTfgFdV/2V2owhTcB9YDllAgy93FlvRZswxuXCuR4bjKuivLZGufhw04Sk1QmR
+pd+zJJg3xtgtVO3LZeFY3FrevBAaCu5ozSkqY0Odm5Ky2lQFr2mIZVh6dGTZTpn13sjgM8YlSf74UBW0igIFHlRd4FjICTDPUSIG
+ejso6G3Ao8PwKJDIEdoahxOwhIL36w1TPCUuPzULVHfDjjiIBPgRG/X93hrbeimlqdJspdwPc4U1bQnJtgg4bxeTlgRbXy/F2unl
+sqvi7ONYG1c0rOZvm1+OoD3e9G7UftLg/aCXUb35GuRSLSNI72lB89B2ui6kupEgvcbrvy2lYSZJPftL29+THuvUfmlo4EYrABmf
+JtY+Df4pQw6UVgg8XIBjFejOrUBxsYWUyRUuYbj8XO70ShnJp2DVP6wHD7OEvPLMVPnp9htPtSzVIooxtJZpJEKIshbPW/3DzkUo
+EsttkiC9oTiiQwGMk2sjC5VP3ZLVWBXWvcEdYN3/O7DuDbbAum/Duoew+Wr3sWgPboH9cP8OsD/8O7Af7m+B/aEN+4MK7IfW9wCL
+9oH1vYdFe3/L2MzTsD66XmaPz5IB0JHj+CVXKUYaoEX5PUyRf7nBe2ipdwpRw+pa2Lczq/J7osajckqDVZGPANj8nsrVX0uQhAwu
+bFGfBhlmsYGQtHsxlks9qSwjU4oodoDwpS6YiUHYvRmsdy9NcJuWg7DdBtgPA7ug1e12Ww5C2DnSe0e6Ajx2ynA3Xb6WAsDfncoS
+DjYMW1utz2OEjgwy9e1MLiVydgo8msOyCopQbF+av2I4JIQlxZUNjFSLLdWS8nEFLj+GfsSxrZekk0saEHYJnaC0XtQfrv6E6o/Z
+SnXKIyBhh+OEsI7AaUFrBDgBUawchpEChCC5LXGjVYCgdNhur4AgicYrIEjAdxy6CQh84kR+lgmS4CgBgiQ5CgxBYu9jFa5/A0Ep
+Zck63IFyY1GQljV1gw1ttM4thXHwoVmubKkg3K2BfqUByZNrsL8v0wNc6yHhklITBpxpOCL2Pm1QsudEbKisuFbJ85BJfeAGJojW
+s5OgekZZwij44Jbi8o+x5s1PkGL4t6KYXWpfk9pWbSgy2GamL4ZGHoOUyjFSY0XI11o6Khtpg3FrDB3RoAYWamWcgmEpUZVSHD7q
+gfX/HU6cpWkpx92KU5GEs5xbUOj/ZlkBcn5S4pJSIG/QGftsGyLcojX2qV62rDf2sV5gm+bYSb14RXfstAG6r9Iee1pqyNIfi++u
+PwZ8JalYWFWAwbHUH6vc8d+fvnldN4a8gVzfKAJj0PP1qwpFliMnuUPOA86VzYs0QJfE9o9kv/focBhXqzU6rrCJCkr+538otvTE
+xYj5//M/AtOj3ghuQYaOelrR1qfqaiRM3hojT+TZcCn5QmYLlU3lT62yKgOPN9swcI2hDXcE4MS8mXuhMIM+cB065Np+KNRntap4
+EkqWIR8HE9nJOGjTSYngL2bLKEa9PKlhTArIQOIIlmVK1GifVEtNQnZCQVeiLyWc7XZ8BFd2eJ8MDg7REOS3qkw7ntwPh9tBefL3
+QWl3OkKCMxTHvWZAoMStoDwkjbFzhQKij8sEhZqF5pb6hy9PtjY2aGws09D2J0dHDze1/ORrWj46elg03tzy3uAWmPfLLXtNQJuP
+weToCFTd+4cPHjwY9A/v6/S9jZ0/+aLOK+1O2p4FRf/QBiOHaW+C6p3/Lx2G8L50H6RoSI93FEG3ij95A/zwvkAI80ZY/95p0VDm
+ZwIMgMonJm7buTV4YwVv3ABv3AjvFx0pUI3S8znq9O+DmKVj1reP/I0nrvnANZ6HYXE3VLeuGere4MHhQ5eNpHC9139w2MuYzzb0
++uTLe233Tb9f2euXHtTbz2lxQo6OBvsbOn3yNZ1Ce9ldjma90+dRHIgvG+k9WdFQPTs9Jdvf0PSTv9N0f1PTz+L0IqK3gP3wFrCl
+esCmtp/8nbb7TW1LIruRLNCvIUra1KAY0sM7HN38Yb+sSsiAoU4f5y8GhRUlTxWWWijbQyrxJLMxj8puxxNCd0PQQsn5Hm22Adwn
+/3xwWQcA7lsAt0MDcqcTSheZXlyBOQSY47vB/HDTU9UGOrPA7WPQ+u9tsEKUdjJyPPMojiXrBZcmGjh3G0Cp4cw7QDPAUuNpIzwj
+r9K7mqH+hFDQM0L+86It8Bkm2oON0D35F0InobGgk9CWoetvg66Gg+8A3T4u9LVuBbG9p4Ac7BsoByqhf1id1PKCI/91dYr3Nw7i
+yb92ENUh9KtDGFRWYq9xEP1Ng7gTytrJPSqWzz14hSqhhbDTx6Bxp3gIPazsRbYhr8AgAgrPQ3hXJq7b04wvQGad/gS8NJI+wgV+
+8+hucHzcQ53kdpRxJyz3lSMEXFcfYzs2o+x0YoXvbh1m+58wzK9AjP3BA9wBfZ6740aqn/mDg4M2bffvhiy/GlcCUfcAd+DPw38p
+wvxqfPlFEP4dpPnVOLMwCsQlE8Cvmc2NqDRHtnfEnl+NPDeNxuxLS6NXbs//VfTaRIFbo3xpz1SNtV+08OROLfSbW2ikp60m/rwd
+iEayeUMTdShAnt6MgJUXkR7CXAn+FDe6/AoqVOUUo7DIyTIl45IPcC6vEw48RV62dK/oMKie6kbZqqTYqGEtAn5JxakIuGhyyKGN
+IsjtvPlExovb2BA/6m2teMJmtWrcFgnIaSjNYa69Lo54R01TkdRm6nEd4oDwjlTlMCI00A0G73VHXKp0hCQAeRwYLHY6oP0etoVm
+mIL2Yi7LCY76dE+qEG3yRaM0IwOtRN/UTKPFbEJFbilb4gUzzNoBAm8ZuelfaQeCR53mHViTNW3y8wR2kQIrrRZ7cv0mFzla8lNm
+zdh6NDfG8LLkK38YHg0ODiUWC6UvEtuktubuYYMJe+57qsGtVNPIQD+15L6Hoy+RbUhN5Lq3LBiEtLZG+lRltorCkSh/brKmebNB
+cGy5O5BC/aERCFtubEZWBz5IintY+UTQSkvBBjdfsD8DIoaBtGEK1As1mBBLqJCQkqh+RP2fPONAjFueShDCKcklKKphEDewjrCa
+ht2fjIP/SnORA6QrcQ2lZHf8e/vDbq/z6HHnt6DzV+d8snu5RlrMgxnYCWyLRq0dp+/tab/4ffA1GJBGjcih6M4hWMADNGrM96u9
+SFdxFGEhrZLhsuceQzhEllthE+qwUF+V1v+XVDgha7EscxL9s+bMz3ksbahiXnEbWjj003ZLUFuGRBYTwkzw8rqvvwb1Wz1O5b9y
+tMmPO+0m6Yryc+muqPDUX6rsWXEf8E0eZD/mvlYrpSX3iH3bHWJN23SN1v7twGimaMkHObPgsyGy4Ya9yuyUrgUu2eaRcb+3Lw3z
+qg7jCz/t6t+umhpC9BypVBzDDR9uieVv+UOM7+78Fd08676jwVRANB82DSN6xgOWBDLfdT9l2dRz+oO9slNx7T+Y05CFIgyi8C+7
+mqdVfWYhF9e5p9BEa/0VrobhHF4E048/pjSlZN69pOJtHEd0ZhrgDTCRLfBaLez0LIC1d0ITKE5PHPcQ/tS9gKNFZ+9Xs0DQpFSw
+4iTQ1vtexikTMvRSR9hfFRd8xi63cSaGEKDgOqc85DzvO1jg6/ziu+4mMZcWGOft9rDqio6ZaI2cXIOblJDw7jm4PQ7Z5VM9t8mw
+IU35z4SasYwS143iy7N49ZJe0egdZTPKE+NKgEP9aco5ZUL7qO0q5ZlELoOp9rNyTCsf4rx7zmUrdJaPGTCoI2u0UjnVcAUmsGCv
+gyUI9I2zWxEuqRfLK3zZXVE+j/lSLc6L+WsKOC3goGJKG3cHPkc4dl27sRM282JArGb6dPRHc7dAEEha3otdyv6Ev14IwdC53Fbp
+RRROX2jDUPC/XomOpFx/4p1PcL3BngrZpd5UefQ8uGBrOy4F8+trZUdDlRNfoHbO1TTJppRTCcDSDcnkvN2vezq89BoAwWoF9Fc3
+SIKV33oagBthPeJWwFqQ3DLz0QpZK2hNYyboZ9H6tKCcKgiSVsCp9vEIJJMeVddB+CKfPwAFfwZVDeU/hHn9PuiDMa8/gHiJzNuH
+9+cCjF16ELyPefsQXn9JmAcuga+g5GEf4UsoAcHwxxN8Tnr4ooIuPoOX9k8Kt3wkNwVa8qs+z5uRkjlyazyN4uSOtazjO/KuZWAR
+6Tlxcw2En3oI+aYWARWWk63glvclpwkVHtoEZbkwi0U4v34cRR6ENDkl44/4ZDJceaF1tV3hm0sqrAOkz3HSYJRzusYzmt/Md5tX
+uSDzLqcRDRLq1aFETVeBrLUNz5faa8ID2y4QaH2NNWrxNzzLrwzusUI3bGzSlN1cwpi2rBFegBN82LghuzyLvVCR1E9tUkTa1l7n
+j+7PQ4lerksvIEpC+wQMqQHCS7A2dsFhPlHKP+NndAMHRYc+uxhWT5Gw94y9bBCzAj+GjfoHbV4S31ZupppqOHTQtmUkdI1ViwZP
+nQoeCHp53djaAwfhpmjLFYSqb1LwrepcwMiCUuEaUtTlHzkIf5IE3DNysxFkZeBU7tCPscZ3KsFP8TxKk8WTcqmneqzQymMscW9k
+hz96ViW5vZqS9nhidFazzJ4hS5O+/HQeiiM2FMoPknRBDnmuaxh4lqqvCe7U6a9x1SqmEo8mTCo0r+k+lD0gZh5YUh8T7I2U8VGu
+/3RHMptL/6aKYD8vl1xjCA1VCRE3lKC9CngpIIIVlLbwYCBnKGTUBCuZxlG6ZETrrihFT8LUl7T9pZzw/IlZQAKn5zxh4WpFrUkr
+yH6cB6bH0pGWutyVhvm+ueuVevmDA/NtwYAsL+qS/OPE+dD7wD+w/+//50M66A0eyn8fOUBdOTglNsTDVGrXBHaD47TTn2Ct6chx
+IG1+YXeknU5puJ30mO0OOn10ExslfZy2yYFW0VZewBwHh+UuwyOrs4KplpRgCC0IkhyCdjvs2G2Z/qO8/7CT92/cELSLuCvCMjVo
+x20bDqWLnuIQtaO284GVqrXLw27HBs1azTm/O2ss7mJPgkXhO1c2bO0QwLDEabWASgsZbTltL9+J7T5qO7ilNmKeoz6BFU9Hwhdt
+xwfg6RqLtXkj/fr41Uv55CeNju3llyy4BNL5ErY8K46VfZKLm043LcPJVuw8kJo/L1cSR6qYDqsGmSgPNqlnENLyw+MxuJnYLXNp
+W51owNeYrT3VJc5POp3dPn4sjAcRg7uAli2Ovvw8XwLe0AhgxeOLiC4JL32qIqFKY7GgJL7DVH7hwOUmKrYQOAKQERRsWCEchXwu
+2Ikl7GEPKsusFvRQXLeeJgu7bq2+QpSkXlZmNFXQ2LSpisrKsoYBqAI2A7AJbruiTmwqqAfTUHprN7Cq5VqQAlIAayfDPiwfPbRG
+9or/0yPvNIUsCaWngAN4V3MvVAE7DyByZ0I4MAzSxhBaJvgI8MSlAXeRF5u8UYMBdR6+0G+Oj7K9rzKTjBA7a8f6LM7GyIDn5z1b
+QVbuOjKSevmoUNOwcpY3HVmm8j486P/9I90GzLZoODvMO9hHXh17Pthp5lVDNCIncOTTr+Gd92C9Rt1g/SX7uPR0YqMxxeAJ9Ysj
+EeFiibkVYDFP/UKIclJvFiYrIMafSlaxwfznSiwJPB2D+JmMVHFyRZkgbGhZHdm4/QUTlM+DKS1sfoDwlcb2YYUm9WJkOGnhOJ4M
+EylaGccTkkCYCi0Zha0ay5yuSiDcN+XYOJ6sFdtKISpmZuMtpwAonY3qSf4OyMxYVy3JTzDDJUvOMHlWqUHSUVANrekHtSicWFeH
+AQYQ2CNmpyJereiMbChsdBslyyfWnB7AVnt9QFYeRKZFeOyUF8nBjrU+DnaspXGwU4dfJtahgnZMy3n8ODvRsPQSZwJIUxkLKR61
+7F791hzQM5UUVGONYgnE20WQ6LoX6cVFRBNdGdqPpHxCNRwuQYa9XDWimG6enWXPAkG7LP4ELJ/aEsu2QlioxHyuh6HHbVnKSpXW
+k1Vn2lQ3gb5cNO1qzflQiiW75fZG1QQPQShaKeu039zWLpStWN9kR6tBNW3J2oZEa5yIeGUts99srl6HvFJvVEvZALtavCdyPWVD
+dgKRSjp3OxEafmCuhUl9KcLkrcoB3FOruMZ2ftO5bGbNWVOyHYWpAGXIcAbyB2chbyjHpYRyEI1SFQW+ltUqSTHvasc5T6MgSTaa
+yGFeFsHZvAGLVNahayRXYBh6gc0rQPYHCTbL5jC1IAzhEmRFggyWUoaZlT5xXObbURx353HKjVk55GhPMaVyvLmcdb3xO4U8u9Eo
+SJ/zL63OvEcHXx3erkGcarbWjYxFAgfxZo0XYSJifg0/4xUtnc0GlvUaPw+jcsq6EiqElCNg57E8ZFBeSlSqZmeOHWjOwc6TKL5w
+sPM85stngQgc8KW204OIA6Bdr6gs2UBuyAjXUCkyn0DDHY8RLyCJDPrqIdSdxYyiITSlxp6SQInLhymcHROj26PjdKIhG6cTtLZ8
+TwEYMTDMQhZE0bX0pbXDXDfRGLH45aG8kBVxIV5brRkZF1XB+/KHJl17dw+7eHB78MR8/zxEG4K12+oMtum6WSxtC81yP9TV8JyW
+7mKunIHWxjd1rTTmDeUxNzX2mmrgsLEODgt3fHVK2Hg6FrZnjzuGhy/UOHZ2qIdqHsh66w2h4Ot8zU3aDBpKlit2oW3qB32lzdLv
+9e11VCorG4QgvKscJ4ReX3o13yQs2RCPctPUWD4LaF1xRckjYUitJRWLeNaKWUvFEtkQibKG8jZ22yQ+aNTYsmNh5hvD2QpB47s+
+AB2nEPzFy6jZhhTee9RXtPDDg0eKGH54iL4Ap5f7CucqKlI3TIx7+qpMQKfnJoGi8AwF4gGmQq2ISeG8W/9QDcu4IhTV4l3mfCpi
+R/q2IvtWfecckX6W5Tgizx33J6PQtz+xktNhHeuzfkQLT5HVPnCshyu5IspGPWi3UQyqaEUfwaQco5TI8MCqCelIx3Cv4y69ovza
+s1akQEDCo5iN+QSt0Yj7UjtU4Qw1SBwjzEgMyKkSt1jNT2Xdxr0JGsG/PpUeukSta9NxkzvENSri8y2DlbeNj2N4s7jl2HFS9Bk0
+wY5bUj8r5kmLfl7RqYDYY62QrVLRSXSRpCViUJU0nSVYus+mwazF6ZSGVyp6Z2seR1H8STr4vl7RxG+NnTZrOxOLNVxRnil2cG2F
+zYYWx/2R6PTBIQ8Y4IdHYhjK7RyWo6YXrkEbdlBD+yHJg6wqL+piGIMUDR7kRavxRIdO7uGUhN1VvPLAG07gaRc4pY0wrl8vSbuN
+03Ip656ZmPj/DIHKREUkl5PmG2Fnx4MR6wxgbmIykH7l9Rg6g4ZRBCQqbxqLoVMZCXB3DHAhQuscLU7LBaVbubXJXHU5TdJIgAIb
+SfFKysmXq1RI8jFp4P4na1kqoeLdrUVJb41XJZ2cIs+L0cZZtvVmvgJppSWkJalXrd/XeMHo4yVPFT2VL5OU01l+3NQpS6pRbtUJ
+y4MyKzWcFg2mi9ZKEwlwWwUtcygbzmDQcto5RFr7UfPnPtJrOHDDQlJR3gmidNGvEW44RLS+EYW5dZAOKEGHHJ6OEAK0mLUiDKjo
+cjpLp9QrXX0WrTcGRDshAuS/N2u0RlXGeME/fEWXMXhxjbHRMTVz/FRhNJLUckhaJNWWhkT1K7mEYKvSepH7W1biMBxsklgrScSp
+4DRYNkquqeERKP1z4jg5rb/Fn1RZiKaqtkHlguayw2kUs8YqTUvfpZ8FZTN4NwOhaQsKVU6DLNQEZ8CJCqxnrXsP/FAPjure9WJF
+zubpuI/88USaD/DcpeRQHHFJudyEJAC6BZpnig0LrFYTNC4pXkWGT3MO3p2uS6OW6zVsIKNAw7pm5GD7CLQV1DULBaw4CoocGaej
+w5zu14BoT3yK+PDyH9z8kMR6/9EjhEahKa1lBEBq5L780Eg08BEdJSaGnlbB7Dyic7HZXix35Vo4ziJsRPOQdtI9PRtBgMc29UtS
+d9YpCmmaQqC8axGfL+jnhh1Wn1Ydfbhk1AHwlJz6l+Vsd9bkf/DgXx8M3/jnzZ/ZV5QnwBdxBt2Dbt9ZD2sGE+fnMOrzc0rYFuD7
+EDZCPhoOHpZfbv3e4b/q6baRi/RgIKFRHgCDzxumVTceekAEUITu+lzSyq8jSxSW+onvGXsB2KKR60bypI3gneEHsn3zsHqoZQwP
+H+l31SOANyHOWF+dwK2fgKZMnvLecJsmDo5IOArzLXcWXOoDt/1pVpY7ma2gIgQO86lgaMR0cwW4Bw8UvI96vS3vv6o+R+ndAjLJ
+qk0BXRdtse0re8d170v2gSe1cGuoU7JyVWhtEzoH2JwlfUVGKpmSXC7ufnmUlYqEwdmAZWDJ1zBHmzjI0C3b3C0nbEu3zKghKY9o
+dseqozUOSPPBNH3zzX2HhG/pWyuJhFo1FId27zx3WJk0GN8VgsmhlDluhCAm4RYIQgVBjE3DOLZhCPMO1zglGxa9FHdZZBn3nMGB
+DBlTCCSkZmkDjEcid7fvupUSWh1+DUcSTxtXnxaraFeVOjOFWiuEfYkwy3sCs9n8g4DoMVcnJilma7wiNzYD35/imNEy994PsfgU
+V9JiLBacVksGuM789xObf7/6Ul7Pjrz+7HkvolzrvYOGkaW3TBGelj+p6+4oo0pOg1mgRFtaeVl9S7lcUcwYXJli5rsQ34Eb8k/f
+BdH8zYpKfntRuZRlWqiUN83EbEo9h7KZg8Mmk6NSNW0JeW6gORWBoF3KZnSWZYEXY0UnlBlTsoBngrIyr38gbwjrLVRWrjURWQpx
+mBaVmbA2RewVG4MPtcze6/f7aJh2Q7agPFQc14F6gEXw+8EhMAuYNzgc2MU8jqPCe9qKJN7UFnmpGKernPw0lkALshrPJ7YYbbyY
+gGV4KYFMS59og6KGVQk78jjH13S2UW8kt7hVi2J2klwU163klpbM7IlynW7epes2VCqyAUvSMhH8r4Ml1x7ZAAyhSElDLc/2OrOC
+xRRxLvdQGkXmKQV7EweewDWtn03s9PyB9olerILpx7dxdA1W22Cx253RFafTwH4ZguSNdleBWCSAK4HmDqMZh+PkFR9kPEG4eV9Q
+7ERxMJNbwbbL7OHmjUG7UkC8sbHw7g2F0FBtpOD65c4U7P5A2/XuDw61XW9/H3VndBrP6Hdnr17igDiuN/L/8/M46Mx7nUeTmz5+
+uM7+c1z8Hgedvybwj07a66/R0AFC82isjI0n+m+v86gzue+N/A8fkjbUeNz57dyf6F+9ziO/e54XuE/gH2/kj3//4HxDjo7/+8OH
+z71e58OHz4PepJ19M/79m8n9b7IPDhSY3P/gIDRC96HS7uhYkrVHu40AQJHxsSRxpaU4vTz5vPKc372R/78IcbYd2uzD573pTqfT
+6Xz4vEfNlzfyO6Px78edCdI/OxN0X5cZH03Go0n3/mg8mkALRzvQ8KQtx/L78eT+cXa08+HD+Omzx2ePP3wYjz98SD58OJ3cH334
+MPnwYXKMQJHIAZy8O/7wwZ3s4hVxxjsfnP+891/uNx6638bdXX94RI5H/xh/kP9Nfj//75ts/T+diYPnpemFXKe9ajuZ0w6wcwkN
+L+wS34zdo2Nn8g12Lh1p/1ZkBe1vsiIXai6bjv0jFSWp9G4eqe/HwutrwRO+aqo77QqaCGmGnwfhm+Ml8ukaX26QZra4F8onlxJo
+Wgyi9RpfNwWKodoHtmtcQztusFwNHeVc+qhIjYRJPC4SL03iN843eeKfaQzJ5cAuEoLzZhnpIh+psMY6w9d2/MGF/LRv8ZuU0WQa
+rKh6EPpXmMV8KS313r974V9ilfvLMvLPMaffiWUEj8YIv3l78vrs8bd+gp++fHN6Aj9TfPL67MXZr36AT06fPn77+MnLE3+1Bcff
+bJHm9h8OFBLrP3iEtj/CzXptaezWNvTru+ktv/1Wqr9MR1raRv1GEdYWdww9NeLDfY21IbQlEHCD3h7ynIRPHaDjjH18/orHqfQg
+niBjomAyHDRk3uEeMoy0U+kjpmn4iebnoTX2NqlAYG0yHTVpJ7NhBJ4bPQZ6jEvqoCwL8w/gEmLgwygZpGeKBrpUIDOB+wZ//VRx
+2dQYPIEQ1CaE8JHi5fiJ/GG+9CsO+d6MRlRQyfHBJhWkN14+ZRa5l08Srl3DDeOzzMqCSZYlJS7dHe/lvQe9sheDJhpH+XMxW2j9
+Nar2BW9JWUbAr5Jge/PetXouvylKwvKCfTyiPvQ8Gk98cB1cdSdghWWUzBNpoR6XpDICxMfA+I5z7bsjPmRt0kchiRWbW9vXNYRd
+TUr9EB21tXAfQPrgIJo2RLVOrVH1lD8jZW3efX3y7eOzFz+dnL94/fzF6xdnvxJC+rsWw6kbJq9jAQaWthJ3N0zUuEhoJYpYThWJ
+rTROVzQQJCnVfU0vpUbnb5THJLWytOgh+GJldcVDGRfTZmKKdOcxPwmmi5J0hxFTca3OanOpeqrsS3RFcCmvaRFcAqvjY8hm8ht+
+uG6YR0iVBkKa9QG/52EkKPea7rQOPK7DPMCJgJNWei/nH1Qu5lj1NhlLKCaEd+fa6Nqk0LUSXMFeuUmmQRRwUOVL6J8pZVMKv5fB
+agUX4c0am9o+qO0VYcuq8hxtgkr6KM+xp482xVSPc6M1vSYkX50sA1mQzFqCvX0oCM1/Fpn0c55JP1czTfHGNQznoCYTB7Mf5NI4
+ah6cHUKKZEuim3jOmZTGSvkri1lHVZBaDsrilrZMh60oTKRXpqCVTBd0GXRbL0wWp0kcXYF+BFiUpNOFbCFphRUHPl0HdobW6F2u
+wojOTBtEedHEjunPwfCIK5U9+VwpSz9vLAvSkFfBioReY3fNDReMlYc9uI/hefUANOuB04KGcffZyfPH71+e6YDLWuDaZEepycfq
+hsq1CCnJG8OiJFlURqJGf7AidRQlCb4uaijJ0sL+zGN22VJSG7lqpp4MDnuqVlDBb+mIgaE9JYHBbcrHU/EpEN6hG9V6WiV+arxG
+5a12uqLTcB7SWb6TpPGh3k1Jy4s57K2QXUZUA6hlV0j68AhCZrZpKbfroFv0jcqApbcDBsZxeguXoIJNtQkmK69byNqUe7AbjQB8
+is2W9QUQF1vdHuWP/j0k3eOoqKUWkRHeLs7JJTjyQldindGYTvzAS0rCp/4jRZkOBgMtfNp7pI7AwwfoS0RIBZc/IDtsqFw93qwL
+fmBCOn2c5iFAh+12cpQODQEqxskETwkfcY+NIyARogmOMIN4dVqelRNBU9f1pgRKIByMgOyM8BT5of6RywOKQUr3p1KHcf8LBrXB
+YyBQuBQVqsLaYVLbMVHknZ4jDeb7u5SQTjxyOj3HFwU0B9ICEuK6fwEoVflZXGhGuu5OaGtq7vcfmkV9hL6yDx1LMCxrgD76Wwqf
+s8K5FItnFDNSsO6USEwPqAjAASo4Jllq4iyBOhlzZWKMlPNIPSfdhVhGo5UnsPqJfJOhDJAXMgd+24qNoXkQrgJOmXgdz/LH9VOA
+zRMKWIowB4Kl7KAL3cxGxSC0DS68z6EOKHBRNrObqfvnmo0UtET4K09PjEA1eng2UqAT4S+aiqUVA3TZP/wDrqoqGmOq3A3k+hQb
+4EFhBQDR9l70szYjM68qP13nHpEG+wPtEulwT/lEGhz0lE+k/cNDYAo1RXGexVN5F7luTa3AZHXNj1cx0E1NrbDgKrwMRMwbIsfl
+ed00ofzxpexs98PFyeySftj9MNtVLJWGYpJT5VX3cx/oVVFMJiD7LGtItOXZRJRnvxwFDBxnyPNlSgVLmqyCKTBnsqwhERCe2s8j
+D3Ygllo0lIsndB5zavYngwh0m7Lk1gWlx0gXOOOUqkJkiaPShv85FAvIJiGOutJFjEwmsfkEfjMJzNcZ/Vx6+JDoC9BCcVV51BVI
+qmTnaFKqmyvp/c2r96dn5+9PT87fvnvz9uTd2a9+H3/3+PT8yZs3L08evz7/6fHL9yf+vkx7/f7VybsXT3XaQ5n29s3pC/kCLGcO
+VI03P528e/nm8bOTZ5UG9wbaAc6zN68M91/Zevp1cVssEZouFtIky25AFE+7z968eiwEDy9SQV+blVXZaVO2zIlUjum1yJiqjFda
+s/SVtDeQWUPaDZOnaSLiZd4gmOec11IN90TLchrqWXK8lnTWeSO9EeihVS2GV8h1Q8/Zf+jglZITzsmqvO8BOUDUzBm5CezR+nMc
+1CZHYZ6VNXZ/hZelEasi4PT2fVJ4LOXeAotubbcgvAiSJ8qxkjTh1AVrW0gWfA1SnXBaLVjaOrLg2zgJ4YW/oULzppM131xRruRR
+G6DatCXRWnk/rIynLVNsOFRKcz9SOTj0nIMerBdO68up9vSSpBDhdNYtrRBZroOmDeDNuvWVJAGETMXRhgr2EsuoqiD2by5aXn4V
+ihXbm3K8mpDZGoRtAXF8EAAFnb8+fEh7vacg20l7vWeH6s9D9flcfT5Xn4Pnz+HP3gNVeO/BM/XnOXz2n8vcQa/3tKP+PpN/VOFB
+/6HMfdpTn89P4HOv1+vD57MHsu7zRyr3+bOn8vPZc/X5/PkzEMLdvHh2/vjs7N2LJ+/PTs5fP3514jsQ9rHDaTAVIG589+bN2ZYi
+PI6Fg8v556dnj9+dnT/97vE7P6jmqdS28+FDp9vrPJJz8eSBHHRPz8GhGvTeczXofdgv+XwDQ0WqrCRJqGTKbBbwmTyt8nBuwTtA
+bNRy/Q3+wYbiaDsSs6yCgM70enhr+bGYoIKC3+mt8yD7lruz2JapJF/inDaEYxBMF+/oPLGc8FU8klpPsN7+I33VwduLSd8CN9I/
+a+6bsKIKabzCqncV7ZYLe0WJglNY6d6whWsZXU7nwO67BAuGYCpeQdPvaDC79lDuI5MDOZzI5f8uTiTR3OwXwCoAFp4p2zIsmLkZ
+zWeO4hpsQPtUmwCaHGuLgY3TFZuXaa1JycTeISTIsljyy86NbyI9uWE3WcRppF3iScgC0JFMXLcCboCUg9cSJJ7qHieuW+v7ljVg
+t6/BOvcJWXM369etaRv8sErpDd3ssxaeSl/ipfn/KSz8P4WF/6ew8P8UFv6PVljYrDCuFRT2H/QN0urtKbZov9/bV3zRR3taMtDv
+HYDppPylFSL7/d4DzRgBFUo8J4lmtmvMjhd5yvNgKmJ+jWdEGzWZIuDDr3EnyQ1z89Twa26WwcqPwewNa6GQHxvxEJ7CleHHXfkX
+a0a+HxuWPo5ZdO2v1ri4MsNu/hu/TTm1c0rfuDQif45t6P0ZDpOfgiicmYSkW07AQNifSXvaVDclnZX4U1yaGH+hv1+Fn0PmX+Fn
+b175ATYmKhE+P09WnAYzf2nvpsuvl6/vKOXieaNk3S7zkV6biHByz0g/dj3LMxRsoYNce7DQqiy/bNQ+2n+wB9vo5iO9Bi1CTufw
+5/w8oZH5JZU8wNNFRatcm6pZpODNvXuKFeanGH74FEO7QjbLJPGe+AE+jz8xyoHGzc1Wh9PyTm00IYjB2xSomysf2fKP8RG7QwAT
+eAJea3MiaRiEQ/W9ImCHBfNme9voqkFK7RrffFXy5dCtEvJb8iZi5bnX6G3gGPjgUd2Xmet6KdhAi3E8UUT3oibw7QxgFMDXW6C0
+UCdleYyjxXG/eJLMtJHoAm6L3nB5tBgu2200Gy9tI9FlezAZWo3NpJANqK3cg1W80go4V6ScWgzwCuXzAaMwg7mSgzE3jEfxCs9x
+D/dw0NWkJE7B5XoZ1zQYRFRWvXsRspmnfXEXhi6wlQjFQrYIp/0xm71TCP0Het18G0w9KuthIcniOZDzcoFpVy8j/JIbUfk/WiUo
+b3/bJkzxisTezTqvhefqTOKFOr94RrzNnaGmDbtQGxbPSD6B+eadF5tX3hTLoRqY66q/pZVzXW9JGtIVPy2t7Nm0cc9Cqlccg3E6
+sdS/l6MVOOtZjtOJL38J6bFH7aLN+/oKrZr29ZW9ry/1vr6CoAW94fXR1fC63UaX42t7X1/DvrYau1xXF3yOF3IvzvBKrmgZ/99J
+467QWoJZNkgNDoEtPJ3e6n78/1q/4JTomAzwdN7gG9x4A1LjAHUdrd8gXTwZX+CJCLh2Fcxzff2SX+CUhX+m9DycEYfFM3rutGm7
+XXi4MJbXp1JJpAzPdrfFABK1QRJ3BSkR19E2X8XaxxEwVyzHCJvdFsPYtFZM7goXRqKH9jSOIsX3+fcMbx7Fn87/KWOsj+VUqz5t
+G4nlJuOfOL+642KGy9Oau8CW6lj/dvC0GthG6G7xh9zsjOzhw9udkZXIz43eH0izX61cDMONBZdRnuPomPSy7BYa01j1cyl4BDcW
+dMwL/MW+EN78qXRw2P9q13xNY/6XeoQqO8q7s5HSdudbtzjeuoPh/c06V/jerkKsbyFqvNQ/xJ3+NuX4PaU6BCr3JcdpG+0Fc64D
+xWG14ebZKQi9khWU13cpqoS38waQVoS/8/bhW3lQFuvt7t+6czkOpTEDavOo0IU/C0AdfrN7P6r0YrRXiOLGhScBjDTGN2VAezlQ
+6K4cg8P+IwRx24YQcvEB0hru2Kkpohvl0HNhea5QKSH4AWogXHR5Ex3lPMy39XGuRTXSnqi1k6BZzCQS8j1KuNTjNX20c78fxnk1
+1aX760Z9d+YdDvYLKTAMdqB31YFm6T7YN8YM1VUBHu3T09N3aUTBgTF+enp6CrfdMzqNAi7FeJAmhZGqQBRSYHFPhfx89uaV/Vu1
+bL7O4o+UqY9ABDLQzZzyF4IuZSJ41JQ/gM38OIoKNC9TKp/gcdMwJuBb+c4xKa/oLAxkY6/CpVT0UNwTkHHN4NZ4Faww/JVl3gYh
+jOHPlCYK9LdRehky/UfVPP3pWxVmShY4/elbxYg3X28DsTill/lnHDJhPqxZOP3pWzXumKtBKzsQFaJUJcB0ny4oVdVBS+OMB9OP
+T1NaTlBfcapcTTvG4AQ7CKugc4kddE5r6yUQY25KOCjprUAfzzLOHa5cd2c1DiagErDCAY4QjsfRhMRdOQX/LmRYcjYPfLl/tbf5
+/5O9at7ZRmvvUeEqJWTbV4Ie90ahcqH6qNd70H/0aHCw/2C/9+hRH/lNlJC3OdxLkzXKqEoP+LkDFJkyAWWrq5KPR4uplwfSsiy1
+Ghl7dYOauJZLK54QdRe1cMIVd4CbHAc1Gq3Uols06+1ZxkBF/tRqxsyREnE0TNLqS+2F5g2tg5v3hrYXDUUllZSXzbKSWrbMLOrP
+bnEXmRdcNqxgljUsepbVl6nJR1aW1RfAcqFndX1VszJsCJCTm98Js4HhQQ6h3BSE8DQEIyEAPcx/vuG5ax8S61SAnQTyQzUt/cCF
+idILlx7gwqSoFslvtfhkKj8UkGQlP55J0wX5U849WcjfRjuCzOTnWx4upW4TWSqoVXwxmv+0PVnt91BXpX5NIJkmv1qhESX9rXgc
+OhrGbucTvfgYik7WWcZ/wb9JZ3d4a6yOEs6E+9J3BP0sHPws5HQKc+M7M/PTAUmKlHc4U/XDwadTHq6E7yTyr6PuZAggfw0wgwzL
+EcGlg6WY1HemoMvj4GfxVHLunZn64UC8hOCyyV8a1FYmWlIv1XRUSpKd5SnbyO0H/X1F3z3o76lH2rT8Rre0NXcgovsR6Y3C7i+v
+XvphF2gn+bTCpuKpXPIvqK4qmEYou0PvXFbnpd5VxV9evSQyN0+BMvvE/joofRHVTA4/tGD91sMJdZszarc5o3abxRcJy23K8rql
+Uq2GtLzD4gPGImWhhOsfd/WA9uiOzuQYrfq1hldtqpXsWzFvFRrZd/UsbbtuM/a8Bhaw67U/CMOF2cbeg4cm+srAhv/mbcAT6dFI
+PgXCvyj3ofQjhDXhDkPwQQi7h/CzePldwGbK3dElFa3nlM50imc9fR0r3cHMe3C4B/75oIbyc1kqrJJUuQNT7ufcvUqtfDkL6u09
+7Jl6b3n8+boJJDtD9bVv6jyLl+9FGCWl8iZRlu3lZc2bh102dVLLVT0NoLYOb1KewHdJ0tROkeyoV6c1oRBEBBYNZLhNpK6ylxIl
++6nQY1gg6V1GsvNm8VK3Ag37DaErZTOFna290KzSNMdMNS0Q5qppJQh7Fi/VGvkb8KSEVCVVmwRVtJOfTl6fnfqFkrM/wBKzS66w
+31MflM38njKy6INUdkoTsDULNYsTBNwDrC8Sv29+qVoyKIUILv2+jFgBvwbmFwPlyz6mcHzhL1RYr79QI18e/lpkRWiaODJ+3Ocp
+XUEFvc6cBknMDCvcDkpo4jd6Vrksczwds6alUpCD2l5ed+SA11/zZUW98x0HYQPbSqQcVE6nH+EVS0cb0i39S4t/jHwrVmQtLGSW
+gbNaS4CjSRC1P1Q4xyK37DbJ6oTwUk49cGLJKZ2c3rbjt5xc6tomt8wa3jGhDFXkQE+0yabJQyC8/fIALGX+ofLUlls1jgGJHaIJ
+Nra7kPIQLGfhTw9NCqtHyOgNVIb+s6f+HKBJE/upkVBseBCMqG/M1QK/wXMHPMPA6SZY0dJxf4KkSZsKUJd7tDw0kQ0OtTLHYHCo
+lH36j5SSxqODwzv7uqwGGcgyqgSZoGcqNnu5rLaiQ5RoF72W71OQ6e7ZXr0NQ1yXYTXn+N8437RF+xtHWXxz+mcacjrLjYO731Sf
+0MZObyl5FdeFKsDoRtrtUl9AwIMgFQtfjAcTvIgT4Yvx3gTD2Hwx3p9gcNfli/HBZC3XqPL6Vl3kRpMt2lUtq31svtqO7yAMG3t3
+15HRjsTClIDfbecf0nMj9G/S4TekAST6VPhOW30j7UbMlIXfcDbqgSAAocVEOiYM516sSKVYVchtPxlRKWs7Lo988V0kcZQK6jG5
+gTRbbffDbnsXtH16eEpSI6TvD6fHpDecdjooIOl4OsFOF0j2YJSaAP1T8CjtdHVy1G77kQyU7KgEryjY7gPDLSI95FupeIBw1Omg
+4soi2ieLswtBxx1iYjonI2fX8Z2ug3A88tToCMOhFyPks5r5oqypHCHLOvJbzrr81k7bpd1akE9mIMsHsu0skxAw191hes3N9EIx
+k0YCk4lDuMxBhwPM5dQGPUdFTHDV/k6gt8ROUFoylUwEDr0A6VAIzq4E2qhz9sA7dewVKouwavd2sQNBlXeddkGlBCNPtU4S2R7y
+k5rhZnWCKCk1rNothS2TTv5yMR+FPtHQkB+0GwWJeKEzZVY49/hRrzQB0InijvawjNulpmn3d2/8+4fdSdv/sItGH3bv39u1Jq7d
+LkdQ8VjuHtvpdncd1BbdJL1IBPcs/9nN/lc2sK6A8zRy7jlt6jczr1QJmkdjtoutzHuFFg7KDZ7SLGpAl0ePiuxw7j062Kmo7opO
+H2VZU/oAZVm/369n7MmMw3rG/qYaBzJjv55xKDMG9YwHG4B6uCH9keWnvdg+otPvDRmgE9bpgF/3vRrUrMnBu83rs8ndmUe7WoFK
+6B/59oeNykfch78yikzMw8uQBdHLkNGOKH2ipnJPZWTljqgkoCxjdvFLyigPBJ3l5SspeRibPF1DUPrGBl4YE5BbWMg/qMy+tEd/
+S4sbJ+J2iEtDbJpk9AUTe5dJvcvoZ3XiZdTzDXd11Dc/xajT9+mxGPX9Tr/EkrW9yt956tiIqaGyuy12Xrp53qzGts4b80tlts0b
+QLhl3myG8Penb1535SvVvkF+/4Am62/Gv39gk/sfmMT5Vv1Lm9wDgtFxwBUxoHc4vGOaUwoT11WJYtwDZVAKhBEQSIS2BTIbNyFc
+35E7ST2Si5qoV8Hq/buXrSnYNknVhQvakmDP1JWSqGtThw1UX/W7Jz0mQIYk+hZUpdQlAc+OHk7bfYTWggRe6MFLMtfSgdf+GhZX
+POaXOnDxNdkFWxlv/OFT+0OnO2kjH66pD7uQ+OFT2//wqY3+gUZQoNuZ3AfTFN/7MGsjNPK699G9XXxOdn+HB7bfbX/A3fa93aHo
+pjySHCPJck959K3eUJLvntsaSH473Haa124ouCZ9xV0ntweRBEOWXecMayy6nEbS8xdJ5LAu6sF/djzn/Fw+Ds/PnZC1yk9MOGYI
+rT3gwor4lAr9dLwYpT6w9yG0WTkV+Pzg1Sjg9Mn1G72BlXmyDD5kZ5vhz/L8Z3QeQQJZbC/3gulyIBOQm+XU7KUXbJUKcqXrp0Ln
+vH/38qs08p2LVAj50JNSHRm+y3yogEHmC3goAaeBU/OJp7qq2MfE7GkUTj86vv31VLEN8sRncXoR0XJBK61a/FWcJvQZxOCtpTQW
+fRVfNaQ0Fn2/qn7nxcxG2mEQKxx4i7Ms25GOHio2Ozv9dWGu0Ncv3P099cId7O+rJ+5g/6EyZ9k/eKSsWfYPe2DMol0wrJFW+MeL
+CleeSgxAP9NpKugzHaOaJi/YGz6jiqeEaSlYsAdSwJJGn3ZnL42e8KxJ2gxX804PrfFyY25/gw2W04Xn3zmPY2mJ+uKZNKQpbH31
+Txl6WSlrSMj9oNucgWvJyRPlpSDobspa41UqTDTvGm+xQc+MgY8f59G+gwU2SUgzZuDCGXqrsQA/6fAHPC0h0O4zEeCDLqeXYSKU
+sg0AoGRlieQfSNvVcPa2gKiekkdzuKTNcJubfqVZEqH0r1M1ZdXq0NVkpbmPLAGuPTST7LqgsrjGSmywFYqtA4aGup/CKHpWaqg5
+VbaqoTFj4+hGwdDiY4BwsjZAPY4iUzFpcAoih1Pywr8CqnxV1flnyHVXYzYB23RNfG4eEZsMuevyxhHx5hExpOFt6V7Wa0w/Cx7o
+vZr49ZAdBuwQx/BYV7sZJ6Q3TI5y356J0QpKSTxO5GSlKgE4HKUu8paH4MErJBBIZIqKYLbhGmvj5hpICsnMSeTNsUQRmmcuC/4I
+VRrmfj7UCIuOpp7AM+TDnyXCc3W0IOZH2uVUUkhPg/RyIRSJhNb4/HyV8stSpOeV1Jw5P7fOw5OAfWzQD1qtv9YirRStpWvi/SuX
+M93VIkjo7F1lU6jtoDq+lJq+DbegQgpyI8DmDiVn5dy0n29gsmxMxiHCVvoLrb1RKZ4n4xJxHOvVo3ccjuvOuoIHV5Qn9OxT/HYh
+r4XuuQj4JRXQCQ5xxZGWpJy/oI+CwWk3y4gYzWQUloBTJsx4PKE8iQ0b4GIKlgrXR5PyEutUAKoe6SLizy0FcUjU6v47Fq/C1Gma
+2Sp8rptUVklbkVXDVXoUx6jC/LmS7hNKrB6DhIpJP2GC8pc0uKIyOHaCyx7Q5qah1Fj5gXHmQBp8KypnaYibK0PcXBqDzUXXOtVg
+/nQTTKfpMgVy1yx2Qdn4Ed6WffoxXJ3JefCnVkGlNmK1Mrcyi8FZBVY2Grm+W8h9TpfxVRkbUhkYZCpeQB8siMxa67jy63LkCtt9
+R3O9NXgwaqpgmchuqlkOeqKUbDZAJ9brzSKXW7GoFYQztAMJ6bzCWaJxgHkgLb1vrkL6ya8514XUnOsvvzTrMdYsbdFV4fPtKPJi
+aIKIScPDZ9q1XU7djJhRPPoppJ+yjGkfhD/LlnzVINAZIgij5gVSeVnWW6+HYTdIL6EDaU7tceWQ5Ou8NW6OM9zpY0acVyFTrlOl
+f5KWlDe3/hMMx5xh6ypMQtFaCLHyd3fnwZRexPHH7mUoFulFN4x35XLvzuJpsisrdpTSC1cOHEN2FfAwYII4baqjsArp+5G1ieMG
+/DIZTyBPau68f/ei8LViRWht9ycIvIU70u+tDOacyujsUiAtFVkSKtPBievSDGdGr1qUXYU8ZtCQrCwrSkCTVsBmrWA2k8/hIGot
+aLSap1HrU8BZyC6TrqPJ74Ldwkyo6lBL0F+Y8bV+CuMoUHL0sDvncDGdxW/jFenjcL1F2FizcrTfY+wLbRwZyuPYFTaObJONI7uz
+jSMr2ziKTZFkJQW3IYRsEXLIMqmjFZM6UYRLNZaK8MirtNsoXZWFAzZdwLTUbPdEpaNcOkKlYkpuRyZ7qhkSnkJDtwNRMigsg2IZ
+dOZ+w9nXWBhugDufpRrsJ2y2FfK/Z5+3ZfqU5s0dp+4OW8N8T+MZcMrYnUH4X5sBc0l8wfa5+zx8Lu8i7UrDbCERXIJv6bvDeNsk
+feEq5dDdAYTHURgk/74Vqh4VZQp919MN7jm+7Hwrc2JeO+xhZepi21o6uCvw2k739i32T53DJtRYgejfeurq6K5soPx/zvRogP63
+Z+cWA+k7OPm9kYrtK7wKrsEpqX8TyEK+0AqLXg9Py3a6jRY7qpl53gxdN5ruqGKLxmJBtdissVhSLbZsLJbmhFiVWgYlnfxVZId0
+GNkf/s16aPdyZffyFQYQT1+ePH5HRPf1yc/nj9+ffXd+8s58nr49eQqf508enz39rpKoP8++e/fm59e1UkWyfjhi0WX00xlQt+yE
+c8IrCdLRoBKy0U8QbQEKxaVPVSRQaY9TsYAiCUiRIhpwkuYxRweHB6jk/eiu9uYRiDBqA3Ao5+eMfjqXtDk7p5yDW7kN428ofX4B
+kDt4UZ3CvGyyolPV7qx57mslTZvL6tLlJUHjTbV5Rcwyy0w5Xc5m244Dbbq7t6dtd/f2tPHu3gOt6bhn/Jo92pf+qMDZ1A0Q7huj
+h63wXPWwwDO8xFfgzm5U54bStVS3xNeEe1BJjAZ+H+Fz0hs2W9hfbrOwD4WyNd9RYurYu9RuAhYksVwDLI7Ph+ftNvDavSsiRtde
+4M0IHZ9PEKiCzqQq6LUnExAhJMqyK0LI1Lzdr9bSRQ40vCS5Mddwx5uRZZfRz8JDqDuLGR3qLkJvia/xTF3dWGxodCi6T96dPP5B
+Wmm9Ozl7/+513YuNZbna22aquY88Z0lF4Ki1HWhOxsGBWlllPp/8/9j79+44biMBFP9/P8UQuzvpNsHRDCXLUo/as7RE2UwkUStS
+drwUwzRnMJyOerpHaDQf5sw9fluW7Njx24kfieOnnIcTP/Nwco6T3N/vnM3Z3P0K/gL39xF+pwpAN7qnh5QUZ7N37/5BTqGAxrMA
+FAqFKrdOE63C7Mfz24KFaK93OBwbKigugOeytx4qey2bWOlrNwY7gsorCmMLrp9GxQnqWYxyvTTt+g5ZJNOV6emYboH/JdD6HRRu
+EkFjwXCuMf4gr8WcEo8cLbJEHHKW2NMMlR4iKNiW2SVGdicJRmsBDZknzTZsM6luLV+tgXOVklpNyHKqnstxqjGe4dYod2Ga9mYA
+gu4z8/MnqlXIsFpVZVSrkAWcmTvG1Nv9xvy9DqeQ3plq0K4Xi2+wHWcAsrt7mHfR6dIoPMkZu485vbHHv6LWhfO3tra9EM+nNhv2
+ILCj9b/RW2B9ZwdO4jB77oWdqL+PJz0iHzxaBJiaticyE1noqoRRYq8Rak1Ph9PczlTfDx62/94GP/LT9ta9jFb4XdmJadtEpmys
+VBnC1JuUiknzyN5ZsfRxpj3SPqXGUlNekp5y/cXBsi/guFnyDZoNKSY3UirW0+Q7r9vEhn6VeET70LxNWVzUO9eRvTpU6S9BnwIf
+4dGEErLCwNC8G8BWMHAD8KkVFaVqILHSAwo21twyOwD0tikXs0NfdnCrqUyAmNIv2rYpt+SLXAOf0FlQpSt9yT9IX/Lj+xV7VCb+
+zSWyR3v6Sz08qz2RKlekhw+jvLuUu2zCegE9fdhulSZwJpEtCMQFqGlQboU29W3Dpq2nEmcGEMAiItlgAjSgQtTskeDYhCNz7TaL
+44jLSZc6lsvmHMFdBr9GISY8JZR78bibbb384NLTZn6gl6FuEEV873XOj894ZyzmTjO7VXcsWPa4E5YYFcp1/qHDynLKbTft+HZ8
+bkiGTpuqPaitsdx2cFbOi9sO3mY3uTvJZoT25AkzIkg12gvnl2Oz2VP5bEFsWYkrqHAZZXjBbjuJcbiZlc6ZYFm2QtcDK1fcnWrY
+oG6pvdJSAp5vuRFmBKqfhbeIjWyoMsmTtykUUsPoEM9sC3kjmrR8K7ISmwa2E4wor22Urwkcmk3bzfwbdLONgRvSsNDOwGjnQd1O
+0RLqROR4lrBbEhu2wgwLCr8qQ4mzHSvAvFNE1mkcuiyRXcYLXZbkuwxWMTRSIvBmLcx3Eze7KRnRAPsmsMFbWns/GsvoJrRumz1q
+W0TZFib2Hu+WtJkjZBBj1wyg9xqJ6Hvb2bX1OEqbXRhd7yuwcl+4e1jniG7UBIZXboDD6CQaSgnlfN8XgnFEZM//dIfIb/NRud5I
+E6hD7GkzspH/Mmb56OLDbl+a2zhWHw7VumWPL61hBZzogPqvVxkohzbKgWTG0ZQMm9x2RrkKsb4vxh84ojeKGJcZuCnNkUQuCFps
+lKAsSL+amTLjaxg1HEbWOBYY6HGsPg9if1h5N5p20QqJ6ht5bds27vC+dj5so3oQrSTod1teFqqaVrDAWsWCN3bEJvrCr11TriRc
+Qduw1YW56bDCVqmXe4yBm2ho2/s5EQ0NOVzebagRQ3NNNZMdnJiMmou4XXAzGkvzeyb5BX5bMYHph7Rh09CUNMb2SBubjaBxcKK+
+/qwCN5RxFmyggeoJZZnKR5NUwUqymi8we9WSI06v09HUW7IV+k1pCkGUTJFAfWbMlMx3an6KTKZrEwHSrkxlD2Ng6ljEwBPKKLdE
+TRdutzLYEfn8VthqKz8pgMNtFRCpe8IC3l0pIKhYHUsj6HgBhSkHRcBNOOugep9nja8adqt0YXNKUlarPkhPiwUouSooQZWXDZsn
+SL2jgMk1wCIWul7Ut/QOLHMoAqmYC3alz/oR36kEzLtY6TDB2oJ1apV/lu5hsU5w9Q+48zGrMPlVcQ227IqIKn7Y5gzmWgBWdGqE
+lrfCpiWbma674F6bVau5IL7jHlt1o9CdQOeFZG3m7rdxS/WhvOKoPxxavjRWWnqFYI9udupIZYn0HVolTClc34wpnYaSVudrWsrc
++tSj8U3Pa/guP6mL1G4bU79ZvsC72nsp9V1UncEH4dzKWmpXq1kAom2lqDs2KY1wofW5NSQfRVBNrplbg3flIuw145mZyu11FGGG
+K/GqrBxAaYWq1VwQ67fru7HcHGDg/WP1XD+AtXHd6Fbq09Wt0/Jm2U6oXyn7sOL/Na0cGfUoIxZTV7uMTRkb8uIAT+1RO522XvIy
+v1XYDYqra7W6x5hDwWWZymEU0mG7Uedi10zhg2xjdpvdYNhcmZSimF9+jFzF1JczOMjPTFpYUqIMkStopo5+J32xEmYP4dJq79Fz
+eRoISoe+ZPce23RafHxvLe6ZYzum5lucldXyehyPknDMBHJuJE37IFnGkk8U6eMJZBwzpUPVRXouIBM0qUjztWc+kSWu0wbu7o0o
+aZabFeGZf/0xAyONxpExOyJH0HJIXdoZqR++HgMisiNxAdbH7LqTPhvHeQSdjNdolt2cnhbHwuzNPTwCwfiYCYuD0JADUz1KlUiP
+NpRU7WhDXb4cbSgLIkcbt8l7taMgp8zZioHCXD9nJUYSsxvlkBtMuF4O0/NiN85hYibchF63hZLMWztuS9GkXuHVaoRmIdjK9DTo
+BE5P+8eiph26aGV9xV9FBfjM4PZE6yZlHuNzHRxnxkvheQanYeYGA57gDofKEgr6X8fPDc/kqv8bs3VDqpnJWmPXm2xLle/vTz97
+YJ92TzgzgxunbzF4UVNfpdmUDFWHzDQyL4+zdfvGyhQuvqjKGYegPHOgn7qCD4/xps1cthJZYiWcnl61V80XVPDsW1uIzmzeHFQm
+b269wVrpXlhbg2e2a2u6JB8lXytjl3OipVEO6Xlxj6w6IfisMubO7E2Y1pmasoSrxW9wHoLn0iV2PlNDkna1ym6faVSr7J8bLjxa
+Zsekr2nuFi+sqC/fIdeHK42Zo6sXOvCyuHmddnv0WoNqCYb2TdN4VW+VsP2wRWcEOxwqFXU+RsvNPadYKnJG/4tc3dhSIl8W5+yl
++9dpdanEKmqEF5a+xWzX9dKRPKx0Cw4fQRe82vyrvLZbJTetzC+FWVbObKzY22IhLCUVr9IGTfiKF+cY+zHf/z7aFMmyO8e6jKM+
+mswTlv5Kz4vDr8EhgYUVP/SF7wV+zDqVmQqqWll2LgWUb5hIBIOVWs44ZYx4yW2jaDEnb45IVbEsbSqpFOW9sQRVq7DtAWcxXNTL
+gw7zRY9xOO9oo45Z91C8YCHTugQgmEmW0PIkS3cNandSI/Omd4CG4QWgXvASUAfHzWA4SJURM3FWZ73YbZVisW8cWIvUU3oXbQqM
+e6zAF88H0YHfjWsQebB/l1quFnjjqmzMydNs5PoyzAwZW5jZXYqkLy3iCY6KW5FhD4EymwrzID8YsLDjFu8lJSsSRh2Wypkw4K6s
+mntF8bhAufKfASd60L05Fjb96Wmbg8J/Jvjz9d5hCZeNVUaqAKbXucgEr+r7eG6j9YPsmwFn/41aoGpzHU0YWXGqhmh4KzH5qwk2
+g6/P3eDeBFe2PP0NFr/ov//i5/3v4vcVLH7xXq9rTMNj4bGMt9Svurkr8H1xLWuKawaGw6kG5TWzOSApzK7jebVq8ZpuuguqAeUa
+rYxydOLHSzRJ8u/5wGW1ZXZ+aFOOOMptKkYjS564cMUPXG4lyp4F3IwPXG617ZyOV3FF93MrOnejPVd0rlZ0uZ7zdFnj2ZpG+SjV
+gcBVPoaFBz1+KmsmEY8JLXc2MdArDVhW6Suhuv7KLr6ozMkMdKpWLqSNwVFwDy2fNuPTZzAp5BCq7Idyb8si60xsMRYSStZZN+Js
+ccBCvN8ysnOZtBEX2qPRKnS/FZQtnt2bWDytiW8rlCbS3gpb+fXgawSGb91rXySpmkOJ4Pdrah8H0fHYDqRVsGJ1F1d38ppYAlVD
+l/32RVAWzetdGXHGICuikoNgj+yC6tV1fER5+tmhG/oMdLYyUzHocRQFMHKPjWfQUky96WW2HuxoxZueNvdab7W5T5Fyn8UyI3A0
+NJoS+uHRcCjdvKpgavKQbNZrxJ4c26gRu1qtT02KPVIjdiujttAxKU/X0vRfcBBtXd/4Pn6dvtf6oPzBwDtsb3qaDlYARIstGh5p
+KxuZO+HUPk+jfqihHAXfqgz0NOq3HZQr2uyth2BF2wWd1akGRSm7uyuiwdx6xIVDPPghFBCh3/fUcw4nsIinwyzsQF/nEbkvFgST
+z/xz3/kam/86Q+fywDctue/xVVP+W4mC7+4IEu6Q9SDhGDzuhWcDb8chbS8cBN6OiYTXBslGL40TMiyT9Lxwgzmkjb8SBQacHNKG
+H4mI+lLrQXYNaWdh3RNGEtkOM1FWaSPZ+UHHEyyXLkGUSohqAadZmEASDPRZmKjIATQ0GqhWJlBcIkswbFA5pLMeZK04wcGbRId7
+G2kQWwMY3QyJFIxrtGA8i9j2hcJv+yJFo0UCiQ8ATCMWN3U+0aaRjeofwGcdc4JHA8BFAxlMJIXo0emosDFK8/2B8FnHIUwCEhm2
++c5AIFqDKqIjkR2NwNdESj0DECejdhI7pAs/iEBzZY6yKwaIb7AdsNflkItspwOmvBTyLGdxjFhkIzX6/ABxiWzRqcjrsA54I3NI
+gLB05ZFGnWbC6xjRfRVOk6h+g9is31IrYg7pA5jWK7UZpiLgniSLWEyEwkeJkZEcMYnXQ6Zsiim0as1ZLwbaHcCPQiQxIpJYIXAy
+pjMRgnCvjhh0ewdIHm3IvhsoCNHnPMH0wHNPMGPQl9o8CgJ0lxIFgUQxdhGGNsbfFIWFxRJQSPVYTWcda4SZv8BzCThgQUAikxgO
+hQ6JJYBIcPaiKERoUEb4fabntvD7zJjU6ELtuBe2WeAQAYE2BrJInJAYk5YDATmOiE/HESMUTWBMRhTo9803VnKRIvRSnsfAR3eD
+Kcu03zcxZPTMPZ4vsE+3JCCRPQZN2YIfAlbXiLTTkV4MLpwg00rHPqfErx2zzYKhFXTUHdNdtBOB6hb6e8l4jhlhK0mYt2USM+WO
+YjkanGKbLLA2a70cwqabtfFsXFADjpmYD9FWXp5nLklfrZZha1kOaOzRj8fyy2w8TpXlMByWomtpThYwI/IybjkqGEvJjmsCxbuh
+jba5isZ4TqCkg4Vtn+Fl6H42u8o8kPsrCViXI5oUQCMvabUtRRF2q7QVgnuDO5J1aAdGGN9T9SUNwRCXXHJuPi/jc8jwxjM4sXga
+l78ltdyEtkPSVShtrF6Mpup7VFLZZDQK0Zmm399QFce+Lv30noUzJxbvWbtr7syJU/Oy8rjPYd1hGZB8lGyK1bb09gdNsW6gLTJT
+qj8PbXoDH2MNqGLlQhtHHjPyQ4L3e9fdKblq+OHeFRn7WtUDP8ZtMbRt6tdUDIgs/JouBIQUTqdsTlxfeQntgH9MkFLCuxEwWjmi
+xVRjTyi0cvF1FaFtM451+g1nmx8yna96YSFNLJz1Ntg37zUXOBAadpQVCCUQw5R5w+zMLUtjEZx2CGfak+llJhlAaSA5YiPKwjjh
+aoais9jTcMKIYM8pVCfVyfZR93CzVtIC0J6b8qvVqa5+rp3UOOtyFveMIuJmaV/1ZclGQouBGAndd5rXapt/I0NVjVnlBBnPgXhG
+vBVfeuzGbc5Y+E25ocrAvTLQRre63zQDOkbwAB4Qym96flekIS/IYGBU08AGE6ejjt/1GV8SwAN5VJrpLZE/sZqM0u8rtnp+u4ej
+2hLOLNoQn3UO4W/DqY9URrEsCO0ns46yoVZq+CqXBI7XaBRZWRdF48wxb6tgi9VEpGAnlxDdUm2wb5aUYdBhi9VkKlZTHTod1ZQ9
+U0kPp1hXyKzunZTVvWZW96ZZ3VvMajkalNnyiu2b8N7TQB/tmf3eyN3lTMvv72PISioTDMZsksZJsrh7ONz96IcaG0wsj8dpy0tb
+MrwQ+gKOQ60yZKoR6JTFuita09GPF0KjKHeqMaJFJIjVy2uk2MtC8nEmbaq0sBEdMA7elYtmUdGcCzxmUP1U+M5C3+Jk9jal3Aov
+jOAJ3IQW1eG5dV22NxuXuSCw6iDgMV5YZuXCJ41R1w+9INhB58J+1wrstBR0DyazMF7aoardWHyay6QO13Lr9ojm6pej8sxY3CTS
+oaHL9rpeSCtfIIaVcFXbvCmJ4UaftcyA8T5CeR5S7dQKdiWZua6f9WF+LMAPitGV8HJVd6Iz5uB9jCBA25fMHjGcvNxEP0XUw56i
+sTuhAdiHEVBTPIW7oCdHOgWMvSWGpcAgIvRvNE5AY+3eczbnrDVGNyRO1a6gphkN3QgtiYNeKnAZYd6YIqe+SwjIpfFMAz3quUr6
+2/SyjvOg45SwXpjOTzxbie4PHnK4S6qXkkg0Se55zBGM8PqDAv4o4v9xe/a2fMThOkYEhXwOzyJ6I0NrUXs7CoUfJmwEIwVOjvxp
+VxheE2Lq2bCxe9MN6k+76eURJm/508W0jl/+/u36fCG3oN8drh2iRe6BFfK16rHbVw/srSs04UHqrNLEmz2EkuwD31qpXOAXwgvi
+Qnf1AI3dA8esqZmZYeCHF4dhJD3WDoHNGCoYjVnZ2VcXDty+ekDe591ah9UvscbMM0jPHPHAA48DC1OuG9XizQ10HBAyDg5Ucfe1
+WS1FuALVj3e5y4fDIp8quQOLdPxNeKZqfEWOxZsbtxN46XXsAIKGwgKvdX0ei+M9P+g0QzNgawUKDFpmnA3sI+gR1tpeeB5dZGr7
+2XtWq9k2q1Uh0vWWgaxWrcAt6SppZBSsglWrZkh7K5FVZJTZ1JPacwKkSscge+l2JNZokMdklVAv34G/Oq5mnHX41tnbjtrT2hQq
+MztFvhToZItISyvqq16CtwFSIxZWGqtOG+oxWW4c0e4ILvMZvQY3sf7sjvKG2pl9nfc7qKgKq7M7PrtWwtUW/HPgHzxIz9Tti5f1
+pufqm7gs3e+dN9wvzTSo57LaIIpPe9s0liBN0K33QnjKW2f4RNMI2lqRVeIDwJ0P8QaZdZba0YDFmV3cstiZGTojHVRhYa6YblAj
+f2h36DaaGHnMayKNHgXKsHzJxJurN6aybTucnk4fDxw9KE99cPibmYETLyg+yOcpTHop4bUYTDKDs2DDOgtvWZHqgfKqgw+9CTHh
+TIPK9sS51iQ0ugFvq/qx9BpPAhavrWW871rba/fY2pqka1PRe22t64edtTV3Dw4szVArToXuTKMplMJyPsnK9HS4Kk3Tui4rUWCm
++dLhSssPmFkBfZqWWhPuCiGrTWZUQr0bt/JyXCalnbAGeYEoTWR4wbOP1VHhBl4wMrhHtikzugkeoYxnIa1L60RgE2Jlle5Zs9De
+DbOagZJPiLXT9RA2PKbOZyorFda6oY0Vy/eYJ8rND+iRkqNpMTQQAU/kYaLNwATg466bpOPrClQfVZW6URJ2nAqZZkopIxtWvlrr
+hvoVXQ7tBcL14f9wOIHecg2Qmh/uuHsIKZgYa0bk8lwz/L+mGdnTsDrdRefGgqrhAS2qbuiEIK9wIt2ekb1/i7yuYPzv3aDpxlfZ
+JCDBCXQmiapQCyRZWTorlC6wdH4jpcsMCvsOU4+8/Rh/0a+jgy+zqtVCbfZcIOCgApO9LFFuzUtHTLqBrI8P0zkor9L3Qm+DcacC
+lsYDv6OGzeuz0okkVrOa1OVbt+voEuWSKbdKT+yR/x6Na1x34zaYwNLKlKm1i8BcHumIp7uHVSxmha0iudGbe5cjGTZkwoDTKnGB
+UrRCpD1iHcIXQ1HtjqTbZbzpIf8Kp2YvCKJ2CpwPY6/LCsGlINoyNI0ix+JWRIVNhcrO9W3KLY/6Npp4j/plBr/GucZyhda5nNaa
+8tnnjdnqSFtIfVnXvcqcuqEy8+UpN0WGw6b0tVb26idtVNiC45EySuZoOIXAZFRaY9m7Y7bZvoI6Z31kF0qDsfwblhjVoABJFeXW
+or5KvXF7d6I92cmmcjeuz1TuzvWZyl2bYCq3kGz9r7R16yWiF3H/PnaOXUpYLFwDJa2GwdnhHl/07vBivw02Zlko/DbelU9OfDLi
+/bMe9/pxLs1gEJR8etaL462Id1w4K7I5jV7QVr1F7fjimZMLd54/N49GXV1Ru3vu1MKJueV5V9QAs3hu4d/m1xYBnHVF7axKWECf
+Wrxz8fyy+Ykrakt3LSpTsWcXz54/qwwIpYZzD6LdXC7t4IYWqER3XW4NQDc37kVbJ6CvlZtI3+xPtNQbRBtRIlzPjFgEaFZa6VVq
+39hmZa23B3bOGuB2h1s96XQHXe6M15RA+WuDaACKRxu5ZpG0NEJ3spYTWR9C11xrQi+RAWdr6ddrEVaW0JJuJmOpbLruWsbYENxC
+pYZPcQhJ2nYsDbSiywe/ZI80DyjaRQxkMofYGB95Ms510Fe+7ikcVwWcYvEU78d3Q/Vo7PrSN7pHE1d6GaGBizc1FgE/ENKWhXon
+30nVyeMtb2OD8fML51gHXRPJgaXES2cCkUZsveGQGwaarV1IuNBxEip9yTrYlYQGbBNVhqSdFUKVtxWH6F7xpJ9gbwfWyASXXVoZ
+eDG8JYmFJ1hly4srUjOpA1t6zPgm45WzhSTw1ET2JetUYFutQAVUagI3XcoolLkv3UgTlOqgboBVp93MMDnoMTfD4oSQWTo+xRFy
+ohHe+pcsEjdFEEwPMRADDjEQQxIzjgGU6qgCaGwEltHSpKuvGxc6NEgDS6zNmQD94Q3uhWIN12OivyQ0BlmHAyWDzEN5gq+g+Tc4
+cPcyA5lkHdZWoJa41avlRtsluO5WyLRVp/3auog824qmiUOmPdux8ibfB3RXN8mJRnRX18XxwDDYpYTxHVmIlYA7QNWONb/jwr2Y
+iYqxbW5g2871VCjBCgU2Gq8q7CjW7nrU2XFk6sQPOrA3gEDStgY2TXjgcDnXcODP84DYFFvg0x7zOozHTo9i3cHjF9AIK9KGua/8
+9eQhxwsJJCWOdPjj4vAn7m6uf5yy/vGwf8Dsf5CnFtXdbc46LIQ7s1jTjW8QTGbf5wY6N1DdGE3oY9mVaR8nY506aUMv01aAr2jo
+shpXi+F5nhrFLKbnLst1v+8K3f2RK3SfC7PPxVifm53omf2/1oaFl8J/B3bYDqMpmTsezdG3E1Nd37WE+044yl4l3UBPJ7me9st7
+Wuzdv2Xc1X+Ljv4riPtGxuWrGIfgusZhMsWrEq53CemGyGkUe9jgPCIVvaQfqeFaAvXHdQQXNZq4qfJV3NodOTFuMaqSdJDFBhAL
+7qPVutSBHYwHtK/JYtONoM3Sw2QMV+e74Kl5s5a5XPtXKFJeNMnZTHfcTnpvswY8w4bdAUdxZHptmpT7idsAq//KNXt+A9oFoh7A
+y5lsRT7wnRiehgq2LQ4MAs8PaeWWA7cQSvAdRyhmYI8l+U+2Z7a2tmZAgWQm4YGsQoeM6AAYhy6Da+ldGOMd2meiF3UcMohiQdKh
+XVcbRkKRWLyRXYOZVSIf+vrS4hl5y2ExvEuzaQj2niyhrWUSAjJMicKE8ym+mV7dRBdb4XAYtXCw/DJOqVfgjfJ8UzmnJOyR7Sjm
+q5xd6it2SeiEf0XZrAYMYhKD+v5IuqsRpTK1TGoG8rSbL1DUFDTCl203caSXZ+Ck9Kg8dowPJh/jZbumxmyG3t4o9T/TWLWHQzOY
+EgLMh7gWRrwv1V9AUGlbzKZjvntED7QwGYVDHIxfUWRQ7j5yYoVaZsAh5Hpr1B6vUR+X6NFNiRPgjOqK2vmzcABcO714Yj4LnVw4
+tTx/LgufmrsXDqWpMxz5GuSUtwNHZp4iTvqBYBwP19BXeK6WR5zTUYe5nnqkjcfkZCx3EmB+azKvNRkiNBirViFhF0sltF1oTSFZ
+Hze1gTqdp7FQUfK3oGfNBmCnIx8w8ETvNC6CzXJiT02AZymdcFQ+A64j4/b1Z+xNzhh57v547oPy3J1QL/R8VBSa6QLk6RVLwHfw
+ssxmuc+rXZnaERRekYd00wscfnMkHzMh93fWWcJMkXZjJhQ7cUfU2UGFZ9fP4dX2B7ufG+mYeBCFMTOjPF0EZH23x324bpD5xU35
+CCWl0KX5U/PHl+dPrC3Nn7sbaDry4oNrMQN+Fz6Pc4R/bv5fz88vLa/dsXji3rW7506dnzc+4LKSa7B/rmF7c3NBf3t88czy/Jnl
+teV7z5Z93ZYNQX5QTpP0+6Wzi2eW5idnIHuikEPXbCs0ce3uuXMLc3ecmh9rgGzx2qbqMdWGv725Eckd9jCARk2VEAWX4XRnD+lu
+h7UDT70MhvcTYYeFwiEXBBnZN0OHYH/Xv491lrz+IGAnedRfQl7fzaKOc+YJ9s3Tp+a3MRGcBxD4Zj/IfeCHXcbTUFzMMV2y28UM
+/aa+Fzoqlewbs3V8ic2lHaDQOnrbrGFVYldec4wrFuv7jxGVwBrr57w4p+mSmPF/YbJ4MPFARlR9CxbW2Izw+4yMf2cBE3PCE8yu
+iWhhaVG9xLNHVN48jH9Rqeu4tW4QeaI8hR8KtjHpc6VRWKbjrqKMqxJNLMNhCo7yLp/gStSq06gmrcP4XdzYlWdtWGsF2tbAUwrw
+0p6gvtteCafJGpnmq8NhG22TaPKMan58MgnbtuXbLdhtHHI+vBhGWyHe1eB9PGY4ot0yqjBso6Tz4Cb5l90R5WNNE2iSTTbJc3lN
+DToQl3bN5DNQx+LGgedsFhG4vOYL1o9pG3Ve0UjmOeZ1FsNgh3Yz3D3cFwyQzfRlDNRWS0O9JjoHAF2g2PZdbZtH6kNClNb6avou
+8YDfIuipJXWU4Pqye3pjTYxB7m8o0vXhQNazeyv91RpXNa1Wp9rDIaK2dEXhUc5waHXASAKzIA5NrEBl4OSY2J1ClzSgFK0Clti6
+vM2xGiXg5Z9ZmzQED3SN5s6xQ82d6Wm7s0LyOZLpnVV3I7Uqq7TEVPuhza0VZgU0tFcdgQZwWqlmXgY5WHyRWZXpbbSv0vUDBiZ5
+/dYAbj+1C/6ea+VXrdJXL1L4AQ5uUomfLf1wKxqBKGWPRo0WehIrXyb/q+ndoPF4Eo0nKY0Hxgy5XnqnPZfroUBCpH34B5dP2/0A
+RQkoedhxN2sDzrr+Nl1zNzPlYbqujBvRbb1HbCkgmzPecFg+bZLSabPhgiJyGAlvA8ohdNu1dlo7IHNyCLGnN+iavdtf2WmR7X4Q
+xg6Z3nEkSFbdNZx4GQ1WqwleTCfQHhf/D4ebKCfBADbFzcDhULV4U+ntd/Qi0FnZRnW4vEpKYLeCUj26RI+FKyh8qjTxrISi1puT
+z6Znt3oTslGjMyEbWSsZXqX9ajVLtLvmCcGd/simHdwiLror6bl1rBXW/s24WCicXixphrV/O8rykYHyVXN+bKJ4dlMPRwD3bcYC
+ugQL6DyQnTU1v7KUraLDYRvUjiUyXUeHw64NiRELJKIBJBEdqEFP+uuJUKeP4wXFJEyHS1a1msJg4XZO5qen5QkZVF3R7K+kBUja
+W1p107Vjrlqd0359plw3WFlarVbhv4E8Ua2eGA6PD4cDrIJU9t7NZeoWi6BmjbLiTGzLDDhQJtLPostkMc186xftFo4G/NN2ARcl
+acqRXtQqxFNS5pnF7ObXNIfMhTsolai0vRDuPHuMMzKynbhanfzVwAJvbpOpX5Ve2cqaG7QCJw30Wr0CIa/brXXYfWDLwXnn9lsr
+aZZ0a9XZop2R3dyD07bqNLPrZfl7Muz5tF37v0bXpZKzkYjyvZY8Np9W/qWn6oZgwinxI6qTp6n0mwjrwAVxYIOSSgUtbJCRU0xK
+yF6qNfdcn2rN8mTVGthgmGlI0c35v0xCedTqEGBBtkwv2va4rqAU/nw91kYRozDYqXgo+q54lRjc0bMKSJYrqjg7J4Y4O0m1R/u8
+z7dqIU2+i7IQRuW5j9MBiPDPSB1YhBdCJ6R+/M1+4PimoCQo9tR9+TyV9IaZ37THXY+fn1ATKUUBlY446bNYn7WNzAY3nNmAR52k
+XZpZdzyzU2OZKXlRTrDaG//w0nV92MlrQcpP784+RV6SOUwKqwTtZ9KwmzjJs23WTgRzUyjTzQqijSwAvnMS4QnWyeEKASlIAVtv
+Shsn1cuSZ8KFUET3en0QDHEWR8EmA+firrrsWI6AzEG+Ob+8tnT8rvnT8zkpzuKpu+dPZJjFs/Pn5pYXFs8oeYyoHQ/m586taZ2g
+tbNz5+ZOL2nv21qOZISlXEjqLBnRUPzp88tzIN3KY4uhNIPxMs+fzcJZECZqFjp/7lQWAJfjpkYYaGPNNkAdi1t9OBmF1tHD0jX3
+Brjklvpi6y631my6Ddqxh2265XJrGzYHuWxg7/qpePuc7POOG6Wo8zxwvTQE/Y/fxKngG0fQTbSf97vzAxuk6Y6rCSnFhe3sezW3
+JH6g80lppZthJCn1lBASydzV/CP0xaxN511uXbTpEr6YP3rQpsddbi3ZdE77nT/hcmvOpotSKnS60L0E/bcreTrAhC7nhyOXIuEB
+oWcLo5dLAfeNhC4UxzuXBldLQs+VkIlMpyeLTvmdIn3JZKasktCTBZI002BHEnpmAi1nSftySmef3FGYDDJpEG1kSU6NTyCZCkfR
+qOGlsZmXT6fyu2/ixDWTF/vo/OR1INf5sAZKnQAlWL6nZEnJfaEWpg6hd+eXIoKiXiRLQu9yrfyqdZ0X+VCSqTsI4ezKfuxOn8sE
+goOAMwkCfDrOXLTrD3rq2DnWrr7zxAqhVp3nzmfag16XgeU6ixlvxFUd8+7XGOQZsi1YA+b5eMYTdO5YjTMPbt4DP4RQ3+MXq1X5
+WwNcy4CnG1qSkj7vCwvLj+VJbQlzjyj1hGM+BlNPaHI97LthoYcjNzR72HND0K2IXU9e9dPE9XSxNHC92tzSMoo0Ml0HOnDbFmjm
+DmpwI6clIjunvTaPaM8d1JBEmWBcojruoKaofSEUjAPrFnHaR7ScLAa+mbGKKDzysQHQM5Zt+hoHHQHXryU8sGwph910A6jmKT9k
+Z1B2fDLiZ+H+a0NlsiTAYY7qusTaxRY7MYU4h9F1D0wYtoGlGGsXXmeZrXJ6dLxNToeWtMjpT1aJYFgxvEhDmkIPWpEma6nagyxx
+CEStfUrdXtcvwNA/iVUy98bomiHxuazWTYIAuqW1aW3QLKisK8srQzNZBiotuRpJ0+J0cPV00OI8VWHKanL6uERRFCcTDWoTNZcI
+3TUtkdcVo8oy9QXKRnYzglm6jOXMc34HTmo/nU+8sN+jcsdoRMfYsPGbg0lDpCdM6LLCjNJzDhYpWL8yzgMXK3lHk1+MTiT9gVWy
+PnEg8V11OzULb+2tyFiyfHyMAc0osJdla4MknnPlZw/qx4tzSweR8c5fh7rlRrl2J3HuQPBOOKJY2ney3IraXdeb2aU0s5M6swLf
+/dfkeQbzNBj78ZmTZsTkJ3fgJ4XDwY1preVXZDG2IgtzdYVLQLhYzRLE6h4fDp+oHy2bhqpr6fZO2y7siAO3XbbWdhE9tjLRnhvU
+RPT1JcuWb+aVhV9giq06PW4IUdRCWxORvr2jvWq1l1VgodNiZsjNxTm9ajWuVhMU+pupwlo0WOhYPbQXBLuvpEzLlvYz8Y7fq8W5
+S3/LpjpOX9LHrlfEWDqNrZpIme6a3J1/CdKCylCWdlkx/RgWPxgOCSjb4V7UyfJNdRIwUdOq08WaHwMrLddC2+rYLWamNhXlOraT
+j+uM5OHIqtP1bHx2R5TZzb4bSj1Nrb/Ztyk35qNlEFJKRn29e45T8MB0oroiVtFEV7Hc0HAvMDZbS0v0bRqOmmmzDIJ0N41eN/Fd
+rOOGC7fItTDayvbxUM9NixV3WQHP8rXJZuPTmQ3dK7Kg0kqKEq28jPHa//vdVI7HOAdB14m8ruEo965O2yUYu9Oql95p1c07rTre
+aQmt7RNmKwTec+1k5TK6QiAN2CTFFCTzTVnc+HzgAZQCKOrn51ctr7Avxm6EYSudbYkbZTNdnmbxPSUN3KjWzuYOzo3YWhE0XE0/
+brtBybykA0SPTT/adQ9s94MDyoNa26Y9N8rYULME2tVl6IZ7hcUd3nlsGhQuuURfcokx1SNtSJ4y1jDO2EKjek6bKqFVQktq7wxG
+lNtoWyeTR911nXbzjtpUWlxsHAL90ItsJ7bs0nsguOCpHUDrELXvxOhqVc9z3xKGWcgVqw5PqL247QXHvRiUAhncYADdrrqpU+zM
+PTbI3m5McK7MXbEa6hpJM1dEmxAi6KmCrAdR++IaGL1iChP4sY6Ee1cFQudvcG/QU2HQS0Yffhhi/TQiFjxK8WDUSefVB75Twu0k
+FlF/zQ8DPyzgsD7E0dcpqYkshWhoF5t5ZkyaKZNWE10mH5KzUDDYQ128lBEjGpUbKNHfUeHmvkNVJ/l2PbOCAodjOZrceOUMVkFa
+rLaWmTJqWfkqmXFj1bOdAqbhMP1inkeRaOXygjo4umK1tZBti/HSpBGnYkkN2xlLCd+P14ju6pAjaAjqs2w0omVKCLsqL4dRqCta
+j1BfgiUfti2cCOZj0mdzAmT4NC4bPCRRPXSq+thUhcm6z8QGXglywNmmGYY6mGF5XELTQ0Y2cKC9I/DCi+A3Q6KjAQtTa4xrSgVL
+ram5WqCPiyCPiqXxSuUMubbmh93ITNFhsfBDuWkaaOGLgJkIPz7JwjaaY9BdAWGwp5XrH0Ce0p61DdxitxuzfHXxOGkgohC9QBRQ
+275Qlh4SNza8TZafLRNK/BiWW89H79u75b6LpG88WIXpxHykYuSEDDJi2TOPjFz2ySlLuGd+gXd92QXe9eQGFLlPRpBkzzyAyvfJ
+A5LsnQdOs/1ywUR75pPOqH2yStPt3ddyOu3X0zLVuLurXLS7N5kYk3Cf4oyUk4o0Z/TexeIk34/EIc2kojByn0JgwdmnDEgyqQiI
+26cEXEj2GyZIM3GQIHK/QvwY+bd9yUEuuEqdcxJRGImup1x/oyeuu2BIvH/JkOo6il6S/j2ur2h0BrJv0ZjqOoo+wQK/74s9VvFC
+xh39wb5VSFPuUw21I+1TAZVqUqkqev+i0L/RfiVt+2KPgmCzxHLiMQ+pkj8xv2K1BDjfiygs0byOtJWX30RaVgGhmBlGmWJzCvHF
+DFymOT6TgWIlyUY0HveMeuNVH+OADYyqMlYem1FMQMdrenPVl3V0iybMsfxWBuaqAaBj7nhw9WEEc1XIPrENJrOVgbnhAXDvrLOm
+ZF/YE3nhIkObMrz5XvDDmHExV7BtVxzDQhdoFIoQzaYwO0d0ZlVYgRZ0QOeF1vhL2srssgrfUTQvOEZ1+Z7VKFljY1xZvn1mZ+1V
+Y0iQr3GOIPNV3vKCi2b3pusG6FZ5itk1LJjFRU/x8jA5ZldWC4BLjbimJ/tQGZxVJqxTB+sghFvVh2iC9mqauQTn2Mb89iBLwtkG
+2x4UEmkbVnGWLhWHjSXl3o6ZDNST80lQ0SxLIi+zRnkbcaxFACLOBEU2NiXTeCFxWLUKthcZOlUFBqFFmDSHTBypvoc68Xixtdht
+pZBlO2O9raKUHJbZRWNc9m7ZMGgrkWXumA0dVl6tcmWMDrXuMith4TQBs3ztiHPoIT/cjORr8SnjIfZoskv/Ww8qsdSRWWli+7bD
+6onSQeUr8nD9kL23HWC34bqMJu4s/ATuQfhpu4fgZ+Aehp+ue6sLqgAD2nPFcBg3x33xxrSTWd7r00264UaWAC0iX2oRcSumHXoQ
+FIk8a0dJXUGfCNTpw1bPEnTddhIE6voNRHP99u3m9vQ06jp3h8Nt6MUd0HHuuzsr26t0012z+nSbbtiUoZJzaG+BKutm+g5k01Yz
+hWkj75lkCcO3qnClr2y06/C2dta6JdVt+8rQtN+12plTG5V40JppOMFw2G61na3RHiN2VAkSZ+u2RfQcX/Y28EF+ajQOHDZxa3xN
+SWffCG4wvcJwwjWoSshWxKppp3/CKgK1AhqO89b65LQ7n027bHaewdlZ1JO1QtezhFrEULvDtluhE7U4PGghi1rf3YpdwNimp283
+9d6NaxljLaMbnPi6JmLO38/4NAMv6F9TTtClMLkShRVp/fI6ZtmsHrODdk0LT2nkcstH1x5+3ha7PaGvVUFRq5DeYrazO9qjmcTw
+I07z5sapHxvuv6l6TbOzEM+nd/tURKeithcwSWpU0xxVSx5B87PCIpTYe5Kt0Sw1zuQ+YtfKyrTqtnJQXvYscEnTDkdzp7p8opfl
+GzaFqKhYGjId8KjvxwwNOjDTLQA3iASE8sYCHY5TzB1ep3JWZlUx+h9suuEzRK6N4CqVC5Ttawy0wvWtMPPUdPSI0X+1bhlhQIUn
+mIHUo3DwNkmIhxsH5XLfONJQ6/2RI7ZFFubXzp5bXF4kNs15eAcBXlHyTQV+BsuQ34VrFWJT7qb+AGEtFzV0vACGa8GNp0vCKGQE
+nCMfnLVzzgsEPhrnbZd8x9v0pNcGh1DmCn0DdY8fdqKtbPrg7XSI7By+VLHIMfnZ7TpJ7aSis2MXDqgoAsnRFwnee7HaySYHC+bK
+jl2Sbegr0QpfXc10kOzRGPmqWTgcluyJeRdkLmtZBsuHdEtDpLDY5AUlRx6ueKtwbAndxDIVqVqh41shFcUBhsvTrq7TBhPG9F7a
+6a9HQbzH/n940uYOqfR+7MO2KWzwkb/ig+8Bf9UVK/6qwy1GfQrgnutgVgKWOLk6jSNHbQuc4MZE8SUHD+2zHHI08mvBj+uXMF65
+dRi5Hb5CwGgwZzPfidfinsdZZ22NyExKY8Ct9t6V8GUl4AcSj8pZdPRo2mZ+QLmEu0EU8X1yjs94ZyzmTjO7VXcsdnu9xZ3Q3nua
+zx6dzLIBqwn0l3pYkJdR1Ecj0GX7qhW62WnCrlanuOW72blBZ4QZTPhabRX7fDz11xU/adOOwk3GhXp1UhFRZcBBguRvsgrWi+xP
+MqHVUC5oGgfrasE8qhjkQw271t2LPXL9mpyHQCEKdCOwW8XTCKAwskbgMNLueXxOWHV7OGQ474bD2BKU6fcQXq0rvRWMVxrWAeQM
+95hhtx0x+EfpWR12Jcn85yYbNhoWlAWVSiqllXNNU9kNJywPwyFbIf/yL2n+q8NhtAJktzo2N/aoa/1WfTr5X173ZnhdszNvU1v/
+wfp/GQ+aX9lti6C/E2LvOVkO1A6gOiY5UDtAVtgq6ODIsQlzgyZW+CpcaE6NJ8RB1Ie0/3GcYOPgrV8FK9g4eMSudeX0qh/R3GBx
+nu3BHLBqdcq3mBu2mMMyHoZGSE+MRnRXmzPOqwiL0Z4s6q0FFgBspXx1LMDBW2dltrfetlfj/K6Fc29sMGXf/COZDqdJpRMxNBus
+XjmiCMwQvMi0ll8qDpIsQV2zAtrR+Z4tJXK7sIh+QmyuQMRhlNhrhFrT0+E0NzRADx629z0Q5TSQDtwy9Q+VWyr/0mGSb4bpcY61
+Ex7Dpqk2UrYtWAgKPJhUWuKr3O177R6LA2+zcioScd8Lo83KsUCDR47+ywaYrAGbNLfjd4HfZmHMKqcXlv+hcguglnsYqpxSMdbp
+hWVbxR2PBjscrr8qVtuuzNYbB2dm641bS4tVn5xlvO/HMTTBj/Gt9PpOBQ1ysg6tdDljlagL5qH5BqPAHXjhTmXAOLxjjdaF54fw
+1NqrtKPBTiXqQpYwNStx1BVbHmcVL+xUvDiO2r4nWKei11ZplrrrByyuWKLHKmRJfUFsLKfDvKDihzJDVtGxlS1f9KIEqEmOjR+F
+tCKNUkBNdDTegslC4HPslbgiIsgviRnFCtNKH5zrwi/D9g2S9cCPe7TS8WP1XJ9WYkBiZ1NozYGIV2IWBJCDz+K00VkdMRlyUtC5
+QnUXlF7Z6kX9fHv8uNJNeOjHPYbfdKJKHFHIMk5Slgy+6EZBEG1BG9tRKB+vx45BFN56tMmwWZIEwkj4bdn/OCKDbKRVVNwDgc06
+U90nzX97QQA56sbxCjo+9NDQcAVmHpQLNGG2oqbrcdd8ZWnx5PI9c+fmKwtLlbPnFu9eODF/okLmlioLS4RW7llYvmvx/HLlnrlz
+5+bOLN9bWTxZmTtzb+UbC2dO0Mr8N8+em19aqiyeg9wWTp89tTB/glYWzhw/df7Ewpk7K3ecX66cWVyunFo4vbA8f6KyvIhlqtwW
+5pcgv9Pz547fNXdmee6OhVMLy/fSysmF5TPzS0uQ58nFc5W5ytm5c8sLx8+fmjtXOXv+3NnFpfnK3JkTlTOLZxbOnDy3cObO+dPz
+Z5ZrlYUzlTOLlfm7588sV5bumjt1CkuTZu11LY8vnr333MKddy1X7lo8dWL+3FLljvnKKTQwJks7c2/l+Km5hdO0cmLu9NydUMdz
+lcXlu+bPYTJVx3vumgcUtvtMZe5MZe44vIWD9oC9s3Nzx5dpZXnx3HL69T0LS/O0MnduYQl65uS5xdO0Al27eBKSLJyRlTtzZl5m
+BD2fH6DFcxg+vzSf1ejE/NyphTN3LkElzMQwwgf+IeUGwkk2AMRwmAufQBFADiWvRkZ5zgLU9vMCfrUFwF4N6tdKop2ZTwVKt0JQ
+wS5+C2XaxlYPYQtd6Cz7fWZJI0clVTK/kRgL3fcUXvKfD9n2ANX4K7EvElxkiF1qdDGzllLmb4h69m5mq0RbRKhWU6nImO8iAbIP
+yN8J0yB0n4NQJNXnZQBeGAnlcadk08QeL2ppH2uY1g5UhUwF7UwuX/b1rF08WqzUpd0P2FK9nN43jZUBlOyOKIZVVh5c05S0kY54
+PN6Dsb07Vt14OMx3W2wPh0r6g1rFcYlacZJpI7jeSrJKmRvLH3D/KtkIZ6woNhymhxlIY8GnIJoaG7csFrkdJ8wjUeAwnr9I8xfF
+RgnzczXutmOiBGDQaZ83whcA0g0Q+F5SjpLsySLx3TkPtLcd8ocHCPU0/CNC59Y522QO+eODhHoafohQr+2QLy//DoAOQL8HaB5x
+f/6Q0Lm2z9sO+QN8pcA3CFX5fvERJNhxyP/1NOAA+IDQufnA33DIHx4l1GMSfJtQr+uQLx+AinS5Q/5/rz73MOAk+Bqhcxvcgyr9
+4X5CPQ3/kFAvYN14p++QLx/+GEODnoLngkHPc8h/fA/QEvwFoXN9r80d8kfIRoEPIBhsOOTLd6F1/YFDqoTOnT6Lv17Y8UJo+nvP
+EzonoWcRDZ3wDkKIfAXBOIgGDIIvYXATwO8DCPlf/iFCEP/2mwgGLEP3447nQdS7aXAdgu+lQRiLt6+lQSj27ffTIGb7kzQIHfr2
+T9MglP/2z9IgdNPbP9dBqMSPMMAFwK8reBMqcOV3aQhLfBWDMfb05TdkQDjkD48geJ/HuUO+fPK3hM5FG1HokD/CUCrwEcAOujis
+nwFaws8/Q6g3AAqC/oJqDRzy5eOXAQBie+8DgKCBj18ByIeKPH4VwCh2yNcIBa8POycV1StS8gYDHm2nGUGAXVJ5zKGatay0At8i
+dC5uI8k9A4Op4U8AH/sbkO3jz0GEcMgt8LvTT6sJsMz8SULnhB90gEJhAmn4x4TOJf3AIX+A/pAQEMFWOwr9ELv9QwhK+N3vEbru
+tS+2I6Scx5+QQTaI/QAa+JdPJAJketAv938sw7EPk+Hy52kIK3X1SULvgHDgxThsL0CYA3W+/w4k5ZsMcrmC3/EtBv37xCOYSAUe
+1TEbTMWtr/OLDvnyux9LUKggVMyo9R0476E1CPyC0PXOpSSCKr9G6Dpre0kMNfpYB5gK3ZELrbP+QOxAhd/+AEKD2FedwHgYJTDp
+fwLfQCAI/DhFCJj5z0A6hH6JEC4S+LHYYgwHFhKr9QbapsDXCV33N9pIjlcflAFc5r588ecylGDUQxiIOhGO3f0yNAiwae8+IINg
+fBTDMp/4kvz23UdlUHjcIV++9AiGBPdxdeiAPXDy5Quf57D43QsfIi7RpTyMQTmOV2WRerSuQoUuejzacsiXbz5J6HrgtS8G0X1M
+LkbvvK9Q8aXE44B54ZrC6CIB91EBpyv3uwI+YF3ohhcfLODx+AIffIYRIZDL0z8G+GJjFvDPSPgQwN9D+CDCzwIctSH9C5cJXQ+Z
+Q9wvH3oLwUuJD2Tx+I8k4gwOwfs/hTgEn3ia0Dv0mvMrQtf1mgO5YoorbyEkon4a2BI+dhyUFm2v4yryzuMY6AQO+fK5pyV8yiFf
+Pv88wicA//wLEkb8izINDOtzT0j4HOCfkWkALyuxfQLxzyEMtPnc/QjeBVhZUg8m4XM/kWiAn39T4k8A/JbEI/y2xMOUeO4jiQf4
++Xck/jzA70o8wu8h3PdDJKMrr2NQEdWV1zCkKffKDzGYYA+8JGFs6Q8QPo898IqEEf+qTIM9IFuXYEvlt+exB16WMOK/jzAM53MP
+Ing3YL8nsdgxv5Uw9sw1mQTwz78vYcTLXtrEWspe2sTa/EimwVq+IWHE/1imwVrK2m9ibWTj78Zaym65G/HQC7l1V7JL//4SoXdk
+4DrfXIcp/QUMiN5LPiX0DoRxdVqPWd+HzeopgLOVO5Z5w6q9HkcBbMLvPCJhh1y4IKFenEDE60Ci8K6JfHn/GxJkIg3IDeq7EoSd
+9NrPJIx76VOE3pHofUslkoGnCD2uuLg/PkpoW8OPATxQDNHDGFjnch1773EMyrXyvasYgAa9B1nhIvmMTCBTy5zkgvne/ZjGF15w
+wgcOVro/AnJ+5BFMh1vEe//3Z/dDiGMDH3gAYdgM//0x+H4nYDu47v+U0Lb65r0nCT2uUv3xCcRLGPGs48MuDFXR8DuAxxX+j5cB
+LcHHAZtu0x9ARCLzf0LBMQzee08Tehyb9McrhLYlBD0hM//iM0KPAxh4MtDONrVfQgiy/+INCTGOn3/xKXwDwRM62FZ70w8JPd7l
+qsHH78Lt9R1C2z2A/hMa1WO4Zr7yrILBxIsKH+/5DvkPTA4bKaTGpv77oxKSZPCiDATM4zzaUuv6o7/OYfWq/uhvNLqDHNKXV36Q
+ImTeX175forpSEbkyquEHkfMCbkUv5ymOOeQL36WhpYc8uWzl3Xi03q1ekFjzqoF63mNWNZrlmwD7EdXEYJZ8M5DCLKsjV01uO8+
+jcE+cpjv/xwDsfz+nQcJPQ7b0JYvn8dGCYcn4Bvcg9l3+ZcYHbPjCQ92TkTJesD+FR6HwmR81YxLsdDYIFmHar78YwUnvtDBCDk9
+h9DjEvry8qcKxhPGRyoJU3ypDFxKQ/2+5xCqIOGQf0EQ5+QDEuxipi/JgLzQMmIDto09+PCDhGqG7hEJqmkLdBeFGzyRHz7+I4xV
+s+RnGKkCPye0pMdgePVu/BykGHTT8sDkH6R5GvFoo0yoMAiIHPIFLCyLZ++VEOBinAwvwgcJzJi9ButDQttcHlUe/RjgKIa2vgJf
+K/hdqLReuF8jtK1hmLly8X3/KQnCELz/PYShf99/WoKIhlVPyA67CvSUdDzOoQ5vfqZDUIs3oRYJGwB89TWEY5gzV18HOFBV/UQH
+oJg3P4cQLMByzb2MQRV4lNDjyUCuvHAmkcvu5WuIhnyflanlanwFA7KWV2TqCM9ijyCMy+81ufwmuts+1QFY+t78LYaCHXZpwFk7
+bQRi4qSdNYUHO4pJ/a4KpmzqU4jgLHTIF28ivJlfeT4xkenCgxUx85RHFszOOF3BEqtOV5d/QWh7J2iLHWAQf0poB913wpz8IaEn
+0sCPICZQ54XPICCb/iwkkiAkmUPwsWcJVcva/U9DgriHB6w3JRrgKz8mtJNx4k9BKPBg2X2V0BN6b/ouoR0NPwV4WNmfAyQAHxHa
+6eRqqGr12BVCT+g9E2USjzwKmEhwD9nHN78H+EjEuEi89ymhHbbhkC8+IPQEw1nxGEJ4UILyFAglZjvVLwjtdP0Yu/6t3xN6Qm1H
+jwFeglCpu/A489ZbhHZ6HtL7Yw9JGOv6IKEnfK/NfeG3vWAuExgZWNwS/v3lAg7WVZX+31/Nxd0p5ULfziGX5fH7318htON7QKpX
+H5ZgFHZU6EQupOL0Svy2xMQpDBV9l9AxTuVRiNzwcNn9y6sQiP0Q8vwlwJsO+cOnCPgdZsJRqNnrq48hLgq3VeDE13HEHyS08x3c
+1J8htBO0Iw7ZPvEaBtA08pdPPEloJwoC6PR/IvSEPu/8BtBqhX2eUMlDvAtI3bWRQOSXD72CWLl/PK1gTPbl49/DdPOXEi/IYvWJ
+4fJnGFQnhsvPYSg9SF4BWsAxM6UHQJeILFmZf67j0srq4FY4x+XMeexZjT3FuiLFPm1iz8HykEY9Z0YtM6YmpsJF4YaZ0eufFWNy
+mb3+azM6H/UrHZUv/pkcWpZ/JW3a+UGa8HsZLtfg5zX+bsaRru/A+XX5LULTdBL1JixD0VboqU8ffdZIYoS9XF+qeKMmH0u0OtH8
++Xsy1zTnWK04gOh5fBBFoVqlH3soh9XLNE75aCtMu/NutNQCi8TTWcwyYxn+tQwvkbKFb71QxEOzPpdI3cFZLq8bEblsXhyLgGo+
+ILHLjKU99k6KgmGD9ZxnizjMBq7n5OsYUHPyCUJPaJ4BIjQMNLKEM/sRxMLMfh6gCCbAO5/AZ4JHFx3yR8hcwzACmpGARVhwHwQi
+v5dgV0liOolaYT8GuCdb+nNCO1tajvP224Se+DcsHzag+7B8qN99/oba21//PaHz+nbgcUKZht8DOBa497z3M0Ln9T71fUKZhn8A
+eHkZcAXQErwmQVhAXgBQ8bSPPw+poTI/BSzU5UlC2Qm5KsEmNS/PUfCRhF6E+HRlwkF55DFCWVchnyF0Xu1ElwEtwTcIhd3uy2vf
+J3ReXyFAvIbfBRjI+toLCMmuvvYSobg5XnuZ0PmMSYYvAz9Emzrky6fegSAke/hZgDCb5xFS2bxI6Ly6cXiGUKZASAw7K+T4iIJj
+JlRwHoJLfS8IlvR6+uJvCE334jTN3YwX0r3wPqSLB42DwCU8rAIgTbv/ERkA8CFC58/c6ZA/wTgBh/+nqwDIyAcJnVdie+gDBUIv
+6N3lt4BWuwv02QCp7erzEoyRmn8MAbk3vPcLQqXg9j8+JnRei7L/43mJloGPZWDTIX8B8JI6OiLNXDKJhl2SEpPHH0Q48EKxIbge
+PokIWKxHQu9e72GuiQdD5Cq04hFUVmAcCQKvY6wf+OvcT6Ckx57AaCVuVDByXO99BqHNtNFvEcrUVHoLGs0VZcJwK/HPzwmdV+AH
+gFVb7dOAxna99yGg0ybOIysGpI8AzIvluxzyh6cB03PIHz4gdF7eKsAYSgiIIOGRQ7588CeEsu124JApAHxs4mUYf4BjFZD338q7
+r6zYtvTz6nuBnmfzGWpeobpeEPjhRspbwhQ8iZP6TUK7OKkfJrTL+jKXl+8ntNv18fLv//7JQxBQMEYEKvAwoSfV5H0c8BL8MaHp
+pw8QetIPQDyQnyS/1fiSiXGN0O538PvudwjtBh5yeT8FUOX6IMAihE554ReEdsOo65D/E5qkCf9zQrua8F8EmHs4+S/fD4n4XBro
+RihxufqcBPE88DKkSbjPuOB43oUyBh4XSgLxJKFd7rVBJP7F5wqGWfzIsypwyCFf/FbBt0LE8ypwGAIvq8ARCPxABmbx++dUAL95
+QQZAwP7F7xSMES+qAH7/igwcwpiXZOBWLOb7KoDJXpWB2zDwGgZwIjwAo87lNcETbxDa1Vvgbwg9qaj/F4RuqO3l//MxoXdKBvo/
+ngW0BD9U2I5D/vKKQnckj72B59trjxJ6p760fo3QDQ2/TuidSvj2xzcAluI8yESBrwIWCBXKQwBKk/vMDwmVko4//ohQZFwff4vQ
+DSD6x98BALeFJyAOoKs/AOiSTsYuXdIJ1WoEk/p3EIzxOHztPYQzrNop7tcBmLfXHkxDWNrDGJTF4XkcQpjJtecIvVPNkSuEbijw
+TUI3UHTzPqF3AnD1ZcCkkN/Hyj/8KaF3yuPGQ4RuyOMG9EngQd7QIOT9PwUABcnPAPQdgKCIUA7EFQmqe1cZhI67dhkg7Ln3EHcp
+Rap+QrRc7q6+Q+ideqpB1+ip9hKhG+kZ707Uw+bpqeStPOqUXPqv/iBFn0yCIE39TopWP1CfN1Kk+vrxT1PMEoxguon8LsWnewfQ
+jSbvNwjdUOQNXaBW8Q8liP3xXQnjmD5N6IaQJPEOgvIa/NcAO+R2Qu9clj9CjeOGZgNfBDg4i9vu289DQO9e7/0WQjwbikdlWO5J
+n2HAzISzS0HWYxjWCKRwwYOsSzYEN5oEr0zSUZQ0uRnOZ6G7vDa7KIX0Pc/nkrm4AoGgK5e4ntf3A6jLw1cJvWvunJR4XIMIjkKP
+/8TknMueeeuyDAFr/hyhPSV+eU5ikWP/KRQrHPItQnt4C/Plw08Repea9G8S2lPgW+gnnOMO+HIaUEd/DAeBjzV+GwK8jTvjlV8R
+2lMT7C1C75Ji+CcIvcsP1hkXS+AATbWndzFm+qzwFga3dBCyjBS7/nuA+wKPSpd/A4HoIhyo0mPcexKHx6kUCX2kZ8fLhN6lJKhP
+AprLht//POC5f18UCi8AW23qgrGnqfVzQu9S1Ar11UoC2GP68AFV1fA7hN6V9PF8eld2wwRgOr2eIrS3o66lHngIAoMeC5VkbEGf
+Jp4k1NfwTwn1YQo88GNCF9SR4buAk+DPAAtU8RLgAPiM0AW5On9A6II8QjxPqI9niP/rY4CQ3/niR4T63a6iEF+N2tuELshR+x6h
+C/oI8AShvoZ/Qqjvw352GQBfbc5PEKrhy1BlP+yikOedVyAQCQ+yfI/Qha8jM/HHXxLqS27jjx8SuqDY/muE+gp8H8ENpqvS9zb8
+0OM7C7rovrcRyEF7+GkZBF5BJff7YEya/PEX8GWKA37lyyufAjhgHYf8nx9D9CDwWayEEX7YluzQw49AQB1odGMuv6ZgeQv+zqsQ
+lMoNUJIfijYO85VfYwC+eJ/QBQn9hGi/p3h58CZGpNKd92UszzKAWB4zrbgDWhZ+KAKP94BzevdFDKrbgHd/C+k3/dhH2RGyB5Ji
+NFJf+zzwBqELi0gKDxDqR7iKQP+qY8zPACnBnwNWzaH7Aa3g7wMeme2XAYvQrwj1jbr4arn94veE+no6/Y7QBTWdYMDirHtjeMuL
+a+7HMgSr5NVfSRjX3o8UDIzi1Q9lYFN/LnS7lErRH98FpIKB5pKL2OBHCfUl+J8vABaPAk8BEqGfE/p1tfp9ROh3FPgxYOHrlwEH
+wK8I/bqaLU8S+h0FvkPodyTR/X8/JfTrurMeIPQ7Gv4BoV/XnfEWod/R8O8BzzhmfhnwEv7Plwj9uqr6w4R+R1X9OUK/4Q0G0Ovf
+J/SiAn+tQDgffkDoNzR39wmhFzX8KeAhN/gOgV8T+g1V/+8SelGB7xJ6cYOj/s8fPyP0G/IK9S1CL8or1EcI/YbskycIvShZolcI
+/YZu54OEXtQw4HU73yb0ooKfvZ/QYE4t8d8n9JS+SP8VoYGGfw1wJmD/CILwUgMo6Bn4pr/egbb/ACIU/BuA8U7u9XchiQSvSSyq
+6X1PwgFTaQLJoT0CqdEPmzp9PANRqJH1xfsAcr4OlX1TwV2gyzdflyHYcp6GDCT4GjROtu1pmUCmflUGehfVvgWBAEp/VJUwwPuv
+X8mAZCXe+lCGBEQ9+gYEhOdjupehGB3ATkA+GfMSyE39VILIrfwUmY5gXdbxzScIDe5Q8HcBL9XUXv0lwBy36V0FXnTICoIXUUx2
+VcJxgN35lA4lEHqS0FNa3PU5oYGGfwd4RYYwRBr+LcIAfvnEZYDh+nAXEgN5QZsQgE86bQ/q+gmASlHuFQVzpTUXdHhHSfbewVAS
+qyDUuYO796PQQGRMYSSRHXubUJRyA1HcAe1lQtFGKvyWklEcfJP3eNpIYoQ9Q/CexucF348aOakhfPQNmfo48wPUvMQuAYyUcBtV
+e1vjUfiaiXR/lOFzEt2Xi3gljgbkySDC8BNXZJWUeFopsT36eQ6Lt6KP/lbi0gbg7vmYROb5sOdkGbm2a2Qu5WPPFT+PjW5CrKoC
+4q8a+PhS4m+kJf7UKDHrmO9KrCm8fjNFqQvIMTH792UhoscZS++irqqESndPdvA7T+WxkOMv8yjNAl75SOLlPUZW2Pc0OleFH2ps
+bjhfymOhNb+XqFyyZ0ycHrd5lPVi/wF0FRt5Sc8HdVx5G8H0cI40oA7n7yKcYZXKw+91AA/nD6QhPD8+hEFZnFyFWCxP+NeexUCc
+nccekQjFD7wgQ+ySFGFe/b4OK8Q1HI44xt7NTqxXvy/RuaPt2xKXpXr8E5nbhtAhiFdH3Gs/kpHqQPdLGZk/7X4ukelRF5aW9AoY
+OrtrzK1TaneFBVOBsAVs6Iqg/OQa7E7qihjWejjo6VnY83iiRxFgqMJbsLP11gNUAX2Y0FNyX36c0EDuy7BHqB0KZyj2xDVCTwFw
+9SXAwB0N4+rm9JS5vsHOHOg6vAX7SiAvV178NaGn+r7kfGHoFfwnGNt+lMQC3l+C1vMHGqEDSiDyuAT1qGMQtyyopDwlv4u4SylS
+0SailUAE6DRSe/xPEJYthe6K5Ib2OqYJN8x2vf4xoWN3mwqXS/dZ9m1utXr90yyD/D3np1ku+S9+LbPqe4MY58jrv5WIfKpP5Of5
+PBUynxKYhCga5NRA3jdwqRYI9ou8gXgbmBzNmT2EeAm/irBSmAamIVPKBq4r2pKaa5dfxACen9cgqy3GzT589GWFzK/3QGXRfUA2
+VwjNtKtVqKsUrQOsoyUBlHq8DYuDEs3gTsAzUgXGi8vN/bGrCkYqhdqj5s39wNVwSa9XgEZjT7EN0HWaD32A0FPqUAIMn2QRPgCk
+hoJsBQi0lOpJCeNSCvM5vrQueaRYFfGSgpEzgcVIyQj+BCujhh8kNFDyrbcRlPItqJ5wyDFCTy3LH6EmbSCMZRG3JbUjBen2BBNJ
+qAn/FiwrmeQLmCg1fR+SIFP7UaDuSV/EGnElN4NCEt7RLNQVDOqbU6iwKeB6V67rSsAlQ/Ik/8XPAZL3Gw8iCLV55YcSZDFTodO4
+MLz5COABevRtQtPZkgU0X/KORmjif1MjJI/yFgT5RaSWF35GaF8p/n357nuEnsZV8hXAAvBbQvtaQ+k5QvvqkvXyrwntMy9OOOuk
+b5J+ROhp1vGTvpZlPfA6YOCeRx0cPiT0tFrgnya0r8BrhPZ70JSHoeZ+m8PB4mMAO/rhTN/vSBJ4/wMMQHE/RihVeUXlknWlj55q
+mjyj4I5SO5EB2CzevUYoKoUqPdDLzxLaD9qojPcDADtcyfD64cBIAn70IHDlHUJP61XiYYhQ8GuE9gc6tZ5MDxJ6Wk2mDwEt8BUS
+PpM7nTjkP6DD4Rf6OwGaxYG+AjVOMjj01gNPaV2dUWfBPz0EeAU/DLB6OPblQ89ACL59/HGE5LuoP2NG+kGUCkFt/iRT6RdQGBKJ
+lMK8/LM0hDKa51VQR63HA4d88UOAtHzvz5+pkFQcx6DSNHyI0DPq/POnxxAt4cuAl+efPz0CeAU/CrDSJ31MwVqhVOYrdRIfhO+B
+bF8FHACfExpq+n2W0JApIdGbb8oAMn8vAqxOpS8qvFyfMQSlQsuYvnCFIs+wDQ8MI+Up/v6rWcxyz29fnBARluHh5rEYlz1WeQMC
+IBeG2r+LAXXTK6sTC9YZu594/H0dZfBvsF6eYVtSrnshhKz07e7DOhCr0Bk1R79HaKjA9wkN1Y0W9r285voFQpcM8JKZxLjOUpg4
+C6hLJhmhWvUx4OXthSTkDQz8HCGuwTsF9s37+KkW8AM9agn/dwGWW/z7vyQ09KFd0K/43uvqbxFCNb5fA7ipos9IZvEKoaFkFr9P
+aKjFGQir/J8EWK4UbwEoT81YHXmU/gAh42AMX+dOwk+qFPnj4c9UsvxR8LuY9lKW8aVLZoHGuURh4lwARx9zljyuxKse/wjwcj+V
+PR4InVxtjlevKRg3159geuz/a5iRWpffJPRMdAdnHsyzB34IoRCDfrihSBsXCr1evg4p5CXE8wDiYyzIHFf2nyAqpzP+hkSlqso/
+xXCprtvbGGXo4DwuEfrg88MsmGl04HyKxHxuHkTCmFU/NxFpZr8wsfmrwz9/ZsaZ8zMfo2for0xk4R4x/0Va7Y8RO3bLolLnr1pw
+uCIhxcyPK1jLmdNIKWpOg5uemRrf+V79VIdQWfsTLKpMBvDnz8aiFD0VsLqOV3+iolKaVaG0ER+kKKM7P0uR6Vr35wxXOKIaMWkv
+foSoCavptTf+/JkRnzsSq26SC8wTCt70ciHsst/pEHbZ55jfWc7arCMfn9yfw+jaXvv5nz/LReTaclXS8Tm2CfciBrk/IfGo4Jgf
+kqf//Nl4HGT1/jg6LUbONKkYs5SsS7WzK3p0TXz6yRu5qAHj6qOn//xZSUz62Y9lZFrGg7gkpahUZHRZIdttxjrYfw/kMGn/ffDn
+z3IR+f77US4upYbfpx9lFX8oq0m+zlfk2pJ+/EAWTIn24QyXWyAey/Dp9zK7woIGbMvA414QSPX7t2XYAFHT4/3P8WlrqO79LuOa
+Fg6iQN1JPgchrsgtHPB2whQZheothopg8lmXJD8IZwHucdSze/PDNAgb1w8AVrvjUwqPO9qrMllul4PE53I7HH6itpv3FcwU7YVx
+W41wGLdVlX+EAZYNsb5SeQ8jJPcNLHLci7jItigZLnal2gofkCBTQxbqR/EyYGZi9H18ST3rufqGCg2YIuVQvv258rAEYWF9/xHs
+DvXJlcsSzlG7QrBL+QRyz0+/b2d9AuR7Ke2JUL4suvKIBLHQR3UEUwQLcI6wFYJdyidQhcrvxUagNyltqgD4Qw0D/yek9A5T5994
+X71WwLFLar0Pi6++r75fRLJLehmCwxJw+fALTD6oW/4j/jLUXnz4BQxI1RE4M2yqd02ylZv6NSGUu3lCBYDG7tYxwPjcrWOA2dxU
+Cl0qB9S0UfBd6roIBngzveB/DUKKU3zzQQzI6xX1FcoyUlgLHRRCzaE3H8KAjv1Yxaqnv7+VwS19qvmxDOBEhA7YUhPxBYWXsw5D
+oTpKvEPootaoeJbQSMMfApy+ylxUShXPERop8CMJqkea8uL8NcAB8DtCI+PB5qJ6tPSnpwGv4O8BjCz3u58BmD7njECvHa/Qfkvo
+ojT+8qdnCI2k8Zc/QTW7sui3f0/oojqYQAoF/oRQeVX/71B3rZ8BCTT8S4ChwHceIDRSGj9vfwxw3yH/8R6hUfYqMdKs/68BViX/
+DmH18u/t30BIKl3cjzGY9/2ELkpZz5+eIDRS4JOAZRueKkeCf3kcsCD9AJ3m1wGvA7/HAHbIJwhmT1kXNRf9KKGRhn9IqBZrfkro
+4oCFZQ9MXzGiUuRL8CnDt4Jv/wrg7JFspFf43xC6CMB7QAy4/L0LAD7wfhUh5JYe/kjBUTcLgfrWNYT6DvkCupP7G5jgCjSNyyeE
+LwCY2sV5EUJoFucHhEb6We+iXughOyVa+QjQUhfpD9ASDQN5yacSV14idFEvVNAoDX+McJ/FHsp2P8FkStD7qY5Tj4MXpVYE1FJC
+UHX5dP/LJz4ndHGTqYum+3+nQvLa+MunXsvC8sLyux9JzFl0f9NjMR5Mn3qFoAdeh3zxCaHmLvUWBjNILgTvfygDcu9HWO77hJ71
+uH5vhkGcnq8TOkDg94QOGG8Dpf8zgn7UcUgNwT7evd7/AaGKIsDQxYBxwdAOx/2/IPSsmm/PEjpQ4E8JPYsvxd8mdADAXx5FANQv
+ngdIPi1GOdigJ98iv/RdQs/CNz8kdACf3A+/ot0zlKEHmMMLhA4CL2xfVNpmMoC6Z9+VoYubaVQSK/tA7/5YBteVgQqAVcwbMuSQ
+aQl0IvUkbRBoQeFbMoC0+EtCQV6oXpN/8QsZ1Q8zWA7Iu2/LkNiC/N59h9BBX6Y5G0lNKqgrUzqAA1hrFGsGfaQn8o8guTwavwzo
+JOw45AtoDJd3RZ8SehZPJr8BHOwtvwZA8kaP/xZg1s7ukj6ViDQda6vntiq1cQ55/NdZMDuEZLgc/2x+m/Kvv5MlKP5RBox7rV8p
+jOQsrn2sgur66l0Mpl/iBeCHMoni0TB7ZVEDRkXDHyp8rHuNq3s1LDCcTwvLFSVfjj8F+aTvxp/CiK4XyIn9MxlUi/wTz8hgnKBo
++4lnMYhy31cxF2Uf0wvGUOpZPiQXkYodcLNZCcfJfuUDQs/qZe59Qgean30E8DBfoO4A/OUyoYMkbEt25zKh/6pm43OEXlLgzwi9
+lKkkXtI09gah/6po7PuEXtJ9+sCLhP6rLvsnhF7SZT9K6KUEDsShLzUOHn5SYlTeLxCq7lVaCpLk9TqEIuF8jXyN/uv5xWUEuFZ0
++gGhaom8/Pmff0HoOS3Rfg4jJPw8wB3U+bz8fYAzDagPIShvO9+DryX4vsTizvmMhFFP5y0JS0Wn9yAgtZl+AyCXc+utj2UAVZve
+UrBUVvqhDMnDD6F6X3wGCpYgpFCs1zMygfzyNRmQak7XZECqOf1EBlDN6a1HZECpOX0k80U1pzdfkFEYePTHMiCPVwBrlSfonVT/
+6RWM8ZHUPlFwqOT4kFDrPT1JKNd6T08Rek7DT0MapQMFzVU6UCMFXnTIKoJSB+oJCSsdqO/qEOpAPU3oOS3rf4lQruGXAa9k/dBA
+Db+IsNSBehxgOEaNIDHsXdDJKJy9n1CudKA+BTDQqk4wtFoj6lUF8zQg7zGhRQxn6cOvSFCpzv5AhrTqrIrEXnuV0HMsReJq8cJP
+AdyQFkjOzd+pgDGJzNUMmX8plkWcH+Sj4C0mT5UnPoeAVp64CgE5vX9O6Lku15VSihJvEqqvfx97QMIwEI/dr2Cktp8Qeg7u3/7j
+R4CNHPIXTBrBfgtTEW+sCxpg72m8oQIGkyR3un/GTGQiPOORc5Yi9zD7YTMzrQb2Y5Xc0AN7XKHGFMHeSSMKmmCvGhE5paDnxyLU
+G2TEnjT73FC6Ureujz2QRycD3c+AzOuDPWxgTdWtJxS+oOn1OKEl2ly6Gabi1tsZDraPN7Jg1vwfqNzyultP6KQFKWEBDbl+WMCl
+8q+PVURRf+upFJ+ryCspOjcKzxXQ0K7fKVwu4bM5ZNrbQBb/DssaKEIb7wufJZRrdR8YgECpSECnB6gV8RShPKeh8wuN0AF1xfH+
+zwjlWrnmpwjLfH8HsFKueQdgdQp7FGG53wINq/32VUQr3RLMMz1yQF8Cu5fp4L/1AaEc87MlgKfXt2F/HGSCPdhttF4ITI389RGM
+farr8WsIqE39MULPqTMUpsGVEeZ+CnFU5FhFQH7/soK5DqRqF9ClKWVdIVTJ8174lQSZIhau36B/JmF1y/TOdwk9lwTsBAu8HTTL
+8s5HhPIk0AoW7xLKgYV8+DVClzRv8H1CYw3/gNB4XdUS8PLW+dpnhC7pHeeHiJbwjwAPCX4LSKCV3wCgmOjPAZbyRYCQf/wIvlB7
+1GsYL+HXAY/SkT+9AmgJvgqgYkJ/jTBm8gmCigl9DwLZMD4LQRn1+O8hU9jvfgRI2O8eIBS0ANeVsQytEfiIBPGc8jahcXrX/ZYM
+cKVxFOu77pcUPtVFinEv++IdQqWZuSYA8PgIcoEqssyGxwsyGKbwNiqvfELoktqRAKvADxDMXnWC5gz0x8s/J3TpLmWJDAroKVtk
+jwMese8iFnCXCV0CoW3RNAUii8rGprj3xypYOEfjd2MbFGIzoxaPfg8+BuNRPyV0yd/A56WQoQT/8pACuw75y4MK3tSwlNEhpNQV
+rmEACeshCV7KYFSaek2CSCI/RBiPXq9KENGvIywVEx5FWC8ib2JIv9n7JaGxoYOPr5qlqTNlxCsGTHFQ+17cQyujH0Ige5YPeRsd
+2vcxmycQRu36awjhVPmJBPFq7Ceo8QTG43E8ISrqChzRJwAOlODk9zIART2MoEMOELqk180rgFMwVGXgyYPqyz/UAfUET4a1iCS+
+JGf/lWcVjEKcZ7FO2p7qlecUjHHPyfpekhIUWD8uKfH9UwrGBex7KqAvwbIgu2TEY/5PKxg/fEYF9EVYFmSX0nj99BzWpvFA4QHU
+lWd1xJJZn7L7uCvfy/DGZVwRmSZ/RsecD1VZz6nqddXL+PhSouoWX0pSpHEmWtKnyJ8RGusN5zLA5vKRo6dYyPyvPkrokrRyC6A2
+eKvArjJ+Gwvu4eVAaun44wwpRVDPIyKMpc6dGs4HCV1C6OrTiFOPqT/HgLyjQRBH7VEJyjTvP4Qh0NSC0AMYwlX9/asSxm+uIKzm
+5rXfY0jPzV9hSN/3YE1kQFUmJYdHs+AlVafCkKYppErwlStG+FJWJyUpfEwGpDG651UAtd5ghiRtQ1z0mUToHTFpm+KizwnV96Uq
+wfida4bLi4uMb7O7VlmCusCSAUNc9GuFUeKiT1TQ2D6TtrljJu3ecs/TR64YD1KXgfhTKE6QgXsZyDUZNBzyBY7JAIwo/BKhgw75
+4kOE8H4MPgboKn6sTN9d+50MqA59CUPyrg1BJITHJKiI52FC9TzL8s1PO/lBT8qsX39chWQRL2JIK7LiwAw0JT6IIUmJT0gYK4Bd
+MFCU+P79GMoVr2jvezqGXcqqYVz/6bCitKtG+FJWpqK0yzIga/2cCiClwYRP76zelgGutKRjfWf1ssKn+tPxVqhYERiy+6QjgNcJ
+XfbWHXJBECo8voHteOIFwMLV4JuATRzyl4cJ1ebGPyJ0WfN+EK/htwCvOLk3AK/gHxMqty5Ewr71IKFK3/ihHxAqWCCFqk88T+iy
+4nheJFQo8BeECvCmBKZ0Ln+kAuB2X4WXx8P4cvQlTIrgZwpExwV/+Z4KbWrYb1/MWa5HTMZ+5DQRH3gdn9LnFA0fx0+UER8MXEzN
+6YuemdNdi+fOOOQPrwEeDVj94XeEisxgnp7Ol3+r4HTOP0TouN7DIwqZrgJQIJwcJE/w7i90UJmSxoBD/vCiAvFV7QcQSN9Xi4h5
+SkVSRANprvuJTzCgVIh/gQFll2tZ8xRXESvhtyQsLx/e/z6EYk/xwCKVOsNQcg/r/fAbhC6fmzsxr2DTBvrHWVCbP/99htKWzx/K
+o+T8+ihD4pr7ShZOLaP/qoCTX8pCJY2++BMMsCwHze+9+2tCl7k/0LZ0kZohLGNl1tj57zyJsJBNf/c3EBqw+6SA6ilovd7lf06o
+0Lv844Quy7PL24iFufMo4CRrD12uePsfQA5K+R/TKvgdQsWWvy2UvX2xFfWY18kpVL6WovPSpx8Sel5fZn+f0ETDvwZYLjffgyQS
+fJ3QRC08Cqsut996nNDz6/IJ8ncJTST4n68hFm3G/OkniJbwTwk9ry7If0BoosDfABZy+DHg4PuHCE20Fc5HCD2vL8U/ALyCfwGw
+OvT+jNAklQH+jtDzanF5CfAS/CWh5/X19suEJhr+FaGJkgdC8cq25qO/l7AWsCT6rdT9hCapxchXdIDxLKiM1T0FAfVs4jNCz6vb
+7WuEJgp8H8BAmmQEt59SdLOmA/pS9HUDoW5FP1aowrXoq4RqRvTqQwrWdpy/S+h5een/p18SmijwQ8Cqaf0EoBX8NqHqkKdsID5D
+aDLwjFNf/gx4Po17LIvL2Vt8BDLImVF8HhLmTquIMdM8hpiCvBfGepC3kPjo7w1c+oAJhm1gNH8wKLw6ekEh86fcF+EzvDYCypPQ
+MxLXk+D51LjaWxIv+WpMbgoc31IIdTmcDFQXotzyMqFJaubwVR1gPAsqQoKqS8Hdn6DpCvw5gIq8fkXoeb3AwAzRC8wVQhP98ucD
+Qs+rnehP7wJewe8BLAVQH0uwq1xTJNrw4WVCz8ubfCBwCf2W0CQzgPgOoZva183br0CA544bmx5X9gbAxsCmx8MIdtQNZXJv0+Pp
+QQRgX15lA2jeAm6q6Qip1GIkP5Bi+V8grCUPD8pQjvPHo6uJlfx/hjaZtzGsZOE0WvEef/meDOX3qyu/zGE1RYJweFNOqPffJfRu
+uY2//77Eoi2z9wi9G5fCZwjdRACy0rpCbxCaamNBBhoPX2n8+wqPyg5vE7rJmCznym8woDRS7k79mmwyJvfP7wOsrPNc/RkEpMWb
+IaTWxm9eQLzQWKFwBUXMH2cY+ZBimCGWQGjiSaH0qy9l+JTLuZ/QscceVwi9Wy3kLxO6qUDoT7XGYpdrTUKpIbipdfyk7t7denF7
+ktBNDQPlGjfTm/rd34eE3q2nFBCWnlJXAU5Ps5IYshOtDuszRhZmGU3dnQ7nNUI37/M37pO+pL5P6D1KJvoRoVsK/JjQLdaRnf/e
+6xjYYMpT1j2GOxrE4zC+DAFf6qQ8/BKh96jO+j6hWwr8iNB7dA98l9AtDb9L6Jb+bIursdjiDM2TYOAe3Sm/JHRLd8oThG5nLn22
+DYc+25k7n21tX/VzQr+p6vEDQrcV+DGh22q/hbew2+qJCjx//Sbc5b9G6Db8/o7QbXW6g5e320qBEF7cbsunYPBAdlu9YPkNoduG
+E6Fv6oY+BXgFv4dw5lpoO9J8HDRGG3D9BGBV1q8I/abuhw8J3db98CTAhheibdOX0LZeZuGLzKvQtulT6F7Nhb1K6I6GPyf0Xun1
+7eeIBf7oKULvVQTyCaE7CvwUsJDwfcBBuquE7qD197cIvVf19CuE7ijwE0LvlVagHiN0B81A/eeLhN6ru+ZpQnc0fI3Qe3WjPyJ0
+Rzf6u4TeK7m3nxG6I9m37wKE+8TvCb0XoT99Rui/6SuIXxF6n4Z/Tei/6TPm54Tep+HfAR6yfRGQAHxK6L/J1+K/IfQ+Cf2W0PuY
+trzyLqH/xnh0j98RPfOZ2L/JY+ILkBahTwi9T3UBZNrl+mvJeUNCZLz/L0iYGvF97FVC79Pd8T6h/6Yuqt4k9N90z3xM6H26Z54i
+9L4tMOh3/5MAhQg+QUZ7eBPUzvJSN2zKYJ1616tVRPZzwpz6ap9Vju8P36occzduBQ/2RYeSfthh24tdGitn8kZUz4sXt0JQz2Fc
+7NBEepn21mMaSBBv2/fxdN+mA/CDOYXuG7Xry5oHLuYscLlLUzeWdrMbcSt0fSvCGFu7M6VtwytmY5W2XT8+452x2nar7rRvd+ut
+wGrbjo9f6Y9mAiux2rZNhdtuimNhc3pa2H7XipWXRyha2NWqNXABXhGrlFsDO62jUC41Zxr79XjW/tC6rXHYtmzlk7TLo74DuNvs
+G3AgD2Sh6gCeNUULXMKz4VB5iW8cYK7bOCAcBuhqVQA651eVCuV7HCDtjLQOg7CXc84Sp6aGa83Mp6l0OGv6CmVAZyZCoO/YUI3E
+lOv6elDSXGCkISfPrTe9Yzpp05uehjGailJXnCveqj0cAvWsALxKhfy1s7xSL/3Sj+s+ZGwMl39Dbv31mFSrrLa2xuLTUScJWIs5
+ux3W9UDMyEYjVXiHdf2Q6SItQUn2BaG70of9VH1kN7VH/tsOo/98bvkwSUPrttsaNo1dbnk2TTBct2ngciuB6QDhWZsOXG61bdrF
+8EGb9lxudW3awfAhm/ZdbnVsuonhW2264XJr06Y7GD5s0zWXWzs2XcfwbTbddrm1btMtDB+x6UWXW1s2ncfwUZsuudyat+lxCB9p
+2HTO5dZxm57A+h+16aLLrRM2Pe2uBDXVKTROoUEK9VNoI4XWUmg7hS6m0FIK9TS0Spddq04jHbat3QFnXX8bjAIsamwtxYHW6YYf
+xs7pEZ1LP2oKDbrLNCONFHtTJFIDV/BzwqqDA308Zh73YmbZ08p/r9Wwb5hSaFZRfhMVTbeFo0dsYwrw6/zsSMP8Cvw8c2uXbQ8C
+v+0LZwUSHLYp/BzCn/pRe3VUsurp/A7fJjenQwcV1VNy2hsQs5Bo/Ot8b0vv0TMNGrpymWKtuqN9YOMKg/sB+taybFj/j4VNXQW2
+IlabGB8zYXFwtsxXGqv2aKRn5NHGUblxHp2tyzl5dBbnJACzclYenT1oN7mx1mBhrk9NXIcFTDA3yiE3mHC9HKbnxW6cw8RMuAnd
+c7DKegRbtbbW8YS3toZj5UsX3dBY/z7mCvxJG9o4dFg19OBB1dCDh1RDD96qGnrwMCw/ANxW1uKorMXeWIvjsRYnYy0OJrfYaK8O
+q30kBDrgrlCjT303JYTp6fAYb9psxZ8OV12xEmbuxkcGSU+m1SO3zkpiPXK0Dh3lW/wm6BTGgdXaURgLnrRFxC1WW98R7FTeZbsc
+LmEjWeqx007KoRIHj9b3mcC63rOzB6He3FJLzQYTZ3VPL3apxNp774eFvELr0CzO2CxLY2td2umvR0FMPTdqcSvSJTi+UYa3d28p
+3sXPmMWU5dAsi97vLRIm/XXGyZQbVqskxsJVYD2KAuaFMiQ9xbNqdSqCbIdDK64JFguLAWfhZbBMKKpVVvHDiqy9JWw7mylqRWjc
+iqz0gQu14YUVq+WsfGvlwurqLUNrhXxt1bZajtWautCwV7514cLq8MKFmn1L60LDvrB6gMbugW9d2Lrlnw40b4DIFWmkicIUxSxh
+hbY9uk5CPqoG8eihlNm4fkLO5aXWW+X0XTKdmoQl0nVd3godnnXerXsRbr7VWQOvd4oeUoedIwZ1pusKUGTxNBO7UW2gAgvxPD6P
+9NYDRhNgonQ5aUXSA8jIsu0Wd8yjjkoCRFutepJ1ZZTAL2PErlan4jHkyOiKZLxhVvEoxdVmicN3uAGt1Py6m/Lr1aqoVqdELYw6
+bHlnAKcDaHq1OpYUuNhqdYoZSRmNXQ/qr6vluhFN3LjFlSt8Rw4sDdykldT8OI9tu8FwaM70tulQ/7ZZG+be9Yy5nta5ut4+06hW
+2T83XLderbJjriQr7h6t129rHD06e+uh2w7Vjx5tNPcklxxrk3VxRkrUb/LcKRYOTTpsHJts0ePRFi7W0HnznMN6Pk0qflwJI1Hx
+0slK7Gy3oX7pCdl3O1EbiavW5swTbJltizNRh1mEwDjXzb2BmbQJOTA4awk7rFYt4YawwbQ9XLWaLIgZNGAqVEtpU7jhCE5mrqAC
+5zDNGpd2t05dYW6oWCuKY8wseUL3a8BauJE7PR3982wzbNrMDWtxz+8Ky6b6LDccWvJjmzLLHtm1aD1mfJNZPt0F9thrgx9WT3iS
+uy10OmycVNjGimKVVFW0hLsiKFt1RG2QxD0gsaZwGc1XcTSiJTIKObjlc6isrFrItsWy376oa5VhmmU56UGtVjVUIKc05elEuolZ
+lD3E7XRBGYtpludwD1v/hi8m5zMhfqRm3HiGYcsckBDHwx45JSljJsBOeJSITIJQEpnLL0PLga7bIwdIZWTZ5qJxcBbOEoeOHkRu
+aKHfZx3fE8zeZ7mczCPWKU/5wplGk98Oc2tmRk4k32UrfLVJasR1Xb/FavEAz2qcNmyH1BTayuFpOD1tOzD3CuiZGXuE8xIKb4Yz
+M83QZrUklLMEcjPWhRIGiNW6fiCyIdRhkK1k7VlZpRyacCxldvn0tC0saAjlFLajUE2MFb6alhiqPfnAt6wLB1pD21q5EF9YWr2l
+ZVtWy7lQ223Q2dFw5VsXDqxOQ/SF2sq3ahcOrN4ytIG5WQHQ/qcDNHLLdsEa22Zti9nZUbcpapzFUbDJzNmnmyFcQqjvTjVolIn5
+skGKbndh7Z/ym5EeKc+NQOiXyQSjVYfV2lsdS84PWOHDjWy59kqXazKnv6+IqDIAeaaqZaWfxKKyLreKcCMmdtPD5dWbJgfItKC+
+Sw4AOXjZQV/PJeD0LW4JJAdhkQMkv7Yp5nWKjWw65du170R+KFNZfoscIA4h9jRMpRoZUdjJed8L4NBW3Dl8V9T8eA68lyeCwSyK
+dK0sRmcaGX1hhdiNV4gNh/5waDGX1CBUrUZA59NusbZsRM2qlBCFrJchFoEvoCC3uJmNiaeRiOSSkI43raeNM3oIGllgL8qIgV0v
+MUD9SighnbVGV2F7OAvQWJ3R/vGzYEb09aZIp221SrCDUCYxPW1OcWO5Cm9362nKcBVWlawfbg9bK6uO6i9YPWfEdMMeMTedezgl
+k/VYcAtWKCMiNCLSon03TzTywBDmMJ68Buj7oaVFvDRKpfax69HErTeTY14zkYJdfyVZnXLdaCVZtXdjN2muc+ZdHOkyA1jREjdu
+Jsd0fvhlIBex3LIZuIFmdiLV6ti2aZCNCgxKzAZAryg8C/y+Lxh3wQu4qHV8Hnr98XklXDglQv+gTMgVK41UYBAOh7xl8WrV4i7X
+fVanPB0jG3YEbjtq9q57MSsUQoUsJsRiVmbTvAWs1SrLmcYtWpJhg/S9WgVeKitQ81gzaSqbhlAg2xZjjVL5Y3EHV0dNuYKSdaAj
+4q2TrFC7VdxX091HpQHkyJmQShyr4yqpSXZa2DT/ZWFz33MjF2ZA9RkFBp0koRSadrJJPWC8C+tA2Gb6vO/mcEagFkZbrVJ+ULWj
+kNayR+qMM4VDobIHRrHWw9dcLWuP7KzQsmd8+0CDHR6hhEp+Q8fXvmzB5pZNG+zoLWylvjrNVhqrIzz32bZzwhOyAXsVWdGpoGT4
+NAvbzt51ZVv4sV3bkCyazqEsJuPV4LJu/7Hd88YLzikWq/hhLKDjgeG2y1fq414IRyworOJV2oEXxxUvzh25yq7DpsyF/xzrMs7C
+ts4TWlDpeXH4NVjtWVjxQ1/4XuDHrFOZqcTJgHHLzqWA8lknXY+mRNkFmnmSyLAt5hg3dlG2V5WkzeitvDdQQb7CtgecxbEfqR2L
++aLHOGxc8HUl4kb3UDyhkmldAghl0v1Wi07kQdRCIk8j6a4hvnTU3QSjLJWdOFMNusV9IeE6bUdh199IVFx9BLcY1aqWR8Y5eWSr
+FIt948CFG9Zibc0V9kgY92/uVF2taKE1C0LquGzx2/vSTt6xlR4hBArb9G1xU94wyotmyoyb5NBON6aohh1JwHY0CwWhkWYNI0tQ
+BrJcKy67f0q+svunPVtbNjf+BjMv+u8/87z/nXk3OPPSrotz1519b2CVzTqULsZ4OkbIjTVk21ReEgFvESW8zSgblc/sJHdo1BVg
+Y2f7ZnhMs0NNOJYrsam88KllXeWageFwqkF5zewud6pOCfYw8cMKMns13bXuVN2m5Te3jHLQfqDcHo2KAntBQ8rTbgmrVWaZgxva
+lCOOclgdRpa8apuFy7e2y60AbvohfBDu+kFLhfb0ctdxudWDm/4Jy5chnVaLGI308lWqhJMeZD21WmUVRS68dHmtDVAb3ZVFwEUj
+jrIWyiFqlMuKee18VvhV4MVi3muD8DAXdusyD6mtxGIdr4Lu7simuQ+mXXltJNwcGmWlxocrYtWtGxU2CUrJtbkCmoXvjhnN1Kc4
+K3QLqehUY8p1Le4yK0u/Eq7ChmE37UJqqLXqUjU7itnxUb4bt7zgYtmI4HfQx2VSbhcPiOn6CTXksNxAZni2kSBePPKRPV7iCdYO
+4sKhRhfcym0l59jG/PaghdXBPNOPcEbA0tth7QDOIiHum7B14YVcCGUOMnUs/GBkOzeSlcwDzstj2VjCZbQkLzyzm3kxzCv7HhKM
+7JJOAcsMX1mn8CRgpZ0SswCtetxYxxSzy/K5uc7R+V1358yJr7R7PDGxg+D8e2OdM56ZzOXmusYTN9w5xyWnGE+cyPmihC5Kc5gg
+IsiVJed3ceZ6gwELO2UiWDYmeKVCSuKkKAT2VoabKuymWWLYWnUe3AW5KH4EwkD8mOP1FeWu3+IOX5H6ATVfMHwDsGrZTaWP48HC
+7GOrottdLU+xUTrU9Fy+Ek1Pr470hVbkcrx6sWzQ/opCliaMarhxpwIlKYJSK6+WE8bZVgEbbULbhWrjfgv3nO1W4AR7VLsL1W5j
+tQe3u0G+2l03WBmY1R64ga72wKx21x2oakOePbfbLO6gvYynGN9JB5z9LcZVoIAQzW5Z9v9zR7nr81hQojqJ2Fmb/tsPvb4t6tlp
+SzsVP8zxBHkOorPqFsLTiarZZPppB8wLz3lbxvKj76BKEuW0p03OKWOcMiTlhe4NQYGhTkNUDQn36N4Iuleuz/7tbpjv3sgNV3yz
+e3031N3rm90bub7RvZ4bNb2sJZawC8yUH8aMizvwxXlhp2JGx4IynXHucFENO6UwyouUKIxuWmHA+hlE6LucRoVeQhXgOvXdqOU7
+/h69FEMvRdhL3u2ZlrVsfOz6K57ZS57r617yzF6KXc/oJRC/G0SobjUZrdNEKkIFmiHWfdAeI8ogT4btVcqOuQEIh/NoN5hOV4Hm
+RAKVwzLXFYxf96jsPQTmAHA3/O+zoqnVrGwAphu0TmNbjVFhCIKxIUjyQxDAECTFEQhW3WQ63H8EOOtHm+x4zw86OR6l0P/5TtZn
+QnWOKqOphq0OaYXmhGPNsUS+PeGqfbvLiu0B/U7j5nNSQ+aCoHy3NFYuUSAJuV9S5oIMie1BElzq48B4h7endyFqpEHvODRJInSZ
+JonQJAnuhookeL4fzXVcVhXuzMpain6p74Y88my3cWMTopKOoCI7QKfHu4zf5PYu5jyIq9WZBjKbGNJPhyzU4B3Yw6Godb1YqFRc
+NiBNJSNB/VLFpClUXZFBtkel0oJNxncmsseSqjCNxQpMbxz12T4fQpKx77DWJR+Oa6eBTDHLTLe2mF/ZRb6+C5Q7hqm6kOUOkyy2
+QNhzm20xW222SD8Vv2sViRSS60vgunmZy25+l0n22mWS691lktwuE7hJM1CUXa1qyFxorIASfyOMOChLpu0lPIqEcbjC5qphLDZ6
+4DLaKzR6ABKzOh24vdbAGezR6D40uoeN7tzuDvKN7ruDlY7Z6I470I3umI3uux2j0Ztuv7mZNnqzrNGbZY1WbWXuClttZkicdFB6
+qnDLZGGGvFrJqnEZqHR9FnRARbHvxyBe98MKdF0FRc5SV3FcXUJmiTJchNwlTKGDtk2ZuwJFtfWVhcXsVaPuqcQC+3UDNYFvs5vq
+q41Cajy+Y8odTHk4TbmTppSXCjXBtsV4U8+HF8NoS7UMGlHWTJVlN1flfcTZZXqgtXVkFqF3XF7jbD2BQcROSceZlY0zs6kxahwY
+a50VkEZ63ZyPsnJJ87HpKnpg5VsX4tUDG5QQox4uRwF7YY/A+k5ckaivN+aymSfvwmBlOmI3fXzfEGXzdEwMsqtv6XBMZXovS1+W
+Ota0IlPHmLogmGv5bo74HFMqwpQ8x/Ld3HBna0QC1MFsgksIfJK0fLWt6nVlwuWGMdzUB8kQkR2NmVSrouXrnhdO8Q2ilcDDU1CG
+cRmowmiGxR/RxBJ0Zfci23EIHl0JBcNdeaVV49Q1tput1FdHIyozCLyb+H5MrD3TWB2NVvHaslN2bdn/K68t//f2/3/vIP/n3f7j
+KvX/rqv/soGJ3BKVccl1ZW+2ChEpO9Yq49tlSjZyygd6j+Jyr+9c103xUxrOiL2l6+akBeLLyEOzR3PPsg8dPJR7lj178GjuWfbR
+ow3jWbZxe20+X2JbFWHWLWPb8RwMipOiuIP4tt5RxYq/SmM3ZSe8lqEh50SWZzeNrck3HwO5cQt01/mKv+qGtkPkTTwmayHSczyT
+OpCHVjH5fVGYT9LUTYlZTrqQgSK15zLLg7tFzCi9cOYj8xo7d9evVTOL4uzb6wazlEau1FdbZsDZHaGcRk1cddIF3sndHdFs3XYz
+TloUOlHYtlFSCdt5NorF8aUl5DTjijGYFa/dZgMRV2Qxev39+tLimZpktv3uDuRfkH6gZHdFvVjNeFPjTAwFl6ivjvVRo7SPGmYf
+NXQfYc9IMtB6qogaRLEPxdwBVTXlKypxzQ8HiZB1shgNa4Efwk87CpJ+SEU6yMjIGWz3iLLcbRgP3fJHfrvQs3jSHuXnR2hL+l3x
+s4e9mJEkxFz+kgUv0ZiUjVQcuxHIMe2At6kRa8q28gWJSB6TxhXcb5KEU+MJTZaRDZ47jLAWpxHSzGYj0LxJJWLaZbAV5yvcDqKQ
+fWW13R1R4aoOy9M1k1dNzHiGXVaTohD+q6iPsgYQhQxkxeMDn5P/Z1ccJbUryKL/CyuHJU+qmzoB3uOLnlvC8cuM/gff+uak5xPH
+NLZHmEDObMvO34yZ/Qnxy2WPnKx6ZobEtgi81fxHmbiy5cWVDkPncoJ1apXzMascj0Lh+SHj/yiv4OE5TWqaQt1FQRBO2JYtJQII
+2jRXU6rv8I2VaLzGxamzb63lB6U1xzRKvnDzlS4bCqlEMakNhQm2bxMw/eQWeBD9VTVATsHJ9QcaHV8b8jQJ9yVycSyZ6eoCZbqx
+ms94wNnmV5HxTDHj9UkUs8/qyMqa700Yur0Xs9KsRARc0niTdzMOQOibIluvcgU+GUx8afYXOTeDq4HnVuE4fwvPsNxwkhQwY2oN
+uWuBW2TALer6tzRg2Q4DOVFZDmEhh9DGt5s6B1mjLBsMj7KH5bldwNsqVbPCJxypXSYb0qm+B/Ysn0UUick3ZE09IeDhdwqXV6ZE
+uYDZu6ZyoyHCpGN4JCZ4iFjyhdhiLCzMEMWlLoSx38kTdPbwDvPQ/JmFL70MPjYWHheabeUlUcjY+m696R9jTX962iYX4KyJx7CW
+FboNyqfdhu2EmTLnLnzjcCpzdcJRea3v2Cnh5scq0EQxOc50W+SZc9nsNNa4KtiKeCfHz2ftz26sZKrmDOiDSnMCZbmHxlPXEWUj
+66uUBd68HANlVbHXHb/8+NriANNCJG4RcmFX+8PA4zHjToWzS4nPmUUGUSzacTwDyWdkLLG/ZisjNJBtkh1fgAmTB3gvRYZ2U/Cd
+Xby3jYG/aHui3ZMqzjzaIsfjeGknFN42Vk+KqeHWAyX/Ao0GVqvWgQu1uB3H/3TAl4qNMsJusVqfxbG3Ae9wL4T3RklFcJ915KtV
+HrPKEpxCt3zRq4gedLIXdjzeqQBWtqZZEXyn4m14fpilS1vdjmOVjjhQBy+Ob7wKIB26+Sp4+SoEbLwb8Cpkn1qcYn9NLaBUXQvb
+pmyUif2jSBRlXYZxNr+pL0aOHkErQdyKpDGtQwfrNlqXiU07OP8rMv9fkfnfT2R+6L+RyBwvGGkk2dTh0IpS/RZ7XJC+n3aSITky
+dJRS2dVUWK2iiSol7TEvvEBgZr7WaKzmb12zGJ6LsSkrr1VOZ3JftRD1WEipbZSlzeWHplaB+001EKFZdkmrWuGkduRb6ChmK5c8
+ZSYyrVpgE1K1z8j1qVcQBOC6V6eR67UiJ9pPw8TDKR3f7kZFDZNoJTYFAbEbaUFAnNcwicc0TMwG59ozyq5cRe7IcY7FsIx/dcId
+w3qTBZtAw7YtDR9Uh03KbEMYbI/+ZhdFsHDiTb3+1hJmyczeY2fjqbFavPWIbmLd8K9z58tdLGSyptBq1Bt1UJo1TkvpPc+WDy6H
+W8KRAI3cFdKP7iOUbLH1i74gq9RzyVzo91EN5CT3+oxQUJMlwP2xWJBpD+xP+CukDXtqAOHhMA2eM1IFbr05FVerwTFNrs1getqG
+3KKVYHWanCtkKbHHc/maOCM92suOh8OpRBJfGzWW6rTrrqw246J9MljGunrKqFMDx3ONNM7hbVt12mAHDxyuz1hipm3bzbYbTgtq
+WGEao/auoU6l8nbrzXLrJWiyBKoMx9Ka7KqAdWzgghUqCNa99kWrbTDD5aXLLZuNwCTUiGIDeJSEHfPY0ZWPInZ7XtgJmDM9PaC6
+BIfRtHxnqjGy6WBU3KlybeiabehCZWWuUqnb6uZaBK8/TVOLJfSv9ON9Km82VEqVhbmoJOr1pW88vTS/GETBTtc3FVWZvcvQHI8P
+G42i2Dw1u3KPhbIKEUnO9kbjsH2TFsnzCzzaTxdoIsWmoiUNoi356+iCnMldAnfAAn8JpbWVEGiZM5bJBOHZUp7Rg7TFUlsxxIB9
+FiiahrbTH/s4e9xd/Do9CINlFyHtvCTqe5rbuK3QHuURhTfQVG3WmZ6lSF8wuL7ZHTCnoZI+5bB+wdYpdy3fjYwDra4H5thMrRWL
+YlbcyCFfQ24b2QX5OzQtrAGhKJrIzeWK155htdq3OJ1kkTC0qW87YcvqWD50V2Jx6lNh2w5AKEmSK1ZoHTkiny036o0jcBluhVbj
+oA3WQY7C/9lb67Z8x9w4fFDaLD90+DC+ZLaKlMkKwkcYaDBrPqh1vHCD8SiJg51z8vYDqgk3IKc9fjEZ0A13d780ziZVNycnlI2g
+DrTXCeiAR20Wx+cHHU+w2CnYrtzn6Xksn57HWz6seLHSa2t7MSMLZ5bmzy2vnZ47943zZwmaT41r7SgULBSUYwglX1BP25ZGkpr4
+5enFu+fX5r+5sLS8cOZOFDXSGI/EOKR7fro0n5XYM0vMpVqe/+by2vHFM8vzZ5aJ05mY7tw8VuXM4ol54ni5atij0cg0b7pxXTbG
+d3sCfIX0hBg4Bw5sbW3Vtg7WIr5xoHH06NED2xBLaN8TvYmpjhyA/QL/nT5FaLy5UZZwtl6vH4g3N8joBsygG6uh5FJTvhfVSxO9
+7yb4vsc11cCRP799pjEcehY5epjA6WUqqClj9PCQYRftNnGvLeY3WShimfI2TGkmdEVTrwYMEsKhNk735Agqwm3f4ivRKhU0smU+
+RwiNKI7J+BocyIzOeH12wo8HsDUfxxPomBg9BMu2Fjl6lMCsn/wdVJM109Wm58Wsc45t+LHguBvBJ7F625W7xefAQfBJWi6xC3f8
+zciK5SqfunxQwBSr8UIp1aoVWeNoucrDRj6+zQRjqSUXC69ZsPmNel2NyuSEriiJPoEnMxa2fZXGGMGVcLXWMeJHqaIsWpu3Qus2
+m0pDqgnoqwTurvZksLJKJw0E3CpPqGRZlFlBiB9EceyvB2xs7NBOJ/XD7zBFrmexLou8w/JGkWPVYw0CrdjDrB+zKYcjz1ie8R07
+UKQzLhQ3HIgopQKUco/RbGbYM1xtJmU0nQDBui7YOQRQ1XkWqRzjfCqQ7RPVKtZyg6nayc48GXGscUklWa2TG5ImqnEVO15r4E4m
+qfFvVqWp8maq+o+a4hMmW8rtTJyN6flKatOg65ZCV/G0KyfXM1zhq6vyRj89W6daP2Dcla5xBq6cjRE2FZNjtBbcLCysY6MGRk2V
+gAJW22a6QmbHFNn/kxepMU2rMspQZeAeLteziU1vFlaykmUszQ/WsdzuGNzE9QgR0eB0lMTs/IAo3z0iGixHSbs3H3aKKHXmBGxu
+DxjL7XS0yYofp7jcejn2KfjyLH4K/ulF4Vsv45m1gAvWg+GQJPLdxgwOGjhVaiecwxqJrqvdjdoGQxb0JI/6C0robXE4dvRrfrgZ
+XWR3Jh7vsM5xdRYEBu84njZ9GlJmOxPSqWhaLBFpNs/s67nEamt6bp/yY8FCxmPKTbSuIe51xTfQdnZcgGvEMLUfM8VqfgxU420g
+jS2JaDBgaNZ2etpWfQfqnkBF6n4vrFZVBEW3FOM1w5bQssoV2piYi1dJC8PrbCEcsTKhDdgnFtfRRC6lCGKFr1qMwmqSmvGAQPpU
+BETs8qmgjm2aq4x59knZMkOnamI/0Ml9Z1w+tG+uj8bPzR3YaA6ScboTrXJaD23cezUP2BIWU5i0ZeMETG+CICjPmjvImQguyQu5
+lS7t0Y7iWPpwrwD+JTY162KByG5Xbu/Ho/4gCqGOnOV39q7LNA8Accvc22Q89oJcop7L4E5kw9314/mw48c9h1M/hkUKYJ/6Ma46
+EIgo22btRLATPmdtofcAp53hJYbFC4p/iSdGAZnOiWWeMCegPS/OEjgD4Al0D55UZ6Ay7fRurSQhqsCWDPfEDMboAjLw4zkYPrhl
+KrWw06tlKSyp77HBxKloi8UwJP0o3O/7CcmzzKQ/6r2q36uNJcPKCznUbHkrOgssyiT7sr1aMaVKkGUxHwrGTzFvs5gJ5dQvyShL
+byTTZAjetDdv5CBb5vZnl4C9YbdOKHEAmCX6QoD8E5m2CJlmtvF0z3Xg5V5J54kVBg618vu3LOKA5daH7qx9YIOGUF4dyiEUyoIi
+dXlQliWt24Nd2WqV/JOEG6stbaYXNEJmbccMNmw7q6Aoq1ooqyaFXrssbnsD5nCahAr0R3s7mdmjD5Xrnlq7x9oXWeeUH15M/fnI
+ux6Jii1y5DZS7B7Iglq55NrJD6tF4fEeCIZs+fWRgu3K4teqBhO/P0ryskdpQSXbKmDmen0mLdRrxxakchyyRcUDzsIO45U+E72o
+U4m6lW+TaTFNvl0j2ksDGaWPIhvy9Umjfug2aSvw0KG6fIByFN0CBla75sd3e4HfmQ8Y+p6g3fx6vJ4IEYV46Qx1WI+2Afb73gbe
+RPf8TodhNPc6foQAi5kAIE7W+76Qfjt6rrrWLp+0eCcwHHZXJJ+3OhxmPQcwZ15nMQx2AO74Mdx7d1rQxY7xtgKUOwY82vQ7rFPx
+Kt/GAr8NqEFFRHBTH/G+et0MihxRIipeWPm2LujbFSnU57XKMugtbPlBoPvbq0AVZqIw2JE51CoLXRwQmV/ci5KgA4oB/QSv6StA
+rd9WN1f4rvrbtcqi6DG+5ceMVmKWahMYFYh45du6rd+ugW1yRVB799tX0FmqnP8W3XVc1eWmOkzHOYMa9NmIdkAa0nd3sYXAUqI4
+ZaxDc8db+aK/V3K+lfx4D7hPQTllYDMqGhCUvNAEp61vattgd1erU5avFZEg/45t73ZWUtQqaFt4cI0wwk3y7sJUMSxopqtZC72f
+UQMjIViWEcCcjo+RT879ZLpctqxI5mbgsvwUcqT5nkXdx6VcwN6VVDdSMgNL1ARyoaoso7CJtZqQgUqCWWgaaGWgFCLphzi2MtWe
+O2L3r9PJJSyrvlolp9DF1i4vvTBAmlCXA3jrwlmoLwkM+RgwEIrvnQ83fR6F/aKoyAexEmnUD4Gnp1ppaS4rx9OoVl4Fl02IAC8r
+9dHoZrdjGQ23usIKjZtc5SPYx3f2LPOgCR0Rubulh25g2/c8tDuccoa3wce9ZKMncLoV3rH7+rbab2JKXSi7+TbCxSwLLyUsYbLT
+gEMtY7pSb4Q5t8qpo+SKaGpRgaECVniDimqfw6GgvOBPuZkqG2a6Jho+NltvhdOkYkFap0KmufJ4QSvEniY2ccIxVTlZlRiYEHUj
+MZWx1XhqznkGygmfb60jyzB7yAaew9LXd4cgHBSOePHpKAlFYVWS/ZUWrg+T4KluStTW5MbCOunJcETVAKS0Uhy9ABYVH0ZHJ5H2
+bCXdRbqJvm20sOnX1kDE7Ycb+qO4VYKTugXgtHI8zpVOwn17rIoLoWA8zB1WpXf38TLZHmWyiWWyrMyTEW8r4nRKnZdQ0s2SELsJ
+GoQizdn4HNR7ODq41Hmr+9Elkc88u0P2ZQHcSEfsJk79tATE/itkh5XPIszcoexUeJ066QTIKhnesFgkvaHhDCcMZ7hq43hmHbDE
+xHjjDY8tlMRMpM3ugrKjFZa0fDgsRYMuo62Hm3JkDHTRilEvJSV5Ca7zUyldATIcvJzdFm6oqKTYd0WSLNWwZfLiY3aWUEHR+epo
+ZN+AkFruoCWvZUp8tZxemhsMqlX8QV9h58PY67JTUdsLTqocWnltSDi775m+xGEnSz8d2SOH3eRtb+am0WWwOh+POtpPC2n3PA5h
+4ocV1gI1LHR+o9F2tdo46OqnFI2DtgODJG53D84OhxgjWsKpj/6qekk7PaEraiE6n8LLFSRMWG9PRx2/64P0yhPpTdN4DCyYcgQj
+uFHRKzRwt1Moei27NahwfeKfA6s7XiC+wXYIhbeOPAoc0hY8QMxpJjyH9JnwMLjU87vCIWjaFBCjm3bIr4/Wkk+EQ1HM22puDIdS
+CTDzE9aOOGfxIMIZdD7W0w0HZ2KsTXGcUi+pLWFo0DjiBsbuwC23/EPlFnnejyt+F05ceNVR8WNQl4dcmDLvyipKkFuRbDm0mGUM
+ZO0fKpjXmcXlecc4ooWRqGxF/GIFG9MWcESLeCWMwpkNFjLut2WJUF67B08Avt2Wpy0K2X0bz/ffppVvB5HXgV98zf9tWvHCTuXb
+0ozXt3Xhd0ScR1txBZQ+KqejDuOhfx/Xsf8y8LjXr+xKGdKokt7ELSXdrr9dQTKtAPtDK6y2UauQduC3L5Ka+XFLeXEeVdreQCSc
+KWmJLw+cGonXmrlelLmokd9NcwHprf54vOvlR75afTEQ+G0WxixrXuVgrV6rDzirWMeTWET9yh1gAcquDCunF5b/oXLLgX8oe6YR
+1dpeeD5mJxZPD4fA91jE63SwD7QcHdaQ1E1o0fM1iUIyzSh3w4qRDHkcrq2AFBS2FP1apONvgvvCWszEnBDcX08Ek9spFNEkNuVl
+Jlq8zOgErANgNWSrx9QtIvoISAv0+wNZGN7nwMn6JPNgZCyCTYxr8ktKDtbqBF0KICeBnrRnD9lNo3vwCDEh52p1QoRRZLU6BfzE
+9dSNaPtoN7P6ZjyBOvygIxH4pdo5tisUSi7Hw2HqyzN03VSXWu+/NNKgfkmZmYIE7YTM7KTrt4yoKBcVmY9LI3yCCi8rtHl4QFxk
+Oxi+yHZuYPWSuwNo8KFLVtBhlydl4Pu5fdNLuFKOY7U1QzvuhBo94mDwjiBqX/zXJBJMIWDOKHBBsL4Cz3rc2+DeoKfCdzEP1nIV
+mu+nEUuCRykeBA86LxB9KljO7oUw8MM8CitDHK1/1NTK7XrG6sNvnumSz87lmq7dB7BQMBhFdwrtzYxMffrc2271nbYRob9DqlLE
+lzvoSLrkphmJajWEc0emj9uy8lUy48aqZzsFTMNBmtKP51u5vKQ8UlWstgYapuOlyU20WFLDdsZSwvfjNaK7OuQIioZh2AgMNI1z
+oLsqL4dRqCu+vlJfTtUp5O5ElLM46bM54fijEY3LBg/pUw+dqr66G0ZM1n0mNvBKkPiM3wiHyL1nYfnYdxDFrjCyOeWH7I7ACy+C
+o1yJjgYsxBca8jNcE9aUrmauFvjiJsijYgG+t0GCKjF+2I3MFB0WCz/EZdNEC18EzET48UkWtlknq1YXwsd7Hs/1DyBPKQUdE7fY
+7cYsX122yXKVjUK8piugtn35Fej3x5lmWbPcO1NCiR+ndjgI3S1YRdQ8rfJlOJrk5SmhBEqZmEFGLHvmkZHLPjllCffML/CuL7vA
+u57cgCL3yQiS7JkHUPk+eUCSvfOQZhv2yQUT7ZlPOqP2ySpNt3dfy+m0X0/LVCMas7zENxft7k0mxiTcpzgj5aQizRm9d7E4yfcj
+cUgzqSiM3KcQWHD2KQOSTCoC4vYpAReS/YYJ0kwcJIjcrxA/RjXefclBLrjIh00mCiPR9ZTrb/TEdRcMifcvGVJdR9FSt+86i0a7
+FfsWjamuo2j9SINfb/Gp5+d9q5Cm3KcaakfapwIq1aRSVfT+RW37Yv+Stn2xR0GwWWI58Zg/qBKvDrUkDPzwIpo+Whvz7JdtIi2r
+gFDMDKNMsTmF+GIGLtMcn8lAsZJko1zN1UvnG6/6GAdsYFSVsfLYjGICOl7Tm6u+rKObe/aoN8RWBuaqAaBj7nj6jboK5qqQfWIb
+TGYrA3PDA+DeWWdNyb6wJ/LCRYY2ZXjzvVDu1qU4hoUu0Cg0AGI2hdk5ojOrwgq0oAM6L3hUWdZWZpdVuMS8WoHq8j2rUbLGxriy
+fPvMztqrxpAgX+McQearDN488pYKDTOcnmJ2DRlIfDMabqPiYwy1mGXVyGtbqGcfNMTXo/DIop4dVfd6f/5Xv30fUwdB27rXVV1B
+uS2fW8MtFSgn54R0vjvmGAQUmVvwz4F/cKeFpptW+OqU6/rp59lbpr9tyymnEfWoeg+SuDPRLZaY4XCJO+PdEtK2m0wH8GBzun0L
+o11XTA9uSV3S40Nsbz22BvaxuFpNg90Zbh+LW5a/Ul91OfVXGuBP1bcdielKzAAuYfa3K7BSp/XV67MPkGls1NEcQGg1TCFU+c0l
+qFQ1M7WBCF7TZ8OPV+++XetGfD7nN5XZu8qfTfaor1qNtG/bEShuqNf341aBQecK9fgqAw99boioQqbDaVKr3OXFFT/E67oKFF4h
+05FxlY83g7pFNT8+Jw1UdcYMhYAYGGbRxNLDQul8moDkW1m8yizyVNLiVNZmBZSc3A83UIU9dv1aFLLFLvC+1opf8wALfaO/MESr
+q/s9Njd9W+JlX14TM7vpUo6x8hsMCwXfMcJdP/TjnAXUOTQaMyooaKpWn8OHqzk9jzgX7cd3JN0ugyVvOMxZXPqO+XbC3i28u7Z3
+F93F4TC0bmvYlIGIWEtbpJAWrhVc0KrPglSY2S/qjdhIXwgPh5CBNiG0mOLt9A1mz9/o3eMJxkFriPrFDFqNw07j8MEjh2TX5lK7
+fDhEIzkt7vi0JB7XgW4QRdwaj7XT0fCC414QGDKikLHOCe75YYqRF9tm0EguB9RAwFGWRzuIUcaMpPS91mHtqMOkbTtFLTmUO+Xp
+LHBpmQ/bEZbMihh4kiS6R4hMHuREV9DfZnXbEb/IOjo23gnbqVxuHUnnrNTHMr+JQsglz0jsSDrNCmHtdYOwEROw0M3lDU/6UHHO
+SAlMzB0TY5UaQXtdZzTgbKyP8fJvvu8LYSALJR6PklC4dbMTVEx8kjMmfcIoLiP/ggbW3WxyTFln5C7bxqzAkxjcappTIXvtA95e
+QLgtGSNN+lKpBCKDnG1W03U7hZurkssuOYFSb3KYJ3MV1qaTv9jMf7Kpv9mc8JGi2/QrFXbTmAnf4RxKv8KQq7C2TRcye1B27uWO
+cUuVbQpYwQraCKigR1TYN/u+sAiOOL58PWHlbWl0i6/2psCq01TDfHiFRkwjt2Bt7bS3I++lsVRIV0GF0Bi2olhw5vUJOB4ouD0L
+h8PMNCr4lTNXPGuslAW1jcJVt8zqgKTUSruXhBfhni9C/jvX0AgayuHHh7sH6mcN7hWenrD8kluy1gyHY66z4GAhXLW9QG62+ZCs
+k704odK52lSoL3N7ah9scuUxAeX8vktkqwjl4DZBGZnILeYOTw3aKGDajZuS49OYYyK/UAMTlEBdzXUZHO7rdQ4GQM5uWcHAFWUr
+TLMUC/rZSQgKn0ytrPhM646k64SZ3Z5IXsXIU2LQkt5yy4txxNjCV5qOitLlatptyKeE0mTMVIPGagw0NSfZIPXNQaKevSuyRZhT
+ka7RXtZZkrfVewDeu8k1wfKp0Ks+qvohZEHGGT79tJFVYtN8wjQzI7L1m4Yt64SFJmVOWEtofoWywppYWMjrtDALuA3cusVv5ku6
+hIuMsVJsyPOwsUGy/EamjUbN6LUyYCFlxtaW5bVTeHKbqxzlbohdBdy/LgIoecMKbSps6DToMgHTCMZb8fxbYJ81Gg5DRdEAFffo
+4XAqLNLOcLgNOdqUt05baxQyj+Cgs2Zp0GAv17L1MhwO17Ed1Bi3mRnKLd172Wcy4S7eB6cPZo1ZiXqcOd5Jj0gHEMQciG2Zlyhh
+QOpNbRGg0EZpWnhNb20hOsIHIVJqhahsQlHpXC21/e+5ooQXaHqaT29mbrvrNIHqhE3bX4lX3ZCGktkGawy4JIfKsTONwZqyX/OC
+QM7y2E2omsB1qjuL+pQQ6il+Mdfj09NUTOaKvJq6pi5jYmSkSoPpbUfswe1I55zSgFPY1CtmWMNlkLbdsKYXQjhtp8bRtIEoXJIK
+i7p2L04D2qYDo1vSVUeaghrpjRgpZVJz7VH5QAIjN85T0jISMlanrdzLE9nf0rYmS2k4VQco5I7PwTXniQHVnCz7i6kmNbI72Ukx
+RK+0xowKq1U9HTJORuR522wNS9EknYfG7JnXs8f4Gt3bZoeZ4bCclZT1bFlG5ZD4cuegOj1hXcT1GiTI11FHc2ovmeviluGPB4Zd
+1pzKNSStAdJDWRFZ/tR4NnA8feyRHszqqpdomMurdQKUq1ktCtsszU2yOvoQV6fMYMINypkrGifDBQKXB2Yc6pu8qdlOns2W/Nj7
+sOhz5SBkVDY7W2VIOaVZ+XSWZkRPoA/KI6Z0qY3r5yI97U6J2jpoQTJera6QzXqtAe98N+u1ozWyajg+hrfGfhRqm4r0Vtu+fabR
+4s6JZrt2T+4EI/V3llG21bCbyzU/7DHuCzQgPSuP9Wfd3dTNhQMJjx62R3QBUtx21Kbn0KbabXZNTn76HdevnfdDcQSX6OHQEIyM
+6En86ohZktWmC2h5KhWIbjC1kOzhNnu9yH2BeI01baEFZZQpg3OG3x8jO3hXVH4LZtSEphww3oedraX9YE24G0urDsZ7SIHBUYcE
+f9xtSCFhmgueSZnXqRFKTsyfrdfBhMPIeAw1AoP40C1nmnu4opO/IGjWL+FLPddp9fasA1bGv1xtWWfc60s54ZqxTcfT0rFnvZkd
+iDOGAWKUiaHR11yPmWf3YDSybedMqblYM510ANI27/n8ARu7Jssv8dnBVpk5h2+kxW7OvA5UCPiiXLZFwQstGosusJpwPKSRG6Pg
+d4obe7Mm5ahancoJCtEzl4eegEvGVeCRDuaItOsVtUR6tnPksZEX5VFmRukRM8SMEptyudq2Btq6tmNFw6G01oyvWdGhiMVzm5Kv
+XHTh1YDsBTgF5/oKFsbxm8r87JCL5/R0/sskLH5rrBb5HJo6DzykS3BmhrLsAMpSdj1jGAAuYd1ZGeuuaLVACDETJwpywIJx3XGH
+7MrnmojAHAY/7sXMskGCtUJ6bJtQJTSEnxn49eK27xNK1v3Q4zsAeDE7fAhStONZ+TMzKz9oHA6YhGYUyL0tYyOx2DQhdqHo22ca
+E+z+a0/Y6bm7AsYmco6BCgM5JhSl43NyrXz2SPcyqmSZxrJhZYWZmKphw22DXZaf0og0I1jYuf45WrbYMnDHwqhWV1Ss+3VMR/UW
+jRkGvpmyEy8bJv1+ZDSrQVeJSSXpW7aal0iX3KDb43rahfZo0ppsbnqpvHuiHkha0ZK+qVZLkJkMfZLaSCEPa89M8N2vnRs/Feee
+1FB+2JMwS5CEpUl0ghJ9XBZ2LJuiA0nTsPFBVE8/dPSgDXN7od9nHR9W8Ekmj4WbsXXABx0GvnUJxZJAD7VzagvBgGbU4NnC4VlI
+eSIZBGzblUJsUVvmXhiDcQXM6zZAnfXieLnHo2SjJ1m6Q/aN39v6qtExb2fa45sul2CE7m1iN5RB6bNE6w6L6CILY1fd3oBScT0F
+T3vbrs43dQAoLztA762euyxwCcmFT5lp2l67B28bZciPF8JT3joL0msDUI7IfQCI40pTWWccwCfnwz6aVeostaMBi936iJv8QBL3
+zqr6FPck2VJlGhxSO0SgHqvSiHbM2lNsojPWoJFdbPSIFivgjk2VgT71ylBWScumY3Vjdkk/Zt2eLw87dokJd5Lp4az7U/vDx1zl
+tDGLwoLrdjNDoRHSkrLuzJWV8WnHxgpq5TJz6qO/9ilNeooteJFgdmtFOslMOzD/Wn78yj694WdgNbbcUUxkZ7xb9mpGR7bwyl9k
+V/5gcK8Fq6wFBvhcjhgq9JHOtm0niyUrx33eTgKPrxITH4G2QDjBgrxhSCDbwjKD/oyurNpjb4N0OuVByhIIKI9SNi1JqCyTYFoF
+uym29ItYeO2LmB4hV2HgNkNVp2zvJSv6TAJ8h8WUsQPihVG404+SGKwVrBJ8uHEDOkfSMZEqF3mtzCtBs2nrB0XqKREmcDQ4c0S/
+3FFfygjgwxwNzsxm6ZEJy76WwSwDDMs8Ak/4YUOlVayeTqniZELF/cmEkjeUMDCPjp5t6bMiw1ZUk7nKWliO/0PztKNSExVcGUIY
+v5iqVi2YXymf57prw+HUGhxXxrVI9mEjh8OiaVC1Qam8ff26WGhT6Ln4bKBk92IkPmjvpqoAbo8K95BpfVyOq1IOCIJTXizcoJhI
+93WWZSfLsk+Fe1Clzj/iqmSMnruJNgqs9KsN5TsWRKtnWHblD+HlSHiBicA3MDsgs47Um/Wc+wAvt766jdnbWnWH3X77ra57uDUL
+0CHXbRxqHQTwoOserLcOOTONMf8DmXRe3aw0YND5sVAvcHWlX+Whbc105Pzb3XrL8sG2iMWyFvkzDbTxPzPDj4WtumOlH9qTPpid
+8AE1i5jFp4u+W3dy3x7Eb+t2iQsEv2s1Zo9MuVbj6GwVvDSkOgDMHADyf//+c1LjbMA8dL6Hlxg6Hnw5idQD0liejdI8G4U8pxvF
+XGeNXGerVTPL2dIsZ4tZzhbcNOT8PabkNJOjNhq6Seays1lk/cNW6OTSH0tF8C08Vg92rBxxUkHrNPeFnafezE1kbs7SItWDFHti
+AboOtjGXdHkzaQ3tvIaIYbXGaDGquuXqm/Z0WlOttWM305RyZoapSpSaJ1aY715DD66kKXWKV6Rj5VBu1HyQDSQKxUxWSR0dbSfz
+zp4rviWmczSyFyXYjihoZyBpW2nbhP3Ps65bz25Pc/WW0gXtO0xrU6aWK+aEFaaLCSbit7u33jp79HC1yo+5tx4+2DhqlzXBnS0u
+h4fyBAVKoWwlreXsaiG6kYsG1y6pzHymYWdWikoLbxQLn92z8MYqLe+W7I66YedUQq5raLWLtHSoTLerE4YzpYfpCdMvq109c39Q
+KWqT6KJyZHBQZy7dDJtN1qIooZ8xpF15cCYsduZB2sAM9u5Sx/qrxtumpfXLRiQ014n+XzfbJvW1LrZOD+4x6zZz957lC2VRMcJ0
+kjahsnjptFO4wVlzTXbNuMHJntBbzEXuUPqdNgWEuxl/OYEnHmNGNQc7zrLeILOMQRBi7vVivokiFz/cOIEKVdyNaGScS8fVJJXT
+s3Tr0PZRm8pUT8kcTF0lSCs9Oc4R2d40j9DNfUoL/J7UHQKfP3oxOpaNpSYqYDZRW8QpBodDEClEBTHnIIeB5G47h0qZ3EI3TNju
+7b02sj05jK+IH2jeTMkZozCBRxjt5ShQXS9Q1JwryktUqJJKiwwrfC4bFd6ERNmTD2B1V/iqm+OcjVs21/T2arqFhQNUCCpVa+gL
+F/ywminB3MPuqPDShHruSirtz89yw2uddliu9fyOhdoBX+oMX0qiwEm8YRxC37vONEZNAZaCD6HvauswaqeH1qFb7dq9c6dP4bFP
+DsE5FkfBJuOImuTvNbtvzRpr9q9ydAcZmr7u1Ee+8uc6SgVjd3gx0wXn7pt1kUwPIlep1ti21xZrA0/04lQKmUaiFsV2GosLbEh9
+GqUHIZcIb8PZ8foBeIiis/X6rBML0BAtjWGXCA3LYvregJhEUIPoNbj88Nu+WNM1isHqwlgyqF8xidfpjH9e/rpFPu6hCUpDpL6N
+vBxcgcCqFjpOeONkkQlVJTbcRuY/ndQqDm6sQDCvnzns+wmalFwBt5x1Grua52x6x+KmNz1tR264At4zpdxPmeK2fPc6M1+JVlv+
+SrTqwD+wvCfzWWFUrNqGtmduLDosbrOwU97b9m5KPLK3pUIWRf8HtC8tMtX8eI31B0KtfiWDKx+S+TDGA2g+Q4cgalqX0q0Wz3f3
+SiM55ZmGDQ4NI1SuS91bRseSZjQ9bffcLrgHa7s9SBS7PfCfKFdEsK21VsjZCmmbysNXtWrpW4Lbw9ZA9WWbxqu2g9p7k1q70l5d
+ibW3EmhoZ2JSJITA7WSEECAh9N0OEELb7cta96HW7RuvRI4TLFs9ZIa+vccSIpMM7FGebrxJZGPvXjdV7F+7aGDtXTdIkK9Y6bia
+VJ2qoKPuOa4hftdK3HCFwRkpdhPodM9NoNPHxMOxpOWa8DamXDdWbUidtair1BgNZZvKHmlzoTALrXZ50ri+67oRUNtUQyq/qyw8
+bXrfjezyq3npQHrKisxyRG2p7QWe9IdYrXqQu7JJXajq2ANOr1r1sDhV0fSh5qjg6Bu71uxSnj6zzC8QdJNu0B26Bm2WZrmyylWr
+4C9a7vQ7LkEv3i215PUMuh5f6FYIWbVbPWdl1VHpO3unh2PZqt3qgP+4HXcHNuu2J/T62t/7Y7mdtPoOuGVvo1fiHT1f28e6zbbk
+RTbdnZX2Kl1zN4F6NtxNoB5ew9s9ayOl9bVm7IL/8REqAkPbB5qJKZ0jkkB2RyuD1WpVKgnjqpvg2Afu5LmTWx09yeEFlIFQU1cm
+AOuXftdKY6G0fAJsveZdikPY8h2FAsWTUBrsbkUKedobDPxwA3GhNlNO2UjP6HGe52/DZ40xWYXyy7kOq4TfAcuKhB74ltVydlg8
+vBf+5peGgidsuIz/zp2fH0bhcDEcLp4ZhtHwTDQ8szjsekHMhifl/7lTS/PDqNsdLsLfyZP2Px2gZOfe8IxY7p6MFslN164bRJ5Q
+1VuZmV5twW995ugq/FtbvcW+UFMQRLD5VUiECabt1lBHTpdFYnZmXlbLgbxvVVh7Os1bpr1Qs1qOH3aHC/B35qQ9REzohcMz3pnh
+mbkzNrZ7ZrremD146NbDtx05Wrv5pvthruH19ZV6Y211WtalvlKfuS0NWS2nPlxpGN2iUm1D2Jvpzs2czD6Nct+uNPbogbH23Hxz
++oxvMNWgY8cw42M3nxtMYZXZ/4FbyvAM/jt/6tQQ8l4h/wehJCSUnAEbkas3XZDw+ywWXn+gx0ITjPFvphQc7pO0ZcKQ87JYHa5c
+2J6tXxCr07YRaRRqgFbLkQS6eouN36tPYRT/bZiSepq/8andsu0WDsFXMbK4EavucTFX9+Yzg5DKa2pYHV64BTOcqt4CGrrmG0ma
+5+lLvN9n8aG9m3P+u4f0wJf+M4UsKqS+lB74q26I7vpSo/6GlGAP6QHPSQ/CvPRAjEsP5H3HMqiaTDg2Z+pTaGtprQ9vuFOFoo4M
+i3R3S3cm6UDN32SFzPOSlfTJE34j1RDk9zjKWiPJKDosFM3Toq0QnxqYxlc6LjnW0RW5ndBQ7WRYJ11RZTkUrVPtWdl0Mw1vZjOd
+WD9VfgUbuU8l58PO37OKLOxMqKDUf9u/D7UO3X4ERfMKAeF+VZTPgvfsQ1nFv08PqupN7j+0EatZwL8XKa5DJSqxqsWenYn1Vdzp
+37e6fVmJ/fr27zPusoqTq3YyiLb+zqO+Mrlmf9/x3d2/y/4ug7q6b4f9Xao1Kq/WN9jOf31dWuV1QU9m//W1cfZcFgT/O3TQzGQa
++jvViJbXaC7wvfi6d3TJtLExpk0Utvd9t3MPip2wZs6F7V7E/w51wnInVGrZ2/ivr5HwNibxOihfuh7Wm/pltRoEnh9eD/etU+wE
+zPX35YKwVmNV3uOgdV2e7aVuRGYprsWcXa0xwHJqruYD1HRmpM8YXK07TuGqPjymVOLB2GCaGDz7pyK1rh8Ixq2y545sZCu7bBV4
+fcX9vmWPyl/tCEqyuhP9hhIdpYra8SgIvEHMXFEDtwMoJwxYW7iithAOEvhdZttijjPPFbU70D+rK2rnoi0XP8b/yoK5K+WHTanm
+HlqzDZt6LrciGy7SrKOH4WaNW7HyDguSWm4F4Bo2tGZt2nW5NbBpzw2tgzbtuNzqSc/dh8FvN7f64GM7tG616Y7LrQ2brrmhVbfp
+usutNZtuo8E9uuVya9umF9H/7K120zIruI8U06rTrn5vZsv1TdjUqtPNAtYCcScS39racGjVadtIYNvlC6ReIa063TGSgwTUqtOO
+iVrZvch2HCLd0BFaePWae7QIDkFjKlxW6yZBELc5YyENVZBy16rTJMua0RWSpSMUA2Q15wC4sq7TF/22xNJ/JAHvGnKQSbzlbWww
+PtPWfUymrbBFZjBfh2R2/PbP1KpTL6vp7ohyutsOvDgGHz2Ob/FaGqLwaMEejVZReJxlnfrrA4dm2vmqu5u12NlK04KgmEJMEZeV
+mUUon0HY6nl3tx+t+wFz4JUQPL4SDpmRAKEdFl8U0cAhMwoiNPD4BnPITK9DRpIeg//ZlNjzO0w5J2MD9aQqBlpkNdlzlNVkf1FW
+U91EWQ37iY4TLGRHKDEzI5TInAgluudJ2uEEcyKrtqTranUq3J+2B14oHetq2l5ZzazCgBRtXptNmYdbW79rxemtEDZdRreNzlDp
+2sca9q6yzUnCKGRkOrCbMGP8MGEjHYNnOYiiGtOOghky3Z4OlP/SgeurAVWuZVeMObGqr8wi+6+cdLjbOrsdPx4E3o4jWrLSyhBW
+Nj0GNzIHYRCLM80c0WKcmmIZVt6E6gk3htfzbixCzr4x9B6znFpyg/ufMkf3IwZwxzVOCMaczi3DGd5Yj8kWB8vtnNzQurzfSruU
+sRz/bxkL6QH/rx4Olc1+o9Fcus7hoEsaA2xlLi0hcrCOu5bBK5a815zYZtAt9TjziLQzqhjPG8nBhy/054qBnUAxeHtSSi7aodiN
+E41heYSGtt2ckxiuvf8qvjhV7VMeylvq12G1fhIIfxCwFuhNAF/B4VQkmKu4dX9E+d9404YnYVusgzKUGHdvXSnKdfQ8KAthCm20
+F2spm1ET0deXWqVYy3aK+OvYomAYiUntpbSua+mEqqF+5pUfP9ChEeWtiaXJB+vp6QgUosnMDLGl63RR63sDq9T//P45wlgIVTel
+qQyEyvZdKpvHzdmZGx9jhqKRa5W9gQ13sm7I0NCErMcKW25hiG+AL6bHC8tDWsRUYyzbqbpcMObKzbA0c4OWky7od0OSaLkbpsnQ
+4GJKr8C7Sd0aNTW12345JjEcS9Wc9Fy/FU0+aMNLY6RD1hnZBSIw0mDvj2wnLQgRFD6WjpktRVfeyKYc3Ot79gjPAqdyTjf+h29u
+3l+9r4GNhq+WxzjhjvsrZ7V2zw86nIXN6+KdNMfcY/5GD/wOJyIidD3iHcYdyTzTvsc3/NCp04HXwRfLoLJHKvCQCEQ4o+aJXK1V
++Ualw6jDZJUXXUNu8/9I2jkTibnQ73uCdSaTkUEBfrw4YCHrTFy/T1DDkrgkGtWDtjNx7MIobnN/INSxbzSiN7Vvqobglqkriltm
+kYhEy+Ju2OJyT5lUrYvp2NJdnZ0Tjug+Tedg7UFpGha62NqbB1w0CS8tsbj4lxOk4QOC6o4ofDqii4XNIS1jqpF9BI+uvnLR7I0K
+RBW/JkWXkcstH7YKlFXGLizaNJGyysDlVgICTJRVDlxutUGAibLKnsutLggwUVbZd7nVAQEmyio3XG5tggAztGZvBQkmt3ZAgomi
+y22XW+sgwZw4p+VrIpSqN606DcZ5WC2xiMblz56SP0fQinozORY1k+lp21tJTPlzsprZPEFmuFdcKJhbXCricXZYrReMrsBHqWzC
+s9H+lHT2zgJYl0sY/RnQsmapnRPyjwdwBH1IFR8gdovVOBsEXptZB75Vu+UfL5jxFw4cAC/KzngmbU3z8YG43WN9r5DXhCSYm1aN
+xbqfY90ljC07pHC1AMUD1pZHkYiju4jOibSSyAD6bkjz/cupb6yo/fEVdfBXc/kbTKRTn4YaEXb9jRjXrFytKRxVZD9Qz4WOUlM9
+VsZcaOLKJaBLA1dYJPP1ERCYHcIiSHMaMwDMWe73feFvMo3tutrGDO25frXqQ6Us8k//xFmX2M2puFrtVatW7GrjmSntWD0wc+fL
+eD+NT8fHim05pzsuh7V5bumgZacFZPY1ia3tgCRuarogaSXO1BS8b8nqhH5W7eGwq16wqnprKx0bE9boAAYvMvge4/issshzQtmo
+OCGVI+D4FLrciWlWb6dDsfedZGTb0sAIHgf2rVB7jwrJHG62PppGHC+tknoTIR/eqgcNMuCHgm2kIeUUnzgF2yeTGjEoNqKkzorU
+HUFvsgkjvXdu1s4mnBn75pa5b6oM19KqIvPHOqe9gblH5uq0nTuWjSWTdZ2cKDdVjXSSoMyU2MjtAvurKGc7v8+nbS/g2fbAgxVs
+IHpGVCp7LUOPDHdaW3/Ta1eDcZfucqn0LpIurdZuT/QDcK4tdgYRemRnHIJoaycGCE4WfndHg8t4jnPI2jr4tyYjW7FVlrApdyOw
+6WI4Mq9WeSvY+4hgTDC42e5EWyGhHTi78iiJg50lJhbCkPG7lk+fcnbX1rDCfDSSEoiCNaOyPYLRTfvmGB5PMTySxWmKWuyFvvDv
+Y9yNlJsOZF4MpqeRY3oajdmjOb6n0Tgyq1mfpp+bJzg+zqBAjAa1mmTjY/GbqfBj2duInbTN+jeuGdGa01ghvQahpAdmTP3+BqjM
+q1RzQnB/PREsdvK8y+6ITs47+4ru+v0N53pS1vz+RlYf6cF4FcytgcTzJMocnFJ5QsrdVC8lkWge2KBfI1+DpWjvWaQ90B1V7udm
+G7PQ/WiSrsQinbTPQmoHkEeqfScmYKtTi1l8MOKeWoVbseqU1wYeqFiADYeTfsDQnJsl7FU31J3QSiEnHI3+tsoWxVf0DP1q5Iyx
+VXDnzvRLWmagFvghc+p4RZh6BWDwaNB13XV8/srBpMIxLcmUaZp8ejo15SdjVji8RYzkO9BopaEebGGc67poJkpVKLTi1EAfvHG3
+x5Nmo39hpXYLMrPaPyLa0VwIhYXp5Lu1AxdWrNot9oXVAzaYjoL8wChKnKt0tYocTbWa2PIO06rTjYzyVWJogFocksysgj1S72Tl
+lzqtcVCwArNNKoE9GpX27La+J9WdB22R96TVahsSZX3VzvWVzjedJvJ95/pwmDfO6ANTXxjn6YYzhhuV2aFj5XaL4R1HJe5FSdCp
+rLOKV1HfyZd+BW59gu1jePNn5hFWkNWqRF2VW0z0JURmycNaQyMEdm4HKFI+Un2edH233vSLpOuPk65/3aQranHP7wrLpqEV2yOj
+xG1Nn2NDChRnDqmZR5KOpCaSFnd2cYycXZybYyNG21GQ9MN8hMSNKAs76XdagazwVYpW34z+G5NAridmGroRMw2zpTk8HCkLhJM3
+7TdONuUrcLHb0a5NrktbIosoJK5WcyhZvWOuUFC1amQlUbenkc7kbFsiTV2Sv/lhsaK6BmPljtPXMZE2IZfL7RI/klMHt4wpNhyi
+PdaVdbqdmUPHKZG+JPabY/MSPo+K8zLS89JLp1AEE9KDeZm4npqX3IrzWQM/liKk0ohefeUEy03S/YsPjOJl/kEx/8jYGKCQIHvN
++5VNJn0mnnLdzEKSaJEklIxthzgFYYhtD///7P1rdyTJdhiG/pVC8LAm41SgugqvRmd1dLEbjZ7BOd2NJoCe4SEahBJVUUBOZ2XW
+ZEYBjUGVl96XFCVfSbZp2de23hQpSpZkibb8ILnWOf541733N5w/IP+Eu/aOiMyIzKwC0DNzOCY5axqVGRnv2LFjx37OzNUyb1ot
+Wu0HBQAL9miSIZPG3aeqS42rUF40oNpGEA8bqprGRBHaoYDdCzFD1A6GDaiDcBRR+wZJnCWRjmPmEWyzgWwnCBXQeHp4hDGCStne
+pMlZJMaNIBWB/y4mDDg1USg98i4mVJ+Raryrm0w9tDaNiijkUR7k5zqqsXV2pwtizNZEVZFVpqJ2jKyuRUAsSaSPIBh1kfk4LdqD
+YJj5tcwmRXUG6QlXxymmEMvkY3Rbz4V8GcbiNa7+iyR9E8iLpxjJTrbNIh/ADaz8LZAXkKSzqGRLtxWuQ+vr7oVoY8vciHr1DfOQ
+1bfKE1ZpkgfY0EgR8V33bvXIYSqvrW04bOVHwFVGecxYjJPwa0G9i+JminAmgOO8yFXQh0XuhXoLJiYDtviyCc2A47xsNTIvvO2m
+8BGrb+6QFcHSzSj2b54eHoFSRcHv9W9+NB1PjhLolp+LKOfzeX6rWnsIfmHL3s5K1xWau0vOA9HnHkeUS51vEpNeQEjtQj/cDFEA
+fyoFiQX0chNkFnXXyqUXKy+h3yP5y4YjfllzxC/rzsbbcjgRm85W6Vg75XY5aVYrJ53cJidN7icnvahy9aOPkLEjMtecyXGdVK+A
+jsv7+jL/voD0t7rpUfXoTTQ9D+PMvwmm8sK/ScVwOhBp5udryAIslvkZy3LWajRHXqt/A0qOT3WGydzgBpQXQmSN5CN2nYbw7ta2
+K2J8uO1A+drDRwbOvy+7FLHN+pZj4tF96Jh4bKvOP1ImHjBGCyyVrzFXHyRkk/bhZ/tfnD59e/TZ6Zv9N2/fsBpfZ3hGX0dJMCy8
+I2dCeiS7SK4KKVsGlNSc1rQB1e8f7P367vLalflEhMGSf3RIvZiiRAwlQQBBSRp+DcIjxAMRONOhnuVSAcMFHoqv6nhvOcu6rO4O
+wWLw2s1SDr7AAQ9Ci3uxd0yUlIEwJYdSzB4STMIfi2vCOU9mM3Ih5QSfachDnJWYpZqBA7nPgiwcqAz6xlFUrz1fADylcTCGFlhW
+830SZNlVkg6xB6oV+B4zneOE3Zgq/IBdiAD1YJ5ByxB2AXRLzmQSUC9oEZ+0Mlgktx490BMlb/XMO8WAPnqxrRVg4S3rfLoPj2u3
+LnfchlpRmwzjo7CkpLyZqnQO4O/ARqr7hRNldy1RI8D5pCcsqe/py/1P998e3dq/GuBrw5Xk1VQGCPIOhMW1PF/RHopISHT8D7w9
+dwvZXU/r+7qz//rF3qdvD3Zxm95rgw6UVEtvzPB7gMwUZaqlbRzEZk/zGeCyHdgvlpbBiyTVAgs84TnWYqGeZ9cg68ncQkdJXjcH
+TlhyFVtF7CsGnCmbaw4R1H3onBBbHQvH1p07815NE4htlHzKiA6pN2B15dvndRiVmmO2bky31V69W9qSO0fzAEAoE4NpGsprq33P
+wbU3oE2ptuHLMJMW8o3vjHyzeuQbGuRrY/aEJxqjhiqyq+KIwJykcwNKNUBQqzhc7k1cnoOe4QFcBWnskS+eHrzee/2p36htw40X
+isyJqzCKgHmRinFyKYbKWEk0YvFBNsbBl0naMCFgc55jXD/nLKydZK3meodJzmdQ3GVd4rp1CXmsXIjGsC761IK9YTQ2eiSBzboG
+Z1tga2s0m0k7C78W6JsvMOdJMhEZoWwK0rHF3Una2rAx8yCoqDflU4M9gbkKOzPQeFMJ/1SIMMKmlDKJMCy9kAXmhEOAkfg2n7Ol
+GKU6kWXmC8QRybVV8o/H3ZO+/eLXLV11w3sCcV2hsVS/x/MNqCukS3SnZTtLxqKWyYOrIKy5D9NMesjpoTgxFt69N85aSJrhFnUQ
+/J13pjM3OaGgaimmVU1LO8zgx5O0v7IitQHE4nlCLrJ7kRSLdM1XVhTcCzBqNzznlS68KZhQ2gJz91S79wyaY5rOvwPGjPggBlMp
+7OMu1RoI4UfcoFLaK+qsp0XKiydLiLa87tIIyRTLiwVctsdCXiSg+QZWBCJFaotNOTQt0yBjEb8pIMJ3waPZdN81SDBri/lpPept
+Nhd8MHWokagMViVuyTz73IxVeF6HhZbuAwzUT5gaph+wfJB4FcZqQoF34Sm9XRPh3ldKxJSfBaVIhqaz/Yswk0l6jfhTGVSg0jP+
+Ib8EMSb8qzAeJlftKBlgt4HxcAGxEv+C+1HhflS5GcmcRcF1MpVucuYyObbXXbbcds6Y+/axBBCeZRTRffixKEKxRrc7quOPqKaN
+F+CLAnX27iUMSQthSMY7veyx7GWtFk3BCXhxJGcnvZKIQxsKAllP20MhJmAVFMbnWoJO0DctSMOm1EiXIg4Ok9mAQ7waNuHubo5Y
+F/ihk+OOijSZb+ZsVQbgnZqPZjMrFVOU6syA5qzKxOxJ6pEHRjJXLgOduag0jw480I/7mF8cr530KtW18F6pDqXnesjAe6fekLaW
+fh9TOne7kg9Kdeey0p01qr0936cf54jn8iD6rkQO1Fvm34vb63QyDKTxmzwsXyK7D91LZHfbtWV4BLfIlW6vUk/9zlBIQqMHlt62
+V0pwXjDEeyYaZAXepQ3vSkOtDrM3myuR6lbI677nakM9sgKgER53TlBHPMy/UEYeLPqk9SJDI2V9gOrsLpEWAFhN+AD24YgPALom
+zeao78WIW7zjMoSyyQlb6VBW852AP3b11b37kF+yKkFgnbZFNggmJWCd0BZZ9n1E2U0yGmVC+qubc0r9CQTiuKWjS7oig/Pl3XGb
+m0cYEfR+O8ZwBkZxb8kxZjj2N6BSCyynOpXKPMl1navgS9q++VEgP5thXFk5zZ7wjU7uaj3Qm+RlEgyV30I5zTwyCsII7hosbMfi
+CiJrX8W7aWoFnze1gXl8i2CsUtpbUFs2HQxEBndTk+FwIgaexBBMReLbNAIkpPd5W6Sp2ZUJXOMdZkqgU0yOjMe23QdQsSMhBxcs
+4hlc0rmYzZL2NI08yhb0MlKvcIP2bqZp5AsGSdBVUKEGNXKRyb1YinQgJjJJ/ahdTXTjCeWcK5aKbJLEmbCyYvlK6qIKBqkYiliG
+QZT5JAvGYjVJw/MwJpobnfk3TwdQhU8AQ4UKczz4Mkti9sMHPyQQwF1eiNhLgQk6ZzVz4Fcv6MeKHjVzwwxksHxRTZKaenLSs66A
+VmBn2mzW6YlglNsWAV5PnMhGEgtQHCMtFy9JSpkONg6Lfqr6fqp7daogkTDNoIVTZ85ySdjN4iJ+3WW5EvAi5/z2Ncs3sieNsPw7
+hZYtKduNk9G3juXizlq32NoCTd/wl1xq3Y5QFbpjjuf4xwuq1zc+Vu5nicLSXA1/q6MEadv0Y6KV85svM9yBpQsuvv0oS+LKPdBl
+7k9LvHwgNvO7iUyDOBsl6RgvMLf4ptNkk1yk9nRkKhNpA5N8AvJ2gAhxW92LWCNmmcMYmX76Fe61BGqmJYuRQjChjvhVstjAH7St
+ZfJ2MjEBDFsipxVyR3yEfpM7W5q7zXu0+fBjNTiUEHatu65iWPWyhbr/gt6Q9oOLJHmv9f6xbq1R/mX2gwcUAyqrQa4VvCaghJ6s
+dmczHQDrJlbm+oIWZpRQI2itWynKipLlMORjjPz8dU7pRxoLVJbMEA24+DqCPLH6b3DnqBE0AGV5GVVXG/lktVvw+0rF1TzIVm1x
+EyLQQBIjZaGaqYgtqLjDJG2FXkwL5fbF8KpwtedOFkvzHDHnPM3Dlzab5vlJty9aJEkbpCX99DhudU+sj2t90ZItwhpEf1PvDeKL
+lpwzYilDfhyg54vN03viNtlWaC2f1G/YfMHQWAMHkTb0lHbYnMLF6Z7oeAlwLoCK0CvDBSOGJG0T3Btlu5eKqRAQmwfifPfDBOYB
+CDPyi1ynP3OsM7GAdybS1FIcKrPtF+gPOVy0R51vpiq0VlIVetQ1qkLfIwW+9S2H77C+aZkX1s01lJP5amrNIJuoZdP2690vTo8+
+O9j/4vXp7sFBMdY84GTK41xXIiwJEccsNVQxan3HZG5BjyJ4PYK0SEZYnXwMCc1JIfhSASAhyeiAhLBVF9clLWVDxzcK7v7D60yK
+sZaB3Tr602dPj3Y+Wz4HZngpTxdgIqf35fkSlfmiFsPpfnOljSCd9tLverYO3+zu3BVS7G4VjtqUApWSIzO8TRHKvkVoaWdJKp9d
+e0suLUjNzn9BM/WLhCqczf/bwRQqJt4bpip2xildCGUgKPx2oey7m5Gdl7tPD25RAIMj30zQqHBmgN3zXD9ThgzSvaTl0WmRZ0wt
++hrjsHk3qoifzlFDUM5zgQoelxFeq7Ydj+Pbjsfxte1Ny+e4Mq7rsEhcishX/SFMU2c+eRu/j5OrWN1cyfzbF35FQSZVfGsJVuX4
+mJWlYdvKyntrITkxZ4FTHqUiZZZKsoRjYqAFbb/NKqCwtuhgbaVBfaVQyrvvTe/Ph0jWSF/vrJLukpTr31D7/FGJpNy4I0n5UfRh
+ygoK8Rv12dYmT6s0Y8qC9ts3z58e7Z6+fPqTqnqtextTK2AzKOd0cYUv9l4e7R4srVBxsW6tEPTdl6PQtrwAfy2plYKKnQ6TQemH
+QzLJvW3FlKX1jepRvNp/vnvvtsfJUFSbhtTMadpLZzNCaIug0mj6HekIHE7H4yC95rJ9dRGgZyhU4j2EmeCyPZimqYi1s43ivYxL
+H30zzYKtXLGAZQtQ8ZR7RetL6D0FhejI2O374jIa0LCMGXl5qvObFXJ84yQdB1H4tUB9BRAZsGfK+ZI6guuAyZWpg5Ah1odBPvF1
+kFRRWlyrVVpcs5UW18AFcu/WLhddLQFfXVfnJXjBPVE+t7Kac2tl6glGxDCUSfrdKMTV3Ydtj9WWzgmLF2udxE+6/Xi16yM90O0l
+j2M07E6Pk9WurX8Cpt0exJgO4MjjmjNPj8WJMV6DzWoYoYPcOdzNUJxNz/0OC+NR4ndZlJz7awyUk/11ppj4G/P6XZQeixMwl5+j
+cDJKzl8CYcUCHnqJddZCXVy2z8J4qPS7CKQgMSw0NWR9wyT8CB1yv0EKUXN7Nj13v2ESoewmTRK5F8NyofTp3JffF52OBfr1Jd2O
+Eo9lmYEAGCwD2bvIUGBBg8sk6TZayRPTAlbDKqQmGlLDJ91+qCA14N1e8DjsBa0WTY4DF1KDExyrFleby8hC2VYh0xpoZ3/Uy8AX
+jBszI7YjZfjS/ZqAPNCrVZid1BO1pd7U6WrOa8/YepX6j7VbSB27BVBdsVTgHd343JnhKEquMkI/uslANTlVTQ5ce6gbqN0PmNF7
+RQH72zTyp66StkmH3QqWVVYW804oU/r65kOuvY+cBGekc9qLeazuwcrXwKtg4lX8BKZssERxPT+fxJxS5FAos7oVnD1jcAdvs5l3
+t+ZCXVE8pyVw+D75ue04GKTrmqBuOpbW23BvrnWdHUTRWTB4nxXuRY2/UaC0yL5RIsrDchFQNkKfKnkIquz2MD2MvE4aeWPEjKnk
+qt/GUtlyD31gdx3P2cJcF2u64Ziyb9xKpYLQmGOix+58FxVq2LLgVy9qIq06xBTsJpn4AYNmQwaeHQgxSt4hQ6XvWDnGO0qv9+T+
+VIInZkrN/707jQqnJaTz3oXt689eez9a6McyX0PLK2AoRQqBfhb4Bbz4M+2qAHde7tLjljgm2ZI4Jvf1XVCOY3JaimOiXRvj6aLd
+/4bGP3CkLLIwxINtBK3DmGhbYJXZn2q/BpET1uSOrhFMK3d1eYw9NTY8ygTDdC8Gywx9iZC59fLHeoLXM1GD9kRbpKnrVhl7BXdH
+guFuiAIfcpBcEaXGQXaSiCgwwsNyV5O7QNORV8aFqIIsUvguQWTKJlyao/JCjAU6WTbekfUSAfQVzMFlBkq2XdXekFDQmZ3TnmNN
+PlFzMuSj/kjlzo3IlQdTM+Xj2xHJwiwXG7flGCRDofOEoMRpmV5TRn76jxoeHOSsgbbolCysaMCUQcwxqSHoCAtP5pSyYbO5uKtb
+5pR6almjLWwvWD6uiBnHqdK4rlahC0Id5OljKyZRcCYi09W3xmifUDbs32WSIYDFEP5Sf1H2bHkPklzKKD5IUvgfJuaJKPfFBTwt
+Cu9Dv61peKPdG9xnGn6I/32zeagbezCVCWCSSEjhk1hcrebOF/S8FO9qHov3JfN0YZvuLoxttKivU6bkLb5AukLOkVZQhciZCFKR
+Ik7of082O/bom+720Z+13a6iMcHR8AuEcXuv/2Lh0/8mwCjGt+U4y6lgRhqfHR29acBxKWKpdcv9xjTOppNJkkoxbCRpYxxm6PHP
+nNB0qTunaycUWGFoel5y++56mD9fSHTb9EilEjujptjOb3Mvf77Yo3ex0G5/lADr9JuFAcuzoIxChd9iQKoiAKH3HCS1Ai7s8HOz
+2c28l/SD4+SEh37Aw9pgXTWuK2JP14OW6pYE6vr7dK1/5Jp9rXdK95KO671wveNGsVnvuD4H1x5t5+7cixHf7OQ3t0Ja+ZmUE0CC
+xXZjB8ru41kyvPbz6wo7FOmlSK1Ln51vF9ntfn4jKMyRwcaouEzOv3sP466dQ+FDtE+IL43KL3rhXMgSiJ90+jr2e6trxW1vScBN
+lpPOj1vxRNshWI64Nhw/XGuO4sO6o/iw5YRa33RCrXecUOtdJ9T62qYVa/1253qDWud6w9suqNP7Ode7rN4gR984ZA6Eg8BLHeKR
+tHy7g21m38cyc/XKQXYP/VCUUw/ECK930tgppMFYSJFmYLxQk2i0XyJeRxWY69X1nQLVqWB0zxIpk7FPuu1NMQY1lo8pfGtZczBe
+34HGiCllUf96Mfmg6aJIh8Wgi2udpKbSguPY+EuEZYz8JbKktylht9RcZCFv8vVpgBZ3h5XtabADa+id+GZ+l2YLMuDcPv7fL+Sr
+nS084gFu/atbAtLg2V3UcajjeRZI/v2fI1YbcrVPlRz/jL/QIyt0h9iHP01uXMFS0zyco+QwgJuh06k0J4i8tJ3hd3RVxMxbDdPe
+8PViLttpcQgz5KaLYRgA4KHo1gjcBypqO2FiNgsLd30GSRWSuFOU3GHDKiQXaJxhoZswHkTTofgiDaXYj6NrXOs5KwZQGleZPBOg
+VVIiAjGwWtq+COJhJPbr6nFGbI0u5mj9+SBsS4G+e1jI475wArq2ZRqOPeq7qT1rykNsXibn55HYy4CKeZZ8qCp/NazR1FgchKak
+vyLa+ctczY5mqFp5uqwIWnx/dmruAvl5OHyVTGFRK+eiCt5cArsCQAuGaV7ZF2EUHYiBCC9xh2eVSoWutbQOGLMvf2s2a1v2rCyU
+rTiBQneVJyBAQNaLqUYDkDV5nXlN75+Hw7eoMXtLt63Ngh233hd1vW7QH8twzge4QNT2TEeBR307YmKzE6WzRC7C84sIAtbuwAGM
+4tw8RjfLuA4CwqY8KGDwblxcO9jVWTK8XkU6hszZtNmUC5kN4YJyp6eKXaDmJCuxDdytPqd+tphPkyxsQXzA1ckbmVP2MUNc1QGm
+yZzJ/kdVIIahJPOFjad2uWmfnMm4MQjigYgaNcNRtfmYCx4X54FJjcLBezWnJfw1Z9M+2cFWiE8gkVBNf+XUyrAcHe+DTbFY+2Ip
+JyHfER/DTrgr6yPfNf65G+PODai3pJ9z9qEc7zzveMWfAGH26NEzfy7dndNiMGc21fXhe0R1dVwtI1dTeNMhu7bLOkZGKcCmKmrl
+Y5Y7irDiDDRQemhSxBLP6szBfVMuCiZQWToGF15UD4x29RZXJCGpMBsIEImy2ay7XSlfAWxofTcUEHwTV41Bez+nr3sBKIYMK94N
+cUbHfIgVBDlBN+4nt3MmL5rNRbkKdvEFwNPCYJnuvSG2g0ymJdgPnXiPXWvLZIYhOHYdMzONTvxF7Y+YgwVkAfhTa9sHrNRJp2W3
+j4qrC7Mz700WYZvoPgE5s7sE5MzuGJAzW4LnLGC28uVxOfMhO/ipFgUu7I2NTSZ/xu9w24pd1XXYVWubFr/qrhG1s8URtYOqPuNU
+6zMG0O9OL3oc9KJWi06PI1uLMSpH1J7cHlE7uXNE7amKqJ3Eip+68OaEXz335qJvW+rb50EagsoNkqu3XZy0f2ZZUxRvjVp1XX1m
+Cc/bPRcyj4/pkWEgg9VLXRpJ0NLVipgeFA5mQGfDu8mwZj9EAU8CZBtE68X7kemV03sVvlIF5hZS7VIxVBmpHY57sigc97d4nSoT
+8201Gi3zsFdLnxxaoyKNCC3uCve6a6nVK5rUC4jNKo+oixfzvPwJ3WUUvXcWG+9BTgq98bwYA6AvdgWsxsYrZefKBvDSOl3REhDL
+GMDJyIJcVYZ86JUaYDGdzdIcnspfYaJjhK6cLMAFhxYJUd6K73F3Y0uXwG26duZZolJ3RyMxkOGlMJ9hrQJenuaKCo+Z5gUTi9e+
+u8wtm3LQj83Qsfa3odqjJdMYp/lFkvpET8ySu5DWBbUvUrrQqgq1O2dEC5mWyOKJMqoj7KYsjrYR6pzFiyXSt8qjiboW5geivRgI
+YnbCnAlnuwNPTR0zgOmn345OhZ6YhrXM97jwAuaZSjFchS7OGdnR7423By99cieFjcRLl+nrEIknwi3DkXCf1ZmyxeK3xeXTQiF3
+caZhofZ7eyaNJkQ8HRPavx3oSkegHxeknVh2NM/dlj4eIjN9FPoC1Po8QIgKJJnhsjKxXH0iRP1FV7VDlVXVAaa846hYZTrmqDZP
+Db9hqY6Ew3LQqMCSbUTKNXtBhNvo1r9ewnCoUAxW7soloPYcXVKgguOX563D/ItLfMc8BddD1Z9VS20rSGAuTWbgJnun+JBLstki
+V9vFzTCwDLkHc6ZikblZpnOWBNl64d30snDReFY0lee/zu3BURmgs021cR1qA6w92oITOzT6AOudh3DFCo1GwNqjR45OwHq342gF
+dB924KIVGr2A9c4WXLVCc9Va7+R3rf8bRSeDUd0ajywyptKHuy93d452n58e7h58vntwj6hHypjWwR/kpD4yWd7awe6vvt09PDp9
+tv/8J6efP335dvcOoasuNcUeY9SCVypgQVKKMBYqyy074nels5qB8jyQAWEBiMzhoP1cx/ZK79Tznf3XR7uvj06PfvLmT7nvOmWn
+4LncYRCHb/ZfH+5+s1GgxFTZ2tzSz5AljBj3tvfpqALG08+fHuw9ffZy986gkpk7RgxcSuzoZRDVgW3lKMsIS1moA5l9XASv7ya0
+cFlFUHsnv4fZaJKbjaYVs9FYsxo/yp5XTWN+dOM85pc/M7tZJcXkq4GMQlJfm/jM7Fes00Y9ZSPibSUNXF+nvUrWxcYgtThN34lZ
+TS9Sb4FDgqI2Z0NIFjtIJ/fWy2rH/VHV1+EFt53qrH9kQzUb226pbtXv0FL95pQsXlJ5Vqr47tWeKEZA6DICFoN3uR3Ls+r9m2Ep
+l0VstxqFcJ7WecIkNwScmM6J8ogpVKS278CFQ5gdXgXn5yJdK3g6ZR8jYJDv7rgFXn71BOgJZui4Kb2rjyW8FCq5o1ULDHx6/3pS
+HQ2iUldUwxqeesKixgfJNJYefdxFJxOZJyi65SpmZ5lvgWqKLI6Ku7nACHm3Fz6OeyG6wAhdxwLhifa4/fEnRFA5IdL8hPBqJzpi
+t2BTxarKN4DZYCX4Wh6yLOc2fsTATBNURTf5Jgd7rXOIwk3e/foWLDmW8zWteHIovnzcme1sadUBa29LZe2Jh/YkTeBqBo+DJM6m
+KvUsyATG95ftiyST9fEs4WOQfaa+DxfHAlVO3hB3BBp3ZItxR5Ljjmm9f7YF8cQt8VttsewuKCex0MTk/vU4KMeua1SDciZLUM6g
+QDn2xKZe/Yws35wFC4AYbcd8pybOTjXLWUs7jTxBIYez4VnnxByjVfj4Nrqrqz1UAGuRHthf6GzELHiNmAXHEbPgG14M2Ees2Awf
+18kSwktKCA833HeD7AqEIlS0ye8cvQbfCnr9jkTyj7aWOAFRF4OcSQL3cZi6nPX1RRpMXgQw8mta64h/Pw1ZXI5vyY7JfhpCfHG8
+jeKGwtuooxgUqMA2+dRChywrfhbxuJ3EYG2k2ccDHisrjQkPPWJMkXLriDw4emq7jOlni1VmgBM+MCovqdKWH7jGc4WLGzH0p65C
+SVjwvcFkYVFDErlB3yfv3d1uyX7MdRC53t1w7ce6rnOY9e66o66x3l2rsx8zelqW+Ris154U42KuWGEEU2jzsM9V6OJDGYwnlgkZ
+anpZpmdJHIUxXC7CYSCT9FkwPBeFVdn3w9mXmYXymb9M767b2XaVZTYfbmrrrnW1BGsP0YTPqr5ucyrFMZTnmxua0mZ7E6SZSFmq
+Xz+T4+gA5dvINUrbStjtAaMrzYQn0dUj9BRNrrIAzq+vRUoh0I05oJvNsNkMFmq7FYtr9NkCZgkdVYtiaMZDtA3Q3KZgXAc0upqy
+hpUtFEGNj8UI7eJ77KzJDeS35m5IZztuOZtx09Gd6jj2L11g6KfeNbDzFSyhrR/w/6/uYOUX1Vr5Xdxm+JLdz8pvXNXEmXxjKz9L
+ATUufLmkXDmXjFbPkg8E3KutdDjnsVEGMQHXlfsWy81J2Gx6Oj58Odv53fUZVNNF+VXwhwhmqHNGnuepPuy887tK79PC/nTOgoXl
+PrTBpWdU9ShlzduNpRYp2VAri9qqo0XmtpU8m3XmlqXbpS3EvXI2sDp4T28zPj8t7XD3FD69i1rn6R3VOpf1xR55kS+ejs9EWkI6
+Z0uQztV343W5jphL70LMlS6J3yfK9eEvGCNuA0JMvTNAh0rF9D1PvSvKdjW6POQLuKuW9pB2tJTbELSVTjJcf5C1Kemc7SzxBluL
+aHu5U8n7olvLzrCwMozrrPCYZKkJuhqrbd3Dm58VXLI9wFJoGUu9MK/mx+LaKDYpFy3mLUQHr+gfumh0R98/ASAnJVVORbtY7cua
+9k0FyFemnnT7gW0pK5ej4KyGkWJbXnPORT+2rMeKb5+HWQgaGyud3ItdntSdU58U/gkrtVQKdFhNxVCLoguhy9r2r9pWXVEo8V0c
+mlUlxiQ+SsFtNZoQoSpjEit7IZUSovTRdNBEJShcDqLNvNQvuzHIA4YqKmlszN2LS+HACTqMns5ca5ERtB9k6xog2IV+L3IMubTF
+zGMnpv8ljyzDe+WP7RrSTG9zq7pTSLVU+Qkgh8gjuVsM5YvtCtIscxOV+p5nzWbAdvlEMyPYDh8bFliRN9+Qdz7fSTI5i5LB+9VM
+KM3pOfvosqsqWuq9qpDB2Z2LGauz6lEjiu3pukGYs1JboRRjiIFaGC+2K5sBnLaiaIrQJX262HBHYibDaJOe38Up6Rurr6jgiEta
+4AHa/3bmxKrxLlNSQhm/wAkpdkOuxceChZNwzW6EQgDK0rPAIn7KHDwDuoG6umVL37875BZlVwe5b9o5Cw1juX+vTRAJp5bzWzRd
+6zuytCDsMj3L58tUXG/JcVFW7o0agyQ6VXu44UxKdIpn95yR1+oM/5ZqtY3t8GpTvNJldxtbAfjQC2vCGi3Em5fsZhSX+XWRfSMY
+qEPVl8qJmEO7OOqs6pGVaRfrW4mcKV0pJqw4jHzbPvX9vKT+Tf37o3JrblevVPvLoGpieVeeOLgMPAcvxij32BympLXNGh/X2w8s
+p4UwFuOZkiFUkS5qMBPCdj3abO40m9Y4ag6L7+tZuxQfF6t1irsUDoCdgqBAd5K02bRe5syQJWgYvmw7244DF2U6NVe0ixoVHc3U
+uSjxE4aU2saQmb9Tsq7NjWiLLedcjNqZkAeV1nIXIc7OmrsnpWV1r5tbRYHAnN6HnfKR++zKNYfdsXf97UPNFauWDNQyMb3fgszp
+It7Mjs2bsdjy7xfq2FsXs6Wck8LN+bJcfzpcHesucWu+u9U4Wj7M0k3IP3XMgEve2ksfXRLJHX6Jnqp+NBdk61MAR48NXKVPjq3B
+TskrgtuZGn9KbodqMpSnYqVbdlbfcTp+fFLis+0uYXDtfGd8ts43iiZV4dIl9xe55nzoO5Do4UKxpKBscQXjIIoKr3Bw0J6nyTQe
+7iQRGPD90vajs1FnA3zDhUudtNnI9FKJ9YAIhCETSn8xEZdyW49R7Ifzeb6Q3Yfd8lJ+j6xcvPQvQilWQykWJjcGa0/YR4VVXO9u
+f7MQhQ9L7g26j1yW9FrHMKW/z2Yz65uO2cyjh9UwqugxDyyHNrslw6G1201sxrkxw5vdndqIisb5au4DIDdm6OtYi7DmdqRFdA63
+sKG3By+XRm4Es9aiMh3AcGFtPzrcf720ulw1zsR10arN1MvboEtbONg93H/5+e7zJa1o1XOtO3fyTVp78/Tg6as72JCgiYuydUmD
+8Wsdz0K97cWovIPgBUDUDrNfG1v2JSoesdtpRqBGN4yf5TY2pezY5oycaB62caZ0fEJZ3WEIFvF78VB8WGIWr271YADbbOokEEtw
+zpMiBE7QCONMBvEAIDC392u/CCPQugx43ZQHlLJ8fSTL+irGyOmHMbjS0vFGWEDn9Svy+dOXe8WaHN5xUTRbO8RpR9Zywm37hLvO
+N+pzPPgwjoxTxCS/nCE341T1n9Jf6LrmbV2F8uLVVOK1IXMWtzAq6jAVwxD5dz35OO7JVsvoIeOCXSrdICOwUqsvKQtYSHvW0plg
+yCesEnB8vmj5dqLdpwen91pEawG/0azGv/hZvX2ybuaULpysw92j3O7uDoCeiqzO0I4FRo0q4KmKGtl3jQh1aICVjlIdwExKadDE
+91ZJ+g3teacZ+EjUH4qEOVMpxsCH+ikL2oqPk/H8CX3Nq0CGDq7GMhla/9Uj7KDYWgW+eRYlZ81m2gZL+XqMpHJ4Gc8WtMaUlf4J
+U7VQyrIlS4L2nHdaka/uZfq4bOQpXQwkr94ePQVD3O+gZ2MAfDE8+PgO6jN0/83uwdOjvf3Xd7bIzE9TE7dAWWYG/F4Yu1fSskYr
+FT26BaUCyo6TMnoI6SISCkP+32ej5gMrT/pQREKKMniCbSld2vJdV/1uDZuVXtwuwNzhzme7r+4yWqUevwzawmYzKRbFBOhSkJZS
+P5zNkr5CYX41FznVXVPK/MY++F5muPCZpTnKv/YEzYmDKnzZSgK1Z8rQPlOWRREbFnounqA5oZXTXnGZ9krnuevEUlQGFn9X3VcX
+xRrTxRT6hzxjUOWQRUf70nhMMoQd9e0kQh0841lFW6RNWq5gCW70Tq/wzMxHnxSUbSWwdrc2sHbXDqzdxcDa4cgbYuDZdpjBjyco
+LbqYjMU9Vk8vFYTYyTsZfN86qfT8y93M7F18HzCi1RViqdEJtWVmyjWoGoaxbqiSz/0F6X7gpQyCu0MeMp5GMpwEqXwwStLxKpzb
+ROdI0jFaG9O+43z2w+rV1dUq5p6mkYgHyVAMidYmKkxTrIFUpQR+yGqsl30zVm0YZHpsz+7UuaPec5eaqSAnNXNdtBEtXPq+8NXU
+vwomH8NrMreCZ2KUpEJLZrhsD4JYvygEbGsO5SmFRZ/Dq36BAcxdEkOlpe6Lmu+67FmRObOyZhj56PxcDPNQF5nds+yLUF4cBec6
+33MhgzDSL9WMBxgW/UKkoRRDx9BR2zFalo1Dx5QRrtqF8dpCi0eTutB0MrFHgdCCpcaXAqZE84i5bIsPUqRxED1PBpBDh4EvbDUn
+YqB/DjQJxWVunqUfD9FGwLxIaGCaRly2oyCTGBvTtssYaAbY5CO4ggNghZ0LmWsI8ZRZenHKAp6Hdlq2p2Ik7MU8qUuHzcgDZa9n
+NqeuJ2PWPBr9Az7FUYy0RemFYtwNFRtvzI/JuZBAZU7xb5LBj6KWCCPGtzkjSvEE9vHggpywy8WWqMPcEvWce/acIk1dNhi8XGIX
+mhclylgSFunelSgHe5QVq33vKqAgKTxdWCD0UVWpslhhnEh0xp6kRdUIqPeuOGc4Di372dJGuHeljmGuVTFoR+q9VlP4HH2rnnIv
+35e1DV9XI62B6ivTm/q2MkV7kZJwaNpklCjVD3bGvRK6+Lgq7SqInlaDj2prPGWLEIWiEFVZFdXxA/dyNFdb2VlNZQ8u+95xZ/XR
+yQ/pu3b16UHYFh/EAI7fLAoHwuuqTiusevdJEIa6gaMbe3vlIuraqj6wkuRsRcxmAp2GPu5SiwQBColqD+mSO0n2nI2SdDcYXPS9
+/NGm1OO8iZX8u27kZt6rLZLSm9XuCufjdggs4v2Rl6L0iktlu+MSHzpcug6fnlpaC4KFQz9tkVXS0i4LYZapb49kDkq/1jl358nH
+Sg6FNNzJgjxCCNy1z9GPr9RUoSo95J51UN8bTkxJwuwZWGzm/tENWJVozF4iQmpFJuc2OWrRMOB/RsXh0moEH99Bu1bqnoXUtra/
+d8WmpB4u0mT3rgRKmZNQk3f3XwJVsOagWUZP1jZzxd6zXbbQ4dEC56aGMW4F1SNlhKPRjns70EihdwemtzvkYuPNZkXreerybUbb
+Y5GeCzDvoayy6ewK89S7VYiuBMXcspAsQG2OuHrH0Pr3XmQoVd3ET92LRN0e83Y8YeDelDTMoaFt8XSLx+qcPSTnLqCx5/VXnNoh
+HrKdRcLThRyfmBdzLXNsYYGampwT22gqd0HSL1bTuAx3ZrF+yvHMAfjw45p+xVXIj+9TKXryZbJ+xHU8KjX3pdVXr66ndYCJfe7V
+3EKXeXmyWKiWQU/KY4+ijTlM72GSSowgkFprrRLNrD9H4iZJ5bPr+jHJeS3jtia2QNgP/QusS6SZ1T44jDJr3FeVKNvzOh/ziltY
+U3vST6zay8M5TsC8OO1LzOGl1Je98oa+KTad/xQhoqBBdESU+ZzZjIGPIfMNK75M57+yuQ8fUbFhtbv1sqMaNse9a69IbkqNvAHo
+tFkrCw6bfYsykCy2qAGLSbOg7KuFZatMnwVVHC2sosJKql6YOnPL/9AdML3RkLAogt4ihRuIe6RZuJ1mkzwgK5zL486Je95rFlh5
+cGpHOF7vYX+PgX/gPfgN7zhY/foE/nRWH7XerbZPfkj9B5QlyqtbO8zw1wtpPwTusG1nb7kT0iIS7f+w+qEiO6GzWaIu8r0azl79
+Ch0rHzIMf4CJae4Nb3Q++mS1O1/IQazDQ9+uBIOlfKVTHEjVO09p3bWknvZwifX5Bc4L+EpXuUv8tpVkpT68Dl2WgS1Rs9TGe1UX
+TAtdwDHtjuQoAb6JzhU7nuCYaR1yfCc90HwSqHtRD4SCBo0OPqIHxdkWt6PkXFcE8IHkpQ1/yG3MlrSRq6wvaUXAyaYZOIBP53+u
+ggJ3O4+2LC8AxwSDvq9OglisgocOcsI+cBKJkSTsipMUYmoS9p6TswTM4Hb/7EefwptuEIVfFxEgyyGoYMreBLHgQgWdep4G5y/C
+OMwuxJBX7MxTyzOLvEhFdpFEQ9Q6igKwKNAbA4+RvGodujThYXuSZHj3ZgEP28MUqdJDDFVjv/FAq+Ukj7lkUx485rIXaw8C4P7E
+O2NZ/8qf9j/471VwqfBr8SJNxvBxgacHzvmHvue2oyzqOpMPhGKcjav6DN1O55cJ9Z1Ps5n81mJT3c8jzuAijIapiK1pL0zoUx63
+ry4CqWYJz3Mc18IoIXGizKN0nHMfiAfYuDhd9yjVPckD1VprAXLQTZi822IR5eYr7GYYZmhUsgPmB2AcmIqRclhTA84MoQwNFGQ4
+CCLCDDERfi18bJtN0nAcpNcQhmiQxEPCxmGMnzsM+uoHzAV7E2jITlPmLQcCC0AwrQ/NJvxcsRTT0kNlbjGKxAefdBqdRjCVCWEG
+4GGRowAtu8HwgiXLQ6bs2hZd+Ubzz0vOa5iBBf/cNf9hJbhYGt3V2brL46vuliyJiq51O52iN665z+7dT6W62B7J/JtYJaT5gdR9
+uF42G/g+2YzcqitkbF82Hq19rBlTT4C7pySVWa3XuYG66vcKm5ubkZCDC78wFBoH7wX4bwSl9VS8gK8gtUgyic+UnU3DyFyorHJ2
+MtN0lvVZpzBNpFlfdArLRKq2/oHIrM92Mksme0Pr24WIJupGvzecz+e/YHOlm+wCccbuV9Mg+rG4zvy0XU6aWyZNj34R9lTuLaOI
+k2OC/x7KJBUm8K/yo4pHtZ2ZZTzUfgbZlIcIEq/Arna4Y8zGWaTM48ZinIRfC+oF7TNgJ+JxmrKYyZw5d5MiGxqGlvk3Jav/mqp9
+t+JpqeIIqmaqd35W+hgwSe0pX1/b+n5bkbHkLoDxi3GHaS8NMpxgirmsW/6yx8wN15HtZuky4MaqXus614EN5zqw5lwH1p3rwJbj
+EWzT8QjWcRyCbUCHDnnq7QILHi8S68A8j71HmxuUPeep9xQYp8t9Dsf0pubkKrl0Ako+RcLvtJTqpSXq/sLRGL7V2+IHKzuLsYlz
+O+k22lIP5/1CI1OXsL2ZM+FR28sgPqKCywepDdPTuXdlkTFz9urP9RzutN+kyWUIiPImk0mKUfvuMeELJ3vBbB8t4PDlqXHhJ869
+itzMWQrrm7vGtnHYOJigb7SjBKm+2axKeihTT8As1ItR7Tnk+7jkKljUDvQ9FgNcF8v3bNx/5cUsoX4yZ29KvYe+GvQaAnrVDJKE
+S+D71zHym83Ei4/DEwaSARzPfM72uFePpcrNIVleG+GiAFGc1Vr47OXzel8oLRz9Qe353ADnMmGS3YAi+QKYlQth9u5RiCW9MU0V
+AEfvGj7X67Dn7ng1xCZ9l3OSUB+MmySPvZAROPWLW+H7JZbu9aCeH0DVRTRQopJSRp5OJiRv8dCiLKECb1HbibralnT4l8NHvAyF
+xfUoLF4MHPH9UFhcBYf4rihMeM7an1AWl6acsoNaOhKEkbeto/bOol0STILhMIzPfdIVY8IG2i1BEARwNSb/1z/8B/+uQRaiSRLm
+DsySaTRsxIlsqME1QPKLRgB9AoNp5FuA+JIR1siEaMgL0RgkcZZEog0aNezLmkFVl3vFEwVCbDatF7weBwNpzRToOe95gvoCvTAW
+WVU/C2qv/MW+SMj0Osd+YnFkHQEEbKcXPhYmok4pnk7Oj7YAKaXzAcp4CnA2c4JiB6h10fwfMG2oJ5SdnkQAUPLNea9EJtZtznDk
+GTHWikHcKZUXaXLVAN1wYHqgQqlHXgsxbAQNlZs1ZNLA62gjKJa23fgiyBrn4aUAQ2DS0qx/znnaJ9NYEbhD4rvc05RSqiMNxXAt
+1pMQ9MO+whNgctw/8gQLmPQo9eHxS7D78+Gv7wmPAoO/DX6fPQsSQWnCgjuWUi0pvJcdUH6qYujejGlDJt+1gQKKDC80brRXu+g0
+L/pxNP/R/qefvsRoiy/2Pj3k0pjRmQSLzi8cFNyfMZEUwifUvVbuFHmoucCVZolmVJyqMgR9kpb6mudRlZHqAhS8kLwzsbcJzJWi
+pzARdy65/tElH95ecjHHrLT+iMJgs3HRvgqi9yL1lLgCEfzZdDQSKTcu46IgA7k1J+9i0pM8bcfiA9gOU0BccTIUbeXu5yLMjuMT
+5Z/tOD7x1Ecm2yKWAnZncYctWimZR1lfWly47QvbSoneQHdAkG1lUW23o1B68NW1F6I3+Ufh2roUIDhPq/g2ZHZiMpU8c1KiUPLE
+SRmkPHASRDbgU1asa1pdOsNuWFun7WEyQNxsceJStBrW6RrNLoOcldjbeEibzZXY29yocwf+cIXX7/XY6253qDqNKSMBQU/xNdTA
+w/mctoM5XTyWhxsqaN4a1BdKkQYSTABy3YB8ghawHHVDuc2ZQL/8WJZzLmazBE4yzgWdL+7Eo0c2R9NRSqi7pBD8QjhPvaXVrj+s
+Z5RCLhaqo1n3P+xLL/ViCkKT+Lh7Qn3pxeZ0ze8roq2y99Qplw86aTZTL9FMIQyKNb8jD7i7roMWrq0pJlF3+6Fmrmwp7zCbm4q7
+8nBDuYfZ6nYUf6XbWdMRSTbWdEiS0hpe8BWwSn4vrrNmkwBGIGGsEzwKjJgqxMD+my+etTG7ZOfsmt5EXswkG6vT95SdsQ+lABag
+FXrRbAq48O0Y3tvOsTjpZVehplkGQSYI9Ib4+HipglT6C8MVAl0RG1/q8/ld84FeeIs09szUsF1u2uL8kh3ylS7b4RYxyJ7ynePR
+yWy2c0x+5VfyKT2ZzS6bzZ3jyxP2nD+dza6AZbXPL/u7/SuPiFimocgI9Z9rI0D2iuewKvs7bZ1jNnvqPwXm46tm0/vAJ94rBTrQ
+a0EpXeG8zH9sNj8gSm82vYH3gb1HF9fpbJaBCQCdzQL4ZUNK2W6z+bTZNMNb4fwpEnbNpnfIVzrsec2aPy1urqiR0myuXM9msHor
+h82mmojA22Ej9pyy6bE84c/Z9Pj9CR+ySxqOvFOuqI3M3+0/96880zgFx66Zf64ScZ0p03Pg78/ZNXJdzwBCTin+7MxmibfDztjp
+8dkJ7YkoE43QC9tvWmH7xQ+9i9nskDLJTvNT6nTJ9i9vh5CvdHuw59VmPn54cpyeeLSX6F1tzwzEIZkzhYlA+8dLbAMatf3X5hb5
+Pa/fMmofyGZzJaTG7EZHEih3BgNvY4cCXOoaacQwiYUfc6QrIG/NWgZzJrzE7pj+EN8ZJXU0Supub2qctL6mcNJDjZO622uAlDSQ
+BlkWnscWzliZzmaVM03df4AZBn9ifng9PksijDxLgrPBUIzOL8Iv30fjOJl8lWaSmCUGzjp/yLQegkcIrVW6ksfihIs5ZQ9X+BRp
+anocn8xmupOI8zBdUqojtBC6AjbwfXfBrDConoBhVu5sEe+yAQ/bIzbhSXvUmz6JerkAYcQueOZZ+iGt1gmg2kEfvHYZvY6Bd0Gp
+r/14DU3Fl7zTGz+57NGJ2pEXbMSHx5dQQ7PpxcejE35xPCrkDPHcny6Gf1w2XMhOVy3kw021jt1HXfdwWV9HTR49V+dCWrIO4yw6
+SXuyjZz8h7Qf+RUoFxyoQyZ54ElET1NqHbAR5rPAMhx5ykDcnA2ht5K2R0ayIikTx/Jk6fG+sW4AtZtPLFFTCdaTBneC7l17VD84
+8KpVywjFC1y4rPnNTdX6wy1zcm9Tj+ztnr452D/ax0hEZSxu7ZGiN2/M1/1RbUcEh+sAg/4ktC+OkxO/hmWKQt9MptOBTFJ17Oae
+a5xvfeet6JvvFFHd6wc64u7tk6BRxVZnjXorXQ1mpRnpLcKRuN1YpgY65R0W8eMTvOugOC6j8QoPgMbKUOIWKZX7WF2Herni7LRH
+MQuXx1O9Z/6zxItYTGezvEy+eZYNa83snA01rM2NWzrPvbCt5gycEdFjkWOeYwGY/WbeCwBBSS+GpUzbh620/eKHiY0hY2U7SFQ5
+Al7Lyor19TR4sc1uMFbJpS88Oi+zhm4wBMolXNSX08yKHn1koHp98dDDkYc8ptCTVGm35oCFJL+eaalPvKQ9KuLJep1ClxM1b9Et
+7TjMxB1690jH+MYjPpuIAdJdt8BXgPcFu5M95/bCOQ9mM/PsxSjZBNc9femHcBtY0C/0+IQk+7rCqludRwatrimivbutifY1DJM0
+gKEORJaxER+gD5rxWAzDQAp2wQftQSSCtEga8kH7lXKWBY54YxGxMR+0n4cZGojDqcHO4VC9rur5teRFmAGpeV6SYMM9RXNJz4Eo
+V1boDXhmEqCHnTowZkTeyqHVvDdqNi9mM88NGlx4MDs+YTHv9iruSeIe1ZafxQkZw17Nw7Act1qXDmGTeXXori9yd8Z46oD2IFDj
+l3N24fTJGticET3vhKtbpzehfTf40QRpr6Nw8N4LvGsmWJfSuT9uNsftOLkqZcY0O9+w74UY0XGotSRTucbUb7edxNrlGT9lKQ+8
+BNVt9MqyBGrwB+1gONy9FLEEeyARi7TZrBm+VbDZXBm0wzE0cYjndNb33F4O7HY80SKEkR9iVJNKYx7RXSTslK1Ah1JOkjgVwfAa
+JYNKdZOEcKATrTpoE1D0ZgoMahEPd0CHzLNy0Xa1Inudp+1UjJNLoQriZYQZqKPzueOrPRPyKByLZCrz2WcdZfaX8+Uy4Igw3Ev+
+xTJc/2gpgltJ0c5OtE8lcLI0g9tibu/FwCcOJIQ2aKRKQJeyBmnBldMEA1ixvBQtwerEQk7M3bAszCxKgU108l62G0/HIgVNSyaT
+l8kgiMShYrTLRD/gfWx/RAwJzcgSjtB65xtwt3K20kNDepo7BJACaJBClmqv9QryC87vnMXiSS4QF6+sSJ8ciPPdDxBpGCgGeteb
+zfqmYXY91PSwDve+taHvNZ1tE/71kea2bDzSiLvTUdyWLSB5RmZEf8Ft+Qtuy59fbovDze4uxgZbm1tq461vdmj1EgR9vfsd7BdB
+BW9p+v+hRhTdR4/+lKng+lFbIkaRHwP+itdtCoz7MgrPpyZtDdKu0lDq9w14V1JCufRGgOyg9Jicng6SVKx+mZ1mF0EqhqensEW9
+BV/4zZwulxmEeEvy4Acy32E5uuv2afKnTfdjhx6pnm0+vGWwVRPmhAUs4+qE9kJ0ewde4GM4eAy9bDo6fdyZzaZPeNQXfWJczPle
+wjEcZwq+cp9KULxMHm9urj3ams2SJ5tb691Hs9m01eWcR+hB283d6lL6eHNrfa0zmwVPNh+ub6z3RV/lwdr8xId35ddmyqatNeoH
+q1ii5SWr2NLjx90ObW1tbq5v0fmtN6TuuuYgbm0YztNmV520XeX3XsHbX1yRvh9XJCDf/uKO9Bd3JI3nXgXyoj0OPrBEP4Zxb/mx
+BKaGAHzicacfeqIlWYf6iaf0Xe5ItW91qbfSoT1AFuvU03caon5dFy4wI+1TabCq0HrOpyHvzFl555s4u6eSxfopD+sTP+GGrdjX
+qjSayEQZzEpn7nswNOi2aaPFhS5itG+Ezt2d048K6+0qOwn67dhPPdza+tiwK9Zq5zXXO/+wgOxcgD5VmkkFtY41pLE0s72QHXdO
+ZjPkOzMoGgV3L2kWYLXrVBEnypWjZ9EeWAmgipPZLGwLfaGds5SDExAVLQn9syhruNfJUGQ+Ma0SiF0pYgnJGE8UtcQmqbgMk2l2
+GJ5FqBgJCYQBtsyT4IUw6BE6kvQJepoFPesb3Qm/y6T4IP11NoBv/gYbJGP8sD3v2eKslC5xP5DCkVUPLzETixRGUC9I5pOX2ZlE
+ORcXTMzrQbsaF+kJR3qh2RSPOdIas5l40u12N7pd46mO/Kc/+SPSwzts2Gx6ggNpaHzWkUIg+ATIjU3IscqR8mCypbc8Cmt3NJHj
+iSdPnnQ7zW5nbX2GrVMmuKJ4IA0I4EUl6TzfLt1N2luoF+Sogj0N0ByO/OyvEBaY539M2NNBmA588rO/SligH/8JYTrDT/8DYU93
+o/DcJz/7m4QFQj3+LmFPz9PgEqr4y4QF5vkfERaMJz5pEvb01Rv1myJk/exvEBbox39O2FMZRgCcP/trhAXm+Z8S9nQ6jnzys79O
+WKCe/hlhZ+nlWZD65Ke/S9jOQAxDSP9/EDYwz/+CMP340/8Ij7H0yU//CSj9Tq598tPfJ2xn/81P1NNgmqYi9slP/xkY8Z775Kf/
+lrBhCPYTPvnZ/0LYrpma3yJMmOffJ2xXz83fIkzox39J2K4Z+W8SJszz7xG2e/SZT372dwkT8sInP/u3hO2q8fw2YUI9/QFhozQY
+dNd88tM/0s8bPvnp/6Ge1+H5jwk7lz55QtinR/izZ3r0dwgLzfO/JmxPd+k/JyzUj/8jYaH4MIBZ+ceE7ZnO/W3CQvP8rwgLlQ0l
++emfELanOvb/JCxUT/+GsCj4apr45Kd/QFgkffKYsJdH+DMOBrAm/wYMnQcpZPlDeBwOE6jtfyEsPssmPvnpPwJ8Akn/irDXZqX/
+HmGxef53hO2bkfwXhCXm+d8Ttq+H8l8SlujH/0DYvun+3ycsMc//E2FJOhz55Kf/Ep/GPvnp/0bYfhYFGazAPyAsMc//kbB90/p/
+RVhinv+QsH018N8hLFFP/zMi08AnP4WnaJqNAXj+HdhcT+OhT376Twn7appI/xPyCfvVt/tH+JDqWfvfwa4cgOx/JOxg91P1kIkB
+zMe/ICy7APj814Rl00nXJz/9X/EJAOJ/wqd1n/z03xOWfa123T8k7Oiz/YPXPvnZ/0CYvEjS2Cc/+2PCZAgRh8nP/mvC3prp+28J
+m5rn/42wt3r+/l+ETfXj/07YWzN9/w1hU/P8vxKGY//p7xH2Vs3Cf0fYVD39H4T9xFT73xN2bZ7/iLBr3Ff/nLBrlfVPyJ2oKHX/
+UhcvvHctcOOFCin1Z8aU5ZL1G5ex0LGYCl1msyG6mr3QdRRl4Do6NbSVl/K7V4fGSHW9Y7cQoZJn6LtFt4qmBcL3UhULhEsWeqIY
+H4Ye8b0A5Y2uqbmhQI5PeoucrbEU1PhHHmov5CosUouzoaRcXe3RWF3xSEBaXquV0rZh1oPydaHgIa4a+R2OjMAnXqndhkdasdZn
+YQ0IeUAbNw2TybEWaBR2J73GvEfofO5RtpR3AgOpmbzClDbmiYekKYJOAKRFre7RbdRvTS/ozfzOhWLv4XqHerTvqCT5kNy9qzn+
+5raR+aMqZjVYKt6zblEDuJkzy5PM8dpJIc+QFMHMKgX7MqM3MWhyae1RyQJmPszBdueuSltdVMSo3x3JbYo9LKjPgdoxLKv/qBS4
+sluYb0ZLzIMJoLgxihRBKQu8aQ0Zmyp+boizlHgSN2UOVzEHupPVXPqzZjP7+ApzaVNs7FgWCe0WLsRDvCiXdKdNd4qVULY4LKib
+s0LxRdDctny+1OVDgnbstAhKi8CUw6Fr94aStcDTq6A4Q6izfMf7OEZsvYmQHeIv9ClbZpv0vWqaJ1kMLBR2o9gavuMCTKXVFpjP
+qS/agZTB4AIz9D3n1SNJTFp4Oa+teihqskKlCgLmbBBM5DQV38bwOvcdXgd7YsqAf7hUnEOO9Lm+jDs40gKM8F48BtgPAJqDaebZ
+SHu+1AhiefjbnKPuCVD+9yzTrdxIzAiR++ZBzzrNVQHRAaGlPCjawUCGl8Z2DaTxECioUM/P82Hy8gGUTbQU90YHX+Cov6U5OoOz
+jAvrXQbnwBHIjW5OAynT8KwuTREWpcRMuaFSSZkMBu+BCYyvmQxSiVE0eUelCB1V0y4TJVci3QkycaQ4FBkndlIGPEdnOCAtt9/b
+Tna/9PHDOHqF1kBuY0+x81Mpyk26H+7RuFtweTdM6lHyXsTgoKnZ9EK+4JvhxUmTgIzm0HOyM8U3zde4ncTo1TAN49CYJ1XStbw2
+D138EPXjbsJ4ooOkY936CeJ0T+BZRT6Gp7OplOo78HQAecKzFB9kkIoAmHWgJShTH/7Blwv8O8Qv8sK/wYQ5pNzICxEMK5kA9P0b
+8ykK4/fwq454zBCF/k0U4uPEv5ngw0U3f1rLn9bzp438aTN/2jJPenAJU5OQsGQq1YMebFKMNSmGmpi5usnnbF5M2o01ffM5y/hN
+blWNuqhMTxc7CzJhfkdJrCYZp26QROpnPA5inAwxPhP4MALXnvBwgTnD8Tn+mEUMM/Q2Co/vxfW5iO2ZHAuJDaN/UHjIMLQIPMk0
+GGCeK9UDjGMAXQjTgbrTiCgKJ6rDUYgMZJZqyJiq5EwmCDKTJLo2WeD5XM/QlD94l83ePXjQi72NNfRz4XU31mkbT5DdcSglwL9t
+0qbNKt9o12UO3QBzyTl3UE2/tHnap5nAAocySOVjLvoVXOWXU5ZWsSrq85sOtLol3Feu7VzIp2dZEk2lwAwenTsjVn41eFUk4M4E
++J90GlpdddCBRN2PcoonKq0lExGbU6GkjrMAYyNfU7RBU0t/Ad0g93wRrB4jav2eILc1kD1Pcus4ObaeC044hTLADcavSTyIkkzI
+4BwJ8qUtZbOZXSPeWIWLOa0ZaDYXfFhQBlwnOafjzXzRBIvY0Z5auqamurz6UqM1iZ4z/241tOYIpwsXyK7czHOz6VSPE1uf0+0H
+rZAd5dkx5ephb9H83BEmV6rANJuZ3tePn9amzmbkLCXKNpRM8GE28zQw1oMJjGsH2cjyKDj3qFJO0pcdp19BphDJPirVhCMPw81I
+ms+CO8O4dSSvDm1VKr7MkmXRmyCZmP40qtVwiV/yYS6YkHuOfl5e+ExEI8gWxufO8tObBQ26yakYJOeAUQ9FNNpR9fQXNO67XRXx
+sIx0y2V4veNTA8e9mgrZrUiMK9PiZfurtGiCsuq6lSfSouCrh8YCenUZCrfqE/VtAUlU05Z1bWgtKlqLBdWoA9PDZrM22St3r3pb
+qaDPlTs5wXMKVWaBlpH8cTnHCb+lJ8uuVyVARCfvSskrTOLX5VU1nm4yEaSDC1CXirl83OkLX7Sz6VkmU68DPqFtDwi12DLmcXn5
+4/KSDcUgCpTX/nr0rG8YStsmjM/DoufUwXTVUSG/9bZKPLICfA34K6oYpLbIn1ZH+9jRfm1Htez7dppuwyUydLlmsyaxTJDoZBEP
+a7PX4LwkHizYyItO3bui5J3nT4+e9l1EB00hzdxs1qd79G70K6sUr45Zp4K3HEMw6lkjx9i5Y9ISLXJyQipzgg6FauZE1Yxfm81K
+Ug1dXcJ0yw5z+4Sxz4yeeNLp3XaaH6+uCogv5PSpPCM1y5+KTMhFuBg/NpuVJK/CnWiX0u/OX1rCSrovS8MZGH4Fv0+RkDVHotNf
+mJfy0oHsrqacNWTMUSnnLHhNqbq2JsE0E9VFKAphhpqlm46XFlM5vNqZuZjG73llwE7GYRILXhobuzNHMvdx0iDKfwo4yTFPMn8a
+5U8pPtXEbzdBYZyDqmd50U8dnUSVkgOUFFz6nv0aa/AK1YWZWtqGIThM4DydzeBH3KsWx7mQrT9W229bngPa9fFslt7W5HBxk4HN
+Ala5Rzp3ycGSw8fgHbtK3nGK7I9GmSiyAH/q0KkZ9daDiA+FxWfWPhtP02kch/E5XzHlRTwUQzCZUa/6+OArJg6nTjA4ZCgGyVDs
+xjKUociKbG46tVjkJkYDaFo+VErZa51NpZS9vrVtzJ8wuEeHjfik1WIX+HeIf8f49xL/nuPfa/x7in/P8O8H/HuFf9/j3138e4h/
+d/DvU/z7HP/u499X+PcI/77Bv3v49wD/fol/X+Df1/j3Gf59iX+/wr9f49+3+PcL/Ps5/v0M/36Kf3+Ef3+Mf38N//4E//4A//46
+/v1V/CsE/kj1E6ufVP2E6idRP4H6ydTPVP1E6megfibqZ6R+LgTvsKHgFwJmVP9eqt9eYNO4CKVHZS4XeYxooO9ZcPmkCrg1REFO
+uh0KjRCte5MU/KIO/q1WqF8HeM2mC+ucA3eENIlzj/tWulmzwfBtKm7r+ZxVZ1aFk9K0vjvFD0gF01z6pYm/28QunEryRON1Z/ZW
+YPZmMzgdnNZHPllxWlfJO7c00+pSn/RrCj6/Q0GngIOSZjOSGS7PIT70h/7bj1mDvbhu/j29ALNZPkswI8UNU4xDiZIgj+TcBR1h
+0e70afk4WAgFwBkRYXxe1xtlFezprpSWpbp6fZKZzh9WS3zhTuuo1EP36/ltU7pgTpeNxrvLlBoCujSf13eYz6cjKdJlHdDt5w27
+E7EEIBevnsO0qWusX8cfLrGj7tYJvw43jH0NI3bi2cftB4tPdxSc3z4Ylz/4EQOq6fpd9s1evHjWPcLNFrhlI9u8K2SN3hGVrnad
+cX64K2AugRReXdcrv9z/fk2/q3BUnj93jpeXPfv4oywfHJobOKP7hHxSPQTe3wXcP6k5PXY/DqwObyl2P6jDQT5PpmeR+NVpIkW2
+bMAOdiu4s8Rlkyxcz0WUT5nKua2ZgoCxqv8WSJnytByG8XnttJSW88/ZtLxOaqZEAWppf3/DiSnv/+/3NCns8XwBF9uunxyrWdrz
+yap62vef1s/8ourKx7+aSYuFfus15SNJhb34Tc6S3lvACK/vXD0r+zvqpqZHa1jhZsodnPrqDqjYzr9gtZa0VyLUjpZQfaqS7mLw
+0SN447+6rZK12ykfw6u2OTJauAPK9dV5cXblGv0I2m9V33rcJVi2jrCBuzz0yA5hB+wpXZ5zDXI+J+zLW3OuQ86nhL24NecG5Dwi
+7PWtOTd1nc9uzbnlrs5xDWC+vO8N8+mdjv+diiyGnNSB6VdLwFStya1RjuKaasGW2SMnhH1Nl1Z/F/CFfL9I4D2pA96XS4D3UHN0
+nKEMDEG8U6WXP/dJzrc+qn4W4hZu7W092a0VHRSX7zE0PDCciR2yYvUgz3QJmaTJdORkci/1+BYK5/XXlnQS9S+7PPHIAWGf0Vsy
+rkHGPcI+vS3jOmR8Q9iPbsu4ARmPCPvxbRk378dtMZy9saBsyfot3HBmYkKcmJ+wEV2eE7HgHmE/uDUnYsE3hP36rTkNFvzVW3Nu
+1m1dzZLzFrD178auWd2qpws12C2BLHkdCQSsnxAmBV2eEQHrJWHxbRkRsHbBqPKWjBsfBy+XHwsvarghDjcRy1ZMjTfE8Qa35kRw
+2SUsuzXnxncHBJsfCQR4b7iGEfwSYZFggyWr9hpMKcNBUeTXCBsJNikXQUknsEKGKushGnvUyuPt8bS6j+07haP6dYejzOj+6uJM
+utzl/sCf9mTVQ49zT3oTpDIMIg+c7tI7UNrzmoG/FOfB4FpP0gJNtnK/naUUKkjLky3oGt+iPfmEr/WMXLNmPpDWANXFqDy8OLeI
+qY4xgoCjaLFWN5lctroUNBrn9RSTtbwuUPccoqQeFDzKblv+XEO0pBlbmeLSJbl0p6W+Jx6TgICvCPI1gdUWj8lT9f7r5r2j3h+R
+AhpKWjZuR43eu+pqzo/KW13hfNQnXFMDy2fCXziupQNbTuQonoC7X+usTWsGJwCQ4hVnhEYXYMFejN2NF3IczF4sMS5hrwp6mRfe
+Ksua2wqytgnAam/JtCwA1gUTUQHXmnnz1hgETKmZqRb1lwNPf0lH/bs1uPza8pn48HFjWmfdrWVj0htmVNowL27fMB85ZrtDC8Y8
+iEQQTydVxRvHxqTT9+rVL2zditYi/jDvUN9Royjda2Bf12BLd7csFD67SLtaTSGR/mZ9d7aU07VvPDlu+TsOy1lXvvASWteJunpc
+RZoSmCzSH0MtmDrdQe2EWRX0aCMAaq0xTGKxQkoLkgcrU9jao3XHvw2fKrpAMfjHzoQpNcNm0wG4Xi3doz0q2mi2V4ZL6/1IKWv6
+bpYLO4ujplDNO7Tz7sUL811W6yxJaatlzt26b81/beevEwRXi4zdJlzpZzX7aXUUjkivWuLMbeCW3B8qI7ilwNWSDqHMoVrk/YIe
+VQRa1aK7y4raQp9q0cNlRV8ni4rtVMdncfar+Z+6zSzN+9zNW8uer5barwHkXNe7lPdVCYAX5TuqAq7mZVfzvlmUd62ad6+mr8h9
+rGY9WJC1ptYvF2Rdr2Z9sSDrRjXr6wVZN6tZny3IulXN+rK0ApCtmuur6pwumKav63PWzNLbaic1G7Ga94uFeXfjYTX75zXZFY+r
+mvezRXlruvzporw1K/ujRXlrlvbHi/LWrO2vVSZ44dB+siBrzch+sCBrzcB+fUHWmnH96oKsNcMSomYOkM9UzSsX5a0ZWLwob83I
+0kV5a4YWiurYFnQ3WZC1prfBgqw1nc0WZK3p67RmXPq2UMkb1eR1LxiVIgPh4hDral7NPClnXl73qJQ9v6cVWWtI0Gn8Pk6u4oYq
+5SrJOTR0qzXXlagrUZkQXWDXkGuGd93siwwaKprkt9KwmixmNST3KIzD7KLc03qrjVtpddSNKFPqhtNi7ENc9fcOK93rlnVLJ99y
+1ay58l0E8TASR2kQgq/W53Aqubod2hTIaaxaaBHb8NabVulS8HI2cxO+ms3qDz5beFild8qljmazeuqlbCRnV7QCe242W8gtWIFt
+tvz76JbvOdCYhNNywlU54UM54aycsFtOeF9OOCwnnNfazvkfwZBxgcy7nSFYB4F0adOL+FvfctNLGLfffqMVBOca2wWF5TG70aDk
+O4DFXK2qWlUry8tTqcGyl5EFgXtKLA+b+eliiEId6Jaa7izrL+OgXEms3vjyWJzcXYe2pmrN+K2p3OWZV9XgXIzk+lK53TWXzeVW
+dovanxN8ZSm3l1V9CnqJDRzqk3KsmaEfdmZsWoVH1DFBbMfwMR5Oyt4Q3IDarsR6hfMnHX5w41GHtr/Q7koh5m13rbtZpGDIi7Wt
+LdpWPXiuOop+WDc6tP0MFz3355NQltgzX+aFqTlywnQqXwTONOQHKLWZXUUyi71bXe+X12EVRCaiHWpHGxDmRihvMJKWLA9ROOhE
+cPckpUVM3Q4Lc8/6vfhx2Gu1YorNgDxLudVQrX4TZ/chu9VR/Z0c8DdqgqI2myvu+L6hX/5v2FX4zFIIZ28mOeEdFuSxCHrJ46DX
+aiVqN2UYRc0zZUBYlBkZYzb/jsdhbXDLm/zKCqgfkQSbtsKXzGZ1QU1obawP0ZYXIrY2a1wfleBmnvvZtdwmOstZF4iKHKveNTAH
+qJbFRdCNu3nN3O7aTuQBVaXeTRgPoulQ+Mext779kJ7M6bdU21p3nZ6wcDyJwkEoIWW724Wtv91ZVz+b6mfjPo2uYfi8tB20le/X
+nmwH9/Q5CXHq4nboBe2Aooo1vITqZYVnbox0yeFror6iXFM7LTPZOrpvE+M8146fSMrBuLQNcQ34QGxDe6OnzeZAVZlSzke517/t
+rkb822sdFTlwe22TsoznAKI20Alh0xoHwkWE7SJpwKc5SLIJL6sAYPQj7IkqSXHO08WRWlRM7LT9NozlNoLrcv+g9UcuRj5P+Urc
+bGJQ7wE+rqTgXVdA+EPzujJoNmEbsAs0xZ7NBrPZiA35BUR7MdFQ1Oiof3xiBTvvGXR1qTzdYpB8vZCCXdLZ7ALQgsoMgQNnswEk
+JEhtqQQTeINzyD+Cz4qQ0p/PrqV4aVWACft5BXQ2m3qXbEzpbDZU59llbmk+LFZdOQGHqNAPTfx0FcVqbc3Ei9zUgX431jDQb2WZ
+IaCVu7LLQkrYaNINSb/aZSlX20D0O34+xdrlsJdSOFAfpz0agudg6YHvYBazPOBcI1yGJe/dcN7ayLPaKvan2c+LG60CIaSQ3Osj
+7NJmM+wrvwYVZ/G2g/iO9jcfWy7kO+ApGGKVxPl6bnS6SwJ61PWHHbE3bE9tjgP2JZfN9+wFl81d9prL5iGipmbTO+Bv+lCHyu3H
+SITlPqUPzDl7APlXPnj5PDUE4rFn/FTjuWeIKA/4JeyslS9NtoEn2AGd567QXvIxZPiKv+QQH/Ml58+h9JlVM+ylL7HOl5zvY56d
+2eyrZnPljW7jxWz2Vf9m7l+7bb3ojzzBpt4BLKc/8QTL1LNqHkbw6vhl7uH+DcQKmvcO+Lkn2EuWsi/pfL43m3l7imJXUVy+5ntw
+udKj/NoU/rq3184gmR2ofG/56/6L/tC/8F/0IdLNXuxfsS/4s76aTP9tEUCxkXhfzGaWW/WQJfTmi2bTS3jIQhU0lgXeAUtY6oW4
+mgkTbA+oe3aQA8VaV4fx397QqL27sa12+fbGhtrl2xvaLcL2Q+0WYXt7U0WF3d7eUlFht7cfIjL0tiGe3RDBbZOyMT48pOwSAk9D
+mNlzfHhE2TU8wB3+VGOWM4NZPsBdY5uyK/BO/4iy97zLdvkaO+QbbIdbRIl2831C2NMi2Zw/J4Q9L1I/FbEKr2p93q85v15ByPpX
+xzsn/NVxmfyppqmbTOkL3OY/D8VVKflZkgDfs5pZlJJeREkg19fqGsVPWxt1n/bMuVdN724t+FDfyKtgUkp5PR2fmWHuO19UQOJS
+9kMhyyl4EpYTkZgqJRbnd92HnSgYT8Rw0ffu1qIv1khXOsz6iLxZVeCpU+oLEbzXMwHxOO6DNnO9M2e7CtpP/RD91wI6KTZgHmv1
+vtg5Y9PiwsY5+PDWpKJQgawwaSVBddGVwJO0L7Ru3wrnEOTT1JOyqdWl7U0dbnTdxInepnc/tovxr3YBEZkTUz7uoGboqnwS9jt+
+2JKUxTx+EvZDP2Yxfo1bPAQ6Tj6J+x0/XpVPnjzpMPjLO73inqfO/BDO/PRx2KMJRJIQx2lL5nEnkrufvHggEMV/sm9c+SEVjrzA
+OlsST7CUtgiBD5n1YdAfmOuRT4gm5SGmoqHAO+pQ7z4QnK9O+2S1Q3xZTPuanvVHay651d3E4DXdBx0W8bAfFvSVCc434FE/yulp
+ndq7t/MlJ6qLTgq9wEs8SdupmETBQHgZIyi3gJ953vnuhgbjRxuaWHykoosrDOGR409+/pf/mxPCyDm5Bc7z6LcYS90WZqTX+FHw
+VF8LGHGv7Fa0ee9mzghhNxD40Xby79H7XAyQXxCxgerVhMfNjI1ymGYXBU8nHHmjFQ7RrL1Js3nxZETdSx2Eai1ogGGzqV5lDj1D
+zlWo5DFsnEvAU+c8bk77SEiYNYVNMNBUg6RMPUqgc1ut8eOR1sO65uJ4fMJOuTwen0B7KYXkMz7pp94pu2ZjJplgA7BMv2anbMxg
+rAPsWk64neHGOKODJJZhPBW9S77S7Z2lIng/h7Cx+Hkl8SSrxIVeCbxzBuwy71rZCkQYiRMmc1CEOzjPeWkQcLaoXOnQhiPvWslC
+ViLspC7t5DQ7r61iueKdzTxLCPmaby4TQnn90bq+wj7EmLxdNuVrH7FVYFcITeWykDLY5wUKfbTxSLNKu7q5jaXh9KAZrxJTiVe4
+Q7LZhP/bCvy5CeHebMqeje+oYQzEXneL0jsOCc6DgCWWt/9Hmjjc6GzpUax177h78VzDA26toypZW+uaStbpLdF12JRn/UXxg3ui
+R1NPsgQvGoKH1jkr535gdXC6uIPbKqAL9HRDk8DrBjTWNzQJvL6lSOCtLUUBbwC5PODAIAGuhRcC9Rt5CdC+kRcA5Rt5GdC9054H
+8XmrROEKH3uKcwV/LTLS61K4kYdWKSRATIGQzmaJ9fGNCpiuMiQmmroHdQTNpkuNmToCOptlzWaVyjEZMjRdGtf4j50iE71KMmNQ
+MZvfZA6llMf9yIvxLEQUlF2FgIZTejMIMtEY+CUuYzFHPcwwKWfAnqpvo/K3fDbU94vyd5wG9W1Y/pbPwjyHIjtA2Pgu7NxG2pYi
+kyaCZlocfO/eTdc6neG7d9PhdqezCr+j0ejdu2lnXb121rfgdSTW8HUk1kZYZoiva52R+toR6md04pygd+IrAE7OvLh0JqU5WjEM
+ixWPxEjsE87TfuLFGDJJslgfc9QvCKW02ZTAw4I8oRcfyxNmhQ/tmm2/rVEH8ooyRVV+BL4VygB5JbRjlK7fnTA1YLzaZbEmIEU7
+C78uUIeoiSrLUohT1mrJE36cMnGCscnuwUiqsaS2Yv2scGCfxMfihCNhWJy9UrucV5sMFo7O78tLMrDMkyI8EWxV41vch6DUrKT3
+rcCiiKAVwIRlPPGM0swqlprqKcyA7ggeZz06PQ5OeHosW8FJDwrl5Hqks6JlFmSWPRqpzEFOrkfIqvKmKlYcCjEji7rcXsfj1MTh
+/m7XPF9xsXy1ncPkoT5MHq0jFR8CR7IomSzvpWbxr3AdncoNZ+ZEFrQ+A8VhfdG4ixCNf2r48CFP6+RA8e3scQdoBXLHmWShfWfU
+zOJH9+IyGhpS0LIIxDnUOYeQHJxP8W+Af6O87a0t+7pacGiy63hgsXuyWh7RdDmPKOL2CfPh+oTcd3ABynvCekFPslTCE39jCU/c
+bMa2hCfOJTyxI+ExU7hmBDxb279g+c6diMptE6xy+6Gm2R4p2UTSbCbtMDu6NtwhlvGgH3oB9VOr6uzOxL2gyHlfUWH7bZK4a441
+IwrbuhffRnEb8pVaseS+anfJZrPmszSRGcVVA0apdAoTquGkpHlnY/CQy76046Gm1E+POycwivYgGFwggyOBBQL7Rj0DCd5ONf86
+4MKtwGxMXQFP8BoaMiAvExbMy99jceWl7R14ns1CwKoF+wvvZWT3w0QMpBg2gvywJD1dxFFrWIbwzfodn9yH+1PgGxZz+ctdC+tw
+2Y/7cjX2pd8p7nZba3cFV+DkhDz1iEzeTibKqTOhHx25EHsK92CeQFwjJKp1Z1M8b9UHdInOOz35uLu23QPryLg22LvEsFlwBY8p
+FleFCo4dlhTtgS4AplyUpSBgI79MWh7pQAxes8vhptm2xulR2s6icCC81bUcYNKK2owK/ak+G9pyxbruepKHRq0Cep7BKqUqMq9h
+ST3wfvk4WB11Vh+d3KzNaevBecgqFxiWh4NmU0DrxAyZpTaTMu3JFl+nljWw0MNAk/fWOkWdxxDmth+1eHwcnvjdR2uce2trG80Q
+cHNr/XHabHbXtjn3uo/WQCZTU9sGk60trI3Svjfl4ePHW83uo+2N2dZ6M2FRi0+xEfKf/uSP/tOf/BHx69ZwiqH816m/trYBXdjo
+6C5sQRdua5gFdd8fMtl6pL4XY0ioPaIg73J3rbnV3djozBLo/kZnfQ26H6jur3U2tmez6RO+ubn2aKvZnD7mmw/XN9bNmG4Z1hb1
+1zY6OKxtPaxH38qwstrV7TDZ6q7dNnD7LSumYbvZ3V7f7HS2YSK6a821zW0YfGDPSsamj7c2N9e3YE663e5Gt9sFGCpmAyfEm65y
+zAaTWDc7OJstb/rkCcjPNrfW1zotr9tZW29OKcWZe0R9XS/JSey5OsUSkHC5W4qT3oO+/ytN3voB+yXCwvYgGU+SWMTmM2HfH5T1
+4DeOO6uPgtWvT37wIFR37pj2c0Tm66ccQ8m7YaiFGDA9LmFAEDXIk0UYzeD/HNOwgcE1tegt5pJVUVx+YcMLIodgupHCejVabkmr
+BYoImYuqE8riZnP9Iec8azaT1trjoNk0kzcCRJnPn9kDSavLktY6pXTQ4nkiJrGkBWxSzZjNYJkgU3ScnRSp+T7P9D6nN5XkrfXu
+I+hOF7rjTUt9bnUpfcIRogtsQW+gOzGoeb492NsxsOmBjLsljpNW9wT61+oZTvV80OLkl3df/PKzF7/87DlR/OTFdeSn0+AuG2T1
+tL3yn/3wE4/W7pT86322TC7xcFjEdVRFaLoaZq+D1+iAJ+SShU94px+jushq1w/9LvVXukrUl95BWa+e4QLKroX+Iyg66CnxxbxO
++3XFc7RGJa0lWclOEMeJbADx3wgagyjIskaQ2XQfnUurYa4vQygjRa5ywIHyVerEjx4BMyL1MsWffbT9CPizqRcBf9bmbZj+Cq0E
+ivuT3qgYuczY3aiYMztZdngdy+ADdll7G0hFkCWwWwOj4T8KI8EDyhKToCKjcuibSZpE0/Mw5pkdZL3ZzJ9Tky8K4zxIyCCJpuOY
+m6i+mZCvRJYF58KjDHvU1mG6DyEAzlEaDESzueCDGd48Z3gUmtZFxRWTobFOt8bQt55bxG8Q3/hh0JlbPJ+Vfv7kk8eDLGtgqNkn
+pJiEfNBmAvJKiE9a+ddW/qYmhbJy5gZpWcszZ84AL5KrQ1wTwC8OT9s8N6QdR7vZnJp9307FsO+8tc+SaOhJ6su5dedYUPo8Da77
+7qsqWrikQXUoC26oYeBohXvrUy8/DwRqvgemYrQf80LuddggT4NrnN4yYTubRKH0HrxL++/iB7BpDBOtgLvVdYbni/oSxsWX1hpL
+DOeXTfSJ7EVUp+XCdn1UANOuPQ4mNgM11Mchz1rdVgj8mQYczA3SSvLTd0JbpDFroJgg4Zyn2LgqOOWxFxSU/rvhg3NGGoTSljmg
+OizV0LHaLcTUD45/45080ZlNRyU4/Ket2AsoxPJ6FzdIa9qSHvkNYnYI9Ex9n9P2l0kYexCJiLqAZSiKBRZvLtxZ0Ydw8ci7+F1M
+VPvEwjutApgNfIs5EyCxLtTNJ9+ahv/3Dr3XY+uSPmanFz8u7CharVzX5jg+6aXtQi2S2y+z2UoXoaRQogT5OkG9SRLGoPnsKVsR
+/Y2yepsAwdL2e3HNUpoLn/N+48GST3DcbArPZonFlKWYxgCzgyaCloaimkcK0sMpHmEPFbE31SGIQE454ak3AFljh10sOtZyJWbD
++tHT9KRrHTj5x+PuSd9+ATXG0mE4yADGcuqZMgLyp9GIGPw9yLLjzkmzSf7Tn/xxKdF4F8M69IPer11KWYxkfbPpPfiNd1ct/92D
+dw8eaFoev9DiBOEqxS8SJhZWVjJPXYgaSkpcNSKTKe8Hi5HVmrbBEMucc8FEGzglHOEDbIpTj2JDOkg0PDabiXPqm8IHugMJpfP8
+62zmjVpc+/ULh5w8xuOvAQchaY1agIOYqSE//4KJVTUOp+7MLocatBlOlYVfr134dXvh12HhWchVPpao5pM0PA8RoAv9aZ6gJkqW
+z6uAsyGM4UehX5bo44olOBSWanKB+uWSsFXydcknrijAwjbOGb+BFnzJVAt+bKLPm8JzZq2Rp0vZExlGgrLQRd9qeLyqtmJWwmXT
+x/lyWxDCUh7rioLIhL18kaSe6rEwPZZzhLqV1D3lddUhv0EqqQxPeW4VNl8diKZKc9zNkYWb6YMmliKW0IG8bH4848wUxGlpNopm
+edUEqLI/Be0Lv7oDa2ZIt3iQJHI2I20CVChLPMGOb96La58AjBN2LqS/wDpU7SW9i+bzE1o+DC/+LByGdhxA06FC74cXslvRJ9NY
+HUdD4mfKy+xio7C6Qzb483jIZrxmkpSeUbNJMnwof2iHUokC+9Ut0TDzPPdrPoraNTHNOYoynPM8fcU8F2Ppm775eYOaQNgoEQiP
+LAIBvm+DOlLqjZQi/tr6Q1BISr0hqOEvuRNXbsOKXxWOQisSo47/gCn64NSqiSDXqoPbuAS3McJtmiSYL27D6GjC45yP5EgsQS5m
+v1/k1xxAflALi/U5mreY6gT85aACik/tMAY0Opt59itf6Zrvk1RccqzNCrwf8LFpspe2M+QLNJtewM2LCpEKdeBDqj+qF/QxDE+Y
+qp9pD8T4CQ88mPVCho8TrE54MZ+byLOA6QCvXBQUDUugXA15cBWkMdh8LzK4B5G4R/Ns3h3uNXZxPHCdAhcirg8egPkD3R5kw69u
+aRw4r9thdmEzPW5Z5UFht0IOKQ0K46lZTyYrs3SAN4aXrtlMGJoDC7UnSSYHWfa5SLM80HfM8/Q3mJelvJyThdxaMLNNkrR9qb8n
+PNWXcdImQPqH1mvPS447J0CmIe2cSyeS4+4JfZK/BvBKm01AIkmkqUGPvNUuePC1Aedq402SyZ3Dw4YaW7vxk2SaNgbTNBWxzD/q
+rjVCoEvDFmGNs6mE+2+LNKaZgNS0RdqNNyK9CCYZLgtklheioQ73Bhx+F0K3fCai5KoNauG4lc3MVmZPODytAv71sErjKw8X+Zk2
+KCCgHIWD9wudBfcMPKgOPeE2KksM1WnoZsf5tIXvOgzu8kYLPV1QybHV0EkOFdMYNIKsT3BBSLyQ9kO1OxxnDPmI1KYxe8DeKbG9
+A1BRjcVuZ1EXUbugKFXoYpxqqZr5rWd19GqnCi8KWiHTs09ueqMXEewF1RP1Uf6gT5prj+Z9LiIN9UvvvldK4IsbDEd5S7qvRdO9
+HEI7zAIjLDinlXWx8a0FE3bnjaONomsltFWeyhwwTZUO8Clk0qtkyh1DWe0UBKgmPt+CCq367g2yTI9ncEYbMmlcJen7xlUoLxo4
+bI0nMkJ7DurUtRYJvVLs+Qq60xUxycveIhhOM6gg+uK4RGadeLSnbRdS7AHOTPwkF3RRVPjvpVwcx63WSW6NGQMeB8cqAP7DJBZ5
+xriNhKdW28BaEy/fjCGl39aMzasr5q55Oo1rQ8rDxEVBJhVW5KJnK/d5di4kc6wEs4ORjZEvkI0QJBJxpY2cA2odBFrEXh0MVglC
+DYLqlO5VYSKZSACD/K7YEwX1JLl5KTpFra0UitTkKlIok0Vu/Gy9G02oEu+HLZtG7KK6yZ8r3T+LWWvnAy4W6kzZicg7Ou46iXMW
+FBfcfFMsveWWd9B8zlRx6NxdSkK+vNAgW17GwlVIzeXlFP/g7mVV/rz8OJjcuew4mOTl8BqwtKAiAiFf0ZgiHbK7FDR56/gHlx/B
+P6i7Vqf8Bkk5v1yy0Kg+BlKAEJbARSrgHZbBwxT+RLzTiwqdg8jctwdcHEcnvaw/7UM+n7x7BxemAbx1/IGS63tQD/VVhM/BbKZi
+Wg76GR/4xFPPQYt3fUL1yxMwtAxWeZf6cFcKms1VEBXL3M3RgKKGDVzPk75HgFYOm02tVhG2ZRqOPUqL4VA/bPGBxoJePJupIrSm
+TDpn2SQYCL9qXnJMGoSBRISRd5IU6hWaSFaXB3DRF9Tduotsx4SRE9DbnM9736a3oV+EDCT8M8SpQLOxdbT4qm97CcNNSffrORUG
+XirM7k4ts7tjM7s7J/7xSS91mRz6EsTJVrvT7m5ombY+3dWBFifpOIjCrwXYKtbcuh2/paVbrFORfoGpHQTSK1UO0XtUp9xjWx8N
+NTZZHy/q0V1EmzBHUsKq1/S8h3yRRR6LuWBpieKLlbJSzNN+7MdLKL5AqYXBBkue8Nil+AIeHyc2xZfw2FB8iU3xBTyxKL6MY62Z
+uXkipszfKKvlVGUOpyr00BjOHVS+hpRKLs1CFqk9080aHe5MYYu7Nw31wtV/NrMOUNq3KFZzk1e0lMgaA4V6zgRc4IeAdQzMNcCR
+vAiGrDGJRJBhhkYS51d4VcUDbDB9YJFdjWQC40AUlkwl8MyGjTBuXCfTNGclpNM4Rre2AwQzdHLfJtQv+pq1CPAOoHdBiT1B4FDG
+oyIrOL6yIvvOvndI3JYMFLjtuHPiqhnmqgrdRbLvm0ESJbEPGgAMzuFY+qTRaDQIO8vDDPh4Oqr3g2kk7Pf9iYh9kmfHSBP2d+2V
+XyWhB2L1KMYTef0sGV6DMo92gPtSjCTWpd8PwvMLTJizYLHsuYRVz6ZhNBQpl7WKR9V7CC4OlDwWyBA+qWEXpkkiqxeps2SIt0vR
+ToOrrI2DazbtTnj2p1Kdg5pYvwqrYQ1pcOUJRiIxkoQRnRkmiKCxgJUlhVkq8uCkEdpz+kEe/JC0ZEugBBq4bD98oKRido8g+vPi
+qFe6tTMhr4SIsb0IQBGV2CdpMmnFLZNRh9wwEhhgd4Rj2D5BDJqnaYvriclTZzPSWMnfQEqt8pEecdfVSyv9TgGgq6sTJYrpVO5T
+JiKhjJnypUuu4kMxDnFE9Sto52CCEREPy6o5gSx1pJhA8iukpbi+araCNBhn/UrPVDoxdsv5eWpDEbhd78etSpqfKi8moHTE4OQc
+ioyqkeiJiFupJVwIuRmaXlGgnmnLk33SAwW7EgDFrbQVsgqDDrbAgrNZ9yE3+uxJIJQMlBLOTY5jeYIbr0flKi+sN12wy8zkI8B1
+euljt/5eai4vYV5vesKSopKQEYWRCO0lpUVOSmKnay9kcoXzdDaLK0OG6bzHNinQZHlOcSPeEACnTAYpbFp1Zcu9qOA4mk39oIeq
+Y1IZ7JM67SI8EEr9umRGcqxLUFrpbqzSRiNzsgDS0+BqgSJIIZCLwSUQl7iRc0D2Ug23x/KEFtr4xtJLOReE0maptB76SjCb5XK7
+AKGl2QzaozDNJAASLTQY0fDRVJ2A2Fh7YUUk7ikHrNAHbRNmvaC8Lu9rkQ5+cnWFTmK5o7OZnmd4cThZKhNGb4CNqNZ5ykkaXJFW
+6MUO6+d4etJXq3c8PfHgYPOz9lUQvXe48DZ+qJtWdL1cyCUBm3KYDsqcMfCUpZXFzTFdzeGkVzg/W+s6tgBwm00CR4za+sB+1AtZ
+jEMatJZvd2c4stLTXQPP37SnnQIf5b2t6Zc6yJf3aQ+pKF5pSR14+JHmnbISe3fpdr7TYmu3pM1mqnXudWrxpDR386HEBucDQBqE
+6SQa2SCoiOZgycPjMMfkYDAjHXXXDNVdCWUr3XntnDgBomqRZ92wdf5sIeALZzS6bOwmM2QyxTmTCQeGti2xM4b4pPWDB8rDlLt3
+4r6F2iUDW1lGCuIYrho11ZkpAZPT+tl4vojcqpsKyPz9nQe4FHz0PByUybc77gM4ZMz29cx2WEGnT0IdDTBeuhD4c9iOq9MkK9NU
+BvnyNMmaPMXYF+4IuC59u1jWYUc5aCsfsJ36ix0vkEEfOVx1duCYFh4bmuq6BUHv3ONou+O+U82WJ1inu3PkW7iy0juLUFiGGmJu
+naTIB7WIvRoE5VLdywpolAtlCspGLsqt9/2iugC4Ce0VsghD4rEQSHijiwWEtr4BhKCckPLUOtzq0Jax7Kg0rE5SJUvO7UdM+wHv
+9ILHYS8AI88WTwyDoIqY8EZWuwBggMksciuXFsCJi7ddxJQpfPfjCiMp+MZ6o675kOIfWeo4A5jzIVhzsB+xH69+Suc1XgoQnf8k
+p3N+/IR/NrdaQRj/SUkl5SftSTJR5POKByUovdFutY74vm1K+WPKvCOOLlOOlGgIfkbNppur1aUrnE8B13zKf8x+1OJdyo60h66p
+jz+Rr91xac9b+DPw3/Af94bJzRsAlnLrbyiFtrHWN6rW+dVFGAnsUzSbWV2b6K6pnwHtfc6PCYqJCNvXjLMfszf0hP2Yv1nVrv+M
+ly/ICyKfY6Jm+sT+PMTP4HTypO7zOX6+IYzc1H2+xs9zwsi87vMH/OwTRvy6z6f4uUcY6dV9HvvhyHvLf83cJn9NLSxw5wlhX/DK
+KjEyTRHVvG02v1AiOfhJ1E+kfqbqZ6J+BupnRG/0UoUj7yuQOGJcE+8N3y/2MyXsDRjfItS9UiWwu3PpkbM0GLwXEhieSep9zd/0
+nO59vdqF1Q569OtV3mVf8ZWv9GJ/pRZTl8+c9YQx4bywH7E3q5/i6iq9sXLHcPgveKksDuLNbHao9NJf0D405REG/9SE+57b+otK
+g9Rek0tcMkoY/KsuWagAP/H3OABq2CefEBC8sltnd68yt61uMbvaKvxjJrcyKQf8hX1zYF/yg/zCwL580ul7z/iPWl+yl/zN6sHx
+lye5WzdIZy/5p5Th7lNdql+uZ+zN6ssT9il/yX7En8Es2rN05b/HW6WKIPXjVpe9Vwu0T9kbDijP+t7fz3tnF1tdw14EcvUqSYe3
+Qo3dfODDLL7hP2avgbvuIqWWmUrEWa/5ymtApGXUBU28bjaPVlDAfqR215HaXUdqd8HPSP1AcAasbkcP00S9fkNhxaE3vcongAbV
+i9oeRrpOOodpuM8cGCHqkdIOqGARjAHt7nzgQf+4tUZbXQar86bZ9F7133CzMr70crqFUvZdQZxpgr1YCGXU93ZLoLVbAq3detDa
+rYDW7XP6a0og9TnFpg2R8uNWi31uWYQ8BWLgJyqr9hL1/OOlsmyfCzSUQxJmf+RR9oo/b4fnMQQRTdMkzdiRMdV6Yx72zMOBefjS
+PLwwD6/NwzPz8NI8fGUevjYPb83DF+bhc/PwWQ4X7FNwq/cj3mU/5h32ayAE/gn4ZlUTdXMWDN77TxkIajFAm58yEQ/3Ry/A2ime
+l8VgdkAlrQqx/oglfH2DBfzRGsv4xkNwgQ0esNfX2IB319iEP2Ij3l1nF/xRlw35o3U25hsddsk3uuycd9fW2TXvrm2yU775iJ3x
+jTX2gW9usyu+tcHe8wfHjXfxO/kufTd6d/POe0c/Ie/e9d49eHf87uSX4Haw62Tx3tF3N+/mfu9XViDju5N3x790Mnv3wOvzdz+k
+D87ZIX/QPn737t2Ddx755F188oDt8AfGLdGDsHcnktOh/1QcswDkzjC96jUTkgcoAn4VTHxtPzNIRSAFepGzPXCiq7Kya7ayJ7aA
+W5LnXFb9Kpj0UjRDRLl3iQovpAPQkRQuEiwpnJKEj5NeCOR9OxgOPXEcnjBZ+Aybs9TqTeboFRQe2Pr5cDGLX+stGEQuWR4zUBq7
+dLeFYDisvUQEfeGHYPIgpLZwRT6+bhncoQnqW2H1sAkWU5Zxa2XMmCEokJzN7C8aK7B0NvOc8aD37iwPMyrAropn1O32RZCVuZeB
+w87GulQ3jc/E0mgK+/zSIGSpLX0YVNuzZaHYXuHCXD7hnbw/RXCWuNoLvAVWprEyluP4ZF5Wyf2EfNISrU9yzYEwVroKQrY/KY0h
+qDJ7n/BOsykeV1fLbRu/gHeicuvkddIQkQAM3cApEsPG2XWDtESpbZmUdgm9qbagDxs6Z7KN2Q+FvLv728edfrflrYrHj0Fxr+Xh
+Q0kBQS1Wl3Ov20R9a/HkSeHOrr8a+/HcuFJ59GgdrsTKJU6VDcNiUO8LOfQBiFvJ17vNkIUQimGThahEKGccvJ3CJV7XA/pRijgN
+n3TsHQ8or9SQJbBiAcsKp/pT3kGdSEVSyyc8q2pq5+4Cx0kqGsPwPJQZAAfE5WxsbTQ+f/mrDTxE24oLoWjygCe6H57r06nVonX6
+4HvxZRCFQ6x1a0M1Az4ahCHk5CoQcilfWfHWwTcYC5p8vcumLR48fhyB+65NPR8p7WmFdB6C4V7cTkUmuZzftv4gHFMyMRP6ORIc
+vTM+TSHoEIEEgowXk6Wwu3UyFsml7O/DyecwTlTacYs4nwhb6eaFsDIVgy/QaSBZd1PGwWSC5miQmLlFtcVyhtG1iiBV6yYcSu4t
+fn2L5vsFnf48erRJ269U1S/DTPbsrXhq9PnWmTq/lCOOV8GkCuRcWDbKLDYBBdEiWyiL9OI7WnLnXEkRDC50F7xyvSm/MerkQ1+b
+g+cK5sOXliW3lbyDKfN5T/vY1T1DK0b1mKex3FFo8S1ppyIKZHiJpq86lVKW5jbq3PTEJLgdMam6H8x0AxYVG4IH/Y4OHILh0ExA
+StFful7Yqkvl1Ii4Uj067HtY6jTWaqBDuyOdzawkICYsj6RVw3eqqw+azRhO2UM7h5eygKJH5zJ1oIdRAx/2XshXClV/7C9m6sy2
+Smu2nfkWOt9gMvWXXt1mNPTEpUoRZsJNHFO9SkqurA/ctLRFcSZTOpu5qTCZKTU1hLgeuoaQ2hs6Xwk7TS0FLW1zTL5xIN2XynVB
+Cc59mfuMsKDRz52ix6qUC5T2Z11ajcZPGXTLD+elc7kMA/XMZA01ZeSJYjQbREvfWZxPn+x7tbhtNqtP5zV0O61Hj8eJQ07F9IRL
+6tdmbTY9Ffqkccea8MKvuwLx5uo7S3MWtbcYgdMyNQbugutQrxMgq3B9LXMv6Jwr1Fs5jj/Jq8sddS9ssJGKr6ZhKrKGCOWFSBtB
+3BAfVFhYY7qK+L2RpLY56ziYvPska6hDtTHR14x241kiLxpXIhWNZBxKKYbtT+DcVz2dW/66LPjoFVsrteEoBFSn9WHUYTlVv73S
+bprGWZJKMXxRxqdS2yqrtpRiiQZEB7vTG4MrFzsqkbXngSyfBzlqLc6lvP3APZdi+1uifGjFTBZnUjEvsub4Cu2sbu94oNBCuXc8
+MPjAdFKfWhIf9LuOcBZxU39PgVs0mykkF0FwFMRhkVqfAVcV6IyD2WyKGQcQQRUzDqhyQFMmizKHJJouOxyl2Q01J5qkvQLDW7Nr
+Tas7n6WJpIULQfcsxONWd93duOWzprR1mdLTByflOlBI4c1U+/Or+aBWp0pgm2XEko0gHjbyFFWkEaQCL36qzqyxugp657Azz4Kz
+6LoxFkEsGzLBfYk72dTQ0PuoIWIZpiK6VvXHUCiYYNb8UGpM9L5oN/ZGjSxhjQmoe8PENkZJWl9vqPTpQX8+iBvaUT4aiKJ+H2AW
+rACp/kxfRED7vNkkMF4SKhscNVL9JnAq4Hol9BTANXs2k7MZBBqGQH7LK5DWR+l8lAtqh2ACJlnayfGSK5GeBLgN/ehw/7Wls2nR
+voZ89otj3pfqqE7n5RPjNBNpiGYmr8ytoSY2iQZCbULXNZdFNuAdNuEdNoJr6wUvYVJ9P/fAIUqHjfmF4doMH497w1ZLwTS/OB6e
+MAE1SJdeB1EACrBBeF3zrUcFKkmzrNXK3ZgMn3R0eAv0Ehuk4tn1p6akQcTZXjyKIMGT7OJ4uAr+HfKAa1ApI3PR4mF+wS7fGFbh
+1skryYYwsa4R3CUAjQBA5q6m7HbS1Qk6lWFu4zY2Xu2uRpRFvJy6oIju7pSyKS+n5r3VeDvmFvq0egrvbj/j1QF6waGUjVpc5O6C
+SrBlZufQIV3qPZeIkgtLyzVYhTSy4qL0iltZ6TomqDFOThYw6G7h0TrMM7cHLKb92g/H8QnSy7VIXiawaasuHW705VnTl/pN7+LM
+pTqtTYXL5NtLVnwze9AULm9yjxbGaBYZrh26CcuVG6blFHeVWLcv85XvC6hrq5hJ00XrIabIzezmKJgWl2Z4oSedEr7E5tSCeHgm
+t6t0bh2bsDYfsEu2ac0Xu9od7aINWSkPafVDnvk1sOwg1za1Uu7ozbrbcUMB1ZntQoiV7q3+qYs6yOHuzsHu0enz/dPX+0enb54e
+Hp4efbZ3eLp/cPqT/benX+y9fHn6bPf0xd7B7nNyp46qdbKWTuuJsZA/+OW1zgM7UlJupEoOXuysP9reImwExpFSijTzbw5e7HQf
+rm/XGyjrCP8hIy3Q5NcV1OUV8zkzVRH9QPICedPzOw3vNvFPWHvKFv741za3eq2WpMJyq+/Jx92tPumghUrJwX7Zw37OMgNXbEm9
+lUovlxzld/JCz0nyuJ2cfXmM3m0mJ8y1x5S0YmXf6YWF97pWK6SF0+nj8CS3SocX2nPq5qllfdiTbWTbHyVqCheKwDAg5yQKwlhl
+zPo1t3uQ7hqLmbxradE1cZyeYCw4Fcn4xGWej0V67vDOYxaqYyl2whQb09Kc9FYKumVPLGYxY8tctVzSaOYeCxaf9LzQGeFsFraD
+KEqu3hiwymazldSO6OT45AP1MrRzALv8HCCWN3tijGtj48xamDkpj6fZXHGTQN/YS3hpBWH3UcqqpcuF+15cvafFEGA/38YJ7UPo
+gGazEqIVkvEb1+uGMQZwxfx83n3MEAPblPo2Byam7VQMpwPLjRE0pi/y8XFieUZwu+I2F2BzkBzA0ZTgwRJkWXi+wF+b3QlZ14nY
+MnCNT9AXJNQsaK10xwlkNxTVuAuWS++WceldeLWxMGGtjCocecpWxJXniV5h+VaN6N0XfiHoRfmW2pHS3pFGp9WWD6W0t7HJOU9m
+s40t9ftIv3fXdELyhG9sN5sJxKzAt61NfHvUwbdHD/Gtu7YGZoPSCJBS6icY5Acinx8nJ36CMXPUa/fR2ix58mTrpBUed9e2MSwQ
+5MBoGlgrhMfYUJnX1jYgc3fN5IaSza11tzAYc3ZZosLLtDwVNCahjx93OzN8Lo0bpXvh8RrE93nypLttVd5ds2qva4zObRSGV6CB
+XORC4CY5+9K/SXwxZ4CKfZKQObgVOD6pWyRTMOQS7AsDHiIaD/URkTlMTfSr3elNH2dFFVO1zhEH6y424AF4Wqls5YFhq4GWF4oQ
+Y9dLirZax84HquPRHB2xQfKA5lOQgGSUyXaYqbCwNcRQHmtP5YDAutXD25y0eWRIrFPFD64jsHT3bT19CKK64jnuPUrePvIaF6XD
+EfILiCmijtebeb6pUxDxClqYSaT0Ce/MZne6PgmW0gUnrO2C9zt0EhAUtdvc7QMxEqmIB6ZO9LJ4EWTxJ7JxJkTcCONQwqUJVMpX
+G9l0IlKPOjmgfRBM6SGBIkrlZF0QV7Av7HgW2bIQhTLfDvXRCMkhdA347KnI0LHkeJpJw4E/E4odlqTW9DBk7pGWaQFd8pllLElJ
+FJ1lPrIbO+T0DTLZfMEKd8T+SpcZX8P+SofZfon9lc5cW8/oNjIhc1pmf9SvTcW58QGAsRenp1zSeb0HY8lIAeaE6e6tdKDR9k4S
+RcEkE8aORJmh6orUGT2b1ePJbk8+LqsXYpSqsu9/MIpwtk1M77RLYrVLYINw2CoWucaiP4/eowc89jqOf+Wu41252wF3y2NO9p6/
+3Hv9KXFdLFtoeM7O0c0HLr1PDkQwkAYSVlfNB/C6gZyGmhyYTubsmlswtCCojaA3ytshaN5p2FDvniwAOEed5y6gS0oLnhPyr/RA
+TlVyDGdcJgMp+I12KnsIb/6YYayGDpOJ35mz2CxAphwSRp40zvHywFnPw+GrZBpLwtQmqQ+pAmdrxiQX7TAD8zUxZDHH6M0Dsafw
+49M4HKPUHC2DkvhAZFL7krQEdOdCHiXqZhk7BkxXaQDX1vYgCkUsPxPgwKOXS09gdJ47VnKwe7j367jmOOgEBg0iaGQALyupZyk0
+BVKPWo749Lx8EUbRgRiI8BJBO6vMj6A3uIdfi0yxfREg+sUMrXBr7vLUZnNZ18gXT/eOYEzGCyuuctvOwzkfI9/M1Dib1TRD79xO
+dejPw+HbyTCQojrmkp8HBRYpj/N2WchjvfgYHiGJX4kgm6aiVzi6rBuRuUIgTg7RXEwmZgZVEQlRhr2bCwQN3/nArsKhvFBpeqNq
+QPoCPsyN1spCSGMZT/s2gProvyFAyYPdtftAZAAAls1pDz7gbLsDsgs3m/mSLM40my1rfuy2Wg/Sb+Pxgt0+COKBKHbxizQYGy+o
+waioLRXxUKQ15fUsFbEpFN2wqxQ4vWH7VQI52dS70VFDzoVUabjBPMpuFOCodVTPbHARRsNUaJ64al1zg0GGBW4l5dwrWn0zRftN
+NWLau0YYBSIp4zcGSP3cl3v7LEkivBagvsKQZROg73fwGLSyKWpuf+QVSUokSlktDiy1wCp4opxhFH4QQwWLfrkNJi/EWCzvjbpt
+U5bJ66ialcFkwsxZX2DpmJ7wSqretOUP+WIU6XEyFNYEztm1+YSrym/q52elWzMnK11nHla7ejg3cz0F58VALk3fL63uXs5xo58u
+8Jat4UqDz4GwNK4lYPOBEUFoAE3iLxSuqGbVSMTKCp2x2xWwbzgowohMlrYVqkZBAeWh2uxqneLWsgTZGAzOCsxVHrnUfqmytjWz
+uU7yk9VuX/qihDNVpqJWPQmHsBa8pAUjFp5QIsfOFld5UU9i6MlNcinSUZRc+eQiHA5FTJhG9vHcN3ifBFOZEENa2khT1GLMohtL
+6s+D8nVAN99fnLEzr8Vdi2fdxieF+mr9nPXNbfxQQb1usxjBnGWLvsz95YXhaCgX9zpsqDtIvaIuwNCTVAxCFEV250wCnp2zKgLm
+ZS2amMu2qjx3iJaxhHtpQR+kzpSAknA9DVklrVhqQw5L24gTMB5pGwcGh3jbIAgWcU+9ZZA3xxFwydFYDG4XoZeyY2J6Rxixe0cY
+qe0dYaTSO8hb9I4wgr2DCqFrhBHTMf2YSXzQvQJPcrpX5ATuOXo5ejkBb3a/H6tjfoRLCmf9CNafskUHLxmGlwTWNBUjXzg4jSHH
+BB2nYRAxNc0KSPCYFuXt71k7BYT+AXgFvq3tvOUC8ZZaxuQ5ixSg3Um8ubGxgcH986uYUlvvQARxMDTQhA66Xxj2gjwbD1ngfnT8
+EQR3loVCY2tb9J4OMA0KaOU86E5JZNeS7Wx6lmmJnrEnuwkM8O1JkeLDDgwBWCtnSToU6d44OBf7U5mJcuJhFA5EKQ3pYpX24UUk
+PliPn6bJdKLf99NhGAdRnqTUk/KW1WsGjyNdyUjVcGWelabNpTDvhxdpGL83b6/FeWB/3YcOwst5Gg4PkivrcTceWm+HkyC2X2WQ
+SvOu1ZWdN6u0SrAr0CmmjlESyy8UilzpYAS4nSgYT8zLZ/mnZBIMQnmNj6bjSTq5CNSUyODsEGznVjpwP0muMPFrtIPFpyQZY3Nh
+FO0XNY2iJBla75lMJs5rmrwXz4PsAoVrblIyGunlV2mvQinSKByHVlqlLgMK4DT6mHwhzt6HgJvGGWHkVfI1YWSfnPRsln5Iq/I5
+4HjUaleGxyocwgkPj8XJnOaXMTRSPU+TaTz0reenUgaDC3SXCjCYp4PDmtRNQkh2k4xi16/VJ//ETT4QExFIHHs1r39TTVtS7Vxv
+L/9G/Vo7LB1q9Fm8mtGYUs8SKZOxf2O/lWpQiaV6VGK5NvQhe1M8l2qCpFI9kFSuRXmevbFeSvVgWqkiTCvXdJRMTD1HyaRUy1Ey
+KdVxlEyKGmA3+jfwN88FL58HaRjENfsVc+pt525X+PIiGIfRtYJ25UzZv9EPebf0e96cfs+7NGd4l3wbhzISWfYaL2l+yLKLJJUQ
+BcTwQnc/TIIYqKjMD+bW0ZH9qXpNzg2Uu8rU62EHTkwv9h5Sdlf/wqfQAuwDZX5gEpNYig/SSQvS83ovxCL+aioqDobKtZfeZ7Pc
+EjtPyw19S51wX+2SOkkVrLpbl+Ho+mkULYguXTTMZKkN45MYBg3sI9AHhiucYsajhzFjyxF6ZG2D3H02Lf9RjhYLeHwFaYHmF4M4
+Nqa5Qg8Hy37zWHLhOrgQg/eTJIzlQrvZvFv98pSrOv2OW2WaqO9VP8RF0WbTHV1u1lKunIvycpn00oKlIhOyEkL/DnNadvych1so
+14UtYLC+OahUFRs5ASuEN0kShfH5UeIF9COksisrUUkU5Ak6m61Mq6nokt6EgfWiYwEaPRBsw/em5qVLaUWSa4lBOJdwJ74IsmdJ
+EokgRi9fyngek1+DRCgc6OQwex289iTVHw0x52aSj7v6+/6lSKMkGIphqXYQ3svc+Hn7kcY43XXKYg8CaAI1/XCDgnU/pHcoU7JZ
+kMV75DeOSStpPz06Oth79vZo9/T101e7p4dHTw+OTnc+e3rQIid1GfSnH/4APKdM+Q2I0G7mbMBv1C3lVZC+n05eJOne8zo9wKS9
+9/zUrbJFOGkFKPjPhHwqZRqeTaUoV8GkZtzkObyaylRIFbcnaOla3Y5J+2B//6jcmU84IZ9UeuJWIWp6UlMX+OKrdMZAXi3/P2lr
+86xQZFVI7dufj4XShdZCn3Dkhcp0JvdXbLy5BqaXcDPMeUNlaAWr1GXA1kFvfamaHz/Vi1bEDknaYbYzzWQyLmZF0L7eHn1CfJGX
+0UrcpZkplfZrVbpS3LHGQtGqkhBcNOzuomkurPSWTbQsTbQsJjo1SmtpezxVASFeCXmRDHvoWSJQLpiN/iGwQWJalXQog8ZyT3GY
+2EgbVAzeZrn0F86i9sRyD3KiA8veKIO71F1iNi2noOO53rTvAu3rQ2/KMkZIK6Z+WoWH9FZ4iEs1elAb9WsSW+DsfG6sSOpgRboT
+NWhbi1nkUos4Z3UfK0sNcwn13igghN6mYpxcCrvVcm+l6S1zl2kRXNbVWS770Xt+CSha7pqroJjS1BPavy4tYhBXACv30mxDV6+C
+G/rg7gbCdeEvISYUannkJVyjpcb1q91sVstLCHBVEAKDO3Gtbi6CDH2OD3eA2QfK/JnftetJ705AFKEDT9MkkVDZ3nNDXV0Znl0g
+RXsi4mEYnyuZLr25LYeJLJ3Tu4rxqHl6uSoA8sB11AZaWN/oGNZ6TTzRHk8jGU4iQeG8K5EmhQuSkCV8CjXCOF6kyXhPX3Y8Qds6
+Ao7WJgBKOIWDXOmax7nbo1aLpsewJY7DEyCGkGZWmRI7U44YSyCcHIcnykkI7eGzCpKBkvwANJrtNB7oOLu6P9Auq2ksHKlyxi1q
+auOOUpUrHdpLCnNviEnsfJyXotXb3oFqVynmWVt8EIOpFPvxzkUQnyuDKCfIogM+3u3Q0aEsagdZMPFSbXyr3NoE6DaEoo+QtY0t
+itHSgcKLkF0L3HZN2oEfkwl46ZCfJZmSE9YfoQHKikEurDrvi1LXzActjlZoBCRiyN/VnOtaXGaBL6q9OfXyG2fMIJjUWoBYwnhC
+6Md+7kwMP7AozKSIRZphFpb3O2mfhfEQLqlXQfZK7wjfbBJZbJJ54WpcKpiZzawUu7HZbDCbeQMEDHYu5CFCCn7aUdecWuuW0gza
+45qzSZJJNebq3BWirVpg6y2r2da1i3k5pzUnvSXfeM18aczqrqZanrTviVvwHAMsVFMrSyn14xVe12JuCu6uRn9RVW426i/OZ577
+x+CFlronzOQuJwwL+U0YA6MWY0LkehAvArjXXrvwwEG9PzGXIb/ePgw6kbRVnWES89CSlSR3O/Si5PwombwUlyJSErDMX/nYQ6/o
+WzabBR7pdruEKWfe6saYQZdr44LB16nzNbG+Oty1aYHPuvpG+pBqDzJTxU6IzESjiV84yKd6B3huzmRmXMyZynwkPsglOae4JPn9
+dC+WIkUjXV3CT5n5ovr6IkmhSj9kYebU7ScsXzM/ug+lUjvZiWciy7XNg977TFgMxc76hmYpbmrvUesPt9SBsP7wId7BL4JM4SkQ
+YAWT4CyMQhmKrA7PGKf+r4Ox89yWycvkysjL8rOs2fRIGE+m6OAd1K0BBRbO3mczTAhSEWAGeE+nQmXQIsjdoVJvtREqiNxiZWYI
+k1lhCmZ5F25GyWCaiSFMjS9YZoof4BGA/JzascPlE2mf/Ksn9MVzzlKRySQV+aeaiQLVKaWNmTfPQi7abgd6GAmi2UyVedaS7sD3
+Keqd5P2JWUhZ4MW0NDPV3qAdXN4yytSIMhvgNxjgyRdt9zMT8dBO3I2H88KK38Bd/tmFimLFF8GHOfCrNWlhNc6OB7aIyt++EV5T
+FTXGyzu+GrfhEoCd9giIb4MBRpRaFeqoNuZQOCKdezceLs2bK6zyEL1rogQvs4zT5Wym2+9gvSh/qF8CSzuzjUVYymVbxMMikFoe
+BimmrHaZyqvDY+auDVcqAGHspaw0lm991RIu9CIBdjMLVegqeBglecGqxOZTeQnSVfyk+ubp+Q/bmTX7rHS/m95TYlOo4+ezZWYp
+58ibGG4xMvFRlyo3S0NZQf5WBAnTD6YuXogU+qtdP64/+URfzTLIiTjnB31RxuK+jhWD11KFeepPSXSeZi7Bzab77h1RiJ9XsrjR
+vtLUegIgfmhXKYLCVVItbalUdlimw571Eq7U8xtI5oMXFK9ib5j1Mz9rD8NsEgXILADvQsqDxSCJsyQSbRmOhZeoA2zKd9tKKaQw
+kmTKMwY7xUVlIetg1Dy7OMBWQplonyrNKDHMy7dPpR6kpqW5YC/bp9gISq73YpnAvcubMskES4Fd5JoCKXMJdb7utHHIB2KQxIMw
+EkdpEGcBZkb7ASAph95K3GyetaeZ2LEVcWgvbE9ECqeYF6gxQe0h1s+W1ZwKjFbrhVbXpnZ89d32NK7OG5CzNsjpODklsAMt7yjQ
+YNejUnNZ8NWzPlltR8WxFxpPuAbpXcM85KRRmoxfK5+oxizLW4lns5W4fXoBt07E9faVemDJZLwVVB00Q1jhfK/ZdN4PSu9fWp2c
+uJ1kaKR+S+/AGsbtWz8u7cRRUe3EPiD6UhWDW18QxiIFeqUCfX7u6PNCE7ZgP6OsZ5RI5hJ8TaxRdg7fNx9Rdg2U72YHpTPrlLJT
+pfS0TdmZIvc6lH2Ay/3mOmVXeP3foOw99wqZzgbIdHah4g5lh8gZeETZjuYIPIUy8PAcErY2KNs3xLbX3YImX0GRzU3KjgzzYFyV
+pID/+XGtWGOPd9kBf8S+5N0uewFMo9e8y55VJXxJIvee89et1rz3zPbMnGkzIA3bUIP9XW36RQJUC3dBMV3ZUQkr6CjEL/lN6Yv/
+jJ2aW0n27FptUuyo/4JlA5C2vkriUCZlDgfKKk+neMuFAsW1oOxNjIV5j1+2nSq91FYGODTier1zzdVEYwIWNpt5lh0teLXyhOih
+dM40knwtrpZ3i94MUOh44ZH1h4Sy87aIs2kqDrGHeJHW3QQlOapd1j9X7kx6O+0zMGQX2ool8zIW6nq1K4OwnU+sXnqzmV6AzX7I
+wjlTXT2cnslUIKrOt1dNdx17X9FsXmnP4GoI24QC5l9cY17RnC3JVdPuYdv4izOz7qVMnY3P919p8CSUXbbDDP2aGuLWzO4j1FB1
+T07ZJ429wrsa+GIDh2tBQ2VsROF70fhkGF5+oh21YXsl1U/8TsHc9PEwvGw8eNImfmHUentbSncFm3qRJEsaepEkqpkXSaKayXnR
+hecRtRX7pHEE1r3j4BrsYQcBBmk/u25M4xDvf2ESB1F03QAxEnRDXiXoeRz4RiKWjUEyCUUGHVU9UTGCkXRhGb8s9ewZu8G9j66L
+wxx7T/mV8eMe8GkbjAEHIsvwpNOMO2+aqypo8jTgT7VPRJCnQG0DVduED8o0ExvyiY17IPcrbwgSVywyhiJVcgWIiOlZFA5y1jsc
+BWmzaWM3pWcyLrxGvWzXIRpvwDIWsJidUzaev6zQCE+R4ezFiva65qEXw/FyDcbxiXcNBwyaOX7gp83myqDZXDlj7/nL9gIE4mUs
+Zh9YQO84LidgGY7oPWXvzZ6vSOeKoS7ZwjlVBUK/+vE6t+WBQQ8bHWIiCIw0SbMi6Q1QOqybX5GOMNwfalkUBPebnLbpznNnH+iC
+9sWxrOC4E1bBjIryBH48aMTV0KZlnJOwAAVPOX7e6BJqPKRkPPSUYPh9exDEB2KaaZk5BlxwpKZgmCUGIAxTkMAyExA4cy8V79s7
+n+3u/Pjw7Ss83NWB38sqErn6fNrNRDuZSpF+dvTqZS9zhah1xdhUFZxwwUY89SYsQhvjhqcMZGiDtCaWlvZoda3DRq21Dm2Rd3HD
+y0R6KVLIFdXm6pWJY5jHNcLGdI6OZasf1wllQZWsV/GN7khE94btMM5EKo9SIVQkSg9WX4UtQSyzr+yEK2sjreuhezF+eVdvZF3Q
+1lf0ZaJpvJvP9g+P/A7b2X/1Zv9w72jX77LdV2+OfuKvAZMJZsBf4FSDY4RTVCsS/aStSoXlQ07Qfs2Ro2M/Ju2i3aSNXUHATD2y
+tkUwyruj6nU3Zrex6UFCBdVwO8xJA1XYDkvFKBXZhUXPuBzQtF2piIv2B1ZKP0omXLSv5x/LTa8qh6Fm4nqHKHY3zK70yx6b+m6C
+pCBoAV1K5QUaq2WC+jrVU2+VQpaPKUn9Y8Hkia2b2gMY+ehxwedKv0WuoA7ffYEOV5XbBUHnHyuQKPyoYQzW/AR6rXcx8BHDAth6
+VPA6dkERZhqyAzz267L56jtCPKosGaFnHsJh82OnLb/9Js1mCAj8bSae779CX17IO9f2O8AoXCQP6DsZfRLGsUAZBZwSeR/XlJwA
+9EM+dtaNLFI5jrD4iNZ9umAzxi53EUQFqP96lnwwwoA0GIYJviwQIJWFicj6PMLohmnFh82yzFw6LLJbMrs8gKxgtBdOfTyJgWL6
+RMVGEYP3YujrasA8Wp2rRhh/cwrIVdVu+BB1eDb0prUsC4FuMqG8X/bSCtNllmaRNgeLeQrbn+iOEl87GSn8RrlRpp6LbJCGE7iT
+SscfkuM4JAINDPRxIst6HTGwJav+dJDOWPAlgy/eIucyMbuxHN1klhuVspsbOMdq9Dmx6cLFh9ISc2YThyMY9sTxBQKOcwDGbozA
+u6b6qNA6q6l0jiZFCADgQtoqDsDITAwDiAeFFvZM0fdY3d5IKTQMK0sv8nDTPZc9t5IH+o/aCDXQyIpRBZCF4B744Zm3CGwKml2J
+sTyZq915KVRIF43LZRiiGyM7o+cSNNG9sFA4snER9BzMuzVbUnWYNHYA0NGJucLmjTFqv8E18i+RlmyRv9Qmxp6Z1OKeOirGCXCe
+b4Vmsy5v8b3E4741e6pcoOQFKogOl7EIJFGQZTTmkfEdlRSioarDRnN3EAxof0jt1TvAmjabhldRpKnS4He8N2rx1Mvap8lVLFIQ
+V5LueodoQmban/qmDBvReVVeoMjCmA/aC8TvXgbaGyofOKlUYZXw1csoi9taoQmgdzbznHceK+hA7flkKEC/BMtPvEwR35UOgcRa
++/Iv0pz+OUoAIDPGIXcJy6e20KHWdzuM3dlhsS2HUBoNcUnpQSlzTRXLd1NrcW2uKZdMG5sboMIFLNluB1m9imfbsUyIjKphjrI9
+AcZ/mTexMPeU3ehrqgyBieBwW/0EAxvdlQSvJRDupURg6Q/0V1bCY0VenPiu5oAxyR0Yi0SlI6Z+QSgEz8S8rEbJIIgIpIlxEEbw
+ME5iZWqm1heegL8FYVHhOUWFATDQFEE6wIxSROrnA5qymVamKSZfCQEmtfe5BpiL2dqGuph1t9Z05K+tddDdcDnKtpjFlhXixm82
+Y+UM1Fw1m811pLnMPdZReVTJSitMAtVtEYxcznupQ3nele6EGI/VPq877BOnG8LpRg+wGTAv6JzSe5iDLzLvrnNMa2wBBBjR9qO2
+yAbBRHj4Sn3LjfP6Fq3oyRoWyyivDpGkHToUQiKTM6VZhq8Yuk5pLRXIuUAzfGQjGHzL+wx1ifYPfqAa45YXpNhLmGBE7ZFBC8bd
+ob6kTJ37F2zIxhyiwJosvmxNenVekM296Zx3eueFRdt5q0UvuDg+P2FDftlKvQtkIrZ46EHlMdOHyY3iHE41maE1xE/ZGTceJ1U6
+no8ilmkosrzND7zTW/FO+Vk7BtRJaXuYxKJHL/ipIpvztj+0WnWtN5DzUlcFduPK1NO7aja9C3513FXDyZf96rhzQlsTbKNTaoEW
+9gf2kqiq34Pn3F1eBDEIPAI4P3dgqqhWdGC62yd2YBQw3m7ckJZtyy2oimVDWIPQFpkTf5e9L1z3jUsHvsUONQyCDqo0EqJYnxXN
+OS0/7Gx0qDlOHnbhODGCvrWNTWopB5M2gaOF+OQe3JfF8Yxv4uSL5Ayo8ZtMhqNRLLLM7z4EZD3GECprW6DSFMtSjrUiR3djzq6g
+jmsnx7aVYw2Iz3A0sjOsda1GlPXlHUIQLxtWwU2jtuP4uzp4BUfKxcSE5qmdXQBA3gCncpCGZ8IPHa9PtnssUKcADvKSLOfaX9Hi
+LPP7mUnWxUfWKhi15Kv+1hZpCr51nVe8AaTXN+XAOsI4vxb0Zml07HszoQpBsDFo1YIdLtVrKkYZ1/ap6qaV8ng2m9aYSnwLdSUe
+vbG09bdcSm/j4YbemRsPN3Hbgvy+Z6i7bheiyt4iHi8HAER9evdkrrqdr3WOW5yYzWbgke1NQp2hGYHzoW6lMFFvNuvy5SJSzCcZ
+Md0jpfgs6PRHK4lXyNlSpS+KvJ6yhhB3aV1o10KqJKGgZZ13gFudYaGVDgCb2Ck2P4SHLPOsb/aQqFMozMBLXmnpVjoWgrqxdY4d
+l3p+OP8IMvyFTqvxpc3iW4NkpDw3BCYtw7KlhQv743fvfuMH7R+2+h49fndyM59B/Hjy7t0PmsTK5VY6y52U0PYP+xBG/t07j84w
+3li7pRNOILI8+UG3/cM+HI4/IAp7aH+mOZmRhwRA2+zQwiW5dK42VHRU1mCSSmC79zzrDZBVljOsw5Lxj30Sg8yp0QAvw6TlAYn3
+Nn4fJ1cxoS0P5OtegD6eMZYPXn3yKfmN9g8xZv7JA7CGbBEfskVhLJRPixahxI+hBnXj1OG3Y0wnJd/aFbqA/BIGYiP+HW+25JdQ
+Ybsmu7qZqx9f/biKfTotDsb28Ou4pizmh3ATf14UV3HnMbUQHLFQpewDL2HvubXKKtBfpZKQAl8uZihS1nGOQIsUWp2yiA3YhI2Y
+Io815r3kijo6L3SegP6pnmiKdAZ/X7UH3qtgAmrdr4JJbm/8KpjcwhNyciApqOsopdLa0odCQvZDIfMmD4W8pUknR95kNZXipjin
+msIHpPcqmID2mbiCSnrT0nlyrWP6gzlrVEcXXWuFCzCusD9fawNn/DSpUeMqZt67VvQyML/dSP2nGIoSargopVuVD5dXfppXXoTy
+P4PT9AP8uaoZE2kjK/d93XAnQZoB+8oTWvwMBGO3Q+eVmVNo5wrA+wzDipQnUCGqKwv+z47lSXkerVyafaxy1U2pffk4oxjs7X1l
+Tq0KPxxLMOYsz261yQ/Y5PCWJj/kTeLW3IUQF4f8JokPhWJepKJepR6RdTybjT3S3QDXLXGOrLksuWeRtnuWGytURsIjiKGd6GoK
+OWuSV1bDOkjaQiEms9esBKwHdTDaYYaevMEj9NgjD7tW5cqyAQ08ixcuKCveVGwKrG6NsND6glIHlsRKZ+CVw0Cu6MpMQTahe+dL
+ZurwY8W7gg4xM1QfAkaZToORpSKZlHs5NOM0jb7VH+padRcIolPndYKtnpkqYDaBq9hF3XcOZWmtsyzqAyJJGWIWEzrC/Z3Ei7vo
+1txsWhXCizXoVgtrKikPLapKUQ5O97q9cveADJnvGh9JczaZpudCt2CJlTNbDgRSm0NQTBOXIpZvpuk5uAi1wpN1euLxroFxATAe
+ervH4oTOTSq6GyrWdsEIjAccd1LQxGgnd446eP90OBTxcDquqYYQS7Mu5oE6zIUWAvRki8OhLPITGY/nXE5jbNcujVoFyyCQb9g+
+HYqz6XmhFipb6sR3uvPseu85cO9Z3Qe/PoIEIT3Ro7LFgSRhQtX6Rq+WrdStJsFslKXzhxrg1p6aM5c2qSldonaKiiyfJ0WOOzRv
+dnxeVBNO92gbYEAtW9885OtQ1Gvm6g59KnCPKayCKC4oymJeHokbGjPuxwaOiiqPynbWywZZo2or/QopDC5oWtZUvrWw4u2jdnEo
+lFc645mPvJkDcR5mUqQCgWXCADG8RluveBikwy+CNA7jcwRnv8JPX8JtSUUwULvA7EWMHVXsrJAr0tjsLLhIwb6AW76O44ShicN+
+DW2tzg1zgfFhnPkLfiouLfjResVppL2wZ0zKnKUBs8bSJgyBJ+IQ/yFwR7JqvzLdr4hDaAIDGz1nONOi11E/Wtxr+FjpNQt5MLc4
+U9XJ9tD/yySZ3LqG9OZOq7cbD5vN2uSSoPzwTgKmumvA9fgsiZpN9Qs3XPvZI9io2YaEzmZbnW5n/d6iLc1Ovqk/hPKLOSuxZ0pA
+P2dVPo9TUZ7hQF2qD6Wbw6nEcKrK3+f03sNb6d6jiOWQFawGatWbXqubyCID+dd2+LHZzLGXf1Yx+VuELUR7kqI2/UvtKINaniGd
+D6iiB94gkAbJms388Vie9A88+5X27bf2NM4uwpHEOI9WOj+Omf1+gj6psZ1KBK49vjebxR4IKgRIzUzICkWZvwKB/sqKsF6ZtCdk
+zzhSsfKX3mczqCAVwRBUhfbzdOPRQrQvwvOLLwIpUlChZmG5gn53y+9urW9vqLAGTm6ezmZo6ttP/ZDVfEerVPA0nHrVr5rLeoar
+jZfuL1RK4VQS3ibhRNjOFfEdzx2TA1zoQzyDIo+Ih0im5m+741BKKwkmBIqY9+w6HiALHl5iIYYHesaKSlQNlXQztQqg7DpTkU3H
+4hD04KeR1TZ6gEyunRRk++/GgwR7JcopsxmZytE2UdmDqyCUz9MgjHmn6EQYn79KUmFVOkiGWrHPzIOuH5NE/t5sem9nM+8tiOm3
+tmhbMUyfq/K0VJu4arz1irK0VLX1xbHkxJ1qgfsKAoQNzNNccd+40Ohpv0F6hhWLX3vf0C6BnCWABQQ1xVqEAJlyv0PwwlVaLSdM
+mEXKS+h3nn+hlL2wVets01Ft9JZY5sbOICw9JkkDGxjHnmCBJXjO4A7vZXziBUxSyrK+QEj0CMqZCMuoHzjbHelbtYOedPpVe2U5
+m7kFFsUG45y/Ljhms5kn0eyCsrQfWFuq3KFC2AVNi2Cc40naCEZSpA0RDxuIHQml/gAGzCR6Fw3Uvr29RqR7THW7+y+gIs+Zx8AA
+LFi79j3J8wSMLSc8SZk7DR3Lb24/71WX+qBoHtg97VJK/XQ2c1rMvT6C10PH2NfY0EmDp5rNDrd99K5IRD99z4x7GMgA/QooCPU6
+MDyTH8LK2tjZN47JGNxnFDK1j6Y8TcckZtLBb83mpScoZUqd3rEtLtTurPCmtmZHAVGF6yhgktvTqtXXLN/RezHaEjbiJF5VVT1Q
+PWwMLqbxe3CiGpeMkTUVJRR8YFQyewzQppqDx6XjTB1PhROMvNoLW5r9hH/aF/xT3xOrq0zMuHjy5EnXPKyZhw3zsJ3n2WLAlGCi
+qHfo6uc85h3VhWK1pQbyjl9aR7DuFn0LSnJozJfwQgTDNkCH/uKbLL4nnkh36Ki66qTwC1xp8biAc+Gb7hQ1ydL512Ed29fvuPAV
+rsviiwkOnRYyJp0AmTzNMTOT4NVA5S3gTefSHOsddokeo/I+XTpew1xUK6vHefksn828rz0CiSaFsHwdaDW/ktXjnn3jnTNB/XPU
+Vs/7A683usqG6Q6B/aw2uJW061DCahfeSPtAB8zrHvAd9sY7ZQID5uRFTysB6w3jbCUvjrgmhy+zfgWkPa7C0NceGQfXZyDFHULr
+OJxGh1jIicUW6qQ9ajVd6ni36O6ZtQFrohqVFvFrjwDZtx8j1QOrU9BA1HlrNu231VXNR7UzfOkJpnCsCu5syMeVDq6GDVgf9EKa
+3jRi8UHKcPC+ZhqKUlfFKjoUoF7JElUIq/m+vJrvS4CgQFSVrWkb5qCO3HRJxQL8IKcGPuZCRA4pNePaXbLNAO6+9ghUZW+enlW7
+iVKtKqY92iuqPsyxio0tqcUX6zlHkYMkYp6jE01p+OhX40mB6DwLIRX4VGmkEeqj33CTuhDrFmepsScz/WTFl0gEqYdq1zueyNNZ
+gR/t023H8U7ay48NWUH1fS/ldmoWhQPhdVANpUiuySKAWOGCc+dbPkYzYXH/KS6B/xx/mGVq9NT1+ASVsJR3MQQnVIZrL1Z5aHZ9
+DDq54oPMWWEqHwu4eGL8jvbNg4/UcADh9c2nsMUTH/7YowS4gFYCSm+c3F6rlTLVYF/1Tjfv6zfZlkEYKQ1V39OJMVOdyhsJKO2d
+pSJ4P2+10nkOZqqRVZ6ysJiR5/aMvG4HUZQM3sZZMNJCfj1JIe/i3KRqzgfJBIyEmFjlqb0KvZSn7nylt85Xomtj5nhcFazDguXT
+FLLUmab09mlKWbp4msLqNIU2dO8vwRdIMphrCi3ryX1CRJwftx4lOvR5I4mRbESFj4a6DHwCOKa4jiCOzemEN94rJplzNr8yhnN2
+GWXdoroD6swOy6DDpHXFNPSDB10kds1HlRDZLM1r7cWPUwyTDWIkEIHD1c+gN73xV7soJ3qDfnG2bV7dFLHfHjsAF4fbm7Q3bR84
+t+IMM3yJ3MiNddrehfuVGkHKan3einbuRNaTVPdyTtkL1M97RNlrVNJ/SNuKHceecdl+G8ZyG/UJrNDlwHh8iaq4Xdp72Q7jC5GG
+GLlsY02xmb5CVZTuNmVfGw+tX/Ovms2v2silj5LzfvFobnmE2kxlFXHzLftCNbRJ2efY0W27SW/KXqgWP+PH5vpIBlGSCcKIvrgT
+RibBFFP0QZhHnSpZ4E0tJTeSc20I2sTVmMMVbkCqbItmsyax4ARVDfNq6/CWVsKV1d60HGwkueafmyfn6+k01sn88/Y0rs1iMtSE
+z1GkO+r9K6UXuyB8rFMCAexYHUSh2uYc6yudGi0t5eMLnPGXmGQMPE+mNldLcMNthiNNckJA+oaepY16JNznWVzqvL4+1+m85AVh
+4ExFQnELh9kbdPhSVRBR4UlqllCTSG49mSgYgjUduZVjVwETh4FXn6lg4+Fntek+5dvr29tbne3e1LHYC4ZOvzSZTBgKnAvdINAI
+6tkuxJ0mWczxMOisaC+YNYxWdaqhGb3LuiiOEC7LF39zOTVIVnfPb5Tvebm7RJWf1V/X9xWbz79Uv8wEHVDnLVf3fprnN626le3b
+hRX/3R0SXHTgvbg0spQyhyaezczTqijf1yi63ISYfx5ReRqRyLKGvAjixhXkGgfpe6iTmvFCdZrmBxJzpcv0RIFfogSZdYDywFF1
+qq6DwwR7RyiTBf/LXIgLzRXrql/iJ9igV+ZR0LyeLrMvPzDDGMtFgVKYa0ly8aTTV7cHJevUfNWwX22YCQ42RDmxIqpd1XNS1+l4
+RcOgvjTrxVSrucJBoSMXFBgeXkjBxZeDUCv7xipUZXiqSaKNMGtAwLVwPFHySoGkh4txw0lZAd68NfT71x5J4mkMOQlsUrS/kiZu
+EqhDv8WPQ8U8yl9h7hLPjfika0MiCGUJQ8/6nqjvcBuKpxN9VQV/MbnczRzLUd23URiH2QVhg7qPQ8UDuKz7pmdwWvdNDxwYq6Nq
+wXiIy7Xgy6Tui1rjDDRr4SC4sG7bwJI8BU6zdXqvlJMQxnT2S8+RDprFwibAQ+JKl610kUo1/OvZ7Ho28zxYugtLKNZs6jdlh2d/
+etJtNlehkiNPJwNp3GyunKudPQrAGgzrb6QimyRxJlhDE0uj8llhc2CWfGy1oPdgsNvGmhwwmuYD1QsnKZt4yxcW9iyycFQShk1x
+to90hDH0plqZBV4Tz+HW697oDMvB1ik6UUXzzTVqq0dPOy4f4eHHLmqpn+wqBNULe63ozSDIRKPjm9UU6trVw+Runnycr+SJzmAs
+sfSHXCXPrr3FEc9DQmMACfyXh41kIjP+y18SZueECEzQ/yH3IHYbwiCiQJh3pLcyOUym0noTacrGfNgP/UnvwpYUvfHG1B+1E/C0
+oLbVGGY4ie29ia1d8jNvRHvqW77fe8qMc6WL+8EcASOVyezFoAANhQZUcza2USkOjlEApPowouzCkGSK74bzlHPNRprV5lF0NukS
+jiUs7AZPKVM+NwWKxVgFOfvLggPL0Sd87y74LtQC6E8YSNEHIw1PmDTK9IOWCJcE6QUbtAtCVDMlZmEQemN1dCt/G8KEW9I1sdDp
+WO+ureU6zQnv9JLHYS/BADvJSX0P7Jgy2sLsyJM5Osuv0pzzQE+D/trOJoqFwbrU6dAq77LyvOIJqAagf8GQli2dFhcWkrhWCf6F
+k8X2uaKDnSlQRtcWarctvjBoisOAY+H8wuRW1Zh1qrt3OWyQtKrNMJt5Nak8LdNHLK3TkEhz2jLNqSxNQftvvA9q2nLRaexOYDDM
+tXS4O7FONjX6RVFUa6+Zwt3e+c4Wtizgytj5qdUtEVtwlNWYAADNA0Fx8XvD1AZIdckyUnb7QiMagkoJXVaVo66Sl6gbAXiCujOi
+gqXETxolI/J2Nbm/9ohyLjVsKJpQWiL4XFKol6YkG0R8Y+AjzU+s1GIxzM1JoRB93nZYblxTTFbrXmg1qKgnsKIC+aUtqS7I+JyR
+EyoBkZ0L1bY115/e6B6GFAXdaNqYEzlzmmO2sIExDwop+UWYHYcn9V5x8IOns3A7xHlVXKZTBKijGad4QXqOHisyOp/DQEv49TPD
+kAQ0i1P62XFyorevCh+VMkiyXCGVby2KaDMzjh+RdAN/SQgsojgi5ywFwDsFPgxsZ344pxrrofdtjMy6Ru9nq63UoEZSpOhEHhzO
+W72znAeEeXzEXLOrKGH2ijQp9gZCQBmcWepTKgV0FMqJjmLVothzwAPPWzLYt9S09jCdmtbxoA0L5cVF10RFs5tQxIZbbgI9NWQ4
+FhmBBa0MInUHqjdBbG0CjKlhxPToeNWEzCgh18RWwPGSkoqGEUQ8Tio8C12TV/5S0hCFuahojSWO1hjk62WOIzONLd2ZRy6Y0km0
+eVN1CmeVg64uj2FZLNQ+y5vPmbh5Cre+LlBGG0XT7CIviW9cp2rcrmnaSSoMWbtcIduqqW89e9YejMFRGzpupXNw+SQhhkytAq5c
+AKB5iLSF8JQroboXYwwrVN4sGAdWY96yvIiAWjV60jZlGsMkFo2rCxE3roxGaWOFg9gcagqdnXe/+jIZRlHDLk9oQVlYR5blV6Sn
+fI8p3cepEZZMS8ISViR4CVxmkuUcdSe8ojtdVVSXleoqkZ5zpzELPMtouTJXRd4FjKpS1YhweH0U4rqhqJXP0RRzkJhgaQkHS7bi
+4tXcMrKGIPRSd6Jms7CEt8xp/zis4K2iQq/8cV4ac4XrZ1NbpeHqPSOtYTab5u0MqSl7eH3PfS9wVLEudl1MuhPGZOkkpcAjLYFP
+pzSeerGQVhEATJrV5HYgzp4MqZwQKOShbuqAbO7pFKtovV7IdIsMzqi+l9l2NYlFIYTN2Sx0fH7JPgwIdVDuXCOizPwiFngJM7eP
+3seIAgsue6ltb+lwrHI6zdOUsbNeYEgp+h70Mma4dJVm4mUDhGaoD5rKnnBOldD7OMmnIdpKGcpa/hVp13BxWcdo4CMns8tqlzoe
+Lv3o9Nj9qg73JaWdSe7SGn/BZVZp7qTH1Ti40cPwU5ZLhmt9shRFalQPbtnBixzfksIvEmE3KubuSmduBTld4JWK/MqvpGI4/fBg
+7/XeURGTsU90kFicpYa5IWGwDzFsyKShHI8cyiQVxCdgkRwm06yRYX7tnBTdkij3qsPpQKTkY1xtOX6wC0vn/NKWiyO5cWDHYt7p
+xY8Fao+Au9wiM7ghrhE09usmZ+537SzyuHPiy7YaildLVtTcMz2pr5mKmrPumnDfnct2cF/XVYklpZp69Oaacw7sjmt+rjWOaImd
+bzBtKaJWvS9XUSXtdj9MMNJ1w+i/wPqfiUaQD7idB8pY6Zj5nXoYr0BxJkqsD1CIgB051SGCIERYCB5Q9zHQxrVhPMasCzH/3fhd
+cKuJ26EXtgMKynqV/j7FzFkDItVDPydREMYNxZPI2o23mWgMMJZ8YxwOh5G4ClJ0G9gI4FbSUAHWsraie3MWhA5mW1G8ylsLrpGQ
+uwguRSOIG7lztAaBgqRh4uO3G59Blutk2hiHWTYRePUM0ORTBrHsf4INn1YHdqC2UdGW8fpm9xnMd09R5ZuPvUtwETEKMXgOpHbn
+hcX7Ob9mdV4xvA4DlxjUy9mMomRdcH/IASQAqnN6BLUANOaCTbwbqM3P2oCP5o79geaFMckH+eWBW87xHLv+Qo4LCBeaRh+z4BAy
+gg1ieXCsHYBlfuEMIjlTMUTMCAxctYsbTexRdjONi45JtAWeM3EctIOTBTHJ5kzgyTLs1Rto5lGTQEURPf9LJrVuGGX5XTFZtDrJ
+Lasj4gtgENQvTW7OklJl9fJxEJAuWX0V/0iwSy7ZOZioX/NzdmpJrcqgwYb8xsC/P2HFdA8KT4cR0x61NNz5ozkbqlW4YMN53B7C
+2Xnm3P11cxkengFPeyY2wvr2I+2ItwvB+QJQmoUQkxm/gR757lE6/5ZP8tzF5Mb2mnYMjL6qVIfAD7Ry7glug/FyvI2ROja2N2hP
+j9M+smtGnLaDOWUmbzI+C2Mzb1ld/tDOD2xQhQkxEE5SXySxi+C5+CrHwHX5g1KXJklWmy+DfMsn/JblsOi4p8FgKoVPfvZXCAvM
+8z8m7OlZKi6FT/7Pv0pYYJ7/GmHBwCc//80/hochPP0JPO1i2v/73xP2dBCmA5/8DErpx39CmK73p/8BMlz75P/3dyENHv4tYU93
+o/DcJz/7m4QFQj3+LmHByCc//yvQkVHqk//rv/8v/zqkqcf/gbCn52kAXfrZXyYsMM//iLAgEqPseuyTn//1P8S3yYV+fhpNLgKf
+/H/+HiSrx39H2NNxMEh98n9CNfrxr+BjdO6Tn//enxD29NUbnzQhbYK/T2MY9+//F4QF+PSb/wKf1Mvv/1f4go//HT5mUTIR8PoP
+8PUSHv9beIT6f/Mf4RN8/91/ho+RKJLHGdb/j81LEEC+38tfz+D19/NXWJjf/Zf5K5T93T/IX7GNf5W/wuz+7r/OX6Ezv/s/5q8w
+Z7/7b/A1ldCHf6ifL6HNv/XH+Rs28t/ja4Yz/Zv/RL1In/zsb+Dj10Ga+uTnf+f/IOxpcp7EPvk/YSn149+A1MkIl/U/QrJ6/q/+
+PmHBxCc//63fhAcAJZg46NIEgO33/y08wZh+62/BUwgd+a3fhsck88knhD2F/Wb8PWpQCiaTNPmQ1wov4itdx1PQkNSd1o//nLCn
+2QBB7u/DYprn/xnSs/Acqv2t/xI+SJ/8EH6vx3mf4VlV/ncIeyrDaAgQChvIPP9Twp5Ox5FPfgbzoZ4ACK4GSRzGOO3/Hl7V8+/9
+PcKAeT9IEHJ+62+rVzHJwggG+P/9n1XCJAWf7OTnf/kP1XsWwmb4zT/K37BTv/13CHsG71GQ4bL9DrynAJ1/8C8ga3opoJa/9UeY
+fiVgfv/238Qv+uVvmJdzYV7P0vc++fl//ofqUepX6JjV62e472E0+PDvCDsbfjVNoMv/A2FnAsIhQo/+kLBn+CL021npDZT8ocO/
++2/hbZKFehJEGidT2PT/CmqAlygKszxBws7/+5APn/4nfEIkgYXllRC4sJBZ4xsYm378h4SdhecDhM3f/qvqBdHcz//rf6Pepvjp
+r+FLMkxw7f6yeptEOLTf+yvqFcUu8K7qyb5SZX/vb6pXGQDM/4O/gW8yDRE7DJMr6N3v/JGTiuV+599j2tS08tfxVa3jb6smzWr9
+NnTofZAmVz75+T/7O4SdRcHgfZR8LRQy+hd/oJOyr6ZBCim/8y91imkS0v5DKc107o9L6ZEYwTT813+1lJ6G5xfw4Xf+I36IAVz+
+7j+F5/fdNUj/++p5A57/Hj6v4/N/Ac/JAPL/zm8SdhYLn/Cf/7V/jo9fTUMAi9/6xyrhNS7BH/xr+IaPf/vvEvbM4Jz/lbAzg3Og
+Vszxt/45PslknL9c/f/Z+/MmuY0rURz980Xcr/D+qU7PFAFVstjNRQuKUA9FUhJtSeRwkcdulnvQVVlVUKMAMJHohY26oX0hZUu2
+Nku2LC/a5d2WLe8RGvu9FzGO8W++gr/A7yu8OCczgQQKVd1NUZq593cZjK7MRK4nT5485+Q5mcJHwEFr0dYaUpF3nsHIqfsc8veX
+XpHhAMIvY7iP6S/JMKS/+LzMcx7SX5RhmGLZ8FYf078hw5D+4rMYvheSZVFA0xcflqmnIPltGYYF+dKbMgumvyXDkP7iD2WeS5D+
+ngzD8njpHZkH09+VYUh/8ZcYHvshotG1NzCqkOradzCmMffadzF6CUf6ugwjBL6N4RTTvyXDCIFvyjw40tdkGCHwqsyD6TJPihCQ
+UHoQkr+OQZjZFx+VqQgYOboHATIvfSCzYPr7MowQ+73Mg735gQxjL78v82D692QYeykh+SD2Ro7wQeylBMUGpktAbGAvYbQm3b1L
+skv/DiMxgxtrsKQ/fpvQu3AvQYq0preVX0OYjX3YrJ6DcEG5E1k3UO21JAoccvmyDMF2/M4TMjxKUoi+ASgKD7KRvz/8fRlkIo/I
+DeprMgg76fs/lmHcS58j9K5U71sqk4w8R+hJxcX9+UlCezr8FKQjxfsGpELo6fcwpBiixzGyxiVte+8ZjMqM712XEfnlKYxIgvne
+w1itL7zglA/eRSwE9Ryg9hNPYD7cIt77vz96GGIcB/jIIxiGzfDfsVvbAdtGuv8jQnuqzHtfJfSkyvXnZzFdhjGd9X3YhaErOvwO
+pCOF//PTkCyDz0Bqvk3/FD6ksv5nVTiByXvveUJP4pD+fI3QngzBqGXlH39E6EkIBp6M9IpN7ecQg+o//j5kCgXjp6D4x7+WHxjv
+6+jJAdejVLvUdwk9eS9ur+8Q2htB6D9hUPAClEP+/u0XVBjM/VX85Mh3yH9gdthIITfyXK9cx1DPIf/+pAxJhHhFRgLmcR5tKgr/
+5G9LqZq+P/k7ndxHXunv176VJ6jd89preUpfsiTXXif0JKackkT51TzHeYd8/OM8dsEhf3/haZ35fk23XtYp5xTpekknXNTUS44B
+VsE7j2GQFSMbqMl993mMjpHD/OAnGEkkaN55lNCTsA1t+gmDx2GilMNrTUPuwep7+uf4OWEnUx5sn4rStYD9cxoJpBKvm9/yVBhi
+kK5B5179gQqnvlDRkxFyen9/GlBAhh2VihLGL1UyU3ypjFzJY+Ox5xCqQsIh/4RBXLKPyOAAq/+mjKgLCIuvAdtCuD3+KKGaoXtC
+BtWy/RF0JxzyVBZ85nsYV6vkJ5hVRX5MaA3EMDUe5G2ojRl7H/MIxaHnMQ+P+mlP6PjZc19yyMfvYbbtIpTgungFCqSwYuZN1i8I
+7XEpqjz5IaEneZQgFwW9VuFvQ02aWn+H0J4Ow8qVxPeD52QQpuCDr2MY4PvB8zKIyUAohQTYdag97XucQx/e/EjHoBdvfggxFkP4
++ncwnMBKuf4GhAPV1V/pCDTz5h8IPSn5T1jhGHr6fQytcUVzn8YskmaDaNJLNTV+UkYkNb6GEdnLazJbhCLYExhG8vu+JL+pBtuv
+dQRI35u/x1iwza7EnPXyQWBKkvaKofBgWzGpX1PRnE19jqhXax3y8ZsY3ijTm1+ZiTm5wY6YdUqRBaszpCsgsUq6evpnhPa2g57Y
+BgbxR4Se8oZDBqN6+HuE9vPIdyESKHnhI8gmhw6ZTmDwqRcgi0zFIBKzh5+HvMkIBaw3ZTKEr/2A0H7BiT8HsQAk+X9/ndBTem/6
+GqF9HX4O0oGyvwiJEPgloaf0zoiahyeehEBpAKpHT12DvJHgHrKGb34dPkUiQSLx3q8J7bOhQz7+KaGnGK6KpzCEghK0p4K/hGC+
+U/2M0P7ATxD0b/2J0FNqE3oK0mUQunAvijNvvUVof+Qhvj/1mAxjzx4l9JTv9bgv/J4XnCgURkYqbgT//molDeiqyv/vr5e+3SP1
+Qv9aSrwoxe9//zahfd8DVL3+OGYYR2Ffxfp1MU2J35YpSR6Gjr5L6BSnAtPgDz0ku397HSKJDwT2+s8hvOGQf/s1Bvw+M8NRqNnr
+609hWhRuqcipz+OMP0po/yHc1L9BaD/oRRyqffY7GOERLOBnv0poPwoCAPo/EHpKyzu/g2RFVl+CdCE7389BGwlM/Ptj38ZUuX88
+r8KY7e/PfB3znb6SekHxVUsMT3+EUSUxPP0ixnJB8hrgAs6ZqT14EiqExBrK/BP9Le+sjm6GJ7hcOU+9oFPhjeo89Xkz9TyQh/zT
+i+ani4yphanSonBoVvTGR9Uvpcre+K35ufzpN/pTuflvlJJl+9fyoV2K84xfL9JKA35Jpz/IOOL1Xbi+nn6LUDPfky/IuGcAqm/E
+n3zByC+reNNMMnryoUxWws1fvy4ryitLFH2BhJHH4ygKFZV+6rFSqibTuOSjzTAH54OsJ3CTeev54stFxor07xTpeeKTf6gmymG8
+9bJM1wAuannD+JCnPvXIVKqq5xX5Qc3Rm3ksB/E7hPZ5QcRhNXC9Jt/AiFqTzxJ6SvMM8EGHAUcu4Mp+AlNhZb8EIRD1/v7Or6CY
+4NG6Q/4MleswzIBmJIAICw6y48t/ksGB0sT0U0VhP4TwSI7pJ4T2N7Ue5+23CT31ZWwfNqCr2D7076o/VHv7G38i9LQ+HXiGUKbD
+70E4EbjTvPdjQk/rfeo1QpkOfwvCyDI/8zJkkecC12QqBN+HoOJun3kJskBnfgSp0JevEspOSaoEm9RpKUe9DKk5PWIy8RVCcZ6e
+eIpQNlCfv0HoabUTPQ3JMvh9QmG3+/v7rxF6Wh8hwHcdfhfCgNbvv4whCer3v0kobo7vv0ro6YJJhpKBHwrGochz70AUsj3+AoSw
+mpcwpKp5hdDT6sThG4QyFYTMsLNCjU+ocMKEip6G6IWxFwQXND195XeE5ntxnudBxiv5Xv4A8iWAhw8/JoNLRyDyuIqAYu1hKP/A
+PQ75C0wOcPh/uQ4BWexRQk8rtT3AQAUBCnp3+T0kq90FYBYjtl1/SQYTxOYfQETuDe/9jFCpuP2PDwk9rVXZ//GSTJaRD2VkwyF/
+g+AVJTACJrErJtKwK1Jj8syjGA68UAwF19MnEwKW6JnQu9d7WGvqwRS5KlnxCKqqlKHQ+swb+NUP/DXup9DSU8/iZ6VuVGHkxd77
+CGIb+aDfIpSppfQWDJorzHyB0NNKFfRTQpkK/gSCaqt9HnLguN77BSTnQzyNrBjgOwZgXVy81yH/9jykjBzybz8l9LQ8VYA5lCFA
+gpRHDvn7oz8klG31AocsQMDHIT79GKGnIZyoCMMDbU+doUAfT2/Jlxp8Lzit11mRpJfeQNpH57wlLMG7cVG/SegAF/XjhA7YWBZ5
+9WFCBwMfD//+7x8+BhEVxg+BijxO6N1q8T4D6TL4A0Lzoo8QercPFiaVRfJ7nV6zMN4ndPAQlh88ROgg8JDL+xEEVa2PQliEAJSX
+f0boIIwGDvn/wJA04v+B0IFG/FcgnZ/Axf809D/inhkBjcv1F2UQ5YFXoUDKfcYFR9kX2og9LpQG4quEDrjXA5X4x39QYVi6T7yg
+Ikcd8vHvVfgYfHhJRW6FyKsqcjtEviUjh7H8iyqCZV6WEVCwf/xHFcYPr6gIlv+2jBzFL9+UkWPYzGsqgtlel5HbMPIdjOBCeARm
+nctjgme/T+jdCuNhxHo3/B2hQ7W9/H8/JPQeyUD/xwuQLIO/UKl9h/zt2yq5L3nsIcqy7z9J6D360Po7hA51+A1C71HKtz9/H8JS
+nQeVqODrkAqICu1hAFqTm8t3CZWajj9/j9AhoP8z7xCKHOwzb0EKbgvPQhKErn8LQlf0V3blSl5CUiNY1H+EaFKEUDB+/z0Mq53i
+YR2Bdfv+o3kMW3sco7I5lMchhhW+/yKh96g1co3QoQq+Seg9sO1df5XQIQSe+QACeZI/xs4//mtC75HixmOEDqW4ATBBjv/XEPCg
+ERgZUoL3vwGhhyD0JqHDUE7ENRlU564yioCDEaKm6v2nMXTFCF7JM0hyd/0dQu/RSw3ApJfaNwkd5jLePWjwwXOp5K1y0n2S9F//
+Vp58dxoEee538mT1A/35fp6oSj/z6zzlAsxgvon8MU/P9w7AG43T3yd0qFAdQKCo+C9kEKHwNRnGOX2e0HsuOuROQu8ReoIExodC
+4sc7GJSH4r+FsGIDX4FwcA633bdfgojevd77PcR4MRVPyrjckz7CiFkJZ1eCAmIY1wmI4YIHBUiGghtD2mBc5LMocXIjPF3E7vV6
+bF0q6UeezyVzcQ0iwUCSuJE39gPoy+PXCb33xHmp8XgfPnBUevwnZFcKlxcxGUUBFZSAeetpGUMe/UfQrHDIVwgd4SnM3x9/jtB7
+1aJ/k9CRCr5F6Ih5HHfAV/OIEv0xHgQ+9vhtiPAe7ozXfkPovVIN/yyhI7XU3iL0Xj9YY1xciL0eU+MZrSdMywpvYXRTR6HKSLHr
+f4LwWKCo9PTvIBKtg0CVS27vyTQUp/LE9wm9V2lQvwrf1UJ5FcJcDvzhlyAP969GofCC+/yQqVPFexWGQh814sJkaCMBhJgWPqCr
+OvwOofemY5RP7y1OmCCYL6/nCB1tq2OpRx6DSDxiodKMndHSxFcJ9XX4R4T6gOuP/IDQM0pO+BqkyeCPIRWw4puQBoGPCD0jqfNP
+CT0jRYiXCPVRhvi/PoQQ8jsff49QeCNZos4ZOWtfhzQ5a28TekaLAM8S6uvwDwn1QcB64mkI+GpzfpZQHX4auuzD5SDA8n0bIpEA
+Ivn4e4Se+TwyE3/+OaG+5Db+/AtCz4xV42cU//8+ob4KfoDBIStyDP3Q49tndB/G3jCQs/f48zIKTIMezdgDLvDPP4Mg8Ct/v/Zr
+CMJdEuT/8yHUFwc+S5Qywg+VDOOHPckXPf4EoXowT39HheUp+DuvQ1QaN0ADZ+TwAUAy9AGGejj1136LETaUgtHjb2L+XLsjszJe
+ZIavPGHacAesLPxQBB4fAef07isYVacB7/4e8m/4iY+6I2QPJMboRH3Y88j3CT1zFlHhEUL9CKkIgFWJMT+GRBn8CaSqhfMwJKvw
+a5COzParkIqh3xDqG33xFbn9+E+EnlHLCeZGL6c/QriAdeKHiuZ+KGNAJa//RoaR9v5ShYFRvP4LGdnQxYUelzIp+vO7kKjCgHPp
+Og74SUJ9GfzPlyEVRYHnIBFDPyH084r6/ZLQh1TwQ0iF0q9CGgR+Q+jn1Rr5KqEPqeA7hD4kce3/92tCP6+B9QihD+nwtwj9vIbA
+W4Q+pMN/gnTGsfKnIV2G//ObhH5edf1xQh9SXX+R0C94cQxQf43QdRX8rQqCfPhTQr+gubtfEbquw7+GdKgNymHgt4R+QfX/a4Su
+q+C7hK4POdr//PkjQr8gj1DfInRdHqE+QegXJEyeJXRdskTfJvQLepyPErquw5Cux/k2oesq/MLDhAYnFIl/jdD79EH6bwgNdPi3
+EC4U7L+E6JB7KIJ9A8qM1/ow9m/BBxX+HaTjmdwb70OyDL4rg2im93UZDlj+ATm0J6Agmg0r6eMb8Aktsj7+AL7JrfU70G/Z7ech
+g0xVQTj6eupNFR4A4r75hozJyOsyMlpX+xZEAmj9yQ9kJMbzr9/IiGQl3vqFjAn49OT3IYLcMJQ4ITwfSyAEdORVjCA39SMZRG7l
+R8h0BHfJLr/5NUKDNRV+FsLSTO31n0OY4za9o4LrDlnB4Dqqya7LcBIgOJ/TsRRiXyX0Pq3u+gOhgQ7/EdIVGv4O0lX49xjGfj/7
+NITh+HAHMgN6wbAwAEX6PSBqb/4KgspQ7tsqzJXVXNDnfaXZewdjaaKi0Oc+7t5PwgCRC3ubUESCZ94kFLXcgBR3wXiZULhhKr+f
+fF7GPUOrXmJEnjfyS53pU28aSWXF95NGYTVxT35f5j7J/AAtLxEkkCI13EbX3tbpqIEtVLrfK9ILje5j1USl0H1Vpt8dRJjv2Wuy
+S0o9rYzYnvxDKRVPRZ/8vUzLB4C751OyutIwn3xRJpaYM2A3gmrik1OJiQEmTFVdwPTrRnpyJfWHeT0/MrpRAOZrMlUpr3+Qx/KO
+vpknFYVek42IEWcsP4u6rjIW9n/Xfl5OkuB957lyqmYBr/1SpstzjKKxr+vkUhe+q1MLPf+fyklqMr8pU4tsvzcTVCYgaadR14vw
+g9B1HOQVtQwCJa7g0iiEc8SBpAhJ4etdDCuThz/pCArnj+QxlB8fw6hsTlIhlkhp//0XMJIU8tgTMkHxAy/LGLsiVZjXX9NxlfA+
+TkeSIHQLifX6azK5JNq+LdOKXM/8StY2FDoG35WI+/735Ecl0P1cfixLu3+QibmoC6QlPwKGRTIw1tZ9ancFgqmCsAUMdUdQkfI+
+7E7qiBhoPQh6ehWOPJ7q1Qdh6MJbsMmN1gI0AX2c0PvkvvwMoYHcl2GRo1bkm4Si1PEMlFD7FqzZAM9NGVcnp/eZJA125kD34S3Y
+cQJ5uPLKbwm9b+xLzhemXoX/AnM7jlIUtb/2Ux3xeiOmE5RC5BkZ1LOOUaTKgFVSIfIUhq4YwSt5BqUQATyN1B7/QwzLcQG4Irmh
+Ia2snmN+KNPMscLZZlBN+7AoWz7O/HVRQYmMvfHbopbyh1/LD2MvTnCNvPF7WUW53l/JxHLR38ii5URAmSiKS2YgHxhpuRUIwkWe
+QLwNTI7mzB7DdBl+HcPKYBqYhsIoG7iuaFPaqz39CkZQfl6FqjYZN+H65KsqsbwJAOpFVwFtrhFaWFer2EAZWgfYR0sGUOvxNhAH
+pZrBnYAXqAp8FZeb+1PXVRixFHqPljcPA4fDJb5eAxxNPMU2/IbQ+5RQAhRRs6SPQDKyCICnRiinAIHWUn1VhpGUwnpOrqxJHilR
+TXxThZEzAWKkdAR/Acqow48Set9Fhxwn9D6hV6bAeKD0W29jUOq3AAuEQRZxW1I7UpBvT7CQhFrebwGOFJovYKLU8n1MBpnajwJ1
+TgqW6YHgSm8GjaS8r1moaxjVJ6fQM1PB9a6k60rBJWNSgP/4JxCS5xuPYhB68+3vyiBLmIrdj4ThzScgHbmMtwnNV0sR0XzJOzpB
+I/+bOkHyKG9BlK8jtrz8Y0LHyvDv7+++R+j9SCW/DakQ+D2hY22h9CKhY3XI+vRvCR0zL0k56+c+Sd8j9H7W99Ox1mU98gakwDmP
+Ehx+Qej9isA/T+hYBd8ndDyCoTwOPfd7HASLDyGI5nw/wJB2oRn7fTnrH/wUI7mNa25c8g0VXlO26RjpK7MTGYHN4t33CUVTUGX9
++fQLhI6DHhrjfQuCfa50eOMwNrJEfXlWe+0dQu/XVOJx+KDC3yFUmky+QOj9ajH9gtCxXkww04lALyR0k7s/dch/AMDhF+CdAs7i
+RF+DHqdFOPTWAk9ZXT2gZMG/PAbpKvw4hJXj2N8f+wbEUNn2DIakX9RfsSLtEKVi0Ju/yFzaAwpjAq3sX/2xCntBOYb6mpcIDddA
+R/vxdyGk9Xt//UjFpOE4RpUh4WOEPqDkn788hcky/DSkS/nnL09Augo/CWFlT/qUCmuDUlmvtEl8FMoD2r4OaRD4A6Ghxt8XCJWW
+r9BNprRFb74JESW3viI/IJeow9FmHtMHrtDkA2zoCX+DlTH+4evFl4sjv7c+40NYlw4nj9VvhbPK9yGSMGmA8y5G1Emv7E4iWH/q
+fOKZD/Qng38DavoA25R63cshVKVPdx/XkUTFHlBr9OuEhir4AaGhOtpC2MvTrZ9h6IoRvGJmMY6zVEpSRNRpk/ygRvUhpMuzDYnI
+Q4z8BENcB+8RCJsPsKhW938NwmoWAVVHcov/4OeEhkDjnwa4or/X9d9jCM34fgvBDfX5AcksXiM0lMzia4SGWrvxVQir+jFdUoq3
+ICjFZ+yOlKB/SmhYko2xtCkcv6ZylCXBr6lsZVHwx5h6RVecCyWqQUMuUSlJKYKzD3UoxlemK4j/EtLlbishHgidXW2O199XYdxc
+f4j5Ef7vY0WKXr9J6APRXXCTNGwB34VYiFF46lui9seYGg809dCk8w1IRmcsqBwp+w8xqWQz/n2ZlNso/wjjtbZub+MnwwbnGZmg
+BZ/vFtHCogPXUyROl9ZBJIxV9RMzIa/sZ2Zq+ejwrx+Z38z1Wf6iV+hvzMTKOWK5RN7tDzF16pRF5S4fteB0RUKqmZ9RYa1nzj9K
+VXMe3fDM3Ojne/3XOobG2r/Cpio6gOvvT6VqNcBfP5r6pPt4/YfqU46zKpYP4qd5kgHOj/LEnNb9tUiriKjGlxyKv8SkGdT0/e//
+9SPje0kkVmCSBOZZFd7wSjEE2R91DEH2B6zvHGc91pcuJw+XUnRv3//JXz8qfSiN5brE4/Nsg/GEGej+rExHA0djSj6YTlZz8vxf
+P5r+ljcjV5o0jLmQrkmzs2t6Hs30vMj3S59ixlWh5//6Uc2XvNgP5Me8jUeRJOVJucroaZXY6zHWR/g9UkrJ4ffTv35U+lCG3/dK
+33Js+FNeqOj4Y0VPyn2+JmlLXviRIpoj7eNFWolAPFWk5+VldRWCBtxKnNM2CHpBwAIjjpYeH/wBXVtDddz3NNK0MI4CdSb5IsS4
+Qrcw5r2UKTQKY85yfAuVY4bKxaRnl/rG1Xb4HITVdvgtFYZCb/5CZtMH7K9j9Hxpl8OypR0Oa1DbzQcqzBTuhUlPzXCY9FSXv4cR
+VkyxPlJ5Dz9IlhtY5GQUcVFsUTJehZ/aCh+RQaamLNRO8TJiVmLMRXJFufVc/76KxUyhcih9f649LoNAWD94AsGhilx7WoZL2K4S
+2JVyBrnn5+V7BUwAfa/kkAilZ9G1J2QQG31Sf2AKYSFcQmyVwK6UM6hGZXkxDPQmpa8qAP5Qh4H/E1J7h7nLPt7X36+ksSuK3odV
+r+/rH1QT2RVNhkBYAi4ffoHJB3PLz+EvQ+vFx1/GiDQdAZlhQzk0yVE+eEr5EAIX+aB2KAReZ0N/AYTb0F+gexvKjkvWsIEGNyp8
+rzouggneyA/4vwMxxTW++ShG5KmKKoUqjTyslQ4qQS2vNx/DiP76ofqqXH9/L6ObWpgBXNtU6/Jl+QHXpQ5Hm3ksVKLEO4Se1RYV
+LxAa6fAvIJz7YkZS6AYnzLPKvuJFmQrBX0IqMMzfgTQI/JHQyHDTPKuclv7yPKSr8NchjCz3ux9BMHfijMCuHY/Qfk/oWXn5y1++
+QWgkL3/5C3RzIDv09p8IPasEE8ihgj8kVB7V//u3CD2r7TMggw7/HMLQ4DuPEBopi5+3P4Tw2CH/8R6hUeGIGGl2/7cQVi3/EcPK
+8+/t30FM2lo8jF+w7ocJPSt1PX95ltBIBb8KqWzoqXZk8G/PQCpoP8Cm+Q1I15E/YQQB8isMFg6sZzXr/CShkQ5/l1Ct1vw1oWdj
+FtY5mH7b+JQnfhOKMvQVfPs3EC5cY8+irg1mHUneuxBQYIHBo47xvdcxhNzS479U4WhQxMB8630MjR3yMYCT+0NpfAJD49KF8GUI
+5vfivAIxvBYHEFE7857VhB6qU2oWwENli/RvMBIdBvSSrhLXvknoWU2oXiI00uEPMV1pdn+NHwofYBnx8NuvCD0rrSKglzIEXZeu
++39/9g+Ent1g6lzp4T+qmDw2/vtz3yni8sDya7+UKec8zkIxYgkKps99m9A4d9eBXcohH/+KUHO/kh8kIfjgFzIi934My32f0HMe
+1/5mGMXl+QahMQb+RGjMeA8w/R8x6Ed9h7QxOMaz14d/SqjCCLjoImZcMLyH4+GfEXpOrbcXCI1V8EeEnkNP8bcJjdFT/EkMgPnF
+SxCSrsWoE4tH0hf5m18j9ByU+S6hMRR5GH5Fb2QYQ8dYw8uExoEX9taVtZmMoO3Z12RsfSP/BKjbkgF1P9C7P5DRNXVTBYTVl+/L
+WD9SLmlxoBWFb8kI4uLPCQV9ofIh//hn8tM4LMJyQt59W8bEJtT37juExmOZ51wkDaigr0yZA8ZAaxRr9hJkkaLxq/BFrenvQTgN
++w75+AeEnkNx5HeEIhP3zG8hIE+Pfg1ByRs983sI49kZwFrxdRjq5aVYrzhq+rVMUC64qgZDNnnmt0W0EEyKtBJPbZbNedo/yhYU
+GykjxlnXb1SK5Dbe/1BF1ZHWuzIqY1DVOX2jBg5PhX+uwomGIFfnalh5eDqvuFSt9Bx/DurM/cafww8DL5AL+8cyqoj8s9+Q0SRF
+1fazL2AU9b6vYy1xxIWfe+MXCdKo7XWZXUR5pBhWzFOOS/zaTwk9p8ncB4TGmp99AtJhvUDfIfC3pwmN07An2Z2nCf1ntRpfJPSK
+Cv6Y0CuFSeI/Kxx7jdArGse+T+gVDcdHXiH0n3XbPyT0im77SUKvpJ5gPPSlxcHjX5Upqu6XCVXnKssqJFHpDUL/+dLZi84BcoBe
+SSOBAa4Nnb5FqCKRT//hrz8j9LzWaL+IH2T4JQj30ebz6dcgXFhA/QKKyNPOD+CLDL4ng7hzfkOG0U7nLRkOWJ5JWjP9DmqRW9p3
+CdUCDpaUqSqICPXWhzKCpk1vqbC0ZvqujEkpSIblh+/IiDRzel9GpJnTD2UEzZzeekJGlJnTL2Wv0MzpzZflJ4w8+QMZkeIVdFgb
+On0bPugIgkr4iGq/UuFQ6e5fI/S8tnt6nlCuw88RyrUN1FchrGygYDTKBmqigusO6WJQ2kA9K8PKBuprOoY2UM8Tel7r+r9JKNfh
+VyFd6fphgDr8CoalDdQzEAYxagKZYe8CKKNy9mFCubKB+jUEA23qBFOrLaJeV2GeR+Q5JmAO0mGAGfMCI6hMZ78lY9p0Vn1E8EE9
+DKnFyz8i9Pzpe+S9Ixyd5H9M6LRG5nqRWPYUKz5cisufwBeT58YTf4CINp6AQgOue6UWOmRXhhJvEqqPf596RIZhIp56WIUR235I
+6Hk4f/uP70Fq5JC/YdYI9ltYinhiXbEAe0+na5niGyrBM/yXy5L9N8wiygrsLTOt5Jj9uFlcm4H9QGU37MCeUUlThmDv5B8qlmCv
+Gx8KU7BHp1KVZdBL6sPdBsxNoyt16vrUI+XkNNZwhsSyPdjjRqppuvWsSq9Yej1DaI01lx6GMtz6fhHN5+TtIq0Y/rdUbWXbrWd1
+VsN46xeVNK0irCTn+q8P1Yeq/dZzeXqpI9/Ok/O0J/9YSVNz8KJKLqbr4VKKyvYCDA3Q4t+B5IEhtOFfCB+1cQ9MQKBMJADoAVpF
+AM3LLXR+piPaQgcS1BHHB7DEtXHNjzAs6/0jhJVxzTsQVlLYk4SeV/vt65gs99sfYFjZlmCduQQCsAR2r7DBf+unhHKsz5YBlF7f
+hv0xLhR7sOq0XQgsjfKhEsx9buvxW0LPK7kJ09X+/hQkI2WEERuhK2uS0GtDjldVmOtIbnYBIM0x6xqhSp/38m9kkClk4doH/SMZ
+VqdM73yN0PNpwE6xwNvGa1ne+SWhPA20gcW7hHJgFx//DqEXNG/wGqGJDn+L0GRN9fI1Qi+gwcjvCUX14TO/g4BkCT+Cj2oP+i4m
+y/D3IKyY6D9AGLnGX0JIahqhnNqjvoOpMvwGpKNK5C/fhmQZfB2Cign9LYaxul9hUDGh70GkmMYXIKo4wj9BpbDfYa9gv3uEUG0F
++IQMrql7MyCMcsrbhCb5EfdbEFHMzDflB66sj5LiiBtjsJd9/A6h8pq5DgTA+QhqgS6y4g6Pl2U0zMNbaLzyK0IvqH0IUlXwpxgs
+vDrBcgbg8epPCL1wr7qJDBoYqbvInoF0TH0XUyHtaUIvgNK2ensFJlbtkU117w9UtCI9Y7mpXQxTi0stnvw6FIYro35E6AV/iO6l
+UKEM/u0xFRw45G+PqvCGDksdHYaUucL7GEHEekwGrxRhNJr6jgwiinwXwyhmvS6DmPwGhqUtw5MY1kTkTYxpn72fE5oYdvjo1Swv
+OFOXeCWQUp3UsZeM8JbRX0CkcMuHug2Ajn2s5lkMo939+xjCBfJDGcSjsR+ixdOFs3dfxPmET9FA4Iw+C+HAIYfwF68qfFwGpcgF
+qK+J5TX4oMLQldiTQumr39UR5YIn41p3klyRa/3aCyqMOp0XsE/6PtVrL6owfntR9veK1KAAXbmi1PfPqTASsK+riD4EK6LsivEd
+639ehbHgN1REH4QVUXYl/w5c2svfI1Qej6lIYkbkl4or1LUX9IcLZs/qTuaufb1IN47lqol59m/oL5dC1daLqkcD5SOfXEnzoCEw
+XdBS5I8JTfQu8zSETfJRwqdEyFqvP0noBXnLLQT1hbcqOFCX3yaCe3g4kN90/GGRKFVQL2FCmEibuws4ndcB8nJiH8WQcqb+A0bk
+GQ0GcdaelEGZ54PHMAaWWhB7BGNI1T+4LsNY5hqG1dp8/08Y02vzN9gNCXXVE334oyPsStGwce6jC+Yzk+eQJsHXrhnxK0WflKbw
+KRmRl9G9pCJo9QYrJO3le2TaM1RDH8kEQzX0B0L1eakqMX3mWqSVVUNG2eKsVbagDrBkxFAN/ValKNXQr1TU2D7Tnrljpr3RxZGn
+Ra4LKEg9DWvSCCED9+r78BmvxMNEXLA4v/GSQz7+DYbgYoWfY+iIQz7+BYYUuvxRRhRAv4kxedaGQUSEp2RQIc/jhOrVpdqaWmyy
+wEjqrN94RsVkE69gTBuy4kzFGhMRd2KJic/KMHYAMSBWmPjBw9iiwj056KIvJil6qoheKYZUYNp1I36laFNh2tMyInv9ooogpsGC
+z8+sgFXRZ1avyg9cWUwnxZkVxkLFigCFuSofAniD0Itwz/1lQajw+BDH8ezLkApHg29CauqQvz1OqL5u/JeEXtScHnzX4bcgXXFy
+34d0Ff4BoXLrwkTYtx4lVNkbP/YtQgULpEb12ZcIvag4nlcIFSr4M0LFiHEG9+c8DY1DZBBxpuKiEr84khe2fBM/YfAjFcSHC/72
+dRXb0GG/t166uR5TCvajZIn4yBvoSi9Gvrq05xlCS1aHz8DH9fxufTEya7r37PkHHPJv34F0vMDq3/5IqF7DkEMUl+eVTR8eI3Ta
+7uEJlZhTAWhQSg7/9ooKrqlrpGUEEeDdn6koetX+FCK5f7WImKdMJEUUqwu5RBTLi7uf/RVGlAnxzwi9qHmK6/hBht+SYXn48MFr
+EEs8xQOLXNMMU3X+xClYbI8DanCvz/JwcQf6h0VUX3/+pyJJ33z+WDlJrq9fFolIc79dxPOb0X9TSZMlZaMSR1/5IUZYUYPm9979
+LaEXObzwe6rAZojLr7JqnIB3vophIYf+7u8gFrOrUkH13PcJvah3+Z8QKvQuD6glZZe3MRXWzpOQJll7ALni7b8FNSjjf8yrwu8Q
+Kjb9LaHu2xeb0Yh5/ZL95Hfy5LK+6buEXtKH2a8RmurwbyFdkpg3CE0V5fk65JCpX5cZ1JH3W88QemlNuiB/jdBUBv/zO5iKd8b8
+5YeYLMM/IvSSOhX/FqGpCv4OUqGGH0AalH+M0FTfufkEoZf0ofhPIV2FfwZhJfT+mNA01wH+kdBLirh8E9Jl8OeEXtLH268Smurw
+bwhNlT4Qmld3az75JxnWepdU+0o9TGia3xj5bR1hvIiqy+qeg4hym/iI0EvqdPt9QlMV/ACCgbyS8VLYV6eiqzqiD0XfMBLUqeiH
+KqlyLPo6oZr9vP6YCuvbm79G6CV56P+XnxOaquAvIFUt62chWYXfJrQs5F2KPeNGxTT2St/M2w+/kSeU7luEWSzdwPjkS5Bi3qUI
+dzKmpRSZp6LvhbmOyzckPvknIy13YIJpi43hx3HF6+hllViWcl+BVDw2+gZUgKEnZGgkEy/ll6u9JdMlX41DVArHt1Q4rxVzKrih
+3vJpQtP8msPXdYTxIqoQCbouFXd/gaGr4E8gqNDrN4Re0gQGVogmMNcITbXnz08JvaR2n7+8C+kq/B6EpQLqQxkcqKcpUn3x4dOE
+XpIn+YDgMvR7QtPiAsR3CN3Qb928/W2I8JK4seFxdd8A3DGw4fEwgh12qK7c2/B4LohA2JdH2RA0TwE3FDGSubjCDwiiWv5nGNaa
+h0dlrMT5o+hqpkr+v0g2mbepVMnC6WTFe/zt6zJW3q+u/byUqjESdMYPyj36gw8I3ZAr5oN3ZRDvMnuP0AeRFH6D0A0M/JzQ3Bzr
+A0Jza6z3CM2NsaAGnf59lQeNHd4m9MH8ARN5ozOYpmwwJrtx7XcYkfvnaxBWt/Nc/zGU1DfewGSoSIbpokgVeZphiPmDIkU6UhhZ
+LoDSxJOa6te/WaTnXM7DhE45e1wj9EFFyF8ldEMFf0HohqKxCHJtSSgtBDe0jZ+03XtQE7evErqhw4C5xsn0hvb7g5nSSwoQSy+p
+6xDOpVmJDIVEq+NaxijirMCpB/Opep/Qjav+8Kp8Puo1Qr+odKK/JHRTBT8kdJP15Ty89wahXzSeoNHX/z79jgrjNL4KEV/apDz+
+TUK/qID1GqGbKvhLQr+oIfA1Qjd1+F1CN3WxTa7mYpMzvJ4EI1/UQPk5oZsaKM8SulU86bNlPOizVTzns6XvV/0Dof+i+vEtQrdU
+8ENCt5SLCvjCbqm9F9xf/wXO8r9D6Bb8/pHQLWU0CJ63W0rSA4/bLekKBg6yW8qD5XeEbhmPCP2LHuhzkK7C72G4eFpoK9J8HAxG
+HXODL+2Wvsz1V4T+i4bDLwjd0nD4KoSNV4i2zLeEtjSZhRLFq0Jb5ptCX9Jc2OuEbuvwHwj9knz17SeYCvzRc4R+SSHIrwjdVsFf
+Qypk/ADSIN91Qrfx9ve3CP2SgvS3Cd1WwV8R+iV5C9RThG7jNVD/+QqhX9KgeZ7QbR1+n9Av6UH/ktBtPeivEfolyb39mNBtyb5B
+Gu4Tf/kI0nDH+BOhX9ZHEL8h9KoO/5bQL2sZ8w+EXtXhP0I6VPsKJELg14R+WXqL/47QqzL0e0KvMn3zyruEfpnx6It+X4xMN7Ev
+SzHxZciLoV8R+mV5MPwuoVcVMKB6yXlDRmS8/69fEVpc4vvU64R+WR1UvUnoVQ2ZDwj9sobMh4Re1ZB5jtCrm3Ch38NfhVCIwWd3
+fetRP914+Fa7rR6kPB0KX/gsMR4enH7snltMvucq3D7rRX126fwZS9iTnid6I8iWP9AaGl/3/NJh3aPHbZCWrLyb1qHLSevQkJIG
+sdsiQt7qpJcwy97rmI/efqt8rhIGn4YMjqLY/f3abuRv0tOIejRxBU1d1o6j5H5vC55FvVW+w5vwXrs38vjJqM9OCEvY8l1o0Wp1
+xPG0gy+gLkFWK6zNrZ6eX1jCOg+7rhuqpIbncsu3ZCH5mHLSWqJQhi4ssDacDTDe3vACv+8Jdp8frlue3Wxa2EtXtJYoawd+uA6X
+trNQuB5dWLQ7d2AbzaZoLR1Pl0XLPeyIVmuiu4Fv8kbuIvS+2TxyeGFmz5vNBSu88/ZmMzy+dNTu2P7AKtVtQ90dFiQMYHB0UX5q
+taI7l+w1cEmDER9dkskHD0bHF1Vy0Z1GsuC6otm0PLcCCCp2h0J57AoqAAN7P2tkz2jiu4JGOYpQrw5oMOQjRxdc12s2j9whf4/C
+U7legQcKfShCzMPBH12yO+J4JLFpxnS4RSWNWRjAS0D0JTbRhcUSUkQ1SDENr9JL6H675wUBvqdvTwooFc/VTj0tD6/BHrYtTn2b
+8vZ59XY/gvwIpHxRPYwPZZeWboekUyk8cSMTMM9F7oXJIOJjmXYbpJ3zkuTiiEfpcCRTb4XUC4Izb+xyytsxj0QEb/e2Yz9mlYk0
+XmrG1/b18/zN5sISrnZIYJawm82gHXtpwvKAZU9KIAnaXA0KsnCWpGMjZJXe9rd3ellm9eCdbNZmYb/0aLpnfq55i5m11fv+zWYe
+LFWQqFeyU8umgFJ+Wz+dju/u4KxRwjiPONEPmLNJ6Vl36PY42mD3qYIW6XvCIzS0KZv+xD0/JJTbdLoYC/uERnVfegE+p+vVlsK+
+0aSuteLbjNbSOa2ldTXm3/AJ7MAF+HSCdhSWRh2F5khZe9VPLoi+H2WZUNgiYCqzzJJFi5FHYd6GZ8unlHvG09IquzHkctyoLq1U
+l+rcZpyNfWERQHZCA5uyylq2dw7dstAYCRE7hw6NxShpr7FDnMXME42Nxfbh9mJjbbvxT3CFoO8ljVsO/Y8LAnQExkKSubPMeAp5
+ipoy86Vx4CJk4s6ECrf29WkausJilFFmN5timssIJ5ZNRYlt8AdWmAaBizOmELl4Ol1CWriy+5JW0dBlyw+k4zUG3I2zCOQ5XABC
+aIXuok3D44tZFrru0qFFVR/YueYV6ufruUtIJ+zY4T8edt2lZtPiLVfYNLwTwkKF73TzKeaTDltmVhWQlEhI5k9tC9qLwoE/TDnQ
+EWdhkWqChK9wOzNmwhUTq+YB6npeS4cbwuIShPDcvgbyCu/qUpqWr/Cuu+M7nAbOwhJVH52dyUSPjkEh3A98XZb6tAgLm/rtAKhZ
+noYrLXR38jpEe+wyKto9N6Si7ddyhxMq2n3jCw0pt3dEO4KgnWW1aIXZdspgXaIMPdc0kOEYi8MT3qIdTjHCocuA0BYPoy9PvwAO
+dHjgpYGYODUfjUH2rZASSVFCaC6q7EcqoxpJMc8jLzm7GeoxSWBDAagjdgmhwhLtxD12zJ5YK+UqCywIrWOH7Qmd/fnIsbmfj966
+yyvnHWNDNOatAj7m7ChoOWxSbDt+IXmwdhQzuDRFAW5hAVjCRbqpwWxbgpIjpLSnGqWTTXxsa27pw6S05RZsnevxYQr2w0k7YOFQ
+jO483GxuRH6/gWyb/rhyuLtsRhxCOsWASYQzSEAMkGWBm1gmaSixs08ca5FuFz1itr1s4bilwfiZfpYRUpKEQBAiturU8ulybttJ
+LJgPBwhiiRUo0IqQ1rol7NZpi9nmdm9iHmYgB0nLYAiCUg6cztgTo2S5ZzFas1zw6wPemFHusvaYiVHUp75r9BfI7oKfZRpMCyaY
+/Dlg8k35CZqLXN8Eg5qCFc/ykTrQ1MKfqNtOojGz6shKs8lg857Ykyr0eqWBxxADaSLLytlivRZgVGzGqHaZ/CxbUFAtly/nkzns
+nFqnQaBEF/Up36H8hh82uK2jEUZX/C4IbeTcifMn7j998fT5C9BGVBat5TR6LuReibowVd4MhPbmjMmzVU2Ju5PErOcwqtHC8alE
+CqfSNM1n0vEmNHWFlaAAFTabqR5zMjFoxqC06mPWAyZCQoJy2F1gRnLxmpWhE8qikRuu+DhMa5GuFQOI8v5HIHN6YyYYLwCcQhWR
+vTCN/9EKw+oWyvWFetpILwqFH6ZMMkqp61kh9SnDoabATKyk3WX4047TZGSFtgMRdyXsUmuRbhRVcrs9iPhprzeyKmwRX2FdTb6W
+bIxN5USUFe3VVXAK9UMvOFusI3dGepYJc7nRUswlpMVaVthasic26gEa/sDKSWdo5tXTBl1bWex29t2PcE4/2GRi25QUswZoruYn
+cFe6tAe4oWcyhpkUNpFvmDLMHDebME0gueXxhPVS7ottjIN8thJ3XbESd2kgJ6pn4xQmzabVM1DGTYwMNFDTInszAK5o5C4s0bEr
+AYUaN921Id0GEtAvpjywOwvWwLWG7nY7ZFvCsu12PwqZ3YGqZKWr7rCN/GQ+xC0Y4qqNzN5WFzHEhI7ruluy6Cb0Zx36c7quPxfo
+yUp/VqG+zoK16VoX3JOVLkFtxgqxlQxwQfUO+lIhywW1Fe3QGzOUwSEwAaoL+RGQzAaGNxcR1qHXp102GQCaBNsobyxsNpsn27K2
+ImTZeSZ/YK0rJv/0ZDKZIMJCGy4MalJUP4Lqx9XqB83mdl79dm31I1X9GKqfWKk9yVWmk3pmVVBScEq5WAC8P9IKVHEctmnf5dbI
+pqAHWbTphsutsU2HoEyx6bbLraHdEW0/OXviwhHXB346uSBZosNuhGvlTN/1IL1/N4/G5zwxuh8JsptQ0Q7Y0Ottn6l+SqloD5nI
+F+F5b9MNqGgP/LCfJ7o9KtrM642KlBi46oiPvcC/ylQ33EFHImpoHVu06ZrLrVWboqrndptuutzasul6nRAwJQGJCC/6hA1EscX2
+hJ6u1y7nzNTKVy5vdg8NfUpWya66wZvE1E6xlUu1bOWSyVYudZ1cRNobS7lW4ipAGnUZZa5opzywqWiD4Q7QpDyUZTsTeqI9ZnzI
+zoT/nDK+fZbfHfGxJSA/l8+toeFzj8Ui4lhn3QdL2FkmbHoIr1SMPS4uHwJl3UFQohzy24IlwsrbXcFtkIXiIEwj6WaZ8UmpLw9e
+xE8wjD4LmGCNmcXpdIZKJdQS7TRh/G4meqMsG8CPbSFgqLDbYsTCgg4p3oC7J9oJ4z7i7nmWWCFl05mZQbQ4S+IoTFgZYMyt/WIx
+O8sYqGhsyam0o3XZsO+GbLMh1Q5hOxGeSJOLbEvYWsbwVSKog10dcXVWyqe7KEmRn/fDZdSf0Hk5sHnMZk9yRUZZ5jKECy+OA7+H
+a/7QQwkqLF2x/PkLZx+QKnvQt5zW2NlOvAG7L/L6FrvJYtjOhHI3bAeR178ADKHv5oV4s8mp5+5E6w5rR+s05YHDYP6zTFAJOIdp
+CBYwz9MgQhV6OYnFNKrZwKt6MzEzcP0sO2Wleu6sYJm1hax4LYjWsoy119LBgHFbU7Dp2QMuHAu5jHptWFEuo4GtFWvChclI7Y7X
+Xov6266gXjtae8g19GienAY1qXpCvUlJcay36CngL9YCf9EE/iICXxRKnVrdtWJDl608aJkaHXsnb0ashN1lC/66Jzj3ttt+gr+Y
+ZC/DX2cF/nYp/NWcge1gETaxqbAdURJ0c2QtiOaC7trdPpDxhh8mwgt7OgUlQuAem80bI8C1QADl7JR8raVn3PNRdO5FQSCdTYAg
+ewKFaC8Iok35qChmjNydHjxHQwlN4PcfDy8SKjC0eAeh0FQC9jIoDOX9DptNX8sjpIOHBaBCI2tRFDDP6GwuPYUdKRfmp70no7F8
+ZzJXb+HRlbVIT5pSz3KtKs+ZTq3KxBObhs3mQnnuQ3vZA4momtpsLvDlsD32Ysuz2w9FfmgRSmyH4GYEc1Z8dsr5ItB/loR+vWWX
+pS1mtzkDCaEiQikBHMQz311Y4O1k3Y9PA5T8cEgj118OnWmgWaFNPbdeH3EjmDaxuD0FLZ7vF2Il6rqB5S1zRzGVfEJ9m4oJ3Znk
+uTYL+ox8lj8AlnRH9h70pn7Y93sscRaWgCEnxFSC3AzageSYcjcftVgmxJGIfwV4EzxwBa4CcDbSuoryREX2TGVPaoF0LpcYIFji
+stkMCZvFS0DbXpbNZXQSpT6IQXF6zO4wSZhhW4/pVH+nhXN7R5Zoe3EMJ4SCBla0IrryONuW0oqqtGdFdkcxQBI6E39g+VrE5O0k
+DnxhkWVi01FFgBvQwyBIjFYWu3TojlaWunTblSRhqMXJAi3kTj60O+UR+PWKCAV01bPVFdadgHxSh2WrBZYtTuRB3Jp5gqQl0Gn0
+okJuDxaD053FTnicdcJWy8ZdoMCwsKs0ZSCxBIJxq5YuadrRJPmi4MtkmbS4Q8jE2qY90EF2EE/dcWuN5mBH9CzkO1NHJYUK9Sl0
+wyyrbZsKV8z6lKdynUok8ApKDYwmd3eAq+ETu56n5zblroCf0GIWB8OI/QqiVLSTUZQGfTC+BjbrBDJGrmgnLBhorYGWVo/cNkda
+vVVLq3RVyq5SEOwIjSAovJoMuOuZCfcqYQakVok9MMizHEeMoug0CFAeHXvr7F4hYndAQwtsLVBJAtLoUUP8xBMPetrl1rpNL8jj
+E3rS5dYFm57Q490xu+d4dLpFJ57QU+4MqE0dFyHvnG2Ng2zbGwcZsHyHJEW5QbpKPnUJN1d+I2ojHbcW6dWCPEAS3QG1rCO1s81m
+ZMkQEGGCMEN5oR3FekNNdI7OF3N6ATIGnOjBjk3LbXDFNwlN3LX+UdZ+irG43II/vwW/a3fyzaU8Fl81AUTw88UXv9KDsqpYp/oD
+a0+neyo/TW2tt1QpKynuP+WNPlC5eq63knazbKXb8fPsbq/di8KeJ6zA1nouf2DVDFp0bRpKDvN+JrxzIDuwpNmEhSS8ZvMiWsJU
+2tYjy7LTeTjf/ObCOJ6axTiHITRo15DTyEDRcj+YvczU0ji+BGwDOURaDFm9GmaTtUrHa/8TTtf+52LpxO0Qpi0RO98VDhHbYVPy
+qqxxB4y+vX6f4DmHw6g60p9UDHOM3KtDJnT2SeVEjoalrKpX1crhIMVzwsmMwzpVFMxdatopHW/tACYCuyxflycUisqFOXtA8d5q
+wMU3u5ZBTS3Ii22JOaVGshRIwAodxhajQ2paqOboUxTr1xbb2K3YuLKLr1ubFtOcxEV7BpY1hKXluZAyTfBgeZr2Wht1HMJKl9ag
+d7kZmjMDug690GFntx046F22Ful9JSGmVEephhXena5EgFi+EqqVdXCpS0Oj70Pdd9VpxWStII0K851Kk9y6yjo+Mi5cN+vb9mSK
+wDFbn9bNAcKwBgidqFx7ZBdbw+m8Ws/dK5iG9WDqeOVmPLvQmbmbFjdAti0xEBR+FUJql21CpUJgkQLPKgHWCY9zZG79AWpBQDEB
+ypC8mAosFq2tls6wxZQIa34tVC/NJltmK6LrsAk1NXRrFistgS2AoPF9ay51dkACyPNu5oK26rYl3JWurSCodgkBG8QD5hH4jJU2
+1RpU77BJqXvrpeOA2XJADghmqsdO34iU/lBZSs8ru2BUBhU3mycthno/I9PJSos1uqQi8wkzs6nHQpVfke+U0ieeybFfWtx0tCK3
+34cxiSzTW0cek3sQRA3zcV3vWaP9e/PN3k/uYSFD/6y7C0AXpe43Sp1ChfgZhEaxj+AZIAy3KHTRKHQ/Zrd0t2Ek5b6qlJzJNOOa
+LYQ0o1PnjPpVd/SGVNObMzcBL27oOPC8lJoecrl13qZ3g7h1u00fcLl1t03vkuLWfS637rLpFThbs+lVl1tXbHoJZJujNv2iy61L
+Nn0QPt5q03tdbj1o03tctNWmn3e5dY8pje14/b7jUQVbJ6USP5xASj1Oj+ZAdWKqIOYM6JCJM6GzSnFJI1Pp+DRGX2M8YMSUbToI
+PCFY6GzSQRoE2w/oQ0Nc0s4aDcsJW9RPzvFo7CfMuUCV/uEBtumMjAhcWusLf4M5feonn0+iULZ/ivrJSdlBmXAOasPQGeon9yvk
+c+6nfnKi38cq8sSL1MBq5yz1E43azknqJ3IundPUT3DpOScmc63ujs61uju8NPfzHUfn2/Qdu21x7velpTuO7sesr2I1b+/gENs9
+LxYpZxeE11u/yL0eW56RLq3P4Q8Q+kTwtCcibjuYkkA218rPvmyZ0pmj/QkL7Q93Fzv8OOvwVstGq9VCDubdDjYwZkniDZkbgrpL
+gGCjthnoVJhv2WEhnBUHcdRIlcYJrJRkDMcNaXgjihW90PjcGZ9vpXnkyPwJ38UEdD4y3Xrb/LoPz+/bHbcufkYmpHJCP8GhO2gO
+qho2thyi3o85ocvoAmKNudH6hWUe22z4VogqgqCg9ArNtCYSMJKzJAo2mFU98zNYtr6fgKUyHhwPvB5LsqxSLfVRpXUi9pOL3jC3
+vQCOkopCvc/bvcBnoYIOnyjzN+s2OIrgVgT68NA6eptNU9fiVgJawtvBZsnlVmrTnrLPiF1u9Ww6gOgiaJS5NQAtX2gdBS0ft/qg
+5Qutw7eBmo9bG6DmQ98qqedDiw9AtY7fBj8Ed6yHQv1CNdfPg+01P+yjpT+VBWzqa7i5Q6Ms22K9VDB3VYeoX9Ye9ktR6k+rEvtT
+SdRv9wLm8ZNeb8TcDSNC/cLK664UvM154q62c+vK1bsunbnv1OnzF2ZMj7tdm0z99hrUdl5aWLirpSiAgAUxNLUDdjzOGprzTGix
+znzqF2TJ3QGIOQWIFWicOmkVEBIJ4imZObHsAqxgH+kZijC06sQiEKLYDEZH+ZwJ9LlQhnM+S5wdLxWjiPtXWV/m1XEceTIBacOe
+UDW5zpTpGJQpTCD02ql2BVTgGIPDpKo2S7VbSaXT9iyOWp/VdGn+S2vsOXSJqQ+yyKS6xg2j6rY2cgSjBW3MKn9coWPoE5S4QgXA
+bmQyMSe7PHluLfhkZcLVAAJKJw3k45KBPMwisfWx9KhyBCTgDGQUJSLLLBlwQ/yxode9ERsjmcrD7oraKntRkKvYiEMoIXYXyqx5
+CQNmEAvpiAsat8nks/I6gCFTOIJH4yA8gk+kDUnJ1l46fWobd6+wcU9cVlCEBN2HC9ynQdWam65IIFOC7RFKoDVCidEWoUS3RCiR
+7ZCSaSslRRuka3fwECnMsjP5io+aTa/ZXAA1iw99eHCGiZ9tRYaD2hdKZMcqH/jJFcep0VPJ0qteOYm56tMJDex8F+rhoWWzCVWe
+LqqUyTbubSenku1mUwUr55f6OxVWb6Y/iLQML08id63S9DC91NVZLxz1UpavZiPVRoSQiI24ME0jEBVqCAHihBKMLvGABi4rjNJo
+z7Xyyu6K+tuwlBjfYBz2XB1+0OM+sAMJHZXRre+y6f2IjuWMS2NQG9YtuCj23fFyru5yCv0Kzv1QHiJ6mq5SOVTHp0XPnZTK7jg9
+WumXE09s2uOsz0Lh4839iTdmByWBI7kB1c6E9qJoHXeFCfJboKkb1gDTBcZEfZuCqAsekvgxh6Mb2LSw+lcHtMO2ao0OcZDbEi4V
+i1YbTTrQGG9bGe8CK3ePRfJcDdIKW6QRRqIxgDvDia1sWbcL9KJrhQXD6vLOxFmlW7DbSzKx6W7n5KMzBBrccjfpUH12LUJaW5X7
+DujIHcHRGja1rn0cVja7mMhx/Aqwba8HkHG57Ndpd6WrlZL3WmsGwth2kb5eSu+crj3OlztBf4W1/bCbG2kgpwxrUKpG/BAIL2KM
+V4TasZR/fDhNsrg7AmkNSTQIUs3maEWGujSHG1+eyuGHLdImLZnUdT5vyRA9bRvsPYhhUcDamx4PLXJOD6txQBdskQMNP2l44zV/
+mEZp0lhjPQ/2EzFiDaWiaQDmN0Ze0hhHHL54YSMKWSOHUmPTFyMsAVU65dq9sI+fYi9JWP+gHxrlUN5LGn2/jygk22t4YeOAHx6Q
+X9vEpoUGUq/NZrMADcIw/2JT84ukID5n/WZzYcpczEBqaV1KzqvcRh+LsQCgoJsxjzb8PgNkD5vN0Nrh7IozLAh+fkJjbAnOGpX0
+AzyrEBcvVAx1diaU0R2jgLSDHbrj5fKx4AU6tEFp9sVyEs0XNfIvg+Jr/sEuuVucdGdmq9WLS4zPc62Ibu7j17LYMmkShxC7dbEw
+aNHCA9KRCSXE7hQr8yRW457Uagaj+1NUylqk52rMF2ywlhiWjiAN+7mCzstNz16GI0enV9K4JrVboyTiyuww35/8mm0ncg1XvZ2J
+41PPFSpXQhOXEJAUwTVNum55+izYm6W7R2uaid2J237YZ1tnB1Zo33kQfKkTN6Rwpm1+6Wo1zUKCjI2VuN7KYhfqwLyLXZsmeX6y
+Q2RdgZXMdJfqtTf08JpNI7LCuhpU5VQq3bzAaF1zWRw1RefZ8PRWDK22WItMCCVDYncSN8mZXg6IMbFpaiWU2+VrFz6RAR0h9Ia9
+DAihoXu2wuOjSu3sFONPffdfrIKbt7PsXyxuRgmhkZIGsozjL/XcEPcsICuYI9Hbh/brc/1mM1r2W8Q5dIi0rKjl2Y5HySHYVpKV
+pDgyXE7UdSaL9OCS7SSls+/CUgwOk2joHtqxVr4y6bbsyaFhsWdxN0SxFq44soU0HeYrS93CXrLWINRcL5L9m1ovxv5FCJDDKQj6
+oG2pmlDI6hJ7OQ/ClKKgmcDJqgdQjkpQlrJD6goF6Uj9EkLBWKQQqHBBlqHdc5NmM11ONLTTVmA7gYJ2b6VnQLtXgnbvhuzFKoZh
+sTyaGLjcioG1QUsww0rstpKV2JGymdiSYSamFT4+FWWdSUQlAC7xwPVy6y70LbLQuiu0lm6zwcjL4tYmRI8t2SVbr6N3GLZeoXWH
+TU9B3hNYdNG26VmXW6dsej/08A6bXnS5db9Nz0kt2BmXW+dseh4V7KUTmsPHSic0hw+XjmgOl89oDpfPaI7AEc1ezj6Zs9Kd0Hvc
+8jlTwcM+EIm7gXc9LS8ZqUrXqBrQCgnM44a0bHEstfjAfYIF1+frrzGYNrWs8++Dixe+kJvTmdPoRBP6L3UDZsuGM9flL0rPeOm8
+/VnpCpSYovbOXIo0TJdD2BzDsvKgdvNUVCWpMCdgNQeaWN4ulGY0KMqnUD6lsRvpDmxnmWeEV7p05AbN5gJ4IvdMH1J9eDKuCNMe
+BStoZaFuqBa2L0iaRLq2FDgUzc7dyZKSO1kijWFd9Ytp5S5w3YVmc9Rsxs1m1RaiGJOdZUVElbKXrbjOfUQU5D8EozumjQkDaZ0/
+BjNgcI/WxiTKajDLOPVcH8915XcQarzY/wJDscazCY4Ewj6KOJYa24ovhRGQUYmEQSmTtiA3s0kWr5RNM5hFttx/WtJ57AT67a55
+id+TheVOIceSuhFKwFCe9tBnPUk2I96ncYXrTlvEIa2e3cl71z5hamFdchc00SCteELWmMcZN5szhzVVEHM3SCsy7C8jQN/DagTa
+Il1E60wai47cAYqsSbKKibTvDuTnVZyOfrOpe7Hgun3T4dOyUZWhmiU2ndWxfos0SGsEXrg2TcAr6IbO2yO5UxlnJYdxgcJJSSD3
+tJ7LwUe7OMqrEyhMgsDdssonKtOTRFOHwK1TUHWE61s7Kt0RptItMiSxUEpiiRpH7IZmmzgNA7e8QuO2coeAr7axaJCkDHL+OgDu
+Gj4HzebInul+6QY5Qi8EsqK+OwDP/z44lM4sBhSvX/hNg+QbLFdaR+sPw/tw6+Dm5uZB9MhIeSANw9E2JFiutc3gVduM1CRU9rIl
+0KfCraNis/UkcLNBR5ZcYV1X4VJtB8I5HQhte7l88BLaYPBp247qFneEVIxyuwhRMaHqcPtCjhOun7Nft5fYr6Wylf4dmv/6DDdT
+WnZ9yzUL1He51PIAtU7EdsBgAcJhVxD1mdw1R8auuc62HVVCgjykWMqJsozg412MUFXa8bIMboPC+x7RqamDDsHSX7o4p9ghLR+l
+uWSWinnOCPCARe2IwtgRF9RNmFbokoEXJIzI6/pU0iKxtXeZ785GldhEDGiK9BmLz+ZYpmBml0+Mw9mYCzd+IebK/Y0rTdAKCrRd
+kmNyDdg1xH0FcbMrOZC5VEmdZ6gx6ANBNvzlgNgal4cYV+H4WVZ1+ssyAiuALOTDbDZVIMsWchwxJOA8zS6Pb96oapEpb0b2IEeo
+gqjolrIsD+4DBvIaVbnpVKeuE+118th+ZmtqSLM6O4H/eob4lIZR3x+EYMu74nXdIoj4X0SrVbiABGUVV3WdFfSB61XXmXW7Qb9Q
+HmGfyiwE7CBZZjqJG9tRqLCjBnyhiRZcwTDMYVilNMY1ODlahlOIsTSxZ2nqbmDknQrx4HOIB5fEw3SMdn3NJhu7ll/jZGqMBJQe
+oSIZLumIqu4zau0OyhwYU0CtW2szQDq5Adc2dR8TMnKGzcvhksnL4SPa5MW0jEQ3BZ8iRjuROuRyPHXE5SRyLvruCpHHM4SSkukD
+oRX3089q611n25WNS+6xILZq7ASeVE4K9dxoudZ1e1By3XZqvRilUZVcGICOubXULMUwLP2J4c6NFQTeGgvM8nAmtLcq2qqKsSe4
+v7WvPkyfD+QOG3Dnm79soV7TbpEOaQlA/lbosBahpBXKcwBoGBEYm9V3iy2PlKM6JTSFu++aqriTdPbYNRxbik4TJIm9HrzlC1av
+rG80Fbj+sqyXkH1VTEhrZJEGsVuBbAJuFKhroWe0QGM5sgxGlu27wbjVsyczOK3PEmEld1nixtVQTDReTmafHnHXs0L0q4OLABA4
+VIPDYsuIIHiGJFpRiyOiOAaG77PqdrnqNiaV6zZQv9n091R/qc4OcUiH2ArFa2qtrZJr9sST181OQUAvGrvFcc34quJ8wey7p5Yv
+z+dwcWGHrXwCbN1xuYXM4DVmY5pVoJpGNDkLN04aa9AqssIyMgChk4kmvAuCI78VIJPxijwgU9XAb2iHTOQOaSg+Ds9VfBQQldxK
+rdZa2MuwL9ULyKLKuBhCuLDtZSAQDtKQXMA9esSQcOtAPn0jB7P/19MeG/qhMlik9jgua48HRfkYysfAyWB5pSTYpv0iy2h5peuA
+kthUMPfphjtQOmVDKTHIdcpDN8mzn4Ip86HrSXHbuWZVgxKTHigJOSjrjL1anfFGszm+AZ3xeF8640FZWexrrSWtqI2HoFtOXA+1
+x7QHHLJf1mjGMs1QatbomBOtXitUzV7bD5dV1FHa5YR0gpVR14U/EnAro+6Kp/XGKJcVOuJkOVJQXg4KA6GSblRncKwIj8xuPVrZ
+8wq9MiqOC82yTWfVmWuQdZWwrnM1MJyxN5tW7MYl1W5clsuWY0crdme2E6NeF3iFiU2DT6TXXdR63V11t7jceGVdlpaxV6+r1Sdf
+e1LZcqmyDSd4cd0aqmmlwg9MIb3l2SpTr3I5Em/rm0ztOaWKXHDwXK4h3FMNYakGbliUNZtmbLbfJRn4ATM87PLLrmc3SvI7eIor
+eMgNNh7x8SkoruzYJgW9maOg3oPm2Z6rjU2kwtXYTW/Pd1NuwWny/tSwtRrJjlIMh/tXwCodtit/JF0OQalV1fsgmCBPVacW0kpW
+Z6owrV515vCp288mu+le96MT2kXfw+1d9E/1gKpXHWsV6oTQ2nvAdtP43IBO2bjHDbat7ZjV6plXSIhvYRBK/FCwIeOkmyvLZDl1
+wJIrpKf0pnuYOa07nXR20Rr6Va2hX2gN/bLW0K/XGn4Cp7sdWCEO17qbSClzEnkFhUc1fXD8T5dN/MT3qOmNxrS6kJuXMK/mF8XV
+/KLmDv2p4tEnvsK2wUxfoqDqTiC31gktKybxlZSqSTihpMYWnFCSG4ETm+7owTqhvnCemz4Kouy+MKER3IdVfqfpk19pN0Ynr9Nq
+3HhJGSTFpstXUoIA7a2B0ay8wb56ZT1cy+8WNrPSeL50zT9cZ29DtmJSE7j9b0d7foXyZn99qLrjxX7i8En5BaqbP/CO2dzeQDDZ
+zWq1t1aWmUZKZFLYc9EblmUmoj4QNLwBU5XiulKU1Et3V9uWmP++hV/4/qSl9y2stC28YbLcVwHbWYm6M81Ppx1N9YoDW1P8I1l9
+aSEE/n62ldKQ+pJT0H4a0/juGxxlWkL3QB7qeytB14Y/rRaNVghpBS2Idd3e9AX+EeTVtrwrQTfLljrw68atpWphKcpACX0xIYR1
+rrjrjuQJEqS6vQlakSU3w7YwlfaDUn3fESYOoplgncdlkod0zmhGTrx/roq5bqJcpkLr2JHSWcGRkvIh3wrQUI3292rKx7oTOs4N
+5KqtOwk1xvhpb06f/DpzfKKjxmOK19+RXbcb6U5b6KckqL7sGd5ymq7ZCWt9NXnhiXQCj2Sc6SusJ7P9mCazbwGXt3JO7MpWErTj
+IB36YdLmbJAYfsRWldnMYwJi4CpXKduPelhyZbvrMptWvqI3IVy87frWkO7UQGRcC5GNiX4ybaVcY3f2hc79ZlOZTxdFDFeiuvcL
+R3VFcvcgmxKFqfKxzKmsXhCcHdgVSxz9xIy+c2QH1A6nop6zPaGqoCOmXYEHBTtwv9fjkdOn46jPAk16ZOJIz3U89ZCBPdF7QsVl
+NFJX2aJ3qLRTYdNOx4Y+Lsky6dE33YGyZ59MGte7HW7McDscau/WbVd6156KenplbbvbWRaBqXWWDbMsLTwaloXFbWffSGRtK3gJ
++0YoOlCzuwsUpsL0v48Mht3L5eejZW10LkDTuOZKi5tPEY1LHx6wmA0XNsQww9YNjV/hqyvaQNPu92Jze5s2QVw6Uhr80q2lwZfN
+7Ctvhxw5WjK0Xyrb2R8tP8xxTN+Mal7PqvwR4aHl8k2pJeP5I0fAeh6N58/C/NwBZvPcOgtW86F1ZAnM5uEWPXoGoofBbp5bZ8Bs
+PrSOLILdPLceArN580JgFWww2BFqjNOZTcv+1pgqCQUhtM/W0uF9bIMFDvHDQURyOrHSVcF7/UREfBv2LOlwD5/0tVQyn7x+qK/p
+yUoX3w1gUCQu0nKqNNHBi5wxB/Dl7ny1JaNo8xR0CgwfvCAw6pTdAexx0EX8fi8mNPDXpNt2Unl5q2ztK69gKByqp4ngwhIeFUDG
+9pCpiwHgakrzDgf9Vd2ZVGRSCVN5R15yPg1VPhmZyrPJ4XLr/jmN8JCmyTwcFOeZZA6jvK31eCeNi8b0JUyqdjUBcv8o8nn9vrXS
+pflVCPb87GrC8iIqrkulcd8TTIHSMivSXmrllwcZXUGTF4L4R5RmpCIPYDUFgrr4eFXu11RzrQ3l6hak8M6l5fDgkrMIXlpLHf94
+2PFbLZAGDy6Z9yH53Y4lXOUqa7eDaJjft8fxwgXZyQ3G1/Bh2dpu5p9d1610+bPr7oq2TGw0GqRr3smYj6LAoOmBFIp9ealHxU+K
+rRhICQuwu2yFLirjpr7YTh0yAg/PXVb6JjcFeW8dr5MCcs6mPfb4umXwg2F+lC5fqacpDWhMR52pkjDqkuMNzEinYyebPmxNoh1z
+tuEKfLLL3ul5CWssOiO31FZElcl+3g4d0w06pNt0la7Rrd1a9aut+rJVv9yqP7BqwBPa9o7M6N7awYfiwfwEj+VRGI0KJ7U841GV
+Mb8iRqZSpibKCilWQCPK7Q42f9TRuZZkYZl8q5O4RQs0daOVBN5yiQrP0oJpJbDZmnFwz2k2E7A5p+r5sIUluqGwiyowLB2hw4oH
+TtlcILRVJ5eOAZBGrrXtDsuPrOmRH76tgBHcRSCVxmtuuLLapVtupFfGqk0XaoC9VgD78FIViCDBDz3BvuSzoG8Ja41uUc+mRCwS
+enhJdfHwEnQxzrJV9FpfZ9tFjfXTctiYlzW6Srcop56u7agzki8GY9alY+bcHL5Nz9mRI6X0OxwF2cN3UL8tlly/LS/1BSZpDPUB
+7oklmf3IEZ39yBE9JUeO0oVRsznMX3bTIUt17MitMEyd+1a6MM6HeeQONUzpY7+hCtzh5MOG0/BkBB6NCvcWp78d0d+WHPjFh7+L
+XImIYgtv/cH9h66sLB2hh++gR47Qo0tdunLkKKVHbqVHF7tde0J9t0JJRjaNAAweoKN2waWKFhyjaQUbw7pttnodosbR2wAwkWsF
+blpBUaEm8UgZDWI3yK/7LqPYKL8IPEZlG40Vti0t6gWx6OBAVNW3mWiwdMTRLd5RSj/mqJEuHaMC0EMo9DgGHPXCIgWmW2PH0h15
+7js0hA4v0oWo2Uxz7Egr2HH4sIMnMzL3Ybrg5aM/fKyEHYkqcMwxnCURAw4f1pXdOvVt6Q47XwJT2CEK7BAaO47RpWN06Q56+LYu
+XTm8SOnhw/TwrYAc9sRidpVH5uquNVCe48JEdaK8DQJeyTLvvTxZd8erxe2Jne+8MPAZO2/NjV7l+4BqOERae3PWkAmNisndEZd5
+LZHfCbJY7ZDJtNf0SzNgFeY+r05bjOaCcju/13KqzMwLD7DViV30bMjEOVPqqOO5iqsN23l2mKBCud4wmBJV0YrA9wCmGzqfhiej
+NBR1LZm1VXsGEq6ExczOX/Tjvfa/qNR4RkqsiGJzB9X3dEt6ys/AbrzPxi76MQxibFahWtcHovmzYGL54JIjptuXN3LNhJze2CBb
+XlgJC/OnueBI6yfaqpnisOvWpsLE21KiEXa1GxJFd+tAp1jm5ft3LTbzPGP69mnbvFg7VPfX6Rfb8IlZcAys5ZErRVHGsKpjIPdE
+ouE1wig8KO0HG7Fc3fJV5bCdS9fgIlBI17oL1GxaEQh9db9tm7fClpa3Lq36Vvp8ETRgsnmzcvPS4enRJblArR77proT1Xo0/k/X
+IUGjv8O5WfGyQRX0k0kVLfJydch9svyGhxyEvrlXMMrozt6uW5zYzSZ+yNUpRY9Ud+Td0vOJdXmZV2dHM+oSeOL4YglQhcRqkYvc
+Z/2GiBqy0YbXKNXVECNPNPwkPCAaYsQ4WyB2p7ZJeGgL9gK6VAxkCivmD6NT3iO0/cesB0rL+7e8UUmbW4CqrgaMgLGiormAbVsx
+Q2Ybey4fmtu+uSPX0xc9Rpdl2SKdJrrykdkygigzTlpJlreTQK1m+ydTzlk4pxtTe1wdD6E+qcpUql0/0LzYXjej0g5W3cLNmi3R
+Wqob3EzOyqyoZ+YtbWNy5s4O0JBt7ixpKaAM4vv8tV3aLlSUeTn55M3MbbMgMHhnfIm22KVKTuoHYuYzL4a+Fao0CUwypxIqZleT
+MFFGN6Xe3DMfpRmvmfh1J/gSLRoN9IIonMdtGK/slm2mmYGs+lSiZtIMZTqzdxi+WFxMRc1t/GjHUiEBjILWrXT9Nv6oi64LWcCS
+FyzmdtXF5xKVwVz6kdKGP/d+bH3cMkXDZmRTZynmxcESyaSeH1NkeFJalgYbYUVa0+vqLU5BwqaGaKCuKI7QdypUHBpOv1uKoYFH
+KQVusLeqKYAXraWpjHcuLdbJJaX7kcPyGHNWQCmGjCsBv8gObLAGB1sY1m94jZHH+w10+GpEg8bS4mJDtt7gaZgQfP4GbPoWXHea
+5Kh9vl6Y0r7CNyQ7dabG6wWBNeeSOUQgrZelOmBPbHtvuDWpmiIAP1UarMvVAXrYrtmDLNByh1M72sElzQBzU5LWJkBVbQ1mzp+W
+o0FFZcMtQbH1+/w1y7btzgLoZFI3KOtkOlCxzaxUP5dXvBMtFSFsMvBDLwi28aks0HoEudYjyLUeeSYwJVJ3Syb6jTk21RnVirB3
+mLUy99QqV0DAW5bdop2c6JSER053SsKcYzxZHppzCMoRuG56YsH1WzucDRLnlG6WommBc38eN2wFzxWJuZbXOa8TJ53isPYB4wj3
+rl1Onm/IW4HLo1p/xuPBc06twcnbsHfFXQHHrA8/nXJvKaeRfKgqwvf4skwF2v/wD5wN6t6wKh7UAnoCrIWiKdhOY5wmorGG9516
+kL94ZdXDbQYv/Q6pN1En++bdczSFZQAaeGnandSKn4EbyHdmcWH4JdY4sPVzrYE0I1F2Wys8t9dO4Dnk/Bhp6q0x9W4XLwmokJTa
+hm1XJ3UXFjvFO/4GGE4HDI+0Gn7YKINEyq0JMZ74NyDia3Tm7fyNHQsOuSfmxdryHsNSjsAG+yCcryzLc0iu3iquBk4owTzEtm3a
+m0w+9SdD80KRtdMb+UGfsxBsWSl8Kxn6l97G01KR3C+ZvBBRmn+HyxWj4gkNje+5JO2oAKg+kyI7a+tu1D/HrA19dC64DLYUcyMr
+pKGqGyaGshtzR/Kk3UbicsuzacmgUb7BcbT8Bscx4w2OeRYS8bSFBPy2eRQJ17fUbX65LnZUc2qdMLEnjRW+7WQxeKwaeb2wJHpH
+Vt4uTG4aBJqysRVmvA3ouWEOYGmsyruVijz1GJ/dgRCMgoYFyztD2AAVFUieK11avBhqm3wedE2RmeI+0aLToT450efF1HcXO/5x
+VngMwVj8Lho76iFQ6foMJ9zChbCp8TQwtSylASTnCShw+0AximWAppPHDy/nnXaYSUzr7zOAqWIaEEzPSTEHqkW+EnabTRDSIeT6
+8m0WOE2A+KTAqmK3Nfad/s0nLTe0zHy5j0Yul1eyotWSXHRTu6T53sKsrdJH+FW3w2ZTT4bx0mRULC3AQVzkpa0uqOTCSxXLojFX
+b50U9J/NcG8FBs5bEd18TLxiTWjB5BUcYLFxyU2LzdqSwGbbEDbkDhrS4t3euq/sU3nQ49PHgOJwfxYGaMhNTZ3xFrQnXYyR4Yeb
+j4opmbb7tCBHZV7S2nlJzXlJ50Hftz+LrR3oyN3y1XLFHy0I4+b5B0oaV5RRG16Ds8CDc+XGpfP3UbxfP0pFY+Rt+OGw4TX0fcbt
+Bvpj4Y37cNm+TscU0SIHCg7q4pQkLAD9ah+VNjgS1cWTURrIS/p1HzkbMM7CHsufDIgGDt6Wr96Vo4KyqjF36b1p0A8Li3yOlH3+
+ih30Lri+S96bvnCm5kwitBF3JGWO5WsV+Y59qsC601ND5zbdWV2VK4BPpl87NksozlZDKrBYVUQuysUSqKWbzAFZ8wcMlhXPDUNz
+ytZwd9UfIRkFzHqDQpOKYNK753IdoIS9LJxpKAjbsaCwe7Uwk58eXYELmFdQcMeCoF25FjwH/VlZHbBKO3vxKzBcFuZ6EkB2qwTd
+2EQXfbgRTrMxavc+U9WoqvdNikvBygtzGuvjyA8F44joLdLoR0y+CcG2/EQAOetHPbTTI3RH5zXUM7z00HfJ32mh8Hcq+mAKS1sx
+6wlUQckStDHEcz7SmvOy6lpJ/an7Ia82ZyuLXdRusnaSriWCW3DtN8FPcNsF0yv0EJEvQI/s0ovjubP41AiWmWPcSf0/l+BO6kOl
+F+YX8Tl5c+X3S1O9x7fozSfJ89IICO0tX3r6vm+8XV95chzn4pLx7PRipxByPH5CWPoU3u6U2tEHawIMGBfCLJPAhcDnMDD9QHix
+O96Heni4bi7LLB988O5DlTowUFovO7ZC2I9BJCHHgc0Ze/FBIPR3wgVbnyMtvEFDuC4vaeahYjjwVg4OzeaGFVFWGR48UKHOhKMx
+q1t1iZu0AIAwPdRfSbrNJvydmR+A6dlZtmF5khIaLfrgeWnBX3kWnz8PPvUeeB43qz4zy2rUONy8013MsjJdZXals8bmZsG73tDH
+iXqq3aCkeOQ894lwrs5poQ4Kf4G7oaEltB7xhhixVXmHkOFusKi9Dei68nEwvAuOlN0LlkreBUcX5aX8S4ulS/mPzbmU33i34yvW
+infwarflHDqUHTpkg2M8Ac+Dyk36sG2cZ4N93p9/atb9+XfhMsCO5Mcu9IriPFEtM5/nnBYfuGGsb4X5kujUU98y4wMNOoWqTtHe
+hgWDPnieDWxCdzAPo6paJ6CaAcVjZ6lWA/aHxm4P3todub0VMOUBzwtTkd13gyyLl30rhvtb0DEy1xHnCiJRbCwjarSM7fVLTSNS
+j1ULucEt6iCG1oj2aQqnVED4oC3X7S9bG+4A7CLzDcUaS+hZG/jq3NjdnR/EvXFGJ6fBY9uONXYTq0+lH04aBAvuuK2Ys+U85Iyr
+p1kgzOFGORsm081NbJuOZ1nkrJwxWCTUCYY2HXfVqjwzJUGkdEx3/kG2V+zx/WazvwD3a6+s0jNTrhMpzd3x+hO768CVNPIoatVe
+lmB3VkEsueqWF8kVuqM9HZ27qLeWREEqmD/Yhqfocp8wJ6XmcnQeoGA1KMMRHTIhQYIbu+NRtiW41xPw9CF8SGjOBzo9/dGJKTBe
+5ySAL0bywfEBTUN52dvnzY/rLAQXQUNivIrgu1SjoF9gerNkerNk8x8HP3z7Li8y37rLi8tzPy/Nf8/5jvmfj90+/+nyW++4fZfv
+uzwnvTi//tuW5j9mfdvR+e3ftlv7u9R/x9FjuzyHPb//S3fM/Xxkfu+P7lL8jmOLu3yf3/ulxcU618nqALo1eaoPxksvML/vMqkb
+XMXVe3foiolkCvVhbPUp244A3vCiP2ZRKuo86dlmg1tg5R8EVpGTbvphP9qkuQeRLemF+mxP8OIA6c264QV7qFhnnVWz/o5Vm225
+ol3KUCIKqFXpBVECPuCUG6NPQ84GrpkCcaOf5exYh/m5BGY5CtVzPRfYUxbyKAgqF52Z3bcY5A2Yip7p27Sc4uLVVmk4VdN+6zkI
+bojtVRz4iR4oglzR9mRg77V29BUhZnpH3OkugthXye0WGGMe/rP2ahSq9GbTjMEsCbiPCzjI22+1FRqNx6zve4K5ZkTjQfG1HJ1e
+NVbVOB6UyjSqzI4hJ6hp7kVhInjaExGHY3ulaeQgmgvbk5MvKFzTbTE4JBHGDawNY8m5oghTVoSRAQphvlaTNGZ8dbWcc4JXqYDH
+59nNULP8HTymhifgGdCqY3b7fo+vs/6XTtx/H+7N1EcKqs6g8D6shHH8VJpvwyHNsMvIu2KOXjsFAm9dLNCJwUcym4qJxSqNzjg1
+U+D1wt4o4nBRzKQw7i5W3oj11lfDqM/cWRZ4mINtsFBYvH1BcOaNLwiPi9OQpA1hh0yoPDZdmFnudNiXpSbmBGHhahdQ6bprPaVT
+r56Ex6pW6Fg1zSR+OAzYVGsSX/GUbmo4TPpW7t4ZePvbndGTPUFFq5HcqT4ACosyllmEGfolHFauysK1M0KdOPPgynlgWLlYBScq
+StZS9fZvwwsjMWLcUIGFRs4pk84CJGXAVoc7BdnZFZXhBdNi2XQqWwWRZ7VfnlWWvz9CI+rlU1uegROB7yUK+jvoADaNzpblu5Fq
+3vbVKFRvbEP1V50gRBv8QyS4c0Vfw4NmG6Tl02gmwFULK353UnQsZmy9wAoUvVyze82m36h2cXYPVb/6qVTwsoYs0sD3YjqNgc8T
+0Yh6vRTFREIr/SphVcJ6UdiXuVX22WPrs6THwv6qEkU5TlbtEut5gcflBC17ZWxJ8JtEGt926krDtR9hjxn0aqoSlWVeNfd7ceyH
+wxLVsyr1jGUeXY3G2cowberVo64xlumrp2lI84uExDSCcle0hTfU6MCbTbKAj8pkmaWsV1UHLF8B9IGoDzu8dl+EW7v9ni9sm4a4
+XZr5LG7kNGZctGFoKihf6dFdABPcEq6wrhvaNJwxdnMKakePvuJ4COlNDx/vLi6Gn+jhJ1lmJVPDV20hAHBteuboIz16I5uV0JUu
+9cyhq4KDINpc3X3kEUB1sbMwB0HzLaBjK/sw5fY0RR0jGsIstVpzECLKZ8Y1Jimqh76JuLOBT1MEfzLdWOomJvhTDf40y6y0Cn61
+kgroJyb0PQV9I5eVAvCTKeAnewW+Z9cCXjVhwD2s2Yo8wIiaZCBWnjlPKyGNuvacKfFqpwSIwUTZfQeBvBtkHmPNprls5LGp98m4
+7OSz4LKTaS6bpvC8vzoLyLIS35zbLS0qS3x1mNMRx8OOaLXg/nGh9zrJhYIVm+uy4gxRBg4uTTp8Fy4eLyZayhnrHFafIkPv5Qw9
+V+3e5SXMaHs+X5+3w/qryvgSWKPyN72wE1dfANNnA8Y5668a/YSvdcLBtjcOyvl2JnQqA15hXZPN65cacWe7pxQVltHDIlM9IMBl
+VwpNdzNqsy3Bwr6lATIns23vmgUP0fWQpoZ74wObhtzM4dUAebdBThex7T1mzAdcFRT7nvDmC4qShZ8WvKoly+y4LFWR5lR/Cikq
+p6oq+0zxrtpYSQhhBXU2pMGcnWbLs5pn6oSnso1W801vorh1CrdSb6QPRDsLUdtPVhlcBW3NXqTw1PvMj+04ii3bMh6AL/USy7iz
+XLtm1Kg8WmcMV3Z/arBodY/sGt52k4b+lZSt+v1cKqmhW/XzXnxfMepB86LINVJoWuyeNZSPRnDEXBGCyvS9TlLLK/LWAtbgrJfy
+BKy7oM6yQG0XouVU4wqIRWeRwVbP4sMPA94ph800cQrd+g8rWLAr31lExrhcSc3ix/kQ3jA/e1/EN43tncSV6fII1ss9VUN3Xn0r
+XlfdCCKPIvHdzzQIduvHsqXa261+qKxrO9N1lmqbCSAs7pR8+U2pZjmsrkcpgk2VMCSBmjLqa7WUwcECWKrFFM9t57wAd8MCiRUh
+SpYTh+VW7rstC5fTmTgIpIHyWQtZjtutWJgvWDNBZ+95MZkaKiyOy6dRKKDAzMfvV5ZTxVNuVrcV6OdToOlhGPN5YwNRFexpKFJk
+VSOhyIVxd5FGrl5lHX486vBWyw5d9AVISnJfZasIC2PpZBZYFGrNhgpehdajcS10DLw1gbNH0Ki29wwZZBTTHDyhC76CqYZMeNzr
+hK0WYmPgpvBMUeQGYI0Ru8HKUpcWb21JSxCt7JiCW2TvMtNkc+QHrGGunWI4VfWp3iFGXjLCzWGdbVd1Xb0ZPYltmoB01ZvsNo+x
+5/Pk5s7inlF8//O40t19HmvncPaU0XkwVFI3p71uaUlMrGkRzjVvhDCOdg2jsP1IbzANEU1o0MnlOA76gMjdiUK4wjsaDOAOVMHR
+RIzimyWQsA2XlS7SMMIrSwP30FeslcWDd3Qrf+yDRvJyJWYtO9ays3JRdLOVy1uHFy+Lbssu5XCMWDliLTuX2zLhFniVfdnRVdxi
+fTmzVg62una1sVIF9jL8X/6HQyDKbzOPO0t0HIVi5BymfW/bOUJHUcqdo3Tsh6lgzjEqdcLOrXTAPQS7cxsVV53bqbi6mvjD0LkD
+QlhqaRGCquTSUuV8eN5mVdVT+YN566FMkUWZGIvjkVYtJC74nNDQhRchQMmFFEd4QwdYjXbEh/Tw4uJhB+tBq1HgaWZIMLLXcKP/
+PJRT2QxGgFWO1QeBJwQL907k6YCC1dgYl2mgtp8OP67Gr8cNA+5poMA25Emzt7HbmzFs9NGUj7zBsJG3lFWqO2Q4XarYbZXnASFU
+GQ/c5By4gbYyHeu7g5DJRUI3vuHNfF8kvoYWRrwR+AneIKCSksYg4g0ABHw3yeQYyOR4ikwOAP6xOzbIZOLGBZlM8u1uwbJGbrwS
+du0bpOufdLTGyGhpaCMY2qg0tNqZHNl0IIn0SJvWIgjanG0wnoC4HbmLNHUHevzR8bQTtVp23x2sRF0TD/q2fBZwzuJDLFy28Met
+yZgITiiwWQ5vtYBCBKpZu+KYGxS+06rbMwhR3RLUdc2RBWqBxWxaTxBUhjkUociLEhCKYnMukynTI6U5n1sjvMRVo9Eoa7rrao5W
+RPlFxO7cdvxQ1B98UX1VcEDR/rc3u8me2yt8DlbB5YAAQSEHAUWAoMFleHAVRQ9pugQraR0kNLGliQ3UIMVgdKxYxIIaR9AOF4Tm
+XnGJ7uIaydVWwS14h82ZUFi6lsM2PWzXFdvardjSrbXlot3K3Y7FVM9XFrt1uVUmBYAePGCMOhJUJPBpuwh5AAkfQ4APupgccg4N
+4egRHEDdRSpc7cPTYcdFh7VatueGK6xLlcl93rpnG741IJcaJEG4S7TnojsyPlOqavSPR+h2HLrwLhTttdzwFkHFLe6t+U3XwS29
+Sc1I56+WQRB5nw7WlTH/E+Fg2w8HJTQ8E+KrtNu3BDjT7dALS98f8B74r5nduwGcn9n8ytZ2meE1P/T4tjt17xz1O/6MGQ3lhY6y
+McM3K/c3kIaIzaY6dJTRZU9Ea2A+ABsys3xK5MuxiAoX0APBIl7S831i+C7KPZy7jO5BMen5gfQ57bMeCmbYQAN03WDAz8u2O5O5
+cBH+mCXCG8dzsT81WUi4OL0zcxHkLHV7jIML4GkO9f4bPuuW2L4L77jRDXAp8uBOfH9gDd18rW60QaQAjwsjCcUL++ASFWZq39u2
+6cJGGwQH0/njlCeYBX/aly6etIY0psJGSsfN0liK9krNoNgB91EYiVJ4gQNuuNtHSzByGYVukZK7rtxqd3JHxls7dtiCRyc7YVFn
+CJXdjz5KwE5Z4aEldiTXAW60lVSEXhuSYORpy0vOwSVqjYrK8BOOBT2qWu74lpFNrX4lhxoa5OlBnr4trclqYEU57dEBuOTPxR3Q
+TKwCL1xjasUltFe6dMEKbyqvDh4q4Sy2NdfJmaxqCKxqOMcEaWA+pBvlvFKFQM6SpnquviwF+XrPXaSB29MkzTsedDyTm++BM/xN
+5ObnwiMXWgYN2Z/G0m5cvD+wlhZcd1QSDP/ruxXrHkkpPJZSaQxSaSJfBSgrh1K4RaYmfZzLI2rHWklov1so4AeTiXqahfK52B+N
+vXgPHHZ5pVjECxsR7zPO+gAFshsfP63921szWG7X2hMm5rH0JVOB0n2q9StE7qYrXVwWaFESTsswFrNtX4JevYnGC0bBL6Av5vdc
+8P2IN7tA4cpNhkLJTi2sOauAnXLeIYBfPgSQP76p9d8jnKpIWhnbzuSGxtbx3fqZpVFp+n3bc30YSKQGAipvLx9FtNdRTB0x5zcH
+6KqktaKoHY3YfTSibjRw69MuAwpnDiicNaCckSzNy16V873cebPPBONjPwTPVkNZjRobMWINOE4mLXnAWtLUTyifWHVmRtMa86rp
+jlWjUYF+kemSczUTN9YSaCD20xLkv7GW/FDspyE/FDfWDsqd+2kJC9wg9FAC2hf8sMSNtZbLFftpMC90Y21GuKHuvTnIf2Mtqd11
+701hgRtrC6+q23tLCbtB/EDN6D7aEfxGx3Nlf+O5cmPt7BMZ9oYLhQPQbpXmZL5i1KsjFG94aN+VDgaM2/+bO9EhryPF47350x2+
+9XY1G+c8/lm708kmdzG6lXciS7Nuwy8McQm0XH4UGsnCG66OvLAfsMI2F68OKOxxYW8uYvjRJSjCr0rvrVXcw8mkwvfskAXikAVC
+yQIEphcCqVgA+klccbIt3Qxe7pXsR421Y2FEP0uRiDfbLB2fejVxWRmZ5+l00YbXQaX1mDsNXq1hKzqkzfvLsyAtv/NMXXC2kyVr
+KrV3lFJdlA+AFhaVBnSR8vxbJzzO8UwOb9wUYL0wXaOp5WB5bQrNFpbK8Cvct2a8q37TITGdbdpqtn4+O59mt1hNfXTWEqvYgE2v
+jhpcrPHFENE6C6VDJ7jHT/myWjPcm+yatam9VnKrX7VM6/s6I3fdEgLBZNqqWnbdb5/yOUOfcry3g/rtU6pCHIVOzN1KMcFeVu+6
+8oRVOmDZjjVFqELqGb6GGmiRy9ySD5RQUCz1QMIxoowuLJlgUxItqXSChX1SB921IFJG4YRWnYXrxlEi2mrvY4VJCA06dbBUVeRw
+6thVVKk4jeqSFfjKG7Sn8Sws4Zl2NbJSE4qpgWWLmmIpkE3p4PRd5EnCuNyXIIE2ZP5GMkIpcY010JScyANEXSm0sY86MXtNldXt
+Qdtm1OCMOU7tvsqjHkuS1b7G4wRPxyJQ6yVuBGq9hTlTVWB5WWNqcAuGW/SB47k/NPbkzgNlW4bpDvt9Om8UdietoybFHOZTXrMo
+PFgUizSgySdYGHkGvJwoFCR/JmDuImFhv367NqjNnhZ9CHfY72UtFQ6yZVh5JqxA0VIlI/kyiWjhE1wPA0VxxdyhK0DNd0m/ESJb
+HXIN3bVYGetxDWnt5FxEM4ddQhY05WbKS7lKLKt+N9Orza0RLyLzgEO6quyZpe3sCj4bjcviaVwgwPrDGVeMz3Pml5ppemW2P3Ox
+TzluFK7sUH0jHzmhcfW4I3H1K61cGvmhid/SQvlO0V3a80PwDPWEvxboJjXNsVTnG0vtWxp+0uDsSupz1rerfZkGt+qYfIcEDvkv
+nrinAqo07z1zU+h95KbQf5b7ShgztZfxFJADFaIsiMc5NZ01qkYH50lPYkjgVj8jPmGfAi2GBhTvgIzwpQvF/vfQ7mFnYtMeVmh3
+Bu7KFFxor1vUF+r6QlmfFaFFgl0zfO1TV+12oVgf1NGQYmHNkppkPlx6C4u1fAp6Ke+xCnt+J1YjvuqHfRaKABb1tP/DnL7RGd2b
+cWNGvQ1pfuugfGELODzD9cfNOb4yOcAbNtSePZ4mA4mi/8VFHNrus2RBScf17PgUccyNRSOJkiP5E7ue25+6yCXvI/qLa6pd08vY
+LXXGc8eGX31u0VlT80VvmG+INfX259Q70vXapUsp9lhz7O6p7t2gUV95/fgLuXtkI4UaAV0auCOgS+pLqk7E01oyNZupk0fNgLTS
+lFXKBvH0zSol4pXSvt0ZTRGllbTbGkjiOnIHBV67cbNpAabM35sTTexUqVGWkQUgzyOq3wsrA/QuWMOnQ8G31TqoayGHp14R05eX
+WBEd0YDG1KsVSadpwyqDRklH7yN1sgxyI7PXZ2mqA3fcjgMPnkWbGvvyCpCZpa6jvyyvLCzRhcWug79L3WJgxZ0uakQaGWPq4arH
+Sx12XeUzRnR3EG2WYHezgI4Hg9NwRxKfQxzvzNkD3KGX5r0y++nk1H00e+ujvulDdnGdGR1ks/H2UwLl0my5fz4s53T1U4HnnI7O
+AGhxPa8bFQtF0bYe3PiOxYlDYFoIHU/3ke6BBJJWr0UKQpiLvXAKDKkNJfrQ3XwTZq3LlQCXLSFTFGdqPU5m+LPtNq17cdLXIDG0
+GMqrekpgqm0OG5rHXJVzzrsWbheynivn6/s+tzTNU3Lpcdf1IVcGnS1jhgXTZDtGfWWtQ22tNRBVty50KlfzVQbUL+tnbgy3scFG
+LwoChnNBzNmX9pYHl2xgLwq8Py4LsbB/J92jIWA4RzlcuZnJCkt6kD1yoyWkxYR6/fCsrXueYnt3hFy2xA2i4hdYHsQ3vfaOorO5
+kDl4KvaEp7Orno2stqNhUELBmbNcOQLYh0pmNn2Z2iY+Raqn21pnc0mekW3v9O4L7IYI3Y3jUrmv0tnrJlG7cqUz8GeGxP2Z0sDc
+Z++zJoCVG9I+VfpXMyf7UdgWaFXoXD8jpETf/9koyW4AJaHKfSOk7eytq6wGF+d138CZ2fqtz4yzK7eG7cxVvM3aTHN36ahOqbWw
+i1BZPvhbYFOXFZuFTP5QPX5xY+QABpQ7OuyFHBygB8Br+UBXHXvB21OkFQEtiKZVusZ8TPZI/msHMlOkKlTNC4ulDhjXVe5Jwsa5
+LGN12JkzA9PTNm34UlqONc2RaTRUKtsyLGqPf6s8xozB75eShntE+7mb/W6rchahpVXspjOAXUdC53Vy111+byR19yZmzai9X7B+
+FtvVpwNrOGX+VCFtHGNPw7l2y9q1u5/uBjbV4Px53a3r9d2t56/MrlYt92d0+7MRLEpNrbP5O24trbmx7bYA0H/VbrsP3ltvtpNP
+ebP9xAS6CtWZNGPPBDnaO5nYIwWet5tPYcVeNvN8QWO/57e+hx29unQ/9Q39v3yr2TfW3BS5aKrGG9hLPn3ppwa55lpZ3wi85h5I
+1hv9mOXrfCandf4Fh66O8kD1z6Rqaz+3lP9v47nwWVxM7kt3CMpcH10iKHf9duEVgfWfxxeZ5zpDMPmapsSTKPEh2c1P9z3u9QTj
+bigTOPOSKHQ5neU5YcxzxV3CQDR9r8U0spM09PDtaLweMW++8Tllc5mnYOhk1GcnhLVo3JSxdKvdIo420ZTdbZHLYaOhx6a/6fjE
+vEddwqvWk0N5XeJaxM4rGAV+iBedSIwM0nGoY4gE7qJJKmPuhzg2y6ZGXS2XXF6Ujhr5cx3cPbTylctbi3dc3lo8cXlr8RRcuXfw
+8tZtpy9v3X7s8taJxYOX07vvvvsUXOiXnrp9EeKn7rr77q61vAApJ2XK3Xff3bUzuLPvK+Vs2VfsSrZDNKx4HNQuf4MLWywNY6UY
+dot1J+XaOBv4W7vVt1SqT7FMRa3UbMAuNzCI+KbH+3XXkkgHjXIzIbjpso4t3PoBdM22WlS5oJDL4cdP/P3RRy+nhxcP3wEm5vgI
+MbnMwZJBNJvkckgW3Fl1Lls5yrRaZZSxHSPaatFQ7mfs4MGCZJUHPGSSis94xI8VbZktmfA1hlgBZgVbazT94Lk/sEKXt9kW61lG
+rZp/Zm4InK5vAkMRvIMGbEP5q1hrg2BZPmWUJDHr+V5Q0IKk4XGGDs1eEESbrE+g7ze8y/yv/QAGLhAafFZbzq4eeKXHMC70vDBk
+n81DGMymsxzwlHsZTLaaUOSEVsN0vMa4JuPaxtYVJvEODRrPSwvWL7hwN9L9KW6RVWOf7xPYj0KWm8UjUxewDRboLQO7WXjW4XO9
+JeclyzYzrgpvnZU2nxAelzSjRWW4dlYTcC9iKHIvqHJxlCRgkmx8wxcApRjuKVvv/BCIXOZAEpEe3q6pYs8ll0WDUM8li0uHjxw9
+duttt99B6MDdWXRgo6OeQ/5fhK455PIaocIhlwWh8EeGQgeoKN1wyOUNQgcOuTwglDtAZClzyP+bUNIgDmkQeoAccA6QA5Rcvgxl
+LxP6gEM+foLQVYd8/F1C73OI7Bmh51TwDjKhsbuz5RymqXOUXnJur/NTRIjOu/Cs3lExrXVUlJJ7yFh/dRxxJitPLO01JOe1/MV0
+v5GJqomb4oUoa1xZ7O7bAXEKLtDoTR2fKfTk/Zx2PPyv6IhcYHrjVrmTkT+Yei+y2oXpLXohJwDNppzPqR5o2/NEeAFbrVuXev2H
+bEvUZbDscsVyAFWBdAos02oCrWlKel64KqJVbLAkBu/eyTSE++7UIaRlkFKbAk1wXdcyhGyQzM0ZKBE/Fvbl1JF/hHJlu77cccOq
+q8H4ihUcrKmg4uhYV0slC1bVnlMV9nheRcWQVkhp9y3ylrXEZss7c4toRYFZoru3RopeTfbURJGfzs2vDOtmzYA8fVaZauoofcdK
+lqcrWWf1hdeZLuRMF0IdTG0x9QUL3jJrcPg4p8rUnJkJjeRVroVZuYQ3VFky1c2cShScQk25wBeMe4Eqe+e+yg6ioM/0DB6Y1TP5
+9JHMdYAcmJGrH6VrOpcpAAeeH9YCWH3pmIb8JgepNe1Ag0J90zRarLIt0UBiREoOVoX2gLRYizTEyBMNKBwpr86GF27rgrl+Exi6
+qaO8WQR2Foeg3N7UEwU1BaV3EzLeXIsQnOLroMzlcH28tgMOs4yVGNbj6FoRuuVU25QSza7PJsx1Qh2nfmdOr2l+WVsou+4PrLz3
+oe59CHc4Msk0K7SDsFpoyFgfZPL3uLu0ePioLU9oWM6E23tGAq8huyef0mBteXNgcenVwA/7jeKsxTkwPdUddblcnwVMsMbMwcOl
+6pP82rkykL0Ntkf8gPUAipPpBWnAJxT6s9oim82FWrZ92h07iIZ+rzHw/CDljBTLb6qkuQI5G0f1I8Crd6d5oGluZbb0sFIZZ1ee
+hYDoRquqnXptxcx1ObvftTwMc/feyf9SjFRzsytGVjs9KYOnxHLVKsa0MFHZGtRNtVp0kRXcWWjLir6WhE0jnJ9MGa8SKXzBBPlc
+a8lmzhJU2CYdKw8GblaqGYnKze40GteXieRdybsgU8q9ZtMujtOi9l7Ox02ITI/VuDNEDpdp/GdhL+rLR71m92JXi4Iyf31QK2Hm
+L+2CMtStIa2amKEToPscez7RjDJ9v4xUgSzWDTxn1WediX3CEdcM1Ox5IfCYEkVtR2feKDKH4ffDvt/zRMTrL6mY204FF/bZSn4U
+O7eNvOSc20Bv/iTUI5RS7VtHZiAYs8QUDa0dXY0INR+QWKBwJpFFZnsKzmyzJIPdeJPTbmozW6zWsMs0zuJg8inIN4dWa9b8Le42
+fzd3+nZdBVU4gHQ6w/5t14n7BI1VLSD2MmWl5monbPcFVkzZwYM3vORuypTNN+uer4+eO052Q52vWqpYbG9jMdQOM1xfanmp3CRp
+DvNe4SmNyzXyC+qhXZ9NnX41RoyzGhZSttivVbihnLbHrXuGJ6/cw3N56BPMYXiDc1hxPbPCvU3iOvsMJ09fWw8M03/JxE1tGHub
+twoYPsVZ/ALb3/TV27Hp6yP3JeDtW7oqjUE9VlfWwhyckpTpIq0MVEmE8se26WxNgIEErMCAT9KJWThR6dKszSL3Uf8UlwpO8M1a
+LOEnXCwhDQEPb9YqKd5svrHFUthkWnxvywUV0jNtCr2bKiYpvXb53p7aTmHGz7xXxt00td2CBwA/mz6hbr+2D0p7P5/VVF730r6T
+ZKSe8UJd/n4qupPM4XpmW4N+gq1/DohKXWP1wJIHEXtgy/UID5AZMm+6tp96DpADc8ST+YC6yZhkdmsGlPBc5TNCbH26U2dSMU+d
+s1hWd9cWn3//bae+pmaTHDx4kOhEaYRoHTEYKTxsPmLTQF0JB1ejgIkKw5fv5vZknkpuuh/tdvvm9mOeOFLRTmLlSzMqr6t7nkdQ
+bi9RbH1ZZu2lpdphzPdMuMmNTS2GytUYZsXVeg8uO3SluzP5XPOWhezOA5fJP/7Tv0JbxxfBUmN+j4R9HB5oVIfdWVbL9KlCZNnR
+Q6iMYdr8omYoykApbwFPV8AwNR2wwaBAQRxis1nhPNAeDZ5h6yywjrKqIY1KqY5dLoXnxZ+rZML3sjuiHqA5UKaqKohJ4IdsdY0z
+b92yl2sAtswN8lOzAdmOyrAR+f1GEQV9c8GK1UC4llLl0JUPAMEtgbsxcW6NCnk19MbMimzqySPi/C5TYXtGfry7sigkjQ+iGoVH
+p3rRZ7keuLpu79XAjE1/4nOmkNdNYWmDKY/eH4YRZ32cW+iI5GvL975agno0mroXpQaOUyoyNPJE9PelIVzecd/ukEVy3OXNJj/u
+kjtIlpETRfzLEPeK+FWSZXo5HlyFcd7pLnZsv9Wq1qqkIX8/B4TG3bLMfOYuiEdemI4Z93vgEqiDhSGDeTUHrznIzrFSbTB+BSl9
+m86ezYaczv8OA8lfx5rGgLqV4daZPEtc2BPt4rNXnhSpLQbP6ZKFUk03A1CNvj/0Bfp/tg/UXzFu2dPw0Yf6BbHx9zKEaN7cRzdp
+7o0hNfYxJDnlK5z63d1nXQ6pxk1YTjreE4rL3QpLM9Zshrj4b+bcmSOcVmJ15NPJi5L6WL7RHXx+1cf+dGx4XD53H83fYTXXMrcr
+i5nbtG6B1JD82vUBx/+7rw5p6zxrQ5G3olrM3kNN5XuDaipT42Q2XRE07O46NNl4HRJU13RxgatFzNm0wZB8wa1iyU1AjirqhzNp
+HN91nDWeU/nbmQPLr4wz5X51kJ8Fya8OeDZR9+du6yaDMIusfyKG1J/Dzfh13EyBSzM5oJtCTXrRGC9/j3jDa8DwG8j77hGq9Wxz
+DairyjdWeYOUprjmkynutgw4z5VWsP4yQR0jcYismVTZ4KjCiUU3hROLKpxYlHNi0T5mA5xBYcwV1mWNid0ZlwOkxVuk1p4wf9NF
+kbSoQrmjmWsSxcVlh3YnKFzuE7k+neGUfApTmuxik6cpUf2DERLDildfDAQjx6ecAJaUZSg37l/XQDxs06SO9glvSKhnU3Lnfvm1
+4g4PWYdJ3u7cBytTXgD5Uwz1Wgo1tMSFF9kqpAdKIuGL3CWawgFMhXgzJFg7hiH5TuouLHZw+U9gjbDSGpmky7P2RQU2x+I1PZkP
+6olx8la7x7B90shp8Fd2FzYXS4sb7q0VTpMu9faCsjP13JJZ4hJ1zWcN6AYd0m26StfoFt2k6/Q0ks7UVWb31AN3u9O76QmGbr3a
+uzDBSqzTQDGG4E7bc4dwKf6sIqZ8fdqmG6ZNZmuJbhxfajatDTd/gKG3bG3PaZ+FwpNrmAp3Wz6hvi2fUN9eOdylsXu/J0btsbdl
+bdAxXKYRuxut3sElujqrVsTNxIqhxlX5etPqylIXXjQgpGO6WOKt+rBUFiqb/Y58J9tr96Kw5wlL2HSthH8DV28ZDXAoXLOPL9KR
+u9jZMnKNqjv5Fi6mUauF5MnQLSkqPqoy4CPoc/3GSzf3MvxNOfzNfELlwBdcN84y5RRlEjAs20mll7nruv1mc9BsWut1a0+OfB1W
+3nLU9tWtHha4GKixkQaxHRXu27nXH7xik+fp23QBNlXebJYBrlU3xiMAltd+KPJDixCbLizR0zSp1+HMwPEZyhxkcZFERmWnivJo
+WwcVa4DU0HdJCwDEq2uttrBnlITBWoYExvcnDuDYGnJsZQbPWEyNfNDS8Zo1uBcOWWPp4B0GlVsku+4s1a3lf9WB7DanzebMGcXN
+56YKOLNH3htFY7RRiHg9FBK6N259xadRd9eV8b+7JDQb0J+ZMDRru6tX/PsdqSBf6VLu1hi7zoRoAwCKYovUNxi5li1WPUQ1+2xP
+t6IvrKpYi8it404g1LzkOpQjHTDgYle0k/tTLQPkK20Qjr86dqPJ46zZ3JtmaQbENLSwufnguQkdUeARlNcBZ9ahvgRKCEKN8rxX
+vpAUATR9OlReOtNnRfneWne4vxqCHXfs9VhihTTSFFRWBjt2x961CrP4DTRYsonIOz7NBYgSFxBVF2k9T1DfsgFwyiXIffXoYM6A
+d6R8tNLtKL6w7y52jHdRrH6BVyB5HbhMLl8GcjVGEteX7B4cM/fhablptq9fZfsgoYy3LMvIAVjYvg6URFj59Ix0qPWzbIHlR70H
+yOXLByS226pp366VAvECS2AKL2M7+d2ONUcBJSWzbfthY6ArH6z43dr6gYnwYROPNRDjFb9bxdKRm7qLdMPtdxaPuxvL6fENJ71z
+ozNyMdpqpc7BgykMd1jLZ3stcuKuk6dO3+2t9fpsQOhwv7ozNNQ5eCWNYJfQOwc3dg6W9LyY5ZddN6JBg7T6LdIYsS2vz3r+2Asa
+8vQgmfGkK3R4amNJ6nXyfZsu3WpTBV15BVl7wKPxSXU7mZVMY0/+8O2CVSZ49k2BhX7WbD2MNkMNENMX2p/Bjk0LMZEbzScSSpiB
+1am5UQULsLWq6msmuyz8uYteSt/6nVGUrBN3sRMbmJbkmNajsdxBErm8ByV1XFLpWVLcQBHd8EHA7IkokDPsA0JKh77ZirYcKSIY
+w7LWJU5Pj6n6nzErVN1Hli4rgS61HTyuVl67JWGQGhKeb+ey4cCm3i4zN8U0TM2cOpSXZBqvfTh4sARxbRFlZ5k2lYqKp4BnWUd5
++143e5in4u1nFnscGHtSf67XSerYlx5NJPJNs89pKb+x/lM5Dn1Eol3SZ3A9dQtphmUTN5cOHanjyJUuHbnTLIpX0RYNivlK3EU6
+LUdIhQSCQmZjtcuxrPKcYf2UX8dRglLSqrGmktDKshrjsMKKijrLYK2lGlVdTVqtiXnlT30H4vkdoLLe2D6eo16VnuwJFXHKckwc
+1WBinXc4JecC5iVA01lvvTESInYOHYq34Yi8HfHhoU1/3T8EZkUnoyAKz4Tg7HQyCgXbEiQ/JUkUQGZdDyDNwLgmBwOb8mnWaGpz
+S+waxlcqGjUNLpBVs5ceHcGqh14N8quPpnBtBtaY3L4nxzQpqYJN1pQbrOkiHdHInrWQavYiMXVQZjxwq5lQ6lX2JM9QyBG9K3ly
+VxqXSJ9XgaVX5TIrzIJclTO2hplG2TndTWfT3bTZtAZG0zV0d2DSq5yodPqzBeC+HDqQ/qpEZs9gSPl80W+vQxnNH8qoNBS1XybL
+io9J5H7J8/0yMvZLgz/i6pxnnOcYzzVjKo5eah5RzjWfZAE6U9a67Gu3g6OS0DxIWTiwByMy313SlhFlazdbW4R+WqZ0csC8jqr6
+Nv3UhtxqVe5un20/N2syU+7PnEnN+9zUs/DOoWXnn5pu6x/oanvhf95ywLJXuv+Yg1fejMaXLX+abEf29Hk09GyK6Ui5vyoFiASH
+ZNvOjHP3Bcmt7b0xm5ps6D6OZqvTe+n8mb0anuTUv2YKjaHOnUae36BZtNCRwJ4+opqyhPDdxY5/3D3cidxWy/8kCxmGXSPsHq4T
+dGfIuVGdFd5sSbZWAD6MArA9LeopmPN5MC/I+Z78F4qdVN45qji7ZRmv+lYctpcrfXIq0wGk3nbyOks3mKqaKyWY7RCy65X29s70
+5cMkTRgIfn5PkI7+2ODmBTbNJlwAzJL7o34asGXm7PTZwEsD4TB5+6rvhtaRo4BGHAiR54bWMTSC4MArpHA17602DVxupTbtwY28
+i0fhNXdu9eBENLSOHbsNdDgcuLk+xJdsOnY5aDE28PMxOIzm1oZNt6H4bYdtulpSekpNltlPLbKwjrxmeGeiH2pZcJmtjyrwtjBm
+n117iPXMi+DLVxpLiDJ5kRjcVuoyuHMr1zy2FUBcBjf+bsOhb2jdYdMtd+fciZNfOHHP6dUHT5+/cObsAw450j7WXiT0njMXV0+e
+vf/+MxcdMjx8ZNC/3Tval8mnzpy/+CVnYZHee/bCxQdO3H/aIWte+FBE6F2Xztx3avXiGUi6OEpp4/CRxgPRRuPw4tJtjaXbnWOL
+zrGjjXvuv0gmdNPdaue10XUVk03S0+5Wu9IzesHdausW6Ul3q1201mFtthVHXJTV72M97vYG44kfhYk7nZRlOxM6ndxONr3hkPFL
+vruj0pzTdOiL82zDx9g6xE75XGw7m3Qt9YP+RX/MEuGNY+ckHXu9kR8y58JEz28/Gq/6fXyqnfaj8QNRn8lIErOeszOhKQ8cQuAn
+kR8CbztKhUPu8hJ2H4YJ7Ue901uxF2IXSOAngtCxt3XKT+LA22b9i95QlR74gWBchje8wO+DPH4J2gDJJ3EOHYpCICR6pG0/OpTn
+I7QXhQN/mEDHemkiojGE+rKZszHjeNxzpu8sLOnU80BOE3Eqld/wC2PxfX647odDiHKZ40woGO+xWETcqbuRakI5S+IoTNjuOZNR
+tHl/KjzB+qp9wEw1m/dHfRacZ2GfAVV2CNvygKUnpe8Izv4pFouRs4T1nd4SDAGcQKdjzhImEmdlqJGkS+MgHfph4qx0qR/6wveC
+C9AFANEghL+9aBxHIQsFAHBCQ9dapGvyBZMLzOO9kY0HG6ytMKGjfOqLBEm4rEUa62Zta2dCBYVlDu9nJNuJYGNnR0+U31ahSd49
+v606T/EVFKdSm8Iv/eu3ZUBjjt+WgYnCUPwrEdTx2ykPJhPqt83x2xOaTjsp7gxCqCs0geK3i4jqnC+faplMgASzzcZI99Ty7E6v
+zdnQTwTj1orfVuOjaddGOA3cHmzGFxAicEKGvTp7IhUjd9D2UjE6gV1KFIhSzuAblu1X6TSJkNgiEy8doKS1Zhr22cAPWZ8AHIMC
+jn5x4+5ALfZBG4B1gQWsJyKOnMJ9Uc8LTmLzy/M/WzZgkFc39z6cmOxMaCjPlEHNpxHG5TbttRMmZCUJbG1yN0HNzEIIM9Zs6tGB
++FcahYd9gg1kkSZTyfqebkt2XYMzjfueYJd4gKqBum/3RV5fXg0g0sQiSdrrsSSZkflCzHqWBU9VVtu3badcoB9thkHk9UEPiwOb
+2TP8btP64uqrbdMckPagzZFkFLCl5EQck0K+xg+rfl/yXKmr9Z7tKynj23pei2ydvMpUVSVl3l4UJlHA2kzyrhfW/Thm/QbPKVYj
+jBr/Kiv5VzjS/1fVoX9tbHpJAwbkD3zWJ/lWP5gAR6LQPMs0TbjEA5VjYSPLFsqwGGqcuWv7Eg+ybN7XZnNuYWuD9u3lvmU7gwnN
+N2VNhdwdL/YTJ6ejpSxySburu7CAcGdatNk+x6Oxn7AsC61jt99qU8V3l25v5SL5oi9GMs8xe1+8pTqkdlmbszjwesyKKKA4d8P2
+2BO9ERAlzarDm+8cX+xoC5YIS9jLxFsDkroWeOE6cULbKSUorvTQV6x/PLyYXU7sW6yHvA0v6XE/FlnfE559yB/TSD5kox6v6R4a
++mPqQamVrzjdlu0cGo4Nzmcn8UJf+FcB6R0+2WW4xaMeovpUT/H0xUqXhu5iJzzO8nvxWy374BLeoq+ezbCQ1Ww2lblBmfGc1EAV
+ifsFVngOq23Gmt7gFwQwuhZDthZuK7GYjXdO2kXFflHxStc0Zr3AhMVsEEJOe71RqXbdV3sCT2CQC0wQP2yIZaIzEdcFLIoGUEv5
+qRyorNmsil1gYlxpe2VhsVvTvLB3mCsmyjwQJKLlYg59pwhzIxzmUhMN4aWmGmTWhk0L+Qc4B9tBsVtuY7Ch+XVv3rRPpCIK/HCd
+cTey7Il+OoSio5VldM+eyAdFjEoO3bLwPxq3NIoq2g8lkLDYXjrWPvI/GrdA5GQUb3N/OBJWzwZp4FjjHs6GEd9ufN7rRWtJ4/iQ
+s+E/wZ+DD2EK8Ad3QtH7z1xs3Of3WJiwflsr7Tc3N9tRzMIkSnmPofI+kHmSQ2NfHFSRdjyKVQ80zzv0xShdg9oPQWuysUPV3h/6
+H3JWS7PWviT8oO0liT8MJRiEPSmwOMcSd8d4hsVhkqFfWKRs7PkBBMSmL4DBWsDbhb6IFA0isDbjcyiFYzaehj3gjOT00V7gJckD
+3pgBEzYS40C+pqk/I1kqJwlveBcIJkUKDNKpf+dGqzTuzWuWt1NJrlW+E7NIc22c54aaKETHvU7UakkcTNxwJerS1MXKLm7HDPQP
+Ksa29D36LGCwY6Kq2SYe/Mos3hCGCA72VtL2k5NBBPory17mhcU0P7gED4TwVsvWurfAcOhmofDFtqx6+jNqpWVfe/kd2+v+YPuC
+4JBPlehp/wNdwaRGG1aUdGbdWzJk4v5iZiw731D0dc2ceYJhlvNY7EFP+mvYE1r3zTFxEgYRVm43xNrvDotLnPKkQvFC5R84C5DP
+SxUEL1wGyc113XBZ5J1nfTl1TtgwXz5pA65c9IbLYfGOnNIWyalU2GfZbRSQLWE8OGdPaAnbnApNVRhZIHo+zuLWiOIj7irMSGgX
+NQORp+V5mNGYsYZqWjO+quaMlLY5yzu45rEQhNTSxzgGcxKASSpSWv/5018qYWLrURRgnTGIYtXXjKH4qIagbncq5mqnoElYIo8W
+BEnWpGIGXcL0PCq7DEweLBODlsJ2VfKNCm3MYglUdiJQ8ZZkc3538k9mApBkd8dbSwT3euJ+JkZR3wQLKoqJ/k4mVJJvp/Jymalt
+E7aoqNcseX8g6NWEyd6wCWWgKug75bFBVbz0Ellp2510/OKTy1FkjDqRG1abJcY+Quzl0Hzeyxwk38O7X9iK50aVx9N8PRbPrMON
+qNfGN8VwMl1OlXqi1AVa3hE9GspDniDw48RPnCqHku+Uctu4UyCJkofc4TJpt4kTUuaydpKuSapkLVJxUG8zdiu04Uk3xXdWptAf
+WCc497aL8WkGNVe06gRLSCMUnHE8V2J1zz7BdLuu0MXD4jU3msSBL06E/ZNeLFLOpruyINrDIFrzgulnBv4VC59nQ7b1r41xmojG
+yNtg6ANwYHigMQi8YSNhghh9xGtlKByBwHaMrwYyu2Pz/BHxHF4+VY8C2rnZATwlCAfioX41cGWxq4ebnzVMVwQV4NpVJN5Vky0R
+3pIKabqzOfIFwyN/HJFz6HLSOkRr8XQWC6WvlA8Zh7bccjTLjPjF+++b0CRnEmbuuUJ+Vy/UaapZLlJbIsvgmCJh4oQQvDKtlU0d
+ciSWvcK6riiaqZSbUypvJpne0d1K9oLJNOEXauDlLSezhufBR/0sFkbcnYmNXTgJK3xml1UfLYKEgKANstfvVwqZJNQwLMdchbld
+BVdopHFKrUvquXyZ49WcwvLh/TeauCKPd0I3fzSsI4XQCIlObooXlm2tC/jJPcnVblYNouEmL9n7LxmNlxtGWMbA4ABU6pTk2DoH
+wa0shSGqK0tTumRP9jnM4dQ02zvzqpDLYOQls5ADe2URfSpaQAcsVuyc0BL1gBCRqHZGL+qZ6FZQAWPp1hSbUUr2W3OYzpSQbnCm
+UsjQTiLInSIIQAgo0MgVyzhIkLhWyHFCYSsjdxamZXnf0A37EGQgd5KuISKUqja7VNz6Ch/VNkNIp9xVNS/oG9GpnAqyOj5F6TbC
+1gGXHGjBLtaCaxfzIRXIMZnYdJr/m0Ho90XS1ahLGU2GT28q1o6iuw7xCEU4OIZQJMcuDDkSw7LH93I2AGOmfPId8yl3NSoQWowy
+GLftSS5aVYhmbqsWujsjzgaOmGgCIPOfTOSSgP1Xb56wgNXK4zYts8z4TXh8yIRLVqXyz6bhhJarc6b8Y9wyK53P3rJoIUK2yEHS
+YnioPjXi2pMyVz0aclFx7aD8okW0bqo0Iih+Tl9cr/n+LHsArSTa585eOHPxzIOnV888cPeZB85c/JLCrFpZbAZ6QV7JQUwt2kMr
+iwfv8A5ePXHwy90i6HRvOUQFKkiTy4vkwJ2XD7mXtxaXDl7eWrr78tZtd3dbh2joHrKWHbLyFdK9ZZlkB1a+cqB7y/KBbOUrB4h7
+/M5/vZx0W/YhmOK21CS1iLXsXL6c3OLCH9IK83R7mZgKvvNseHortlYgP6HkuLVw6uzJi186d9omVKVdvpy0dIRTkhFq1EYosW8h
+SEowkuXFjluH7GWIkRYr5Tdr5ao49PLQcl5PQXcoGfrEhkd1AbIntRPD6VD4wmeJYtasZriWxJ2s+bmlWxc7WTMQEMbgUAYPd7Im
+GH5D5MhR/HtHxz409ClqhZwZ9yPpVZNPK3CvFJ2N1JmUJfQD2Czf9DxXSL9usbLUzTKxcgT0SAsLAhzmA7fM6CoFtN0JCk0H9gmW
+wImwj0PdhsOSxAq0p4kSjiIa2jrJMpb3aamUgkIWGqpKhyXFOesdG+yg/VwlrjVJZe6509u9V726Xk0K08cZ5eoZFelbqBZXRT7R
+K3c2KthqfrS1Zsc/HnX8lntYTwwHp6YEflpL3Y7XbIZTwIOeSsjZNk3qchSDsJJipDk1NKBfEqjBJaK0exiExZyxHQHET1C9p4Rt
+Ed0XbTJ+0ktg8+hJVaLDC/pf9Gj2XmW2VgxANVZUpYe/p4pyWBXV1JFMyDKDYGI5Qug+92W1lTr6c0lxQpWKdqaQxLbEVD+NGah0
+tXY4tNjyST4jC0t5x6aazjXF+xDjJjRXHM/KqZqeHk4+xXsbzZx+Y01kqgU995+0fpgNVfsMNeQM1Jl3HlE5gsCKGZ+1M1tfyVa+
+cnmza/+TdXlzZ4kuHZvYuCtby87K5YMdp+lebl3+B3p583K72/onW+/IKycOftk7eLW7crDdksHFg3d0W461vDDr0+VDlw/Z1vLC
+5X7r8qFl21qWKcu4fUOdm5ubl9v2Ieq7h1bygpfblw92bzHiB7uH4Fj1cttadvxQMB6irZIXZPlC8qMQIrguIp5kDLLF3E9YksWj
+SERD7sWj7SzmUV9mTzK0EcWaMn88jtb8wGdh5of9NMG3Z7KxF3pDxOMsltw6pArWG4VREA23s96I+4kYe0kGHvxp6IvtTN54FvHt
+jPXTnqweXlWMsR4/TIQvUsEysEFlwg+HWRIFqezRhicLJNmax4eeD4EoFf6VlGVrktNPMmDjYMPKegHzQhmIxAgD0ThOBeNZn42j
+HvdE1ve9cRT2kwzH7/eSbBQFYNuRZAEc7UGp2OMihJrjIB2vYY/SOA5wrNzzsYkNFsKmlGRez+uz8XbW8zjD3kTj2Au3sx5PEdj9
+aIz9htPHhPWzATaTZIMgAlhlQy8IGN/OhqkvPI7d8fvedrbuA9KGWcjSRHg8i9b90Nv0Ms56fsySjLNQeAH8bvhsM8mSkb+ejLxM
+3oqfYJcjLjJpYJVk3pCFve1sjfHAD7OeN2bcy3qIFlkvGgwYA3zpRwmc3MMIGRoyZQxGit1nXGSDVKxFQbbupQMWZkG6lfLtbOz5
+SRRm4yj0klE2ThOWjrPQG0bbnsQ16GXs+Rx+sE9Rz/cCCdXtTHhCRFEmfBb2PYAwXPK94XsPsSTb8IPAg58E0GYjwp5tRNvekGWI
+2RINAPr9JOuNmBdnPeAvsr4X9liG1CEbojpgFKUJoFk4jLLQDx/ycKLVcsiSUQRQiwKPZyKCGRDR+naUiSgKkmwTSEi2GfH1JPMY
+jzKPx17mJb6XrbFEZGv+OsvWAkTLq1cBvHHWC9K1rBdFsCqjOBt4fJwN/GSUDf2ByIYpTzM/HETZQ9Fakq37m362zlk/C7ywnwX+
+OMrgNCEbszDNYDFm46jvZSHo92LA2xi+xlEisitxFGbc740yno54lrCt7Uz4cQLwYvAnyja9cJhtwnIG97DsahQyWFLZmt/P1nzo
+8Bqso6zHIsBgWKrZMNrI/FBk6/44G/tBFjKRRWGQRXwIyz+L07UMOixYkKVhlG2yfra1tZVtbV/NvF7m9TOPZd4g84aZ52dekHnj
+zAszL8q8K5nHMy/JPJF5aeZtZt5W5l3N1rxsbS1b62drLFsbZGvDbG2UrfnZ2kPZ2jhbC7O1KFvj2VqSrYlsbSNb28zWtrM16H7W
+62W9ftYbZL1h1htlPT/rrWe9IOuNsx6QwqzHs16a9Tay3mbW28p621nvatZnWf+hrL+e9cdZP8r6VzPWyxjL2DBjPGNJxkTG0mzg
+Z4OHssF6Nhhngygb8GzoZcO1bNjPhiwbDrLhMBvCxGbDIBuOs2GYDeNseCUb8myYZEOY7my4mQ23s9F6NhpnozAb8WwkslGa+f3M
+Z5kfZP4488PMjzL/SubzzE8yX2QPseyhcfZQlD0UZ+ssWx9m66Ns3c/Wx9l6mK3H2TrP1jez9e1s/WoWeFmwlgW9LPCzYD0LeBYk
+WSCyIM2CjSyApZqNe9m4n41ZNh5m41E2Xs/GQTYeZ2NYwdk4zsZXsjHPxkk2Ftk4zcYb2XgzG29l4+1sfDULvSzsZSHLwkEWDrPQ
+z8IgC6MsjLOQZ2GahVezaJzFXhazLB5k8TCLR1m8nsVBFo+zOMxinsVJFoss3szi7ewK0LSMRxlPMp5mfDNLvCxZy5JelvSzhGXJ
+MEtGWeJnyUNZsp4lQZaMsyTMkihLeJaILEmzZCNLtrJkO0uuZqKXiX4mBpkYZmKUiYcysZ6JIBPjTISZiDIRZ4JnQmRiIxObmbia
+pV6WDrN0PUuTLN3O0qvZhpdt9LINlm0Msw0/2wizjTTbHGSbSbbNsm2RXfWyq+Ps6qZ9ee0Q2DOtXD6Yb9Ct5j997vKhf3T/56pl
+Zwf+4ZbLK5e7ywsObXe6t+yWr3uoXkInVAvShqxNqJb5qa8DUV02JXdbhfhO/el8Mk97+RAK73zPOWe0reX9lmcqIGaJ+L2Rx+9i
+g4izczwSUS8KzrNAWQ2gnP8Vq20vA790Q0czyAQ+qC3D1bHx/aXECVVGBk6tWRLKwcUhhsprFbUrFtM0aiqcRQO4OlDWNHC51vCd
+9MK+39fmETUF8PoCWWzkCu0COpANFgaTyu3lguCtUWvQTtKBjE3yQ157QmvbrAipZgdksz0au2mWBXTggsUO3NLnD6xQP0mu2O4s
+i3SK3HMzT8eBU8+yhZpZaPsJhuXgodU4N0IGDUvunozF7vWSS+GaF8DG3leC0TmPsxBvtbOEK5TyAg5clQoAHqQducQGb027Z9o7
+tE9DN62dcWEZ4gglTUSTwtAmtDn6w3K8MyfB04sl26blyi5KKFSrU8C5Fz09HX9S3OaiUKHvxrIGOc5d1oCNGiVCOn3sUr/cpUm5
+S2DIWukO2LwLGquqL3FZtbOwkOSJ51ngCX+D6S/xLvYcCsF2cvxzBjTHPmckZS+nN5nQ+XPoVO3XbfTGR4icEBYr5lPrltU4rUOX
+rUNDm4ZGgn1oiAvHEnCSoH3KF+3jgLahkaCQbWExv/5QiqJlujBDAvVDdDSZRa1WLm92JbUaecndaRAUGVWGOULiIZpy/0JvxMZs
+t+xY/xcj3gel2ImBYLzSkAPa5e4ty7qCQ9RcdjMMHBYAdnLNqtyXdIcsYavz7FRh0KmIJQ9E4l5vI8fbs/xUJKDKOXlPiPuYl4iz
+IdPdNwv4yZkpABeoaeErbtWu1RxBMHN9lYFqgxFBSbum6Ckp7JgdvM6p2SQba2YC+jnNHXvtufsC3uIk1L0FdXihba+zDE8nC3MP
+0ia2XdvsNBjntL0gbD2vc9FG9oMhjOfNQ80xzQLccCWncM4KyRsodD9y2d2co7tdVYSGReAs7ZpBPKVxQn5kN7ta4wCrNo8xWtyA
+pvRnBiTm6uWgsNQmYj2zxoD5qr2frg5yicghrdpC84Ak8xrDUlvhjQ5MbZlKUVraP2f0oLTJ7j5UbUOtiqERtRr3rJrqx0/+qbaY
+AYpLfJf5TXkgN9KcxcXeabWgJdWBy4f8qf1ZF5Cbhf56jrOYhX3Wn6+MTrlCHXCyqLcvSHNfm4Y+kZ5e/FlW+qYZikpy3qni5baU
+By5RVvD5dd5T+eGhLrr7jObWH8DxFJbJh5reOO4cGlLSJPacySxbKmAdu4+8eLhPcUPlPOf0WyY2NTNBYqXkJR4YmdUXadBzkXt+
+AF5ngSfdPGi5RO0JeVksKeMXJURXUt/hPdRYi4ey4ppe11RIDs1i7BAyTPGzixRYPSqX08S++S71yhldekPmtieCkqIEoTv4fo6z
+sDixO9oHv+yCX/bAP1JywL+17H5fcr5fLPneLxme96XDaD0ukWN72bFRi7hlL0tMtUR7dRWna3U1y8r+iMK26y1sNT9vLdKRkZ0y
+m5YdLQVd2Vln2w4Br0/l1S2BVb+04LwgocCOoa+d8ppFrl366+Z+pOid1ks5Z6GQhmoWB3cpvSj9LJsmAYXre+kU1iKjJSJfmD7Q
+eCBqyKYaygUWHrxrkAOU0wOkccCeTCZUjkm6Ls4fUD7uglzM6gTDHtiTCVy+ObGKbDkc7E5xu8E2RfPfGGh34u6Y4CqcDtvQqbaf
+gLe4z1mfVoBo5JSuskbeCd3WHwHvwYjyv9HyOrxUWl9Lt5WvuCjfcFFaYEdKC+zW8uUWpastFuFiC66vkFiCKyS4tQa39u/0oiDi
+DvncHXfcQeggCsUFsR0wh/jCC/wemdD1PSzQuHaBjndboMH+FuhweoGOahboXpB5zurMXWMTtMxIQHzyqA9O/ywWI+q56FmnLx+g
+qcvacEgB8wSFLdJnUnLyo5DAvKlkH46lCM6gTBC+CBixsyyloCGb8qcsRBk0m10hYB9PqKqIltqh5B/+gbMB6Rrm8hPAD2GR+z2+
+Dp7TBBAF4lGfBSejIPDihBFAG51IAHmERTT+EsCiuNlcnUVtktgLCd0xfNzGUM9BObQJvbGCq6vylBy0Y5rW7LemOY336Q424wxp
+T0Gh7/h3ekUMrw8MhUNW2u12l0woWSHgiu5fZcuDNj5BfIFdsez22IutaRG8vGUxehg98sBIiqOR1G5j2pBkOURzQQ7MbvwFiGMI
+nMlkmlypmxN7YqubR5Zn1TiiO1Ij7QQ68y4AnZtnTMvbK9xyUCyrnWIJOSGFtSFblEvJ6VGuCLO8awRu8PBbSxNw5yddYuf7xra5
+X6ybe4SqaWs2zaelbWRr5jZidHV2ptK1D3NbxcEWGaRhWTHe4staFAXUICPGJ3mLFe3XJk9osXGu/zfaxY4cK+1iR0ub2OHSJnak
+tIndWr6iqbSJLZY2sSVjE5uxJeEFZpWNRe5HHXmiUdkyanelpLwrFd6XULvd2ZIpXI+dK6yPXF/uA57r610jlaT+QYBXwTZxeWWK
+u4PYEul14akdK51QXmx64+lNL67nSrGVvW572FPY7rxUjCLuX2V93T1UGaORvLUiKMH6SNfeF7NYNKQgEVa3WthbGecFF+zrXkVu
+aJEzYZwKIpGJnI82CSBUaJGTUUAkUhHoOXpnEUCvsLTPAZ6Rz6fj+GJ0zhMjAvw0jXNGVk0IbrteEGAt4EYx80qDBpNbNrR5pk9s
+13XhcEN9HM4ipX1/Q1HSmVlGR3fLAeYdKo+fZUJ2BCBFgGB+/N2G5cX+F9i2TWbW0aM7sSdGzgpJWC/lvtg+BSvch0EmBJ6gtW0a
+N5uze3mr6gE5keMLMAcz8nvzhxTku5Go4ZigLzdYMYl1N5ETaJA9QbUM0pvR+plwn2374SdrOfDWWKBbR+x2gNNc3ksXSOMW/AfP
+Y83Kn8xvP6I7wJs60rqRRuHJkRcOlWexjuEGP2gj2ajjngxvvVntpHQHL91xGAVSBAa4BbswrBMz10y2oaB1zmplEy/zC6szWQGT
+ZE1VYmZURH11N2ZhtcIsmBlyOJb7I72Ct9xa0tspwdyddlJkkjjnWdCPQjq7yLmhvjvF3TG5Zemtn8PBbjthAu8Pg00/tHx7MjGk
++rX/B2mwQuvwsd2Zk/+uCq0b3s9xt+bVjR328SiEbULhV1TiMqT8bG78MBlEbmFQCrd3bpE1L/F7KiFw9WUqriKYKArbnWTTh7PO
+nr3T8xKmaiFO4I5nEjIcdKjXp1DySXlhe9QgFVGZNvBiWQILIK+Vx9axx/MaTz+dxvUSmd2wZERk4xNKLqnHUjQz0Ojn3EADANsg
+tJc7euylzhY5+FA6jsmE7qL1W6uR4gwSyfuMs/79XjxFJodzyWSBbXM0hvXUv67JCS31szQ7w73KnPM7srcKy4LnvJzGbYlz8xUz
+bW5fvmDcWwtYCQb/z6TlM4m3fF8ELcY6tSQ89/P13Jwcq8Memrp4zQV0NHAXO8FxrxO0Wna6EnSLzCtBcROay926HYG5u+wJIJuq
+jYHRFSjU1Vf2pzZeLwEuNKx0n5hiCAwUasN9qoaIYC0sgWGEG1a2KU79T3u/KS2WXGJV/azffUq7C+w+pWWEG9AgbJ+4cFG69o+i
+zdAcq9p/oJmE2J29kUFD/dj3vSAaHky3yITuvdSa11vv8yjGcvY+CoKVfLDf1mShG+upbvAg+nDfWNER8/rzy46O5BLnhucHQJ0a
+moJLFxkyB0prqRCgFVdiiY4aXcFlcBA7hAJL4PfWndzHjM3pWbIxJHRn0++LkUMOw4saDDxcMDynWJoARUP/dPI5bIPQLfB4uNdM
+mqAYeAMQ7UmVNdyDMkfESnY9QFQ8SkJPXLjoeKX9glV5kdLG6Ff2NVHak8LKXhah6Ab/5vILpZPBQfjfdffdM4ewx23a3Hy3/8/m
++7/P5qsIzbTozitbnt7gwqkt0HfDtrEqL0a5Rg4eg53auf3/Pht3Rf+rVLg5QT8XxWksNbU+XB9QtzNH+KHg5C0bD+X2v0lDFQc3
+uRfH87ehfCMpykbLZE2E+WbEGvCcMNwvXklOQ/WhvL2oyLwNpjiAK9StxP40d6RomXwuH8bnip4XG9SMHKjU85vNWY1wHMd+qHwB
+6KrMN7khQ5G903vG+V5EqP9Dw/8PDS/RcAZG2YmIwMgw9oZIzCxbvSnAC3UrlOqALbz1X0CT235yYuqobz9kjy2XyfXqKpC7nCTU
+fLtxAviJ6RnblZ6x2fRs77TKhKkzLB3uV/T4OPwykfrvSiCO3PqZHuVL5XlukPZfeLKfH8+bCkW3zsMmxMu9igOQaWsYysB5hYOD
+3tgX8kmTEr2IOV40cEoWsvDizyk1TM5MWMpmAOsMomGUijoiVK20TIPwWnCjerwkyWAl0YRh5pmcmNhtEUkqbHdC1Qsrwi5VlEp7
+H2Gtoik3kLgRS4g9UER9f2ABFRMKXJ4xmDolUeFmI1eUtUweaIxgls8INiZyxZAICh2WHC2qlO6SVBWWkF9iYmExzbP/W1gIoEvS
+pi+uy6tz6kbRlco4KZngEt29nDtVbm9GDlO8dS8KhedLBdHCQoxs+hwDg0HEx4TuROEFXDDK8TJfPBMalzHTvJp49qH5jOOWqSMV
+4/ygcnhlHBoEZTEfNRjFiqD7hM+aCA35Y86Bt6kjFGFDKny0pNGAavpRyOp1WOQUfLKpBL/ruj0MLN9oc5VWDEI0oeQ+jM0xIki1
+Ok7Oa0kdVzO0HPRgf2nIQWBF0GwO5o9kL6Y3UxOT9KKYHeyzAZnswcjjAuROGh5njTRh/YaIGkPuhaLhhQ1gIH15lU+j7w8GjLNQ
+NPAN5wTerfTwPSUo0veE14jCxhobecEAvokRw6fz04TxdgOeIWmcOHemMfbgnK4XQHtRyOBdoXHEWQM7nbSJvYcuQz3KGDHBdgYR
+vJkMT26qahoSwRubI783gnaSxnaUNjZhXPkARdS4IF+fa1w6A26a9IYoy/7tP4wzRzF7ihK6U1q2avkzufzFxC4tXGkOO9+KZNNk
++uqP0HbRR67uUT5d3aPgubpnveXqPBF1c+Y552c6sj33eM8wMCdpa6/nnJv/x2ZlBtudzGG792u2sleD2pIdrV/ysdD2mz03aAOZ
+DI3LdsvWtWnVura3vKNLOL0JPvPIb8QHbF/WtgU5yg1ndHfxxgZlbRsW1rbwXuCnYHGLQKw5I71Bi9uqhW2vYoY7ZXGbzxYduP4N
+m97yyR6PZXHXG+9qegtPtfq98vEimVkumG9My7Ux7XifxrTj+baf49m2vbsY095gxWWj0kt60aBd6XhPdqWExvDXdsbzzUrHezMr
+zd0oiA4RuaUTjVVzTU9vChjOeUmyGfH+fsBQmNfeOBzqxu6lIoLlHTDBHBKyzYOx6p2GSxGXcCzin9hEd3wjJrrj/ZjoDuea6M5W
++CuCP0+Fn49+Zi0VE7BdbdButo3YjB5OmxWPvbjesm3sxZ+aoTCFXRqxBG+fVm4vykhYfuh4K1HX9alhJqzYHG9i09BiWqH1/1Sj
+4f9drYTls7jat9YiKPQSW/MjFhmzJPGGjNjaessicgO7EWMrbCwhNEFfyB2wohryKA37J5Vf82DAGGOEKj9nJJxjjw/90CFLbEwm
+8w5/Cd1RFcMOdJF7YQKKKof0vBgdpK8yXdt5eUKhquS4+wl7T4e84X6OHCRx/V/w3PEzP1Y4duzof49zhfV9eQyammHaU9GCV4/h
+WaEUl01kU/WEun48HMUG2geX7ViurJwzsuEePTo2PvUCn4UCWGp4PFrHMNvGVLYLrMeZMLPKFMw+NLJrFuMiKnmyDPkVloiDa1F/
+m0wJaV4cy5vh2ypEq06RUjEFDzHpLjpjanbB2aC5RNencc6gEWr2xRl+ZocJaipv7BxBSJSQj06ZZqt4gqCFNFgsSkjruVwLaXHp
+kGGgrVi1yAaraEpk67u8JLKNXTgqPnviwhG64Y4tu3LqexIYW4dI/SVG6LbMJqfkJGd9FgrfCxLIVqhBCV11Q4kigwA7vqbjHugg
+WV/qUwFnVHqiEuhW5bgEP3uwqhcWtui6G92wJAm8yGl3YX12IbIhb0WUz0tXN6yJtD+iF9ywRvi6qf6hHiUN6yxIjIdpg9AyLIk9
+x99wNF9g9VBgVTc0eYLplThbtz46lguwxQSDx+N0JVL+w8wXZh//9HMZNqyVYenm/n1TLeKPoXM+vla8mmWrrutu2M3mHtTkJsY3
+Lp2/b6/enGGBYHn5SzzAM4uCNJr9kT/be+rWxWidye7srTekQBMBRXVP5rVk8laIWxNK7g6izX1CQKKlbdNi0Auuu7oMeZzZbsG7
+eA3vyQcWH6W6G3g9PHhYzXfACc13Q5DeN5f3CkMDq3UFEq3nuFsLUDMLZ2mR9lmyLqLYWVqcc6jkI12nO35/qtvUVIGQvie8g5g+
+T+uB24Qh0t9kkOZzOinmdz8g3d3f+GZAcLbyowTF2TqRTw2KuslV6BgAUXbwBnHS5HJuNl4myH4osJa7XQ9FZPvmQnJ2Y5Gk91p6
+KHOOE0rOy3gD4/be65F+mRNTzbuf4ldSxrH9f4ZAI/a4N2aC8QRd9m1qIQXPstr9JsvKZL/ZVN2BS3Xr5xDuBVz4/7P3pm1uG1e+
++Pv5FGyMhwbMajbJZm9oQby9Jkokt6KWYydsuoMmiyTcIMAAYC9u8j6ZJM6eTBJn9ViaTNaZSWYiS1msLX6e+7cseZ6Jds375OV9
+M/cj/J9zqgAUQJDNlu1c32SimF1VqPVXp6pOVZ06Z7fb3U2nhRg+8ztkpTgyHbI8Nw0cyIHn/TSSwxqS5JPgCLicTU9ALUqsfujh
+Q97v23fQl+92b7lsJ9Yj0YD3Ya/xmg7qFbZ7G7y8ibEO76mx3XR6K53eGkUspE/mwx0m8NEs+MCxHQogvXV0aSQzeH89euWqTVrd
+3rL3hksIMdTYzaFqEaPGNYdtZqRxP4dxtEuJ6CP/UjNc6N+auss7ICgqRJpmbQub7MM8OrEeWoFha06fQjfDg1O1o4hYcf1xo3K6
+nD+0RueNxQ1Kj3jsJVufVAnZfkfqTuoJdynvTM5sJZ2WB88S7cOkzUKRrCRBsFFkwB67iCA8Lgx2BMmdRIUr5F3QCjOCspd3X8xn
+dFU0I0vXCC9gDpX/YUrzwmi6tc/um7aH3TcFYMcjeRoVjtCib7zxkEwUWg4rQGweWzxDM2Q4XLYjJ6PzTrZqUt2RD9jxj4rTIJv7
+dBRt5CcQzNfDI8E9QagbglU+hcWetgqwOaTKkWFqPHycXFDpzJcuYU5NuHYL7thQLTDMnrTGHv3pnu76SnrA2oeTTqNiUH9e5SJ8
+vgJQg1sOseMR+HOWslGJaPE5YJ9RsJWaLh1z0ulYyjDr4+P5dDohcSzBkLO4MVShJsAirOiHweJDwcVZLIYJ0fsVFznEiDRS98tj
+U1akIC9Zfj64CcWio/LzlkiZtmYx0R4jRmr2UDrzBfrlsl2JXofu/vd16F/Y01+YqsWnv3Bui/JaLTSmMe8xyjpD3bZtuVS2iKGQ
+IBC3xxj23r8ve4wXZLjcAz+C1U3Z7S14dIUvBRzbxDdjg56KSUuQRArW+trA+1EBwaFyGHgiPkyigyE+LMpf6gvPmOioJc/CFY1A
+G73B961/VuMZ16pnGXOtJaxxTkyCRqYRRXvv3iit+oNh2aidsjtWkm0CQUKD6wrBEZNOCx+Cig6InK0bjuvJSqgMNij5WcM0z9Aq
+RdsadtvtqwG+CYuWHPXzJ0JyLNSwqmanRl2ZCxYp3S4VEU2s3+NqqxUzwwk4mL1QWIWxXqLkLp42jC6uwvMfB37A35alpIxswT26
+MuxJrH8QOyAzv4EOZDRAuo9TKkxbcb3lh83mwZkoYEp5WbRHaHTTy3a9Q4VZIhsyEW5xtrboWh0C5XK4vzAN1xMUkrs06vtkReHV
+is/YA2Xphmka2I1ZjAjy2OOlQENJpP5yjmxl647d+tC6IpfFu++JF1zbAhXKf7FvDaamCv/vKshsop2rVbvaGTCr8UmdjVFZITW7
+ioVl6R6tLtmtlm7V4Ai2vS89xsSU5fcgBA0LbIYVpO+yFPxSBy6IyVFms/Y+yBx7dNizfDiL0x2q4/NORFHl5vgCWIlD9dqaZe6r
+kud0aFRRF9TKl+/bbRoeXW/rsH+ybKelg50HhqB36NP9iEQvB3VUSbr3k2RqLjpcp4TxmvRYHXMYoIAnbgrE0OyRtNLAfJ8qwz1k
+tgr8hqwQKVUJJV7s0Q93oU/GqdVpwZXGitVpqRIZmHzLYVVQBkZxwHJizIyHAQY8sLLMCjNJSby+Sm++I1IFQ9vtezAWPQh0B+px
+6Lz7ZBIkMcIkMkV+Ieu2TcOT4c530IpOmcnPZ9pt3+RnhgYWdHsKByQlKY9HjjZbPXTNkW32XqjA3rq77IXQJJNPM/HVujzNhNLa
+sGzg6gHCZ01YNpCodzRHbsGygavHvubIDVg0cH8B24xicSqy0Ri4enQSV4/6YauHfrTVo9a/elTfsdYsWjM82xGPACLygU6C+TAj
+COPJ0Io0MKcvoKzfScOiCussMWiebbEsUXaOuJo+RBTOazogJBh/ydvtMjFs4QMX+u6hVeIxt9t1/XniWD5i8Boq0dGcrOGug7Yv
+ucyyOq1bVKqggKKp9Z86GKg3Ih65o/RIVXOzru14i/tDBABNw4qoNtgZeOnj0CRxc+FCZWDSJoihtxNSD01UTCpuc9M3pySxjpKU
+wVkMOnfxs8Kzl/HIUYvZI52S9EEDJTuhGyRlcAFbWX+5IAeGu9amFq2pHaJbRkv3wKRPbkj7Bonv90g14XbMP3wVFX73USLsnPAk
+FdylQSXv8kfjhN+nsTzRA/RLSTgu4PxbleAYaXiW27Es41kwjeU98YZqJ4kr2RPXn8jwV/fj11GRK5/9Iadbcet4h8cdVOYAfmgP
+R+5uIsOBgODcJcw2MA94fUPf0TxxSB46IJMJSBiPXmnncF5451Be2IuK+fI3usG8VjLkWAwlI6WkTDQWiKB7vlQ8yBwNrpvb0s3g
+jWFK91KxlFxJAdk5hK9KYJPbDh1HdElL33uW6dTK53J/A89UvNiLHIWMgJ6TTg8eFRJj89nEYmW3DKsms1RKj6DwOWiPgPqkJOLw
+ZvE/cVuDw+gJ2FcgIv8sJgKzo21mTxou0C78kaNQjtgL/Z3AmaUsdMfj5qGoEYJPp8esdFp2tNHysy0fukguh/bbn2DEjDQiwFwR
+dPs7ouPHoFs/P9yLjtdo1XaYRL8qdawaddj4qHYcF8UzbcNCi9w98hiEHu2ZfqKPfo8OgPnd/qdew6ZuYcnZj+1J4gdYQlQ8oyPb
+71ZR/31J+Rd4SRnV7CteW6L9lzZlAwzV7+Mlph5eYuJ1vVHTPXpad/SWK5dtogOHFIQv0rrt0JU9Wu14lH+GaTK4puCf0um+IFkh
+VpZy90FQEdVgN362f6+n95iCPv9g97Rj1zpV6o5wnSO0NluNJGZaLsp+RGy472HlVgh9H1/OctyOdjXLgR/1cnZ03eujXOOGHfwe
+X/aSgML+H1Hj+e7PhX8uL9aP/Mi8btvID4xq0XznfUQG+ZkIHbz3z65nD390LVCMmUgxR31xfRjFvHuvfflkzuyXuPNi3x1R8Jxn
+MYISyL5jIZ5UOBf6IAtRh71rQYH+xIyGle1BnMMeqXpOUr7jDr4fHJJ1YqqqDZc6aMFTUh439XLEBvtjZsLesA97MenhWySGDh3J
+MLg9imHwUGMdM0x2Sm/LjlIajv9hrwu82pC2WspjJkyyef8O8/I47GyjEpEviAoYHC7xzQl8uBLEv9RL+TmYt//stzT40B1VtQRE
+Emm0k6WmRv+kolhyjuxnm0ajaYLSGNbWLDUTpKqWjdoz7Zru0cfJ5Wg3T+E1cTA8w96xmPaQ4fckDq2zvUEC5MKNvpWRUi2j6thY
+b3w9k7hz2Oy/mB3Gnw+T5glH+OZf1AifZfenICKwpTnyJrBmOO53/7yN1DZt10NS3tJdCrpODhWU6bvpg5TjHQf5gHJqUXeprwjC
+IxYTHhhdzg3q00eWxK9cP8HG39H8mfURvt8INYQF+om8Jk3V6A41YQxJocYwJpcUaAujLd0wpaMIP1np9AixWsOOetGsCD4JcnWY
+3l6kzzimIlsKYdJfqrS5ZerWNsxnREqNp56lW67h4eG0k04/Tt4StNOzVSkDlydWSVqnVi2FrYfzZCnjqRKIuOhVDzyHSD9Fznuh
+A/pOTBjZrfz5kp1pVKnl0gTKO8m+xGnuKCRWGtrFMSIhA8kJlkN1FK1xh/X3itjfvOUDunz9z3s1MKy6jR3bcUycRXB5MITlAY+p
+I9JxaKV8z6OOpZvLdtVFhVxIGDvUcdmusqMladwlph/MdueKYLScOi13rb5OnR2jSpl+ZPalyoYxau0KLogYScJiL+sI5n4gXnzQ
+U2Bf9KF1GZiAJrZsR2tmhZqQhmZHNHttgv+jrPbrnt5qP46+RcByqNnWBLGXlm5YQ9MUovH9Uw2TuIMnzk1ywHsC3lYqxOl2jYFD
+cJewJdgJ11yjp6iHrg1HHLmt0aUeOWeRkpCZkI5m9DX6+HxQukagVKsDAFXf+Ro4IhRVuPU8C7QO5ixcn9pRKXZ7+IuJbcKWprbf
+O6Glj0FJVkgwu9X9VLV3ZTKuKT2y0+3WYhYiEmfb9YhZMKtu9021pOOY/TzgETlDIs5J6lZEC/BomoXFzdA6iVQbx52QUrf2iUBq
+sS/+6IuGJjXyv2+I/6xuiFdRPnSIdTTxjdu8f/0ZkTLLdvBQg+UEVq3+75ioHX6RnWhbPi58C4yDrbuTUfPyEOJnA4wDTAgyYxg6
+jikzHsEf5DLjEGAmkBlLkKCmUWacgTj8ZSBJrm2UusgIQFIHBqbMpKnZcs3IUwquSl2m+RMErNkDAB6w6SsMRQUNi57FgrdCXaN7
+oa7RXXCus9IkhWyD11fGDKlWNMm09ZphNUCM0styD7zC70D11jWprhsmrSV/XtKMbLXjONTyOJUoZEE76M2vp9PyQha1OWuozVkh
+K2LQX+u6LrHxvgx14vCEvA7Xq+o5snKEh3sus3c03jEONXg/dB3dxs+DF/utPm7LaWGnRQyqDUq9Rw5a9pZhUjVf6JFA5npgO3cI
+W6tcnLc7bDWqhsuPGV1v6tE1xun54kukmU43cZnudttHQRU7Z9Sm9SV0U/7lGRGaHdRlYEWWyQGnrXXMR/VCiRUWIiuEF6E2I7II
+Fm/zEOMPm6yLRckuUkuna0d9K8qG8uOAw0ZlIjhDOGSeTK0RHxwMUG3+sI3WWABgQ731SJiqZ/vCWCxwfVR3DLh8RPEYP2rfB9KI
+B2PRifFW6nVa9Ywd6icwO2Hs4CMG9/xbI+wWTdOWut0xUJuyxLSRHkEMACeix+gQlvBI/RHoUxOyCWh0nNeEx2mbepU2bbNGHVVi
+s2Vqaz/l6Y2o1rz4q2BxISc+r8QAYs/wECRJUpdCdWEr/CXewlCTKlvDJ0Fxmgq1yxWGbWXYYPrTlbjvl6gMOY0Jn04+badglki1
+HXvHqNFaVjqaSv931UD5qLJTo0tjRTicQ2OOUnb8gUJj5AcK79y4yvtVYmuqENmc/IkNL+NtUbIAfkza5R2pMw9Uh/s6bFI896EG
+LB0qnHpGj7L7r/h12CuJ/Z1wru03rdZhU+q73TamqdbPfcS2ESnVcvFRauTw1s9FaKLVaW1Rp6+N6/+vSVy5Tbtj1oIxO+CuOxx5
+kVfxjH7Q0rHv6XaFGCD1iV/BEfnCxD3xG3NGvtYMt23q+7wTlzn6GDv502PeuPuVTjbVJ+i4MwaWzKwuRbaxLm8w6QStw5mDWUuE
+d5OnOp7u0RrPirS1aknPtiKBq7Yju6SjqHrWiYaEB9Qu7tUktgsV7ulqvjcY2P5Jdcv/YtneMteRgDu4Hf8Df5FH9v0A5Fxg0uJ+
+YURtxcSpajCZ1cpRtSgVnNmYEgHYdFqy5MO+yNRIL2lbgx5PH0FFKWsre0cCpEDZ4S4lElwZ18q0QvCVNexic2OatsSPdo4w77QH
+6xLeJYE+g3YPd2PvismJYB575sxJ6SiaR33N3eyUe4SprzlUyi6sEGP3U34XHlHc0U/mjrOP77bYY5g/IwfpCNJ4VdtMVW1zk6UM
+WgiScZt8pIEaNLs2vNFHyDV6iyAtU083THd0eccjw3JUOJIxqJNW6SiUyPPoWLVwxhlSESN4hfmMkCIVPsF8/CZEAd8pNUa55RUv
+qjOSGj6pCx+/YcXI/sD81omvaEndF1UWqXt47NMkPgsVY6CDs46FgVlvk4D/WvJjG+n05sAEK0RgZ4Lzo8O0+K5HNbcwTAV2yLBc
+T7eqdK0u72ZPcJ0dSt8LkM3DX4AMi5K8BAtJtmzbfDzFuyPqGU5UvNu3u4mgNWLOAah7EQOS4mXRf9/i/CmsYTtZz240TOprWahF
+1F8ICncDLTfqmOPr1feDekovwZh28Jl0RB/XktNnmi0sgKkyGNM0vaSrXuRhqZBpPEO10+0OisyjPI7t7dHFffA+81ARB2Gypen0
+IdEOIm/gYj3lK6lKeEdMFfIe5XwEmYOWXaPmOMtaysiiQQa/oJKUCtySKjH70YkRkwL9jhf6oNo0zJpDrREeB/ZlE71ZDsmxEZlw
+iV9ELH78NpsyYHpkP/Yyuq9c6SCbzfYkocSxHM+OPZ3+y1T7+n91UtTxQP+svjXsxllUCz4vzpVBarShEJ0axY02Ux3f0QJNUHg9
+egZnHMNq+Npn6J7eapsU7L500mk2rrhH7mjBZ7gu53NpWIHOezrn+aKN4VGC1XdAAIcL3ESlgVJuWFu8yTZc/saV6H4+DBhX0xEY
+NwLMyl5bt2rLtO014Uab3ybzF9TSu6KdsGPGZMP0rWEXsKbRFx2Nh2RkG7RU8Y7RIvZ6gr4pSSnmZjPfcFmoaCGmYW27Mfs1fmkx
+OxZ+afhyGmOk8J4KBYVLj9mygAST2yWpQdMep10Z2S5JKcMS0Ik2lRU/uKFIF+EWagSCGKW/lHTaIGN2MAIHRBu8qJvkgFvucKIc
+uCdy2nqMj7cIDekeZeuOcNczqg370R/KH27tnsMYXR/FCMGwjy+tAgr/z92mFPJ/0tuUP9enzskrirA0OMHEP6rB236edLiJK4f0
+mfUQ6ncgEKlFajgo85EhGkbOCsHdbq4X0c02SFPsCMZ9kg0LvYub+qPY8BFaHr8wer9qtP0TKyn4sx6r/UKNfVdLh2oQ7Wf/eK8G
+wogdZAhNrZOt2VXkA13DtkhV6wzkENuCmk827oFjqndMXLnNblcyDReNZ5qsc2PsJCqNlUJlxf5k4x6iecGlVa68Ppx32iVeg5Th
+jtttavlMjHuoHgafyRmolTRo21hb6Q3JTVSozMUwh5wuuzsNiRzsMv19hZxEmhSez6J7SCEdF4bcHvBxHwQp93ZJ+msTdkvjuuPY
+u+P4NkSNhElDjd02BbWfbZAxPETpgZOk9MDQHFB64GqOoPTgqItGKIeFN24c9nHe3eNSxhiMS50wY3BGZLqs+tyUGzsIH8YOgt7x
+IUYQBq8rQ5aHQ6b7PumZAREG2XIb8Rz5/blWTMbOOfKRxSI/E1kt5qJHHcXIclGILBeTkeViOiIoE5WTyTFx510QcsaFZV1z5BW4
+SUYZmoUR1pdW4vqyedj60jza+rLXv7403vn6wo4OYGGxfLsoQDV1XEb6HtEJq0hHo1nkz0gVzx3CpaEeOySipMxEfHWJsLsvImEZ
+EpHEEnwv5g/bb8hNIpKQt1SBPq/HlkV4M2e4awvrkwMU07ZwfdvRWjinr+x5FBc4lzSSH/0FAgttRsoGdUWxBb1Ww6VTN0+L37di
+TwW7XYvs+WG+kWMUtjdk1CN51ga5bF/C3oi871tHP2PEyULgCRdMspx4eIa9aGkgoiwrpVD3hMu72s3iS2Nh/YcAf9bePur5MyiS
+HPfscdSH2iOD0u+SA4gBl5BKj6xp2yMso6PEkQ7g8CKbzUqjRe/BMV5pe7AkOa4jcFUKpyKnArqSlZLf99b+Wl3il6NnEyKguRs/
+wumECJbt+Z9PaFvp9JEx919UOoEi4yeecGhdUh4jr6ZhodKOSD4Kebw6bW5yS71bivK4FDWEiBYIf9R2QjjM7xyv9t9VrfWO0oIt
+R69S5BtTbEWVeoywRqOUUYg1+gzEos54UNL2EUReDkMoKtFR2h4q0+HfQsFLG1X667m5OYnUbctbx3DJ8HQTDO0PK82XqRGnT1VS
+RkixPViigD9zbYSi/vvp9D7bFuyPpJXLjDGoDtPKZaNWLlLVarKSTtv+xN92aFVnkmttbSmianpPSaf3spw1dWUHFh94qOwZVd1c
+MI2GpUqe3ZZIG5YEq3GGMfG5bAFMXPtDoJ1Oy80sQPsscvmatGWbtaEw+RrAHEERTjWdFmt7SL/w7m32iAOyZtujqWjmxADrVK9H
+pKeGzgVCMUmgDKGcdRLdXjPOhQ2JcSnD7I07GWlTytggI3Tgr55q7GGsEePlGcNv84OjTibfi5vyZhS1M3xsMKL+Xz+QgujeYxFe
+VB2cUZelvXG0Q8CNtuRIgZv1NTSn5KBkJf8jK6qjikrRDycWi8SG9MzMzGjj13pHvRzdEhp4DNeH+GY6vcnG8OHIjzDhHEs9lTr+
+zqaaPiKMUtpYfhRS24yRGmvsqXenkchrpMaPj9TMU4Mt0m8P34sza/TvLU40ilM4t599d6BCrmtUqM7+vwnV6XcHKsv2RgVq+/Cr
+vfcUitOx0RVIGB6dvauaNsqswhYgvCfYFc9zFhLuCdZHFfNbH+VGYH3EG4H1w24n1uO3ExChrq5HL/zE07D12OUBqSUGi+dEC+/j
+11TTkXOiYuSYqBA5JZqMnBJNR06JpiKnRLnIKVE+cko0F39NlSAz0x4iM9M67DDIHCozg6cx0UMPO3oco2s2t/jF1e7bzjOO2ScG
+CCLCBldbIEbkUoGaprklqel5bVedmLAt07Bolr+Wzxr2RJBEUt2I6Euj/3CqOUyf5igGhv0jjQF3IP6FpSXjEXS03Ux0IBAZgmZ7
+Q5ttjNhso/c4Rj+FCnsaWjXQmOYCgtSxG1VVI8g9iJUN7GXxvaOmaXJQf6uExk9gXNUkNTr3WgrYW4hwaZbCn6ywZ9NjQpEdx+x2
+xwbUodsVPnQcM2tYNbq3Vpcl067qZtN2PUk5ruUGx8sXZrK5bC6bx3is+M3Rdid109Y9VXKYptEe2TyyWiAnI03U6FanUeo4piZl
+opUcnCNsS50qJE9MSHTTU6U1pJtUAFdqS681qCTeS28eqssvsqjsjbKo7I24qOyNpjV5e7jeQDpsqrPe4VQXHhtZ/oRl2nqN8QvM
+qs1Yvkesx593hujxDQct8TSL7qZOtPQGnfeytgWVECW3qTC1+BXM9dAitG1hPQfF5o3gkV2nKs4SrlN9PFPsbKaEnGCTSSGjMS2W
+s2+BL2yZldAyb1DLrKSWeQNaZmHLKGvQobOl+BqUDSnMqzRweBstuD3FMbfCjDAqqpCa1fuw5DCeowjhKBaCdNPrKeootTjJ9N3A
+OXRv+FiPPAWGKuzFOTnIcO/9p3OLeNk1X2PFyp53xt7V2Noz6CovF73JEzi0/pySVv29D9N9XOz3PqqbsMyXLHY+YvnnI1bkfMQ9
+dG/kHrrf8ZQRIkUXUgdm9/mq2K1Qc9WM9ys0QwjVrX1x1q2+73o43r35mcfq3sS+peENXLIl6xiBiOIho4oV+FaPfIGRHnnstOED
+UPfwR63h7SIcno5eJh73R9TRuIfcDBxC1MKb13c4Nga/QYUN9apB8TT7HWThi0wPy0S42fDeiW0OI2Kbwx1seByPVPEk2iA4pC02
+hmEHoCj9wz4katUczGpFTw3MUeQ1qn95diy4lfA/a0XGEYUR/I09yl+wN10oWsfs71lnHRAAQkk1pqPQWtKtKjVZCOoo9O2ZocaI
+uoXiGZF51Yzum6sx/Yjtvo12XaNZveM1/RhN7hflLqLKEXdEljO0GtjQdviSTTa1hi81SLbQveBrKKyRPa3BbcLC0r+rNZjmi22t
+4Wu+WNEaWbtN1rVG1tMbZElroEDHeqfV0p19sqA1wmJP1Miy1sjqpmnvnnX2T3hrHY+saQ1f98aaEPFUGBxXyXEWa7lMafukYW0b
+VmPFQt1Z5DRUgufrh53QGr61whPWacduONR1yRltRUDjBe1M1uUVXtXORJQ8P41+/4qPLGpnomqrT0JapsmOfFLbgf48AVbW4Qoy
+VCgGQjIvsq+y5GuflBTyjPZJFuZTnisp5Fk/sM2tHkoK+aiG6qkb1INbUEX+JClLbd3RW9SjjgvZf1Br9ynZ2yXbCvmAVo6oplwn
+CxXyoSC/cHFU5E8q5MNaJ1qb5yBAKEohH4MQ6hslJE+AF62eSwr5OHgCFZdBh4KuS4V8ROv0S9lQCqGCEI2HAYLcjYUBgZ5J4qBf
+5EckhRhUg5Mkm2oGjYkUwQbsmXQaLfbDddfxHBv+OtXGnkGk15EflGN6VxRl3kONn30KVYhOlR5k4VKtvEu2K0fW8/10yedoAluQ
+wkWyutn/WcpsZyRBmjbhOzFq6ge4EeXxoQ+RBrNXbBikYn7M3RfMdY70KDaeE5s38K7cs58ByeMl3aWyohwh06dLfdnCvLS5KWKY
+GEUa/XWWZeMZV/jq6mwpQYUNzbYdugPHGCxTWemxqyE83Dpbkv56Qsr4nTLBb68PBRBz2B2CyUe49NYevB9eKj12R49HdWa8wOpH
+1tLphdI76ORQk6IBXb3As30xnX7xUJ2pHycH4hqkbpEEiXC2fDdFoflV2+GsGmTjyi8q83WcCUQtvxQuwg95JUepIIK9+TijCBnj
+Hnl6yHP3ZjE5KZPasmynpTMF9s/qjmVYDTW1LIrFrB6mbD4pb6GvBauI7yifIek9GggOraLE+2I6vQhHs49Brf6SO16zq+4odR8V
+3lXDqqVatkNTNV89D3mH1ZMea3qMZLG5eWSIF0Wupacow6e5+En84RUyjeDEHjmHyO0IdqvSI+yvfzk+qAbPkYOQn1A/KpgY/mSg
+QhU2dy4lET5bNUiEy1ZtEuP21NMkwlyqy6RuxR8bdCL6O+NPAdqoyeYUU1aDa7t4sG/2FHI6nV5Op0+m0yePqnA4mCU5KzO4Yy3K
+X85SVz2JNVJ3fQ0627H6RzUtf7B3GPx99TqNvNFySdryrHFmXkT1+TthrEG7Bzb2Y+RA7MbDERbbE1p+1n3hTb9Og8p7ghxEy4jl
+GUzyJ47QP1xH+kgKiAemloIOeGZg0R8mgZIlV32G+KrlrICefd2dqhenXZEY+4AVlcPWYp3gbybUZwMn0+oc22xs7rCTn75eGqCd
+6RQOMb/fbJpOf2j4uHAoEQ9mPhRrYaizKtRocrrj0EGvfJIMlAvqqjaT1VX58B+aKOicQyMKZwRDHp5HZ7Rh8cSZbli8ZKvpR9fa
+O9JjdCIcP/RbYImcRfR/fm/0Jo+uDfnd1ptcH2oUv0e2YopwQlLFsRLQIPfxSSCmAWfrv03m/lmdMb4rz4f7JI08OH1r0FpwKuKi
+6JF4b7MUPuccy7E+E49dTM2KnLpUUXqprVWjD5DrWjXb0veW2WJAa2f1BpxDVrO18DwOXn+l01JdN13U4dMEWylxayP+kclOOj0G
+qs920mlZ1/QsU72fKAo6nocr9EBuZQeVZ9XT6THDfVp/Wq4r6XT9uJbjGfny3HVlJIUoeoIEKrue5wYLhTM0xe8APOrz9Iav2zPy
+7EKqcFsk+qDI4kliPK1CqiMm6zgmRK9HzvnG0ToBsuqsyf55KZy8AflXYEgG78Xr4UPxtvBQvH10C3bN8GzG0xv+fV3/wZX4lT8w
+Dk8NpIx32Obu0GfidTLWVHrC1saO1Cxak5Rl47ZWgjO0+khnaIcdF9WOflxUY8dF3hEOiTxFIfaQo5qWbpqH6IAyg02kPeLmAaPo
+o5Wqk2oJ1KqyfKulkQy17scN3vWfAYmYup7dhnVMbyD5yCDJEzcaW+V85WEqkrY6nhdTZsCfu4anWhLXVicx9Qsp4cOIZDmsc3ca
+0dKZ0gAySDVBa7hqAoS0OYJWAhIqMWiOqMRgMIwd4QitCdJHSXfCwdSKJ7OCAWJ+Rnzo5OOKV8IOsdsqZdsWy9+2OMTTG6iULqJB
+QPQRHfcrx/KDSbM5GSgtftoOe9tNcenOlGGheZKxlDSKLcOI4fUjaKIakX0elSkecVvw3lgSGbaPiMcbreyj6tLaJJF+GL3q736P
+HQXiEXvtkL3J+0u2CfiSuHDTe7/XKEwP321ATeghtiStfluSDrclaYEMfG7eOGbNG5mM4pQN0ZakUZlntijfbTOSIP3mz5toCeys
+3kAeT9Ozm4I3u2VYNQBUP/KGR8ymX/YVGGfRJrg4rtgSCFECGVQIWWu/42ze6SYsvudy+vZcfdo37ORdl64Z4sbq3VDS2XebsUOd
+HYPuBnoHpDUeAluTARsZY8BGpixkpzdciXigOMgKtgY23y72czQO6webjLlKb8SNAmP0/QKPwOd3xEsK4FRSYrW5rWe3JI1LqpSR
+iDeYN9EF3sQlumW0dI8JVRNjgOwazXo2m+DwZYvHxGCAALgYDOzAjRpxNZ10BOyikhdu5VBq2GII6YyNMXw2xoZtiYFsjk0AdAuu
+MXDknIBG+M7TDq0be2qkVGQApb8WN1dueJePXTiUPbI5e5ROvyv80XDW6JDlefRl9/+S5TOc1Le0+No29OWGO+TlxjtQ7Cy+3ODd
+rVnZTe5k07+lEOvo0z/PYuTJlhMnsQQPo1RYXalfO0UuWzD5jLFY1uNO7gYriY9MhxfKBPdgMBw2Bvez0Gd852T4L/hDZRGqU2Lq
+IlQpuEiOaOblHnHCisrq4GXqOzJz62+xI69qbbM2bupb1ASFDbDpemwBGyEvqUe8wzTwRi5kELY+u+o+QNGhA9Na43CjIcOiuLEL
+lz4bIf4seVgmwvw5JGrvL+o4fpZJ/s6B5O8qb1kWpx9vv03J3v9VnfUM2132wRGUwRvuSs3wFu09eKPGcJOkx1ELP8obNRzxzNw6
+3qe6QkXDiUl5vFdkwzKnjzM/WkxUGWUslmzL7bQo43VRIgOnyFBHvd3HKevc9h2Tj0DB5ghL3NFcWVpkJ2hAgK4snaV73oJDdbxS
+cGWpaTSaJkyj3NRWGwIFo0kS0KhdQl1Ap30xEVknjmioyfcZFig9d1ABXWjfzuUH30xUVgEyt7NCEQxKWVdCPXDU9ZYidYgITlc5
+Uul0QiC77U74ELXkEgSThqBFHcWvsdu48DWn3CMfu0sgczaOvQjavtJpozTkvDcxHdcVlpGbgYweKKXHx7NMJz2jr82YoeOmbtVM
+usbD4H3eYFaxOrDsQJs/L6R3FEGsMKNxu8343h4xSo+VAa0Z3pDFuSOm20KhmVQVr+pTCc1huakYC5yD45AE+zQnfHLoka2SxAQC
+JFWCQOnQk3q2epODptcyV0H5kDTajYIUjLoUHzMpj42JQanb/upUE62fuepOSCgWSQY7YkuypwyT+tgTmQxM3S9hEJQXYzPiU178
+e7U/nOfof2GyMn1sTd16F3YNg+0DjL6TEaTWGoMN9ZI9cUrSDvzWsTuXumO3PrSuyGUJjpaMKu5fJl5w2XUkgzwS8aCnhJBv9aO8
+xfZDu1rikjTft7qF8YLLV6/PuIonrkBsLw5LF2zGRfMqXjbSdcTVAsUMOhjswI16yYouMw4xIsuMIS4zBz1iahN7LXPCyHrU9WQX
+ljTERwhpa2apw5LhoNjca5mSoopBkgJPEgKjX23W1ro21k6nqyXpoCep7XnxiTpf13sKCVdvULFsuM+1TNUkIcNj95QeNV2aMktC
+VJp1caaRJazMgIRqUgqMLObOrVXh14T+opzRcDQr4CmsbN3KGladOutMt62tGbKvU81n5FA9b4N665gzi6jINvHIgWFVzU6NPusY
+Hl2zzH3/sT7fa2GNhaoQi1XGwYMYXnesBjY7AVlPAMJBlDcDJDxi+GVtDihMpv4DK/69291SgkCGAkTskYT1cjCGMQJ2oqSGx8si
+NVq4Gyh5vrEmbFrWc4yWrKjR0Hmhp22fGowA09jik3DyJ0DYfyEr8t807IEevMMXDnj23hd3EMHYT3hfnYvsr0Q92LF0hz6W99k5
+e2SGJJCQ3twMhCVhK87sHfMHSxa8eO30PXR3Ex+6u4Meunf+zLe0s7Clxf7b1xy5wba0U3nY0zryJuiUwh3u7v/Nzey2v4ud94/q
+h268OsGOzdT4ysKzx22WEd88dSKrWkdY1UCgq1SNLE6qJAXCWf4aZabTwfJZT6edYP7g1iNkU3kn++t3pntKAEbcynpczzNQFGtg
+aDhO94NC2FzNi8JmDdlzko4/WRh12ZAV5aCjyTwCV6yuYL/vZE/pbdiEsm/U6rQkvkR3NLfkisGqMP+Y4Ue/W9hXUg2mqaBzSlXN
+DI2I2qWqZos2RauarjpxnefpdCed7uDmNZ2Wq1onWzcc14PtcpC0yje88c6uPu7RLOsa8RAiNMXvnyEYUdnGfrN5dYu4Qg7BMUan
+jzjMKHFAIzlJtNEQYV1rx9XPwywifcjlT2j1Vdtpocl9R2b7Im7UPjCoD9RAGhruaUDWcYepzxq0VaqRqDopB3YQOuetw92G2gnf
+8vrtgyEW3Y10Eg41zGGHGsJ2rI9Xd4VdiB3beHTE7YXZYxMpU329EgwpUNQnCpDuBpjxZRL0W2pedIyQFSCyqq+KHWUd+Tci4aaw
+oqg8Dd+FrmtS3XZay7qng4jiDlnSpFU/wLBSgdQ1WUjQ9L+s+QXxcoXyDI+23KBctRwLUMgam2zDKSJmP5x44hRxSlsrrcVnVnJW
+Q8MRsZfX3uH3xIFikNZRtHqEL63QRDrW8wivWhZKIicCqVMBmGr8U6i1nsNx+BvSZCXX/+sHT0mj2TIcwDKx4wSygpfCy+m0VJYy
+yxmpIj1mfhEl34xwAk3/wjelJPreSQMMYPYkWRIpikiKhPLOZ9mR4xCdua3BRh9g1vbwJpkyHSoeYw8pXAIfVuURKGy0l4ubgcxp
+krWPnkLW0+mxpcMeuXL1PqjmLLVvd5zUlmPvutRJ1WzqpizbS7mddtt2vFQwQyik0e2O2cOn6SY5wHk5NlezRe4UCXTmLhCh3olN
+KUVGREZKjaekTFJEVZIykbixU9bYGuzr4xVnz3BaBYlH0kintwcOwP2+hUiQnzOGrgW85O3Q7mXwZivRCPVu5LmW36j9gadhbDHc
+H/VYbX+YDNywXMI27kdP3frWxv0R9Tnuj3ZAtz/wgE7shP3hQgbbww7VYsQy+IwhPHyxgkSK7B/deNEd++5f1N1nYSqifHhWUD6c
+OOdSdrbCVGDxVyyh1sBTejtruLAXwFnbU3qDtRfz/iQWcYhxiACi3i+A2OECiDrAkJs3j+nzZiajdMqmKIBoBkpLLM1516UQOyAc
+7iScznG6iVgIhyYaouqjbBVTIZ+lyEZWeBVOaGSOpMLyyI7YnL6NQd8gCDaTQvleQvlLIretyF60HkoPzhRj784cOC18Dx6QES96
+i+vFVFA5GCKqoApOxnFpxuPxqOIlfDQW05YEe2DUVOXFNVV5UU1V8WP5dvRYvh5RGoTqAkkTwvzSgzvimqan0/bRrzv7dQm23rku
+wdZRVAOOkGyoFgpg8E4LepWGveNpkgPKukjVY0+DndiTYivg4oxDVa2MovKwdQRjSCG9DU0oqEF8J7ud5jAdhgM2PtLTbN54l3KN
+MrvSsuAd9jomojpxVzaIuD4fMhTq5CBJp0VEL4B/nIC8fjhDZqTsQB5TOJHpY3/ooCk1xv5URSanLfBXtbiAqaK+59pr2v724Glb
+6DVJGcCkbvfdLmN8QdewyaxQHflxx7tukf6QO+e4TpTYlXJUQUojzvkO1k4wVCVBRHFL7AJ6tLvpEd/vbMff1EdqnCCjFq11QoQ4
+XmP5GEZjuUjrypWIbva/XCvfeGuypx1qrm7wzcqfiUHw0GBr31N+gWkyfFOgemCmFboHesdN0Fg45oHJB8hAGcl+M675/OogemBa
+9f1wbKp7KHPHQ5g4QF3zxGsI0uyzmmqQWrJZ1hbsHLb6TzPxgHzoU35N08qsQH6+Svz6RR/CE24CsxK8+/dgMWF5P217gw7AmrqL
+MZFsxXNpuP2TVqxOyzflCsZkJH+chQ92Gkc3k3mEJEA+IKOWTo+eSAbQ9HQ6YnQUzpQgs3HO4TSVo1aDC18RuF9Ip4+amHcanFY+
+IZEqO6TcYSeUO+9EK7QT0QrdGH6i6T8BhioxxdDggnNNFsampL3gjJN04GyR6yl631ayNtDo/n5wflrzI7fT6fZhlvpDKb+BcbY4
+4304Gbm8ugSmETD8137vkfTLFuEM6jFCm6T/9QP4JxErIkjhxEzMM0Trg0fDpr8A12OW7EXTMjsDT0F9U4Wjso2boyhtGhwJDZRt
+xqVCmLmxzSHmxnbfHyI6PNaRxHP8NEkSBHz8Ef8UlFmzoNlgDB4qrhMZAU6P2IdT3mGSO/6k0Ce8488REfkdYb5wY0T0fhXtmSz+
+ibnTfCEXsxgHJuu2NUfehXvnP2uelAsGECt0A50hmXcck72FwyMNl+iaYHLtoAdP3hLfdcRfbphaXCLFqMuO5nS7kkRisorKgefs
+H5ix+f9D62tPwyGlS4Fl5C9IU5LSq+petQkdY2pSVbee9FIYK4UJUqkz+m7KoS5Q5Ia1YcEr4qrWGCywzzAzfdlYoy5HBScVk13K
+C6gfeHTPe9quUXfNWtdb9KRhAT0Tw6pRy4P9Tiol9RQyWrnzfrkSZDsBIvloKy5HtkUDcN3uBHzfmGibumFNBNUboRBPKGTieaOl
+N+jGxIQxQha+8SV2E9/S955limbyudzfgMkTp6ruGlbN3s0+c+YkT8smgWfOnEQWWyhY79QMe9SC2w49jBvB/IDrtC3PsU0XX2kP
+jM1YIm5JyiHA17IVWaxhKNq+MWFXPeqNu55D9VZY525XL0tccmYcNJ3ZrsGUcqXTE7rn6dUmlOcnGBSZ5eO/cKiNkk9i5Fh9RCVh
+6fTEqmHS1FlHt9w6dZKqJMaPVWnErBLjcyvZbU2IwC6gpEqkwid5IG5qM21/N4uGopjc+8TzslwaqzYdu0W7ulVzbKOmZJWnXL2u
+O0bQL/qO0dA928l2XOosNJCrgjzr6bS0aNpbIPzDKJVVralZ3a4w4GAPm/KVd9r1FKQpeSpYXwOnXPYq5ABppgmPj7QhVI+rj5N1
+O1uu58hO1tRd74RvTnFCUjIobFpukh3SqnBtYiruPQd18mCai7wV2GRN29Im6oZJLb1FNbn8/HzlKWW+NGGgpQt5U5kHcMc0bSud
+3uK3gsfz6bS8r22V8xVlyGQpyFU0DlXU1SKwpTZtvabuw+k396SgavDQHwfdSOM/TMsXpVSNerTq0Vpqq+MdItrhV8KwGqktwwLz
+BTuGnnrug2dSMnSskpWCukiMqZI0DTrarqe80ihTq+pbjiiNAJz0jLVt2btWylfwyd9PRWyjVUvvpAuaU35ZvqZgfFgGhz2sGH8L
+0jjU4h2nRmEfoFuRc1mBfejfQ4y4S+G92rfhIWAFNp6pyMCu/NmJHEwWIwxsfibCwQ4zeDw1kxfYV5RIWGeHsEt99+scicDmSWk0
+A12N2N7cEQ10wbMdFHfGlzsKMHR2zLqo4VsVQuIsCIycrfGdT3A/nto8XFOMM8SibDAGHGVgJCumxi/yxNTuRXf9Ki1tHj4mH6so
+6itW7JGF98GbeQefMoXjuk9IIyYgwQUO+lKgkIHPmi1Uq7TtfRBH+rwjvLHyp0EhOQBCLP/5FSVJebAHScGj/qRcHu9V//CdFIwf
+cTflaFa2atcoPiDzK0FsCPW7G+YDJpVuxbdNlngDYGpWTGyiipmH27M298exIHVNj7yga2qmL8pe0/grRT7J4qm8EXnw4KLEOg8D
+5SMuCq27Ypr9pC3eFgTG5Lz3NDdynr4LfltUdwOSv32v+mHmaMqca1zWjEDcm8eSiGAvNqGziS/cWFHmqbZcknNkvf+94LI/AR2S
+mf+m8AzVa8GTQnbq52nLpXqQER+2yD54Wj2Y4RRCNW9AJTxSTSqAJL5jZFxBK52WW1qrb2YW39uV8Ffm9yH8lYaCcwvOEGvakkxJ
+i+wH43xzmHSFOF0FnIqUkW3YvytDJt4kIWA/A5SK4Aa0esNm5sNzicpWbI4sLzAoExCqZiItg/LaCw7VjUShZIU0SyPXI7q3F2sl
+vHgnB5I/3Md1HO++VJHa7g3pg4WANx1O5pHn+LxRPExSSvGA7DZlDAJUfiW7Tj+pyHEx5KSFo6eQdmnzEE1FjF1d4q1NSYN7FGZb
+Pz6bBEH2ne8MspKox5esDSx3Kybg7IpH5Z2YuIXpCzXjgGbP29ecGnVoDW8zfUnntfBmZlC5DXLgc7u1QBTLn6xlpfT4A4JN3D2y
+UwLjjUmcnHABunnICwB2J+ARyFSlUT7exRuogRU1BMkavpjEtN4m2tleiO46arTPpnaigY69JLsbK4LdjRCyvaG7kz+V5X6Qzxn2
+WdxP9dU4kRHa6xNPj4/AWL17ZCEmJhPAiSM7pr+hLzeBJ+qJu7GFv2Rhl9nDXwr3CW6bgwW37X7BbZ0LbtvQiNx855g938lkFL3c
+EQW3O3HB7ebhgtvuyILbelRw+zS3CpQkQx1sJri5/n75bdHOkFz2I6LuTN/DNLxVUJwaij3Tv4oN3J3QZF7Z0rgoPgkkzGHrGrGL
+q2sGq4erGbwO8146bWdd6iVUwd+pWEwvp+4rpoOZ0pcCbw6SAh/xgsdt2h2zFsxXz6BelKSH0P7ePtQ4FTcbNaZptC+w2xVSBOan
+MGrgi8TxLUJhFN+TGAM7OBINQyJxk81HYaLkT9GSdK/JCtC9ZuQL6wn8xpxHeZrcv9ULoMD9XhxD3OlFtnhudIvX6dvimWxvCDu9
+CDi410tuOalHrqPWkZ2GHZkiOzB56XGFaTUIMo0d6tcT94B6yHXGFZr5NUmnEwL7FJr5H6IKzcJwsh88rEaD0vsl/4krG5ec6TMN
+q+FXEFoSOQ9tHJm/F+1ANt657H9jmBB/9KzVRR0KAUcHH9XGIWq4hp/niuq3ghPdmPatxuC3AYzCqwNeDMbm8LiOrsipFTP616eV
+izxO9/jbLeOdHHXXQlN5qhFl6HSRcXMTn6yrsSnEn7f75o9B1u3aPeWIlME3CI0jPJsIIWMfhxCj+HaicdTdvTsCtSftQoT3D8n7
+fIkfF70ruQ57UyEOu3elMH9PJZ1Eh/+OdUhLhJcbzsAz9eCZZexIlp2pW3Cm3tYsOFNvakY6bUQNj2uaUwo6bZPbzZIEtSyDatfi
+p+YEzONjBLWuaRoqmTCFgd4kuP9ywl1Wu2/4JO5E2pqmbSZuGegw3i2y56lGh210SPc9FFGG3mRFhOr6jGduJm0dtyK2HX0jnIdF
+jbzk9ZfDQ/OPGtqM3LANmnA2Byoyxvls83BlycOivJtChVGC2RzRDMrmiIZrNodvsDeH2V3cjW1++wiDycv63YgS7MN1Hw7orLH8
++0xa8k/2PPov8eWycsButdDQskwjGvXYTZX/8ZCbNOREjMB4xHz07bFQBLGIEW4w3+kz44i2rUHqrOPShFluhprpFRCb76uLegea
+rVkJEQPWMBx4kVl+X+LKgyIr3W5ftfzEfdUbWYAyaPJhj5L7NOwebtg74RUFTxQ+Cl7nuQxT2I/zLrOOksDx90hEGcGAS6WBudv8
+5U1wPQv4QaZxMyHDbALsx42cjWRaizV9aJyovfNhWv1xxYxHGGRTQJzJ9/97Jv/zMLYbVZLA9c4JShIcjWb5w/qjqyDwnP1xuwNP
+n5zSkcxJgjJwnnhzM9QfLqgAhy0VC1PUd5R1mKfVI6B9IWV4KbvjpaSjDN/HfxZMEx8h98j+u/6MlwbPd9+vI3nwe5Wko+0d6riG
+bR3+FEW4XrWHSlqKdMJz52azPPjte5HC46ju4Cn2L0mNbFQ7UHGuwJ6SzsLt0H62Sc02ddysDYaO9t4XMl5MjTOz/dc3UTrCLBm1
+cwezpKc38IlIINZzosYVcWJ28x6z/BY1b2YRo0LGbH6RI4xnsXRRMKzv5T0X9oqrpenxLIUZYHiDBHYjUBCL7eEM9+NVgnjZqkl1
+56O6adR0j6kncsFolVHhNeQKLwY1mJ+vnrBOO3bDoa7LNZkHQm4JuggS0uQfR+qtpbexFmftATw5EXTu223UKNRviN6LqmY10N6h
+HTUR70KAYA2+A362gV4T6MkMg+P3H1WN2aVb77RaurOPduitQGBMsLYsCQSKrxuSY21u2o7RMCzdXIvF35KtmFFE1OKJBOPvz/xs
+ZcmoSXE76xJBI5CkDUd5rmj13iW18GFVTPlSKaY/Kfp11XbkeB3UWA6kNQARl1Y7juHtY/PixfgfA/P7BwKAqLJlOew4nwibROgL
+tUr6+1LtDDohiesbqRG/CmqLGO5Cx2vajvEirUH7RFO/WfGj3FIIn3rURJv1ukJe6LTaZ214QYnmpF1UdQMurvXGNwfnnwHFofE/
+JIFPuDbdhEQYnpSm93j7YlHLdd8U5oRm7djsjPfaffQLIxID/SOuTa5/lrjxL3Ck7of5yoXxaV8szM9hPrwsHtO0hAtlfqM4dM7L
+93wd00yfgazDyOH3jsHOXe0/iYMZOEmywCJOheiKkKuZTsumFmi4LnVGyTWi743naiqPq+vabgdLqdO/BjHjWnwsoEoQkeCZgmth
+suxwK4Z8FKJmaz4OSFWj2YD6SVujsZmiLtzwk6ZGfaoltfDuXZyYdwZeyZMGFts/R5D9Pt3bWzEi3ou/Kd2NqkfZTjDCu9LHnKxD
+60LT4WSJ+8M0C4CcIOmxzDSGr2l70UFySkOLI4EolN1WPTQWz2U8HP+gwAinLDs6ZelEnD3dyDTZCSYsU5iaqrHpsB3MLM2kaXXg
+teRO8kzdSOAWBAnRvo99uo+EyH3sz2Eb5DUSLiaoI5dPtHWhjSEmAnMqWrzCgJgaKn60JQTFdoM8ghBEAlbM/8i9kbuIrbj61sgN
+xELMOuxKn5n27YgV+/WYrfqlmPiheKuyC3cYy70RjV3ZbbWRdNW0mXw/BUR85GOxI1rdFAn/MMOa/pAYbKPTJ4vBMcIhNKy0obrN
+EsZXLEZkcCenjg/EWKyA6A/rr2BMHBoxmHwOjZk8JQyEdES9dCKJ99tbE8ZL38focOj7PPKZ8Htgzfko1qRHMfF2qNq6vdh5lzgu
+xnIh3eAxTp/6uQTSHcuPdin5HlhZCpIYIcNarhBLkyRf55nwsAje/XjZdsdtylK145hsf4Xe8eckX4Evm3IkJfj4pPRkhn/DRJkn
+pScV4oDpGWDofA3CoJtPG8sH1t3m4VWif8NokjYs8tVwNxyvHApwGNQFM2RjsqHJptbOWnQPLo6yNduiyjyU4b8nN7lcaQ2y7YTZ
+NkGuo6XVQK5jR6uBHiNL2wnb+cGUFGlYKwO6YDI72Kie+EhyLAesX68Oe1VzH5VmjBmg2In1TOiSlSCSUZdtxWs69m7K7fV6Rt1v
+JnsSrIDaiVbH9Iy27ngToK9rvMaNZ1jptHR6bf0suOM9gW1uQI32AeHNJIS3yF4ywqxoQT4G8G1o8pa2F8O3EeC7G0N1i6ONSlt2
+Gba7gG2A6yrINYpP+uvBCARdBiUB8u2MpP0PKbODKvgy0jycGWvgBwf2gxqLndA/+4DGZrx/Gun0XtA/e4n9s8/7Z7PX40/J/CbU
+QsqQc0QfAKSSdWjb1KtUntjYsCYaRJLCkz6PKxgAxSmPdWJq82fJuuaA7LnLpes7miO7sBcEzTlwWurIpmih35hnCiCYMa265sht
+5X2hMCo037MJix7VLS0SiKpHo0GMl4iEgcWIuMqpyanowXI+crJcjJwsFyIny5ORk+XpyMny1MhPwGcVEjn67JEV7WDEVy4Mor2I
+EFJwfRxNBhe+sRc1EPuQhy38CVU8SmgtI/aORTSdsRc1TreuHfh2u8M6bvslHPSCCkpSmD0sfEv9XXj4BWc78YKzddg5vHm0C85G
+/zlt893UPbrDrUQKmpyN+J7bxs3wvIePWNNp2dO8wAwnk8nxSEfTcVIkpqZnmXbHQMMTnKyA8VQTDWgJdrI2pUwnI21KmSpoC41/
+6Qtjw0069KFqm0QvSw56gk11VIRtR/lYg/gPNQKyCUyleL3wznNfvOtcEjZbK2QpyqqtIzALidPFqFJQ7cd9BuRquXn3mD3v4jMg
+V5SCcuNSUK3DpaDMx30GlHBDKaGWF5AuFaVmGOmUAuEoiOSWcxU1Ki4178Qspspe8CKID23/RsXqtBLqEHt7FGQjWGVoDRKXek8G
+YmSYRYcjH6MGP3lj+2fUARzMf2iPkFqdFiwnWWbEvtsto+4fnZXY0TxZYjuWw8dNxEi5T1glSTDmTlDcKPzmQhhuPGiNmZJT9WAs
+YfhKq+3tf5Sts0bfU4Own3pKj2khjphpc7KGlU6PyRFTbVxbE6lC205Y7U7YNIG8kKI2BxuU91AVBMYnj9PqZBkq2F6xLbQ55DFs
+UHxbd91d26mxKrNpsxSGqqiH6vHq5/cCMoBN26xRR7VHqXXiZLcQmewWkia75SR26ZAb7faQG+2jrqQDb7T7p6PBM4F/jRuKZ9rW
+CY+2EgzSRC5pgw+BFh3/7R/LCmLivNETbc1gAQ5t2TsUCkmQGo1m7sUy9801syzkpNz1Wi2adXLONG6QyJ+LEOnd7EnD9eBZPy+Y
+f+TbESmh3MFzcELpsWbR/uz4TXek9Mh99ojT85Fu1Xg5/pWVQBl991XRih3p8qffSE9MH7wXLgBwlc4XBp0Z3YGXqOHMTzpRjS+y
+kUVrlGw/1m+hFHYcUmBVp6112HJS72swLCntQEHe6EtK8/GWFHbwYKLuTA5dbJlp9y8vzvDlZST9UXiSNlbvduu+GZxjefZUrx57
+q0Mc/zQpKlxz0CMdPL7yW+RrPHT7NdsLgw7V1MMq0FPm2zxlOi37nauV2+VchXkyTuVI2rDEt3pwdTnOKAh1sI8DeQxRbmImmTS0
+iG8APwA8wRQNjUydCFjPZ6qN3mAdL9U+0cQtzxp3W6nEuo+zuS+UWEwyOx9OsbIDYxPtKUbVeL1b1dFrNVCHk0DqTKeHX02kUD45
+Q4UWarUUZCCKWO7H73eWI6vwctIqvKYlnmT8Bew5/px4fpGbJ/ZReHhbJFdjyIQbfDNCbjFQMx6bbJEFCN86eU4HDW+gzFJF6Z+J
+c8Nn4iQGcy1C2msx0j7SAaEvDAM3ScTiDv9iH2CmjuN7DaYOAmUJXB41fJUfHF+4oOHaRYN2vI/QmF3VblNmxQ5NtNQ1L1s1DWp5
+J2qoa43ZTzHRkF1NK1fm3V0DDoWbykFVd6nA6IuW862s7l8onuYRwNIzphDEUAYmWgjjhOmqVeqiNgBJrXG2LXgriqfaqD9Jmd9y
+qL7N0hgtyMfwBqXw7G1qRZOw1i85tEYtz9BN92iV5B+5AfPD6trrU8taT6f9BKwmm0ZNkzLUggTPnDkR0Jtc50dXLdTw0fGahTO0
+ZjigqNcxQ925mqa1FLEFTtaiu3Dfu+I48gEkRAk4rgBM2mFCntA7xKQ71FQlHMgSaVHX1RugSiBWmpFi9Mcv4lIGU1MLpEFr2dQa
+Rk9FoAHJf4izRVNt6sDaQ2tZqYdcRwgYK2Cz4xjJELQUhSxwc1r4V64q6XTuWDXCt+xoHUbn67StO7png6L2lDTvl4PfkguoshuG
+HXjzw26F8K5iy7N1RQb9ycu6R5UwJ2TxE3NqBEJaY5rWyTpUN1thV6M3OSGPyzsbFE7otRpqSNbNj3Sos89mPCaTG6x0mynDSu0r
+QYn75c1KUFx5k4C/guxgf5EKv1bRJF7olj8PRLrwGbzS3NPKW6TGU6QlhSt9RjNHW4HlIqkEJsJLkgpRyK5/u7CrhSNU07Rmycq2
+HRrIIpzg39ROtuPSRd01qvANxiUbds8aMCv608IHHN3ySuIADT5BxIT06sDIsLlgkApvC9xdvdGgzjMnfNJnlK3hIFI9gt2vNogT
+jkO1RWDh39Kr2+ouoY6ztKWKA1C0oGG3qSXvKY9z8cOz0JzgwgZupuwkLmL4pZN/wTOnvKfX536KcnDvF95/Vt4JAEZwrzc1NR25
+2JvMFf2bvSM1DW6Vo83yZ1M6z5bpgx7MWkzzOFX8EWjBCKQKb0vwXAfMc63tWn6r2JEPJZYClxJlq6LRslURbjj9hlHixeQP3qtL
+RL/EPibwIDh5cNWDhXZbXQ06LzIxnLbbnba62PeRLnqW+sn+4EC6A74/E/nuqh8N/DBiYKuhfiAShRnB/3AQxhYnldIwVtv4MN2H
+5OrHgsAtfzZQPx6E4SMH1QpTipqFVCMMN6y6rX4y9NuWaViUP4+wnUW91qCqLnz3W+iqnYRQtRoGRhTRqjvhh1Blw35/oLrVH7Zo
+1/bV3TBcsO25khB6xt5Vl8NwLjKpnhKqxrU7nhZiIYuvnglDRIUXq0Jbd6izY9BddTEMq9u2Rx31mTAEZ1ys90eFHDuOqX4g9PtP
+ZT8cBomKetWPh+Fo6I5r/1GpFw1fsk1Tb7tU/VgsgWrFYrqqEYYEBvRUPQxkw4wFd8Jg5ElYaDUMPe0YLcMzdij7Uhe/sAGq1sKw
+qOFodUeoG1dFrG6FYYu6S0+ijJe6G4Z+lD1oW/f0VlvdF6rtU+DKnqfWaWI40EUt1kVAL5BkKSHJkm9CWT3jfwRVipHZY8XrESsS
+suT1+JxXPhVCHbh2Alcg6BLK3pCERYTUAhdM8VuBzwqVZIRvMcOrz/BEgpwOF6RgUSnMzsGiYviLSgEew3U0wxcXmQTxkapmyCa+
+3JEnwZZ+XTPkNkhBwPdp2EYZchPkICD9FCqohnceDfDPFEEWwpAb7I3dZGESpCEMeZPpYCzMTAP7ZMh7IA8B34ugEt+Qt5lK/MlC
+AZTiG/I6aKKG+hYVsqwZ8oJC1nBRnAUBcENeU8hZ/J5XyGnNkM8q5AR+zyvkjGbIJxTyAvhzUwpZ1Qz5BYU8jf45hSxqhvy0Qk4i
+l5FTyCc1Qz6pkBfRn1fIM5ohv6iQZ9FfUMhHNUN+ViEfxPSzCvmAZsgfVMiH8HtRIR/WDPlDCnkOv88o5GOaIT+nkCfw+6RCPq4Z
+8hMK+Qj6pxRCqWbIH1GIRzFkWiEWhHgoXApBhRmFGBDkUIXYGDQ5qRAdgmyULMUgkPSBIJcqxGRB0wqpQhDqsGFB0IEQ1KYKabKg
+okJqENSkCmmxEosK2YGgFlVIA4OKMwrZh6AGVcgmC5pWyBYEbVKF7LGgKYXsQtAeVcg2CyooZAWCtqlC1llQTiFLELROFbLAgvIK
+WYagBaqQNVaJvEJOQdAaVchZFjSpkNMQdJYq5AQLyinkDASdoAp5gUE4o5BVCHqBKuRp1sZZhSxC0NNUISdZwimFfBKCTlKFvMiC
+Cgp5BoJepAp5liWcU8hHIehZqpAPsuyhzyHog1QhH2K1n1XIhyHoQ1Qhz7G8ZhXyMQh6jirkCRY0p5CPQ9ATVCEfYdnnFEI96H+q
+EM/DIZFTiAVBnqcQx8NYeYUYEOR4CrExKA8DF4JsTyEuiwVjF4JcTyEmBuWAACDI9BTSxqDipELqENT2FNJkQUAAENT0FNJiQXMK
+2YGglqeQBgZN5RSyD0ENTyGbrKp5hWxB0KankD2MVYABDUF7nkK2Mdb0nEJWPM2Rtz2FrLO8JhWyBEHr3nvLmPMc2Q1mWc6R01l3
+3/Vo62yzY22fMmo1k+7qDlVkSwHzGGeD3cvm5pmV5Wee21xe+ejZtbWT65srz51deXr9xNrTm0trp06vra/AueheFid917c0iPoS
+2fHfumdDvlADQ95jR6VhgczPzzSIAxvyoNq2qAAa62y4bEVWZKrA5TsLw+UYgkpU5UHwnh5DbBlNsXmK2h95kB4RVm5PyToUHmXJ
+OjnoKeqBAKgeMM/xg+fjuXQ62J0HH8u5Skn0gMSX1582n5g2L6bNV1S2LxnrA4TvX5I/e0q4vfGyoGgyOBdw02kZY9tbL5zS24oc
+/06Eq6WAiGg2XObLVmXeSaejRzaOUorF0Rz/ZLzsVRS172up7BCvwuwTwFRsUo+m4pVhRxcWHEl78GbZ7DQMZmA61mJLCfZsDuzZ
+LP9SzCo7lYT4BmzVYkFYNj+Q5QLEthYJDbU/QRk2i+NqdlmvzEfxcJVuV3a1slsh8FVzFcKs2QjNiPvLTiUhSCz/kM9lvQIb0EOi
+YHX9vjksMlwChfcQ66KBHU8YvK4woDpR+qIk8Z6RjTbH7YlzQCdizqculBYMzyh18uxwz23KuOsmXg9GcDg11cUrHP+yQc6RJaDI
+LcOiZ3hV4Lt6IqyNKVQ4to/2HncqgOPG3ewpHV5XiuMcR3FwKOFx6RNatlB+KLi4ckoONlz1hPmpGjOb5C8AjydOjRLRBV8imjG8
+yO86Pr87ieyuE7C7wOw6AbMLrK7js7rF2UlB9Dew/VSAY5x1zZFXgNG15Hy+kOO87vQU8LqOz+vmC8jrsqOws5ojnwJWF/WYn0g8
+GOuRM+KhRwASfac9d9CDe8n92JUhu4xbj4UesEPEgx5ps4GlliuELcHqAb/mga91C37FE5kecWzbO2FBx6FXHJ6wKpEtu2PV1nle
+PeLZtrll7+EnjxuOAZMuGIFd+W0G/uyWYdWwijymC8u1VpVPkMjVWih1Ec+R+7c6hskrIY/leaBDG4brUYcl5y0XbjG3xNmDX1lC
+xlCHhEtLUVE41lMQZmEFDX6BPiaPvNyCUUnBSywNOYJIq0HAWOeoYACx2HwexyIUuBFCB17Ijskj02KklrmKL6vjwycr+OYZXnoQ
+q+9Tg4sHMS14AgHBLLvTL5/coN6ZkArlkAbYKUxtwaotQiZ8mZC9gTGCN2qylUxIftWQM3VlKwxetYSC+VUoGi7isDsUIeaTt4B8
+SO6HEJUARJA42vSBGcRwC0pUo40kfieokS4Rh6F4C++qYXv9oFhcBEsd1MHic75g6PMAMaMTLTWw7tMjAl1nhdmn2z3oRVHlOR02
+VllOfJoTkT0sfTA1JubjZ+QOzsjXzBhNqokzh0g0CRUJZxv/zZJPYK44/iNck6BjoUGZUvuBExMF6SnPfgYOM5d0l8pKhmZd06hS
+OS/wLMg+sZKVgeWGjJUVCoFZZYrMhKEEmdUiI9zJeCBbEqlzMEwP6VrePF+DIySI5MMnhOESKEEmOo8db/dAtjEODCVROdiQzV+1
+hD1SHwQo/xqDIHlqG9yRov2FBvXERDJNbJEl1jbYWXlJPVu2OE3kyPiMEuHJQ05wIFwk2Pw4uFdjCYySHN2iGLBFMbSyUVGI0cde
+C1XsJwb47EWWx+jOPnxBFNmeQq84Cn9MCJww0AFe/ciS0MSURWnNTXl2ihenp0JRsabu8WA3pWMmwTfZoCmvSVPMsEItxehLCWWV
+nB6h3W6/9j0FzdCpdChFDB4jou4poAonShVBQoyYRBkOSRLnLDshEcwpFTTamEQrBqOPoBi/tXaUQryEQnTNhu2xD3OcQnSgEF2D
+bSDREzZgjr9JFhnud09KD7c6Q6iszJe+E5ZsKKIcXh/R2UOIzsftPaM6u0e8AVSHtpljUzF0rTvYcE5sQztoSTp8u8xe6Fr+frmv
+Dowj+xNUpH964f0qW0ovoXar1mAGom5FeIe61YvyHz5XNdq8HrARfrJyuBOPjhRLKVnJU+jAGbOnqAGnT0sDy1OTv4jNGmV6Sph1
+AibSTykriRxF/zw0sMdwJPYOW/ySDlUCgYv+acPRfIyJoeXmjWPWvJHJKE7ZEKcNo8KfgPqHzChBX7blcGZwlPB5Fi85FNljirx0
+2ZNBjlfvxfmDw7gCqvkGoxL2ZFzGRDiMCLokrMpYaNR94MQtAA5n1v0cFrQadRxQzRu4JjONAB5Umr3/Wss+vfLs5tkPnll79unN
+lTNnmEgAyM+29X20kC/nwktVOCfrBfoBgoOXXmLH+5D4vJFIYwJZ7uH2hEVacqgO9KjIOD/RWE+cOkQVpMhszvdTWuIGrkdodHDG
+ClzmHTmozMj00Veo2ND1SKnekF21pbDPuBGGJ1Ugrkx7sqjE4MxfkBSUcFMUzPfR0zHQtaMe6L4GHeKGurF6hG8G1QP/5DlUTU38
+JG0hSbPXe0eSVzafj0DPAqrwNXxFC/kcXLCbmhFYeCzMRLQtTE3nolYep+bQKjbTuBCd2GGsc/gETcd6nZ609ZpM/cFOo0b1Q8lz
+EGM8C7yRBZLEwNkw3ogqCjmAYdDQDkA+BWYBtu1Wh83k4lLjZetWtk69alNhTwKDI4DFfZCqTLT8Kg4agXot5cASVaVgJUGzKbM6
+dFwr5nIl2ch20O4gtN2wGsz0nCzVdcOkvPaSQo4W6xnHhJeFQD+ubVL2IEL2Cz5L97yMlJIyVCGezMwvqZ68I1tZeDLLJY4NblfP
+38PBQPSHnpGNwgt3HV6TWrIDD5V6PbKPOjJO2lXdjHdAgNqOHD5UgTRDJ4XHuhZwiMFvAt6BQOosl+IszIiTmOxoBz0Sfb3mEDf7
+zOnlhbMrm0trT6+e+MA6SSQXmm1Rp0GZ9png+DrLly3gshIyPrv2gQ+cHJSxr0SYZxHohLKCmYw9orXImAPZO8q7DTfO+tqA5jIu
+K8p9ekrJU+GWNaHnQW9i23Y8Vws6xJKnZmcUEhYPxY6ccu5xU85NP3bK2cdO+bi1nc7ljpISr7BmlMd6HsTYXJgTvL8pHs8JO1W+
+Sz3B3kyluMbA1ElMkGp1XHx6oaf8V6opu54qhjoINNSLVfbGC5VSQQ29+Uopr+aSl+DJp/wKTRTHoZoRAQ1eaWIRg9hEx5dKvPq2
+BrFhlNPdVFuefMqdKI7bClynHM+V3PGi6s4zRRA5vEX3tNy8d8ya9zJaUTG0apmCGlsHBGYXPNlTKseO5We78eBMHj8U+j8U4MN0
+f/ikUiF6uZPJVDTj+PH8dLowNSUEzIr+wtRU2vBHeUHTNLskJ1etkFiz48eLkawUFR5Q2On0gFzyuQENLCa27/jxwtCaw7ZFEFoJ
+O9Us0+PH87Pp6clKhrkLoXuaO6cn07QSudFnFByIVRBDY48A5+1j1ryd0SYVR5Np2YaWTCsZcGbylWPHZpUMOAsVYrCnKrqw9UoZ
+/JmJFL/39wtijxGDzZ/1N5PEAOV8NhSvazngocadef1YZ17PaPnpydlJxWYFQaV1omcw8Hin1FG5OyweesQpyXASb43nK8TIaGbZ
+O3684DuPHSsCIuCTNE1SVCAEB7UNgYDBeMFvYSR5Phe6g/SYWyHMTVIIr6gBrgAHL7u171E2rDWDeFnPXtz3KE7umg18lGO3wpBO
+IIZiAiRV+GlrUsdiS0wt3Ek+Y1jeLCYqhU4Vf0ldkxYWl5ZXVj/wwRMf+vDJU0+vnf7ImfWzz3z02ec+9nF9q1qj9UbTeGHbbFl2
++5OO63V2dvf2X8zlC5PFqemZ2bnMhESaWo7UtLo/CTSP1eYzmaZilpsVrV5uVki1XBfpuKlUtOZ8tSyNS2JwTqlo0wVSLUub/eGT
+/bPqxFNjf5V6KrVo77rUSY2n9NSWw9w16iH/Dp+bntd21YmJhuE1O1tweDJRo7WJLYwJEU6dOJs6aVSp5dJUNyVXldRyx/UMK7Vs
+6C+mCrn81F+lnpr4qzHxyJMYykF4bpNOB2tAKXBphqyoILqQyymyxIqT4M5FzpEcSRZOiD6HDtgQkFpje3Wffq102hKutMEKZ7cr
+SeFYso6UusBS+wPckydko23Xuka7aVu0a7T1mjJhKFnPPmnv+ldWxNYmTGObpnSr5thGbcLIetT1YP53tTE7nZ7o/9DRJiy613E3
+3KfKufHpyob7lPDV1MY66bQYY66SEb5XtYklZ219IghoaxOuYW4LUerahKsbZt1wm0JoU5vwjBepJQTVtAl5l251m+1dxXaFDy1t
+gqn0cVPYeuHTjiZPrOstt2M1FhmlCR/HWum0nzIMBuEYUH451k6nJ1p61bA8O1K1fW3MTafH6un0WDOdHqul0xOmYXX2hCggUDNB
+aw25TPVK17BdZWNC3qhl5I3sRi2jlKBryBb0Grd2k/B5T5tAu7xemG86PcbDUu2qUNyuNraXTk+Unx+vtOwtQ/iyrU3sbdlC1eYn
+8OFLGFBytAMLVRPgIwKJPYwBLUysZupWtwvUVVLxQ9duO127DU0qb7gbE5V4vXvqhN12NiZYpCOU4xcyPPtud6unDuzSsBAeI3XC
+8qhjUS9Vt53UAqNuibiR9EmNjZYwuK1VW3e9ga1MLcFniWCspGLww+Dc9/WtwU38mA7vO1O8ihLZR//W4DYFuQ0usFMdUuAzS2Fh
+nWp/QayUTjWhlJLKysnwclp7A4jjlL7nNeH1c4s5+rLHlMOypm2j3dSt/aTcV/g3ifix+vL3Pwwrot2p1w0rqYDT+EUiLEZf5iw4
+KWu/A1yTGm3LSKZp/k0ifqy+AvwPw2q/Pd6iJrUT6//h8VP4TSLbzNFXgp96SAmtkhxk+CyfmE/DxCwR200M5pMwzt6q3iObJdnJ
+tlxaa1BNJ45vlUzbVFT8YESDPXnCoDDzmbR/IlUUdQJSdD3HqFErcbAGs8TKXtu0HSBwSNJPfK5BU11nR1X6x041zG6p6dgtobXM
+n1pbl0gVnTaouWPORdveDjx9BbLgbtUxbLdbdVp2wkLSU6NLTeKwMqqO7dp1L7VSa1CJMGyF4jZ76sSOsaObNSMp/UfZJ4nwONGK
+8sCNicTZui7OymyhD6HxQxAcnw+I5u6HbrglPrMkYuBSvWVb23R/YyJx8FD9FH6WSBAzVk6YQUL2dcOhdXuva1TpLtVdanbrsTks
+JPpVFlciPFFftw7ITCmnkqZlMrEhy4y8u2z5V+bLz28olaecHbW8UdvIVjIbisgmyE6WF2G7OFIY3JpfM0BbUdR2CM9CS3/RtlLr
+hrktEeDQYtgY5nYiLDBXenYrcS5knz60LhEeK5onD3zBTe5O02hR5wU3eSKEb5AxixWrK0+ZmO2WqVe3t6jj7Hc3tra2NmoCt9rt
+TjhGa8ONs11hwYuQehFShxQchiENhwX0LcHhp/JGrZJJql9NnDrp1tp6WA737tIt2+3LehdWri2lZLuDhwiZ8OxOtdnWaxsTMWLx
+P2g6zJZbei2RQVzUa7rQbvRB3Cj8NdusG1ZS45phVmeBtw/z4l7k+PsGC4ZuuCVl8PBnXOEnO+0XDdNMrPtHOu2PwzeJ+LH6yvE/
+DFzZWCk4JRudRJJf4t/4PG90WskzutFpDWQChFLEiX9gcVR6Z4uHK0wCPn8cEBcMRL2uO0YX7qTpLt3aNrzEOW8do8EcDn9hEd9C
+0vLX6C1FUQ0hPtuoSppmlCSDsQEQqNf8IB2UTRmn7ZqUlJWjTTRsu2HSLVusD8/8A/4niQSxovAEwRFIAvxZPp488bycfQpgyz6l
+pCaUIAcr/qVHxnyOJZ2ekBEtpdSPV+zTxsTU5MxGdnI6gilqg9LYn25XWjQNa1siTnYLHJqOTFA0yrOYG8Rh+Wq6AhXi1U2n+wEc
+c5ilr3R6okGr23bfnBAt4AMQB/LHyBHuK3DhTMQz6+PCoGoCl9ft4ibaycLKUhqLfkyngVJQToQ4WSO2hhmweKkNZBH1auRTS6/i
+x234CBvfyNfntuw9SVF3SnJQXOQ7Z0olRd1HBHBXH4lxEkIkxJ+flEQ+++OH3bytaJI0H5RUWolcv3FFXpQr8nr6rK/oCpxMm9Vz
+p4Ow507zsKfPpqayuSC8kMvlxC/5ASkKYorJ8Mu0kNdHDdfTxU9hZjNicJjXrBg8GQZn8+GHfE4oIp+T5v2rGhbU68mePOHvBmT5
+6bPd504rcmqjtlErZTdqnHjUKH2UVoDlj5z5pODswFVKG25JIL2nILWKdFKSMdEpvZpaW089l8JY5c2N7IZbCaKSFW0ltHlR3txw
+K2D1IiuxyQtzsN0grZ8yhYdqLb2ast3U3iH5uKz2nH6A4xuvJFQZ13kWVS6p4bnXxkRC5JCzYCkENmbDtd0NNymNXtNZbHAlZosr
+bzrN2o2e8sbEhttXXYWs4ICxXX9OWGFDYF0LR3Y6vZJ126bhyYBDORdI5O11u2a3G8z93S48dZ3UtPVud/24Vkynx3aVbpfPFE6W
+NUvTVXkXU/nLCHpsP4tut9PtisB0uxxTDNZrOpvlGFsN06U/fcN32OeBzR/enONaPgfBkROW6Pcp+M73QdEv8IGtxJHwAuYYPYiK
+RCh2uwEXn5gS1tnIh+luN5jU++pu2G46LXRRxBPtGJ5TiF0su2zYJqPTitWt5GR1TVf7MDyWzwWpIuGFXHJDjw1o57HEZh47ciuP
+TQf1ibbiGDaiio3A6b8nKh4XrvTD7PjRvHiYH8okEEdjKvXZ5X8ohtXS20pEKjXyyRfL4hpULS03bx0Lrm0tkKRkd1Ieez8rSMtH
+76uDi0DtlO41sy19D5Pk4MmtTOElnYIyItGXG/whwrgXvopI0QzeEvNnCJm8r6sum5MUYskiIklio2HaQm7cb4mfR05SMhRf80M3
+UFnpKfPj485xLTePAnBGOVcpO5XjRjkPf33g8vPhtzFNi34dx6+ghNHx4+ciChfwRtwXhnfn+/RBWngFbBGL68wTTL3CJ0uDN5RG
+Oi3bGpWNwJqJJGXswJK+D38HhPE8Be2SRQXw5I6STtvlTgUb6lciFCuFL33CBXzKSPFiMPMmTbUMi+sbSrX0dspt2h2zxqQNWL5g
+AazDDIFxba2eQDpyWSdQXkU5lvNVRFsxXQ+hdPRY8GKZtZuZEZOTbjQtfcdo6J7tlAJXtuNSZ6FBLQ+uslQplLx3kRGN6hzmKDLx
+g2AYZDLhpVnZq8wL+ImdiPC4nAbG/JaB5R43a7jPWG6nDTeAtMZR1QziotC27lAOp6s5ENak1W3NJm52k91YapS4vQT5kYjO/THh
+7jBZsCQYclao6piKcnNeieIbdE+mWc/mPacQacuwdGcfhl8YLG3pLp0uSkpPEHTpwYhic4pHLLmYU7KLnXqdJolB8VvFebyuTS3Z
+7X0HlKLhTWshl59OfYjWUs/qnmtb2b9K+VextRT0u4NkKN7QyqdOnFVIyqX0r1J4t6tOTLxAa7ssPb/kNewJVDsMjLT7V5HL28GY
+hWRB4S6d0UZcDn3ey2R4kwSxc0Yrgaw8JxUnSkDIVFid1hZ10KdwgwWOMo/m1Ix6TKDLUfwolijN7ihhAomZp2L5RdRSOIrN+sch
+wJw4qBWCZ6crvjaHFBXsrrGJ66AXm1DmD7votlQZFqVQJ6psJL0W6/FGeNCCdFoOMzAUpScnUM6hynBQOJtmLbtGwWx0qGqaZgM3
+kWq6p48znbht25VUWo4HVZINQtLYhbel7Za9SrfrzYtKeKHF22WrUrJUGpOT4tG47N823Xdlig9qD3kPY4BKHhSc9l++RCV6WPUA
+CJSSoW5Vb9MPei0TFEMf1HSrQR2745r76yAnaFHng2dPnVQPNjebXstUadY0POroZq9HjGjycvCN6WSZR0OMYYRud4xm3W2jDR5/
+qdvH2vuYmDLNGu6iaVe3S6j3X5Xctg7HcyD8EFMW4uMMU6Pl8T/zoYyyZBouUreHGjCi/QEizPD9LEwnETGiUIKTztfsA0uzeNa9
+3aZhUnnMyjpUr3rKPHdkq03DrDnU4kyQEtH/ISpSir/4AVEK3plVUoZHlFqZEi98btLthtg0ourR+YBoEEMorxqpPp92xvM8i771
+CECKhwHhlOAno3kq9ZtEBOpsh9RZBuYNlAlIqkTQna8QaRzceSE8D+FMzTBbGJQECa46wyp8EogD1OvNW1k22E4DR02zwcgDZqd/
+NGptWYgTMEIGrFbwZM5XXK7z8w6g6k0DFXdKahiyBTQoqbZPjpoYrGmaTmyBtDVL8BA7oHLNCpwR3eJ2jfolMP0/NGtYdbvE/nDm
+dSKVmVBUULUuqHYA1Q2CV7azpm41OnqDavCJ2FnIHHLRXCVeJrTHJ1YtGK3EzrL2a2M5MQXo+QTSUO0sKgDXKPsrxoGLPPRCLPAs
+gkezQrcYG08PVTvbdGhds7Keo1su6PwGk83POEapP0hGK2GeYaFOSUWNeImdRQMAGmV/u13+UMnKQkln0d4aYsRMr2lieEw/vN5A
+dFynKlbsBITHauaHPV7V7KxuehqNzxz+cCDxL76K7AiKrod19XTHw2504b2l40GhoFOeBcEjVaxGo+nHYpNdH1vvMDpi1BDShcKl
+bUOa6XZ59dJp7gg+JT9qhO2ELNBcJzLddbvckEg4CYjPTGhJGMil6LBWm7I/8StMAViQRS0mCUocFDQ2SZW0SY20NJrd1c1t6sgg
+lHUQzC38maTvJeGAZl9CP/HHNE/CfSROvOxzPJT0UVIsnh9MgjHkV417SUjF7Evo75FNYD21FjcyPM8m0y2tKXvI6giIsgCVh3Mw
+UfE+6GwvyVVtK7NJNjMZRQU35Ayib1tox4laHgUqAuvTDpp6glxQJypTOIWKT4HlNHEvaY1pmon279q6ozccvd3EaTSddmVL6XYj
+SKbTcny67XYjMzUEKYpvNtnSNM0k2xpqm8ecuNEPGANuoH1eh9PQsTxoSM0abqCgNp22yRJLxsyfUAf0lgESYV5P2zWaTsvr3e5Y
+JDGvw4K2XoqzAzB1t7W6bJEq2SFLWHg0Q5m9ndyDmvlFq30VIWhwRW2TIOOFngJWrne73bGVdHpsm9VhWWM29jhc4dQMAbid6H8o
+upROjy2HXEAY3r/NP8OrhPJrEC31CSmz5/d2RvoEGnvg2+2U7fjmH1hT4KiU2UKPYe8oHDdBD7C6xNt80AsbXa70cOtywGwDtbvd
+EFrId0lZ0/q4maXSmrovr5EDzuara8BJ9xTSkS0S46jkJbImbo8CMBWIbIXTYhAjCjHrhVOatIV7tOiYLcULg1jsgWgVnvBHIs9D
+gacUbiubEU3HAvUAy4bLSTuddtLpGJatrEPdTgvkni0ylldY+pSpWSQEWcDT38iZMcoNJ9OWyGgH1wh1w6TqxsTGBFwl7I2jd2Ii
+PDWpUW5LQt7KdhzjhLVsd7ZM+pGO7dHaguc5cNITFrIjFAI7HBx5kSEM3HrNcMWgfgJds8z9FFzA2PXUJ8SonwAp49QnYhl8QjiS
+8g+JlKSix2JPpSKflf56xMoOHtlYKTQk6RcSq05COfEWJxTV16ZBpQmTWNJ78fD7oPbANyH74JWl5CvY9PpWuojxGUuxtFYwdKzE
+algJhccz5XWghtekTrQqBGadT8Ci8wlQwsEtgUq+IU6vf4kNaziGLzQS6uQMq5OfzwBgEPlgKk+nxzZFf1J/Bh/FHNumblgpdlqD
+xA19/Qk19Ql/Sv5Eqq0bjisFNqz7Bo/ox30YSBesJBKir5K07wNPN69r+mD7f7gEh0suvrz3X1oHnNYiM+UmeyG7pYjcTjgTdrvS
+hiUFi6Or7sOb921iR4EUmbUw8zBQCVk2oWwepPQzblYCk+aQYByownghIkSqTuLztFBg/JO/6Ku1CEcnsHPd7li+xy0OoWF8sI8/
+W5hmcv2zhTluIx+1YYK2zDmmLfMAGadPwnyrSujeRI9EPDD55RlVXLZUyfduol8iwGNhfFVkwDCYcV5qhA0jMM/z+MKmljR1p8ZL
+gHgs8x7Z1g6EyvCa8YrRVrupSqBxL0ih4hqJezNVMloNiRgebUGWGGlblXSJBOykKrUl4nqObTVUif2VSLR9qtR0WGNYRVWdCK1R
+dQKbpASVDNJWxzQpMgM04SypJHVMSZVsUyIWHqwFJ+eoBYGpHna8dDovemQr2MHhX+EwXSF4NE5osCIrPRLim1BDGhwDwLkXd45L
+mTCcWJops1MA0a6e1yPChi84hpPaDpUIOyTkZSc92g9yxJiR6vLTgwGpmlKGnyYkJAXGCzd1JGCw0NsjK5p4HLqtzO8Af3HWH6vU
+0VpkB3vI1VZwvtpTyE44U2gr/gY0+W10eQ+eVWrbZa9CKB6ekvCkeedxDplrdhVvSxYJdp2rnmIO3LC76lniGW3G/dXsKrHNWsRf
+7TgOtbyTOPDYyHgaLwHUHLHrdZd6ao5UbbPTstQcgS3f07bltvUqjXmX/DgwMVte4KA1dSwPkqLWNjjauuMZoJNlybaAkayd1bcg
+XDfNJdN2IXaOmLrrnYJXYbQW8J5hlR1ab+lt4NwhHtScvUrEIqEBp3XHpQ6C0sFXV4ZVe1qs+Rmi13bgsmmNtfC074/EOkH0Wg2B
+WQfXEpCPukQMq2o7bdsB5Qzw8QWCWmaMF6m6CpuKKnXdE1gPV32aVKFRz1gt1ppF1kNrAINL1ZPEbjOlHsiV9nw9DHIerHPYmiXP
+TCrZjsWWGTZ2ic6D106vPH124QPE5f6lk2vrKxDQAW0cYL7D1MoT2Qky8fwxeC2BhvC7bYd2XW/fpApIYrrd490nlAkD4oyNj2Pc
+cqmCf8fKC+Mf586N8tLywtmFjTKLsTFRKcklVa/VHOq6XejSqkm7umvUaBcu5PCnblteN5yAu1t2bb9b1bHF3Spu77tV24T/Go7d
+aXdrtW6Nerphut2aoZt2o1sznG7N2OnWzG7N69YNatZcCo6Gnw8aA6RdZuGnC0O0W3dAjA9/IXKzzF6DVrowYeAPdbpNpwsTc9dg
+sU3aoFataxpdmPa7Ld2wui1qdfAH1oRui3p619J3upbNsu7aZtdue6zqrB+77S5aOeq6FMdplzEfXXZA53ZaLd3ZZ2LtXQ/h8Gpd
+D+re9ZpdD+vH4npO13P06na3Y/KOQtDLxyusv4C2z9DGyl5blp6XS6qU0TNSV8q4GUnZ2HCfekIikiEp+PAWaeDYxkQSERyHvh8f
+Pz5BJjZK8Iuuykbl+AS8152AvKH8p8qpDa/ylHIwSXpduaRuxvzjgl9h7icm4Lnj8+W//sT/fCqzqR07nhufG69MwGvH8vOpDW+j
+vrGz4WxYlQl47fh8+akMfG1BmRu1gzyZ6ylyOatUlAmyo008/9cHeTLdk0sq5p4BGEhDm3j+E1CkXBrLPvUJpfv8/wx8/1OZIPus
+ARili58UuaSlnoK0m+yblumOZ8Iab2kTUKcu/N+ZIHsJakTGmr7oaI/sJnxPTcJjbNrtzsEfYEkSV4LwYr8Ue3SvjudhHYpLBMxT
+JhxCs5v+vLcIs2pw0z8fXAvut9EGOTvHBYvs6bSEtqvBrbCDXqrxjHBeE6QE1sXbUf+EKmna5opo2SqR0fLz7HK6OI7BbM34myLT
+5+wZ7ewmOzrZ5Ia7MpqUkmDHT3UEc3A8ll+4UHFVb0LpSgaY+B5ZimGNuLHTDbY4loNSUGV9tqr7ZqSAqVLwqz+dy35c4h+B8qVx
+PB/sSDN54qCckAHqwsuxiMSqkHKO5Cqhhikn3jzQGhBUCnRsWWylkR0lCNcc4vTIgpagBIY4TM2FRhMQollxhYaHz+w4EDkfPLaH
+1ZaxnktN3WEfkFdkzho1jZbhUf6lDQZBrQbztHRnmzp8BWU22S2PqebSdP6ou6YoLrsx8Blc4maZC8rTLHa5x07dUB9mmLalKN1u
+9CyXXbOm01IeKBmelSsCHzzvF2U7wIvB01fO/+Jqe8LyZExC3GzQLg1el8NJ5zhwzrKjbcsRIEkUwowVXo6BlMAc29RPFsYEsSv/
+Zjq57mOHd1SkFI5GU4lkT7MJHIuskCCc9Yss5ETGcgo34Qwjk9ga5aMHLqHjCfMYPQkPPuL4XbWf3bhxbCqd3kUlGuxSB/fpA5MT
+UwuTBruC49pUt2sey3e7nZLsZjm9aUIrMnnip9OMIDvNJrvywLKUdDqxeYqaXIRJ3B5ZHqTcCGks7E8qEBOEBj74JJA6fAu9PbIW
+m2aDg3vGBvO5CwMZzz7GD3yTmOP5iPJqFj+7yeUVEiY1FiNhXiPCZ432onXSUMHQKdC1VkXRIrQT4RlWojLuXC9kjIWvPRLOuQlb
+t2Cdoj0CdrjbnnsSWWlYmnDzfOQyg5XAP0XcrBsOX/fmLbaornBZiU0Yh8qBl92EspZ1T+fXm2N5dkHaCyVMrUhGjp+Ro+DOG3MC
+qVuW5dA8HY1H61kaT9kbCSctGSfxCCQBLmFPL4yXUHOYv3HrdqdhYtuWvdg0qJTyqjzqFJSHewIcnt7hQ5LklHefbAzfpEkcB66P
+nWZxe4pumF21KI2E4rcDmtwLly8fvOOa2N3iUpkRP/DZR0kogqNylGwAwN57N/r885ZhAzB/lGIDjjqfUFrsXO1PU6hwAjaIXnzJ
+J3GlcTS/39l596bhrlKrSmssuqE5x7RJmF6Az1/w4nyZxggOUsDaABET+TfOCuzjsb+RThuCpE6QAzsLUYLBHE78hApzvUIK86Ea
+dD8xo615GwR/YMRawoidV5JHrD0+3vMvXZzjWrEvWhGihSyeP9oOHVWHzulJWOP8HuWwgUMP7cNvWBI+AQj0RTuoEI57nUwedBui
+dJMtG1nPMUDVLsHRxqRXdNZaIaSvxPAeU96wUk8pmScmCBY83xdVY8eOR6PUXE88Yh5EqTw1x7vbnR5DOoOUeA51FvmXmYTQUk7N
+D0T9yA2XFNIX8XGbHZ7FJ69rAdljm1GF4KEcAXFgJZ5j7Go/9eRwPccRHh4uZnFLcYbWqQPEl5CMZtk5JQyc/ub3I8fozwKeeywH
+tgv3+jNFmdIOVGNbVo6OXo+c1cqJaPWt+MM2QUop9zir/xGZc2DzE85OeRF8jxy9eYpVk+QjQtuc6WFG7IIWo868EfbOfO7dAZGg
+UdvuiZuv/OAWBfYjw3bxtbavTcG9DxeYxCLYJOWXZPURW3LzOP7hkH2enYL9dSY4DZMk4TOGZqJf+5ocLYpLBwdlkYI4qb+7PdPw
+hbUsTcB9fijmTgRz8XpxAOxOuNzAAyAnsuZqFnGEZRyrAQKzTmRxDVgFMvIeHoknGTq+DEZgm85phw7h8LneCAjzN3n5eeuYNoOv
+8Yy6bJatCjsIZRueYzOR05oxf4eM42VIHxhRuheuhAOyCbVyRpcpzSKF3kBY2AujODjJhzKydQRK21QOa5N/JBgOY0+QLA2JiY1h
+pvSWH4WV8mqBOIevFwSYFJc63kIdxCTgsDBYGQjlh4ZHGp1DqEzgJUIk2/wY/HDclJI80mQeFxWIZfM4zVHUAXTRt/T5rzkCwu12
+4TRyARPxJW9AG4JnIDQ4WU6nl8WtGwEBVJzOwtZior42Cok0SyHRJLg7G56kb9FLamhkmIqV9pnFkpzIxI+2Jkfn0WDqh66okNPa
+QC4MDrL7zvrnKT5CMDS7LJz3V5R5RdpAzI2SbMXvHIhXGnxtoVnHYcdmHQcF8BYREmY0h0SuNAbmAQwyoeOao6hDChrLR3O3orlj
+DnllpCzCNJEceRY90c5s5FqGNUGkFzGD/q/sFp8Mq1DceC3vQEq8vs7zbRTxEeloQtnzEhCfDCZg+K7YAlYZzs1TOJYUK5MhTiYT
+7Byhw4Fe+QUW+6wVx52/Kfb4FY9ubWvShiWxCzhpw/Fd+Jf0NVez+sMYBD4psFEzCCiR7MT4tKYJvuNasUde0PoYHmIhE6H5kg3i
+aa8WvXzivZmL9F6OCM0eyw/ttfhZL9DOOJMWCrfGOQlfPoqCuBs5kMD9rzeuS4pC4v2r0eAMwZiXDTjFDG4UlXTayG7abQonrPxF
+FLw19k+jazGuR7ykc/zbOb7FwwEC7zLxQVUqp4ZvVVJ51QoOUllIQRXeP8rBmXkoKBK8W1MCDS0oJyn55XEzarSWMkyTNnQzhZYP
+SCAxmSOpPMqCFiS4PBqD15GaE5y4s1Pd+Om544tsR07fE07zNUc4molO1g6fqE8FGImbPKJrIYxM/oe4mu7zwmP2fHijm9AFYxEK
+BrW//hIvXLhGV3Y+2SQysrEDc1Cq3znm8qsKU9PLnYrfscCmwf7bBBx9yufpjbpciH+CNyH8xVKvk8lAnA5IhY5QnZ7w6IJ1TLcb
+DqM+Hla8Lua5D1gCw0xAv0YwDph2Jd8Xu7vXYFH1eK8Gnd7WxLzGortcIKJuV1xkNbzgF7Ym3S7jFNiHMUc8zIaIASvKWE6fLMXD
+wabmzDfnlWa8vm3S1JrRmyX/Yj1KinwB5hJUwCRFn9uwGkfY+eNavj/wmDaVTlfL8WC+9RhBMkDhppiDc1AnPhUqiiokCMQy0ukx
+sRtkTn0hnxMSijhHK2QwCZIoJgo/SYwEzg+bsHpkVUs+jva7hLI5F2Z84SVWOV/Ryh5JyLoSmXgpn1Qit4WECpIIVo88nbSSAfsm
+vHtDWubTiXBqxo7E/JdQ4Cb9kbhMHJ8rmWfe0ozgwRk8s8S3YA5n1YkVvBaLD2AQufd3YeDtdvsLxLM8eFVNFpM0A7CtnH/nKZFy
+OU/ygWBHj5zU+mw71+yqxmQyA+D8cCI0XvONmYfEqOWSeknLiSSWzAYkriTRUsWl2xc74QBnPQNUFfkGpMCHIrptHVBNGVa743Hp
+f5QnxvfDWwq+y+PLSz6HWyBBlil8H45HBMCqHlboilVLKPfwqiIBp6AnoaeV+fCaNjfvHLPmnUxG4T0fEd+UPVChMx9etsPCM0gO
+KHwqP0orYlU6vA2MKOMpoiKlst+joyGZnGXNrvbmQ7Fj5xCx44mnxnxdJi2v6Wa36AQY+4AlqG0blpfayWUL2Xxqaz/1P1q61zR0
+F0xSGHX+Fh9NgwBJnIbYSlhwZNyENuKSUkWsxMGdragw5gyolMAHLkpCFpwe5empqclJZZ49iaE94a2foyXEJwbTIVU3bduJGhTl
++x1iMWMz43mi95kExZMnnddHkgKCdDVJms9k7GM654Q6Ghv5cqiwxa4gRzRmuKuGZXhU7ijdbudYrtvtHM/n88V8Pt/tgmW+MU3r
+jNDkzjENXFMlrkCio6hyZxzDpgnVpqYKc9MZuQMWYoAd6fxNPlcoZqamJws5wpPgKQiR7UyePf4MLXRMT84WYXi7Gc0RFcFYCvEj
+aeG9tCsSnn2YFbggahYewviC0WEom8BR3nlSDIdHN/7TJfw6K359LvZx7rGUu7gdfCigSlIoJucy0bgtEN2lHve1bVfNCVLryDiC
+rDil2+rTxG1bprrIJMIX9eq2Z4CI+MkwwDV1t6l+kgUsdDwbX6a8yPzQ0rN6Q32GuFXdWoaKuOqzpKlbNZOiV/0oiwkvj86CcLH6
+4TBgOVQooD4XBp/Ut6ipfowFrLWptchapD7hV8tqqB9nbmSi/e8fAUl530MpcWjL3gm+epQlWbE8w9tXLe5llKs63Ps03cXHMgYl
+0Vs21eYhJ/hrGurL26+02k3dNVz1Q7zEZb9H1A9GZexZBqpLk4XtUbp+amYGZCXtrGU7LVwCnjlzgoCagpgovomRi8xAZj5fVLLs
+XSros5m35OIcFxRta3Z2ZX1p4fTC4skVUtekjY2NDSkDzLSdXXn67ImzHyM1zc46fneSliZIeE+Ux6S/fuJv0k/KylMZsjGe3ZhQ
+549px0v/Y6O8UXl+8xMH3d7/3NhbyG/sLcxs7C0sbuwtTm/sLc5s7C2Ce3Wjk5ucWYHf2ZmNTm5qamEc/8CHqdk5/F2A30WINLWU
+w99J/J2G31V0rxY3Ornp3Bz+LuDvEv4uw29+EX9X8Bdynp7GYqan8fMyJJ7J5cbxDwTNrM6gZxUynJ3EL7OTkH52Cn7npov4OwW/
+M1CnhVX4XcZ6rBShjJWpBfyFsldzRchiNV/AX4i0OolVWJ2E8lZnIafVZSxoFeuzujyHvwsbnXyuCHHzOcg3n1td3OjkJ6chbn5y
+enajky/mchudPLYmPz29Ar9zi/i7BL8ri+P4Bz7PTE7h7zT8LkOt8jPLzDPLPFDgLIKRnwUo83PFIv5CwgUAMb8AIOYXFjDSwsI0
+/s4yDxSyiL2YX5yGai2uLqFnFdIsTWJdlibRAx2fX5pB9xJmtrQ0A7/LkxudQi4PQYVcYQZ+J5mniF+KU+iZyuPvJPOswO/MMv6i
+exbds+CezEH1CpO5RfgtzOHvwkanMDONH2ZmpuB3if1Ow+/KNH5YWd3oFOZmsYy5uVn4RaQKc8uQ19zqEv5CUUurc+P4Zwl/V/AX
+ki8DiRRWENTCSgE+rLD2rBQLG53JXC4/jn8m8XcWPfk8/haZZxV+J3P4uwy/C+gGWlgoQlELRShqYTq3PI5/0DMzib/weXq1gB9W
+ZzY6C7MzkO3C7Ax6llbwF1LMrs7ih9UF/F3a6CzMQX0X5grweW4Kf5egugtzS8vwu4yfl+HDwhR09cICxlrADwvsA4yOhYXV/EZn
+YXFlcaOzugyjaXUZ6GB1BTt6dSU/B7+TzDNVwN8ieqbz+DuJv7P4u4C/kNUqore6CuitruammGcBf5eYB0pZzWNQHpPkMaSQg18k
+yVUciKurWKHVKYw0hSFTq/h5eqrS3egsz+Zy5Y3O8jL25fJyrrDRWV6dW4Xf5RyPkYcv06vMU4DoS1MzEAalLmObl1emMIOVqVn4
+ncGgVRa0Og25TQItLWN9llfnmGduiZdQxEyLMEktLxWX4XdxEX+X8HcFPyxhRYqspjBwlpdnivg7Bb9LU/hhaQ5/IZNlIOnl5eVl
+/LCMlZqcxUoBOssrC3O8AlNYARgoy8tIDcvLy9DElSJ6VrC01ckl1oYVnmoOU81ghZZmiiw0vwChKzBtLa9MMyCmMNkMSw11Wi3y
+2ECGy0tzDNzJaUw6ixFXZhcroBpeXJ6exAdI8pOZeubJbvl5aWNjL5erKE9J3Y0nMVTqlp9/0g99sruxIcsSD1b84I0NRZHAHFn8
+ZVP5WAV+n08dO76x4W1sWBv4P0jVZbmAV3mqfLwCGexHM5AybXzJHA1r4uMoeOzziQw88pl4Hv7uahMbWfg30QBbYuPjmYkGWdHg
++VxZH39xYfzjufG57BiuxE9lNia0El96xyuZ/xHGwOoGvvHKQY5M53vCd6WERhCOlEJ5Sjk+QdahMvAsTx9/scL/Qp0y45WDPJnM
+99Ty88eOAzbjG3uFXOUpeN61pE08n3oKigSJrNIEWdCYqMeGtbGX29rYy1U39nK1ygRZ1iYSwgGFNW3i+Q13gpzSJlIg+nEW85wg
+p8O88UHWiSjSGxtl7DvWZeWNjUqkzw5yJJ/L5XobGxVJIWegWs9vWJ8ApmZjY+xY+qnNJ6VKZqKVdHfi37CjChXBfnsgmwYG3Mlq
+QkLYB9Aq20dzHl48NmQq3YS3FnBYWQKHGkTIaB67NcmIIj7gViLHY+FjL57wWKRU/vwrEia+BPOrgw/CFgdly4QSlhQyloufRYXm
+/nmsPWU+kHHWPP/lWH5evIXld7Bt253nr8tlS8xji1/TWUIWocQDPtYGBeNBRyQAbRO/iHEveOfCpZj8m6dlIqWYgFH0TRQIx0VB
+1exYrBdA+QvC8cnESzexRvHM4F4MD7JYAKXbslKSxa8B6YV6B+LV9BRF3Wcn1IHMb0hcJTka+4WkSAoRylTUeAppY0PibXwx+TiW
+N0yO9P8KlG5pfj/kiRee0BFHaNg260NB35zmylJLN0zPVqWMhV+Z/jlJIk68PUpixynq2Fi0PusKE658zAo9ZjV65Jnhw6Q2YJiw
+w3ZeKVFLhSCXF9J9rGyLddezg07PmbFq0kEtcnVutTYYikZdnpxjl9mTRfirNDOZkEbYLXkqPMZklAsR5+MxeVWByJv+xKaBr1aS
+NixJ7ZuPFjy5xvpDyJpY7IWXwxKZgG1HW+NUD1viVuBu++EemEBtBW4DLarKY9VuF5RtwS4cLJHKY3U0aVBVyNwUNKEk6xpoHh+z
+MZaL5qrHDIihqFFYeNQxG2IpKvjQFQCg1ciB1WnV2ElJE4Ro8cYE7NLp1iZe7qmo5vqjiVctDJ3grEWm7KjOilKJgyLmQTnipBqO
+a4M4Gqt9Sfrjp74vqbwJ0h8/9WqsG+Lzpq+H5wXQzxwsfiK56f5Zb3A2pR1UqyolYeMNYjtGI/Cgrj6dtB26Y9gdrq0wTI7aHtiJ
+VoCZlfWdAnhWNnD3CF9CYnll/UJAyHPAJ7zuiSdkY+iDkTEU6EARMqWxbJjMlEL4mGbeUhygMJXKYgR+4VOPfCBGGCwue2fNZd80
+ENALEys98qEB494f9Ux7ZB1OlJsaX5Lr5bmpikZJvVwssL+Tc/xvEf5acXj8FRtNKPvFj+EkYGlhCLvu8OPyc+iaZmWrVaY/L+hA
+Jt/jCWlJG2rnd2s67THN2/CnXq5VmIRBU5ND0uh2PSFD6PFsSHcZS/AofzOpwXVWtloFichstZpOs7SQD9ggVg7a4c0/iEUGvQKv
+TzSLFEFWoNbt4sRRAz6lrRx0xLF4XCtArqK/VFDzMER9DZP8elGINK51iBX12uFUHzqDVyVCEF/QOgrRwyR6fxI9IQkO8xZfcmC6
+7ZQk0GMkBQqI2N0V1gEIb95Mp6Fn9HmlqpksjJihaGgrMkmY8A63Om9HZElbCvkA6swmyAeFrUZNq0FO/B4zctqKjFcO+05MpYep
+XPgEdUpMDncGmsvvxS0elS1tOFHWSjLrmxBInDlJG8mq78v3JIX42Shseq3h68y+mOcieQgfXhWzmG93u81uVwZK15xwTDgisSc1
+zFFijeqJQzA2itPpWAAbwcmAx+bHHvlw302VyNrsxPc2lLEAHZDy6Wy5niOD5AAnwIKi9MhzQzNsiLxSVC9usOYRuIVlL+w9kYdg
+G4m5AnJZIo+NcYWI/jLBPof6MYu5vqQg2RZ+z+N3mJKcY3kuOBiJPa7lg+ebvDcW2AbRBGLuTxKYJohtbBh2VrizsRTiyqB/KLz7
+kgeirPTIx4bCfKKv37pdP4Pj+Vwu3+1OwEa7srGxUXligjUBn+qEsiNPDOJ6o2yJz+e+IEvlkLPtY2Z9sRV+uQNNR5lrWKA/Pqyk
+QKcI64K5fHS3pRwIX8OqjEFdRqlEBp8PsdHWt20ak4LNPdTzIwMXZVySYaWL1TZsh89LyB0W5F/6KUoiYi/IUoVt2ZBf7GT1qmfs
+0OGRhSHvtxBzAD3XqLUO8alGsC3mtIFjh+8D4MoRPvj7eyeQdO67FZQDASo/kbwgyF7FtgkB8echmWzH8sXrRxlBiOdazA/bc5sg
+KKf6IVpVIWPcvkM77I96rDS8xJRxeqofL5TcpJOINmln6ora8XsPV8BuV06M3GGHPsRf5OoCtFAnrtiyKco3lXXZVSrzTRQga0YV
+uGtNprqdNQ8lE82AF8MV3yr5iuP5Hni+Ft0DO6Tmb4BtEHNm/ALZQaagpXX4QofLaGte2dFazENa4ZJci5BeSyEtbYdZ1hDDa1Fx
+FP/GVe4Eq2CwKA2gW16ZoNwxC23CxIaPvyZ25hVO492u7I8WtEHV0cIy52NmjlKJJQed5JFBg7JHKNXiQgDi8uzXz+9z3x+hHCbP
+KX7WUC+0SmPbKv8z6QOvb9uFNKd6XEulRRgS7D2nR/vk/oOCo7UMdy8W7Z/0fBhjxzOb3BJOFLIqLozsIMUZPbMzSjAV+UJLbkt3
+vFI8//AV8S6R/vipnwqPELf7Xw1qOQLm4oJplC12uJEohRZKJiaDdfBvCvDJCz8VVDFVoSR7Wp5YmiyszxOT8EpDK0SCixAMNfxW
+oMbKUjLSHz/1cuD3lB7Y5E8+nlR6xKD9soPCwudFdHThUpROB1rDA21IKebz2dmy17eRyFf844sRIhcq89E33v53vxdO+U8/xbXY
+n6/CU1FV0F6uJC7ILFEk2nz/0fZZNj5tGtt2izO0RsNBzhSh+WdqbmRxhGlbtpMXimDhzjElVkn8XY7YCpmajXKnSvxA2iW5CN8Q
+WXYDvmH4qtvtQl0d/3g8qQgmPRbl4GJlGANWYHxS5YuAQn58qwkrkXj2GfbCaUgjsRdXwBip8QxAGkaYL/1UCq7Vg6pf1XQ5kKrE
+3KslWYwF465crcDruXK1oh0Ii5/qEFz5VKMXTvHjsAXSE2YlkIi2xF6bR61fqDc8OLrj71Qs/tAkn1M9AUAumARHfsJzk7lCJFIg
+sRWPNt0XDSW9YtGKBZVFn/KjC6JcskWisSfnWOzJoh87MrWm04fmMJePVEuQ9YpVbHIyVn+rEW9hNIYoFxaLOR3F1Zdpk6lvpkMU
+bYvXYzaSlomShXGCBz1CHK70mAYbMQ/ISWAv4/OzCVcHnC1waZ8mxujE06fJgV1kCfNR30Es078XWa0haD6sMpNwg2Yp830lCOnj
+3Bh8UY4i28vEX9m9LyjjzHThXnljb3FqYw+khfaWpzf2QBZnDyQVcnkQgsrlQSIjl88V8Xcaf2fxdwF/l/AXZLjyeYyPIll5FMnK
+5zF+HuPnMX4e46PkWL6A8QsYv4DxCxi/gPELGL+A8QsYfxLjT2L8SYw/ifEn5/B3EX+X8RfrX8zj7yT+TuHvDP5i/CLmX8T8i5j/
+FOY/hflPYf5TmP8U1gelz/JTGB9l1vLTGH8a46P8Wn4a409j/GmMP43xpzE+yrXlZzD+DMafwfgzGH8GazWDrZjBVsxgK2axFbOY
+ahZTzWIqFO/Lz4IgSy4/i8lmV9AzhynmsN1zmGJumn3AguawSnNYxBwWsYAVW8AiFjDBAhaxgEUsYMUWMNUCNmQBUy3mMdNFLGcR
+8V3EBItYzCImWMLslvDrEn5dwq9LiM4SVnsJq7KEmS5j3Zcxz2VMtYypUHgvv4zxl7ESK1jpFaz0CpaygpVewfxXMP8VrMMKxkdh
+oFx+FROgWGF+lcGyiilWMcUqpgD5r1wB6b+A9F9A+i8g/ReQ/gtI/wWk/wLSfwHpv4D0X0D6LyD9F5D+C0j/BaT/AtJ/Aem/gPRf
+QPovIP0XkP4LSP8FpP8C0n8B6b+A9F+YxK9I8wWk+QJKUxaQ5gtFkK7LFYqYXRGzQ3IvILkXkNwncUhMIlFOIlFOIjlOItlNIpFN
+zoKwUm4SJUYnZ5fwF4lsciGPv5PMA/WYXMSsljCrpVX8gN05iR05uYw5Ls/iL2a4jBlid05id05id05id05id05id05id05id05i
+d06ieOjkKuaPvTm5iqWAOF5uEuVMJ7FLJ1dB+ipXBEGnXBHHbBHHbBHHbBHHbBHHbBHHbBHHbBHHbBHhKSI8RRyzRQSpiGO2OIPx
+ZzA+Ct0WZzE+4lVEvIqzGD6H4XOYD47J4hzmg0OyOIfxcWAW5zA+DskiDskiDskiDsniAsZfwPg4JIs4JIuIfXER4y9i/EWMj2Ox
+uIjxcUQWUe63iHK/RRBoyxVR+reIY7SIY7QIImy5Io7OIo7O4jLGX8b8UZy2iN1ZxO4sYncWsTuL2J1F7M4idmcRu7OI3VnE7ixi
+dxaxO4vYnUXsziIOziJ2Z3EV4+PYLGJHFnFsomxmbgrH5hSOzSkcm1M4NqdwbE7h2JzCsTmFY3MKx+YUjs0pHJtTODancGxO4dic
+wrE5hWNzCsfmFI7NKRybUzg2p3BsTuHYnMKxOYVjc2oyz8SsMRJMzfkck+bNAa75HIrh5gDL/ArUPb8Cdc+vQN3zK1D3/ArUPb8C
+dc+vQN3zK1D3/Eoe4+cxfh7j5zF+HuPnMX4e4+cxfgHjFzB+AeMXMH4B4xcwfgHjFzD+JMafxPiTGB+lmVcmMf4kxp/E+JMYv4jx
+ixgfRZlXihi/iPGLGL+I8YsYfwrjT2H8KYw/hfGnMP4Uxp/C+FMYH6WcV6Yx/jTGn8b4KJqNgqr5lWmMj6LZKzMYfwbjz2D8GYw/
+g/FnMP4Mxkf56JVZjD+L8Wcx/izGn8X4sxh/FuPPYvw5jD+H8ecwPq6n+RWUCV+Zw0gLGGkBIy1gJBTjXlnATBcw0wXMdAHjL2L8
+RYy/iPEXMf4ixl/E+IsYfxHjL2H8JYy/hPGXMP4Sxl/C+EsYfwnjL2P8ZYy/jPFRKn1lGeOjUPrKMsZfxvgrGH8F469g/BWMv4Lx
+VzD+CsZfwfirGH8V469i/FWMv4rxVzH+KsaHAZtfRSHsPEoO51fzzJOH4bBaYJ4CfplkHhRnXy0yTxGjIRWtIhWtIhWtIhWtTs3h
+7yL+spiYeJolnkbPLI7F1QX0LBbQg6ivLk4zzxL+YlWX2HdEeXWJfUdoVxHOVeBO8qvL7MMyFrzCkiBwqyvsC6K1usq+IESrq+wL
+yrMjs1tANreQL4DoO7K2hTw+RCjkoeKF/CyI5xexkoUisEiFJSb1voRS70swVgpL0wUMgsFSWJqewd85/F3E32X8jELzSzBOCkso
+nr80s4IfZvHDLH6YxTxgQBSWYEAUlmBAFJZmUQYfZf+X5jD+HMafw/hzGB+l+ZfmMP4cxp/D+AsYfwHjL2D8BYy/gPEXMP4Cxl/A
++IsYfxHjL2L8RYy/iPEXMf4ixl/E+EsYfwnjL2F8fHGwtITxlzD+EsZfwvjLGH8Z4y9j/GWMv4zxlzH+MsZfxvgrGH8F468goivs
+eUIBHgDAdLgwXWTuIv5O4+8s/i7g7xL+4ruBKYw/hfGnMP4Uxp/C+FMYfwrjT2H8aYw/jfGnMf40xp/G+NMYfxrjz2LMWYw5izFn
+MeYsxpzFmLMsJuY8h/HnMP4cxp/D+HMYH/pxYQaWkYUZWEYWZoBiF2ZgGVmYKbCvS/gLuc1MYsxJjDmJMScx5iTGnMSYkxgTEZtB
+xGYQsRlEbAYRm0HEZhCxGURsBhGbQcRmELEZRGwGEZtBxGYQsRlEbAYRm0HEZhCxGURsBhGbQcRmELGZaYwPW6+FGdh6LczA1mth
+Bl94zCCeM4jnDOI5g3jOzGLMWYyJGM4ghjOI3gyiN8PQm8NS5jC3BYy5gDEXMLcFjL+A8Rfghc/CzAJmuohRF+GRxiIO99VFYBFW
+F/OT6MnP4GsL9kxjcsF/JoGvAXLsNUBhhoeiSP/SAgtdXK1MNIihHSyguatFVdqSyJIqVSWyrEo1iayoEpXIqirVJfIBVWpI5IOq
+1JTICVUyJPIhVXpBIh9WpW2JnFQlUyKnVKklkadVyZLImirZEjmNprM+okqflMgZVXIksq5KrkTOqpInkWdUqSORj6rSjkSeVaVd
+iTynSnsS+Zgq7Uvk46r0okSk//VrSZVuX5OI9P99SlKl/+8H4PpbcP0juD4Nrh+C6zPg+hG4PguuH4PrJXD9BFyfA9dPwfV5cP0M
+XF8A1z+B64vg+mdwfQlc/wKuL4Pr5+D6Crh+Aa6vgutfwfU1cP0buP4OXL8E19fBdQFc3wDXa+D6JrgugutlcF0C17fA9StwfRtc
+vwbXd8D1G3B9D1yvg+v74LoMrlfAdQVcfw+uq+B6FVyIyzlwXQfXeXD9TiLSm4DVm38LLkDozc+AC3B58yVwARpvfh5cgMGbXwQX
+tPzNL4ML2vvmV8EFrXzz78AFbXvzG+CCFr35MrigHW9+G1xQ+ze/Cy6o/ZvfBxfU+c2/BxfU9M1z4IL6vfkP4PoBuKAH3/whuKDf
+3vwxuKC33vwpuKCP3vwncEHPvPkv4IL+ePMX4IJeePPfwAXYv3kRXIDum78CF2D65m/A9VtwXQYX4PfmVXABam9eBxdi9YakSjc+
+JRHpBtDVjU+DC6jpxmfBBTR043PgAsq58QVwAVY3AKsbgNUNwOoGYHUDsLoBWN0ArG4AVjcAqxuA1Q3A6gZgdQOwugFY3QCsbgBW
+NwCrG4DVDcDqBmB1A7C6AVjdAKxuAFY3AKsbgNUNwOoGYHUDsLoBWN0ArG4AVjcAqxuA1Q3A6gZgdQOwunEBXECdNwC1G4DaDUDt
+BqB2A1C7AajdeB2o6Q1wAX43AL8bgN8NwO8G4HcD8LsB+LkSkd4C+N6G5r4FRPcWEN1bQHRvAdG9BUT39rfABUC+BUC+BQPv7e+A
+CyB9G2B5CwbeW18BF0B6E8B4CwbZ2wDVWwDu2wDVWzDI3vomuGBovQ0Tw1sA89sA0FsA89sAxlvfBRcM87cA8LcwF4D5bQDjLRg8
+b8MAfesfwAUQvAWAvwWAvwWAv4X5AeBvAeBvAeD/DuTy1s8gDHP+ZwjD9gLgbwHgbwHg/46t/CWEwcTw1msQ9iVwAfT/DiT01iX4
+CtPBWzDVvQWTwFu/ha/YNuiEt2ASeOsauGCY3wRMbwJJ3nwpcAGmN2Eg3/xC4AJMbwKSN78cuGAKu/k1cAGmN78OLkDyJpR2E5C8
+CX10E6amm9AzNwG/m98DF0xIN18BF0xDN18FF5DpTSDTm4DaTUDtJqB2E1C7CajdBNRuAmo3gUxvApnehJ65CWR6E8j0JqB2E1C7
+CajdhJ65CVjdBOK8eTFwAZnehD66CWT6Fgyom4DVW0CmNwGrm4DVzSvggmnyJqB2E1H7Hbgg3i2YHG/B5HgL6PQW9NstwPQW0Okt
+oNNbMDneAiRvAZK3AMlb0Fu3YMDfggF/C6jzFgz4W0CTt2DA34IBfwtGwC2gxFtQv1tAibeAsm8B/d0C+rsFA/4WUPEtoMRbQOO3
+AMlbgOQtpL/z4AIkbwGStwDJW4DkLUDyFiB5C5C8BUjeAiRvAZK3AMlbgOQtQPIWIHkLBvwtGPC3AMlbgOQtQOiPr2GGMKZvwZi+
+BWP6LejgW7/Dz7Bgvo2jGibFt2FSfAso/21A698BmbeBAv8dKOttHN+A29uA29v4FUc14PY24PY24PY24PY24PZ7SHsb+uv3UMPf
+Qw1/DzX8PdTw99DDv4eJ6PcwwdyBsNtQxm1Yim9DGbehnbdhDrkNS/FtKO02tPg2lHYHanUbSrsD5d4Ger8DlH8b6P02lHYb+us2
+jP7bQPm3sQzoudswHm/DGLgN1HYb+vA2jMzbMBpuQ61uQ2/ehoX69vf9dtyGfr0NE+VtGCG3gQJvv+ozMLdhrrkNtHgb+vo2TJ63
+Yda5DVR5G3r9DqB7+x/BBfR5+0fgAvq8Df1/Bxak2z8BF1DqbaCEOzD6b8NMdAewvw00cQdmndswJ92BXrgN1HEHZp3bPwcX9Med
+T/s530FcgD7vfN1H4843/Lbd+bZfxp3v+PW7Ay2/A3R8B9p7B+j4DrTyDtDxHWjbHaDjO9gimBHuAB3fATq+g+0AsruDtQc6voN1
+Bjq+gzUFOr4DdHwH+vcO0PEd6NU7F3x077zmI3TnV0FNf+331h2YEe5gGCxcd7BFQO93oD/uAJH/Hl1A5L+HnrkDFPZ76Jm7MDfc
+g1nxLtD9PRjVdwGrezA/3oURcA/G910YAfdgprwLVHwPRvpdoM57MGfehdn4HmB6F+j0Hsyed4FO7wFqd4FO78Ewuwvz8j3A7y5Q
+7D2YUe/CDH0PkLwLtHsP5oG70Ef3ANO70Ed3YS25C310F6j4LlDxXWjlXaDiu0DFd4GK7wIV34UevAu43IUevAtUfBeo+C5Q8V3o
+y7uA1V2g4rtAxXehV+8CzneBiu8CVnehf+8CVneBiu8iVtDTd4GK7wIV3wUqvgt9fg+o5C5Q8T3oo7vQ+/dgBrkL9HwPqO4u0ME9
+oOe7QM/3gJ7vAkXcA1q7C/R8D+j5LtDGPaDnu0DP94Ce7wKV3AN6vgv0fA/o+S7Qyz0Y8Xf/FVww4u8C5dyDEX8XVuB7MOfcw/oB
+Td7DWgFN3sO6AE3ewxoATd7DcoEm72FpQJP3sAygyXuYM9DkPaDJe9AL92DmugfY3wOavAeI34M57B7gfA/WpnuA7j2gxHuA6T1Y
+m+4BkveAEu8BfveB/u4DaveBSu5D2+4DbdyHFt0HirgP7bgPdHAfqPM+9P59oMn70Of3gRLvQ0/fB/q7D/17H6juPvTqfaC1+9CX
+94HC7kMP3ge6ug+43Adc7gMu9wGX+4DLfcDlPuByH3C5D7jcB1zuAy73AZf7gMt9wOU+4HIfcLkPuNwHXO4DLvcBl/uAy33A5T7g
+ch9wuQ+43Adc7gMu9wGX+4DLfcDlPuByH3B5ALg8gJY/gHH5AKjpAYzGB0BDD2AMPgDKeQAj7wHQywMYbw+ASh7AKHsAtPEAxtYD
+oIgHgN8DwO8B4PcA8HsA+D0A/B4Afg8AvweA3wPA7wHg9wDwewD4PQD8HgB+DwC/B4DfA8DvAeD3APB7APg9APweAH4PAL8HgN8D
+wO8B4PcA8HsA+D0A/B4Afg8AvweA3wPA7wHg9wDwewD4PQD8HgB+DwC/B4DfA8DvAeD3APB7APg9APweAH4PAL+HgN9DoKuHMJs9
+hDH4EOawhzDyHsLM9RDG20OYrx7CKHsI9PcQ6O8h0N9DoL+HQH8PoRceAn4PAb+HgN9DwO8h4PcQ8HsI+D0E/B4Cfg8Bv4eA30PA
+7yHg9xDwewj4PQT8HgJ+DwG/h4DfQ8DvIeD3EPB7CPg9BPweAn4PAb+HgN9DwO8h4PcQ8HsI+D0E/B7CCvEI8wP8HsERwUPgtB9h
+zoDkIzgieAirxiMsAzB9BBzPQ1g/HmFpgO4j4PAfwkryCMsFnB/BEcFDYJweYQ0A8UfAlzyE1eUR1gWwfwR8yUNYXR5BrR5BLzyC
+2fsRUPEj6N9H0B+PYPZ+BPT8CHr6EfTMI5i9HwFlP4I+fwR99Ahm70dA44+g9x9Bbz2C2fsRUPsjoINH0G+PYPZ+BHT/CCjiEfTg
+I5i9H8EIeAS08Qj68hHM3o9gdXkEVPIIevU/YfZ+BKvLfwK9PIL+/U8Yb49gdflPoJxH0NP/CSPvEawu/wk09Aj6/D9hDP7h09CZ
+f/zVp9D9j+j+W3T/EN2fRveP0P0ZdP8Y3Z9F90/Q/RK6f4pulufP0P15dP8Tur+A7n9G9xfR/S/o/hK6f47uL6P7F+j+Crr/Fd1f
+Rfe/oftr6P4luv8O3RfQ/XV0v4bub6D7Irq/ie5L6H4Z3b9C97fQ/Wt0fxvdv0H3d9D9W3R/F92vo/t76L6M7u+jG5nyX72C7qvo
+/nt0X0P3q+i+ju5z6EYu/Vfn0f0GumEs/eEzn0L3D9D9t+j+R3R/Gt0/RPdn0P0jdH8W3T9G90vo/gm6P4/un6H7q+gGyv7D65D/
+H17HPn390+jGfnz9s+jGvnv9c+jG/nr9C+jGPnr9S+jGfnn9K+jGvnj9a+hG/F//OroR89e/iW7E+fVvoRuxff076EY8X/8euhHD
+119BN+L2+qvoRqxeP49uxOf1H6AbMXn9h+hGHF7/Mbqx7a//FN3Y9tf/Cd3/jO5/QffP0f0LdDNM/g3dv0T3BXS/hu6L6L6E7l+h
++9fo/g26f4vu19F9Gd1X0H0V3dfQfR3dv0M3jNI/XEb8LyP+lxH/y4j/ZcT/MuJ/GfG/jPhfRvwvI/6XEf/LiP9lxP8y4n8Z8b+M
++F9G/C8j/pcR/8uI/2XE/zLifxnxv4z4X0b8LyP+lxH/y4j/ZcT/MuJ/GfG/jPhfRvwvI/6XEf/LiP9lxP8y4n8Z8b+M+F9G/C8j
+/pcR/8uI/2XE/zLifxnxv4z4X0b8LyP+lxH/y4j/ZcT/MuJ/GfG/jPhfRvwvI/6XEf/LiP9lxP8y4n8Z8b+M+F9B/K8g/lcQ/yuI
+/xXE/wrifwXxv4L4X0H8ryD+VxD/K4j/FcT/CuJ/BfG/gvhfQfyvIP5XEP8riP8VxP8K4n/l70MMryCeV5gb8byCeF5BPK8gnlcQ
+zyuI5xXE8wrieQXxvIJ4XkE8ryCeVxDPK4jnFcTzCuJ5BfG8gnheQTyvIJ5XEM8riOcVxPMK4nkF8byCeF5BPK8gnlcQzyuI51XE
+8yrieRXxvIp4XkU8ryKeVxHPq4jnVcTzKuJ5FfG8inheRTyvIp5XEc+riOdVxPMq4nkV8byKeF5FPK8inleRnq8iPV9Fer6K9HwV
+6fkq0vNVpOerSM9XkZ6vIj1fRfyvIv5XEf+riP9VxP8q4n8V8b+K+F9F/K8i/lcR/6uI/1XE/yrifxXxv4r4X0X8ryL+VxH/q4j/
+VcT/KuJ/FfG/ivhfRfyvIv5XEf+riP9VxP8q4n8V8b+K+F9DPK/hen3ti+jGvriG2F7D9fral9GN/XINcb6G6/W1r6Ib++gaYn4N
+1+trf4du7K9riOc1XE+vfR/d2BfXENtruJ5eQ3q+hv1yDXG+huvptXPoxj66hrhdw3Xt2j+jGzG/hhhew3Xt2s/RjfhfQzyv4bp2
+7V/RjX1xDbG99lN0/xLd2C/XELdrF9B9Gd2I+TXE8NpFdF9FN+J/DfG89it0X0c39sU1xPbab9D9BrqxX64jztcR5+uI83XE+Tri
+fB1xvo44X0ecryPO1xHn64jzdcT5OmJ4HTG8jrhdR9yuI1bXEavr/4BupOfriNt1xO064nYdcbuOuF1H3K4jbtcRt+uI23XE7Tri
+dh1xu464XUfcriNu1xG33yFuv0Pcfoe4/Q5x+x3idp2FI27XWfjv/AOzP7zBIEFo32CQILRvMEgQ2jcYJAjtG0hKbyApvYEwvIEw
+vIGkdB0hf4NBgpC/gU1/A5v+Bjb9DWz6G6zpV9DNmo5D5A1s+hvY3DewWddfRzc26zqrM2vWNXSzZsGQ+uNnf+qfuP3xs5D9Nrp+
+7t9B/vGzyD6+BOzmH19CFvmlC+hGFvml19CNLPJLF9GNLPJLl9CNLPJLv0I3ssgv/RrdyCK/9Bt0I4v80m/RjSzyS6+jG1nkly6j
+G1nkl66gG1nkl66iG1nkl66hG1nkl7BNLyGL/NLv0I0s8kswZfzxc8g+fg5I84/fRDb35a+jG9ncl7+BbmRzX/4mupHNfflldCOb
++/K30I1s7svfRjeyuS9/B93I5r78XXQjm/vy99CNbO7LMDX/8WVkc19+Bd3I5r789+hGNvflV9GN9Xz5HLqRzX35PLqRzX35H9D9
+OXT/AN3I8r78j+j+Arp/iO4vovtH6P4Sun+M7i+jG/v35a+g+6foRnb55Z+h+2vo/id0/x26Yer/4wWs/wWkgQtY/wtIAxew/heQ
+Bi5g/S8gDVzA+l9AGriA9b+ANHAB638BaeAC1v8C0sAFrP8FpIELWP8LSAMXsP4XkAYuYP0vIA1cwPpfQBq4gPW/gDRwAet/AWng
+Atb/AtLABVhW//jap9D9DXT/Lbq/ie5Po/tldH8G3d9CN9LMhW+j+yV0fwfdn0P3d9H9eXR/D91fQPf30Y2D68Ir6P4Suv8e3V9G
+96vo/gq6z6H7q+g+j24cdxf+Ad1/h24cg68h3V7AMfga0u0FHIOvId1ewDH4GtLtBRyDryHdXsAx+BrS7QUcg68h3V7AMfga0u0F
+HIOvId1ewDH4GtLtBRyDryHdXsAx+BrS7QUcg68h3V7AMfga0u0FHIOvId2+xuqM9Pka1PPtn6MTqvmHX2N3vQbVfJs5WW2Q8l5j
+NfgXdLNSf4FuKOltbPhrUNDbSIOvwVh/G6F5DbiPtxGN13AGew2p8TXc6L6GVPcajNBbSBSvwQB9G2niItL3RaSJi0jTF5EOLiId
+X8S+v4i0exH7+yLS60Xs44tIoxexXy8iXV7EvryItHgR++8i0t9FrPpFpLmL2E8Xkc4uYt9cRNq6iP1xEenpIvbBRaShi4j7RaSb
+i4j1RaSVizgvXESsLyLWF5EmLuL4v4h0cBHH/EXs+4s4zi9if1/EsX0R+/gids5F7NeL/4pu7MuLv0Q3HjNcRLwvIrIXEdmLeJxw
+Ecf2RZxbL+J4vojz6UUcwxdxDr2I4/YizpsXsccv4lx5EbviEuJ/CfG/hPhfQvwvIf6XEP9LiP8lxP8S4n8J8b+E+F9C/C8h/pcQ
+/0uI/yXE/xLifwnxv4T4X0L8LyH+lxD/S4j/JcT/EuJ/CfG/hPhfQvwvIf6XEP9LiP8lxP8S4n8J8b+E+F9C/C8h9V5C6r2E4+TS
+v6EbMbwEGP7v70Pb//f3/xbdn0b3Z9D9WXS/hO7Pofvz6P4Cur+I7i+h+8vo/gq6v4rur6H779D9dXR/A93fRPfL6P4Wur+N7u+g
++7vo/h66v4/uV9D99+h+Fd3n0H0e3f+A7h+g+x/R/UN0/wjdP0b3T9D9U3T/DN3/hO5/Rve/oPvn6P4FuoH2/vcriMkriMkriMkr
+iMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMkriMmr
+2N5Xsb2vYntfxfa+iu19Fdv7Krb3VWzvq9jeV7G9r2J7X8X2vvpv6P4lui+iG2nj1V+h+9fo/g26f4vu19F9Gd1X0H0V3dfQfR3d
+v0M3jK//fQ7xPId4nkM8zyGe5xDPc4jnOcTzHOJ5DvE8h3ieQzzPIZ7nEM9ziOc5xPMc4nkO8TyHeJ5DPM8hnucQz3OI5znE8xzi
+eQ7xPId4nkM8zyGe55DGziGNnUMaO4c0dg5p7BzS2DnE/Bxifg4xP4eYn0PMzyHm5xDzc4j5OcT8HGJ+DjE/h5ifQ8zPIebnLqP7
+CrqvovsaunGr9GvE+RzieQ7xPI94nkc8zyOe5xHP84jnecTzPOJ5HvE8/2V0fwXdwK+8jVU+j7CdR9jOI2znEbbzCNV5hOo8QnUe
+oTqPUJ1HqM4jVOcRqvMI1XmE6jxCdR6hOo9QnUeoziNU51m5CNV5hOo8QnUeoToPUL39U3TCzPX2q+gE0N5mGeIK/At0wpLw7+fR
+ibJC0ND/ugbMyX/hXvG/rv0Q3Z9G94/Q/Rl0/xjdn0X3T9D9Erp/iu7Poftn6GZ5/hO6v4Duf0b3F9H9L+j+Erp/ju4vo/sX6P4K
+uv8V3V9F97+h+2vo/iW6/w7dF9D9dXS/hu5voPsiur+J7kvofhndv0L3t9D9a3R/G92/Qfd30P1bdH8X3a+j+3vovozu76P7CrqB
+ufo/X/86UNT/+frX/4n5/pb5/pn5Ps18/8J8n2G+nzPfZ5nvF8z3EvP9K/N9jvn+jfk+z3y/ZL4vMN8F5vsi873GfF9ivovM92Xm
+u8R8X2G+XzHfV5nv18z3Neb7DfP9HfP9lvm+znyvM983mO8y832T+a4w38vMd5X5vsV815jv28x3nfm+w3y/Y77vMt8bzPc99H3j
+U8z3feb7W+Z7hfk+zXx/z3yfYb5Xme+zzHeO+V5ivvPM9znm+wfm+zzz/YD5vsB8/8h8X2S+HzLfl5jvR8z3Zeb7MfN9hfl+wnxf
+Zb6fMt/XmO9nzAc0+3++8UMs7xs/+hTz/SPz/S3z/ZD5Ps18P2K+zzDfj5nvs8z3E+Z7ifl+ynyfY76fMd/nme+fmO8LzPfPzPdF
+5vsX5vsS8/2c+b7MfL9gvq8w378y31eZ79+Y72vM90vm4+27wHxfZ77XmO8bzHeR+b7JfJeY72Xm+xXzfYv5fs1832a+3zDfd5jv
+t8z3XeZ7nfm+x3yXme/7zHeF+V5hvqvM9/fMd435XmW+68x3jvl+x3znme8N5oNZ+v8D2nFdEOmFdhr/ASDfgEH479cskACE0Bf+
+A8XCUNTp8n984T9QzOoCilRx33/i9epP8MrzD1dgNDT/gx19w1jw/gOvHa/ASNj9D7xqvALjYJ+7YRTo//47dJ/36/OH619nJbBj
+um9yz3+wk8Bv+V52GPgd7v09VuB3n2Jns+y47Hd/y05nue/T7HyW+z7DTmi577PsjJb7XmKntNz3OXZOy32fZye13PeFSHlfjJT3
+pUh5X46U95VIeV+NlPe1SHl/Fynv6+xMl/u+wU51ue+b7FyX+15mJ7vc9y12tst932anu9z3HXa+y33fZSe83Pe9SHnfj5T3SqS8
+v4+U92qkvHOR8s5HyvuHSHk/YGev3PeP7PSV+37Izl+570fsBJb7fszOYLnvJ+wUlvt+ys5hue9n7CSW+/4pUt4/R8r7l0h5P4+U
+94tIef8aKe/fIuX9MlLeRXbGy32XULSSe1A27xfc8xv8won6t9zDv10TUr3xaXbyy32fQXlL7vksinpyD8qC/pZl+MbnuYd/+4qY
+6pv+WMfh9sbL4tD/wxso2nmZZ/Nd/o17f+jPCyzlj8Rp4g9voCjj37Ix/Qae/b7E0/2MR+ReBtE1Xp1LeErMPb9CoVTu+Q1+4Yl+
+yz3827Uw1X/9AuaEeh2dMCHUDXQCcHUTnZ/BCCz4s+hm4TANuB46Pxc6X0ZpE5Qt+a9ffIt5foKebzPPz9EDSD36HY/2XfblXyVR
+60iiAXpfxbtgFTKu9N9JMl1vlGml25VSUk/pHcFQG/sMuha5klTD1bdMelZvuMdzSmgIeatTr4M9bOmYlKEEdOb4ajVzSmBFkhig
+Vso2aqkcV5hYNsAIfTSHlJRxyrlK5klNejLjlPOVzJPSk8TIZOatQG1cEHfCt8YYBB2XQkueax0PAnq9oEkGQEI1ZsWMZgOlWZrg
+BpvnlkTi7dVysYyDSL6tVRqWYweayOwOmvBk2r+UMIbu65ozDS+m0M2viBDb9WN7ekOWthyJlCsk0JNXdWQhbqdP07rueQ7qSPJK
+saL0Ok2n12Qq6lRUul1ur7AsNR1a562kbjUaD8qvKIQy/YvpdJAI/ZFUGMLjh83QJWL5doMxYEKXhHaYrB1eSQ6UcQrdkU6P0pRS
+APGTx4xWI+U6VU2SUrrpadKTipr09cnM4OZmngzSKn0EgjYm+obJuJYnA+ofIBdWQ0phUKwWAXwKEaNOHH9SUQTAqoJKN8DTAzXX
+kipN0JaIa7s/GleDrUoT3CVEr0dtJDMTyfwPcWIEFqpOl0zDRQ17FtewZ2Uh5KzRaKLmqJIckK5AEm2JOIqPIqOJtiRQudjcplgx
+qSllYE7coWZfpZLLsvpKQpMcYVFhSbVgNGNEbp4lYXgLLZlgscJMWqL1HMOq2yX2h5sBnthwMxOKWq6QvkEbTKXptGAq53hOGHJV
+U0fe2NStRkdv0HEppB9II469OOQOlUQ/1ptYI7ZPDBFyis5IOzEFxmHTEurTdCTiDZrbGofPbbHs0IAws/pOIt2rJkafEOInE8N+
+hOy2OqZJkc4po+/9Ni1JHVNSJduU+kgR1kuW1uAJ1j3d8fxxY6TTefbH8XvWhe8SMbKezVWwDehIoGcBstAqeryFUTIPG7Y5DNsw
+vWkAkJGBYxoDsNoKl0Fhmg4nZWljb7I6Nj6ecvTdFBj8TNktw/NoLTU+vrE3SSVhik5aRPeC/MNGvpslJcG0y6fPdJpmbWvF8qhT
+EpLyIEUd82PsGZ4wxftBQo7bwoTM2vFO806q90qAVcBx4OoC+saFaOvhWC1XAgWcIW/CjeGHC0IQBPyZ55NtTff08eCTRDjtWuVc
+BSx8ZSRVyohheQgbF8LyCfHyGK8SqiBE87NLmiVPTuYVtMn2gr6ju1XHaHtqd2fLd9UNk6pdqJM6YYCJtufRjdqANybkktq2Gt2G
+Ue++0KaN7i7daisTBllLYsG54m6wwTG27Lt780a27dieDUudtsbVeTtU96i8FH5RiBAt64GJEzsSJJhD0rb6v+DcpO1FPoQsrB4J
+D1TIam48fFvrRIIQBs2MhIF9DK0aLQlZA60dCWzrjt5w9HZTq0frS/WaYTW0ZiQU1gyt1hfEG9aKfPCatKV7RnWTtWIn8hETbOI0
+rTVi7XM9bT/aPo+2tM1oqR3Xs1s+1LtJ31idtiOfqFtlJo6zzITxc60oanbH01airdAbmhMJwUlVWw9NaWvGY1jOTtFgvzeBlhUr
+igxKJyvKRINIT+Q3nyhIStazT9q71FnSXSorA3ZBo+9zDKtGLe8k8FhaTgzSpFRqlL0Q26RpXMO6RyzBYJdJqhrN7urmNoUpsB3R
+xIqa2NuJ9v4dNEIu2OX3d4JPHivttczUDnVcw7Y0KZ/NSSlqVW2kS+mZs6vjs1Lp+Ib1ZDzd2PLa0tmPnV5J1ewqGpNPrX9s/ezK
+qZS0ZLdatnVKd7azNa8mQeJ5Q6uiMRKZGeLQNSNLLQ+rRGzNYFZwwLQM9D5axDbcJdvydMOiDuloUpTQgZUwu11BBTQPCAY6BhBL
+c2RTIbpywPXtwnxNTKZ0V/JrLqnBjLzXMi1gEZue11YnJqrYlBY0xXYaE3stcwIQqoh6YhkPr3LmxA4YHGGeh0ZJJPwUJbmKQiKJ
+kdkRUnPmhk/wQpy+lLhvEMsFf1AweEqS53QoHM/opkuhIUBkdZ4+UA0/H9ooqfumBiRpvqlJWQC2XpLa1DHsmqRKuMeRSLim+XlI
+pFlRegJO4SQmqTZy9UJVwQs1hb9RePkkKXQSbmBEQMCvxDvF2pZQZbFv10CoYbBdhRIFL/CMsf25zfaV0cwjE6OkimG8fUE2trWJ
+hA45cc5ELAU+7xke/7pneBUFjDO04zwE2GwPguZrInX0sQ+1BPahlsA+1BLYh1rIPvhcjcA0djxZ4KQ90lEU4irxaU80izPmptNj
+HdaGlmb7PON8K52OZglcVktJKojx4kpPYNaFwuDkYFg1eWpf2XvS9Lhi1YbMkOHMzkLEwyl/yo4c+JUiycMVqEokn38OPkYXERo9
+yjLqMhTuqznnsZSDAdUTl6LAzCrV4oDN0+O5eTo+Hj1QFGL1YodksNIy3c14bsltAyQcXRrEjhxdGppXhrGs8ANLIziwDHrcQJqE
+o0s7NBcJJ5jBuSU7rewxjfGcha1qExvHys8frzy1cXxiCFNpDmIqWWdrdh9bEuUOq47m9vEpnUN5naE8S5RN4asSsBp8TbKxBZLK
+NhcHvaAnrZRhpahCs03dXdu1Tjt2mzrevmyhrYuyVdEcmZatSkjrHpusdMfR9yXVz4cZlV2AwMDAhAI9R/TAUMW8fUyftzMZBToQ
+87Ur8Wwd2qB7bVZRFxYH/tkFmm91TM+AybEkwdmaRDC0YdpbulmSGmGQ0bBsh8I6WJIMDBbMOvvbJuIqrMya7lFJ9cmE7qaWoZtp
+tkG9s8DnKEqglZ1Hoj0kHWPec/YPDM2S8zNTSq+KFgOoEoYMVGMu9BhucIy6HFhSgi7sRTs0QBl6yxLoj5a9iiYElL3KfFDHsHSL
+WCJJWmISsD61sgPj2HA9alEnZqVCtHywWdVNcwsMBGgxP7KzciywLD0hZWhFSw7udssVha03fNfci1WzGreYIYDmn23U4YzUgrVP
+b7fNfawC0Z0GsmBusF21snVL40b7bIsn6S+yXo9Aw+zwBMD0f1owTf+rm/B5GK7+QngIpjlNC1qTZEtDSM43FPPCKVIf6BFbr5gD
+hOQHF1KjJvVoKjk3Vl70ksk4Zvnj3chkgC2XHc2C2yY4ie92HegJNGpn4fFrlcoGyXNGSLSCFO0a2jK8flszw8Gb989S2NVdFj7K
+QUNJXiHDYLKUAxgo3LqlEjZTy6FxXN5I55gxn8k4ilV2KiINesrgxpgBzSSccoxCFYNHVLSgpu6eHFLW2Bg/lOJRZKrwZvWGz1cM
+WNmfLJVwriSWdkr3mtmWvifnSH56XPbGHUUhhuayKHiqTQKbKSlH84jR87Rwtto1rJq9m3XoJzvU9RYso4Vs9Kqjt2i3y7/u0q1t
+wzszNE7LfnFABIvbjUhsgeHXoKpbVWoOrcDSsCgt+8Wh36sm1R2OybzHi4t0k8FolsUnNOn+OLpSBNfFKcNyPcjQrqecwMIPNNcJ
+Tv83647d8tlFCrtG3Prqjs+t1joOVlueyuUUtuRpljw1m4elHRw5heh8sSMusCx5MG4ZrnrEkB2RXXIiU6RLPdH8l0j/hou8hMb5
+DE3TdDmss+IPgI7jaHbCh5ptga017sOtrgbdnLXsXdmf+cXKePbAoYj1lP2cPVujCel9pAYP6CBGUnIAP9rx0I9Uk/wwSdMgol1P
+0RJV3TKtKIrXdOxd7FLY/a84ju3IkmHt6KZRS1HdNaxGKkgfNUy1iQUm1cT17PagToFvbVrTxnIRmLkPZmhZgjiSIoZQqyYpiSXR
+SEnQ5DDbiAnRADwidiPuGcaFLj6u0ejaiPTt9xuvc6cN/J7shyqDmxJWPLKkQqbECbM1tJAYfTvpiC/RtWj9lAk8hJJ1JTjc53Qe
+sngdLTffCZfQTKajGOVORbPKnUpGdsqdyjg4lafcaIfyZhkcaT87EzlGxSibkIWJWZiQhXloFtHeYh+TyDuBQylxcqFt2b+t4rlz
+mjuiIAzbtTIjQtJYMBKSR4BfGcgr1eq4XmqLpvQUTx2u4xYwTA6wJAc9AleC7DK4AzOZk61ROFjqdnViarl585jh94iZyTDarGqA
+KmlrVWZWc60uS5rErOnL7WM5hUWra9XQ+FrblxgizTA4k2mTKs/e/z7/pPSkpjXLuUo6LTe1ZiB8BJZJCdsNa5pVrsN3+KPZcpO4
+cKDhT/WRM2dxw23xFjtZPJKl3S4ygeFcEyBsJCPMTobZgS5N4VqDkw5vvOlfzRzSP5beiqWG+tmaIXuYkZ1O+3nZh+W1o5v9Weka
+zUialLHDg0cHGJOFBp9eOoF/HK3GGe7T+tNyRyyMF8Ripdym3TFrjKCe7rS2qCMp83pGk+ZTp/S98YUG1aQM8j9107YduYMHXk62
+Zrd0g0tz8SYFgUMxZnGiTWPlLeMXTcr4GbGS2rrXjJWDQUNLgRhJZZzWvSaWABFY/nSvbTjUVQ6SKSaIkPXsZ84usfO/4VTE4icV
+v8I+YQ0SspU5uHCqvmaZ+2CoHdN9kAdIwHC4tNpxaPBtHb3si96i64YXHpj4M4wWtsaPUwqd0WN2VcwHjhPGcioviQdr63xOE8+R
+9T0pHu2kvheJw6fC4bkF5uKGIOzXLwJxME/okdsivAx39oNlX6b+uUZ4FEB7vYmnxv4q9VSqatvbBgXXkt3ed+AqQK4qqUIuXxgv
+5PLF1Bm7pVup9aa3b7Z0KyniVGrZ7jRM3U0tNR0DuIcmdVLPGqZrY/xTJ86mThpVarm09leppyb+ymMG5jSHeFmXOoZuGi9SzeAD
+nk3bz5w5sWS32rZFLY+4GrX6AjvaxHzqqQliahPPlzc6uVwOrAPlCmhPKzdD4XeWeer1SuaJif71ypKngRGGP1P4Z64Afyani+ib
+mWZ/ZliUAvvDAmdn2TcWODPJ/vB0LLMZHmWOJcixPyzmLIs5y7NmUeZYlDlWpWmWfJp9m2HfZvKKcIxoydOTSpYNpcTWTU+L7Zlj
+9Zpmhc6xmswV+3M87dgtw6VJWeZzLFlhMposX1SyyAdlgblKTom1yOdiKacLeeVo8QsJ8fm6CPXAdfFD62tPd7syc2gHbFow6vsq
++LOBt6cMFxk2wqj8ZMAQj6YSQS9M9UPDz5911zUaVnKqaWVeaARPkVg74UzP8Y+0IXBAdWaOkDGxhKxrtG5YNDhQZp8HFDI7sM0N
+6p32edC1enLquYGpt+m+m5hmMjcwjXtoifm5qYC2AkqbnmQDb5L7ErpxyMCYnsSpgOc8PcmmicmZ/kzW91tbtjlkcGF9IqnmJpVs
+XZbwjsyzHUkZ2ihefzZQJ9n8MZnQQc9SffuU3o6fEyVQhnLQGzzkYLrD8/LJSXaoMF3IHTKsgiCPWERnWbmkozmypxBTM+ROcAFR
+hd0WMZGdBDnYMc1iW61583gVZQZkV+uUq5lMRRnTXCWwxo63gkHEKj/IpN1uFTZUHSWd7oA539D4bgq+dbu+Ie8xmk6P53tDGl6Y
+Yw2fnJxiDS/kFFly27RqUFcaBIFooBxlSeCaRqNZuHn0nE7Vsx2SwJZ53f+fvT9vchzJEgPxr0K6ptlA0YNFkBGMCDCRFINHVU5n
+VeZUZPUxzJgcBOmMQCcDiAbAyIwOcqykXWlXe/5W2lOr3R3tob0vaQ9JK2nMeky/f9Zs+jNs/z7AfoWfvefugANw8IjM6u7RlFkm
+A3D48fz58+fu7/JVXHUc5LG1WtUzYkUqAybBDj9SmBSQWKvJ65djrmyr1dIc6enDiXtYoR1v6Gan2Ta38SGfvTNCOC8Y8daDYcKM
+Tjocf1bbQuEd0CPisX3MhVKAYCRWWImX8AApC0w5BtLwjaMjsHbxjfbhqUnnzsI4goPZwuiYdOY06Y3eD+TNYrUy4A/qvO7MNb0r
+3GLvOiCKvdL3d26whqvzFGGTJtBUvDbX3bt0hJyHKxbbBTJwrrikWdq2ikriiXWxptduZOsEvkmZNY0ylab2p2m1fg8M/5yY65dd
+YRzAKNgTUK4Z0IB1bfDsaQ/Trse8h2wt5VF/JI0oXakNiEEwX63+EVBVSjiAgkFK5HaWSGjAG184zFC+hOZDZDC6oDElbzy88/1N
+7MTwx3Nm9TrFUeT0TKWKOazVlkZIfQr6SUBUKqpWdb/0QYMAOHeBAj+9kZujFGaq5Awwc/zejXEr9QRmg9dkMNP2a7Wp4QuZlGfW
+avxTxZ+IJM3QPrLRazfStLhem3QBgzu39VPaMwLgs9Vmpu6wBzb2sHgCVzbtcAI4vnB8ytZ0OY/iIGT2za6Tu9Xmc7vV4qwR5jjO
+6aNDPqeP5JQ+6Ygp3TrlUxpn+62Y/nNIaLZgUvvGoWU25nQm5r/RNOkNpB6bpbsZekev6D3v+BsnnLALeum8oe+dqx6JWAwOsrMZ
+oe+cy1rtUqGMt6BhFzRzU6tphLiXtZpxv1q9a8yDcOROr2u1qmsoPAT1Epdmg/lx6LHIMIWZ29o0e8alExvqGmg+TI2YXlJGyZsp
+MWnceDNF1vQmoWm/VlsYPr2i8eT9BWz2TDozAHqKOggxl6mAhl6xGKgMOASFPRS9cxdLFlEBDo0D2AgTITEjlJi0yAYQOQ5YOiKy
+HId1GSye72o1o3q/WhFsmlQdWMQiQ0UgS6vzYRJ7c2PKCfeSMpOCCTYsY7eGL4meXPEmajXe5S5XVgjJ7IRdGHwGNG2oUA4Ot62H
++/fXJr1fra4zUJDI+zkjNMt882q6BmRar8Gn4dK5a2S5lBFTRq/oe5MuMzX7Jg0aX45GQwd8LeYG9urthF2A5bHhNT6re40f1b3G
+mL5FuO5gYp2jDTDkpVcmvdx1Lh1aYp1sWuaGfTuaFoCYA32OqGc00UGMGxhsWN+tZlPUf3IkJ2tr4+7FgaWe+o7XmHM1qxTIuji1
+GczsoDFHuasUKD9ddM0l14Ux6jrRZAE7tsRezU1HdL3DhjTThWQ343elNadolMtzKk1p9RH2QN4j1Mg+Nx6pWOpXWN3SHBRfebZW
+PhuFZTqXl6fxAm1tARpPWtpS/AMvelhelMaTdnl5/nWdbHT4mdWn++zKDlNqo4Fkvy4wROTahkvFTjc9jlD95FqrZ45860zZHYWG
+Sx+AP9qeYcEh06QoyaqTyrP0zLMbXUgIHpDj2TEFvZBdrbKNW3mxXLUPT3AJpIETNr5YxqiyenEZsfCOhWD58CN2+QMvzn+hroOa
+limLItQ9iIMiXTpEJBPH8Y3TUxMoXXvCAlD4mrXIJ4fUQ53HEgzgHFeKncHPCYxEDbPLumCzxBpznzKH4VLDLZsMKfwLzQcuYWQ9
+3zBteRig4XqdPmONfszA9QVm9tL0VVhcrPiVN31rLMx1YssZSG1KtUlvHWk8LSQTr9j7+MtgxgxCzC4saYGxMBsBR5xxSx+m127o
+TmMWDsGppNpcmzTT6G0DvE2cqVOdClNPb25EtRqqdYPFHZNKmiTBMLuZGuaN+Jr5ADIvn/ko9PMhhR7lj6eh9DZ7mPt2SJFCOa7W
+3RiOVIgQxzMpgwOF41HfME0aO94mYpP8vH0sJ1jmvIw7mp6QZWRkMB6Lctt93CAm6jDqOrizixxXMEAKysjo6bJr8mXBd9zJsl4H
+PuGnlntsh9N9m2++AudhnTjTUdeRdonJvogbOtRq8m8qBVKME790b1jUK/8kzCXQoTO7/iiSbS+VbKfnL1eo2cy1EgygMdcdA91a
+jUw4+JUfYXsXBAzm+Rpl9nBv7fFj7c7b3hbH1umJsK9oC/OK1mmpSMQIG+eU0QcQnBbPYtSnIQXZSCJ2nFgXqfgAVbFUzuCq4yzB
+/80DHahcFlkPph1ktA0f3BzingEWUKDHXqbSTFg9gNGjT1tmMyj8ZOEcFtbrJmxhbZGNf6K+SWUbBppk74OwbXgJ5ureLTVgzqus
+aewI61UT7Ji7ZgzbsbR/LEFbAmtcAmpBwoMztWD9BxRIq6m8BSyyhaYs1eHEdWJXpq7//bgSsbjiRpVk3asSU6FS5wGO81oZ5mpl
+kDdvsNybN8TzH9a9DMihmBic4oyx+IakDAqAwybID0XVyvY4rZOvei2ThgajE/BEcKoGU02QELfKhIudanOdZ5hMESAH+EbjHmsk
+7Ti+HfJktl4bD2twn7Sl4Gp6zaZv7WDfI6Z1yOfaoThhHh7zE+bu0jidkQ6E/OjBDxyEL7purRbXatV4El3UakFjbsQ0og/TwJ97
+V8sQjPztapOWnzM205l1Krfgx6d7iE55BegD5giFKmjeTZSk+nCsjhIbR156+aS5Wi2fOose6xEiMG8bgRM1YB0eBDPWRwOK4MnR
+Ueu0s1oFT486bet0tVrWLe5LZbi53HXLNJ8cddqt5mrlPj06bh+2e6zH82BtdmDDO+fMS7qst0zbPcASdSM4wJaePLGaZr1zdNTu
+mOvtqJIWijQQj56/TUbJ+LGFPWn2PIPVY9o07aBMcSKbax+LgTk9NLt5AfoVi+X2VENUnnBZ1wlzC1yC1Qkod/0gruC2+nIB3CEV
+W8VyWdpIRscnHNr89hymx7GmA170TDSmgZ+zi1QCVUnWGIg8s1qRv/yXkyY8HwTUQd4HAVjjzkvBUU5kdNzh87kNupQIHw6FzKjd
+5jKjTlOIgXF44Nx9DmfuT6qQ+cQ01E6l2knYN5iUYALZsO569JbOnQCI5lrHImBec/EDVkVnxSXpxpk9tXrq0m1LWWW6ZN/QK6dJ
+752pMUeCuavVjBsnNG7o7Gmrpy7QsnBLkeGD0CPRC0TGvYmn8dhZGvNEieKjMAksALrx06vuVb1ucmHSXe/GmE+uLuiVacNfs5uo
+TW6de05z86R8t2p4zq0QZJkNOFhl6nKNW3pDJ14Dj170CgNn2OI1ISNpGedcUR/Y4m7U0WmKXXPHshKCRvo4PsoSdrt9bBp8ROQY
+Z/mBsPtzQOIqpabSJffNWyde0/yxTJoKUhGC483bxO7cS/yTqmCV/DTxlelJA0V5uvIMyzRtAtI4oKEeF9DYhEvmkiT01bHhaeLj
+C0jqZSaQOvUlQTgBV7nT0OC1wuqd5gwNIqR9ZIPCHDZguNWqh40xJXzSE/rAVdU2oPPUXO9SgVKYH/1sLk1Y79r6J1W+eKf1ZDXQ
+tpAAb6rwuCOPKy2zC38OTYNkVdBEI63Qqf7lzn+H1vAEJ1vDofh4bejwm90d2jApjnEHt9t0ko7jyEqbnKe2BEs9aguOenwipPAt
+LoVvnXIhPBxtZlJMfyMF93eI82OTXqlSlHucry3TMOkblC21TXqJGY5M+h4fOiZ95ywbyUpI3zrLRJQycpaJKOVcFaVMjbcmHWQU
+1LTveM6bxpwOnWpV+QAbYz6JR4mAwIL9rZHRuToPa3OS3zReZJZFZgzoIJESGOerlWZREOB+xWCovMBHByITAo6gEGJgKlvqON1N
+r9eGmQuZoSqKq0YV/MtWq+JmApXHULcJ0tQ1/aJ4TqmyxhuUuL3xnWqzK2OPvJl27w3N0SqEb3fUcywHniJ035Rc+2nQNauqWhBr
+A3pyvF7cCN7acWPuegsaObHEN13iM2CELpxYyK9QQOX2DG+1MlrY0nWt9gy3aI031w6oEEGo6/Z8J7SNRa22kLIp6juuEZqUp6EE
+DNIcBw83gP7e0nhnEDEWB9NrsMCc3k8XjICYP3BewDG1F0ghakSXpo1HV3tphMohZwl7hbXhTwKQVncBZ3CC5pi04Khd5XC/goyw
+1XmVGcMr3sIyv6LwY73HER04L8VWMUA1/aU6KOe9t8KYfelfu/5swWYJbRHqUWbCqX7ZCPzkeyi/mz3feBAYsRkNmRsFPuhKbCN0
+lg3h68zliDD3kgeDfC0rq4jylVBp1VyLQTpfrQD2XstGw7Q3rlzs0IVfmnfHjTtgTC/z/hGcvq5TraekwJgifbqrFWCchir9hWj0
+ETv+JERBFlLbalV9aSSjr+hRE3MQ+my3gemmCE96/DlHBUFkx4js5JtAE8w9Da5hfNe4An6l2WGjY0LceDMD+43GG+6W4cSNN+9W
+qxiUgHcOgz+R04I/Ls/mQo6plHOZ9AsDA36Za/pTR7ONdaSLYNVvvJmBNIc35Ds+NsTnIahxQKsvRiydPEJ0cckqYjLPKl4cscWc
+mF0jdl7A+tXLsBHOQh7evLN9+mZmV601tiBPMHRh/JSGYCGwML7CB2W+fSWlsTDtgLI5DnzAgUW/MHxa1eVXG8Oi6+5wtTJGGXzM
+uP5xRGXXCCVvrolJoSYa8pogi4nwMgMAxSICVvFcbF7aY6xpqHNwRJkbPibTg78m0WLezFJXp7tsnuskD3CcjEeH0J4bozSJPsBK
+oLUJ6Rt3AgGmshUP3jqa8ylbrQDpMLO0B59aLaa+YOMOTBj+aGcgn3KxoQyX9sYVZiJv3NyHqFb7goNWBQs8OYvXFPFsl/lj4Xoq
+WkRyUUV0cuOO7nLcV05UK73lBDk7MMoMhlikwrx2YLghcU3fNOZOX2tF5DjOaLVifIlCxQbKi/FweWvcNj6r3zZ+VL+Ffe2QPgia
+s0drbqTXMg2FEiGtY52YhpLiZo0Osc5zWV+Sjz5wkDVWPH1BzWK/0qRyETbx4JPgeW0qdRvRalUdmtkGEFO6gXgPmhhoBbEwspOZ
+oFZZNYa1muZEPmpwkUaDz6cBCJbVdt3FwtYzTQrkHJs0dPxkj+E5vugeDbJLKKf/yYUIZmB1b4oybh6tIKjX6dKpWl0h8paSSbde
+p8luBiBG2lPLL1crY4lsdRJdOIweHLirVYjCcL5cJgmpMVLQYCCqDxp3GaoP3Snbqd/YVy/bV03XioCnSAvNtQKQBwCFhpcFaOcj
+uhTIHgkzgkNpxtcSZnwnx/ysgQZ/Pxj9hB84DsWB4+SUHzispjhxWO1DfuRoNcWR47QtjhynLX7k6DRPxJEDbDDvhcDujdBbXWJW
+ix81UJ/9Tmq43+LJ5NCkI4fLx+m5ECEPpElE3xnhaeK8MacvnLeNOf3CCYX1Ln0lbMzpS+dVrfYqNROnz5xrg7y59mYz5hOTfgWv
+cfAyhOBH3h1M7J+CDu1WHGqfRSN/ecOFcHTsTA0SYQsHIbvyojgEF5gv02Q42J/Ba3B7kKY8dwqy/Z/pGPcX9OdO2Pg9npl+7VR/
+vlpVf56Wyr415p4/G1x7ixn9kROA/VHxUHtcdd4ZQ5DkE7fU0GbIuTvm4Fr547XZcMHmpuGuzZ7+6Ns3ntPY7IaJBd3zSXxBhyIT
+KKtZ1XGe12pDyAikbA/pDzXbrC9BD/TO+CLtWULxMQh8GI3X9HPnZ7WaQLKCsIYUcfaK3K+Qm611PDKjRfliTT8rs7iHpeR5rfaZ
+ccZ7eI9s2rnk+zsKIV2oZ3xJY7Nn+A2WkE0PFFH0GRwyJ88uJjE4FIonB1dU553h04e0gA2O/VULFXmi7GoFiH1G3xsWfQA+nFTQ
+NOmPBKCmLbG/pr+bO2beZzXRoXNnxM4b1EaAcC1wwiSGzFOva36Gyuhw4mmU0fQHegG+YrX8Dtba3zXeIYbMNf2xZth/qm7OnEuD
+x4BODtRi1XoOLPhLihEpPeOMMjhGgwf+alX1RGETn7/EB5EG2IYHwBK7MFcrgOInxaM3c95kRxFpVtQXY31nNDbTTRqjcQKjn8mX
+GeLVKkMCOExg5PU7GUSo56kXBkACawdfCTMnemzFEcfc1Sp2nGf4uwSbFxHgRQ5QuKa/X9oK0jAN4YDdO7N5iwG06DrNriSBp27X
+5B1zwonLm/QR/8+xrwFv8stJnJJFsO7+bLUyvsj5m+cDJXxR0KwQzrGldsWtKEKfqnAzZc7cyMvunzYV0XvzItFSKgEPfL7F51Qk
+zjdnFGdpQiT8cfLsgnJL/4RmcGr+iGdkOO9gs5B0t1b7ulb7kfGcsqKaEfTE8dqkPzTQCFzlbJRI4wxCyywe365NOmrMnZ/Q88bc
++YxK4w5Y45zfodz+rzF3fky5TWBj7vw+HOirXFwIWobnlOgWMEJ/jEQ+01tc/NC4RnGnSV3DbXxWdxs/qruwQfwZfeCjZH+xTrnI
+7znk2o2eicGlGPhx6sbntyFzZ9AclcyZ3sAOkoqIajRibji9pkKMR9HGlSprMJU4euVe0aUfTYNbqC7KmMMy5jS7vyeJgbGueW38
+3oQxFAQlJM+cgXHdQONok/pQJJYKgKc+65o3RswmPi8EnT6XHRZkCfqnjC28IhseU1Z3CDF7Y1BDw4/zBY74W3Y/zhWC8/3nqcO2
+VrHoVviCVVVc6NFodAwSlTFwe0eJv7Cmy4idszhmobqmfw16f/zk3dwuWO6TtRajm3Y0rxX4Ac2J9T+jBSun36VZq6Ah48GIg9D+
+CdUYDNm/k0vl2I3s31+b9FWtlkJkVH+2Wi2MwkHxC0OyGjIBW44LUnVeGpy3k4c1vj24Nlsr74mGlJ9c0KKaKr6GuQFKFH6sVuOD
+pbJOYMzsAqSu3QIr8sB+i9vJJizJQ5qSg+XAXR9Uf1Y3fAfM/f1a7cqIua9QYZ3C20EcX10yYVmi1c9hCU9IwqQhupPQl8K09BXs
+u2DMFR40+epiteK2okoq/UrNw3WCL+ZwTvwimQz45sbXlMAvQV5yK5w4JX6rzR11HIqbQCBPH650ReCKwlN+Huk0j7jyQ7ogHB3y
+o4jwKnIT76RrudH2otH7mPmRB2xo5iwbwkOC3jgPGW8ijeVGksJysc42rzxr8EYq+BF5cwPNAsSmZ57zGIl7M+kxQoS/HzHRcgF2
+UHEvTrxSRDNrnVeRgHcJ5ojF6qiwobh3Mn66zY5pJJnQEWNJq010O5nqTxLoM3HPfVCktdI8ZOznbLXir6ZxY9JjDv+NuTbRIHaZ
+t9m/U4GL1PhFYHfvSrt96vGwtCxmhMJSQyg6IVD0drjQOETcKzX5DtiXdQP0EEiyxtQTYwJKnyrsm6QcEKy4xBMXS8loGSIRXByg
+uGRDwuWCWxgEaRi8dIrGKFpYm+ae1onSYqolrBPb0mQK1HGobHxZDxtfqbKYuee7i8W9Ri7BozpRT8qpYPsmHuHooWFJrFsQ4/k9
+dVMlPkeAWjA0yEpbku/+2lzbjO5Xlq+PvKi5Xu+NuhPh5AoqTKmXTdEUhzoUeY25MNT00ZxESuT8ButJmZydiGlMwwcBTLxRAMNl
+IAZxo3t/+myLXzBk5BbXuEnT5upYR8p0LcnS2Zwl9SEwDaLuqwB33P4mmT9deZrzJuEFiAc7h6bh0ZA+lHgNmA+QEzfOzZ1dRITr
+Uc6UERbBZDEGrRcPoZrfOvlguen50yAM2RQ2SnfBFM3+q2Qn02nrUFr1WWINanfafyE8o622MIzoHApD6I4UBnakMNAShgcdiE6x
+gFVzCj9qGMHcWN5SGXOczugNrCjOvKdZVdc2qG3pvRMaUCjm2sk3TrPEGu9qV2s80NAaV2Lbdu1EaZzc7vXTN903ApN3Tty7N1xj
+5rDJmwsTHHRm6Cp0b2CCye0o7xzHmUoM36WjcuNcSWO/btWYOTdZgyvRhGfc0Hs6E6ZWcUml3bhx9tWo/wNnQePGV6NXX3/1pTMt
+jluKcjjjNTsmP+w1j039Qq1zTrg3gNUcmwaZeXfE3CQPPF6j+M/8ztHr1+PolXr6ZUzlxPGF/JyYWnG00ZRuKJrzKTmXkZZCMACQ
+sdcglHlyLNoIyqlcUPOmqvmFYiO/VM9URsjt4Rx0WQ0mHj/Pbgpt0NrgOuxlvU16sREaPs5mH+khBmVOElmJb+VYg2fvco6SQBeA
+XiVxLTFpvN7VKrYjzWLbRycyykQz8cfrHHJ/vDwWfwP+eIUNgAKOBzo1aYgVOJPji0l4YZjdQKArI9RD+YJirRvQwvattVaU8Osy
+u29Y+MFCx8u71eeBoa4TcIC4h1sxeuYDOhH6fOsBeYtZKu6aMiNQAUv2qd+i52Jb7DHanda36bkIrOM718W/8K6LHXEM6hyJI2T7
+SAQQbjUt0yDPRm9efvXi1Qs4d2etM5cagnHkjsGbh+4NhvRzAqkmAqqJG1F8v2CNmRfdLiCWsB/4jIDA+sgyYc1jQkGJ8ecbeAOp
+cocZocyJG9PAj5kfcxe/hhxjyhrBLfMNYInvQi9mBnnCiz1NyGAslsgnrz8VnzAAyXQRRMyA3StrjLshuJoJDeVSEYIBl0g8zpZG
+xtMrcx3FaqUx15EFhVsZ6xlRhj8zaZQfUfUDZKf+xL1wmGn7ztLIxPrxbW/rFqEtXEg6LTnAOfN62B7+1ninWk0JroS3QIduQTde
+HIirnLed1twHeoPucYHZg8Aytk60oZoU12qZU2fmWy/zlsJmZ4pw8HouXiK1CxrwyMnPWy3TqFr6uVnqKcXHKeJdXTpNuoBrDGEE
+8ZaRyPSrjgtbmYgCD19IQy7Mkl61CgMKWZwYhxOkdH8UGAsKlyMnZZLx3dSx45NNES8kaXkAXGzC2HgUnJOov5F6tBstuZDiDAgE
+oVNX7mZ2cyREX8FA+gq6F7Uayn6o++G+gvpoDW2xjT463gxY6h4IcigUzBHYKkjDY/ALDBzH+rQpDsNfuf6V1KAOgqUfp4agPrty
+uSkLH/fgabNrBE+fPnUsGOq47sSmadXAmNmH527JPijj5XCc9EMgX8oLOiitJxNSd+vkgsDG5Fff/Ou/+GuELhxxHwz5A1KP6lGd
+fEJALCJSecrvgFJBe570QNwQOUHxiFutuiCMNVerJf6tOss1Sl9A+hT1YmNu2pAD71T34IqdhUml2HT8SUTl4Qjks3Tu3GJI6DKv
+xGRo4GBgAekYTLkeakEJMU3aKnyY8g9MXVg2BPMjr+PX/uu71/PXYeUXf/z//O1v/p8//rd+9c03v/rmr/zqm7/6q2/+pV998y//
+6pu/9qtv/vqvvvlXfvXNv/qrb/7Gr7751371zf/8q7/yn/3/vvnm9bLVbJ3g7+nr5ZzN56R8MMuJUXRanBDDLf6Mx8dmd9PmPkxD
+ZlUY39tTT9xDVWTOhu+wJHQBSDhCw5MaL2YmFXlZKVGmtFRabS5c/bDmCwYMA5x408C/Y2FcEREL4qByK/XZFYRr84nstFN23uaH
+8W4+CKzi3PoFi6+DmVOizkw0xgzdQtlEdQy9gIM4jNPFzgdeKTXtHFkpeMIaNhcoow3e7t+5+m1x9dOvHJwgHtZdbyKoQrGKANcn
+8nNCvTohVScJk/HzCwKi+uMTUxNMYIPhSVIBqYd8BQJWvt5dl5v4q7WPhA9wu8lVtihqnsoO3YqlZM65B1qPWu0jbj7aObS4+Wjn
+sC081jpNYT4qT8/osXaUeqydngqPtaMONyNtHx3/dnmsZXcn33msfeex9p3H2ncea995rH3nsfZb6bHWOTr+zmPtz6HHGmidCh5r
+R0WPNeX+iD/3Hmudo+Z3Hmv/gniswTkAVA1oKU6oRfVOOBPVlkxzcGLCkMtLjBH8Hn+yfeXq3kTx6/U8uWULTVu5w9g3J/GFIWQ/
+ISDdv9gEt5D6ENraCnlIPQ3sQQK7m8AeSthDHexuz5WLW0A90xbCCgFzYMJ+cCvc3Bb/zyHC0Z6hgO5QAyTXzqP+AfSpkwuciiC0
+5BtAghKlKXEc4l5eTqWXwafGpfnJp+bEulitDqsOiVkUp996tvkpXBU3iS5Wq1YViqofXV42EmUb6bdGz4T/4qP6xTR46lNrtSJJ
+cqOHifI6tURd9Klh9nqfNth7NgUFKEad1KkLpPySc+5ELuSgSUYT7wkWI82vLvYUoV1ynIHqxFl/Qadg6UWvgf/NHENzF7hZN4pX
+iWPi0vemwYz1yDJJimJv+va+R+4xhd44aewtVIodtk4PTzvHrdMjO3769GkT5AGaq8ZndSKvHgTOGaqZQP58JTLWye8Yverr15FJ
+6Mw0u8bCueJIxONu1TCmzoJfN1hfTJoXgPqnN3BFoNhjiG3yDRW5wAh/WastcODgb/MiEQGHNHcYnjtWd/4ktR6PLg5a3Xm9biYd
+Tr/hxYMLuHhQGpibNGmFt/3Ex3iHrjAvuqYLeYuhCbIWAT+9cab0Gko6d6bZNa8aCzeKn0EFYJTGq6rVlOT0Tv8bB+5AjC561VvI
+AXMAqG21EugAe6I8ZkyTt3bXk5cqNumdaV9zHTlpStIWO62miZ0wwi1ehZJce5MLO8g5QiQrjsKugMuWMdbNPCphrD5nrGGesfrI
+WMOLrebJvtGCLZjrT6+DkNCNBqV5twMw0CM+6N1pbK53berSu9rcTqEZLEHg3x6tLDz/7b7t8DL7thQsZvs2tHsjefVWu2OhWpYb
+2ad6ogfgWi8Dz4/7sd4ZLN2n7tlkYnzc4nHcCWkwfxb9yIuvORSoswJRJ6BDfiKmApxM1OzxAjlH1JJ+IRBiSRDE0PFSE04apWzZ
+74W2jOtpeHDwCkHsK2ZI6r+dzKSYLmlk2rFgCNGBtJOmEVjGLnemh7n3nu1LEHG8N9nNAz+eBov9Jy0UJJSIsvF+LfLrMB7VIC8a
+b4lVl5A5eknw4UIrvoGIF0vdTKqkeiUIoFGturWaVU2sRFRKzBSyy5yQJxc0LJAg90AG12P0G3bqKUGCCzL14Noiyzq0LMtUAjkr
+evBYdaXkd3TDtK3cAjBgIiY8pZ9gFNteYMQQYBaj29YNIz5wMN18+hRmZPw9q9k6rGMQXNNMvXt+Gni+8SjeAvNbM6M9f7pYzlBN
+lOJRJmovmPojL5nUaeHkhmRGd5vcu1OmF7sLbxrtOem8vefc9jVFv0Zeh2y+M+knFjjAd6WDUIL30H2np1rHM1gjdN9xF6GUJ2rp
+GKPiN7v+06hrCtGU3IRPIhD7mzR6EtZq2W/qrjAVqLmbKU7pXmHNCtktc2OIPnl0au46CNGNu1jsOdSizGMXXc0KiJe5l62B6cfM
+nEmTN66DmdK+4xnJOrbjopgMPoz+phUvpH664vnUr8v4CrDihTtPC3h9y/YdElFozykYLS/3bWh5+YhWbvdu5XafVjodCBMah97N
+fu20H0G9HelwKAxtUWcdCU30d86aGyf+6anA3VHnN+msmaqpgXTkhSoyTNbxiRhYMa4dGeW8KS+7PAELh0XGbOcW3jMGG3TuLIWR
+yLXzMDg//2q5YM+9KAZjw8H5+TlYUg/ZdOGG6AFgVy1I/iHYkPBsFh0sPObHX7FpLFOGL77IvfImlYRXwVvmy4bAEv5V6PrRnIXP
+YnYj8429RdLK56++eN5fLAbBYsE1kjKxmDIOwpvRggGLlEnnDPIoiV+wmefK9r/wbhhYZvBrTasWhfAQM7Di/8K9xfdglmDlpetB
+b3+2ZFHSw5eL5ZXnp09JRec//Ow5cleZ8/yHn325vLlkoZIA1+2fsys1JfD8WHnPou/8h59xbAVhgqpzlCOdLedzpWoYvPNrxmIJ
+OzgnvArd6dtBOnxJWpIQLKcS3jWdOZ5xjRKw7s2TmbSzv6nXOemDP+VscnNB751rCAn/xnHhz6XzplZ7o1hPe3MDLj+8nEwvVqvI
+uKRTOjfp5eRWvN6Ck/1ycnXhzKmIRn9X8fxKaF5O7sAezLikdzSc3GF4+G1mYfJjJVQ1WemN914yyTIpUjh5n7+SIDZ795P4Ai2q
+u++KXyG6DXnxw9FXXz0bjt6c9c9HBM6jlHzF3Gk8WLhR9MyPWTgHnUflJ8Gy4oas4sYxu7mNPf+qEgeV4I6FoTdjlT/8XvSHFTiz
+VO6DZViZQukK2urMPe5K3KiM/GgZskp87cY81w1a21VAMhNVZgGeN6DGhXtbeefF1xWERGSLGrAzpQzBHo7Gz74cvfmi/+VPEOjV
+Sk1688Xoq89Gw/27w638eWcCX3RF3sNfuQk49H4l8KesUXl17UUVMHZeeAClew+a+NmSQU1u5cZ77/kIczqEC35tDN4k+BDpPHQz
+8CKY3y9ACUTjKnBxZAdhshpX3CgBoAJRhZg7o5WfLqNYlA3Z1XLhhsLCsoGxI6oxCB/2b33vtqS6Q9GMB07YePMmhKb7yzg48/zZ
+S9cLo66fJ1vw2X7TwOYiQKZ4TJ0vXJiAPpihFMq6Zq3mwsVR8v5dcB+hUycsZuzinUdT6pr0TfGr+WbiXoC1BL9EAmu7LU5AF4zx
+NBuNBVyWAVeC1mrVaa1WtaqO4zfcZRxcev4Mmr4xRWAw8HqnIXi5LBJPrqlgYs79xL3oRsZtrWaUUP9ddl5gUm6Mv/bZ+1s2jdkM
+p2vlNlh40/vK96LKPAgrbxk+vrtmPh/jK0BvOvhQBCbmHWCqDIgedmBuwB+6MO0CSCCyhizXMovJfcB4x9dc2t1Np9E0sZ6Lkws5
+fe4ZIY5x4FYDiIzzY+Kb0iQeC7zpRkbVo9/PUX05awB6Rq84NL7COinyC8r5WnQdLBcz/zU3ywlgM8kqcFKK4dwP+GxUhrwqj88d
+P3GDSWrEFObOupDnnbdYVKIYfi9ZxZ2ClSXE4pGVq8413wd3EC6px+4x6F5Ayc7d+6iczzfR1BcOaOnY3fKxiyCQA1iP52/VY5q0
+mJIbFl6xZ34cwHHzy2C4hPu33Zj9gN1HhmlXBq4Pqwdmq/iBf8DriBpKBDBBIhqaqNWMSNHmTfyL7S2+Cj02gw7zNuN3gWBxEV+7
+YGwi94bBmNuI2J3w1gUgb90w9qbANimeRmTWqbuM2KxyeY/Ngd2678WeuziP3ZgZJqwBVywesrm7XICf121kmHL5rPAdBYx3Bk5Y
+Pa4hFaytlVHLeaYlAzjPXg+fN+WQDvrZeE9wwI/1X2Ca8iv8kyAcYZoWyjS/Kz1ZJEy3hgfAwp/QpJ5CY9dlIJYBVwKaUuVM3WrF
+DWDUiqjCTzPeZIVdTLuuUR+EWk+k/KPr152WyrqoB3/q1kWXgQc2NO6p0NypFxSrd8ijKz8abmlaFa3VajfyMkVhenUbScMr9CB9
+Hzshfw3ZPHJc/ry8nbkxCx1vtRI3sANX446YaninHFX2dImGyXelkVGY6xB9kbvEexH+BS0h+Z6uisoN7DKktMiX/iJBiL6khMbS
+mxZORquV4ILA1CIvZgPJ3YipdidYm91YteNj7ypvqZKSMUyPM190SAcR5pW8Ot1YcMoB+DDoHAi3Lvkfxv+8B2rMTWJ0Yp4pCU4h
+CxibRoYKTMj8GQsp4a63yPyNRqMBvLK4OeeoxDCHN7ipq/whL/+Hgn2ofBSdEO9NpTF0hzGyCUgYyl3XUPIKsHHvPPBNW3YjQMUi
+mUsF8oRjbj5dUKr2E/hJD8q/51Bn6/YtNEdupZkGSmP6TByRybcXXw5GAL6gvh95i8UXwdKPC/0TGYbebON3qOArNmXeHSt2h1C+
+IUmI/WucxZuAyeUoQrM5A1bh32gg5gwkgcTOnT3X9I3zoExYO+/ep3wDdyZBQwWHCmVLmGeycH8b4y7Xaw2V5Bss5HACCP+t+YCR
+macb69JVk68hT5j5SnLfe4UUZ24U0mhs2oVEQGA6s/LtJF8kpEkCgiknarYY35SvqTzHqC6/6zW9dB6KJJ0RgOKq5UX4hc14AJL3
+zoOWsjYWtNZr+s55EKZDfPpqnOXEgtZg/s+WbMm+UrIr5jA0qdjWeM/mG1+v6duM51NiT2q8Vc676tn3nUnv1mLPblgt6f93yEWk
+eI8A4bROFI9AL+/+WlXlu3q5UixcOwiP26qYlKO/baKAMemnkz9wD37ePDh9ffCXfud7te9/Um+8/oM3f7j6o4tPPW65lMTfhfVR
+8eB85gt1sQwYAmvFNXNnLKzMPbbg0h4lqlwjDp4H71gIRm+GIirJRJ/fBi9bZ4VnfGvE4/rknUgc1oiuvXmcRMHlEW9SOzkqgtKA
+p4KA8qohg6PBUjzhMVyTgPm6EDnx2qRxRnYnqO7GvQVv7EzwA6/Hkh2ChlB59A/cIqxxq2La2R0SM7UVZIsziOfEIJ6TrIRturPc
+YGaxyiJEkzipL+1tIAiNNS6D2f3XEZvJXbxQSQjlgpEjnv4iZO7svgK/xDS7aXngBkn1riIfhRpEpUb2wmDWCPxF4M7UwYkNaDpa
+LmBiQwb0qFJz+AbjblaohEmajFKqghZBxv8VEjW6u8XKLQsAe5+PCxdsYwCTtKblnjWBpDtXxSJ7xIBqvvb8+EQSg4iXwl9VBXuz
+G6bLIVxw7sPpQmO7YsST8ELxREkU56oIRgwxKmXNZDoLM0WzqweucXkfM65dULoasdjI5zRhI3yJKFSlB1k/k5Q+pIuO53vxWTC7
+z/uz8a+QH7Z1DqPMVFhhKn4w04yAeoclcrerxuUiuKzVzhbBpbLP9iIloArni7ICyJmpAJQfoDeq1cbiabeKZO5MZdze/KUbujdR
+rfb1V8/PlYTd6uU9THz1DVOp3k1JuFaTfX+TLa+QuTOFwcVHk6Y5ENkwtIALY6ItKu7iRW/YTLurVVXJtqFLtVr1MrskCY6y9KPl
+LayYbFY5E+BUoBgxu6XdENLHHJoI4SX4YobnUYOIiEsHvMrVSkNQvUypqFCKEtgEfnq7ALdbWDYjFjtfvxofnBDTztJSrZZ9b0AF
+W+vXlDHtx5NPrbatQQLCFHGw/PT9wbt37w6A8A+W4YL5YEc2y/cT9r6CwvhEheeiw1iQmt6nHKebmdzQxeJiw11scrmyBRUaKCu/
+lYxzVcpZWyTLKZzJUN0FTL4iM1agFAiFofvpHmkXOIBEL0yxFKtzyCm7GyMHfU8gd7UqR5uKIzsZJ0NYM0RgVo+pcS6y4KNGb/nB
+47Uoyf7Rhgn6WT5M2cFBEk/5P/8q38sGKcZwuBy9swS9P40Cf5cScItA4xYmmqCLjBA33Q7Hwde3t3IHLnvzPjGDjM2nB1YvtllB
+wMoP2UbsxKvVw9rE1RiHV93cXue3gtpzQ3brxzntMlw4DH6FbDJkM+aDRCZymPpGY8mNZNx58YoLj2cw+W4KBHKRlsPEg0gMZgyS
+ghmj/mrFxc8sXcXgHiL1nWZ2p+qaAXCn1myS1lTwY/Vttcp/X61IcOPFhFaTnmW5rr6fcUk/QQ3GH0VT8oV8NnolpZ/Y/Rj/yFz4
+yEWVQhjMwpCFPPSegYXhvJSp8fNRf5hLNWs1Xz/osCJzI+PFInjHZqhy/Gz0qhKEFaioEnKLlShZreXuDnw1Vdl8ZkstJpVyxAy9
+G8OUcXtrxNQelrxkUsA4i8wO4R6d4siY+CN9+rr+6RUlFQz5KjfIDtFl6MbyvDRjsPp9/dWzRAhmhCbVpHp4UzqNc2qFtI9e2jnh
+3PY67L32P9X3LNcnO9cnjh4g1CSUpuyRTZLPSS/QFzIL3Z04rQJdQrAWsQyA/JwIuTVJhezLyCH8L/H8StyLRardagr/9OCto+R+
+6rSazVpNSXnSljn5O9+gpc/Zel+haPbFDwQIZZOGJpM3hl/wHMydKWBryEO2zFk8vebIunIe1K2UTXJbKYBF3N8E8fDkBTCQWqul
+hm6eX+FfKKypNkkPhjIrLILiORdCRzkLQyZabaZhEGS0DQxiI9cbm8hJwqFT9gs2UZZK/IpBZjP7chP6fe9MkmBHz+Sx7YLQJPFr
+rzR1sHBvbtms8PGZH1sdbRFN8jM/bre0mTXJ40XglqZ3DkU6mILpwgnUaoCrH3rs3YaNMcg41eOKF0EBfYjNWu0+WV/z8aWS41gS
+Me3pgbXuekoOPhMdVfNnPjAHBQWh4xviwhXPkSInCOGnPIPvcJ1QUg/tcE3Vmnl41wwSeFIlKR5jjLNMqSsWa/HGIeKzzgUZSk8B
+gof6zNRz7WqD6MlCBRs2QE62hojFObRk4UbkZIsIjpnzmcwaDYg6zDJIfIwiJazVkxb9C+oL2VimRdC0F/fHk4uMAXJR+CZil/P4
+HGuM0JqrmIdJe0TVScWxvmIRc+3DgJ74NL5I688IVZXGiuJVDSScwN87EzIcPR+9GhGK+xHKNyCUvHj56tmLL88JJS9fnEP6y69f
+kYvutVLTdBH4TLOPBjZ6zTUAD7DDs7PSDDB7vuVDrdRmyrQ7Ne1ux+bucuIp+iBWRGWNo8pillv6qFjCbLGkKaucSZfhwpYrG8B+
+1ygIPdNwLHdcNS2bb6qNErJWxfa4uGNVBCJzQh3vnEm7adF2s0XbzTZtN49pu3ly0b1rhGzmhWyquY7twHIc551y2FC2impkVqlY
+4AChO1p69NKAniLlYSEus7G5zTprfM6/OB5lDWEU7Vzjc3Qb+BFz7qhY4vWO1FqhM97AI3ZPSEFQggb48uMvnn8ex7eirW6gEU3z
+MZDQB5pBD3QjfmMEwH37i4UEXnQOYrqCxLaLJyiHhOLz1189h1U96AUNJclmWbnWjw8ErAeQXwpzk0ryNdjpI0DX9Q1B1RQZdKCT
+tId56f+XLH4XhG/lpr8CEY7YjMgKYu+GBcv4A6q4Zb7hyTOfhwdLuHZPuv7BscVTj2G1mhE0wEZqkKbh9bMJHqBpxEUqITUUVOAU
+4bIc6iUI1mt6Ali6BNL5EHKFj0nhiz9LzdO8lE/0gORtJcHkBI6027gNFvdzb7FAVSrIQrmR3yxVpEGssB782GKV0kW9eFh3v1Mu
+fqdc/E65+J1y8Tvl4nfKxe+Ui98pF79TLn6nXPxOufidcvE75eJ3ysXvlIvfKRe/Uy5+p1z8Trn4nXLxO+Xid8rF75SL3ykXv1Mu
+fqdc/E65+BdIuZgE/PZ7PtclKvfv+8XAV5nVoZpn6ElBWDHSuuMeZ040f7BqDM7PYYkcnJ83WDR1bxWVQZom6CxXuOkU4o7qz5N/
+mNb0h0gDXsgwfIssrrrIx9RPT+l4HuJVU885sGjgEEJdx29MhUqkH4NSo173noRdM85+8Ewa1J1mFbr/1LFqtfiJ07ZWK6t17IBs
+pOk4Xq0WP3UOT/Db0fFqZRXSarXDIwjHTl6/JvU4FcpbHbNOKsQ2mlWI7GBVnXC1OjyqOjFecPvUsVonkIBNnfI/2bbip07nCN9O
+m/h2eoxvVqtl9nhPsBc2Np1J+H//5J+SxEF23VXHDG5qh7944FNH0YkprJKm3A/50AVdJEJFZytWA3lYpSHI2zPRDJjpOxC0AG7X
+N8K6g2fvql+r3U68i15Ydzwbfurfd8j36xiMAdaIkR97scei3rTBZc8//uK54Zu2b9a/T74v+xOu1/mIbSS6Aym+wxqgsAZEOw/Z
+Ku18G/T9zeKLYMbw8n1xTSB5Quq8Cuo5ocEabhyH3mVEU02fx68mJhVS90wQ+YhqVivhxh4yv1aD2ZW+J3d9QsGnhKo5IW1mpCkY
+xeJ6wqG4qNXUFrD8k08ljHXyFG74g8RPnxLq51S6Qh2PXZq5sQvZM2rZVCjIM8ASJLpZzWMLunfrhsyPazX5hEDAYM8BNCc7Zhkd
+aKTCU50Mhv1X/Qmpp72Gmyk4jBcXT0lO/yoKvn7fnlYPDtLuHBy8ft9mZC1ijxnHTUvc52kdQpjLhzdvcEP35g0eXijKrebLxSKa
+hoz5EArRje79KT4s42AeTJeRfIHICPA8vWbTt2yGj4Efh8ECswg5iXhkIT54EeyPMe+1N5vxJrzoBsJHNukiCPAv3j5yy2/yvlnG
+PD8sd/AXJKqBv7jnz8gTZ/z5joURf46mwa14Yu7NgkURf15g1DF4hu3ezTKK8cYiIHA6L2AjgriQvLrQu8WevL9BAL15COEi4H6n
+gN1c8ir9AFOxKdR+YXwO/JBUsKbXhWbckLmQ7dKNmPw7D3wsehlytC74n5sb18e2kkYTQK4xp3dzhX/82yWW9yLciMLjW3Z/xTEI
+oboRtSzGhm9BroP9xOCY8BRDoEt4eIcQQHjLsrui86YbfJozFnoBcFRnwi5MKkTq3TROBiFCgc9UBT7OtqkDIYi6JAyCGFjWlOsF
+kQ1MVTZgLxpe9Mq9MqYmfPWMKSTy7Hg5yhA36d4dFg6MaebjILiBNRQ+LfOfYPrBhwg++HXHxarXJXetqxuIB362QFUKTdq3yUw+
+EioatsmUPxB6zumDcDohPCKpTZAACX3lXtkkdq8IRbhsMoXZTSj2XRNkHvMiq4cOrVay2kwSVp2krNfbljNIKUZO6xk+rI4Oozwq
+k2nr7wcU2ZZSlQ4r6aU7fSuDQL0JbqFMBGSyFEmMBwYanDki+NMsuEnvTpzB6TOxkojdq/MY6ku+o54odNQXLvxfy1ulLAuDP3/6
+OgLJNo/+3BEhMJptky6dBz8Ib9yF93P2o2svZtGtC7PDorCHPo/dEO7b8aYskmkjf5amrLuqhj/wOQye78WaSyEFtCxzoSPeK5oR
+AJkPyr2UOVRmEZnHoZmvOSNvMx9SpEpdjMBwM4vPVH3yhl91OhDN42nVzDWTy+PkQMgeoYQxn4Z+Mv00s68GS4gjtfaQ95YW8Dld
+BBGL3Sun7GZyQUeN2+DWMLsZlDayQww2ew2Gr+x9hs6SVDM/CrVaLgEFRxmM8dhZw0BGZs7jp1qEaRjcPL9bWNnL+uNu7GQZQU8I
+ZnkLRmTa2QQ3F7aQmUwbthCCGWHAQiXSVrYP7mxW0oH07sIU15Psq1gODqwL6sMBJGH4thxnONxM/DRflzV8UJgotKmiR52pOGiR
+SMgPW5pu0g8e+bBnsMZtyO6ckIYcPmbaIgkhFTea4AXXfLsI3I8LkbNUC1ufDNGqprKQx1ZZfM9rJOtJwuUhEV5sr/HKvaKwL7UZ
+FZt3O6YJkicXayrZZp4WDV9OicwIG4mFVDp9oGdhgelkDSlylxKn6Naw3dUqm4VfW5d+76ZTI0dIYs7NgpskwRAkOAtuJrmPB9aF
+KfcBXgMNHfxejNtpx+B/6yzVigaoEbXFB8WcrQQUY2fiN3nmdP8f5w9LPFP8odA++Gh9zHK5ZMzkEkp4gCpshvtomze9LiOOdYEL
+833P4zkDjHac6bvYU5nKbXBG0k95eM0BLcp8i/SOGzVkKxrRaDrlOFyESMAQmxcJmLDpW9O4DEimBzLWAxmbJaMBGwIN9Np9QnaZ
+zFd4GwYYMdi/8kTYSi/wtcyrjLgEe0K0xBIPyWa6hNB8FBXKfXhYft0ObvM85UCTXQdD2BU+xO4VhgTkl/ituyIPqLqMoNwsIXYC
+0E2K3Nz7IVk+PcrowxWLbb2x0yS+kPw/YnHZRdeTGC4URtF/6ZVCSde6E9jMnprUN47hoh54O+Z/TvifDv9zZF5o+6R2u8SCPkSI
+JvEFDzQamkXI+K3DN8EdO19eRizOakUVCSYNcWDEifDgwHt6YHV5jtgR8rIk0icNnWqz63eFRFaqXHzQL5sPIZwOuI3IlBketczu
+Zcjct2vf8cV6uwbRG1aXnu2E6sd3HobPzgcvvvxyNHg1GtoWffnVaDAaPvvyM7tFxy+eP3/xI3g+pIMXX77qP/vy3D6Rj6Phm7Of
+2FYHGUsDggm6IRsGUxTZvgwir2Q+hNSjAXVpRJd0AScZuCoe7c+Ui14rTdylhQ7rhl1z0Vj63OwlBJlvKDomssSQZbohy9JpdheT
+5QUcsifLi665TC8PBdH8suc3MmgwPGcxWcLeLADdgjyKu5AK92ZBLTRIhsI1n6Yvkdnz8ApQv5Hgb+U3MkhTPtke7lv8RoL4NPO5
+rSSb627cWPrez5bsPAh1+wuPBilRQb+ZIw37ze7BQYBEFjtsElxQA+hP0d49PbBqNe9JUKsltBQALaX2SkEYG8XBROloRiqqgNw7
+sGyvpnS2Z9lNkDqvt0uVxRlfc0zS3f7jSwFtrRYbyQvIZNb25uxqZhi2okg5H+w6rYYZvrlaIUtO40zCcdt1Yi6ycQL+txuj/1Xx
+rFCwWUD/BqYLrg+nxti9eoOc2nEcft1F1TVic7Wqsob8ZnB5rLwguWqt5W4Ni6Oxd1qcNWSiwfcZZeWmgR+7nh+lZTMty89iO6Kp
+pRpLVEOhiX9hJAlwysoWkOLnJudTkfMgu6eTBBWphPU0F/K5eDdLgqC1TT7hpwbXLs3N80Ku9ZpKVH0ADAma17o2k62ebE2i9fEt
+VtNe48Cs7dI8PAdvHbjNFZMUm5WGUp8GfPq7Tm7lvHFvM6umUCsoNxjHQOVRDxZ40OiEqJpcmyo/lrd2wi3K3D7FW8QsNNxGyGZL
+WOZMAcSaqlCe3T+bOfor4itZ+S0QrhE74AZHRQv+7IXPjNAg3oxQBg3w60NybURn96/4nimPkjBr+CRgjtJ5yUyRT1cjap33qBFn
+bFpj2UakRLzCD+i1mngQB3f8Y1L+Fz/CA+ZweMb0EJ/aofP3ZJXsxnIBidPLutHSjloAZyxPYHpmKO8BUBrtonaMwxgnBzYV5i4q
+F3mZODGiE5AlIOLVM/K+7XRZ7waTIAfpBawDVJrOYiDyoglMWj+1slo+cQJJlWryy6SgCjxoXXRl16jotU/jVNKjwKHFlOwcDSUu
+EDRf3DUg8EiZ0gaHGsnLmydoo35qRZygxks2lhkMhSZt4mWmyNv9Wi0tkh6/sO2tcHf5lU3i0JK2HMqWwzwVYctaAo5NtXsZGpIo
+5RGiob87qAKUCVjOPoiPF7ilTDis1bxo7PkeHrLwnn/r06ZJ+S1jnKnAlM1fP0bDdCsQJDe00qWT+H5GT5Zw+z/4eUYoORGXs+Ir
+PTgInzhNE9IDB5LSjWvV8Gu1oFYL5K2hTcwEDQfYMHUdtzEN/KkbG4FJjfDAkXlNrNTsRvV6eoFoRsOc2cM4TTwOCIj9JyFGhweW
+Y8TKMl+BF1kd0nleIZ3WKA5BTeqlFYdPvFqt6qM2bcH9XoEY+FPPd+CvDT/5CYdd9x1sRP1smro7R6Jde7fgvRMw+BewL5z42tYj
+ESlfbVrudrrJJkjVfGdBwJupcUGVcKRfPSfkwgoaOE3qOp7MEjxxuwHiypsEF7gfEA9ixuIbnhq67sFB5Wmza3oT90IxUMi8Kv0J
+ZQXKZ0WHKJXysDEWm2FFjcjXNDuksADbHhXrMLJcO5CvtkvZey+KI3iOMLW/WNjLnc8RlZTx9tLH7G6FKTs/KLo2EwdqMKLMBgXQ
+sgV06Ic6PaUo2k+TyzC1SumR1z6xPcXWAyTmYuMXCK1s6WeQmvW45QOAlTt3gJAD7wANirjGizd8FsINmHZIIXrAMhavLrxC1ba3
+1gerwC3LQECkNRtP4OUSgSsWv+S8WJtZyCb4NvPcu1x4/lWkkZWGym1AcQ82lfaEXYgtVB/PD8uY4RWkerNPlh72ksdJjDVcuxGv
+QVuyWlWKZg9kwsZfMQsSAOU2hgoQMPKabdrC85kbaotAlZ7/e0t3pv38CWYIlnF5DqN1gE6EDc9/sSmf06LsidVrHH3CPmH2QePo
+E+PggH1isIOWeWCJGgbLS1YCSAJKSR6ojH3C6lYCS3llGVg+YTYAY7ADp2ViFS0zQUsYl4Kj4kafzTqoHBwoeSWGwngnsCSaUsg+
+AWRJ2Dx/E2wKdPqMCWQZnG2otgBdAXEZ5J17PtMjBS8anwaRwT7Bx5fPPuWFgmVcVgozRp5fKINQl5QC6JTmRMFPmClKjt7fBrrG
+mnBeb9qY/zZ4Z1jN1iFlgkiDZVxWzoJyzLYOkpItemA1P1Hmx5YmsQbLNgDbJkd2EQrEeivfiAGfZM8GXjjdgPzoZ2FsWEAAsktl
+BdTslYOkBJ9fJWUSUkHqzTcoO6CmSxIy65IRnIGNQ5FRW43jpnV00lVo3TDiumV+wg5i2Zedyh4cqIXrsalMgpIKWo2j08PT5umR
+qCIZpRwYnzSOshNDbUVS7Vmw9KclE4T3AjMA0pJ+lRZhT6xPW43jo95x46jT4hyWPWll0gAaq3GEaQBL4/gI8jSOCrlajZaS7bR9
+fGRnvnYyn08O28dHKeo2wNg4AnpOO2+0PmFIC2p/W58AHdcbUOWEeP7Bz5bujFw4cpmC1GAZp8licRK5M5+SFUl8nC4vmfgAa4Os
+KkkWi4tSlVLiRebjz4CLp2CFsQJXGCuAhbFSnVrqRfbzz4DxJhV6vlKh5ysVen62Qs/PVJh+jjxfgg7sUVaXJAtmq1SmlHiR+Qi7
+O/EB+JesKkkWLFGpSinxIvNx6oVTOQReOE2GQCYLVqQOQVriReYjmCyJD2dotMWrSpIFJ1CqUkq8yHy8RNqTleFLUl36KSFStUq1
+ZEL/O93/r5sjfsHfW7E5+HRS/b5hfnLx6RUtliXf4ybOiktCxk8gG8phjd4/GxS8uqBpOTEsP32siEl9J0DVed0hq780ef/ji8nr
+1zP3YN4/GF/UV3/p9etZXXYafbfY1ej9rUFqRs8m9bhOzC6h5Cr12dJgJnHHSLARgki3TJHCnsQ9yz6wtFbqlaJwmvwljEwg3Rss
+s7cwyI8zaS3wmnqfS+qhsdIzPzbiRrS8jOLQaJvU6ph28UPLpFbTNG02iYXGzKIHlnnB9TpwrGo1j7jRZLtzAkaTkNIx6QJTjsHc
+PDSWYGweGq5J56oxQdJPppBEl6DMULR/YKHooO6QLjEpBjNKI4WpYxuZXBPnwciqH9z0Q4hSIzA69lWj45hrQCF4WM+AX2itR+iy
+Xjdt+c5pYaGhBT8lqrqGlLo9TkzdnskJhk6dAIztSsdV0k2c0s0CXKfWhqnQ/8OPv3huTykeUuf45xxnqn273jYzRDO5qYFYMqUm
+IesglcimQD114ZAaqft10iU0XtOHtZmTAfDRmYABX+pPu82SgJkPFkoChIoj5tITdOFhpp1Yy61N6icqbTIB1yIpUqiTC5jY6Qgp
+Q4Oo1/t/1P7S+33YEPRbG7yPV4Rb3k+MXH0HR0et046ZbcYyD4467Vaz3jk6and2b1MK3+R7Ro0GJ/hylpSaecG4Ji8z6qYv16A/
+0nqYKMVvaLCpOJ8socG5AdC8Zywg6MaPv3juRMaCTrk24lbkOTKBN3jGLeQBYnYi45bOeaZr59PJH7xuHrx+fzyGhWTmfDp5vRye
+NJsHr5fDs/H4Al4H/HU8xjw3skHhw7XcYLLftGEA2tRqndgn7c4htdpN+6RlnVCrbdmHzRa12i37pNWCh7Z90mo3qdU+hBTIewQP
+R9Rqd+xjC74c2yetQ3g4sdtHUOYUEk6pddi02+0Tah227PaJRa1DKGp1qHXYgYdjah1C2VaTWocn8AB5oHCrQ60jhMmi1pEFDy1q
+HbXs4zb8bdsnhyfwcGi3j9rUOoJ6j5rUOurY7fYptY5O7DZmOLXbx531lqVe4+rBJzVm5kEhML5it1Thyo0KJyR0f04omb1zyQWN
+HaBEehYEC+b6aMAHrL5Ww++wRkE0T3O900bEN44PLTNxqqcepLSa6KFsHLcsLvPriLXpSCxNlnXEl6bjU+4IdXzYhLUpI66kc2cs
+AFD93d3FArx3poE/966WPPoJuOL4yxuWvL0LvVg+87ir3KBr5mhNwnSoTs1i6Q29o1f0nr6hl/Q9fUffpo6jE+uCjpTXlrAU4s0Y
+Sww4uTDemiBXf8uVudwUturw7tZqIoKZyU0L06CFb1H/BaxcKiVAN2NcJczZ7HGE8SvMoYeUmbZxp8TptEx6N2mCYVjzgt5hNJ5b
+qJBL5JWMSq3ijvKrbr3um3fSzFwuIHfr9Z0zucCINViVN08ddY336DQk6n/jRMZ7U8YboaCy5U3GJr103qDGy+DNVS8bs8BnXfOd
+87Y350Xe0hG95MEnqG/a8jHuGdf80XlHZ8Yd9em1adoI6Du13nrd7145fmLgMU06nnY2CxToT26Uzr/DnlO/bj25qtWMe+dddjkx
+nzq4oNRq90+co07bOq3VjHd1h03qdf/CpLnOvKM3pv2u2IEb0YEb6EC9ftO9cm7WCVodx7kyOdSukQ6SArhxZWYHLdcu7wTsHv2L
+zeiTgwyVy1xIV3eiXecKLq3dkTW0wLrRy87qkqkm2vVQR2FjJI8dG2kfWyZnO+22YDunwHXQebsxdW/jZcjQTPZVCBbqsVM+2XmE
+WywJBBtl5/kyO8+T+32X5mrlGRHskJdOBIwOfHAofKjVQsOnS3jB734DDmlOZFK3VnMNnwLR7dbT0ng+1HOEO1LRnjUBeG2Y5mbU
+h3KeOo7jfTSQYHyaW5rWGQrVaqKC3eBJq4dVp20aZk9Ifa98G5IOzUcvtk5SU3cTvAZumw2radZqB/z5oNXcvo5uHBTmfInae6BG
+L/rS/RI9O5sOFxazp01+Ut0LOx3ADq+2gXUigo4/AEFqZZtxVGXGwxq1u1+6X0J8XWa0Dz8UR1VujrbbbqXVAm6BQ+peRjTgj/NF
+EISbm5Ho7zVtQx2WJt88JSYVzOxB+icBX7nt3TnZ0QlnZLg7CnSbH7Dspm7ptihST9x0WZyft2K/8ywaJdulbplHccGulC74gE/p
+bYYT0rny2k6tJuQmyEMv+9BYwF4vMnyTzmu1KT/oauhk3gsEO4M1i6+BJk0z5uL1T9Gz2eVFGJ3SbJwuAcySf/cpM3si74LeUn/C
+LihDExMbgkt8yJZcotuNNvMKtFxzHuZBYOO+fE1jg9GHSze0cQe+pg9x+PN7m8AvwSAY8yCos8alG9ZZA1Idx4Gis3cu5tl9r94+
+zJAY0v6N+76UBu4wagbawoO0JBcuhbZMYcedjDRsd5VKQh5JEPaEaPKSBhD0eeBTAN11rK77ZNmt110zVkjJvaChEacyCh7kNw04
+I6O1JBqdD2EjOoa1M1pPAauJIADDJCBCwGd/y6qLoa+9iWzyYrWq7sfP20fAz5WJD+y83XkUO1eiPioVGuQ29G489Jo3S6JA7oUp
+pe5t20HAj+icwXZhplncnABuRNhDhVlKo2XIcmruBLs63/TIK6WjMGkQVkBYvNMUA6c8rIRWLn0eBGTXU3ihh8JzYqcdHzqxM6pu
+dTE05MfaAhKyZftXiG2/WoE0ohDloFbLhoDmDa1WmZ2iufcEOmwhkWB0QKCIw8PdKOIhmeM04oVLZrtYIboZt/pkFeMNJ94FXebw
+FIPELIorvGoI9xvePySaDM0UFA/VcJKpOI13CMRX+BoHL+Xchhg+mu+8zVfu1cUHbdOqVRg/IrqjjjWYMU0D4aIYgKW0DGaLcmjl
+k4iZpH6eZDNkwN1t8khvL1gVDy0unzo+RPlUxiGRTrWyI49F9Fb7BQRY+elBr50FD9HQ1QdZENTwEAoqUIc9NEw42KoMmOt/NBH/
+ZCsa7UZiqYlefs0um8R1w8Owfhdds15PgkDHdQeT8ToeCEHhO+Qv/2VSj+kt7M1o1LiKsJmsShEtiiGeDecsPo1wVwCOgOYawy2B
+BiXQ3aSizu5AH4yNo6XiRTzCd0UZ/VQJCPsQ1QvVcxQdl9zWdDWNsg9o1O3BbsU2fGdhBGq4zthJb4HqEWIneiU6NXz68ObNjPG4
+AV7gv3ljRwa4IJv0DXplyISZEZuAvjWdGh6FQbQjnW3o9YRd9ODHvsaIvEZ6n9XahOhD40JJHqcCRJW4m0MHmGsQ613DuDsOM5Xb
+p67d6JnAFwcshKO6kgqyEKK8ExOOrgO03T6/DZk7Q8FsWrb4EasoJmNNgpup5UUSLyVeIC8PKpVmxHfMhU+QRShLlEwiBbOJZ8jI
+Y3Er+XgCZuOPmOuWTT0Wqdl4Cs/Hn3nGhRdnsi28WGRaeDFkUTizklFJxezKOy+U8L9MoSRVFEreodDSh0BdgGEVciUVCynvBGlp
+qlI5fVDmhB2hB5RohddZdl+IIPI1r9FTa1QqKJSWU9IgdX6jh9nIzaM6MeHwhDLMF3NdHeIuEHSUpreZtj0V0TTfAc5iRWl50Cus
+bCCgUe5AWpu6RpKBgEamhMq1DTMHWzIrlU283NqnKZ7pkKZ4uhMwzZ2VP+2yzR3oB8KyC/1YnaTcNNnibDjHbYgUaq6Npoa8VFiU
+uwq5UxGYE0iuBs+yOuGaBUnOg0izH9bUm9khhdi6bMZjSkktb3jBN5+erIF6NH2OTeo1eDFYEZMP6+R+xcSv8sZhNG5MuV8QhGUD
+/cLamGwchmJsMDEwsCh7ubM8uEHhE5/fd17kxfi+hrCkGt1hzxOR8ZyqhYul+eA65JLrDdN86Ft/gwEXk8cuDyCny+zGsTu9HqRF
+Mgldw12tpiAq5/Y+kQwP8jmGsAppKPI7U5q0BrgNHI+ru28B2rhWKxwr4gYPrAdUWKsZtw65CWZLHphH/caV3fPu3IFaND34afQe
+4P5p9L4HIC4bv3v+45cYeAhHITAxYPaikUkTGvTb3pzfnfMFtm2YtnjnsYIMk86c62RHUavBdngm+xk5YfJo0nmD60AbcfCW4dly
+Jh6duXjIZFqw0AWMGzMeqhuy4YNArUg16azg+iU973j0QAGdp+AswSWF/nrUN3MeWDvVIePuFetwM/RNeSzsaeMV9NL7OZM+/mDn
+AhtmdBPqpq6U4PjA3seYnV9JUg1MDEOBvpugNApgN8CNW8DsItlhe1kccTSimHed9EGTAxEtUeqILBFs4MO1PipJTMmbNyz6QuDx
+gautk5iveD7hp5M2152fiBiiR2BegTTEHSpT6nI8GqvU5QQ05pTh/Zw5rrD88I0W1HB+78fue+dWPNC4ccfCyAt8hxw2mo0m2bIk
+PKpXqCU0VVaVMUeT94LyGS/DDCazQUYZjDIxBxfMnXn+VfIeh6634Anr1LtHEZVELOSORwNZccGXNvFvCgV6GmeLYPr2PHZjxvkY
+6mTw6iapoc26xHmOClwSQ6nrPXWaEGFFEquaa+JdgOWMP2sE83nE4qdOwKOjoSZRGl8FEiFmpv9qyJUsItQv5tpPo2IBMlQ0gJEk
+VXEFjm2vRC0aZKUmZ8n1VEmb0h9PRYsux4HV9TlKktUskw0tETgWnjpMQQ6wZImShE0mk1TXlNOk8Vq50CRKg11FxUBXXq3mNfxg
+xhpxDgMpn9F+njQvuujbGrr+Fdhh5OE24rKiVFySUtbwOo38lx+m55wKtKOkxiqfXHQLPQbnShX3mxFTNfgRRg4JPojOmYLLxk6I
+XVDmqwhaxfcVqX9o3FhkIe8VUkTzNi76B1Y34PSShHwoFJgEF11XoN+6eJKDMTOZXJMW21MCzqQoz+fiLKAM2FpNDGUhA4SfFr0P
+SnhEtoNhlkcEyXzY2LFwM5fI9M3PkhPuFL8Eu4UCX6xqOOPLMLgK3ZtaLcMOnzZNwc01DJcv3wpT0DEaEJh46eccgcMdx57qQ83y
+iHY8k/rZHPkJ5fhmhkJhN/AAdGszipi14wyG1+Zag6pBIa5e6qVKnqeRQCfNix557vmM2ASXE0JDEUjSF1Zr4u4dvCuLcQrGfQU+
+OSIFNISLYIofFsHUwTczu1bK0HhUWVDljuxBZLK1bVNsw55ke07VdelircdOV8LlSThU+ATl+Fl6RDi9/IolUnXYzqEZESpGQAm6
+itWpo4O5Bd53zq4gr1ZL88DcECcptsY77bKHFsfLW71+0M6JJpu1hz7qmqGp0fvbkEWwX7OJLpXQNPWlG8cszGQUSYSikVamsmyC
+yJFWobzht+CdNE/I1aL7QGj/netlgc8mEJrdadkk+07omee7YQbkfAqhZ7AKqXVk3gmF0MhqDdl3+B5PrwcLdxkxmygvhA4WbhTB
+LX42SR5F6pBNF27I7wgi+RSRJ9NoNgEilPszjFLnZoHTJWPu2POXTOlmIYnQYfCja2+hZsqnEDpkl8urKxaqmfJJhI5ubuN7JUv2
+ndARyjr6i0UGD7pUmXfI7yrQ5C9+kWXAoX2mKZFPl/nPQQg791hok1wCoSk21W4VEwkdBypy1Df89mKe/fpinv3+zM9+f5apW8yS
+TKc0iWlOlTh0c+wZXjPEO50+E/pMBfOZCuOzG451BYJCUpoLx0ZBrD5d5oeRwWDBhRLFL7JMIauS4znK/Bc2EQ+EPncv2YLNlM7l
+Uwh9Hlx50+y8KiQR+gXc8BCqmfIpkCd2Jde2ifqG366D2RA4uxeL8tkUQr9k79QGMq+E8sVBzZBPkXkSlpx5JVTsx2wiHgiV8NlE
+PhH6VRr+zybKC3yJl6FKsLkEQs/hfil/ylQoi2mEclVO0krmldDz5S2ML/4h9PydB1zWBYabPst0BZpcAt7TcMVmr9jN7cKNMzCV
+fSE0SZOw5RLSHAm15RIIfXXtZdh59h2+h8E7BfDsO6GvQpWbqm+Efp1f4r7Or3Bf385yvc2nEPpDN/RAgZOZ15rEYs4gLGYMQkLz
+a0l+JfkRD/yefFZfCf2JxxYzFeRcAtmmwNd4mnYjpAdxLjEfpi7kBxGtOJ787vmPFX4Yi5g3PChtV5c74UozeLJFeDA40hp+w5cf
+zTqxSV0mmRvqK/AUvgvnNXoNblVi1kkDqvMSm03lsJ/KLORNBm/Y+5j5s0i5FlaqqcTuMmKxcq/saqVcfwPOHKkKXDil5L20kuxO
+vF6tsl+zl5qasfa+AnA+cDD01LpolZBzjhQnRUWl6MRr7itHFWsKh9+m7vi5OxV80zZCNVv6jG6JIXfifJTEEmTFh9yrSTg1dYTN
+SFYq2wZ/JjApMbtCTg3UM7GazQuHZGiQ0GwGi2fAC6exyblTosvKhoBj6p0k+IVH60/k1HjzH83YA7thxEDtl2EmTomuFrXmBnlC
+xH27WPp3z3/8VRDEBr9UYXPFKYAi/J7MjqfG3z3/cSHEeTR1fZ+F3J4tEUaF8Rdu+FYm00zOheczbpxdzL7AsAT53HgxRUndB4Xk
+abBY3vhZ8GEtj6518Pup1iFbJGTMj1mo7bNAhiEOwez9LZtiAlmT5Fif0fxgGn+RseDVxvi0AFaWEeJkR3caLBZsGifyFJM+IArs
+4kBQQJtdgnbKMaQpdqBH/boEWIzlpYf4NwMZfP8xOMXiXXP3epEqOGVTHzSSGPncgx9UoFYzbbBgbkBwu1qtGgpha+RkcnDF2KQI
+LApdI7TL4YuMNzdCcI13HCeicd2JaL2uQU01NMXaGCcqClyfWrbn8CAGkbpstW24QtB1eOCCiPqOu1oFjcG1G7rTGKqF86x34y6G
+3pUXG1HON456cBVl1ZVrobyJzneg1yBPr+5Rm0lFObdQ7nP2vqTMGsTLPmpRZZ+ftji2l04SXMGicRLt1Ox6tdpS5rV6sbStBT/b
+gajdSAI0LJM6RIAGVynd2laaNEk9U0PHNG1vtXJXq+ptIyU1j0WT5QWG9Cwmq0oAlVoX7H2Ouyg3LGWJTEVbkXBQX9pB/dZq1Wnx
+v4fH/O/RiUi3+F+r1ZYPR/CQuVFkK2nX69J7hIsij4UoMqbpLC6d3cm0tfWzmUbFj3z2W5T5M80HJJ/2Ie9Q+5T3J6e+ys6xcPeO
+Ug/uItVwhW6ix9gZZ97ccEEELxlCHbiQA7e+JvkVxmWEpu2uVTyfCDx7HxHP/iakHnZS4oj2J8k6asL3L9Yyaexg21Gthn+XPdJo
+NIhNGoTqRjRzDX22siTOKv32iHUjEk87inEmh8GS7u6EfKtTpgwsoMQsc073uVgjuructqrJ6GuRDnNMt4btO112YGxl0L4EYF0J
+rGtqwZGu+nBtsuOK2afLuV5nR0kOkw5mHt/HpxqAfy2UpaP4BXuf31TC1haOKbDrLOxhi5tJ/f5ax0O123anrLslG3Rn501eV1kW
++ZppmJlZv3Cj3WBWMm4HWcm8L8TbjwAYdFtkumOhOIIw06RMP4iZC+HUc8hv22DJ1Zdpgdm8ovr7bLDJAyyg/mpFnuDDhskNe26f
+ZhkJaCFfsfDG8904CI3che8gD6nXC10UWCKvMRizX6tBIGbH2RXsWk0P3aYDr5rTXP920rv0txK80/oW1llWuqAp5h7ScOCR0y9E
+47+s/IWxtzoeqtupu3dcsCrvQ00+TF0/5bNdZbut5WayWMiiOAhFlcykudNDInQoux1QZf+G2T1G25cYTWtjETgEt80cMSDq/trn
+lbIZLxTnFhQUL21oUUVW2qmShtdFOVdG6FaC7YykxDCpvrOiaavZrCqNb+hococLXqdpcCmkmxVFGwJ609TDLjQR2QDeuwBf7Dw3
+gU1leihbsklyIUjczQuebJK5iFJbZeyU9jIrQjdANmmuM3d0pnA0iInRbrrF9G5iQ1wAsEGkePZRAOZl8oZHA7PsZC0rT4K7a8eE
+xvpR8R83KqHjl46Kt6FKlut0XDoqIfXkqDBHcarX9p3LNYTuK0HEB82qkx0nk2onBpcCQPJX7jsjzjK63DAvGwJYOc+oXzrTEjLY
+s2OFAXog6dTnEmK5Exay9DW4fou1gxuWC4/D3z3/ccWVrUeVm2UUVwJ/cV+5ZBUeYILNKm7FD/wDBnYXFZZqzTKLAHZKZxCUWxdS
+gbSxgV2lxQfcUR34Vhkeyy4/0GgVJLayigXNQBimnStVUEfIi0hNO5Osp1ijBHaYEQj2I2lbyyNw9oPOqqtHgEPwuuEcETmkwDSy
+mAWvgtIRS3sal1M818R/NGpX0kC4UpwD3x6B5nqyA3FmHPXT48XkolvNDc+nGKchl/g0WZZiJ/epQM954HIUWqQ5jDQrL6zazJBf
+3DLf868Kl5btNYJPNLwju/UwymkRTvmhU8SZHMWwVsu396mGYp6SDcOb7aUR07CcqCGc9gz0j3uhRC7BWazQYrfMh3y+T3fAXoak
+d+31YBFEaq9Nc62sgfpRCjeMkrdhlLyPP0o+9WhYNkpoNajXPetGKVEK5lBZInD6LZXdaJfWLCK27k2SBfjx3JpTa8litCZmz8iT
+chZGvYbatI3inmcrr6cFJv+xtiHFO6G0fF4nMYrLqY/62U0shhzFywKFM4YPBvIZjw11s+qrvgyFnTnW5kv7ezNZBTz0lyEPEJt9
+R6GQkFtpzkY6JCYtBRvXG7higEsXdPd3prrwUuSmd3Gp91KKC/4yUMrRg/iVCtPTDknuHJRbAPjweNJfJWeVleVZiUsXVxFEbDEX
+TDjRHmfGzldoU7QWUIi8DkFYzK7SR8TuUpyzRHB18OBwuJ+JTwMOiB3QKW+RO2HLCsBaa72hH9m1AjHNUxyvK6OBs4ZoRNiqwf2A
+VQc/iMz8g/5oMhLnsco0CEMW3YI1un9VgROLKF2J3avKPAgr32sSujBp1YgVL6CUIPNYZNxHrIDKBF6aIpImsJp4yJ3EqVcaLeKb
+xsIwZss2aq/NQn6zn1v3TCouQ8QtP79hukBJKs0WplXifCSJIk6IIkwpwl+bXV+9Bz6EF55vveFInMExtxgrYaFg4aXR8Wy2QaI5
+ayZV0VI4q+WP7snKkRsnL8Jl9MV8o7ka21xCsUNbrdJlD3d6axqvDem7D648iXO/M/+oXjyCmz18Gfj9aOp5OUWp/enk9ft+//X7
+s6PX78/6r98Pmgev3w87r98PTw5evx93Xr8fnxy8XjZbAwt/O/gyxJdRE19Gh/g7wN/R62WzfYwf2seH+NvB32P87fMPQ/wdw+8J
+fj7BRtonffwd4O8Ik/oW/rbxZXyEv8fwcnhi4S9WedSCyo7aFr4cdfD3FH47POkE2j8aImBHI2jmaMxfxq3Xy2anhS+dQ/jS6Yzw
+F6rsHGP5zrCNv9B+Z8R/O/iLWUeYdYygdMYD/IWkY6uJvy34cIwwHh8O8aUPlRyfQR+OB1jwGME6Hh/iL34eQwq/+aB5Yh3hLya1
+DvH3BH4P+ecjfOnzlzP4fto8hJfT9in+AtJPj5r4i+g+7UDrp7yHpyf45eSIvwzwFwA+PcUPpzgCp/0T/EWAT8/wy1kLfzs8Cds6
+w7YGgJvTIVY1xJThGDONsN0xlh7Dc7+JzfabffyFZvuIur6Fzfaxp/0WNttv45d2C3/b+HuEvx38xazY5/7RKRY4GuAvgNM/xrHo
+I232eW/7J0P8ReD6pwgQ724fu9vn3e1jd/vY3f4ZtnvGy2On+9jp/hAzjfgvVjWGr2e8h2fNAf5CD8+wh2e8h2fYwzPewzPs4Rn2
+8Ax7eNbmxbFbZziUZ9irsyP+jLCf4YCeHePvCZbjPTzDiXXGp9QZDujZaYu/HOEv1nuKuU6x3tMR/iKgfayqf4i/SDpnfcza5xVi
+/8+w5wPezwH2c9DE7wPs6IDPggF2dMA7OsD+DLA/A06UgyOoedDBItifAe/DAGlywPswwD4MeB8GOEoDPkoDHJkBH5kBQjbAkRkM
+oUcDHJkBjsxgzH8BzUMO9xDhHnK4hwj3kMM9bPfxF6oaHkJVQ470IQI55KxtiExtyCEennbwl3/BqT48a/OXM/zFygY4ZYcDyDxq
+4mQcIQGMkABGSAAjPstHh5gLWd/oBD+fHOLvMf6e4G8ff6Hy0SmygNEpfj495S8A46jPW8KxHSE3GvUxFw7tqH+Gv8isRkj5I6T8
+EeJ3hKCPOOijAYLAOzAcYNIQ2hg3odyYgz4+PMZfhGHcATyPOdsfw8haTWRzVrPVh9/2GH6PeNLREf72+csQfgHpVrODHzod/B3h
+5+Mm/h7hywnmOhnBb5/XNcAig2P8xaqG/MMY2x0D+Fbr8AR/+/wFsrU4LC1YW6zWEX7nELUQolaHfz/BLyf8ywl+OeVfAIlW66zF
+X47w94S/AICtAX4f8O8IZmvAvw+xzSG+tIEorTYSpdWGNcFqW/wLzByrfYKttYF3W23eaVw3rfaYZxsDUIdIaFYHxsHqdMb4AsRr
+dU74F2AGVodX0BnhywhR3BlDF4/5cB3DhLGOccJYxxbg+7jFv7Tx5ZC/HOELx9ExNnrMB+wYB+yYQ30MxGUdD4/xF3Kd8MpOYP9g
+nfBcJzDfrROgU+vkjCdhD085TKcW4POUD9hpB7p7esxfgO1bp7yaUyBx65RXcDo4fb20+ryCvgUI7/Om+0dQpg9zw+Jc3EKebJ0d
+8pdDqObspI0v/Sb+jvAX0Hl2hqRwBpsGi99aZA1aUH6AOwFrcAjZBpyWBrA1sgajU3yBPZU14FhHZmUNsJ+DMcA35JUNz6D8iL+M
+kSDGnCDGFlQ25t0YH+KXQ/4FSXrMMTQ+OsbfU/w9w1/+GalijCCNOc7GsLGwxrjYW+OzAf5CZ8ecbscD/D7g3wf4nc+vMeygrPGQ
+fxliMyP+Bbs5HvMKxljBmGeDrVSrCetZqwnE2WriXGo1YY1qWc0W/h7jL+CvZVlt/D3C3xOeNIRf2DG1rFYHf0/wl5eAJahltQf8
+BRqxcGBb1iF+AV7fspByWxbM7hYfxdagBV8Gbf5yhC882wC2xK3B6Iy/4BdYZVqDMYDHB641bB3h7zH+ApBDXtewg0mw/2wNT3gS
+LCWtYZ+/9PnLCX+B6odn/MsZfjnjX87wy4B/GeCXAf8ywC9D/mWIX4b8CyyS7SaSebsJmG03Wxa+tE7ht81fYMPVbrZP+MsAfg/5
+FwC03Tw94y9j+O3zL8Bg25zBtpuwPW5bvB0LOt+2eNUWcOu2hT1tW2dQxhrzFyxziMg7HAIDPeRUfzqGfXmfLyD9Q1hN+odD/gLM
+rn/Ev3SAV/U7Fn+xxvALq02/0zqD30P+AXb//c7xGF9OoTzngn3c5vePLTh+9I+x/HGrhS9AF/3jkzN86UOZYxyR/vHZMfzikaV/
+0rTwt81fjvBXfOnj7wBfWi345eCcHLfh9wTbOTnDlzF/GR/j7xn+QpunOAn6p0BY/VMkpv4p7Bj6px3+cgwYOD05xBdYz/unA+jH
+6Yh/B8Ltn446/AW/jHmdY8AK54993I73+xzA/mELfw/5C0DT56314ejX7x/38XeEScAT+33YC/X7MIT9/hlmOjvFz7Ct6PdhJez3
+B1jvEHHaH+IHDmV/hDVyLPSBYfTPcDnrnzU7+HvKXwDkM4t/sfAL8sL+WYu/nPAXzMaxdQZraP/sCMfhDLYX/bNj/mUE4PCpPzyG
+XdOQD/HwGKbW8Hhwhi8wHGO+EI37sPaM+8f8ZXj6ejk+418Q0DHf+4/PrGP8HeLvGJMAw2N+HBiftTEzzrbxGcy28Vl7BL+HTfy1
+8LeNv4f428GsZ/hhiI3gznU8RJY/HsLuYDzE7fN4CJuh8XDMvyD8Iw7yCJbL8egYaxsBMx6PkRuMx7AbHo8P+csRvnQwGy4HY74c
+jMdY9RjPt+MxUNp4PORfhvhlyL8MBxcrfsefcrffoHmGv0N8gSEbDnDIhgNofTgATAzx8DActMf44RBfjnj5I3w54S/AfYZDJNnh
+EPo1HPEvI1hLhqM+fxk2Xy+HYw7AGKb4cNzmLyAaGPIlczg+PoJfXsEYeMRwzCsYD9r4e8JfsIKhhS/DI9FJS+0kFuYrwhDXgiFf
+BYbDDtQ0EqAACQz5Tno4PsLWO/ylcyyqbWVwd4S/J/jb50jDpPYx/p4oCOSow0r5AjYcHHcU1MFRcDgY8RdYx4YDmHRD3IwksFv4
+0uIvsKgO+do1HJ5hr4AuhrhZgU7Br8VRbmE/rSP+coy/p/jSxi8cpNHxoDBmA/4ywDIDXmZ0qAxg+6gMa8ctdQAtgcK2isLDEwUH
+Z9jtgcSByH/I87cPUsye8Bfgc8PBkOcfnSCe2nyMAbV8Hg6HCMYQsT3kJYfY0nCANDOE7dRwOETaHQ5SehhZFkcdvrTOFNSAQG2I
+J8HhCI8hQzwKDkco3xiOkOBGp/ylj9nOBO2PEHU4EGNYK4fjJhKwhZOCNzYGzjQcI2eCuYG/iEwcrXGbF0cCGOPcHB9hpqMhJ1eJ
+66OJgl6OLuztYICEODhWKKiPtINblOFweKbgoYX0dKjO535fncKnorkONscn6WCIzY3HChGNT0TGE4UGxqey+KlKGZ1RCvrwsH2x
+mmAewBhftYcnnRP87fMX/IKHrOHJcSt/gSlvYai20BrxVEvlFaPDjkjtq6k4l7l8YDg6GnEuhi+joYKJ1liZC4c4Vh0+onC2Go6P
++ficCHiss4lS+PAwHchTXupUZGwNBDD4K0a3Nc7gC9n1MX85HqTIGyCZDk75i0R2+0gtfIREcdThWQYpQzrFQezjxOwjzfSRa/VP
+OUlhVpRQDVEONByc4YJyxhcUZNSDAZLrsMk52TF/wenG152hhTPQ6vCXAf6OFB7XPuMvI2V5QWwND3FWowwB5jv+tjiFHuEvkvMI
+NjvD0YB/wZnOZSjDES5ZI9wwD8cIx5jDMW7jS5u/HI6UhQlJc8xRPUbsjjkTGONUH/f5ywBn7OCQv5wJvKtkNRgcitSziTLfmnxR
+EswaJ1ALWRHsWYejFk5EXMZGLcHE8UP7kL/gF8TcqI3z+BA/H2L6IaYf8vQhJ3ls4gibOMKsSA+jIyxwhAWOsACu9qMjzN/B/B3M
+38H8HRzaEVLiqMPxi8x3dMwhw0kwOj5V1prjkcpWsSnc4Q9Hp9ginmuGKEGDMeUvmK3Ps50JxHZU7jEadpSJJad/R53+YnwPc1lG
+6vBYuLlpZbMcqwvYqC8m47FacGQNLz6lRXXQy4I26PgjaoSae2mEHq0LavOX470VQ6f8BaWaR2dYBLt3BBOleYQCziNYmJpHgy2a
+JNzVNDtWX1ErdU7xd5RRJfEXVBZxvUgH9gnNzkinRoKk48O+okASqiP+RaMuag1V5dBZXjl0MuIKHdhlNk87XHvTGasKofZHVAih
+VPgU8XiKGDwdYEE8NSWqouMyhRGCMuI1Co2RxTVG7W9RfdRGvVEbhw2Puc0+CrH7h5jp8Ix/QL3PkVWicOKo7R9jGycc6pP2x9c+
+IYb7SKP9wTF/wS8cxf1BRj2FSYjWPkdrfzRWlFUWV1a1P7rmCqHEU2rzDHF5hrg847g8O+TarA7+HpdpubBejtczJFmp8mr9RhRf
+Z/wFWcUZ54dnSN1nXLF7Nhgm+rEzpPEzjvMzxDlnzM1Bs/14/Rm+IFYHePZv4umlOTjk2Q65gu0IfzsaZRu+IF4HHK8DxOuA0+vg
+pP1x9HA4+rjNbw44ogaIqAFH1AARNUAt+2DYUZV2+BnpdcBxN+C4y6jwLK7Ca++lz8MXRN2Qo26IqOMbt0TVd6wq/LABjieU0ua1
+fwjNSfuDNIHDAcI34E0OET5ECT8GNYeoYRtyZAwRGUOOhnFbVST2VeUh9GV0xF+OTn8jmkRs9ezsYKMSEcUnzRGSAz/MNEfD0xL1
+onWCv/CZ6zqa4xa+IFsfw5aziefR5rg9KtNE8jVvjCgY8x3IGLs65l1FXUdzPOgomsrDU0VHeTr8i6RkxMO81T5CJWMHtVVt4MG/
+YfXjoap+PFTVj+3N6kc4CljHx21FFTnMqyKPYUtiHXOl1fEIBv+kCYRsnTThywnu0qwT67RUb7lFY8nLnLZQL9nmL218Oezsrcs8
+5SR3Ouyris0zVbEJjXJZvdWHI4/VR62H1YfDjtXnJNU/PU30n33eAIrpLS7KlvpPTrlnqEU86yBazhCfZxzCs3FbUYDCpLQGfHAG
+h6eKNpQrQDnsg2ELfw8PEqXnAEd/MD5VFKCIyeF48C+sArTJf0FFiIYRrSYI/lvNo8NN6lHOc1rNIb6MMNcINZvNcfPPj/p0f5Xp
+8fjXrjJtDUf8y3hcqj8d76M/Pf2t159mdaaoTEVRSr9zPFQUqGPrA3WmreOiMpS/wFTrn3BAT2B70D8ZNcs0o02uGR0qmtGjdlEz
+yl9QAXmKe6/+6fBUUZBm1KDtjqoGhar7R/zl6FSjBj3YotUcq1rNzq9Jq4lfgAH1z0Bc3D8b8w/j029d3fnboOjk8I2akG3U4i8t
+fIHT63gEu4gxF0aOURi5STeKM2OMW9GcorQ9/nOvLh2OhxuVpqPRjqrT/kdVnUqVDgpe/+LpUTNCefzt4O9gJ9Vqm6sgkD5G7fEj
+tK2dX7+2VeRHzHa4WqiDqqBjPgBn/bzqdTAWNak0gjL2IdehDLHviVK2rShlm4oedsB1OQPUAvEGtupmucbjg3WzI64L4i/jUwXr
+zfZH19oOFJ0fambGODpjFNINce+caHWPVd0u1ypytVRnoGiijg91Wl9F0yvHa5gheJwKZ1zti3qyRAc8VPB92Ex1wHwIhWJIYP3s
+WCXf04x2GH859K0zhWW1tXrj0em/2HpjMfk7p3klckJ5GeuKTkGjLHj80enj1Ms8/4hn/Na0zOnacSrQ1UbWMuxwZTB2f9gZqhYa
+XMt7gi8nPNsJ0MuQK1SH/SFXZnJ8H36nyP7NKLLxd5SZIO2Oqr9Gmh3xvo46XM2LnTg55IpdzofHqn53rFePq1yr852u/F9QXTmk
+nh02J8rOYTS++HTdjdNIjM4DRt4OZuxl4PmxrbqpS1/RJ52jo3anp4vUzUxbl3x01Drt1A12gCWfPoXI33Vtxk671Uwy1qxmqw0u
+tl70o2svZucQe0sHUlsE2T7lf6wktrb4K4Nxs6fO0XGnWatN4A89sU5b8NOGn0P4OYKfDvwcw88J/JzSk1azCT8W/LToSasNaSfH
+1Gq1Tk5o56h1fHrBwwe8mBvMfOo01zQf0lIHtyXgskQM8JOW7MlJq93mYfkKEYG1COgIBBwlHe0c1WrsiXPaxLfTY3yzWq3V6rSV
+ZLJaJ7Wa3yhxqW3ELIoNhToaGeIwGB+bnOXFh4LHnjqHJ/h6dLwbsC93h1UNma8lbqXxNU1D5W/Lq/bpONunZgsqejGNd2v2aL3+
+ti6O7pRfHM2EjzrGSAg3RUjgXuoQ7sBhaWwAQ3h+Z/OKEApBWaO8c6UNZ1oMsvGaxL0kSVQMkWuabd8vQig/BV0eBXvjRdpaqLIx
+XTQtZDOIe8OjR2BeE3EkCSAja9cMgqaYE4kAEvsDkc6zzUOf5nOWInJG6bBvaC4fWVKMPtK8HHV5xZMTF8HIl3cWCMz0UcAkUaDU
+rvNnHjlVA0BSxpmKC+Mf03I21mTaPKY5KjwaELKFndv8rUh7zcJskI4iIpQIHXIWpoEYdTMwH3kNobt+BGXmwsOJtsOrJdarIdFc
+Aecam549aoAg5JBKCaL50H2nGxHI7cw+9q22CUDOg0p4NlHfCC3wZZsUkjCX+jmTnuVoNimm8XxFxmMTfTqhGZZh56/40t37RjSJ
+mDN34xwpJBFaoDubFJIILdKITYpphIoRtZN7x761Nbu185otSDN3/bBYL3gHo8KkyOXetmCXtyhuz9ylOZF1ywqsYUtpe7qrkXnT
+3gyjB1EZNce9ieTUhMvVJYe6Yj7DKxnhuqn8kiqqcqN7f+pUrWIfdM1vW+bLu6MJ75ZseTiMTF75PY9lB0Lv6rq4v9HVtW3pp/EW
+sDJDizAwFYa4FAY50Fu3A78dA90sdOTen5aN9tZdRUmfNPcSJ/1hmc7ESmf8HTpTtbb3RtP69l3Klp5oBubX1xFlRLbscvQUlr07
+fcsOIpd7286FY47HLCOrFeEHWVLDm6ZYNwXH6yUAFW9ZTj7lL2l/LI/I17NtF6RFXPZOeWWA8zjLZnRm2NbNfm1l7p4X3YT7qYuN
+ZXI6N9jY3Z6ML3uVvThWuosFS/Z4kkCiAu/LlnXuEICrvQFIrstX5lGWuRXaTYo4V9jo/V4oTm7i3zCSSR7nHlt4szfHyF/pn+cX
+0fKWhZgpyzN0gKgc7A3Cc/k4eMrZ1z7gKIN+idC833PQ9cfeaXBzu4zZDK7H3OccPBDlCofh9wjcu/1RBUErIZdbmBsxi1JxTOBH
+eI95woPcBWwB3JgV8aar0nmHAL7dj3oDP/b8JduJRxQyOzy25mgnSZBoccgul1dXLEwqyTVS+O6MsJHzPakiRUyub6oAiKfMvJBN
+Y++uKBAYJl/OEYjBnkAMg+wt5SqPUEig0GyumDPA1vv74BkPmWVIzn50+lj9cC/KGb2/DcK4v1gUuBIPEFygHl0BZ4gtv3hEy0N+
+xWuh9ZlSux6EYknnBYLxxd5zm1cIx+TZZkAEZm7ZFA/pCVsUuPK1gObrdb5AMF/tPxOCMD6XbSezIAiBPcoZH0zdRQnCkqLOKwTg
+5b7DtX0iFtvNl3FeYtvP9t9sB+GzfNOao1hxp83c6XXxDJutznmGUH31GKhezPeDqgiIUoPzFQLy002AUK8MlBwgnu/FWQbFn5e3
+M1yOFKi8IlQpTD9FmMaPgenjnPW8bSekwugW23XG2IsvP6AXH3bOe3Qn0madL7EPZ3tN3J20F2km5wzbeL73VHiWnweP3RYpFTnP
+EZif7ckqn93wBSK/oqV8m2X4dn5dLZR3foZw/Hw/xN8oy1SOb+v5tL6E83Ns/OtHNJ7IgPdovljG+RoB+NGjRkHfMn/xbuTqpYUk
+BeBHCMAP9wTgOWx/2Uy/Kd5wlsyXc36IzX++b/P84qgdVSQit/M5tvXZ3oen2JVCdd7GDYvdracktZjzGbb8u5sZJA3K2r8OZkMQ
+7nvphHvL7lMGIM5xGZWRzOf5M8kfo9iNvakTFCHNNOD8LkL7g70mxcswuArdm+L2nfOBV5CZ3HBdRL59THV+gK3+eM/R+ZK9e6w8
+JVPU+TE2/5O9Os0VL/n2BUl4rKiiyBdwfoKt/s4jWs0Izrc2KXI7v4Pt/f42QqRu2SAr0wBIMM6RoK+SoKeQIEumznUwcwL+Fl0H
+YXzt+jPHzUGdzJzfR4B/7xFbiy08QjyyK/beebjl6LF9Ol+4V5HtrXPwfAX5JB/5PYSJsb1G7SsWxRk1d6kkWMnpMIZtxfu2BfXl
+mPOG9jK5nZi36bOPzwCiaejdxnkGcI6pjs/bDfdr9xz3PlNW0Eom78U5USzjhLxxb8/GUXe727Bm8joeby9gH1mUZ+0lyjvHFSFf
+pxNw4Fy2jzjlHCSa+fohzXF5ddG+fT1/54Hk2Y3Y5l1vvtGkmBPxlpePazk3gWYekC9YXComYm7EohII0hm15GAs9gXjlXt1xWav
+2M3two0LFB67VxKMny3dyCuAUVbcWXB4pnvDI6tSCT7DWmPXWxThyBZzprz528c2n+Hs2PXk1KHO+jIwJBu/5WDM96LyV9deVGqf
+l/3ozHkD1/vxlFdw0e6uvDub2bnmLc7Y3kfMV+F9XgYLyjWJV1ikFyx0clfmhYUTplqPM+Pg3Ow70F/vooxMcBJLJsfm3vuiSjdX
+mXPDgbrbH0dfo3DnkXDlEZWvzLnjcF3ti6wfuqHnXi7YJvFqMj9wL5afGJoanCsOzf2HQhOEeYEOis62gRCEzj2H4M2+EOj0CerK
+oT2XZgs5b3jbl3u37cX5VSO7EusbV0s5l7zt9/u2/ROPLWbl5gaSJhbsCiRDeRhypZ337NsyOjsFozPfsJomDeCvZVLX8Y1jk0bw
+2jLpkpumLeC1bdItdjB3gTergK9DDNc1Ow9rkyo3ojkPoetfMZtcBsGCuT5xHEBbMK/EDfxSq4kHugim2myLYAqZFsGU8r0svwOQ
+366mLZHcwiae6JTfhKrNLL7Vaskj5Xf8leSXH2u19HmtdlkCzIGt1QBavM1dJhhqZp7ocIcZQ2YyzRy9yF11GAbh52IxgGvrvMZI
+SSpmSkB0stfU8USq3keJ9UWNc/5msGJlZiZ/Iw7d6VtxyWym+gSPKn9+GbIpmzF/ypwHYhK7SUkXfyn+Ovh7gb+rFbEtCsY0douS
+FbHblPwBsQ8pqRH7iBLHIXaHkir/4ySv/O8TYh9T8hR/nzj8hf958oTYJ5Q8fSr+8L91Yp9ScoC/nxDbsij5lP/5HvxZS+le8Na9
+Zu7MeQBSsFuUzy1CaHojrl12U25y5a3dpJH4y/yZ3VzL9T3nUZScJKaBH7P3sfPgiVltVy3qvnO9GB8Wi+DdM9+uNvnjOXKHRCmc
+pCN7gbe5F0bxILhj4TPfiz3cRaAVLZIRn1lelJr5vXLDK4ZNedGZh5dbShtbSPOlJB9sR3jKs5jxNYy/8r04PKN08pzF9sOaci5m
+VyV2+TyFCyLTexL5pcLOA/pe2U3EYSmC+dXDCT7TS4kfV95n7/n1tIZZVqHm8uRdWtj5cuRUBKLc3xjDfhOHqvSSWeo7Vtd/ksjd
+xE2gXb9eN+OJf2BdOMm3iX/Bj90OGu8qDUULb8r41ZBJbmrBksEaIbtduFNmfPo94/XM/PQqZ/guwA4bbhSxMDbiJ56AgZIvWBS5
+V6wSsjkLmT9llZtlFFcuWcXzK7gMEJN6k/hiDauS9tZqGjm6S6rp0tFfR123uoi1SpE38htMEZ2GSyO6pIG5phl8q7e9/oVHuYaq
+N2JdvxZtxvjSZ+JKXZx/ecRTcRuxT0MnXq2Chuhe1Pg6WxCumGU9I16tjNBBP0Pcr/W0JUYvzu32ljyKP0NnS1axGJxsycbXfNtq
+bsknD852eW/pYVKH3F8kPCYaL+NlyL5iEQvv2OxHQTgzGBcbmL3Q0VYqM9vqGtTgXLtWy9XPF50vgpm+jVrNyLTCs6tZTRNuB5b5
+bd8hz54/H33Wf05o6IQJ8RO4Qdk3KavViI8oTvdlTCFKaZfL+DpCXUf9SiM9kR/oCZkuZT0HUd3K3Mxbyks86tIlDc21cqdxYU65
+e7Ox/dqmhXUjRzO5WaUwSd0cxEx65rit3jI2YGxuLNfaNFgs2DQW+82MK4Y3NzTbz8xl1ZJa4a+sgt+BzpLbuGu1zJ7bTFl8sxs/
+kfm69bqo2nfYJIYrrfkRqBuKraHfuFkuYg82dD2CNtKiRWITSJRvYgepubuerwWGz/9OmhdUPloXZvawIQ5RRsifHJ//NYsnEiOE
+v44Pv6ZYgx74ZvQBdy74hdM6p0WxZVHTxaQI5vOIxbZobNK8WON2Vq2H+TNNLZCqr8O6WK+7mQEwQuqZa3E5fPkgZsnkivGN21fu
+O0fjjF2ObMktGMBYoD3/joVxnrw5GcRi2BcN/A6b6QlnxRfqCCuAGcxcd3NEK8dRnIadSQaeC9144pk4O4T53XNmBAofebo6dB9v
+82rS02RJSqZLQyj6aOiwBqr6unGZDjBMNsBxdjCSvXnJvfTJSa2rZbyOZuOu48SlWx49f3Z2xo0cyQw7M0xarACkBvkxww/JGp//
+UNq5PE1s7l2RSHbvHmctca4B9j53rX3xxKsu0tDzDFS1mnYnApu2WGx7dtuVxOmuhBd0Ds38CT+mujv9W9VcW/xD43YZXSdTOWET
+Rmziff15ykVNdoF89STRLVnAvmJXo/e3OYTmoDUyIAa3ksA2A81Ms4ANVjwS5/rF9x9fBlm9Bwcuc1rOkyt9PNPKQoAZsgCwHASS
+n2KTTMPc5DaPqWws04pUu+R2ONs5uZ7LYOqunJ1lsMLKeHd+O6liscCz1tmtRCqf5As+vjrFHHnhpGTvO8ArF32Bkg8DX1amxWth
+MwHC6pIVhW9ANQt7nvC7xyq/EapX9PzDnLpdtpHfNfOvMMndc3YrlC3lm1kpqi1Z4o6Vcx9BWag4RvXysNuZrF01q6GXeZXs74FJ
+2Jsz0PKTqqns5/gHg1CixdEP2P27IJztMi6HHz4uN248vdZtGXHMM1hXF4E0MWlVU6+uK6L6w49Q/YAvi0t3saGh9kdoiAuGc+R6
+XNVWbPJ2q1ZXR7m8GbGCEUc60H6SPqWPnyZP30ue6snTQfL05Eny+PSp8pg+15KnP0ieVg4p9taLgoUbM5SUfxa6NzduWEaHck+S
+F5BTP/89L1anYTbHZuF8d2NriRdheXOFLJvbQxf8rhSloFwSSie7DqnX2r3GWm3DdNy9GpNuxkS8FRH+XngIqZcjDv+ahV78HXGU
+E8eWEdr0tVbbPoBbvtdqe47warVH9jw5TAM/Wt6wc3bjTYNFUNzYI+s0SJeYxVVZfxharYyWnl2vVmqVa2JuXOMy5U266UBc2Jtv
+OBTn9+obj8SFvXtu4b11w4i9DL2brJ1Q/nCPpsZyTiQHDsPsRqhhNHQr0MPUjVilbRs5euGa1Nygo1LVrNUIPhDH0a5YtdrGrU8e
+48xJhysT3MIQtIC9L/pDScqQRw4jpKCedxV3psKuTZxsze5lyNy3Xex7x8Y/JxsE+WkHg2nsLvbqIC3I9TEWojDxM7czHWsLL4cM
+he2eZJ6qSC1OcF1AmYBGHv2pn0WRZf9WQUnicMmQ+vTgHv12gYsWA3mMNm3mpNSdM/w0MnmPbf385bSME5gYxN7aIdGgZmk2Ukg+
+C4PlbTrJVEDIhNg71YFq23RZCDOVPOxYCbfeKqvlU2Lzvw75OINdkN2V8vyULFJB1d60oXprGELAS30aJ+LfmAt/E6qZcb9Aibvy
+tUyhV3OtUNGhXS1ncDIxtYSp1VKuLE5MBrmHL8TsqaSbcltY/Q1zUzPZ2hYsTut6DBe3jY829FnI5MKa62r5GpQtDknE1MgMyvqa
+tco2TZs5mmqnCzeKiLoo5mKvSGh2I44Po6zENrO4Ucm6keg1EJntSUbS0Wg0SEZAvoXXaEK+ZbfYCbaZwHYGPqOgRdWysB26QcHO
+pQsa0Ux/JsTsVtUN6QUxu6YUYYk0SkyzKGHiImhYPswuioRypRBVUrSYoiTbP7OrFrmA3fDHmzaKeCqFV8qvFAn6I8bQpAW4801m
+hlmmXxCT6sc9F+yxZOSloTD3Oc0cWj8C2rqagy9nkfnjrs5Ysbs1h8MakXdzK9xnxFlAI6kxilztHCXXAtSSY6rk5oyf/75iPIHN
+tmyJC/kpa9zwXXFuB5y2sHPVO9aZF3po0efTcCtNSITtxhNK1tduSbqkEXVCj4Pwxl28dEP3hsUsBE1oqHzOQmf4+qFTmohLJohm
+feN7VxHigoa0au0waTKnuA/CUmFSwLlzA+poMbdTbapzoQyhXjlCw10Rmm3XL2ND+gCOHNWhRLWnxbNwlRbw/YDda2QAGvz6RXWA
+OFL4qhTgxBYHYu2M9Hc6/PpbzrvqWKh7Zd/s5ndHcf4cJU57YfYo1bbFGVWc/cRut6w2ZV/p68QBxzaZwNnSl5oftgsT1S5hNL8w
+mRs3Wf4+OzK/bAvmRVryUI1guYrLcZxlMUwMcF4MEuOA8WgxpxgMyCa1IPFWQi2KfqmvIdMwJ06iHo9xG/E/S5jgC/iBeDn0Flil
+NzdAaRMqRjRzJxSak8KOaumoe4oJMaESo0y+SDA0DzhNzLMiRZuY2QQjn/AJ7Ic857aXP88qY1OQX/lFCp2bqjIwrbwoJDWKffOc
+ja2bInyr9FFdQJMzmQUPczlRXRbbtVr1tlYjVwwFgaGU/V2bsYOJdG+I6AbGHilFP2NxzELBndOtcQG0SAda9DjQ1PbP9e0fK+2T
+T4ote773wU1/JiNKydZhQjQTELy9RN0SpBxtA/bM6lIw+gw/MTzwnsO5/uYN5JQmzLlVgZuoKivBcHm78KZuDGtrHMgaTSqKO9Wm
+WbShiR5/ANQdlwximlFmTm7YtsjZuWH/l0d+kRFtnd9hsgLlYHXgYLePfibHOQu4nMp90OzRy1k3KulQIeS5MaczybmgYRo5c4WP
+7SZi0B/iZZuKP6dHlzSiCzrdsGXa7yiflUg8ELOb82WRXqPWOnu2X8PZXj34aia1kT/brnNn26zhi6E/5q5Lj7n5CD4l51wpcv6c
+uZnY16mHTFbaDD+UyFKVBd8HcE8ZlJJW3nnxdcWtxDILlCBmtwTHJfJ1scfznLgxDYK3bLZZlpML3GA8hO4726e8qO2taYwBHzZi
+QCOpsprVTZYgWgJ+ZE/Dj9LTcKeeyrgSOwqzqA8/oUZPAURj8Fnhc2oPzW41RAg0E0AVUZraChNpFU3r2wkdyeGg6A4RMs+PWXgb
+MmU29CMZaErdk4rTEFNPQ5rNsZ39ULihJftZiZKU+1JgmLZyAFlqQ/fYYiu+LI3WtLm7Bks87TPHnWXJxSmF9tS7S7p5vw9544l0
+AInrdVMYv6QfJ/FFrbYbqEoRPbR5JlcANxNUrABvGo1MhXg32NKyk/hCrN96jGoW0SJaC/eMzNiCxazCErfwHYd3weaxudbM/pxK
+rzD7k72HXFsMkl2gTK10WsngPC2IZyGJMuH3QfAakUTe8nLhTtnnwWLGQkK5vMOeXFA8buGayhYRU02E0n0NcKSuVgyuKmqUuWHk
+j+Em+fiwMy3woTigbrU7Yo/e59K8EkH49amKiM0Ca4+zW2Z2WxuXvLSNbmqAW0YPZrJ7VXIkkzBwmt0gcb/tBjvOPG8SXJjdkCNs
+57Hx0pFZb9ChbByjfQ5VXn7ty9LiI4lxq1rhtwS3H6jygdkSmshL12DgVZDhJSp53KXEptToFaLlGZ5pmmtvblTDlFw3YR2PspvE
+YkL5jTbhvnuDDoV74ivDJ0xaDc2MLfsH016xA0XEmFlKYWo8sn2pJlMYKSg9420ranb3QNwuHetloMnier1t/qzXmxTa/SRAa95s
+UVkxxe6fL0/VPDcErHe7GbtXlQH1SrW39qNP6+i4y+djkTvz/Up2YuTPnfpCa91pFGZUQQ6dtQwps7dnwmbzUHm2lOej5FkzMmVN
+bFU1qeuS2qE81Ea82Xq07BCuWq9IGY8G/myA393B11redJOjO/HZO24fB5yKki/Zu0o6OQpn9j/02bs/FBTsF3ZXxDR16/1mX4lk
+rmXC39RqJMadR5npqNy/bOik73AMq9GrQQhk7iRgUoTZyT4t2EUi9pzNY/DOP/dmKjeVMspUxKhM5YRvGKY9uUggzwy6EdDooxiB
+rsuOy1rzFn63HwevhO5KWYuh14fuYA1P2TZYfntZrALkb5y3bqDIPmhN4B62ba7fVMVRwTvKEMovU2uW8szv6hITVXt6+MhasOGV
+YrDX2sAjekao43t59hOWmNJhPF+TbtTONfIJk718BEw73HJgy/YamLFKfpn5n9Ey5Az8zW7X1HDjh91ss3ZwZJEkJi3uNjLecPNm
+nCWbcW3EZoxUYa7L9DKSEYNeOfU7Lq4S6bfuh2PBElfWBr2SiR5ltEBKKh5UdsRH9lJEI6SRadKgVsufQNKAWUun2V0+idJoKsud
+tuPRZImHuJ231ZHcITfX+sPx5FuhtkmW2jYzXnU2FOz8dh6CkvsAFaKEg1iZ1H+1qmqUIYLP87t9N5ny7wplWTBsI6SL1OJWy3j1
+Zms8tvkO6q7ispNn2VTPLgsMdXcfq6JZLnLunRe6cp1VDjmU8Os2KsG84mc3wu7inXsfVTAjxHp7y3vdUBR+qj8XYqvQGeexC52y
+IYHsyUbk17+qfLvz3P/geR47epIpndcx9cW8rvxaF1B/0wJa1ouSBTPtwwfxJm8jbyqDqZQXxcgx9UEisI1C1PAyC8UE4vyJs17P
+85eDg5yde0YGGBaP9DtJ1HNh10sNkTdJBXMRdVIDZxHfz3dvmLnNOIabST7//PwlBmLfehxcrTbX98y/cxfe7Pnn58/8tKxcdKtN
+iZ6CUUV+7KR9CmUw6h/llCrn5E4jtOWIY9Kq3navViuNSLELpeUYxG+EEoIo/hZJgX4sV4bI0TtnUQ9y7Cq/L9BdRJnKZ7Rig/xt
+9Dta9ahsQhEV6DiCscUGIQdCiaFNrtJy9askygJN5hP+KJ9QzSYkewSuSi75CCEZSz7xiKGS58bbtkD+x2LAZTbb+XGRluDMLGHa
+sucoO5Z69FottYHYPpW3zNEhNvAReSLTeDrUaptFNJAnK3HMUaR6fC1MMrO7YXrBrcE+oMTN3UK6o1hpv5HHOYBjUlXGJJcre2T+
+5BOdkvmjMTbfScKLbN24ahFV3FuVnfvOvBx5Q9+oT8OUAeY2WpdYQrkuoBhahWWiB1XSyFa9kisHJvHFatW0M1GSiedHsetPgRlw
+hwDdAatWI56P383esd3Mgoo4yvfw4xBRKeapn69PjGQebwanvPBp81uiJHmK9JwJywUNvKCBE9Pog0iMLp1JQCU3jC7owpmEF11u
+oWGUdTovznziNMV5AUXoSxntt1WrhU+cxWQhEg6si675EDlLHiwRp8k0eVvwvzSQKdTjfzHfbX4N8SZeWq3ZXSqWA8mEuS2bIFMK
+WhPTXKvFig7idCFtCTNmGcrKpZZ/5BjwENZzZ5l0B9EYO8vJ/CLBwfypJVQTRUzwLGCivZzMAcmxszMeoETrAmwf6fzAaa03ncwG
+gT/D61PdxceeinnICvbsPa1FkHKi20u/8HgT9hLpWeYwb+8jnyxpZmfBpGZIjJiGH+28paeH6TWbvhVyYxQR5xzFZMCRbfaw8no9
+bwauHVARlKdc76y3ilStYjn0eVigim3GqgUD2g1VoWFkuc2rrEHyat9pdv0nccGm1U9tWtOPE1/atOrbVvJtMmQtg6Fop+pL2xx9
+e2mBiS+NU9fSJzxxDq/V4kq6tGfMFUpNqPsRzgDuOhs996JY69UHdjdo+LirSXWKlA0aC5tHxuZOyA7jl+bnvCWVmHvr2HngPYUL
+fbAcv1VnrazIza73xE91LB7vg+v4E++i6xYOCAWS67mN0Lu6Lh4lcheV1WqGzCmJemuUP2WJyrSiQWCSAb02hZWaNCLON5xPT+LT
+2mGt5m62f0tifrnC/m1PgX+RYGPqmhTQ7bjrvB0cP8lJ6WbRJ9B89EAWhmfnfiCQMsaA4xRv7MBuDZfSASzSxXnoyQc2s+N8WIRu
+OSwRTZqWxwJJ47GY1lKv51OlBflIc00VG6eiejtpaK011ygueFqZRvURkYUyVty5gTLMjXbhcTeVKpZvd4Thki9OOP6OymYgf7SH
+SB3DazWjvT3+YKGD5kM+GEFBQSMlVVvUveF+NurhhaLt9ebGhjKK9V1RSf2xDtieZOPyDLRxrQGDUW9uROZDmcR3r5iDjwrjudCG
+bZl+lLAtkRq25bY0HMZ8r3AYTV1YCk91+1Zlsd2C2bnwFxV3t+R29A9SPHiz1waezpxtoWe0juDPfOeGC8o+wIEVwL1zZgVBE95A
+k9xv2tWKFKOykDdaZl3grdGWeDfRrvFuooauTuZ4veyR41oNN4JzXRNzJJKbqhm9M027pIadCuu65Sx2CLkzLXX7v9XR71xnqsKH
+29Da038rKpNddEJ8elw5rLtZN3Sl1Q3tEFjl+efnBQC33u7x6NbUWsx1zlm9t5vR/0eJ2KdVOOBV0HJtpm8ezyN21VnpShv3lNE3
+5v7ry0b3g0cIax7nLqB1JvOlM5kvDV738BQr+j3vLHAr8RDaVbKicQnyNxsuJPwf9hvPYnZTrqnbI0a4zt+v5D4Dc3uYV/b+Nghj
+Ym8K1rx/WOJnCzgFwi45CGPlivVEIZdIQDOfM1FqiXfzLYL27GYjaM9uNoI2DfwoJpmAu8/Ze2/qLtQiD54/DkJ0zVILJ7FAbV0o
+0PI2MVhnpgxG6ywtsGApiF6kgU/V820GX1H6JURtlASXyufKKCMLVWzgUriH+uDYGl3FuzQNjyHUE4W4AoUpa+TsJLdHy8hu/UpU
++ALdYibr7lrVRrOaXGTDysmDG2Wmfo/pfYCFibePhckPXTRKM4UNBx5xON7EZIEjYE9zjPX8rMmARisdzNMYo8oGwSgN7/OIZUCJ
+c1sMNZROii+8KPL8q3TZDanoHwQPrqJFZhBKvFe1eM+dgYlpSiOi5KhEPqgvVG+L9UM39NzLBZPdwYtLIy15CrrMyWSBNlN58qTA
+PEQpzHjR3W/pLqsn4RB+3hmwyLFKeIUk8Mi94/NbsoyS+0l3ukpPfglZFAehqJcJJzYh6lAvruIhAIXnXu7TQ+aTeq0S8vDSj4n/
+sPhczme0SMpdmrI5vKNU/icOgQIyf7VKJ7hPiWiwMktbTO6+Zl58zcLKgsWVIKyIaVMwKVVoz/Apy6I9f+2HkSf2uITYocMe2r+W
+UruiR9qNIZeFmQ41LBrq2Rx/SmneCMtDSBeDzewM4URZGmEteWQU6TAfRfpBHwEhzE/vIp7FFBeuavn8oqs/8uLrId9j8Pzb4ziv
+d4vj7KtxnFMp6KZ4tBvQDzpWzVwKeLDBNLSk9tIU7qWT/dRVjb8lQSt+wKYqREw65RUdhpcbg8IJv8MlrDnysJE/CE938ODcfI5a
+mqWx3XigtUJUSjtZ4xU9Og2d7QRiG7k+hc5CNBAUgiX6u4SQ3BMC/Uz38pHmRLREnwYYCLlkMc4odPfYKhYjxz8QUxNdrjjvsrSu
+mXTrnYOnr0snXaZbZbOuvNOZ1tLh7KnDmZnXUNTOyZt7msFXs6s+wdlbJpJlDyQXYqGupgZt+59Ln7P4mZ/dAJmU6Y2MtvAFGC2/
+HJsKsZZTU8HSrbialQaYzDMPb/+Q5dwsJ/iYZjm8Zm+bi59fzqdCGqSnQZ17XHEsHrnb6ir3VPqF7Z6v3jaa1wV/iAzWLsHaJlc8
+37TbVQllT3sIVXuxm0hZ9ND8wO7oDQDkXPXlpprcuSEkpGfdko7udaWZqhVOTR38nW4088u2tbpY4xsIcf99fy48oyR9nyKSSqQM
+4QdIGcJHSxk8VcpQ2S4d8D5AOrCphxDUHI/9+ThbZNfDSRAKv+GdRlJvKeUIcZ2AZU0LYvbCkqHUCWvGY07sZVXpD+2ZIomIbE8R
+X+puggSZ97gpQVhGGLvXiZJtOFHGYlroRm50cxvf79VJ3f6pWypnzNZvblTz7ANGJiLQphgtu6FNA0SJTPTZfLBwl5HmPvvKrheA
+pdds7cZPpNBdAF8QXeuALMdlyU0ZyKV0BOzNiUm1IWnCzUOQj1RTq+luLxcscONWVJncGmepYmd0lCfXxUx4GVWXIsY1E6MmQQJb
+RIwkElDN3Yi5OszSpTEdGSOkrETWMwx+dO19BOYzC7K8J/XIfxYzzh26ZR/k9tYv0YmUloupDpR30KFvl5L2IyR7c3DEbtJMhvr0
+7CM/Xgb3YioO7JZh1c3N7t7Y9MuwqQkfpUel+euZk1wulx8GlSL2JFhaosCjpWVCffwwOV0Lw6rfyY6DcNOgCvZKRTSw5HqXapMu
+NAOeDaGVLhtBqBnxPNGaRZ/UgtlQdnNiPvg7RJ5SBWy72bxZqlHfTrue5tos2BrI6qYUoiPeCnvnWq1ESSevoLidNC+684bngzep
+MW94s6JluyJ3Wa20WTLCltVKs8BvXcLHQfjMfzF/HgS3GX3cPAgPAGC5gGxVhAFF3cqdHNXcsemX7okE/XHZYhaNkA4Jk+aFQNZW
+JWfP+JZA1ots5eQB4bRpf0jbym5VFyQl6aTQ+WjtmVGspp0xqn1pUSVVZucPRFvdFBTyA6ecqqe6pupE22mWbSKPzLzblyiuH0nD
+pv1rp9/rj0a7ZWeSveER5Otvv2nn+kN4xYNycU4qai2hyDLGXbWov4sv5YZA70UqLeP+W8zUwPMhb/Kga3cfW1lk8Bok72IZ6u80
+FpoVvIS0f0P9h8Xt42Fg+1TS6nOpGkrwxpn4uxh33HyoXaa/WU4/22CXeWOa6/y6tF5zrwPHcfJBe7poXlR2UioucflQq6uV4ZUV
+Fi4C394B4e6jHxCuHndAuNtFvJoCUH5wuJIHh3TAcp4IC3m9bBBmDobUQ6eDqDz7M0UKBceHoo+CkvnFvJBZ7wQfe/7yw0UKU1FR
+IlhADlVuNFCrlQSrKl7grTUeiB1fHAjJ75C6j3L4Lt+XK328dqMX73ypEm5M3cUiuxot3Eu2OGcxDTMhGwuc7Wv/rR+8859Ddspb
+K4SgLCzfwjt6tSqjlo1NCltfOUJlMobCCJYIKM9C5r794GFGY5df0xjT3+DY0tjxP9r4pl/O0aR9l2HH4Sq10s2MZcmAf4XQa0d8
+QxjOrUs8B49Xbnb3oR1hUSuJp1oQq+WtO0rpp1Yr8bmALWVPv5jZBX3bzlqAHCYNvXAWLBO2Yzsj/98i4AfNMtQq0Ly7FNCLr/cW
+Av55FM6nQ1IqWM+MSqmgjs/LgRuxPWSv+eByaJSiVd4ycUbVyu/Q3aHgW1K2g7MVM0i/1ENgtdoEofYrB0O4Fvh7uxYUEJ+i1ChT
+Z/AsH7wmcT+hMoIvVQhqt4++o+XYXW1qGoAHXRtEgKuC0domHw4lLkKOEOFuDy7EiBoxi0Bc6akWHgWe8cVyEXu3IBXAYY6eCSjB
+dgDuExaWctEWl5BCJ8su7s+NnhGX6DZwXV2w2d6qx3Jthb/dZCM1wyyx6wodn3q4wQg/eIPhmRtH5iumGJRTggghNJQ7DV2VEAVE
+UFek9ThP5y16VpnmXg7/0WY/rA1C0FRJzQdvUSIG2uAWtrfl14KW677taeNKXgJeq+1eTXJzONgtXrnTeyE6AdxMuRgtKtFwiqAx
+ZeMG3B4nCI6zQvd414FiKazZf8iiOrsDv8QwN6ZMe8ktkOIH81YkaMkaSsNMaKn+S/Zu4fmsP49ZiMBs4ceP2pple1myEx6gaKxg
+nrHX8dbVLzFUv1/a6cal3CXaRbs1DOb9sKY8pk8sY/p49boMqAPsC2hOaBN2YmFAhTvfTy/0BDRtxKThJALmtNbua/0PsKbz97Gm
+w0GVx8biPZKFiyjQs3FbCGOFUgyf6m+PHkOhxf1mc5+8jpaXISYtAKSbuuH9h0/c8F6zARJtSmrL7wCRxpXzk4oOfoCioa5k0j2l
+bAZNueOXv1qFGw/BXwbY9ItQ1FI6/RVMwc6zZAsyZJfLqysWfqBBm7KT5vUpe6bd2FUBEHOT533R417vEq+ElLP41dpH/E+H/znh
+f6wm/3tq688aRedozH5s60JMCY7DHOEA2CsQmU2M/BdtWzbpFvJljoGZsM1bwW3bTCFRDPcyTtDY27I3URoqbFuzzRza28MTYPas
+03tOcpP3y+ciVDsXtysn38sUSkgxU6hAZ7lCQS573k4pkxsMTLLe/kFZxY+MDuDNswVUa7hMRiFCsrO3W2elM5kC4myYKZA/uGQK
+8A1PJn9uj5HNHt7nMof3JVnB5CCTtWBLnM3PbbkyJTaNEgp9srkzoo9NAQ+0k2qtzb9TYMANcRH0Ma4eFSghv7glMZRehsEiuFqy
+yCjc15fKhEv1RNTTywBo4GyQmxYvGJdNwQ6uXPVklZy6ix/U1uBsWBp2pugkt1OkiO5OYoGkV355p8KSPnkbuxQ8MiRFJt5uxieM
++jK8IOoRtAdPY8uWlN+ELdOcmLIk4qU+3iW6eNCdduIihitXUezbDsbVNG2WD622Wm3tUs8olNq1Y/aOLlD7t5F1fvp14Y+mS5bj
+8Hs+KqLtGZt7fuJO2tOmZkCgD7j4Q4xd5i9vWAjMHd7ehV4sn7kkfSm/rU07rWIS4rlKp8rROfeXekKVufbrzpi5EAWpD9LGs72Q
+MQJcSchMjWXBhhqSYigf0FVUkCgrWChRwfAImUlFGVzpTtslF+KqDcWmXUCa6jCNZ/RmN0yP56EMSV0IBA5BR+FHbBK7mkDUflkg
+airIJOKs3N+h+1GRVlCGWAgGHdmTi0I8XLYuCju0lwzHCf06D+vyVcnI7B9VOI1YU3M+qgzVNd/VL1qgJioLBiygjb6lmMDFQ0fJ
+1mbLPcMwUNkJ/phAML4uEEx5BJgYtVDZGMB+JuxvxqVX4ZzSM3SXnd8jw7lsQ5hfdMvQOpwkUEs1QDV/kw4EAyhWhrk9yqND0WVi
++1BN7hzM39+bF413o20GD6WS8dLFfCF4yT6i76wEXbusl7TSM5YO+CnsV1nJHiEFvazWbIhNndXzO9eL6e2OHvqQOb9tVd32wy73
+EShlVBGE5Lh25kmgV2ee8I7u0pkXuMVccglQ3jnJm9nVBArmhehdNvWR8ZPnavzkqx1CDHc3+SouN0m1l9QrCQa7LXTvrKSkc7ND
+mNw7XTDcTbFz/Z5+V5Fh1qqEIqLX9KpgYSeLlZYoEUEWAwbv4c26jffFj+N9vlONi7zPL+F9IVW4n2YaLkqnYcEEHAcrLp+JPtVz
+1GkJR817T/gZlpwPOt/bzIXt8jtrH8Ggp7sx6OmHMehpwqA9Z0rDj8OgU9DLai0y6NtN3NMz6dy5ldzz2rlNuafn3Ba4563CPUPn
+Nss9Z1ruefNRuOetyj3vPpR7epu4p0fDEu55vYVirktKOrMduOeNjnsuSyfkgsa9kjs/Ve6ZubF0rjFPZjnmmS9Q5vacrmu7BXze
+yZCEhk5RWSmugua9vWKcAX7lvjOY2YgW3pQZFj2wHmNMGNOwJ5RAsjvoDmxv0vhvxEYi5lTRkl7UjpxaBpHVBUcCvnySRL9RDJJC
+nVAVTg7y/jjM5TlhY5bMKrhCAwjQvyJVKUjxRJ1kGbEKp044K3g9nc8buuluoXlW5JIvprG7EKNmbqf81Wr3oDzC1PW5618t3SsG
+/DGpxzTtKl6YEkD7IHty/LJw2j9bugtYRGZSagRMOHP00V0O1c7q7nR6vUNxyVO1KTV1/J2H62SZI1nV0pDSZyyOWfgFi6+D2R7b
+kX1iYVkFyVNhWTDB3NBF2Ya4arG5zQTgzJ1x2PuhF99rAlcKTPOu5U1nNHDGdGdOhfNKQoyh7/TGjb8luLVwhmfw29uK3HMFubYs
+PWleZIRTipisVtuxyqyg77dx3BLDsG916Jq7DN2Wgf+NIa6ZRxxsId0wfjHfdLqB6Jx6I4KtVg3HNnM4UwPXaEM+PMiHunw4kA9V
++fBHSZ40U5Lr0+TBwaesgp85wrhSZEov0YY3Vfol47KJR5+9k4/R8paF8gU6mDzzO8XFG14+Lp7TwMibpGm528AeaybEG1P9eagv
+or1u9N0JH8GtclL3TyDIgcZYfrvPp9hWaqjOQLnx9gpKd7olcT/k7MhhHaQBeotDoJstGpvMbW2aGKiEpBFBxKm7atEF/Ezh51aM
+Uw6n+kAfD8tC6Fhvc+hYsaNzYw+vefPELYe8Oe2WJm8GsFrlQUsHR7UXrjZpEbpc0V6+V/YW8NVg5hv8iNJ77GI1usJcz6iIDUEZ
+5rUaMeTDJ+LBuNUxuG1IzgBpkKtcwHQSZRK2RDIybR6iIlxO4yBUym27DU7d9A7SGp5FeLqDsAJrJYjFTmMvl5+0f71c72q1654R
+OphK96ZOuoHNqGJ2dYcLjCOHUTxmAxTR46BQmzrPNZWJkv+JrlEeQPnDWs1tVwwTOETTpNUwlTykYjS13ci57ZVtIHJmc3ZZvjSL
+aDbcFG0174XAAXEcJ+Rw3WCdxKTL1cqYCuC9SO28R0nCardYW2fPisDHXsqSJq2WVq9OIcCXIcGqOk64WlUXq1UE99MlXge7Q6HM
+rfNbNvXcBceiSVlmgm+tKDHPVmo0bVEJDxme7UdZGGve/pDNYST4LQNLCnu86dZlLRvIU+46Jhc0dqQdhLXeNYp3zhW0yO1Z3oJJ
+BUUN2lmwXmJl/UCjoz0jSuYRsNWeXDZU5pmQ873ZRyGaFZ90S0QqWmcG7jIkDhGsVmvrlfY92HbYW3SWnhIiMhcc8X3M/Fmkj4+4
+Uzzb52wef+76s3Nvpoju+sDtB+5iIeJTBPkBQoRvEsoWfNpidbxUlQ4oPYINc+Ex2pyPM3C+U+J1v029oYSc23vUwo83at7+o1Z2
+Zs0NBQ+moR00HvN6i3O25tI6Ua7a7Kby1c2cQmeMWmYc8xgDzQISOIglfOZ8Gnq3sV5O/FvWDw5qST94J3HxzAaoLz/1nmy8nbEk
+BgOPY5RrLWNxrIn1qqoL4i29FBJrQ2wJS46R/CK/8u5iCMlSz5Uy/mCwrXYoDqNbFM96/hBvqRlvN3E2KVh3abn3iIbtLdtSpbLS
+3VJuONDzUjds0JcZzxwVeI3uqj9WuM8Id0WFTU8egA+62ISVEpywbdxnmmUGID+qm6eCvs2yMNuYGaqNbt3pfpwgg4FPiLmd0Lb4
+qPUjNF7NwVQSI/VDEVTs80YUldwst8EAfzNHLLt4dK8IMOJSVIEQHuQflJAl90qpKMvxYkN/eRe/FceHHk7dWJk56pRMuIEiXvL1
+U02D9FT6mBvF3Ok244ehixJS0mRhIuQmOS3hvB/coUehcJdz/n7Ml1Y3Tst5GNxIc6BQK6XrZUI7ZVqzlU/iMsxxGNxwH9VunvxD
+qqveXOdBphvpdP0ov/bCRDN8qp/u/B7ij8arYUNR9AjYYwvgb6h8wzULuYXV39DXb4e1Ferej7WJq6jNbmpnv2H2J1cV6qdwabQN
+f4tXZbVZvBI8i2TBXtRC/pawxyLASAGAwmH5I7ZebuyYgeMRPr7Vpmlv0w89vhcPOzCvjdSY41h6FtTNo4Cz7bzUWAksbph2/q65
+3E1zmswbMVTGzj7O+HN90YNumvy5Wx8K4o7iXuZx2OwvMleeewomDzdd1enLiELbXdjxGnb11nj/UbfGo++zehF8zlvcdzaKDHKu
+y7s7Im/GH+5osmss+IZBqDYdTeb4zG4c2ex+IAwi0svkQvicoAZ4V7n6wllsDgVHo/w+Mb8W73TGzF5NobgNb5mmhcm93DRNyoJt
+JPGqFr/Reb8pstPeVAAjHdGlmTEqXENF4DoZsdCZrmn2AkzVzLIrP1XER/RUMrEXFWiZd4X0z89HX72yK6Qem2u9i2tMyZs3TIhA
+SerkujZpLC7UdvyNsOxbr1jeVGml7A1LxCgAP04LRbftx07VkghTd48hmwbhDPucUbEoVfF5kA+lJXXmmVKJcYwS9hrwKkhFaaxQ
+X6KVy8OS3mCajg4zu3F4/yAGbY3xeaB9gU++J63VtOit1QzfyWQ0Urf03Ej4oPVcLG/8ZBTidUp4uWvweGVF8DH4zgNf6giYWQBN
+1QmQVqiETlB6j1eay7NG0PD8GXvvMBqoDoig9pyxCGXCPLRAkBugZF5qoOGYUzbwAq/iu36oN3ettLJuGVV4RarwzGQyYwJoSxYs
+/MjTiMYJV3MeshazNuEv/HZ7P4gr1+4dq7j+fWWOdoiV28QQkdCsQahNzpWyWI69d6fx4r4S+KxQnlC98WdSTTK33fBqCet+CtQl
+q7iVkEWxWl3RQsUmeBSpKCrvyo17n1ThV/DsUBGKfFqmh9+hnumURVEQEqowa7FiKBtom4i0ipcmVjy/8r1mRQkFCbUU/ehtkq5O
+lRiWp4pDaD4im02SlMolT6p8r6nkUzppk34F913Ym8Bf3PNhg+FS+qoURksJSWJqU2/e4IR586Yy99hiFlXckCF+0ASHzaCTAdJp
+ZcFVHBGh+ruObPK9ZmURBLeVOyGwV3GTIF4QpopJQsuiN9ok+aJWpgVzgQUrQmIQEapG3raJeKvg1rMSyU1pkk3GZUpzyvhNmswF
+4UJxkJO8z252z6v1TUgh+n7KPr5fSXw1oPPJpIOwQRU/8A+4y1M60SoLL0o7wIMspTULrq32lOvMRtHUvWUz1TPMJmLbl05sQJXr
++RXGc1em127oTjm3ERV9zt7zuuQVGTYRXyrX7L07Y1MP+AyvoRKJTISqd5CkZ9e08ILN44Nr159VIm+GuHCTXNnSSLabCoq7uqjm
+2pNtxYDuk4K5ja52vHnOr9jV6L1SeciulguQUiVHc0I1d7HbcI6reFFl5kVyBrhRxa0seLbFfeUyWPqziu/eMEILW2AdRGXhhm3y
+RRDCPtj1kcGIowYwIKBGz6/wU6dKO4VQoSmh+fxTxYVvFR6di5apXnRw5kMJprwZ91OVIKyI8IWyjfCe0A3hSWzyVWZF4lR9ySoL
+N4o1y18m+i8yve9/z/p+5RoGYBEyd3ZfuWTMF/yKzQjNWauJZYlFWZ7IbXMrt4JL49jNKql1HNXEybQJvqYcV1ne2J27AGzIZTjC
+scI6KjfBLKlwiGbwNuF/K+AK4leWfmIWWvESqW+uhoasQh6UgZDVDJVpMGM0YU5RZYpEBOTJEvxU3LgSB7eVBbtjCLDn48xyK5cQ
+KSrfBhCHTcbJcci9eVSvn39+rnKT9LkSB7pKovxyo6/zZRDFc++9TcRDxfOnIc6KT2dMPGWHvdgWjL/rb2okZLyNkH0rTch7Cmxy
+nhvLpF7Pny6WOEi44Chzv+jGZxN8SzYQusVbR1VJWKcsHMlSl+njLNnSpBMZaCPK1MaJ52Umx2NHO7skfh3h1Jkv42XIKqH4VsFl
+Ul9eXB9vEzmZH0HLr9jN7cKN2b7ojkW5CnfxjBqEppx29OI8w3iZP4Ouef7tMlbzpdqgTPaUXaiZ+fkvk9HHJDWTRGkmWwaXau5z
+hD2Tl3dHzSQxlMn2s6Ubebi9zguOint1TS6xlmkyP3v+fPRZ/zmh6h00kI8f8mYVDLdX+f73mt+HPLHwXGA7bATsyo1Y5z4l6zXN
+x8XTC4tA5iDiGJOm1WofHnWOT07dy+mMzQk/ob+YG3BWfh68YyG/p8BcJ+U9TXmlnLl+lCgocHzj1KSu4xuHYP3uG5YFokK9gAgP
+zjzCAbrpS4sipp60hSlhHLrTtyJoUhJykTtJOkw88ETsgyMMAxUBBUt8VnuWrXxG1ySZf7oMFxAWdPrWmVzo5FNJUKdiPOkHbNlO
+gaBp63YOGpq0bGcByUWpUqM+FeVhvKtMtKbpcNperrtMaTHTIAvmZZGysZWnjoJ5jYAnN6EyMBfuW0Nv7KhU2Ctmo1mkClXIq+A7
+j+T000G2/3WLlskPN3Xgo0Ce8bz9SMBHb73bc8+/WjCQ58lpUjBRpz6FG1BSnYQ6q9CzA70ClJYZ9Z0HvA3RfgAACnTMxZF2KbAH
+YLzmz+yH9VqqPVgwN8xuRkzH538DDpeDYMb6sYIYlNfV62kCdRsDeQpteDlHMdCtJcpItXvmg99g/sx5ZDestWBwDzdwnIJGbfBg
+WnhTZk/iOlPHy7qgoetfwYdc8iKY2v66K254CRLRrQUmoF6tZjUdZweU1GoZhNTrCbT66a7kDdfrj4+ePZCjRU05YsIioX8h2yjQ
+uWpsu4HM4aO8xYn3pkXjDyTz1kYyD3ck842UHZomkkm4K5nUre2EkvlcSjWJ3u6wBQDgDDs83hGGdD7yFKdFNeQXfzD5eRnya0ry
+Y/WWOglbCQGyAgHGa3mflqcoVVQUoeowk6CfTL+m3uzZl43OpSbN6Y/UMJXFCw50Ewuso9XbUhIq5URUnBf+o+bFj669mJ2DGAls
+Uup1DZ1unEi5QhRnlf/rYL7gsZLOJZhAvimfDH+32aTu/epOSw0wod0GGC2zZLCYNB0NwXQAAhpLS91D8BvxRVCgjc3lmbGxS2Op
+yUatdniEWMBWj6q7cZTVqtPaKWvL1PShvR1l7Z17AXB3mgq2wBm/enBAcvDh9M30Qpmx9UMdnIfb4TzcCc6S6BReNEaZhiru0AY7
+EhZGzF/eCHsgYUPJX7wb5YWH0UjDHUlLoCS2UTEeiS6k3SY4UP2BXEkC4McsnLtTJt5v3elb9yp5C707N07fghiZnnxfXi68qQSe
+B1HgLzzghp0aV+3ep2zMP80ZiIAACEM/rVYkEQJhQr4uoZHRIkQcwzheKi0BEPHmsmrPl0+zAJ/kzSsiJ1h7iQzzIHkUUVHgEUTs
+4lGETWHdTESrJFQKZIEJIZ+nbvosg6bAM95+IfMDRaV1Hsk6+YUaIhNOjLTWeJoU5zoG+QWt3sRLEpkFv8jQMEwN8yLa7Mg2xR9Z
+dRL0hWXiyWAViH35JqaCePNukrdcpC9pyCXRLS5iSmrhvpxpwRNZUA1fIzrKL59JgBP3yqRlraYsnIaB4p+3kC5Igl8Gnh/3S4Oh
+FPkt41ZlT52jo9Zpp1aLnzhHnbZ1umWJZ3ULC/pPIXurWav5T5yj4/Zh23yIHavZOvzEiA+wTrPuH2CeeufoqN1Zl4Rvgw1Loows
+CSlPloiI3qHdor7TlDe2det1L92n4hZltapmdxGfs/dD78qLjZIOKVsE0xQQou+u71idT/x6qBacKJy+fpHs0LjkswFGdwNRcyGC
+PHTza9+DsRrI8cr3OeNSUGgRDvncQZasCRdnlFtqZs4zuLiUdoOWICyDpqYJ4eBjwElcD9NrDYz4qWVZh5ZlrVZkDesn2xiwwcy0
+hhiT2DDyt3VdsTiVaW9wrhUd0exU4x13qqdwQIrNgtxMyjavWDwIYAF7n7kkPzeB+DR4RCU5kk0/v3RDdNXl24zMCUZtJrNbUQ8V
+RYQWIChzaEl5ioosGjvl48fMzE4oubEBqvWRcE9bQgxnWcfV3Xbtm0gps6MX4cr0U6eXlUUpgfv1cxIcsMT984aSOeFTBllivCXy
++jXQvF+rlQ0hniYMPzeXNoVxAST7JfO3bFg2EhCDqetvHDga12XQBjF6vhTPJ2MWO3EjWl5GcWg0qRzcA8ukf3EG8+UjxrLuKNEC
+s/MRg5u+Cobc1kazdpMmclTqO17KcjOEkYUUNY07L3c4ptUm9Z2TT/y6t2Gho6htUjVNT51mrfYxIdkOhGnSBxhd26eIODuvdoFB
+3cDYMjJy6juc6ZcAZ/Y28Gxbfsszcgj0YjDH4hd78GCgbenPKo3zfbN3aBMgSKBxv3dkkzjkO0Mf9piu2I/7Pctuw6Spy7qqqmAm
+I6PEFIX7Jgq4ErFRVAjGoDEsM1XVnIzc94AmM4xyHaL/GG0Z5TLbGGWvypJWHM2XMIRLNw7KlikpoCnhFIkHjjiEGuJk+EBswWFq
+tZwGkUvfwN8jw5FUb5sGsbPMqrGBWdVqmz7XrQvp5JmKWR1xp5TS5DrXZAHo4DZ7XaEpetoVf6n4OxF/L8RfW/ztib9/lG0o7xmU
+lY7wxUAB69Ck5OnTp45y4agUi9jZ9aNtUsiUxBNNH58+fSofnzxRUpPHTz7R1N/O1d8yKanVZJHVSj6pTcqnevJ0kDbi5GKXloQ4
+ffIkhVA+1ZISq+TpD5Kn7yVPSu+SJ+dp2s1iL1u2UUrrlDx56lTrB598r7b6g09TXh1zXq2OqmlmhcuYuPVEQfnUPxZTP/6Aqc+2
+T/3P2XthQFN2JiTdDevPPse+rhnXy49Hcu1GMbNgxLufcIpbwZ2Ww+3D0BHDwB3B/dggzfekHlOrY367A3Pm+W54v3FsqF8YHYM0
+gZzLyddcrYhF8BjWNf0dx8PfaTxU6YCxy5GQGluG0FytsjnEFk6Q3JYRLB1CH9jWtzp4qmGa1juKEBpKB8jSHV1eMgCBRnEfSZqk
+Xr6By64sH23zuBu5hKtVs5pSzOZNu/HBU3gzgWwv/zge4NMTU2yOw29vZ+ZFz27AutOL9eRUIqCxuuyJYobUrdd1MsoJw5g15ESu
+g6dEkc2I0Nnl5JKXWUnx/zp5KMyJL5c3LPSmmm5s2Wiyi24gPDSNTaNdOC+KHSElomlpG8pN3XEMuB2vW5F+GDOoCo1Pk6TbwPPj
+JFohIYi2BqqwUCjqbxD5bdhFNEmi2iPv5SD8WB2E4k40u2AL2TK5lKXPtpfOrCqygkBW8IIU5HN5bobRwrpcO7kzeSRBanUEbZjb
+G1wDpXc/bKpv4V+lQ7Xm442YwRm3sZrfIIxJTP8RBxZos/444qwjeddqhKtqV6uNNZn0w3ptfvujmzq1l4jxBQn++veU40Xgxob/
+LW9IuOrkw3gv+b4gsO+T73O+ymvVsNVI8tWfLYOY5c/4ctnCe+PD3B5WZ/uYG0xvbnh8OuLxBM/OOANevwYIPaB8Y0MFhY1Q3koy
+v7oVrVjIa1QpeiDN9DeKJfaxIRNiFE+IUZbEhl5tktFmbXXC+nYZbTeJxrHUyWg9swsiM8dxlptJOaw7y7UiCXlPbOW22LJKF5sq
+LUrLCt6T2O5ClcD4xA7rDoyCmhqK1DCTGovUOJN6KVIvM6lzkTrPpN6J1LtM6omQ6pziV2+zTDAfBgaoecNaWiBG9QrJrGQb8exM
+eepq5QOudDrTKeo3hKERAJwY6+w3LXg/sAKx7yNi3TCKarnNZ7QTwRJDsa32v112KN1jipyQEBorl7yIeeWQP8xPQv+CepAxcFrI
+0MoOXV0zM9lK+Bmvf2k+BI5FPX4HR7WpsLbfEd+3MoQHvaD1d/KSVrUBBnNZmp0JLroELrrcWZ2do5Xlzix0+a2w0KVgoT6xmZY7
+MC13YEXusDcTZnsx4YVKZ1Md81xy5ll1nGmP1Z2prU6uBTS3NDWM+HZDXY7j3H4wI2Z15zbHRpmWjTItG2UZNiqZIT8WLXvGh+0G
+d+yczo8QO0ZeN4lpl/LkPHX3Ht+anc69vSZUopz9FmcUFVNHAClPaZtlOvLqlLzWRDB6qynl2gWLCj9j9nkQmHQaBG/ZzGb0mrkz
+O6Sx6y1s7wOWBn/z0hCzKOYuiVqhHRMXB3TjROoPKm2U+xu+4zdCdrtwp8z49PXr5esHY9I8OHUP5v2D8UXdfL1evX69NCY8oXlw
+evFwuDY/vSq4NPLdbyJrgnugQdosTyieNASq1cJdZy/vk0m9Jw7YiB31dLsCz7TJ//snf0LWZtqPyevl8KTZPHi9HJ6NxxfwOuCv
+4/H44tMriiVMHuCJtwIWWUmIp31YjAByDVWJvkIMKVErDENar2JAplndeZENF6NoNJjpKQdv02OUfFXwRd0gP4oWbnRNVPP+8oVT
+HHuqVnab4M03Wo/FdQCKr83MZI9dmJleNLNtjIpOu2hywXbzKcg3aj62zaQ53yQXwjjPAHymcbTF+JkPYbq/IRMlbzN1n1qtHgsI
+TXSgVmqj0zLLaHG8cK9K7jfGDaf2COyXEutWWzZfv1XP+wpKS5zVKm3cRBMlVnf8BNncLLRU0Hlhmg+Z3W/eYELeklO0BgKIxObG
+QylQDMcnXHZeL0k3fKLUWq+HJsvKeEJVsCPsJ4AsyZKklWw+jPGll2cmWw5uGyxr86vGBsFKhkGlkaZzxJKIpPiyeSpWTULorRvH
+LPTtmM4ho+3TkF1J1+p0BcPo09/eKWrB3qu9zRgHmyrkrRTyxwOj7kmyUHULbL3M/nQnCR8ze8loZEyRDpvcovzQ4n+PTuFvmjk1
+oTFMu33KM7UPs5kyUjiotIPfP2SvC05PaQNZHYu0oCrAt6FBtqm20w7vl9U64vwUjpVyn5lu+Ca598SG8SKtW26G4VK0xLaXcdve
+bVaem0w0y8ZPi4gkfOH51PV9FjrLjx25EDkHXpT+sFZfJ9aFQ86CYMFcn2Q+tC4c8oT5s6fZ5PaFQ54pIUbUb4cXjoxNlv1wdOGQ
+L8EALpPawVQc2uyH4wuHpOjJfju5cIS4N5t+euHIndJIid6V6WrzwiFyvMnHxvCPP3/1xfORH3uxBwEiQeBsf598n7o3tzapEere
+BpFNvk/oVWyTp4T6l9GtTX7xx4R67P10YZNf/F1CpxgN6Rf/OaG3wdKf2eQX/wWh02UYMt8mv/gvCb3Hh/+K0Mvw7tINbfKLv0do
+xKZQ6r8mdHkDFf03hE6D23ub/OK/JTQIZ3Ob/OK/I3Th/mwZ2OQX/z2hfgAF/gdCo2vI9j8S4N02+cX/ROiNO4Vq/2dCZ5j0vxB6
+u1hGN9Du/0potLxt2eQXfx+f2jb5xT8g1J0uY2aTX/xvhN540xDa+N9hfQhdm/zi/4DE2Qwb/D+hhzMPYPyHWIFlk1/8I4Txxia/
++MeEhgLG/4vQeehOrUOb/OKfiGdo95/y5zak/zNCvZ8tWQQ1/wmh/avQhWh9f/oNoX0B0p/+FUL7Uy+c2uRP/yqh/dhbzCD5XyK0
+j7j603+Z0D6PY/Onf43Q/mjhweNfJ3QgIP3Tf4XQkaz6XyV0JKv+G4SORNX/GqEjXt2/TugzmfnfIPSZzPxvEvpMZP63CH3GM//b
+hI5efW6TP/3/EPqlBO3fIfSFrOFvEvpC1vC3CH0havh3CX0hs/97hL7gtf37hMbeDYts8qf/AaEv8CRgkz/9Dwn9Wtb3HxH6tazv
+bxP6tajvPyb0a17H3yH0JzLDf0Loq89ffPWlTf70PyU0+jnHzH9GqCur+2NCXZn77wIZ8Or+c0JdCd5/QajLq/4vCXUFov8rQl3G
+q/t7hE4lov9rQpms+r8hlMmq/1tCmaj6vyOU8er+e0I9mfl/INSTmf9HQj2R+X8i1OOZ/2dCWQzI+F8I9SVo/yuhgazh7xMayBr+
+AaGBqOF/IzSQ2f93QgNe2/9B6My78zD1/yQ0kJj+h4QuZYX/iNClrPAfE7oUFf5fhC55Jf+E0HuZ4Z/Chi8IfZv86T8j9J5n+BNC
+X3Bq/Od/k9CA4+uf/y1Cz6duGPg2+ed/TGgkn/8uoT/Bcv/8HxI694O5Tf6/f5NQ3uz//dcJFd34v/8Oof3F7bVrkz/7dwg9YzE8
+/U1CP4NrB23yZ38LoqguMPXfJXR0G3kLaODP/j1Cf59n/vcJHeHDf0Doq2ue9h8S+izAp/+I0B+4t7fw+LcJfe7eXM7g+T+GCIc2
++bO/Q+iX8Pc/IfTHnk3+7D8l9AXyDGjjPyP0JST+MaFfXQc2+bO/S+i5d4Vw/ReEvnKh5H9J6NcJVP8VoS+vocjfI3SAD/81oS8j
+ePhvoGZ2BWX/W0Jd0ef/ldBLDvLfJ/RK9PkfAK/jff7fCGVJ7f87oT/nmf8PQvnD/wlDxR//IaEe7/M/IvSt6PM/BjYr+vx/EXoD
+EP8TQn34+08JfQ+Q/TNCg6TPf0LorWeTX35DaAh9/uVfITSCPs9t8su/Kp5t8st/idAY+v/Lf5nQpYTwl3+N0Fvo9i//OqFTfPhX
+CL2F/v/yX4VWsP+//BsC6Oj+xia//Hd4Bdc2+eXfhMbvbPLLf59Q5sN69Ktv/iqh7IY/QpvXnkj/G4T+/J3/U3j8N+ARn/5NQhfh
+DTz9W4SGC3z6twn1ZzgjfvXN3yL0Rj7/u4QuIuTuv/rmPyQ0lM//EaHRpXj+24QuZuL57xAayuf/hNBL+fyfEjpzwZcSXv6Y0GHy
+8ncJvYQrVsmvvvnPCb1mi4WHoP89Qm/h4IxfYD0LPQj89qtv/j6hL+XzPwDwXNHGPwL45Ms/JjTAGBHkV9/8M1x/ov8/e3/a5Tiy
+JQaCZ+bLnFN/Yb6Q6CwmLGikE/Ql3EG34ER6RGRGZiz5YsmNzvSCk0YnMkiACRh8SSfrSK0q1aaWVNql1lJq7UurpV41o+2c7vf+
+yPslc65tMANA3zLyValmcnEaDAZbr127dleo6c/+FiAjOO1++d8CBpoHJ1Dkt/7QwWc0pAk0/VswUAq+yn75W3/XwSwJxrwMoMgZ
+nfAF+eVv/W8ANAkM4c//ZcAbIvmHcBqK5F+BIYvkX3XwVCb/moNHqgRU8ZAnfweqkEmoQiahCpmEKmTyrzl4EicBn7Pf/TP8zGaQ
+BCA4D1Oe/nMAEAvwW/zL3/1tB0fB8SyA9O84OEzDCJK/yykKkf49B0chJP4AJhq8QP/yd/8SHPYw1N/9Q6AGoiyF9F9x8Cw+C0Qr
+fxNGOw5HkP47/EuYv9/9ew4Oo4mo+e87OIDz45e/CwdPxGv+Z0A8QAJonoB/8i+AYuKpfwkf89r/Fd8BCd2Ch/8VdhXvzX8EQolX
++Xu/7WDYH/Dd78FwYJl+748cTL/PYIf88vf+oYNnPPMfO5gv9O/9ExjVse/88vdhn/Imf//POTiSmb/FX0PJ3//z/D1P/o6DY6Cn
+IP3X+fHCj+xf/v7f5EDKK4GKOcH0yz/4bQfPRpRD7l/4XQcnKv17Dp5NZjEf+1/4fQcn+uEPYFZ/8J1f/s3fd3C6CMa8+r/9Rw4e
+zbJjnv5HsDmChPGHfwKnWTDn6X8KgMjn4x/8cwcnMvkvrnV4dyc3dAk4nuvwiMeu10U4ht9N8Et3ZaQCXkseqYDf7nSUCxqRVmXE
+gmM6iRMdUaQqzrDm5g4c1wGDNOwMHOyEkYOVoTk2LbUxt8DHyiQdO9LuQpqhY2n4joVdPXaIg8HyAIPRAQZ7A8wtGzAYHGCwEsDc
+/gFz0wdpVoHBtgCDWQEGiwLM/21CHfA1rwEqgO/hBX/T4hXxGkQ1UAtUAnU42Kk72PlNh1tLYDCUwE4frEUcYZ8Bf+Ez3h3+B57g
+M8L/EGdoW4WVvSqc0HMhwKlmQon1Gxjp/FqOGZFcONor+JOA8rktC4jGC+YvBqfSbIIDRcsDnTXpcgGMrrTvgih3qhAZfg+igjEO
+sJWAW27Z+Zfa4rDY2hxaplrlAlvQmXo9UQZ162DTTXIfLDdpeOu6hrd5wxB1/9p217AYQYZvbRnwiUN5LKTlckun+1JObcXhN3aq
+te6+4xpllc6G2Mzl0gibeSLIiHhScRHNVzzwj+Cu4PRaB5eWS0uQ+SRWPIm1fgLB2WUdtE2cY8lJIUSgjBrTRRqNPI1yRdhINhUq
+3o9b4V/TLl9ws7m25ZFyNaOTyPDp9SqITugVXwP2BwMhkTC/fBaPrvhuFo/gq1k8kt8cZ5MJTTTCTmgwlmMOqjC1jESzxr2lNfui
+aMnm/YUKgFRgDGvHUaJH2i+LybmVM2x61XLzeExVC4AMT1o9tk9zdfdcXjtgQxXrXbdgSZzF76AzxCrpDeFkFKzrqK2djPWdj2ax
+btvxHcPRkKN0l1a9wjI3Gm4o1pFElevJC8ziEYlKC6fdk+mALGoIgsfOxxioEGjFWoMKj33q+zXe1tRrw+ua+YVyh6r8+Il4XkLa
+VjHDg3Kdw0bDgETrzOIhs9J+CRakKAMVBkDPlSctWRefq0x0aiZXLzZYkBlHj0NL9aAKILI2nzWctWk0Rque5b9OLelMLunAKj0s
+L21Q9HP3XlZgxgEmQBiEDJkRD29EsrYUDuEFydpcPNSbtbl4iFzKV/5Iyo0Wq1UJ3GbGCWS8S6fhhBnscj6xEMKEpKshWqFryFR+
+OOIQxzjAKc7wDI+A5tzyEF6QyN3eRXhC1Hlo4JRgsZhd4GnVq1Ewm+GxHRkKz0klQYxPK/NDmuIT9SaveRqkL88i/e0FuRzF0SQ8
+yRIeeaDewTQC5rl48vBZEjL5ZtWrDIWlJE8LlyF8wrvOoYqT6fToiEcq5tMtnn03IhfiOCVjcZTiuf0FvkBYFREFogEd9h0RsyY/
+Fngu/BHLy5APD2QAfzEbiidhw77CYVXncWh1X0hycWKMgmIL4cfiVQj5CE9kQd4E1s680ArhiA/3ZTSiECGRRjQ5OtJ9iSv7woEI
+NiXvS71yLk1jkl5uniNe4zqMuFikOG1uQngxfsTE4AOJJIN42Gs2YxQKBdewovPSUoI7AVVeCfgEJ4O43/G9oZ+00wXHMzH2kFRm
+SESFydoKxcWGL6UZ0XhlXthMH8ZqjipnBw4cc0L4MCumoBZKWxei10wOCe6L9KzGg626EfglYcTrsf2In7zxgLW8Yf7RgAlPsyEJ
+JYpF3L1SQkJ4BZ9IEBFkHo4l7S2vIcXWpTc3z58qMIOvTJP8Wtd+lwMdnO1mwc0rChoP3WFRY/u9TYzVfIxWK5ySyzgCLehoRP0Q
+x5OJH2M6D5kfrHDGX47cBIn3IzdEvMjIjZEoNXIDtMIzcupernCGMG0LF2wpYVXexoUyKO2P3RnyT12BDF2KcIZAXCkijaUkLQrt
+Nu7Vf612rzZlbJH6GxsnIZtmx0DtbsAhNYqziNGk9Wkwerfx6euXL1qfB2w0hS++S+OotYCnFoSuoOft79LaKU2Eq3+v7bV3oZg7
+QrVux9usfRoHo2k4r31JU0YTePX86ZsaAFKU0l+r3dv4NYFiw4lrDk+la8wFNKR8AkrYVnfbTMUnkBdcRVWLJxlUQTzIoA2+Ipz5
+XZW/kRvHl5osXPfIml3Ce/ca3APxkIuv3QgtlzT3NaJsZXPbS0XaJtwHmyZtk/2wlzSbsF/rzAX1FxwNkiHKP9TWl8Lj9AXYFQUk
+VhWEE/fCpShvOqhss5fsBzdsqMJnnhmyUwFai2tN5FqTG4CJipm/6aA+9WmuffiboGD4mx3HUEg83OB5nmNEa0hshwVgIH2mRgzb
+j4mRnA3YsB0ff2euEORZYR8wy6sCD3gJoe34mPtqSbRFbbSf9CJRqfF2EA35fj6G+AiGGWWhiHrIm41/dLPqwWyWXwzMWuTRE2HP
+mLtAtM3aWSRLusbb1KXr9o4Ce9ka7HIR7tblSbF5wskF94pV3G2m/z8bgGrUAKDMPPsDETs4kUarWT8ioZthhnw344j3HeCtM3UV
+QDjS21CQAYAQJckE48Lcq7teMIYjHsGXk1M9QU4bVM2MOxq0FLxGMxokb8I5jTPmio+RqiSlTL0ZoVXPGHtdHbBnYTSOzxoNVyTa
+wXj8+FSGi6YRTfrrXrjOPM5Smi0cvEB4baF39OK6IryecXwWXVvT9YVGU7gTQRnku+N4xE+5tko8Fj5p2wFjwWjKP3WdODIHctNv
+8nHdqhU9glu0c+tvjEnIXaS3+XFHUxLgqC22DaE4yndcEc5wJWTFbgYE9U1AKaHz+JRWQ1PFu0qAqixXgqn1tRUhZl19qtxVUDOm
+t4eawjc3gJqqVq6HgHI7ekQIEE6OfwVjFKIic9VM8CivMd2s6NcFjoCzCsR/Noj0ISaBCaxgIVvqOo9cJrAcVgUwVSCIHQflT9qp
+y8T4RL5T0f51hjY/0IGCwFuiegu4mWpk2mjkaQg5gsN8pCPOIUiwdCjLHZlemlx2g4ELJ4l0iCgeXJRr2AN1w4C6ueD0KkgJZiTQ
+Mo3e7AHp9Gatljg/FiQYzIZ4QuhgIfSnWeGm7y7Qcqlj2LDBYthoiMc6IZNGow7UymtwOiN5JZcgKRVgzZVyp37YdDacZuQu4Hqb
+KW/2vP0pr7FXumZNGg0heZk0GqWXU/Vy2h+5EzyFadNNIH9SJ2TaaLj1Dra7xGmlUp8k8yt1pwjCYgNfMVsuY00MqrnTjNUZTOC+
+Jh5nzaaay3gwG/botRO4XJr9Csbj9X2C4sBIMk7/RdWeoOaeAMi31GIj1OMkNriL3doVzpbvG1Rr1Gyucg8luqWJ4lqp1vgdGmA6
+IB2c5h5Wg/20B4YFbBAMcdBsamDMSAJbYbpcgpozyTixxW1JEB4RiifEw1Myk/XgsWLb9vguCMlsMBniSAEcIWTcaLj6YTQIh/0x
+mckbdAdPUPu7OIx4/f6EkGnLazTcsewEwhpux5Llyg1sRJwUHLQ8TPEYITxpNrFuJGw0Jg/IFBg4fM3OB0k7XgzV5XyEQwyknLZI
+fe2OxIXGaUmjezJS86RsMaJGo74ADJAH7D9wnccqeBwPuJmGJxEd146DlLa8Ti2MGD2hSU3go9o8eAceBtiU8q8TOqEJjUZ0LArw
+FwHcuGtUIGRhkAPZP9AkbkG14xq/YjjYefn541cP3zx9+eLo84dvPjmSYeuOHr569fDro6cvHj3+yuHTI+aRR0PPDbFC7HU4N15M
+kxgeh2rO8IkXjUb4QE2BPeI3U1pLZVxc2Zva87ev39RevHwDEQ9POP8yEdFm+Vj5dRPCD8ph8RiIerDWUL54+Ozt46OXb98cvXxy
+9NHLty8evS6OQi7p8VVLqkWeIdzM6iS0LmacbxQaVzMP7mEb5tXsNzv8auYAYJmAdHRVqyMO3JrpHOebcmpa3g0060ucHxE/yYwD
+dCxKG3dPZQidBwYndKU3OJ9OVDoDEhgo3HcJg/tuz5BwEXmbZ32L6Qw8VdfgTBODl4xh+SPjPjUHlBZO8p1NteMkzocUi6vxPziw
+L54JNJd7wRgoEAVzFyRduROmnr6M65ZPXckbFFtW1qopSDAylMNbLvkhZ8HvywWV4cxViFAVHt2CxBcv3xw9fHH08qNPHx+8cXCE
+Gd9CE7d+NGAAA+tq/Y148Rt5BGDZRhzx0KKxKpXWVDTJMKq9enLQ2tnrdK32X35+9PTFFw+fPX1kNq4YOflgBZZc1xd4We5NoINs
+lrBIuUlAvaKVAstDZioSyurDh+v7ULQ3dDacD2/SD6DFT4VboHa84OERFhfqETwxl6cGTELXTg28LE/NIqEpYF4X5DXhiMd0DaPa
+b0Dbv1GDeLu/Ae3+hrGUyJrGJ69ePj969fhnb5++elzov0SvsvuKtMlzGE2ZekTG+SkJ2rUj4W9vPpRgPP4NXPsN2b4cFLS9flAC
+Lb+XUc0VfY7+2Mdz8PAF7PKDly/ePHz64ujti0ePnzx9YY8v4QIFMUY+gktBr0vgz0kjRQ4FJCznQk0xsCibXqPBE/bgD4KIj5Am
+EKu8FshR5f3nAbantDamaZhASPGATcu7Vw7o4SM9hFz3p7wwktzPM45OaL5S/EbDB8ntGa/qLnTM6mnA+wcUAKuNYyqWj+uClvv8
+9sWrx69fPvvi4UfPHpd7fdWWvxRSe06P864Lglxse0mMiz0E0gaq6EbqDtKhXF3wLnBVf7hQOgrm9Bbjh9ZvOgMcWVTOQH7anUiu
+ZnIhOe7FncPlEbVU+rLQoe+DSNNXrx//7O3jFweP1aHGaURhsMoQI+v5nQzlUk8Q6zBMcb0jhX0Gj7cX5TorcLkv0OncwYxpSg9c
+31quB1k70PzlnhgaXXGu/4Ul59GSh5w/ngupqNbtEv1hVn8G0ZA4TjPKneqHEykhar+jF6lq38gC3qxoRAaY1LQWRbSK1mKCRExM
+z/1H5DIYj31b4AQEjqGVtsJiJ/qVbhpAtibrk8JTyMLRCssdfe1nhebg01JzyldDUt5OQpGOTVe9iRthTkkKdoo+nxKpoScy/PXg
+lCjMj+PqdmDvrFS/eXPxEGGeKHMo8i+sMvnVXHddIoNYzjdoRa2Eddst5kD0Tc3BjRpMdIMrDAeS2VyU66SJ/ZGvEFphaLywsPl7
+vrqrFT6uAi4tqJEiEoY7ZtWYrQG38nceGnSGPx7MjtrwI6b7qA0/Yi6OuH23GOlRG35W+Lw4InR5LrmtppKG4ZpCXCX18PJkcYcy
+cRuy+ggXjYrpUB5mL1e6nWjNvo+gVo5d+BoeVXQW2KMGRihPp2oOODfrwJxvwCHKtwa9FvgMBAPqXHwtr1yQCpF6SfxFCCnkXQW2
+NtCuVvgM+FDv1unQyuKay6zVPYFHTPPYhi7Cj6/Vw9XCMIqtWgkzK+pRQ3QRYC1iJBkGNdAIDnVKhAztde814WdNO0z5b996qpo/
+oezcaCiRe37zNeKqc80wMgGeczxfBAklIvzRAVmjBaA1Y9ClCWlKM3cuXH2ocB5AvlhxeohUQtYki3byl1BKYjU9Y+6sFrOVy/WX
+Yao+TeOIUxs8hxxgmh/y5DR/ouRk5UbgaJVcrpDBwgMuhRhuJH4x0zMeqRQ2BLjEEC1hlq9IpJOY6WmLVAqzvCeRTua5cZJnxwlm
+xYFFhQxB7yikgLkzljtYd0vpMIlwwiUBYlquUzi0VQM0YClWVYgr8Thrs/jtYkGTgyAFMThaiUN7o+W20cZJL9elSe7SfujmPYix
+M09bDlJNRO79rT044je+bc3T1sYd28oHU3frFOwNREwrztaphy7EjHNDiLrDCwtlf/YCnEH6PHRiEEapA2i7T9vqGThc9breaY+k
+AOzzOA2h3UajXne9ncbaAkARmwPd3kN3nkoBT9S4IroWQnEpynlmmqsBjipUPUYuuIF16x7CCtXoV0y96YipYy2PH2bLpfygLK8S
+WIVS9WXx2EOGWyvRZcOBDFAPAitRg+Jf5bcFMTiGsNDOYVw7B1RyCHeCo6Nxm6osGhTqoLRcZiSCBU55GMAVErPL4aDRqLtOStnT
+iNEEwrKGkShTnDLajuIxfXOxgMYq1kTMjiNm0QkZnYuqLDUYc6+gfrGWPlUqhD4Agz+gw5VQgo/c+yZMxXeCqTmf91FOeDQabOAN
+2yx+Fp9ppFBUFhLrM+vNlssMFl4S+lAzjkGokoJXVuBlgLpJGEU0AYcVJB54wyZtxoNuflMakXjQGfZGrRbEkYvasyBlB9NwNhaX
+e/Nz2hOSOEDqShadfnTxJjgBfXfXSUdJuACv9Qst5XWZ7CIO3AVqT+LkccBjNuWk4UQe1kC3cx9S4WwM2CFFPaM3PRRJmo0/usar
+nNqSGmiR290CIS3s+y7YAkFiC6SjsGZ4RkBdOHqb0kcvn/e1kF2w1uWwXGccnjoitBwekY1vD9N7++7hWROZaDK8C0oOlsuYz8ik
+SKQCzLqUOPcchNPKl4GxGs49ETfV2Z+F0bvaxgPHd/adJm06D/Y3xK+DU1AFrgftSZio2eJ5/cmADvnoNI6EOYvFDAFv7FYzlHJF
+JzLw8If7KZ3REatxK5bFjBIRAO3Bh9jZ3xDvHjhDPIPCzj7XYgeTw/0NmRziERls6lf77DgeXzzYZ4kslTzY35B5+ScL2fTpSe18
+PotS4oCWqL+xcXZ21j7bbMfJyUa30+lspKcnqiunJ/DlhFw69xwfOtPfH4enohWeAH1cGvBX+/NgId7wxBAsKPxBt9RL1bNRPDtJ
+4kx+o5+MHs/oCY3GovJJSGfjlDJROn8acrcqc1FIoFJRRKWHmCUV3RATVZyjeMF4L/wMkmEc+RlYUfPUDKs++jPMv4TfSRwz+OWO
+MGeYjf0RZlN/tOoNnFGYjIByckazcPG54HKO6SR1sMNN8bl5LAguuKm8g51ZGKmfIPk4CcYht2Jy5kH6zsGOZJRKexFIxbOLk1il
+5NfwWTAzvk6EOChl8QKscOk5PLF0EUTOUGMbizyHDbGQ+wLIvR9JYNH2l1IRymKUqez+5bkPCisn9KuXk0lKGSjhrtXrSUdJPJs9
+oxOGL+RnX9/4szfxYuXz5grV5K9XP3qwOTXrtD7wHFQ4qCTt6g4etr4Zvh/yFZn0a4sTsAZZtyPo1x9BvuqzuE/b8VlEE0VKLpfU
+VzONI6KvBV+E9Gy5FBpmPYvwdStom6gNZ1nfAg6R55epo8oLaE7iKMlZ4SUcvgit3sNUNxqbhOQtGvO8+2PJ58ucXag+NThrZXbN
+cinZNcbFGfTXIPM2cCwZhZyqOIgjwBAwtBRsqkaFZ7nA0AvxHKaLWXAB8wuPJ5Q9KpSYh+dhxFMgBNMVwfLAZRKMOiP5tbR/FkUF
+Dc77ACRqAiltpSEeQnbBq4hJBVTJS+0JZcasvb6YH8ez1Fio4sxUCqaVUWdlnTB2uIz1Ym7fGcAFbRQw96oO8LuXJu9S0uml+0Fu
+KZtyDX83GQSDdDhcLkOViBqNSKQRv7hQ8UCY+DXuKDlnqLz6NvAJq6PRcQoeBMEbHjzTU5hmMhiacNRTwO55O6j9+IvHL9687pli
+hhBVHijS1jgEoyZKnDhymhQn+UULDhqDB2Z0QIgh4DusuzmgymBVPrlopV2iejduh1U2hNnVTTHZFoypW5dtCTkPZ6+4zlkSRyeG
+opCGWQf1ru8ULE9lt3B0TcdAKLVaIbPqdhwlNKVszeRqniTUoco2GqUsMDA1a01oWvRncbNqerl33Y5yuyrHKUGf7rMeFRq1+eiM
+cgM6HHSGQ8sPr36jKvF48OCrvneR372+UCHLGyL/duVxIac7RKtrtqPm+YZRyPhj6WarFK6qb5hQZ71jfBXf/CspJrGNUHBkf/yG
+njP3imrAjgW1WRLOCxYrWGs6iSto4IpHjje5NCMWZEsmMQxX2HwUz5W7iZF4fMvCWdqL3K0uchM8syEepk3yuBcVhnVOkqaO8JXq
+TCgdqzT4hHz16Al/XPXsLUSjcUUsbRwJ9mnsLsQyj+M56gEzVFeccH5132UkEVf2hEY44obhxAlYPHdw6kbY4e5w4A9DIoOFjN8b
+5C9D2KUkduFi8A4eBfOQtgPGkvA4VY/ThE4gHbWhIKGytjEVTIeQ3xTS7NiqNnCdbAEs5bGqOWrLDC6RfhQwEGfIuoKMTeMELjDz
+IJw5AtZw1A4ZnackdB0aseQCamrPg4VbYQdrUDmU0Hxe7Jmg1TNBEXZZPhNUxnBn5kzAY2EmGP8scJ00m88D6B9wCgKXs1b5JYnK
+kRtzlX+l50eVWmTHjzg/Xs0P41YDYCvBewfmJhGdOThfd1SCAAEdVkToqB2Owe/4GiDg2WLkGhSqlth60qsMvKCPsnA2hh7faq3n
+QRSchNHJ43Eo3M8WFx0S9mDvvPgn2dXLX54Dev0cULWScuFusJJIb2oS4ZmBD46mHBsdSJGcQXonfeDz+JIMGMXZbBx9yGqTMBrX
+khgUJyc1jhoQd0d/BXV+JX32x0WQ/QSk1x8ziXU1IXXdssTmrQscFvEkwhQVuP3SX0i8ALlxcfGAm7i520H6PItRgdYKxsCdAjGd
+4qmvW+zgysUOYBpDY1LEPJUXmne1Tefc6bi9vNfXwSoqwcUVDW6+onVQ7bqyQRxVNrl2FW/AwgGZUtCHXvrwhxR4Oo0iT2ct+2WD
+M3k2TjQDBgeAAtdufjD4hRUv3EoNqgkHOCW798JW0vJwRlxvfz9FLTCxyh488PCItO7jBYn6YcvzO3hCon7L88HGhQ5YcyFEGosm
+meCYTBvwdWsEn08fPCCtER41SdobPej0YtLd3rkXN8VXmH8xapFdQcIHJDa+jfW3Cf824N8GFd9KSIxRTLzWTINEzKOaKdZ//0Xw
+wvc2OvfcKe876gVN8hwUVBfxmdvFCcJxi8zk/VYVuhfcM8rErYT7SThLQkYrJhPHYjql2xuyey9uhS2YPhjWDEa1ICOY0AnpbgJe
+6xu1t7pbqGU+37+P/A6ekqTf8eOWh8ck6Xt+y8NzwvY7yyUMmjUa3gbb7/Q9v8MnkYlBBccp99+Svghe8MDqjBDibXT6bkpUJnyD
+AzJCvhuIr7h3S5cnZ/GJy9AGTz970UWY3XMzc8JaAUL7YIcUtFo4u0e6PEJM0Fw8IF5/spH5E3PqvNYCqsgekC580mzibAM+4eVH
+0CvZFVmBmxKX3ctaHjJrCeEDskC+mxJmvlhUFCQdhHrhA7Lbo4OoOR2S7vZ2I8XTJhnjdAOgCYcG7AX7++EyxbMmCXuzBx3zo0B8
+FIiPZvwj/ro1Hi6J1929N1/dCSOA5WSuDNGn/qUyj6er0gWN824sbqZltwEsMIn2pPItHCS1oDaaBWlaC9JaoBmATlEOq4Sii4RO
+wvPnwQJH8DTLTsIoxUnJ+ckDz7CWNB2p9M2HSn2fMjey4H5U3MFLLXYqW+yYLXaG/uWqF2oVOMGBrDKhjoLT8CRgcdLXqXaW0uTh
+CY2YVInW3sCO9BsS5aWWS6kkdPSO0sXbSEwdkLxtO2O5rHu4UJFyv3h0nMRnKU2eRpOYuB2cKVY3KjaNEK6XPlkuy3ntUZp+zpu2
+4mxDTU+Uwle9g+ue8KimevkZvZgkwHKEboyK3TDrl2m4meO1b78QPl7wVd2TlMqVLYBQ/soCQylsF4US8COcUNmCqZ4YwOkbo3gQ
+DB+Q67qtl8eubxCA5Eq6ojuaBinnRr/iZcLoRDZq0k5VtSAN0as8mlFheWQTc8qCRwELyKXdP//aeTdmyL96CfV6+FesFf5ufSH1
+Cttg71dtDmxPhV81P5rHHHAVznf0wnfE98rzYgVaKU1in+uMXLlSkk0n6n7NLmZwSwXMi0Wj5puqpguWeVIzmiv8gqZSB5/m2yhB
+KNesFfWCGryigm3dl8RQ4A+BnRrz01F5GevF+wF3TibNO9wOvshb4tYRg3iIKbaBCPXcDh7nBUOcLpdQEq1sK3/oZygsTGQs24oW
+KqrP1McZwlULu07feC1ENcH2OW+XoSGpxrnaa5rQVzaEFEMbgh7OZlcAEYDMClSBQe91dSdv28FVWre2OYhlfiGBB2RsvaSduxwk
+5gM/SZK26aAQULnD+wD6VcAnTNrKRSHgKlw9CooTQFDYcEFsXn1wouckajSoaxm5ItDNpC4DkpmthIdIuUYkln5CI/f+3ibi/ntS
+YLjC8xbYxifuTHiB7Hqgh0MS8Aow5bdVDwGF604RnvPnLsKnJHHnCJ/w502EL0jinpjyUN3ye/acbqiiXkoqyB8MsSaP/MvRNInn
+1L8MFgsaJECQ+TtbGI7r11zTxt/exCDifDxfTIM0TJV6JJQy84vPHNsUMw/iWZxA5nF8/oiOYqGU/BGYVEOuUgTxt7cwKHU85TES
+dsTD83is06/oggZMPZldgueDWbhQ6ZdJeBLqN6/DH3QdB/F8AR/qDPX7UZyMafKa+1e188wuyFKzsFjoy3DMpnbWy4yllNl59hBE
+nnoCElhNXT5NYka3d4rZ78JFORf8/JZzxfxv7+BJOGM08be7eBJH7AkNWJbQ15SxMDpJ/a37mJu5P5xAoa098fQR9/+tH59GaTjm
+j8IX7EGcRSx/fBLOZvnTx8Eif3iVzaj9JPplZYnRWlliZnVWmidfL4Iof9LlAIGE0Qlftq37K5wGkyAJ/cvJjJ77uxh+PuKwK9KP
+woTyrSefP07iM5l8MtPJ19MkjN7Jhy+TYOHv4mAWnkQHgluuHp8C+1c9vKazib+Lv8tSFk4u8pJi2XcxS4IoBQtDMy1ht5TzVTnr
+a38XA7UwCUb0izANj8MZSP938YIm4MGBhafUfipXLma8VPU3MIYonAdyZnT6EZ0FF1aGMX86E+CAL4BZMEtKtT1lVMFoFjHzDaf9
+jOfPZwHE52NW5ptwHkYnyuMuvMnxmeeZ+Mzz+EyNk3jxROwCz+O74DOaRGF04u9hoe70Ogq4Pobvddqekcej96VfVWd/Xcx+RFMW
+RmK4hVcHcZyM4Z1sogIpel6OFD3PQIrygc+sTEuM4nk2UvS8HCnKtFx7z8uRoucVkKLMUL8WUrTyzC6YSNHKElvS88pI0cqzhyDy
+1JNYCq8SKXpeFVL0vCqkWMoVyMfzcDoNFpRP75tpQtNpPBtD3O88+3mQ8Hkz82AYgAe9jkKqe3h6sZjSKOWANYvPnkYsVuknSTzn
+IGhg1F0T2+5a2HUXJ/QEdlESnMwBZXieRHJqmxjYdtdAtrsmrt0todrdEqbdLSHaXY1nd000u2th2V0LyXreCk/ChE7ic4ui2N61
+KIpdvgIPATE+C1Lmb+1iFhxzONze1bO31TXnZdues23Pmqdte1q2PXNetj1jYrY9Y6D2kzwdvdLcWFli3DorzZN8evSTKrfC3PpK
+njrejnHsyIccb3o7+cHj7eQnj7djHj3eTn72eDv24ePtmKePt2McP95O8fzxduQB5O0YJ1C3WzqCyllfVeR9DXkVp1C3ax1D9mNF
+C2LSy/V/A3n5UdTdK55FVo6eVDNXn0bdvYrjqLu39jzq7hUOpO5e1YnU3Vt7JMGrfEds7Zk7YqtjnUDeXjXJvWXnF581yVQmubf2
+qk6Xrb38dNkyT5etvfx0kWmJmuWT2SV9usi0XFH5xHf11l7hdJEZ6tc6Xaw8swvm6WJlaYKvdLpYefYQRJ564qeLnLri6bK1WXW6
+bG1WnS6lXDn/m+p02NyrJLk3t0xkt7ljITv1KJHd5o6F7PQjR3abOway29wxEJf9JPplZYnRWlliZnVWmic5stNPupx5GmxurXBI
+/Uub+DpLgoVAaiL9ZprE2clUPaoz1itRYZU0WCUFtpb+Wkd9ebc9sj3PXC7PPow8r+LUPknCsQomfCAns5D9Kj4r5T1MaFDKVM8P
+MxYX6oIssx54VrMNz+r3VXwm4pbLZ1GNlfUqPnscmR/YZe0no+SBBr/8U+MJRqTSMht2DOCJh2M4oAQk2EQFHZ8UAGnHAKQdG5B2
+LEDaKQHSThUg7VQB0s46QNpZA0g7OSDtGIC0YwCSvbW9HQuQ7I3u7ZQAaacakLYrAGm7CpC2C4C0XQak7QIgbRcAaVsC0nYBkLbL
+gLRtAdJ2DkjbFiBtFwFpuwhI2xYgbRuAtJ0D0vYKh3F6lAYTdcVve+YlXz4Z19S2l9Nb6uHJzHhQl/22l5Nc8GBf+NueSXTpR3Hp
+b3ula3/bUxf/tmde/c0ndT8v531Vlfk1z6xiAbQ9mwlgP1c1Iy8E5Ua+EWPLmQFtr0iC2VnWTFewBNpeBRVm5RbZAm2vQIdZOQZr
+oO2tpcT4uzuzB37VJ9P/nytwU66AfYT8KeQTtC2qQz8qXkHbu45b0Lbuxfnjx8HCeBI8g3b5amzn5WiixDlo57fjPC24B237frzb
+Lh/2QTRO4nDsXx7zpZZXknYXG8+KvrdzFYVv58obQyFTXjbsXHmTaHc5utcJcYCopxytqRx+gKiHJzPjQR4g6pEfIPBgHSA6Qxwg
++pEfIPBUOEAgS95e2vKWHOr+5I8CIxfyFJa1s5UoqZBdQJz6JT+uttpbpeOqIu+rqsyveWbFcQXZ5nFVeK5qRl7Syo18wzPz48p6
+UpOzVXVcWdn6uLIL5xO5tfa4st7x48rKyY8rK7s061vmcbW9Y3EO2lvW6QTPlbyD7R0rv/hsCZds3sH2TtUZtL1j8A7aprxueyc/
+hGRa7kv5ZPZJH0IyLRdYPgmW4E7hEJIZ6tc6hKw8swvmIWRlST7dTvkQsvLsIYg89cQPoVyqptakJFZrW5d8/agEa+0tC5/DsyVa
+089CttbeMrB3/iSka9aj5EFYefmuKQnYdF5qpIWITT/mRS0hW3uLo++j0TSxRcreGiGyVxAie1VCZK9KiFxJGe2Y1NCOQQ3tWNTQ
+ToEa2jGooR2LGtoxqKGdIjUkM9SvLTguUUM7ZWpop0wN7VRQQzsV1JCVp56E4FgcoUfZSPGcvfaWcYzpR4PvrLIE51k9PZmZT4r7
+rJ4F/xmebA60zpE8aP0suNDwWORDQ54i60w8XnjUFGrVKVOV+7XIrThnPM8+WEoZlW0pKrLqrPE8E4cXHsVpU8izp7983nhe5YHj
+eVecOJ5XOnI8r/rM8bwrDh3x0rwktbfsa9K6g6b0ppxjTmNpY/Ns43pjnS36MZ8g65LT3ipec9pb5kWnvWVfddpb5mWnvVW67sis
+PFW48rS3ypee9lbFtae9VXHxaW9VXX3aW1WXHys3f5YXIDmV1hWovaVOJJ7ObxtrziexV0y2ZuGE8rzCEeV5+kAwwM84pDzPOqU8
+zzpvis8mABQOqkKmMYEjzQC1zyrj2Sht3zXguIoXR/MwCiE6tGl3dlONLNs6WaicSV9alnpqQJKibmtKEkMzNSNJQeHajAJADB9v
+LPfOP0riNG1NgjF1HfSAh1NxhEYXD24CTuVA+Kge5NEsH11HssvUa6Efw5/Aydy+11HBGdwOjg0Fxjx6Rt6BQ3fjBKdNu0uYQUjO
+H6G1ltvwbe0iK7zuDa0PwI3ZT7eqWXlVZ9aqjsqrKrrtjLIkjcETHdgt1oMBG4LRtRCeQ27YaKillE9yeeSTWFh46Ku6CCG00YAY
+rdzOWtYlguRk+92t5VLVqPI279vLDnl7FtSIL1HfhoBZk2GGR0iaGPjVb/+4Fx7MyU6S4Ji7XkmC42O4IYFHlJRcOj/E8bwVRg68
+FA9xxuBp1fuvDQsILJ9jgPXbGnb1Xtu7dluLGtWW1vX/ad/OaXl5Mmt5ZtXLI938yA0YyA1o7bV0v7vXaKQPuh1AvMaSLJfWeiEo
+ykvuFPah620TQtLl0tuBX1S9iBnfe7M/EXuP3z5ge4XRLIxoC55/6h1WgZFH1hIuSkuIJyRp2yr+sKruTO8wCjtruSys83W7EkYL
+H6JKbNwtY+Oudy1sZPtkp+2JoxyYpPrbrfaWPOCzkSjMLe2kPQH4rMOL5dK2z+DR4q1I7dixLoZXDNQ1qRBOZfGx9qP2l/T4Xcg+
+is9fJiGNGHFOacLCUTBz/IqX0zgJf4gjFswcbFSZ0FOapLRUp+4a0UX86tdRnMyDmYNwcXeWzTcqN9KoCfsYM7xAvQpXmuAfYQAW
+8UMizm6GVn8S9pyTLoIRbQVJnEVjx3fkVdvB8sUxZWeURtYbWPMWd1rk+I74lZmU1wF/sQNC9ZaedEd553S4tN185tveOY7PncLO
+V0/wih/CBpvA0WvI9QKdIo8gf/95MHrn5ByI/AWIbVKHexF1TJaEgx3Nf3Cwwy9QcoDA75BJwd6QD5xT4hR3A/gcNa3iUqScmWV/
+Ek+lil0b8EgMLqtASem+t1NESel+d+cGx9V9KGNdNOSlKi/idSuwVlqFtf6EH2obwv1o60R6EF0m3KGo8cwv7GF00iqV1G8K32z8
+xBRnDinpXchL7n+1lVJ2hzumubbL5RWU6bUkqe4Fp0qDptUtzHD6p5kwvdEqLiTXKz+1w9E7fuzBeX3VJq6c+6DJ/jRO63V3raDq
+rE9vSDSETfYn4o4ElMA8OP+EhidTxt2MBueCC1bv4DOVmOrXJp8MSodWWlcjbs7zMGop12BQwpkH53bGJJzNWsFpEM7AflPlMbuQ
+DNTQmszigKU/xb3gqqXGs0qiv/rQLEEEukyXSze1qIFZ2a9XFYhQCGPdE37zmYwfuIHdfn3wrYuG99y+f+iK5CFC/UOENk60e/q0
+0oPRqJzL54nZ95dGQ5I+dY4e3BE4x80RLMVhk6Kmm/Ud7DSZ74AjLogIPpJBm7HzvijczZ3tHwPYhsIDeMotKzHUO1gQhW+sksU8
+s/zz+Ae7sJVhlIQtIP1q/DhgvYP3FCs0kGG4HxqG+zGhg1AZ7htG5zEY7odDwtx4nbl+bJjrc++nM9LBC5JHUt9f9JrNGZKR4W03
+H9xtEMSqpzhCPdv3RoqnyyW8RKvUttAPhyQ1LPTHVZWKGseq/Bhht4ODvFDEIzMbxvIyYJnhBCbJncDk8bl+BCiH2jnZ/b1tgMnE
+jUWUiM1d21ycm4Mb5uLcHFyYi/9q4OdO1v/cY/duBzZpIjYdjJQHwkjcQIbBgJHOSOJmMEB47sIQE3cEA4TnLbCIT9wJ2MPD8zZY
+xCfuGOzh4XkHLOIT9xTs4eH5PsJHJHEvED7mz7sIn5PEPUb4jD/vIfyOJO4ZxASD/nQQfk0S9zHCB/zZQ/ghSdwDhB+RwUJNKZ7p
+1FSnTnTqSKfOdeqdTr3WqYc6NVepoeEwwMbxl7mBfapyDXBUlviPrOABdzvx0OWaU6tud4mhKwVH2r/YmjOstl7mQwsyn9V7on48
+r/sjTomB0zrj+L7lYMcZ9t7PPFuClngtUVgL100jbbL3Mz/W+Frz+AcxTgi3uV60gaukIb0/JhA0pRa3AT9DNkEN2cSfZrCzOZjh
+FXAH4ULfwzQI1n0+GMHM42Cmkiln6sknWQxyJNfMGRaYf3llBhdQ1lnISVvGl3YLZpV2A8P3cXtAl38KGeDxGu51zLnXoeJevw+w
++ePnPkO4i5+Gr/wrxZOpYtReixnf24nyXlGfcSKlf0J5pnc8tgrc0NucXDYLk9oszD/N59cVnEk5f3m78h1WhX7cefZ+6OtKDBr8
+CSX/fhK+X/Anme93d0e0bgfPTNacWkCahwMn9IZMOghyikMSKXZJsh/2ms1EsWUg7ClssDgPnJnxkOaiwAiukGnel0xE39R4Z1Rk
+4Y2QqmZB2CCDOIQdPCYqcGZvsj/uNZsTFLSzKJ2GE+bGGhON8HSwGEyGw+YIoRX0jAQGl09OQmRk2fFSBJt1DfqcVLFLxRyEwtte
+QOIbzmlbkvflrVTf4FC/5HGHxWEJnNW8yzB9NEfb4jS3UbZ08vdeOiN34xX9UaCV9+l5/IOg71LfjQaqi+AtcmEC5ZAEOBrw0hXv
+Uhz/OL5srD3nc76sweLhR4nB4uG+DjWL54+bDzsll6Im38CFz+MffIUPgfqD5bgT6XYnvllC9CoOcUjEmgFSHjjz1OHbX73H8l1q
+ZvFCWbGQyO9d68rRUNcPTF39rFpRP7GyC49C6zqp0M9PquxuklxjPzHU9ZNcVz8xFfUTW0s/yVX0E1M/P8mV85OCZr549hNDJ17q
+5CdFhfykpI2flFTxk7IeflJWwjez/CRXv08qjY2TKlvjpMrUOKm0NE6U9n5Sqa2fmKr6maWnn1lK+pmloR+Y6vmBoZsfmIr5QUkr
+Pyip5AclffxAK+MHpiZ+YJEZgaWDnwptocSwT0oKxklJbpmU5GZJiWmTlOTXtsS2RkpMU6TEsENKihfBBKt1zc2PkpLtUVI2PErK
+VkdJlclRYtkbJRXGRknR0igpmxklho1RUjQwSqqsi5IK06Kkwq4oWWtUlBQsipIqc6JkrS1RUvSokFgmq0nRn0Ja4U4hrfCmkK5x
+ppBW+1JIKw33k7LdflJhtp9o85kst9VPc1P9tGh6nxZ83IXaxV2Ye66JLcc1sem3Jq509BKX/bzEFW5e7Dw/1h5c7Hq0j5fYdvEi
+Hv3YdvASl/y7xKZ7F/1gFbQe8mK5a5fY9OwS545dYu3XJS5aNqWmxb6fmE8ad5edAiRllwBJhUOApOwOICnZ1CdVFvVJFQmUrLem
+T360vREnwWlb6vFJslar9QkCeB4fh+Bxm7a5L20mvwnjVJVXmkCivNTQUy/lo3wJgwrUK6Xjt7L8x2eIl1x7Nc6AQ20Ltfm3REt0
+20djyugI6Ocea18EQC1LXSgev66iYM5i+Tr4SBQ93Bh0Wnvt4b0NDJoMeXDNCHrJI8hWxAxWuiMp+C9n2oE7CTHLtUmI03KaoR3j
+qOm0nB4/dVea72MocJHEZUBznwr9zT4rKHSSRZCk9AnciF1dCvnVxZ5GzLXKx6n6AnsdaCZOK+s1ypn6X6wQqKLQ6AOjPs72LvTJ
+eI2wqeFZqldoDVa0sHnfrJhPmNYjvKZO3fb+9o/rXBrM0yw6+SiHtOr+4FIbW1vgQP69qBNs7+zB9fj2zDuufqCcuevrg3R4rZ/D
+OM0flJsVnbGYBhGL53mGcFyqH8/osfn98SwYvTumSXJh5AVj4wMW/kCj/JH3LsyMBk7D02A2NjqonMeKe09Kg3kc0Qv1GISzSZhO
+5eM8DakPVyM8T7l/OGcutL/1NIhfs2GVoyZG/PJ2ebMKd+oq4a+eCP5j9Fo+i46E1Hk/EihlH6hVbAE897c2bUVsautwAkOS2aZ7
+/LPNjq11zTNJUe2a9qOm805FznF8I/3Hy32VIcerzhOFz0WkEhzaoUVCHlokRNEgGYTDZpGjPyTRe5KUSq7Ir0y1VQy5KmYbDnDK
+Z6suz9QMDm2hq8Y57RmP4yljaz0Po3AS0nGNno/oQrD2RqMsSei4V8t4OB9ai+KoNVcFx/S0RqPTMIkjoHBrkzjhhSbZbFajUGtt
+TtM0OKG1IBrXgvGYUz3BrDals8Ukm9XOAk7xp21HqKDxXs7IwBjAEI9Ip2f21JCf/Hq6cYKNuCRymmeDUbM5XCGEMx40ljhPo9Mg
+CYOI1b4I4xknz5yVCDGWtQVgv4k/jxfEw9nqyoCD+asncTKH+DB34UtbMSRVoDO5EIoerDlN2nRqYVob00VCRwGjYz6RIxEF7ZjC
+sozboIWpQG+3w3W0QPlpzwCmNlyjgH+3begXtV+PpnQewFeemf3k4dNnrx8+eXz0+uCTx88fcoDeNAt8+vrli/zl5u598+XBy1eP
+zZc75stHj588fPvszZFdveftVhV68vbZM6PQltX3WRyMSch/CrkPZzP54uFsZrxLgwl9Jr5SyYq34mvjySgzzuag/Ag/hS8fiTcq
+abz9+uHzZ4/VhuJjtWbr+dMXT58/fLZ2qm80UevmKB0FEUlcB34d8wWnAeENT1ivRpyhxl/KpPU6GI8P4ihlSTZicQKl7BwH3VKW
+xgnwfLdjjqKibDYzRIEy2JkMIG5FIUMczccmmo97YZN4KCCA53FKXnOBhMsGwRBhp16H4y1op3Dfczu4C5oPAXFYcOJfBPNZO05O
+cLfT6fpOU5XqAiIhlM9NOKNj2EzPg0V7IiNyDYIhzhqN70SA26ydAqvm4SwMUppiHmM7JXbuIB0iHEHUNa1+GlXdgQCTw4QwQtss
+liOBaLYsfrtYqKsGpvsQzRFFxDl3cEK6Wp2X7pOd7e1N/iqDV1s6iGgdXm5197b2du5397atgIuw9mNaW8RhxGpnIZuGUS2oCclO
+bR5c1CT6OUlowCgg/SCqdc6fyH8c1IuI8xba25WXH+fw0GlGzedtIb53nY6Dk5YKFIWarBC2UQao5fiJysRy+bmIkRVGYxrJcKDz
+4Nz1MJV5y2VXRuhK34ULQPqzcEyo+ZQHLQRWzTN6SmfkeTtMX8QwzBOX5vkIQqUaz7JmWMfnAUg9jF5iKl6kyyVAr+pFnLDP6EVK
+qE7m7c/CSPATCM3Ty+VuR7yO4ld0Al+KRP5dFAPXO2DAtyPUeswLjWKYEH7TOyPUeswLhfPFLByFDAA6JcZoNKg/lSVEeXp+XfnH
+52Z5FpwQmA3xlNAU6CTHkfH/MygaMMo1y3kWHGeP8mz4NIeLoBBqDCckB6eagzUuaHk4gFZg7jRWSHsoJoak7TBycIhwyyOExH2X
+C3X5Zg+hmhT5ZhaOmx5k8x8lzm00oJI6IRGP+0oSHsI1Uvs5yHueip6rnRA5TavjCnjvMUOqmhXQo8SAHa65bq2bGmO0n/QiwHzh
+xA2LhQbREIewAvHslObMl3pHdrfu5U3PTIKVEPLRcgk/T/ISI6PEZncf7hB0n3hgGurteOp5e7u7t91o7HY3u8LAZLe7uSm8m2zf
+39zaUuUAQ202Gjvb3ft74jXk7OhqPW/L84z+LYzWR1zxQn/aaNA6IR+Ln2/Ez8/ED5MvE/n7mfj5Ia93clW99RnPgU8+FT9fW/Xc
+qM0fxM+X4ucT8fNM/ESyzFfi5wvx8734eSt+PhA/lOadnlZHvK5DsOs6xLFuedABPCY8vDLcnEbTIDmIx/QhczsIiaGZmWrXtDzE
+ZeaM36xi0unF+3pHxRDaD4zjiPVtjHB95AaauTeivTmZNxoLN5BWHVfUVa4MEUJeoBRiAU4bDTcj2XIZt8Yt70HSaDg12H10MG56
+QzwmhhXL+i7wGqaNRmUlilGXLpdZ36nxe/CgM2w0ogd7/RH1s/6M+hn1541GHc7ofkD91FiLca4JQQWxWBUyMXQ1LpC7fCXjfOvj
+ULx1PvyQc1nrNo5vNPiSxjky09vZ+dBpsqbzoSPZRxqzGEdlBHwhjvaMUwcOOl1GJMLINQrgrQ7CxnMr5noAy6VxPHJ1lOgBMbJ6
+6VnIRlN36jI804gOpxicUYyClNYC6su5YD2ekVLfGEx+1ftw4wTDjCA+Pl4000WXTnPuMt0Aap66gctwjJAoOdMlH1SVPHEZTlFe
+fKSKf+h82DxyGWp+6HzYUzd1i1AKgUZOw+MZFTdevxYKOqOWjoIZaJPwIKsIwknmkDI3sDvJIa2vaFXkO0A9wVnBX+VbcqjpxaZb
+T5ZLeQYZRbpDfTLRftJ3HN9pOb7ThJk7jJy8E6c5vqtqqE81kdzyIHqs/vCkdBLjkGy4h1ETuYNvD6PhPcRD+BvQrxSnzPNXK8BE
+AklF/chX7eOwPQtS9hSKkwhfuHlnIgRbxgWuqO52B1xl6WnsJSRs03MKSLynYskmAw80KZJBd9hTc54NOkMcN0nadIPlMlouHZ7L
+5ww62LxwM6ArAhIp3BDn03CRqzMq/pvuQoXamJil2uDb2pDPDnCnOhjCw2fEcXqR2eeURGKqcNqKH4BueUKCB3E/8FOcNfm4m2pG
+Yh7hnyRAmwT5fUIWw3pFeT1QSdYk+bcBalq1BU0P+WYJ4KGIpGcA8JEVJpifPzHQXAHp9IIcswfNJmI2Ug8QZg84bbDDOY/bO5ve
+HmifF4o1PYQewNtup9GI9gmQDJt9N26S0PU63a17LmvxWlAzavFiTU42ABXWRL6bkJCzAeMmqSeNxshlqE8HwdBPlktAwahXXtFj
+dWWFUfE7qRgTBUqWr2SFRl8CNNdr8SVo9NU9XOcefiCeO4gN4iYBA1rXpsI5kNUchKCH4rgAhg2QzAEWz8QZOM246QyNTXteMGqG
+azN0MjM6KcjgqHQl5p1sejiCizFESu9AN5NGoyPd/wVNImhVJHvQaLzgBwWkbbqhHzRB6uXzn5oDpK89iEwNIlgunYE5hLPSLIub
+PwxjJoaBR9Y1P0LG5I9Kk58WLjj9D50PAYGqBUhhAWpg90BGQucygjDSYs3ifMHkOKU9KgCZ/LgPH6dqhE23sjnUBCy7ZpVlY0He
+mK4ONnSK1LTN9NpfOs2s6ayMiXtXsfY4wzM8wguYvImcvGlx8oCI6BBi3ELRlCfdnGZyVDO56ma5uJGjPzTyrJNR5dbmWcq5BUHt
+OI5nNIhqcVILNAvUQQbQTktAO4KRGTA6ymE0IFOA5IwAJwUr6A4EaEvonvFbZJ2IqWk0nL6jHpZLMdWNhvg1Fx7PNDxcsQdGABuO
+P1IgMtJrCp8bPVV9y6BjM3TTun1Rt19zjKoXTTLS4DJR4LJYLp1LE1ger9llcrKjPrWv837hxihPqNAMzR7L62VKQthAbtoOo5QF
+0Yi+nCyXPPz7mN/dYYR1+60Tc5i0XcrUVIl4UjOLy+91fVblgLylEAimIOpzRoPv9B0MTIZFQlMaiYMZsKLi1wx4sSFUJSk5rlKF
+nYHoWU3x3YdwiL8SzDyzQpQQ49FlOEM5N+27UnmcFXhq9X2nyfvQ/PBBjQUnNXkdT2rBCBjEKWerOR82s+aHjqAbP0Q9q9FBNuTt
+ruSqJyt1j1+V7/GvTVkQupSzlc00iokwAEkECGm5lMmOivYuJ0AhdR6A3STt9zs28c/EhykgJKLnVCAiPqP8HJDZ3L2AyAXkNOMs
+UmqwhDShGAHrlZOHKcLuFXs5Wy45m0ES9o0Ge8ARQEjqHgL2LC2wl4AHq3DtvYROjpxmqtdz1mhkjUa94hu+d0u5PCJ95agR4K46
+sVCynFS5tfquQOwiF7hSmUYQxGnIrjXlR8h3z4ziawo7IDySH+QovmL6VfcsFNh3z2/Xo+M79OjS7JG4+AxhPQO5tw2ereJWeT1r
+Q2WR2DigxlR7F0bjWjypwfEi6mRxDZqrOc0ArQxYaTTgoi7HBpfE1VqwMgYCu5fnNZ0H+VDy/ae33UGBcwfczZgMhJHHQ5fy3YgF
+Ky8uMe+YuQkWWTp1w0E8iIZDUH0q8kfpmVhKNzFI84clxK9EKoKTVULEFFA632JuSEwXMQhxPkGks8BzWyR7lYMVEzkUYdt7CEUo
+P9hp6WB/yL138K6KqgSJZ+2T9eId+BoEPKKCfPSPxOwzwpbLy1VP3DthomKX5RdOzVOHxYrgfI5wB1NJNvQjQefBxch3jDP1pcnI
+5U09b9NzRqOxeykY4f7TFRz4XB77nMtjEX6jxH6fKxHdUyXLe6WGu0hiFsN6aDEP/q78ztZ5wE/IHn5BvA7+iGx28TOyuYm/J5tb
++AeyuY3fks37+EuyuYu/IJt7+BOy1cUfk60t/CnZ2safke1d/BXZ6eKvyc4m/oDsbOFvyJ6Hf0b2NjGlZA8CvxCvu4kj+NnCCfxs
+45CSy1UvhDsucQ4POw4O6eA+Twc8vcvTxzy9x9OMpz3xQSQePP5wKh66/GEiHjb5Q8IfuqJiyh82t4bkw8ND50Nes/jm8FB+JL56
+IZ52RFNHoj/dTVH2mXoUZT+XTDJKBg4YF37tYOeCppASfx+/drATRw52XvI/LxzsQIL/xpDgf15CqQm4kXwp/j55AnYdlHg4paSL
+M0o28YySLTyiZLtXkCY/qhIkv7yLRoHXETwIbxN+C8JEWWZPFNns2kXidUWWy3W1BsYnW1uizJ4nfzflN12d2La/TrWAU+3IrV0t
+Nbjfp62tXd9lZLO7pHjv/j5hnFPgdbp91tq73/Q6fsuzZSX5NHR5j/td3/Pu89SWv8ub7+/6nWohR7nxllct7Nja5TU5hx3H3xPV
+O/8Px99T2ceO73k78oE5/l6e9MRU9jlS8Tz1xanje52ufJjAmy35kMAbTzz8Px1/UxaqOf6mKALXzi3ZiQ3H31O1HDr+fVn9//nb
+jr+3LdN/5Pj3Vd+ybqe76/i7HeN5z0Z2pphFyZElg7I9SeL5gbyuuBT5VdmcOdN0aYuzZR48AEVUwadReQ2v091EyJLAcOQthLyL
+jBEqRbXhjHJFHqaTQs6KTUEx04LiL0R+HH0pFIwIy9PmhzN6EowuCJOJXDL6XcpVReEnz5yFKaMRTQjTSbOy20pTmVAjqCwpVQxU
+L+Hs00eoyFQW1KSTS5PNNFfINzOeCqm5zBnHo2xOIwbC14I0Sa86HJs/uAzD71uX6qnn3PNFBgSf6oWUDRg5LZp3w1zjsVpjIORE
+eyW+OM1Xq9EwHsSVhM+4+BJZ3OwycyTl8qt9oTOYEtlvyc8ExIoTg0ZJS1QG7F83IKl5Nw8RAvy4TwLwna/Ek8slDMyh52DAQ8c1
+IQMATakafBuMGE2kklvtZ8IaFDRD+EdsynE7DeY1aQqdckW7RRJGgrjVVaRQh5SkN0m6KjDly+OH0X3fDlNBS7gRkh2VOmRzmpzQ
+2jxYLEANr8fV9xZJfBqO6biWcgMIRU6Hac0kuB2gzWw+k2Roa5ZBsJ/2ApjGmITAoflErB+Q3MulywbxUDDiEkjVO6jAWrfVGGFQ
+GZ71uHRbyklChJWqEDcEuFwhXKHJwwfpEEISVOXiDqYoIx08y8nxbH/Wy6DnYlZjsKZWZKrM0s9UYgk5ugiHaLms67HCk5SkwRW4
+tE/Af3/+hPni6CvAWC1N7R29AF4xA4d3MdYO2sNh7r7OZs6L45VpmLcEvKo5MA+A2evnWc2mzw971nfNTFHw6soajfwBePB8LEEN
+BlzjlhAAQ2qHOEqe2CQeNnAFyeuoYMwrkYNgTl3dnR7ca4Oe+KgXugHqocpvmk3zK8AYjcbmNr8dj+PL6z9ZnU3DGXU93l6j4W2K
+X/7I66uDATXiU8A3JCzQtd3HcbOJqYW6e5wICHrIzG428Q26KKBEyNlE1+JGw6xmP2o05hz46CQchTRiNcFJEcqyCMeWEEIrqBnr
+pXRJ3K1tuExWwl4EGgdbO3UCFzRWrwSpqOmhK152YUdFTbKJO1c3U485q7AgeUCXngR5hUaBpmIPPH7ZV3nfaw0duLKwlmfW8868
+YqeKBY8neIrHeI5PCG0DOwJfEFUhQMH82gV352i5DPhfDn/z5XJzV/xudeXzpvj1ulsisaNe7Mlfmb95X76Xz3tA+s1zTgroW+zI
+yrZ4Y4hrWFzZR5Axxm6Glsuo0QjcTIccqXscsMW4iSNk3w5WwxeinZEBK3hB6h7fofMeP523+UA11/ZWnRBmVoofsbmta0qvrqnF
+a0rNCgTvSRXQWhIcNTUaAPiy2bna0OHETeAJvpzI4nhqfofHxNxpGLBZ3QOpurW/HxCGLheg63IdpPSATAijjK7yTDKSlZGJTPC2
+ydRqg4ylTdpq0Wi4QDMB6AKH9MylsmRrgqoWC+GQQ6Y7IvZazG+KfWpme/W6Ao7lUsHNSQ4wF1CoIM1QTDXJ0toE1Sw3umaubgGh
+1nkXkthEbZ0btdVDvF+EEEFynnJ2nzGRwCa+ot/NZlXPO72QWKS20U2zk2IHJG6E+rLlmDcISythDoRR9siQfzW49/kpnkWasqWC
+zwqUorpEGDrCYXQyo7XvsxiKyklGvru2z6h3Rf2SKL6m9oLEw4ASHAC4cVjZgjkf/USwEpGwBCuj62HlkneMEDJCxgaJivBiNQXA
+MHH5XX+kQaz0yeha4MLgswVpqNDM3NF+d3un0QjpYDREasBNEsOz1RP9hTsjGdT1oIOkct+Mq4HEDzq9uNVCN+iLOyMpr4J0+gFx
+g/39LdSc+faNakrPgzEdhfNgZl+pdCdBw8/qo9gQEsDeRfFZVKPpKFjQWkq/z2g0olxVPazYQyO5hwCOKvaQ/dH73UPjODu+dg9Z
+za9usYeqa18vvhBSY4PCmcMJdSpVDYDUCaLRNE7wEWcMT1zOBnSn1261ADjNM1BSTUA6og5frwvk8zTfiQHnO8+g1YRcrrhxuRbY
+iLY55SaSIOhV6SFJEJ5eT/HDfp2K7ciXuIMZwtePQIjPlA8nY2341JBTrPrBzzWOSmZ9R17owLOlgsEcsSSwv+fyij4P05SOa6N4
+Pg9q0k9mDQSutVE8mwlfHjUasSSkqYPwgozIRMh3U8KVfzl5N+Uy0BsQVKE0VIGJtoaj54TDvSRwnnIS+GOuz9KBxpXejhpL/hm+
+biZxPVN3EbieLJfbu7AiPJ5CvVP9uY1A8rYK/Zro/iA86wNbIcFHeIFHeIL8tJ8I0RXk84lTr5Av34zQbcbBOeHTvju/WbeRPyd1
+7zabt7D6TlHsp/at0qj6Go8AEIB8wxPC8JQAcSmof3GDuI5ERgjF8IHanjtdLorXuzMmIPu8+rDkGyzltNHW5to27amRl5IUfc01
+MjPCv0z73/gfiHNBXM+4xLc2mgLbFGyTwGwpBEI3nISa4SbMndyAzIDWf0A6inQHlkYgkPNxAIavgj1rXnxr3EeiaOZ4Fo/eSZTZ
+q4XMMMSc0TQVZlBxRB3kL/rFTkYVtZo99d0JYc2gxVerw3XCQ+ju5Ti+TG/KguBf9NQdKEW3+baecI4kXyvEl1St26XmWdjsCHwd
+7PTc+mK5ND/aB9UezsVIS1yM9Ea8mfrC5ls8mDQa+t4lsoC4SRGaNpt6+Qt9uMwIId/01133R32vOfWnyIdiXzcaI4s1IHSXxTWK
+Ezx9mHa566+rEvlzXtJbW3IKmq9+h2OSQsM1B/n5Y+Ez5F/XNiCDDgcvjggs1o25+j10g6Uo052eDrlhqkM8KtET8qhIc9IhE7FF
+JNl908M9Qzi8CQswbDS2tmXE1OQmJ2EiL3KzdUchj+BpgRRhKBMnhrD+u7ZjinouHKifqYNL1pafXnnT11aOFb+ZH6bWZmFyjUOk
+sZ6JljiaU3QJpy0uHFPT0xgxkzhUrnhdKEwq9WVJ+KRYHwtlYgeUIVFBuSLXW9FkpwSXRQ4uEzAEnsKfuaB3TsWP1G47gt11flto
+4kyPG0DTTBCKNwCkVC1tZt7ed4C8nTUanMaZLZd1Dm/8iHrKt9SnEgRy9pJezVSg4mv7Gboz1EOzG+FTzneb3aAwjt2Zkh4FtbNp
+yCh34Z5fxkz2fi2YCMtgCqKLFveYUUvpIkgCFic5RSPOVEUUI3zUaHByjN808CkW22lOTolYXYgAU++INQ7gz1zCx2nOa9Vn/nlO
+ngNgjnLAXAChrQRhXO8yocGYn9JSRqv6VFsEYdIDIiOexREMUdDlYNLzIxqyBy62GrTC3dZzick7emHaWptd48Ig0XyNE/mzvnvz
+iQPJSb2D/KO+q6axI4U1YQQCaC5b0sRQcR6gXxGns9RU9EChexLPZvEZHdeOL2oBF1+y4DibcSEWnS/YBZcDOdYFnXh4RriBuwHj
+FRjLFcjxK0CAATwf9fPl9k8MGv9oubRm4QSwiD0NBiK9biMV+LNM4M7ZFbjTWs9rUKfiih41GuWFO9fo1AImhU7Vhsmx6QTh8xyZ
+PrdcCPBTt+5xu1BOHW4KZb8bc8MMBcJcg1KLKfXoQal5IbXTnDXnlI1Tdrhaf98FGLxBeeRz+UfYFzfDiIA/hxt9B0UdbEoXccBp
+6/Cm9LEkJfgFiDvqUQW0uVPfTUoqBuZgb9LPW1wGT2lyHLBwDtPuGNa1iuipw52+h8SMwd1e3ElgkdJsMgnP1f1FKh2A459ZIBx2
+1uZB8i51pBm8NaSWh+1zLqJCn0HrFkTBnI45MEyDaDyjxXbSbDS1VBowX01myxVuMl+9qyecyZ4lWtNi7dj5zTqMuIVBnFi9U/s0
+aTTqSVWNMN5rxujXnGaCcNAXezrxP1Hq9Sw4AUWfCMlXKmcQDZuJ73B3JZF859QdnmdnVrkuSTggfZhFYzqaBYm9HM6HzQgsWhE2
+CfU3BsrgSGL3DuKVErVViSiimnhtIovr5SEWgHAgjziQg+JBPQA7JxTdAGKKDDuhPaIB1+4gP+i4AZNa2IDVZjRIGVz0TT50jqWv
+gkhzwj8vuXnZAtziJreb8uQOE5fwiUvExCWoh5L3N3GzMEhvM2/RlRNmEOtl55wC33yYRZqRMpbt5yCuD0iT7I+GJhlgLsrTSn8K
+JgOceJyFhk9J4WBUOoGNRp7mUdAjB3PeiWF/I0FFPvEzXaZlb/lTTAKSkq/EPe4z+AFLOPMSqpgOJpXSnxLPN7O4usOUdHybCmk0
+XHAPgRAGjv2U60H1nnMJ9xtuhZw3JJkbKYl/bFu+okFSzm2eL5chwqCVMm00vhLcX3dBPhZD/hR++swH07kJqdYtFJ3vQ3XAapig
+5RJukhO8ACYiSDMWqH9K6h3fDRqNh/xZWDvB72vjPezHvgtJpVUmiR39JFZN3YFyQE+ncTYbc0p9GpwCrX6hUBvnzSMfNEYWmA8L
+Vq2iEUntOX1Q87rppVUTvpJhxKtOGw0xE2ZFvA2nri1bgL/h9B3VPrrMteDW+JfROnEgJSwWAgW5kfYvk3frUiXJqD1SbsLy93JT
+jODv7QetVBk4kZ0fqEJ1diC21XLpKDddzlA0h/q8/1eUG/Byw7xHosFGY8TL8ixISOxn2SFxgICX3B1ibjUkbP4421iCyzE/iUWV
+zQ8drMz/qMr5EFXNad+9Zk7vADymSqhskF/ixpSTmzXL+EnfDDndiXxLvgpkhlna8PJwNZ4czeKUckRpwuxyqZ/U5jvNkfUr8wQV
+Eg+DvtcMRar8JyscO5rS0TsItMBjVQg/UaD/jRXxBbwlY9JAssmF+tcKSxoN1zhY0msFX9bF8EEH1LY4/x31FKun3sHpHU74lJ/w
+KboRL3fCfUtddQwHwKFVHgX2PUXXiZACp1SQwPy8rxKImCyiqCYqcZAlXuiFN+1sLt24lWhETQoXDUjMwVW3UlMz88fNY6CYtmsn
+Eq1kpVycInFWSoH8T+kgGnIOYID8uaBrxJ7SKgL5fGv6ZhVOTIjraPWDp9IMlrO+r9Pbvb5Q07tZsS6y9IbJpkFoIX9WBJyUX3Dh
+slnQDX6q1dHEQFoe/koyRo0ay3u50aDyimavQobtbQoT7ACX6uHrg6dPDR3ltBYktBZGjCaLhHJOZlpT4cqgQbUaaZFDf7UOiFbx
+icOxu7Vzg0VZP4+V3IeW2pVllgH3t6ChKOfCWlOeo9XvpBWGUrOnCEt7SixMhZUDPKHyvM55mNSEXv/apVKYhrmjNVIoy823qfZE
+w8ed23MKWyGBusREQmUdR+hIR+W5jYyNiiJT9Eg8HJlMSV5pL8/aj3Sve+iVGxl2pBoY8tl7UnYi8x23Mu1ZXjbq2g+CgozYcHIS
+l5ycMDceJENjlV6YPqTEkkEL/F4sv+ZiBY8YGarj4CUpt6X+wc3VrLSWnYaXMDJgCdeOM1abxBls2zjhnq10lz4yPHRfNVKYou+L
+lrNfrjBoxYnpW/M2b+qZZan0Yk2F3EJImgx8L81xf1DmuG95hofwl8oc9wtloPvJtba3HxMPf0q6+DOyib8iW/hr4uEPSBd/Qzbx
+z8jG4PC802kdnnd2D887Hx2edw4OzzuPW4fn3pPD8/tPWofnu1uH57s7rcPzvSeH2ZMnTx7zv0+Gy8Fh9mgXPs4effTkyRAi0B1m
+jw5EDpRAS7fvD761iy2/RYViG5hS3pHdbWHux//uDTcwg3x8ODgcHl4eroYbOKJk41u379eX9fqyPghaPxy2hs06+mAjxIl+N/g2
+/wa5ff/XIZ5G0JoML7urpUj/cNj6b3qHG4d9///VIIfNww/w0WG7/puH9z48dA8RfD1E96DWkBrW693tHYTjUk5AwZkUBYXEXkCb
+TRTSQUCHZOQGFPU9v4NjI0N4n6DkEjwu+0X72dwWXtOTpyqARRWL6tehlvy8dcQdNXf9yb+xy2gvHvQ8GLHZBSd8guSEbyIH4QTm
+EaZp2ESHbZX6YEN4/YIdqa2ZFN8MgmdCqCc61hUpzF7qX5iHAuGuzjzuCNzI64o8TzCHyxcXXqOclKJyIj/4NBENXtPKRHS83+WV
+x41GV/zMRRtptgADZzq+pokVfvPw43Ur1+uW5//Nw4+vmH52FutZS/n8846HJILpiTQjd7kszrXBKnUnYZIyXQ9SvbaadjQlp9m5
+ij/8IZvShIvKgtoioadhnKWzi5rmykpmNFwVnQ+bCTh+yVv/EGHFbw7XdFME+6u5KQWvU9f3U3OXkyEJTUf32nX6k6KT9RdrvKR/
+VJFPnt0ymIPgswm3xsGcKnvf42wyoQlhBVPXyDB1TURaBJIjoeEBH/USA2+fUPY6ChcLyojtyF9ppGg70brRNDIureIOCYbsW4IO
+ur+NI+6Viljd6yUPOuCckxAgRA6Tw+j//G0T8zq5w868IU6hPGRuwr2tAm8laRHPHncrecA2ui0PXUbEqbXb7ZqDkybZNnWQhKM6
+uz/BvtmQ2jp37GAgu+dyi86W1ZLqXqy7F7R09+RMpsSsVJB0CQ4QDrWuUg2u/82omTZj4ZTQftUsTEpTUzVN51tnhZMKLxpWEA5l
+seY42mhSAR44IGySD8MItqHOhe34IcJRkzgBE3cEp+lqGGx6qOngmgBB/UY8gmCMgpWrGHYOhS5nEkCVPgyRIRyt8Np4E9dH+1Jc
+xFwooEUKRIhUxSThmMyUE+letB/2wERTKEYykkcVpmq9Qc7wYGdLqKaw/U5ef9IkO2pVk1/fJaSzznU9MeJwDQDeCLi+dPhplRj9
+4obDmfKRA4aObD/sMXAe+etbhHQaDWAgS5Wo4MEDb6cB/u5xnrNrZXS3txsBdxAX7O/vLPPRaWg2/D9GJPz1rXs7/Ooc9W/fiu/t
+lr7sFL/s8gzke/xy0mjkb7ZE0bSfcvcJ8sfNkA/kUAqJrOCqI59fx8G2ex0ckJkxibGcxE09iUmTBIPwwQNvt7GzOcT6sWs97uRP
+O5uNcAgL5ob7+7uoSQcs90FL4l/fVBN3x5r9buHzjlV+y3ja3zfq2tkaIt8Ts6k/7lqlt6zS+Wc4qXZkkjYaaTtMP+IoyqXCi0/a
+Y8nFZUoid6uD2uLdahSAV2OKLnmRTEZWmRHn4UcHjx4/+fiTp59+9uz5i5ef/+zV6zdvv/jyq6+/CY5HYzo5mYbfvZvNo3jxfZKy
+7PTs/OKHjtfd3Nreub+719wgh9Fh4uTHMqeJM7dCxnscRkFy4eBLYBb7Wu1acm8h/q5iDfsh1r7z/ABrZ3J+vELvCdvk7p2UoxRp
+k++wJKPKO+4bM/3q7WOeRsvltio8CWapLvHEenj47LUsX+na5vp2Kt3daP9jHwnPlNwn2lqXTJLGE3ARiEUvLFVQuVRxPLv1QsXG
+Ql2CZlUyClLqVwWV6ovR+3L+VjhbLK4szifFl5O6wqNgTmdXFRe1iwVZrbDpPtF3dN+cW4JTQS7QaNTd+kwFSl8unaOSS2q07qDB
+oT7RzTPnSJw2dmBGIABaUDUDj9stz/eEjzan2TIIILiOPQCkCSd4zoHCUETESOxz5NN/kc2PadL+/OXrp2+efvH46OmLJ09fPH3z
+tS9fvHj88UP7hdOOgkhU8SJ44ece1xzfgTb7LpPx5yGjPYmTx8FoagWZD3UIfyOcI4X7HVoBsw5MpTwcVn7LmoTeS3Byj+xAALPo
+HkN+dM+oh0E9hfj+klzm3jfSF8ELlyIkfbsz4cndgAPla52Pk3tUdzRE5i9fPHwhX2r4M14GLxxt0L1uijk6uL4XsGBre/H0xZP1
+vXgaTUq9KK3nzXrRurIbrSv70bI7AmfUC3oSwJXuG5rEOXPZaXXaHSc/nc2gQWAVqvS0+pHeIw51sNMGk5Wo8lDUGFIM/2YIEvi2
+v+7VSWe5rOqsOFclOy4/PAF/vqInj88XrgMcp0GrOey7fb+zHHitvSEwTI6G94DzdHjYVk9QYEAfD3lhyVPpL3WBZuXr/ElW4/Z9
+f9Bpbctc1MxbEIUPD9tu3w+jyfIp/P/iCVqKrCiIli+CF8sXD18g9IEDNhYb3xqt0Y2bHOYT2Hg/7ix/z1jZ9IkGAVnU0/3Ocrl3
+Xz15nW61pznj8+1qR3NmAwXIu+pWY1K6Ha3QWo8sXVVGKPgbclqg7sAaDafpCIcj/E2zySOBdTgK5q0FTY7LDXv7iescw/v8AyGm
+DJrNXrAfccf2UOjIkS5IBrwIZHVUox5P5B0DJcdVTmqKbxkI8Jxz2ZebNVFP3IJLfXRtK1DzddWGt69W1ESscd6gKccX32gTh3p8
+s7brbj1VpAHXDHd8ubQb37r2Fv5gQ8kBZWQDyxVMWrio4pB4wk2r4SknyY/mIwdx86GkRF3AeZsMOpXgJgkNboIL4kr4XtES8jMF
+iUm/44sU6wvYAzZuP7yXs3B1ODrcRb5zXlkGezvItzJ2kV8cjO+gvptcR2TENpHxNDJIjITbj3k4rvwyARIjwpEkMcJ7SaFPXqfa
+feMdTxv66x6/4NZn5dNmJUKKisNmVEm0j6pQchitR8iBgZBTAyFnJs0uLmgVJLXTOXaa+TDcLlrheMSCWWVZq+guWmHpA6GSVrfO
++xU2XCZU1n3uNK+KK1gi9WVdDjZjGeqRDrrYOQ4jZyhHM9jFTjxizlB3eeB1MFTiDK2uDbwd7EzpuTNcvY8bKdzXxa3UcXkIVeTg
+iMioly7Dl0kQnYjwvXKzO58n8UkSzB0uKgUgazSE2PQ4Hl/k8d4en8PqgvTiNQsY5WILXWzQGcpPdQTXvLxdjOp8/kXOTlBjWFVf
+dIwBJXpAkTkgfpXhx5caEt/50MpyKbCAMaTlsnJIupgaElzAy0OyihWGhMpRbQPwa1ljsVYwMwJAKG5iVXWgrDBP11yDpAoGMGvF
+zaeyCj5kPkncl6UaixviSCnpDDrDpofZwBvarr7i6u2FriaWrVACN+Mn5Hym+1s7KAcJJ4tENOpxLkg/C6NxfMYVaEWyTdNFEs4D
+ZPKjbkJ4fpdu6GX4Y2clyTBo1FZWyHlMOCIbhxvu4CScD+9pySlFnBHKgX5D3etFC/zEBnEfword/GDTas/Z4Ef1QIVeayUGt0Hv
+xrJVc74rr+uWhAzds0bD6pfBXcAVnUAIG9cihhNUxQsmzgaP4MT9dTadDSdX1j+ZxccB2OaCeswJlwBqE0OZO+e54UkUJxQQv8wO
+HYTZ1WAuunUbIK+EzHQNZCb0hJ4vfnVwiS6rFhpdmlOu58Cp5iHr6Or0DgPOt/pPO2YRMr6qZ0lVz+ZBvgja8DDvRwUhonh6EOB+
+tbrTDdTZ35c8XIUotIi33O3QXev2de1M/niM1VnD/PYEze/8phyAZoZDBTLPeWGm3z57VmZuW7NZfSJZU3MLjjTvyI/iSI+CKI7C
+kUlY6pX7TWeFKzjW+j1vvZJLrYvwGankTOdFeC3vjRu9fn21H2LppoIqARuOyEwtPduPeqBJKJ0DzHjoOTATKgWFAf1fgRSTgiVX
+WAujGvdRHMgCPE5mOHHjgsMdfmO2DjJ+xcuMSB3520xF7Fh3jpU37WC4El52BW8uuFYdLl1/ABTgMa6Cx9hEMbmriEqIfP/rmsol
+jQ0NNGX2IrjpEUnXLHQKC121xkF5jbnratNldiLVsUoETzxgQzIIgauQ8J/hcN3ilcc3GBbGF1SMLyiMLyiNLyEBjK3U44q+5aEU
+r4Ga28AE+BtI3xtQ3PLIS+n3FU3f9MwbDFfvEUoVwgHsEBnYIcLAAZPNRgOWE6y9G2/0y9XtNvpNFi6lrEwu/GpWjSWlU+2ma+Y4
+qx8rv1SmoKm6AWjrpUzlXCHBVO5KpZVpBwuTUMHP1lXaDu/zenV++eb9KGBUX7op5DlcUzwiTbjv4gR+u8MWyEGbbLA5xHU22Boi
+IwoEVOHCn/bbNwcu767wMxbDF1sQUrPJBttwOjbZYGeI2eD+ULCzpwTSOnrvJuqpGI/7mz00bRKn4/SmpDlV3GU22INL0oj3rjPE
+C9J0GUQMWi47CI/JDt265+507o2aC4SVJJd/MiatMQKff2u6LGd4ihAeNxqTdkrZm3BO3Un7RKZQa4zwZP3t/+nrl5oBYJBcOC0I
+r7RsyfiDWu66B5AbZbetol94AknXGzZcDmqHh2wIoZiNl77ZnPUgxGki5x4S8jRewz33m6ULkixUbMb6HvUR6n/g3IjsZOGcpiyY
+3/ROlweE9GElfwS/waKXaT+/pfUzPwWzpBlgVxkkg4KANHTjdoBcinxIByKtryCbu7ugXh25u10edhkSXYADg4M9mw0dnOU5b9Xt
+bgjO0MN20A/bgT4S3wQnvuhWj7WDa/UA3aJiY0TKgd0aDfivLYalL+mNBuVNRCukglREwPy9KTLe7W4iHgQ5dBOYFnlknFD2uTo1
+Xk6wyEW8pfBWayUuVfKQozjjLgIG2ZAzyCBB5DyJDtU7BXWsUNHXNGcsArbs828jXwbPgCeEw+KSFg9AHJC4TO3Get1w9mOXshJS
+Y4tvEpa7FZMwJ6du24xhOqO/iXQWdbn652p1k2o1VHh7ABUlIEzpbNJo5H/L0Ai5PMZfsFyGy6XmzjqGkq6DXAFK8d2P6MrYh1Vj
+RJe6Ft2IVPDILavgjlnr+PpwEDsJcR2OmlfMx9y+Q7zsVr7EnB8oSmyuL4GjQXeoXdLQdrBYzC5cOwZiUojPT1rgY1kFZew1m9E+
+7aFkEA0JM6zZEos2yW3LnnJKBvZXP9EGfFhAHvIHQyMgOlx566GOeR8AUqWozhqN+kO1nwO0XMbADRGfODwy7MwNMA+sJMNLBrpX
+UUETSduEEK6JqqsFWnjiJlj4V+C7T7iTYKITEGUAbi4RKmsb1BeGgSZ4wJCMHamQDA+RdT4g3Sw05+gzK06cOiikypCY+dwyS/Js
+bD9G3rj6NGL9XNnNZxj8F+dsD9WThGirFgxSZRyTN67mGPOvAqKCG/WaTQhmhQKIG5QMWDMc9uAjPa5UFmVNTxRmPZSKwnlsIbjk
+kgDzzTANU5yaRn9ZHngKnHdEEIVJV887GBDF2eYtQEge0TQT4buTfuJGg3SIKfxJcYQpUgg0diOcYj1DWR/K+BlalcFjZk5sve4K
+Jyowq+e+0BWIuFTZ2P3L5WulcAhi5ActLxcm031mBQHUlo11cD1jCycSVSPT/jCN1pL+mDsp4htZIRDfSfkOcri5l7zcAQjDTQ6b
+nMBFfijy49yANrVAFFRVKiK4s0bDODOWy4NysD2jBrZcUnGTYUJnxAgLpwvOucRdw78Dv5Ry7Yj6y1LucvlI5iFCzuwwcGX0fKLR
+C3gmOnWpHRpOf3DE+zC23p/mswRYrq/b9Q1zD0LeLZeMkMdW3DSDMVmAjzJMkHMrSplqUn2glx+ug8ZpA+5FjNVBhWBdxlcVR5QZ
+BkvPAYy+D3dIP1BUwjnZ63Tue3t73e2t+1udvT0PnxEj6LPEGkMHvyMVokr8OM/9mEaU24gbr18LQ1Cpjnc4BlkXPihTJg/JQZFg
+ekQOcoLpJTloK8dfT9PHUTanCVgg4ucFC60bUCiuwZxSJCd+Q54HbNqeB+f4c6KA8pK7IH058b0VdmQa/AwQKx4cfkUq2ARpTm0b
+5w+/MBsnX/jA60eDsOUNJfLCAQkfdPtwYsscyYajWhjZaFTs2rjvhq0WjpGup9EYuTkJwF1gxiTc35QXGT8G9SVg5ckTigEuB9Nx
+hWmjQTLsgXMEl+EUJxD0Wp9MaOXa8x5O3M+Xy4XLuNEhs1wX1DIXXPszhCmyD0emDkXGD8WYu7Jgg2iIVubl8FWZgDObj9BlkY7R
+tE5KGRfUczE9wrREr+iSwXjsMqtEXEkTUX3g+h1OF4FjNGDJMpeCkzA4iXr6ELfj31ZUp445w+xK1NpDdBA2gd4aRMOK6tJC8EZx
+rFu9g6aSRgMop4jrJIZDfbpHQMdhHk47xLSKdMp+NElona6Fi7QPlFUhYq7Ai6aLNGoCu9ZlyJnCCO53jNTrLm06jqECsSoTUcaZ
+2PJwpEbSTsMf8vHTCqURTqYMmk3gYSeYDkEDft2ZeBXisc/Gu/RE94PafRgXKixBgbAynNEgcWHlgGFvkcT8PeyVRPDowW7CPEel
+ve3R0ThgwdER+Y71v2N8jZB/aZQ8NTAg/2IapOLgFRdoq5YBHRbOVHkwmoUAFr7T9wo2oIYlGSEvNThFasVPmEIpFPWhvMSI1Wew
+1ZSs4TvW15cBXoFZpXUKm0R5YWTkO9ZoGFT6S+oL0+TC0fzTrdp5adXMsL1nayYBR+R7PlAdmTHa515VIqJxVcvrs/YiXrjIP9BT
+E2HuFtEOeXijJmrRfkctIyC7gTe0orjpOf7eteuh6IEZddsM5VVoMSHfu5HRYrLf6ctr44BiNkQ+nHYDb0iKi3Tw0y7Sw9IiXU6D
+dMoNOcd4Hiwg5b5hy+UxwoL6F+9WVrgEPUUB5R3CFLXFlrMI3pfVJU8os4o9ry4m9rLl/RWzqoIwWnhVmEruvNQeLAzl2Kr0qVtZ
+puDArWrTVQ34u7WFi2N+srZkcdgv1oIZP7dqigMdT2rHChIiq0wd1lNdv/cf0ZaHtDajAZN8+noRKU/HgZvkt9l10/2R2c9PhIHc
+BUxPP6tkySQ5YRqSej3RpFpssGQUKqQ8IHNo8WTi5XJB3RgnBlMmrqIsnlUwZfKK4d59Qm/Jlvm+SKxoYipqtbgTghPK6TNOC2ry
+NNKq+3lVP9iEYaORUJfh11QQsHm5t0bA6TzcNKxvwqOiBsD6ku/8hAvc9GAy1QFevv6OGswk2hPq53zJ4PVMRjjNoBSua5dIEeWs
+buHFG75ZkC/gGzwhC0Je0eVyQch3FKr4mBkNfKYdPy0I+UgUe0OXywm49uVtjYzSARf59ngPZtSd9C9XvtWNELrxg5thimRfYEgh
+GyyGhToyMqLcqelbzNBqlfJoXQDPnwvu2ZSkcl9Cc9rz1LTHLdgEmGcI12cISo9J1I9hSvzX1ODbx+54uaT5hSHEMboci9sPENmD
+eIjwMzfDMX7rhnIFY0xxyq0UDMP5Lw2UwFeo/xj6ZhE8X9igzIyO8PXrJ34AoGzLcT8xar5gmm2v33+cv69L6Fgup1SwnNxz8QyL
+1D9ifsSQYEjNqd3Kp4pVOTbB6yW7CbMy346351Z+pq+ELI9xIYS5pjc2iyuVc3Fy+ncUL4BtZhK6X+WncVUVxxeMPrOrgWJncLvl
+8COfYC8aHf7awuj9r6Am4dLFVymzOrvVCIt2X04mKWXY6kTexAcKTjR7gPUjd8E3dAf5PJHgUu1GDd9cPXShyIsZ0+J9vTLtWZAy
+8Ft3TmieNifgZ5W9m6re8UR4Ze8oNUD6LetLSHqrgdveNoy+1ymfFac7suoX93Z1IHCijdv1iXtXgpC6cQP1Sahx3zbmKKHXMq1j
+m2kdS0IwEEzrVDKtA2BaB0McWEzrZ26Eg5xpnfahjJ9WMa1Dap1QvGNfcsRvMgljc0UATb2GUkaBwJojWroGTeDE60eDnOmsOMR9
+leU7QKw6Qz9qz4NFXnVqVS0OdVXtx9yYuHQp44fbpa0cy003zYXPawFd2WK/KFeVzzGXwzUbOZs5ElqO3H8mwL709aj8aVo4ZmZM
+XCXzwejRcslxK5yJX7rvmI1/R7TIorG+7dkG4LUvqRLhAZYTUr3Pqc9/n1Lf2A2h21QFvtAvvuaMCpH7ifzsY/n7qfz9TP5+JX+/
+lr8fyN9v5O/PdK2MGtU+0dmAzoBAE/kv5Gc/FHqpOvlM53+j877XeT+z6nqr8zlKMXDGgq4V1jyn66U1CbuZuGZC17LmDUhbLo02
+lksnvZgfxzP5cCw8gsBT3zk64uztI27JSn2tdp1zgajJxp+zRmMuCNy8yJi+PznO1Nz7nFS4zNls6BIYaYpnm1MkOT/NeA+sNuPN
+qmzdcGqdBsIJrcUaOKE3lyRdmJUdU85OMja5KUx6zSqkSTmBBWSuycChVQKlM2pJlM7tC+Wx+c1j0Zkju8i5sWiCbLxg1YIlINEZ
+kOj5x2f0lrKl58bH7+j7kC49prcQL70254NPRP8jGOen1pQcUK2Db/KgHubZdY+Lox5R0u108EtKnKOjWTwO0ukRHDFH2szl6MjB
+z2lZavWGVoutPqek7LEHPzVyQU1s6OBXtFLA9R29WsL1xHj/PFgMHfyCkpJNMv7IyFQa3/iZkansovD3RuZrCsV+MHM4Jho6+K2Z
+yZHQ0MFfWpOQBBfC99TQwV/YAw6+COnZ0MGfmIOexQHb7PLvhg7+uPhqZ0u9+tR49TRiuyr/Mzvf21EvvrJf5I18bbx4GxpVfVB8
+cTAL5gs6Vu+/KbzP2/pZ4U3eGGXg2/bw2w/a95p9Fw0Oh5erJXiXY4xsHJ7d+2ADR4xsfHuoPm83+wc5wj0cfrCBE1Yl0QwZD2TO
+Bm/okIRsYC/C0OGZX4p3X4ifz8XPU/Hzifj5WPx8Kn4+Ez9fiZ8n4ueF+PlI/DwTP9+Lnx/Ez1vx87X4+UD8fCN+fkaHPGya0Ueu
+8iv7+KrQ/y9p8I5D9ZBItYWY3UZpEAfszupdKSMxWy4DdoV+F84q6mfcbV2dtaN4TN9w82qGZ4xkrAKXRY0GhISKjMIRHjECoTtm
+TIsgCckYXsgrgyG4njCiOmfkTllZxD1mJGUD5+hoFCe09V16lE6DhOOzIZ4bjkYFAgd3y9+2h01lGjqGsElCZq0T7aePjz5/9fLN
+y+XSyQ2iad8R+MBNkxE68tpOk2uquwifMjJhuVD9hJEpK4rdL3imLnPEiNYvdpqKMjhhSPu0oAw7h4cfNJw8a8Ouc6klWKh9r+/2
+yeHhoYuW3INtuykzhghcY3zgte/1HdR0QL35mJER66dMetBTku1zmMi2GCM+4w856sDvGJlcrV6KH+vVGSU0YBS/5mOu1C84YGQh
+XGuNKH6oPzyhzBig6EuKHzFyzPrHTDsEVF1+aXTKUjt4zkhK3ZRhRyFlB+E3OvN5sHAQ/lw/f57E8zCloIOg815T5iD8Sj/LLesg
+/B3PE01hR4zVQfgJI3PqPmcIv+CpNwzhj3jqc4bwM556yhD+nqdeMYR/YOSc9c8NaFYDe8vID6z/A2srTQmR3xvnRYVshMyxmSdY
+9eTUyjyhjJxYOdMgJRdWTkoZOcLHperP8XG5+jN8XKj+HT4uVP8YHxeqf40PStU/xAfl6h/hg0L1L/FBofrn+KBQ/Rv8ean6p/jz
+cvWv8OeF6r/Dnxeqf4I/L1T/gmPpLxl5yPoT9yFToOYfUPwFI5/03Oes0fhCsMKeix+DVOAhE+rkC7pcvsnLvWGoTp7Q5fJzQKHq
+cJDQCKZrXzD3c6bjDgH//2n+9VP4+nu6XL4yv9ZHS53Icq8YZ29+wcoeeElOQuOIMGBb963bkALIhET9OXUjzVcBdrxytZbIm/YT
+pu6YX1B5g9U5T2TORyqnPFx5qdWffC8/+b70iR6joTnD1+cTVtAh+piRR2y5fEh7kT5zTmlB2x5H7v0u4kyGKxRiCrrIwnlSiVeS
+SxvAxNDgD1/0L8zbiph+uPL1tHsZbkrkbVBCWmnfaXUcn62qlKivvT2Y3jjzm3R+defXq3l+fcuuMmmmfcfxI+veYVrZUwKfYAoR
+uSRDoJ/7fZypQ8xXRvjeRse0xNA09mwNETlSB+VM8mIRXqyjTViZ5GF4cmdKaUoWy+XkKjJpXKZH5mScH/OnZKpO1BNy2j8tY/oL
+ctI/0R+o3ZXraAVXW4YUYfKnVfW5NQxKD2louVwoaKuDPDPKN4b2KTKR8iltPWcZX4ZkpubBkpogWPUy+6aCcRM2GqEpRA7B9TOv
+MkSEjKTxZfk2GVRRomUja5yR3OwEz0hapABHRDaniJQFSfMPJndXuFxj7ZPDUPjHqOf3X5vBRvB+lRPT96ucmP3UyomzH62cOLq5
+cuLip8dYk59GOXF6e+XE8ftWTpz/tBpUpyWFoZ+x/s+qlBNP7qyceHGFcuLP1ionfl9WTnx6rXLi0TXKiT8rKic+rVROPL5aOfFn
+tnLi92XlxPOfdtXOrlROvEJz8O0tlBO/vEI58fENm7hSOfG1Jea4Ujnx4CrlxLd3VE58+NMu0qMrlBPnWjnxK7ZcnlvKifNVtcrh
+9ErlxOfVJYuKem+qixW19D63NsD0WuXEp9XKiedWpa8qlRPPb6JvWDHgJzdWTnxxY+XEj26unHi+RjnxK1M58dntlRMf3kQ58ZnZ
+z1HESe8DLj6a3V058enVyokXN1JO/F6TnhpBRsslyLnAyE7E61WSOX3os+XyhoqKP1ToPj61dB9f31b38e21uo+vb6r7+GVR95EH
+o/2upPv4haH7GFfoPsZS9zG+XvfxTZXuI4cIU/dxXtB9nBV1H0dkFgndxxEhn9DlckTIx5y5sTAucUrxqMcDkpOvRLm3Uvkx5o2Z
+hr2xofx4WqX8yJUSviwqP56wwWhYqCMjXOg9wl8UlB+f3lX5kWss+N+Zyo/BTZQff+DKj19crfxoqijyJer/UFJ+/Hi98iNfwH7i
+p2Xlx0+Nmr+rUH78zFJ+fCOUHc+V8uNL8QyL1H/C/LFSfnxcUH78ymjlcyGnf25L9uunbJC3P7TUAQVH4MwEzY9vpDf59EfoTX6g
+2rW2xDuat8s7hCOz/UTgBbfQVLJcAkrMu5PA7Em8l1ThvW/M6HrhxKV15eq+PopcQEz1CfwiwX/4AO7BgRsvlywHN64GDS5+uJ/Z
+AIPmWzpE+A13pR4ul24oQR4LlUMw3P6GN9nTO1kYd3O77gCnTcfBUDLUHN3csFuoWSMMKDsFbLAyqZefWTgqUGaN/B6c8YsrnpGA
+77lM4hrTaBEqjfBMTP2IxP3YTXGGI9WhQHUIL4ju0qi34H6IMjyK3AwtlxP46Y8iN0X9EUn9R1SkZvDruwtw9zciX7gZUKfIf0r5
+Vwfw0z+QZV/xsgAVKVouk0bjJX9R+hjqhzyEoRMBxxkZHiEcuiOc8Y0eIBwoUiRDYuYiPCooclq+Bj5lt3Q2EBnOBqTF66fMjSq9
+DYTa6DSA8JLRgDWTYS+5wttAIrwN8MJJ0dtAXOltgNGfTiE5onfSSH5maSQ/K2kkJwX9WGjmveskh7Sg9ptxtd+JUvudXK+UHNMb
+aSVP76SVHFT3b6z6N75eLTk11ZPSSKklp1G1WnL2nqe9pJc8e/96yaOfVi/5h5vrJdsKmqJjWVTUS56YSwIUxHdQCtl6kVfpJR/f
+XS95bFU9svSSP6vWS56/L73kpz9WL/n0VnrJZ0ov+RP3+4Je8snt9JIZ8w2DI8m9/pL6Bf3jgmJypL9KTBXihInvQvkby99A/qby
+N5O/M/k7kr8LXW1mVvup7gZHark68We5ZnOlavLXOj/OB/eBzgzs2nK96LSgnHyxXjn5hyuUk+c3VE4+oqYzmTf01t5kHlLuTuaC
+3sCfzGtadihz/KvWjj63tFBBF+FNUTv67D1qR780UZSpRytCYyg16TVU/1VU/eOrVK0//9Gq1q9voUl9YKLeR+JS9LRak/ptlSb1
+d4Ym9VsTOh5WalIX7lsvbe7XI1q+oT20i7w01kHcQb9bo0n9idCk/tiAjue31aT+wfj4zXvRpP78NprUTxWc1D8XV9x8rHXOqFhU
+yKK/Z1cKoyOSSwfuKI0G/UiTaxg1GgpoI0TIKwO8Xpkryk//J4VL+XdmCb7Y/Wewll/bfNHKUpzk+8Bmi1arlX9UUit/JtTKv79a
+rfyHCrXyt2vUyr+sVCv/oqxW/km1WvnH16iVf1pSK/+sSq38qyq18q+r1Mo/KKmVf1OlVv6zKrVyykhZpQkztk7ZPGKVyuYJW6ts
+HrK1yuYxq1Y2D9gaZfOUrVE2z9gaZfMZu1rZfMTWKZsv2Dpl88k6ZfOpVjYfX6dsPq9UNj/lyuanbJCwITllg1D8xOInED+p+MnE
+z0z8jMTPgnEd8FOhKX5apa5+ygZMFP5SFInE0xeFD7Tu+KnQYT8VyuunQnn9VCivnwpd9FOhi34qdNFP2YAyrVV+wsd0Inp0UtWj
+E9GjE9GVE9GxE9GjEzEXJ2IuTsRcnIi5OBFzcSI6diI6diI6diI6diI6diI6diKU5E/E5J2IyTsRk3eiJ++kahJOxCScWEO7uJ3C
+/NHdFeaPQU1yuTy6UmH+/KYK82eg9HtThfl3jJyxRuPMVJg/Z6Bp/Y41Ghec1BrRNAVVa4NlZFA6j1mj8Zi1j8NoDL6ynYyFM0tn
+AhTYDxh5zRqN16Bh/eZC7VDQyy7q5T+q1Mt/WaGX/xyUttfo5b+5Vi8flGmfK73853fUy/8clD9zFaunjLws6eW/YkSdu0ov6zte
+Tn/2xFLVV4WfGqr6k59MVf8FLDRovtt67x/B3CrFwmf8IUe8oGA+vUZV/4eiqv5bPuZKVX2u86xU9b+4RlX/E0ZesP6Lsqr+x0an
+LFX9T1nuIvAzRsbUPbbV9r/SmUL9/mv9nKvtf6DzhNr+N/o5V9v/Gc8rqe3TiDym7mcMYcZTXzGEI576miGc8NQHDOGYp75hCAcR
++Yj1P6pQ208jEkT9ICqq7c9LmumneF7WTD/B84Jm+oWVA5rpR3he0Ew/xuel6s/webn6d/i8UP1jfF6o/jU+L1R/gB+Wqn+EH5ar
+f4kfFqp/jh8Wqn+DHxaq/xw/LVX/Cj8tV/8dflqo/gl+Wqj+BX5aqP4jflZkEfmC9afuF7na/guKZxH5tOd+BgZJEecXf7ZObT9i
+y+VXebmvQPH+U7pcfr1GbX8WuV/bavsf5F9/AF9/QJfLb/K8byCPcnbGLKpQ0/+uoKb/1RVq+o+vU9OnkebZMMF/YTrnU6lzH0XX
+qOkn+pMP5CexzqGsqJc/igp6+QtwnbRcfkTxJCIHrF/h6dNUvM+16kDv9YAh/ys8jar8g1L6x+8g9Ij+yjyECm6jljGCf8/c0GEa
+3cLQQTmP37nPIwq4W5sIxyRxQxMZG0rFFd7fq2IHcP0ppQXa8W2u/F20qFSMgL3Opgj7sNfZEmEf9joi/Ie719kRwez3OvdRLynh
+lxAnZfwS46SAXwKcFPBLipMCfslwPiPJrSZVH15Xz+kVNfCj7idYEUvrKFQLw1+BkjcsTR59o+tty2XY7Mhl2PTsSQ/GY2I+A0+P
+xKXJDe48lfqMXz8ZxTgCV6m455VEN65Ea7/f+OP/ShTjbzEZBaXxChC7geb8+2wPh6QjAslLeZ8Uz0HDPeYKgRxg50HYbA5JoGcg
+vmk3TDASEdwddIdv54E4n5dLGe0vgevQ4Xmn0zo8704OzzeD1uH5VufwfPu4dXi+0zk8vw+J+5Nhc+Okt7bBq0w7Go3QZTiWGmhq
+O+9uid28vYd6V27Hu1W71fWuqLdqixj8d2qp7jUaLiV0n0R96keGGhwTLx6AUoXP0JV70sQrm7soD7Anb0WxeU3VfQM26KqCSBHG
+TloRUOi3hfKpFrrcJkfjPMJMFYlcGSIvIIaEI7ii3xzN53LYgRZrhy41v+GqSEwmtPYSj9odGYh991ZLhZN8R+ZmLbDqTTfpe34L
+ToV+3Gr5zWYMxE44cQEHxEMcY0MzMNeevAVY48hwFQnoYGbL/4UAP0ZYqAcOuElMtj+TKGEkgxo96DQakTtCffbA6yfuCLOWJ6v2
+QzfFI+QHUMFAhaAckpFGGqkxdfflmeh512wjHbJnzwPwS9xr7Lau2nZQJDb23LZ3g7289kzT4iZmyeRuviq6ppjLoEIu3gp093Z2
+RO92doFsqBIG3AYBAfzhU3whZvSI8KvSMYHDE5+TMT4j497RcumekwzenJNzQqb9uX+O8PFy6Z6RDEqekTORfSauLe+g2Bw/huw5
+fg1PZ7CpX0MQAIqkFl++5ete7wi4mO9I3VuJcvV32gB4uXQvBE2F8JFUtYytzvvc+gyf6wyuyetGjQkSAzsg7xqNEy1CPDo6S4LF
+gnPVEH5IHuuXrPASKjpYLh+Kah6Rgz4VTAoX+RS/JA/7TD+zXlWPT91H+CXv2AVSAtN6/XWj4VrFUmtAJp0o13ur05VR2vYkub67
+J8n1rc59hGcQx28P4RF81N0FM0k4N0Anjnh4Wi02GpMiZxvPKyQ4p2U25Qk5XR9X80Zgp7COvl3OSIZHpJ7k8kMdSHTGsRElejv1
+MlAll15mI0BCoEHdaCwG3WF/MfCGIHAeLCC0q1934Zerpebx8KE+jctkFVDbhEBhPCV0MBniMYG6jLo56Grto2mjUXcntULVWnN0
+Lm8CnJkAGackcad4jCdcY3MuwVRXd9qP3TGe4mAJt9c58k+NWvPguSXQ2Ny7D6Dh4ZR0b74KhlJzwAW8sVRpBozTH/szqckMBh05
+PG55uwpHbwp43NwV4LjlcXCsljLNrhEtjarY44sy2E3IKOcwT8miyJYemzznidjT05y9nP1EDOfbzzr3BcBDjJgq4Okg5LrfRWTf
+7e6Jyd7hk3256qWDNbLLIUkHa2SX1itDdlnM93bWvKhuxJRdll/YssvSe29n3RujsXoHp4MqBGZ9VFWRJQi23mgpuZWbi4eL2bSQ
+paVs5nRrgbmVLT1WpYOSwNzKVOjWylQCcyuTC8ztHCkwtzItb1n4FiDKHWabZic3MTVIb21poKDc21YsqW5lAGeckuDOh015NEE+
+mviW9gvZdeYLeSROMSI+NM7d6e6gau8H6Y8YWj4K1QUvtzxtNIA9AwdiwBk1glfTActN5FfYXkdwV1wuQzfClNsE5RSxREO7e7ti
+NFte9273XGHgDERjf8bdumNm90UQ9rFlgKoPyKTRSLjaf8Df+3BlTvBiOTE6yw9DgTO3VWfvixOq2+1KgsnrCIIJxsEppt1tIJg8
+PCHdO43LIE2wuFHY3PR8IwFzyLhs7Kgbvncf3Y6CMsMptuDsV+uOMzgggDWeShJpRtggGeIRga7NUC9yR3jGT58MB+4MU4Tz+1hm
+7MuOXPf7kke5u3njK9FV8giliqrtmOlwdYcLUkXNobA7t4bw/nps+2O4Dc9S8A6q2QrcKC4iSd9NOJ5GPvc2wfM5a+EufKrAFVek
+EGHh7UFTjV11oVAE3Ja3fcUcrSPeAaumlqkkICJmxlSbAVyOcrhckFELthjtiUvypNFoNmf7IwmlU7hMDmZDcA8UcQOoOlkgSUlP
+BtNhb8xNA+Z4iidabqSRw5iHT0/dOerPfcB4s6Y3RP3B0L9coVXoTvAUQ2h0XpXm2eaQsqXCYctp8ba7Am1s7gqssbt9Q6bE3taO
+5LV3PIWLdqDSsF/prAbgwVFUrYMvR3E0CU8yrkbg1zuYaqUCv+5hft30E37vTkImC63Qyo8t/0c343ZdxerSTg2IYnoBPNbrd2V2
+3cU/ym2Zwmuln3fZRIwIfILF9ncpEW5uIKigisCdusC0NWj2XUXMbHvq6N+TF/btuzCUuJQHhnArvp4WCCp2nj7k9YaJ+okfYSBr
+ogck6VM/lJ8aZ+nuNSBv+VspzuJVBm6qXzghsz7o7voV9ktFW7cE4SS/ifJ5vpnyWEDiRmON2ludGkU5rdloBIbeWAw0Wj8sqBLN
+SNbP2sFsFo/eRmkwoWUXZIkpRV4rP67mQTOIi/q+LOzy3bp3axpDQBHYuQHZDAzdwApuk1RbvOWbQVJj3tZ9ddp4sBu8m5/K15rz
+Jbew5pNSIq7x2rvTjvoRc7Fbmouta+ZiPftA2Q4GlumgbqsLTYX9sKxsFYDtcFHT6lY073sC0dmtwLOMH3+80eJdzgRAkrE0IiyK
+6bpd727Xoqsq3ers3JDuAIwYkqRaefTm8hGjV9erBAUVKkFppUpQcJ1KUCBUgnJD9hRuKLmwPQXmZGBqBAVXawRlQiMoExpBGU5w
+YGkE5ZewPTXVdxTf6mKKNBYEQ5T7NhFMiCgnl3nR3G2MOaOsH/v8RieHFaGey/oBFwEG+zGSmgCJmwr72NTQBIgMHLDzY64+Rckk
+t9vV84wDwilQ4MzIrZdqjnxGggHtp36Th+qfuHUPqA03HmRDnOEYoeOEBu/ylfiRVz9GgDySdAUQY8DvQKZqH8CQz9qjaZA8ZG6H
+k/P90I2wh9rfxWEE6gc+kxSKl1OfAzp0UTMzpvT+fQUpu0prR7K/d+/fhQtcnG+hyJaDM8wepy/lxEKnN1GPERgirtTky9x0QIfc
+h4ro+IxQWb0a2OxBy+ung6wP1y1/pr2+5ZchT+6I3R0xzGv1GrRAVugZXTr/159xfOehg53/68+qxH+rEn9OJX5LJX5bJf7I8Z0A
+Ev9QJf4HlfhHKvGPVeKfqMTvOL5zAIl/5vjOCBJ/2fGdR5D4t47vjCHxu47vPIbE76nE76vEH6jEP3d8h0LiX6jEv1SJf6USf8Hx
+naeQ+O9U4i+qxF9SiX/t+E4Iif9RJf6NSvxPKvGHju+8gMS/c3wngsRfcXznJST+qkr8NZX46yrxN1Tib6nE/+z4TgyJ/0Ul/leV
++N9U4n9XiX+vEn/b8Z23kPg7KvHfq8TfVYn/t+M7GST+PyrxH1TiP6rE33N852tI/CfHdy4g8V9U4s/DmvIp+6ewTjz19x3feTOF
+1H92fIfx1D9wfCdNHez8XIHMzxWk/FwByM//rFzqn/85lfhtlfjzcvF//rsq8fsq8RdU4nckXPz891TiD1Tiv1OJvyhB5ucKdn7+
+lyTs/PwPVeKvSEj5+V9Tib+hEn9LJf6OSvxVCTI//+sq8TdV4m+rxH+vEjDrH0Pi76vEH6nE/6ASMNknkPgHKvEPVeIfqQRsjk8g
+8U9VAnYJTPTP/5lK/HMJpz//lyrxr1Xi36jEv1WJfyEB9uf/SiUUUP9cwfLP/51KAOh9CgkAve8gAaD3GST+D8d33kHi36sEwNcz
+SPwHlfhPKvFfZOIXf1blAAzOIPEfVeI/y8Qv/oxK/Lcq8efk3vrFb6vE76jE76vEb8lt94s/rxK/qxJ/oBJ/QW6yX/xFlfjLKvHf
+yZ30i7+kEn+oEgAbryDxN1Tib6kEQEICib+pEn9bJQBsXkPi76rE31eJP1IJgBbYKb/4eyrxD1TiH6oEQMsbSPxjlfinKgFAwiDx
+T1Tin6nEP5eb/hf/UiX+tUr8G5X4tyrxP6vEv5Bo4Bf/SiX+R5X4n1Ti36nE/6ISACRfQgKA5AwS/7vEIr/4PyTy+MW/VzkAJN9A
+4j+oxH9SCQCJHyDxH1XiP8vEz6GHTzkgQqshQOIvOHqFvfYL2JgxTwE6+JAv9X/h87cSurG30huTPp3e4de5ZqpUST3zw4nldadO
+mPG0XJpXIvVOPOWqMZSoyxVmhMmk0E09l7qp9Ssbqb/jxhJC2MUTDCGp3ToR/iim4udUO5pwmxQ3lQbtOFeBjYI5BRET/ILJ4Jym
+aXDCs2RSfHIiKjzyc0VEBk77ee7cF0o5mXi84I8PSdIYSX0b94DMEBZOfmE08Nto1B/a9uaPyGvDRd0jpDwJEMJ6yZIs8Gvt7pGX
+f0lSF+Kn4wMgntSaKaLode4TE78UHTuG5Xus6n2cexuQSaauE3VPk07i5g28Mykz8bodQSFyDZ5MsUBm6v4/Ih5ekC6eVFlwT0sG
+3GNSFEGbKjvCFPq0wjT7pMII+6Jog31UYYJ9XGGBfb7O1Pqs0tL6XTUn4jF513/3IzgRfOMFOFNCrqgRCxkXyPJG6l4CLhTrwMhF
+Wi5an+WApG44E7LoTfQVZkpGg4m4vLiz/hSEwsyXEm6Gp8hQzBHuDzMDEseNhnjMnVuOidRbnZN6p5dpuMQiaYlNTsms12xO9hc9
+dCn7AdknhA6mQ3xBGIhCtE7REZn1E/cCn+ApZpjiDPmJe4Iv8JQrGWVFJaOj/gkh5GK5DHgpmMIM+UcIXc7BRFhczU6XS/eUWDJw
+QqYIdPPmjUb9VEzSse2oB58T24nDcZ2cF1QBQq5zWcwChm2VV5TjRuPYil9fyTc4bzTObUeyjYYLg8lFmsbW1mmG8Fzv2m1+3/He
+v/KBweAJTC3Tzb2dnGd0syuWXbGCFq3EwCwvq4pZCbYCoLmfDHuQJgPYMaEboOGqpIfBxeLx7bsiuqEYkTiD3gjF4OTiEhJEuUrk
+fap3DDNq0bbcWYbP6UbDZX3+beRrWUs2RDgsYNrSegUkLqwXTkmca41lHBmpxzfBybWY50pd36JU+JY8ZSVi6lnCfEtoWvc4V0uJ
+SSegnTtIhnJjL4jWOIZmJkiyV4BsGEz0Ki+Wy2YzqZNRf+HX6+6owuyDK2aMUKORuhM84sy2XC+wJN7qdiUT25PSLW9bHm9cWW12
+O3mXntJEOaDS9hxB64fh4GHrm+GS/73s4hXPWw46rb0hJMVLmeC5y8G34rHT2qsNb87oL7rTDvuhdtzPPTlySoR0DP2CLXRbScZN
+fP33TO/a0GaLsL7ndzC7DbNsTYiAcF2EgLgUICBdEx/AHD+Or3Rj895R6hXRCMJCMIIg736xyzfAG3eSilR1S69i01z8fsf3cATh
+D0Ir+kGsgh/cfJJvC4I/ynNgeDfHgWI/l6Y9JMn6aY9urkj+/GqvgfoikvvaW/jyBmSIrJ7nHgOPfUNpSzn2O/flvY7/vBM/j8XP
+a/FzIH4eip9H4uelqmxkVDbNb1umV7+xL29QVr9Ut+a+oUrXs29tmVXPhcrm/twsMZvU6pJKxbu78oayu+tJVZfdrkThu5tSQQ7E
+UYuqK8qkdEWZFu8j44r7yLziPnJavI+cVNxHLiruI0fr7iPHlfeR87WOn87W+n16V+326fEar0+v1zh9Oljj8+nh1S6fHq3z+PRy
+jcOn29Oo1/vwDKQLz9AtkARbWuVS3Xm3N++qEy/ojeWyXndT4ZYIXHaXrtcF8gNIujA9iKNRwF4vEhqMuV7U7Si7X4VDyVuLuur1
+uNEQUSXySdjlmgTX+AKCwG3SE1B4J0dAN5y3cuQci1T6cfQKjhSBfKMQO4EVYafV0h3h0XZyXT+OAotOmoACEP56fiQ5Uux0VdAe
+sy93ulBWhfe5W6XrAwGFpUBAbrOZz6kVFagUFuhOnUGXunrSweui/YQ62k+wXMZWsJ/QkNZudpXCjRRjdr2tO9xsw3KYoCvo85xq
+8+6+sEakofdWn4zNc7f6chjRFfIgODD2XnV0HUnxijLg9Bfo3QJh691pOUxVVT1Q1Q6o+kXKPQrmwd+ALxWMprRserLV5SCy3enc
+TLzd7QodmyqXXOs1bKqPmbu68r0SobpFJ0hcMQhcvYQ31JWM4TpyM11JrlYZG7qSoVCfTLR/vWyNe7200UiVcz0jWe1nz5jY7Fqd
+yjV8hVA7Nr7iHqKYQnfyDKFOo/2uVKWNXYY7uOUhVLaouFqzds0Y7FBbYq8lKN9SyQ2viNEdWpPI48aVCBDv4Ih0ekVdF/E2ILHL
+SbeWG7Qi4TWYBDh90OEaVM0me0ASbbOlbP4GnSE3sK2xvGLleEUpOKqy8tKTkN1OBzTFdnBM4IrSjuKzO7OExPFTyQva2rkbL8gg
+G9gVR4yUg11lEHKjpZTnyk8LDzflkBSjwIVrosDFRhC49KoYcGKqmk1xHKwPCxesDwsnqjAqsJZYXD+8LUVRcGv8bqdzh7MZbjp9
+uPQAvjDvU92iltlW56a2J12O6je+PWxv4JjfCAaHw2FzeThw+77b6h+Om27fP2wfjpuoj5buwPlwiFx4168fdtHg28PD4fLwsI3u
+9dFhFx0Ol26f8C+Wh4PDIcqTyw8QGEIHZOPw0D08RP2NE5ySxC274TM8qij2rjYIdRwQ9Cpz7NgcmLSakgWTfqiLBWB+7SA/Wi65
++xW2MqcnvZX7oIXpPsgZHB5mnU1wIAS/O5PDw2xCu/xxQrvw2O2M+WO3M5kMHThWDw+z8e7mCD4dTybHLfELL2PiwHwZ78d0h78f
+TyZDdNldOdyJBy8hGh0fTyZDnjHqdIyqUl7VLbu3XNc11HdwpjrX7XTGbt93mgNn8K3ZFTkIHAylmuQS7N3RgLfWoeJnMuw7zbTp
+oHsOnhGn8mWGR8RZ30QzaTp9R0SEws6g9N5u3sELZeUfNh23T5xm2HTQ0mmOmjPsnFjm+LdzJTW3YOHw8DwYHR6eH3uHh+fj+4eH
+5xP4w3sG7qWEfynuYEp4mOIupoSPqcPzY7EaHbkaO5Pa4SGDz48PD+HboMOnaDI5PIwODxNeqLsrfvYODzNvZxdKeLsdKisSP574
+6YqfTfGzJX62xc+O+LkvfkSdnT3xE4gWROe24Wez0+lwOB7whRBgC+IUGPUEhjLZgT+7PDkZCpAtriL/9vBw3ISK78tR3z+erK3n
+Yeubw8PzEZ/MMbwY8xdjqoH96n2T3WzfAEhe1ZQCzLjpLJ1mIOHrfe+1yQ33Woqza/faQuy1aeVeWzQnwG5VTRQWo6oFpznFc7Wh
+BjPYiTAZcEoMPvzln/nbQ7fvj5ez2XK+TOgyXbLlKUWoz/fdIMQz7HxQ2qB8+tbP+rI09P8f2mrvZ48ga30eLZ89Wz5fvnq8fL18
+s/zisbk+zVHVCol1Hl2/zrzodY054FdlfI9TEb73+s2y++LRcvPVo6Xbrw+87uYQHR6O33yCDg+PkV02ZctuNF5uJmOrLJsaZZsO
+Hhv9vwbFm9QQN+o1jWl2d5XMY0dJrTeFyGObC61L18IRmRW1CRYkdu0zBUKiugwBhzxX+eG3kdBlOBNO/Wy/GQyN9EUWNRoJJ3eA
+N4gsUmZxlZXxlrIy5pfK0C2W1FasrM3iZ/EZTQ6ClLpgEe5G/QSMJBha3ULX0SBcgWC1azXoV2Uk0d1Gd9SViZeBqSuzrbRztu5u
+0aJz6B0MTlxKpHskahChYe5HKMWmkf3unoI3LqXYGIhNDHuYb2HY5XyTn08mh1nHA1SQdbz7kyGnoZUPo1sTo4WdcQsLzwqvo9wv
+I1JuBXvSooqXi/odPzCYUtl+hwc/Td2kmeEOQlgxQGB3mTcbKXv0PLkPt7rcBFK5sr89uLjlniOwTMQeBOrOW97u3AEWyxLw5bIu
+/PIFhne+tOSwybP8NZWEmLc2fi2vjtrcfTpgue3dzXx2cowRWlc1C2Nw/ODUHN9xULOAPFa34HOGE7dKqojYNInPuFwbOIlcjdXw
+olPgE7E8fqsW6lc7/q0rD73TMC34/jVfcSc4Bfe/pffCq7HtAbi6EGaGE+C6dgLMy2gOlPP4fEFHjI5rgR6fc7MzbHOvi7CBBgGp
+bGt3LEJuv6UcAW5KxWJw6T0iWeGcEgwY4WDV9rBXi+T+rns9RhLX9F9jHCSBcIeQLZdupsVvDzx+AecuDIWrfYQzLqEL3QjfR/nJ
+l6u19UagpBi7cOaNhob1ogldo6vMzDbV4bdZPvxKCnqXK1/woO502nG5dygc4fmxxanZUUuyoyRM3e4NnD1chYkz3pqf4lkO+Irz
+takmCr6BxdhCOMIzU7HU08J4TzKPPKWht7sjPZl4dzNz5TPQD/0YuRRnbsDRu6UHIN2d7G4Vm/b2RMt7t1IMMKdFth1oUAFtRVEC
+dEWliinCiT54TAJis3Ds7N7v2h27remv5WM5FRq4oQtnIu7ktu2Y+8ThlgzctjTCUVMjL0KYMXXal6N9MF5jWFoQxOc+KG9O4MAx
+r9EAaKDRPvV5BAyXcmV6BDK15RJEa604J4j2O/2W53voXrAynU3Dx3mwgR0+8d4GuB3w2vf37u/sbXqbW7s73U1v+z7d7Oze0pNb
+OXRi7oAonAjFFJkRQAYfgDxHKnTHqTI/6OuUi3zao9xgoA8mKz4DtXdFCdRL7aopa1JuqWMRhNIoeaa4nhp4l8uRyusvXAUeXYSj
+ftffRX6m3wZ+k1a4leMnwIvgBU6B15s2l4dp84MN0G7e+HbQag4756AIG7QmoA8Scv+bneNBxxOPI3iMB53WffG8IIsgSenTiN15
+DwCSxIxEWs8BmyqNMJJUMbp9xW5jObtNbAIdmkL5upWe2/a2rhMUOxmP1puEI+b0dMyD7Srf5MU73nr1wxInG8RZ0tOqVAzGmU7N
+DIkUAXjFl9OQ5d7tdHjjTDhjazZnAKHpYDQkCYZMMsL1UEq/on19OKtaE8IN/pnwgwOGJkJ5OIWw2QGIX7kUuVePBZ2S4mYzQD00
+iiMWRhldYfGBz8ojIxnoAocTF/zA63rZUH5Sg9e41Yp4O0y6cNb+DWZEOOJyA+Kh3tWdWGEum/cNMu+aWV2tVjdc8G5H3vc37ysz
+rPvKTe2OPIq6m9wBQEH5R+xAPKvwQysoOjwqANLiWkCK3J091A7Si2hEKggxzD2SkZEc6FynTrkx3TwOf6BjfEJYO07CkzAKZr08
+nwQVchZNL2Cw/MjVo4br46kmnFBLMCOZ4kcImTU+lZTshAvP8JQwtMKniBt1xK7Rw1MrWHA7jlznhDIHF/uX4BDHnPTk0DMe0CFa
+2y9424c/ZAB/cTT04VdpQwhoE/FYUC8hEQ7JBMcENJonZMrzceoWRc69hRjkHPTZ3YDMoWrWpvOQ8U7ztXIwxTEOEZ6JwgkOQA+S
+0XOGg3aQnKQI+XzSJiTEEJJFTVWIY3CRtgKJlF60kpoeTAPO6Zi+C9giF1rjUH9Ro8olJnySgTb1uGeRtplAt6k74yoUrpBXGiJw
+5KvdDB/jkIzBC4fM4umw78Z2B3iwPzfjWnZ9LZHOkO/OwSDnUk6Gr5pK/XilZzHVs5jhinUN+54fKgoIoeoiAZFTLwTtOEZ+2J7E
+yeNgNLXAXhekqqAw00C5FxkEJr2RCRYiko4bIqw/P8FKpylsj45JDMQcVGG/zqdohQWcp0U4t7Ai74Ap0edAfRNgb4+OLXhvj44R
+fyaQVMvH01E7HBMqQxMJwItcR5Qo78FI7YAxBiVbgH+OACChqrU2hcjT+0IiiYhvAwxR3nSTHKsbLSql1Hkvx2yqVl5WVrpGnUu2
+RFVLK7GzbnYObMtjwFOS9B1OVa/D7b24PQ7TRZxSUnXhTLm1JafxBTYHpSeeWC55XRCQCvJkEmmNoHwp5GANmoJXr43mOGRGMJsS
+avSUyi8tKLOmDA6UVNi4wP3WBoBig4BR1i7ZNVXf+hTW099VQa42d+WtxvP2pLnYDme8l5cGj/IAlwuZDCM8sY/hXgYFHp5Urxw4
+25/j054r7miA0aZkIoFxTPRyZhXLmalk3xFL4DuOse2bY2z6LZ6CcWVK2ZtwTuOMmSePxgARWgGKOYV4PINo2Gg4ET1nb8LRO1AS
+F1l8SVQlkIXw6fqq5Y6FEis8NzajXH6zkxRdWpVP4fxVex4eeMcKXaLlLhlf8YcVbItFQp9QNpoKy1vuXVLnLZdh+iJ44eY5qN/e
+3Nz0R+7CFfYg5jtQ3O7wpTollys8J67XmqN7FOekhTUsHPIINgM6BKNlwJH5GHBcJAHiXv6WqBG6xniwgbDz/e6G3C0EDgVCniGA
+65wGkud/ClSDAUKBC866qiyb4/Y4jmhf/LgzPEN+3GZTGvE0EtjO3qbmoGGfTtcvLWxXPIXZgyUN3VOrqLXEtNEofwoTz7twq3sW
+XPe3NbrtxbAxK3dlAELonisuSTBBqTDaD8jN8OuaDRmY2qZw3ilTQNj97WnIRGvG+q6QCVQBzorbJ8CpLF1YC3jBkzefJH4lCRUO
+NLCifa1Ib3itkHNRdbEggb5FBfoWJVO9KsIlEVGghI+6VDNQ+JU0VQdSkbxLsId6oPJPPDzjJXuCx1ukOULhhi/M9xk8iwsvxVUV
+g6NhvDAJZ/ii8L1MQ9P5noX16DmwkSRwV+y9VOw9B/5eVWoC1Pvsou+KD9wRwjrTXSDkq3y8gDTfvebYY3ckiHJJW5qKyvB2wQ9V
+XHlj4r4Kc+Ijyu9KnKpsNiGnl5IZ/AjOvXXilG80UOGqB8vbXz8n4sfNyuOBDmdohfzMRVdSmTlbYsZJ2ULPa3oJBbtBGhhnWLHp
+EsGJMNkOa2hRsIFfXUt+Zr18F0Tl/VBJkiaVJCl4qb8NEepteWLDC/eS9jbX215oaMvNHdK0F7YTOjmIs4jRpJocxVkvJbHe4xpp
+hhVIM7wGaWZFag8OULZcejZuzKz5aDZTPhkFbGkX0kwko6TEnpm5TBDOZoVAmpGzFOTHr+jET9zSkvIg6saF1b4SE9qHhA8N9+ut
+ViqOePNYr3eQL69LJ5S9ktN9p6Y6oh3443duQyRv3lfqG10VUWnXYnKWmY+BeU3n4knZm6Adxck8mIU/0GS55Ece0445QSwhk1ow
+AXwMgAZ++gbtRRLOQxae0j531cn6ZoW8Zx3kswceL2y/ub+LXIaQb+XLWuD1HnKRnz/uwCMk7vPPVD/Ad8UeF6HlkAul4HxUVzNR
+BvQpJMUvMnZlhniCa56xf0QtIJUCedmN1+a+tyfWZlN5huV7GS4w97fuo/acsmk8TgVr+v62uMfs3d+qvsho/mHFK0B+eFHADZPr
+SAA8XYc98JgE7TjCcxJwvFYJTyYldorBC9ARPsbn+Ay/w4/xa3yAH+JHZCEJBoW8iQg/22e+IOdzbQsvjxwbGWDQaPD4gGYWwifk
+DLYUviBn7ZQyfETO5MbEx+RM0lRYGEVFKhR8kjYa7kOSukYOQviAnPRNH8WKrxri2NAZCCfuQx5K/KEbI1U1cSNyAs+NhowS9gjn
+znnfcQ/w6gh1cIRjyVZ6BP6jQaxEAKZjOez+yOZCgX6BX+RMySACJNIOgo1uSJWIxHUOwmSUzYBsik7jUcCPaewcPH118PbZw1dH
+T1988fLg4ZunL184qBeRCzdGwh4mnLjmSO5Un3KgBhfOED/OZyEVswCdhitOCIxgX4h2itoaPbMjDhhcOGvnVbzO+WpqktuQDxWx
+kgi8b0+rfo18aw0QLvXhR80I7xFhpTmRI+ATw0ANwr8FOOadB2wjdINcDjx578O1kxcaQCn8LEd3AEqzoR8HM+GQRKX5CRVjC0cr
+HJBLxRT3KVaHvX8Ah7BfwQJ8yEMSP4RrGz7pn4DkUE4SOCJBKzwN0qrv9Jgo0iKv0mnaM0o1Gu5Ro3EkJJiPDFYoT7/Ox6QIXh4E
+pEKQJYiGRz1wXOaiHIniwKQyHd6xOPKrdFb44nLS4h2pd3wnVY+P+aPsgQzr/JrUOwiPDbUkqGmFoTF/jrPFOGD0cXRq9pHCCSGX
+YbXC5zYSNSSdOcw+5Dzih25k4E9GTuAZ5kY7VKtGC5pIkeXkhlxdteT51uDbITDu7HjqHuDLoyMFP0dHfuzyaRArHbvnSK5M7Aby
+RAEC8xbM46vIsPWyNNq/TCnzKYdnuvJdRi4hmbgUzjyYHsWS4OHz+y6DHwLvUwroQw6T5+R8Bz4EniePR4Z8+SmnTzkw3mRwnKa5
+ryJ4bnMlnUo5aC+p8izObePmwcKtjG3G+iI0PfLhL9jpcm8cbmCiHC35WeHqKdYCdM4RopVSH+3wLBY8nETKvm7BoL5fucRqA3cw
+2KxhIxT4ZRFFCcZGov1LcZVCpSsLonhxCYkG4XCFU2qrAWj5gDBs4yAufSk0mzDstWhLN8n9MrSAIgv55Ub4rXBD4J5G5lMVmtKj
+u41k/X5Jl+KqXcJNfweDIR4Mh1w/zyQm7dk04kLBUQmqAFEv3qctr8dJJT6frh55IBQv46F2wslvY72ABANvCKoQzWasVJIqvsLm
++shPlkt4KixUmPcrwCnpAJXfS0W/AlVvBvWGg1TVG/AYFZAr1pO/ank4G3gyR04KglsD5IJKR7OZ6rvcLWtGWFZCmk2G4wED0o2V
+Acia5YBAlI4IZwAIMwJf9YK1Mw5G13g2CPIZ72WiD5zhnZJUT3yw4p/X133O+8D0B/DKAl14YWb06ryIctyayVQPhSQTLl9wmqeu
+rU0e6zEP31elh1KC2Ot3yNV4rF+1AJEi9rgmMk4ImKfTXqsV9lDUJM7/zWmyQbOZ5M75Vn7pQHWc9755S/jvqm1LNX1LOjgmwJ4w
+TQeADAjUBo0Hgdxj8Com8CymoxfuBzbYRQpugGLFdBAWNzp87g3BsWmzGaqNXvGV2DaR2OjyE7nRjerKmx5GFcstwnBGwsKoMpSC
+GkQTFP4g1DcMA3KWS/6jYEhsjAw2VgYjjK39EA4C1cOYXwdSY2MHHGWkFShD7rWY77XebasUO2IQ887rSeAeCK86cyRSZoAygCDO
+SJrHbB8M83mR2yuAUatLKaDlTC12vJ/Zi23j59TE6r2Z6HuAuaaGidxv9LmIGK8+C4q4IShhmsDENDMD08wkfgny1HW1rQw29/oD
++EfiGEmom/GvTJsd5//u9AS61bemiACZ0molPcQEnqGDZtMMifXjFCyACyZtEjhbM7uDsiWP1yhRywyPhO4FD3ajIp6ZLNBcRKuZ
+3oZ5iTAtcV4uoOK09iEv+mEtiMa1D2XpD2ujIIpiVjumtSyl4xqLTyib0iS/22rNOMe8dYDfy3oEBOqI9pnvzjh/Su4L7b63KNEE
+s4zAZZjfiUPb3wEDORcbQrgq+MUjoRBC29yZ3CSc0eToqNGwn0EsNWrrm54LT6qT6OZwJeTwbc7aWy6rTjK4tayK2ucb9+q/VrtX
+WwTpKJhxY5n9KWOL1N/YOAnZNDtuj+L5xndxlI6mszB6RxO2kRd+8Gu1e/D5Qby4SMKTKau5I1TrdrxtXPs0jmqv9UdtKPYsHNEI
+liiLxjSpsSmtPX/6RmVDkY1fKxsglRW3q8yPHJob6Igv2jkAmHrdGy53QwyeL5waOKHAgInUBuzTNovfLhbKWsp3rW+/HRx+eTRs
+LsUP6Gs7DioaZxKaR6qya2tSHaPK8JmxIWpzD8+WH0C3Ki1hmF0TCLKutBGwwMQ1Ly96hkMTOgRY0vR5PM5moA5/OaaTIJvBRXil
+P4m1vWzdpabbF4aqV+VA7E3Yf7WgNpoFaVoLUtOICq2Y0TB41xfOhSAcnnAN6QbSL/UmD7sQuhlsQgMbm5hHOJSNtY8zxv3BcIAI
+JxfStR1cgl8uWEoSSC2Xyjl0EseMRCId8/faNMLAgGH6PLCE21aYc+Cxq++h9n69bj1zrcv2IqGnYZylrgpq8KCzwmYjqkCZuIPv
+Ld9CqujzYJFC6MRinnboCMNrnwWzdxbPHdi0MhJno6FSwu0v9Fe7/Sm96bXEzjHbEu6DX4KOcKNReCVVf1dcMabYSXv0Yfo0moUR
+NUcfTlx7huUitkNeFBWYHMarXj5x+g2HS84ZlXOZR1gAG1H+C3zFevVyLZfF/DSeU7cK78pOrBAqjvE1n1DwespoxK6FKNX11Pqs
+f8U7/z30/ixkU1kdoB17DJwweqinsrBcQuBUPevaXx6Vll+YkRxIo3hMU63+3mMPSKfHWi1Ei2XgmHVG8RzoCc5UbTPu264j0vSc
+aYh0/puamJ3nwWIRRidvXz0jQAPkNSZ0Hp/Sg2k4G7usMNKUsvXLlcMXZmCeuW63SdGRXKXcU5a1syZJPOdEE3j2Ax2JS/irEGPI
+j6NZANJesLkFU5xF3ju1UiEu1DpKwZ1acf24ZPPzhJ5yPGGiVL06BUjBMjJrO0xV9G9uVEMJ61OfDoQr2nbIaBKwOBm6qKeCWfR4
+PGk+Aw/0gSuDHSSEDqJmU/iG40UIbYOemwsU4jiOqC4YCUMvaXWU4Fh0UU9K2AbKiodr52uwXM7a8ixrj8MkCuZ5mUxFthAS7yt2
+mZtxr2Opruq1gqWDOEqzOU3ckMMbDwljfQuW9YUsUsyw2bKW/esKIeTDLXakGkL6EBMLqLviZlKmlM9GwFnLNt6p3rDKXTH/XiFg
+F4FSZiUayvdyf03++0A/eW0lBMrij4KU7mxV8W2SfsI3UqMhfuuE5O66eY4sINxgyAuWc8wrdEQw82TNu7MwGsdn7WMWB24W0XQU
+LKhLo1E8pm9fPT2I54s4gj1IUbHHwXhcPfcmIuxRUliCPpe4+LDWoZDmbXyXxlFP9Ag7wqOvmg5XA4fuO0J+yRPCbdeS58cZW2Ts
+STgDYtaBYuJKyYhzGDmCn80LjtKcFHAOk8PIEVa+Mo1VoSZEFdu4V4Wam7Tp1O5tOPYU5j2oOC9zQovFfXsfmK+Qnz9xUFhXFF4i
+32ExdLXQkRMaAYqj1bQgr0MVUauA5braB4mrzqDSEeMiXL2BHnTkJxb+zhvIgUxXboFeXlKD2ECtyNDXSY1mhvbQ1UyRgt6gOHT1
+su87yLLt3fj28KzpH24cbmwo69j8veFOUy1gGW3bi+i0nd4aNx9rgJiDYLnaPEdqp7gMr6kBTJ4oMT9QQAOXDefwEIiQ/HVKF33j
+ond4CDfGDQf5tEBd8HX/PGDTq+R2L22ANXN8G4RpmaRA1eArYHPNLUMBAgGdQ9nkumPwY1FhnLiXcLaW8cUKyUX2cEQg6Luk+0KZ
+6NlXNVcTUfndNhZ6zlRgjhgHjYZDozEoAadct01dY1SqnbIgYX1BJAXjscQv7qV47ZszD9dMNStj/xL2hc/wKJ5l88iPWt4Ka+UH
+8dJupA15qnjhlchteasV8tf2xdmPYokGHzjFtjxVc2d1dSfhcpWQWBpobxxGGycIJ32XNYnyv4pDErdnQcqeagQNKDnSmietEPlR
+Uz/yaeYDuWKiaTT+qaeZRuPqSYYXIu9XM8GrNXupcP0Ru8e+IeWYF8g1hKqOC/5G3lkdx3YjfNXuYOgSAhStEOZMawqet5naqGRk
+COx1rumNe6uD2iLuCrqGnVTpZOWGHKTwV8VBEgYa2yLSfSx52ptc1zNxU+Fmp7vJo+Mk7gzhBXfLsIPwhCTuAsxv4P0umJkl7hTM
+u+H9fTCuSdw5KEKu4UCBbY/FfRKRlJjJYqJntbFaA5E/ypKERsYdWGSni2BEc/yb0nk4imdxROqe/I4LA9/E72gECpoKvqAGuT3I
+Je+AzzDfw0V491arKiZXoV5zsJLaVG/cDs7UWJCbj7iwSbiDiuorprqD2RXDtn45EWdHD0nPUZQUCnFbKHgCzitIY7lLKYfPm+Mb
+c9gkdOANRXxM7hbK6cn3k4TS12piwczXKLOSZWg0LrxRTAfxXj4VygSsdRYnY1kmYEk2o4Uil6qB+YJdvLLeq+3D38dsSmG7rVR3
+5KFqs2NELyq0OQDiRmqVenKRQh64dNAFeesmuN82ECoRYEIHW0MFKXSwPVxJjyiUy8uE0xPc6iJJ36X3PpC0XYQQ4/dhDrvtJDgD
+fs6Es1fFExcYAILjV37FCpHn1rfuYXoPuYNvh/cG3x6mQ8SfP9hAPVlrAt02600Gns4QVSeDzWHh7qtnec0Und5giuiMjlicGOM6
+puyM0kjvUr2X7cb5EloN5zFA6560AMegfY9DkY6BdRvAn5TQXioErxGXC+NA8FNThB0XiM5ouXQGPIHC5dINSYpwLMrIAn0HOb4z
+dJCW7XYMdVNet9NztIZzYpvBw8DGdDRzAwmf3KvOpShfKskhPeBg4axknYWdexyM3rmBEMgCW6nekfU6Pv+g0XAh1qhUj+bhDQdx
+7hIDJOTiY2wOg5t6cqks6qVXIIuVOqAr8Q2/KIBOYly8cWXRaBandPxREozeUeaGCLNGI5Hy8SDvhURBIOVOSTAI8p4DllouTa5l
+inroqtnprVuJlezTuyg+i76MkzHk2Xe1IrBTUWfveqDvDAXcd4YS9C1gN1Drw2h8IAaTPkni+WOOK+U5FJyB3FVtGkcfimv2CIxr
+zd4MqrqJND6i+QRzqbkEZR7x0C0enh0spwHhaLA17FdgvsjAfBFgPr+yUNcotDlc9RyO8EHWCvPWGfaQKV5sNEoLRo2ZncQJbRLa
+TqfhhLloIIfCrNuExsydodG6XCZwbLFI4gXHq1oFQmJX1SXYlHyPJRACTYFpYsNkIhmuoj67V6sS4tMuAvVtzmid21vo77FoO+SH
+tV1Rk4T6jF5VvVo5PB6b6BNfWueelQHyL3s29Ss1M+JHC2KLgN28ArJfw/xr2F4kdDSlo3fPwzSlY4uCULMRkxwse/GDTi9utdR8
+gBKQUw/nizhhgZhzGGLBKe8la+siirSVeE1cBp5wJiaONboTPc83YjPFTs1oR97h5KjzylMTr1/bLTXCjCiZdgdoagccsse90YMO
+eIgUgLcg2WCkAK/D+SMsCecuyllFdWAPSlCsE7KQsDdTqoEDb9icra741rWnCZdGN8OUZBzr582EAoIU0MscJMHPQF9c4MBxl+Bw
+CglE3gVfy5HWAESBDcyS9STIJEdzbeBOmaSWh7ADCyLeaGQCD+OHvFLBh6omXnq5+qJkvRg8mLqHY/gD1MbVlDiA75VkuMC8VO7v
+CpLSxFocZRWxc5HAEHXF9ptV/gbU1PRBLWAzIfmJiyMSgAV7pGFMngw9BG9aLfHOvckpkBPgmlBf8eY5paXmvnLq0GWYj2B1q+M0
+QFgNp692bjBhNHkBEHIdwgrsw3gRJME8dXCAcMjNNSzq5Ea3AOtyao+kTBQjbu1Q6DOnnkVPoAiO+fxzma7WV9A0QmH/QMfMzWMW
+FlU0GuW8nDSz3vF+5cBnwyJae+02v+ajIu6aFzxYZ9OYsMqbvfpuESRc3Ghl3nQPWUwEu0Yp88gipSd1AGRsCTXJi2Xpum9XVqSF
+Z/HonYtwec7f8zr8mFm3x2nd+YtyjBLXoGJkVgAw681g7ZCBNIWw3ICoBRoXygp12fH4LNJ9yk9oM5esB6OSmDcKWclm2F5LibGo
+ZhSZDKKc6bnCkoGkWTvQkkFkXdErbJ6uSjmjRI57xUtLcFbqujq8SnIDHBOtthlAkykQABnp9LL9uJc1iYcSEnFPeQRMdSx9kdCk
+gMNGI4OrZMvrp6Tu+UGTcxW4Uq5c8Bk3ah5nI1rtsJo2waX4ivuOFXMEBh2XnF7wA5wEZ/5staKQGdhjXof71zAM5Ngjk9C3b53M
+vhSVb50MwflHcworys0Vru8aP1p+VOc66/pUuAJd1amrJ8lqPm+9OC89xMxpYLk2td2iprcLTntyuspxcEJYL9nP3fw3m3xAIrRu
+HvFQ6poz7dKhxYPsF9h5ReSUDw1mt0zF6R3RAQMs1Ye42ZScI3Hp4OwgN+EkEAJFck9zhBwk7n6sZWRyi9BGQ94ZeVXCy6247BJN
+SS+S+CQcqyuDdhmrRh0L2mkcZ8czesBJ4witQhKttN9ra/wFXkvhzAdZQY6S2lSIC97Kb2rH4iPHIICLtetL+A1r5uVrfMwl9kix
+buuYvWH96pva6qpeG2dutdi2SDZwrN67fsKgRgdTIWOjUqJWaN5YuxsN6hEvX+OQfNWgjCtMYXOtq/kha0HpGugnxhmrQQ0Oj+WA
+WbmFNXd1awyFzXddcfP8V/d+rfRoIgVrh7KW1wsfkA7nboKZEzY4MJyB0eVuJeDYQr2w1UJrV050rqbPUQdzpnjCR1+S/51Uyv9+
+SkGfKeIpuoFQaoRSfbAX7dNe1GwiiKCUFx7kli1cP0/jclsFkVvhcZYtT2G4RmuRlMtuIx/cLMgH9yz5IJcXGvJBLi805IN7ex1D
+Pgjl7yN8QRL3BOEjXn8X4WOSuEcInyt54hlJwOL9nZI3PiaJ+w71wvZilp2EUaWDuMK+jwhbF0I3j+29iFM2StPPRa0U65wvaJKG
+cURca95Q+1Tkw6mkAFjVVuW95sKNsCOrdLBta6iBJ1ku4eABxmuykp4neaRpm97LbaxDdxC5CRoiVVCEAYFOhYZev5Zt4VBKGi+M
+nFMajeOEnBpZszBl5NjIqJKdqbkz2TL8yhS2A1YSIxmlx4XSJba2UTYolE2uqPesWDaO1/b4sVU2RwThHRDBn2wDEl17cDcNUKx1
+Ow2VSaUCWv1S6oAGLD52aTWCSaviVQj1aCBGeaL4QutN9ysWVZaklY43qp0gquYoVx5mScYll4To/LpK5ydfX/XN1w2KCCube4b5
+jTTHWZAQDNgmgMy63g6gxdCdAFJca59Tss6ZxYGpm6iCOAuzCcXqDhL2Zcim/EtDH0848PFlpA4hQF70+V+uNunnB69qCtRrIr7y
+OEHcqyV/xaXKIarUhZCK1+v0PNX7g2A0pcoCx8rknN3ZFbrjugvKNsb63KZMDIuMKv1tu3muXG1ro1/9PuejFm8/cglI1cW3XucI
+IM2OU5a4HWwEySmK+OzlriCpqFZe2zi8d5hWKQe77XvoML13eO9wA1QCGo0iXBDGZRZcVIBKIsZ4TIv2Rbr1jW+LytaHQtva7ftg
+1pdSRjI2afV3e6gvNbA34KpbqaONnZ5mKGkYpoarsjEt642raVRsDcQJS1ZUmg3ykiLgIFfqew5zl38oJX9qSteNbfAtHjYR3uAX
+7pL97dsozRZwYtCxXIvaPFjUuM57GJ3UnGaCyotsaUZrtM8JOaQufLlNSFmHV42TuySrQG55RHpJekeNxlTvMXoepix9zW13q3Ld
+fA3y1wkNOP9TFMAOLPSuhfklTFXNUQA3HRbXYOw1pbNtzJdfc5qRUZXQQTAPwSswhOrr7ArNW47UdLbLzH73btCWrggZRqdWBbnq
+Yh4n9dPXL1+YionoavipmJjaJE7mAYP5YaX5yY8CS1nS3MXFzZ/31ciTMvBCdi8/Pt2ELPSc8GC1i5KmOEU4QYaKHSkXSRCuu/Uq
+iFsuK7PdBLxwrYHB5AoYLNn12BtO+xkRsSs5zye3cew7WSTI97HjK9/6pT3IdWkB6abLZcXLI/UWla6b459G3fRXozsarqFeCtw+
+uLbqgIhwe1Uwxu+tbRrBxgWsQMyH5bLu4QQO4El4kon39Y4SMocRD+mTtM+SkMl3CFfftyhO2u/oBU6QVuE0PXsZt6gIfBKYYZEj
+hBOehxPwerhypQvbPR646/9L3Z99t3EkieLw+/dXkDXdcJWRAAFwEwtMoSkubU1rG1G2203SdBFIENUqVKFr4dIE7pnNs++etWdf
+7uz3jizNzLW29jnfz7Lke05r17xPP96X71/4TkRmVmUtoCh5eub+ui0iKjMqKzMyMjMyMjKiWL48Rr7nG+jxV7Jt48hPC31iN+f5
+8ZVsFgTWLkvfVk5dxrbjW0PxlVF5J4E/F8luctSMld2CzMnQvuUXCSVZBQY4043vxakeLVrqgwmXQcVGXtx6gzWa78FLpUxCeouO
+J0D8VXosorSBymwmSWikbMclgeMQTG6+4TCWx1Eqfr9rOyHzC67raaKI5CIuWMrbOiMbR1fZoam1uYipkWLlQNwhoy0jO58E/+nq
+K0XdAkpe4nMDYlXtzQ0ltapU3knHLTjToOSn/Vg2r2cFPQ1WtskwbRzAJ55QNaXgYh+aAtoG0YRKO5Aa9+FQ0yUcf9pyHG0UH5mo
+G2/1+ECvEScx2gbOGSjqAnJk77qez6fRwJysgaYGTlPSdW4akwUOt5X6E592Nzglt5pumfotJv3shPrUpt/adKeM9NXXpKPQ2tkQ
+YePhhooJLwNXxK0b6zliAT1H+NJzBNr9O9SXW9cZCNwwoD7fuh5JuprJtab2oeWSxH47kwFEzqVx/ZGSvOtbh4QvmqlUxlyCHKKk
+HjLH8fbBIWpPSe1bu8wNLTixydXNyCcdafkStVFR4kZR4lZRolmU2MwnjpSx6r3GWC3qSp8eDXzWtQ/MYzaJ7+uVzf1yxZhKZrsW
+qIFNTRuRyOUFsE6hzkRx7IKFTGEQeLUp/peedmwe2AZ+cJlPxK7Yow3sxT0HIrlwAKfi9BMIaFl2V3R6vhBbjkbNL1ff/1r5KgmA
+8tqrLfc2pUoaQGQaL00kUfaE6O28A37o8TfllsGmInHgBTZU78whxMvHt1FzYOOPVNbAURm1BTCKzT8nbHfCRdNucNrmbnhbryOj
+QD1aMSSOf+J2kCMuJAjHMggTNE00xeapww4IzGP8GaCRIZdz9a2WApc13I7Jj5gxlJP0T6BPlt2qLZ1ZXlld+/pbZ3/8G+fOX7h4
+6Scur195+513v/net6yddod1d3v2t686fdcbfMcPwmhv/+Dwu7V6Y3pmdm7+1EJ5ShNrB5hXwFIEe8DcLeRFcC3LFmPtieInr1nI
+xuejAN2HCZO1iRo6GJubBgpI3XnuS6LUuVnxObpQa7HK3Ky5MC9T6o1Gi1UW5suNOXPmlEydnW+xysyp8mzDnJmGndhcw5yZR2Da
+hAuGx4TkJz4PPkmEOUrE46p1Hc/zdd2vMGOqYZQZhkexiQdGL5O1eGJE+9lWZDqnay2/Ep2ut1w9Uoo0AzhjO7e6tH5l++1Ll1Yv
+b5+5+PaFlZa/GPsk981K3YzMqMLwbUail74dmSLUNBuF1a9fXl26srp+ZfvcxXclBq2TgvdoAy/bWH47pXHE2sa3zf10H1fqsQhQ
+qROZScRbw2Hx91HMshZrSSH8Lkeljue08B1b98HNq79hVeqcpEalEocALfJMnZ1UhSAZX/LsnIPZw6dhJsVWcZZxOiEWDbNp8tP+
+aXc49PG2jHUarJk8OMgaWD47c/h1+colMYUFZ92uAwlYpUVaS5/W4hDftuB8JN6EbQeotcHVBp9hEwJesZU6m5U6yVTPrI1G8pC1
+3qjjaaZy2s8LXeOuoXPn/XEtYufR/MjPTnvSSI9GPakexoZNHikjapnC6g1sUlMNrBdipb8aenh6NG6eFmXJEwBRFCTqJ+oWI0tz
+Q60TzENCBX7ODkJqHztRKJH3wdqsCX8oOPYm8If6oyyDiobgfOJ7kdvRWZk/WG7H6+vGm3pYAYqkt0gw+HE4eosy4BX1dUgkEVjS
+NaEyAbES63OHMhhLeE1g0Wq24dRfZxvtLeIAT4K3nDL4CMDJpW0YWEJUrpM23+gOaFSuN+XHB+AVlT8MynWMERNWvxPZ7avrnh9m
+9c+Il8T3r9SNkw/dcb4mGDrXD/ixc6xLPesOMBpfEgslYFhq0MJ9uu6CF3gAbQTH7Tybr/o9ITnDdfJwyd8FLbY4yNcMYqfSxcEP
+WF+n0kGPGWhkAxz3F7xw2fNCHjLDIFFBvjxJkjjtFI5UGWqwA1MzurbDxCsoxU1yrce2qL1xrFZZIJl4FNHkrraDJHCNbmGIDhv3
+mlzY4vtOBWfsRtQqldCpyk7gOVEIZWUSIDa+4nGJMPDvIccuEpM6qJvn5iqeWguSjHJBvRSureRb4nvrAi/1lpyb9LHNwEvKg/j9
+ty+fg6oC64kPJL1LLTUlcVolKiK7kLZTNT9vDeBsTujzoDvpQFVAiLk9tXbQGkkn8vVDJvOC+WVYTJBeG/DdfLJ4O8kA6uNjykjg
+S48ve8z4So8jOeI1ZGj7dRnaNpoqmQN+mJxiL0wRsg83364oDi3k66I61MuxiN3VWTXynXyd1nmN4IhmIvKdia7NnA5sa2RpE7AD
+tPsDh8E2jHWqmtRHxqRgRPO63YCFuRkIqpojG6+3INqihfus4dCmlHK4VPIWLWmemK8wE/yGXwwm+kK49/wO88E9rNuZcD234u0x
+3+GMrHiKtahLEunmIpZhZsQdu5yXd7xyfUTkmbowHVHbD56yDBhrI2PEF0kuGPHIYwsz3N5sZnrOqOIwXmeh0FTNc9OzhYU5I1nX
+mpmzvsIz+Qk7jSTEKF8RaCQv0ul08nbcvvNyrOOYKj6HUF4lWv5VjRyphx0m3L6NT0Pg6RhtcEFNhB59G8enTNTTM1N2QjPIuNJG
+GH9EbbqcS1695dk3v1zDs6V9qXZnC8s1G0wbzgYie50NLDwILjQ+jN0bxwcM4q71cKgRBDJMlqpxoXlvasLZQUUSE0M3nlom0uVo
+yMpfX72wennpyurK9sXLK6uXwa9U9eLls18/e2HpnEhqEL9w24e4RdtOte7MavfEFwsD8NnEg2ibyCIWUCBXpabwXGJxzyQTOQRT
+2CbleVPxEzKRbVb8VrZnc85DcosMN2PH+XDC605wkzPbc6san5simuGipp1bMMR9VRFFU+DySA1pscQKY2dkiYKWFsgiEQkLpAmD
+SO9NIUnPwtmtdHZGzm+jVdnBZClRgqQFCCVXvAvLrBk3F57UxsKzaCqAsKmADEPZwHqZmddynPxGcM3zC44e1XVELJgulWRRM3kS
+rKdqS8Ns49RXxFpLagaqTqXTWMFbXdvt8B5CV2FxthF7l421JhtbYuOxsUVs5XXBlrpLitmVaGplNaKlK6uRINk5XxRZMb1IlB/A
+XGw4TWucfN6YYSJC7iUGEVKgiEMGUS/FIk2vVEqngEDSNHyuK+BSV0xaj2gp9oz3QpkuUPFkizkmqC6W89iQ/PXCN0YGGdvYctkW
+ET2SXbiX4fHCBoalUhaPUuf/Ra2W+nYf1Cc5qyrqq/qddIAddXE3VLRqu/Dt3HApGMroB5MrBDJzLF5fyDohTbJJbBgaz6w9K1Cs
+sNJ50jOB9I+CyhdhsFK0l5RGLOWya0jbqSwOqI1obITlxsHSRiQjZxYKDWnS2ippfeoWbZOZSEx2trgXtqgbtzOLn9sLwxtNV93Z
+xljwRNzsFpcly3DGF6tbVLybkrVcvulFTnAYcXMbYze/iXe/3A5erYDYzDdV1xyxZKiUzn1J4El4oaS/sQX23EWS8MYW6dEa6dA4
+jldvsdPsScunPo02eltkD7ejXnMvs9Xvp5/JXm7X38+mkH7saVLfEyC1Yvbux+vRXnri6qceldz4Q+kE0hceNfQ9BKivfAMSDIi0
+ilPenmGQtgTl7DLQi+h17Npl8CsshXux4s2GrW42pO5u3EWb4mEuuh6kf/ukErpkJxdPUSwSEYfWSZfWBC/USJ/WyB6tkd3Y2w05
+pDWyTY9GZAf+HADn7INnkcPF3aYROxqLdxKHhuGUy+SwXIaCk9uoJIt1WC4nQbu4yZNH3AyjgSh+2LQWd+FqPdKheHeDMcWbVrnM
+FY6xJ5tDdI1Bt8H5/mHiNzX5sM2bYjWNtjga1Bk5JDuwnd/hzmHIId2p+izAC0nALBF+pAGHSaK8vO5iDfTuE5YwjSUTO1E44XoT
+sMii3kLVjEy/Qkn4MpQSFxmXBI2k9sjNjcVuGcyKcCbIZBFbOZVPRMZ+GS79kj731ETc9IjslW24nNij6fQMGir+s4O1U7Y3prdI
+h2ZzkorMYEVw5O6VbfBWsgfVmIGQa/uxwZvmYpCkRNWX/nipdCBRRwN9Xx29eVF9haXPbArm0X0y0A9eMgeM2Z7Tg1FetCjehyon
+onCys0hrxbYa0MJYGbaLy7A/EfYsd8LzJ9h3IsuZCL2JOpnY9UI494ZIsE0s1N9aHFMm74aXl1pLSvW3lLBc/HgX22HjDiktZeFa
+x7+xPrDcMQEqak22OGYDnYg16fu6eUywDYDGlusvKSu+czCunDK3Ecyc64JWJJ0CvpEKxNr8cKvUm/Ii/WhU/E59qjZKE8/LsFrx
+zjKj2CzYaGZ31QV7R7iMWbDhk7v5HIlye4T8XuBEI4+oddmxrUAbo+jh12fceEs4Vt8CZtuwecx1XZjtOr6zjCtgx3tvcX4lgmzz
+MI45hYjHNd45YS4r83tFChFDqPnVj/O772qozwlRA34SltFSwGGY1B94JL2Fs7Mb8uIdnJ3fqHNE+IhpxT6F5Wcgk38JIVEcwrFq
+JcPCPUvK3sHF7pLjSIl5XMiSXNCVglS5XmT6JIAjQkNKC5lXxsUm4dogHtfJzvnyF2+v5XSoaPmb/0wqQqo6xWR1MCzh5pRn8FRp
+wMni8L85brvpH7fd9OVB13EbVjjLiXznEgiP2RIMQ44SX7GyxMgAGAECPeTgvS4bnSHZ1aDdY7FTu9Qu1zOOa2rxztczcCzrk3Af
+HELOaFP4FXgwij6iTcHR8at/iL+3hXeVUp2Ylcne0N4o++U3tAlbnJm5GAEx3jZX38gw0m526nu5elBqALHp4RgOCg0jVtsdFY9J
+RQ2DI1NwZKKLTWkYC9aNl6scobH/aXrCV1sqTqw99KTsS+MgXim6/pcqxkav1MOgHjtjBXY7ryOziZVw5XE6MiujI5P3zX1ivco+
+1zrRPjcRAMFWDeLDLaaPuaXwF5bLRubOVgpvI9yKNXpyyKuXuhjfFLz8JZSYpXkvbLGt/0KBLBauQ5KuOSk+0kt9uBJWM2fg6XzZ
+Snc4zB2zHPcux4CjR5ohZ+LoBfw2xJQtoJrQOWdqVNH946sMZmKC/3MVeunLeRGw5b+slZW6WTMITDYmq8LPyDBfURqyXkcamsiM
+ArbH/MPi2GoxmccUzuM9WieXbY4bZkX3JLM8QDAeeHpYJR/TGapz0fuYaIN9smWXvWzZtV5l2VWcsB076aR2isrE0cTw55OwZsRt
+zS3SRau6kUSMjF8sqq2QEePrn3zE+Gg8U9bdEw8UX5yJvfQdbokNw8I90bAYjY5fnTK8/xIDgjHql9iEuUiP3Twpq0q8l3JrvgbC
+DZ+dcsPHN282+OGLUq8r+0MrPjCPiraJuSGCEntUeHqe2Xt2OnAFLqLFomzEZX4Hz/WbllSJO6l6JrtIzE+ZOeIHQFhJ7Thl8Y7Y
+U7RjOTLKnOlbabYqn2RSzy6IVpbjyieY3a3Xm91TUrB1rEWBVWhR4EjbvAJ9E0od7QKtZTujtRzD5bKA0UAf942TqjgH+phvHK/d
+BJkSZzPWyUuV1ssMr7lqUwzwNgTl9Zkbj+rU4hBQGcHbkXahcJ8Gfs30FS2eFfKsMGdkClFSMMvNmI9SavMMfgl9I8DLfHxj7Cch
+FnXfEHFpwXTvlFHgW4PIOxHEolM63oE1pkhAta985SsyNOQFr8O+8pWvaNLMDy1UwXVM8RlrinZJgC1prjIcKlYOYmbhtQ2NJPiO
+Td2Wx2/burFLYCMO3dbkbwinWGlWz1nL2CQkwhTGGEl7R3iTYLgAvMJlwTRRGxvIPRZTnEV52NiKNpxyecvMXPbXjbKOcWrh7iYZ
+xGdTOIkJpFA15MpavgpVWU/cfBwsZqRhQ0rn0YYDd641gtHQpaujvATcTVTcG85WgluESboFF35svUdcg0BD9R5lxggS2rphkAE/
+IcMbhs1cTZuiXyUqSAHdxVz52faIvj2uTYZBXtKWwpaMepQJWyiSdGSppPdKpbhNYl2SLnEdeecbBJ7YG30Q21Jld00+DdOzgZCA
+4sEpOthFX/cJi+OXc4GzGai+RgYJMuZamftGdlfPhsE2WGEV46mBGYISTRnjepJtBFsYWxEt0OP7wKz4tGVVuoe1JpJpgkzwt8mE
+509Y7gReFQKzvgQlwOM/jhZUJ77OT2KMJiuVUnOrvPQ0UjYSaSoMfDZg7sspkUjIrCCIuwyeMsAgChvh1v81FEmTI3K5E+rjKALx
+3Ys3B4Q7fk0XKQXLRZ97O01ng0wZbgQQjgjK1ZlhahjdhntqibVvyaLFN5HxyhfvcJMVj8sZ8WI2ypghwmDI6yFg6S2uOWwrZIiN
+EBZjLj/7lTpvkKIrUVplEJEBNB6LoqbQcDzNhTr5MgZ5K9JmpMsuakdFcSsOBHdThWJZ+YjZbitV1LiCaVwYL6dgjGlaCsUg+UZm
+J6aiXU9aBtrw4CI5C4WXKGZs0TBdJjBVqtDgGOfihd0fLrq4tc0QYgtHbTax4HtqbCRfqhKvssNAL2iQQXhN/OzXme5xuShurA+z
+SJFguAE5WxmmL7pvXxhuFUdhQXxVVlzeOAkt1u61vQ4zNY3kIs5ySxYb/YvxUDzSohx+xBWEKC3S5CvIUFyuwlfKlAnpFE5WhAlV
+nMBv0cSPvB4t3aMqtkUT1IAmeDxIgo3TyXDoFkXalYVkg+zaqVlKFlgQcDeFF8Z4OJfZYiIjXlxZYomakiCuJolEHQlGETRREEhV
+9oRfxS/FoRnVe6wgvcZO9p3FdtMpl416LTZZWvY6bCnUHbiOzJUvZSKLpTXilMEXYrulq8VDPf/raGrEGeWyvBZYMIrTc5FbJEXh
+tULO8JwjCXhAdEeJGS4sxNR/Jd8kcks4SjxOvKwAoTDTwVkt7MrmsTf1mZm6obhKGevuDb+I7hYCfp5v65N1oi1bjmO7uxNwZgHy
+SDCxZzl2xwo9P5jo2D5rh86h1Dgml/p2DlH3+MHA9wYVmDqCDyYGVvuqtcuqE28HLCmPu5+PH3VjIvS4n5Wwx/rVicvM6kz0PZ9N
+WOFELwwH5tRUd6faZ1NRwCr4ciX5iuojOUy2VmzEqnZwmX0nsn3WoUye8qHEZDKy43mwf4ZXTUa4GsJkhPsTNJn0bcQIdxhsMmK5
+8B6+f7FrhoTxqzsmI9KbDCa7wBayIEzxXJb8QpPNkAQ9C3/ZgdUOzXCUuDJPE4f6xK0mTy5xR6/HFHVuXqzXG8Id/Ex8QW+hkFty
+1+5jO+9SSV8uldjG8tZwyDaWtoyxPkzjWCNxOU46ogwcPrTA7w0bDutTjNL6VGiC42aQ30E6TN5sxxsO4d1F+j4IQqt9lWpagjrA
+FT+pOvC5x607yYBHVKPWcLhCHOoMhx4ZQNhAtA03frRD4IPxY+CDLzkIcJvRTJlv+BveVsvFK/ltqTDBNO1Kj01o5aisTXyglZ2y
+9gG0pW/5V2G7EUz4YtTAecIHWtkqax9w40o7REpEDPA/gCI/qGrmf1BxsfOoD6qaYXL9lh53nDGSxwM7ttvB5hBYsUSLfXWwp3Bq
+4I0/ZoZuijVCPTYyJLGHh1CYSu3rgTGp3gpAOmpnXeQFuLvMG+xBg4HdDwcMnq/qgQFJwBeOzTrQsR9oZR+bHYdB+UArs7L2gXI9
+Fw8HYptr1WtCb1yljaPUyFO2lOk6y+Nntbpt6ZNZVm6iZwUTtmid5MwJ6Ux2wnYDu8MmxPwnb0JbMbkm0ztlyzCOXpFw+7p1AsLJ
+XS/c6EtuA0BALBnorxnJwwiHMh24B4lV1jaQSbc0EuCU5ahuwHDXLcnmjF7aJ51j+2RSB7IoxUtFmyVu2A2HK81XJM86lnkCCsnP
+wstivAGxXtqk/vgmJRfHoA4koLVmkMRgCsplmD0d3SKg4zBSZ5YnbCWfB0R1X9JAz8W2aeWMe2ZmlDWlpVnVTQuaa/rVsGeFlxEl
+uJDynrD3f9E4k/JDeqCRiO7L0OMcA3Qo0atOUdGJhhn/AFQg3hbAFG4ZGBO0ZwUX913ZYt0RDD7AIefIIVfVyo4YboOxw23wct7c
+PQlv1ppWwpSWDAyGCx/ofTesLSN5kQSGUfDZY0lYQDLgt/zslziyz7Fb5nSW5WwHbHRl1CzmuNg6wENBRVZTegpM1TCWN6sTq9nZ
+EzkThIjYnWHA12SftZm9xzoTXw1A8sDzzYmvBlWNrOq2gb6VcwOosMcOx/fYfwU/f8CL/yDHzizm2w0HqT7gCV06GMPIXVm97ujl
+jLt9PBkiTgaH7utRlgzOq5LBeTUy8GZbOpwvQiUU/VUXKCMI0aNso8uX+N6JanSV4QTYHTdiNt0zVkfMLmZ+Fse6oOymTUxoRlnb
+dN/Br4AurQBf1bQxQ3mTt7BDe3pEuko/dkU/dmRrOi/vxh3QkAlvB0mIhrYVMHmEbeIDr5Z4ULzQC2vuGjo80GDzySw3Tg55suh6
+M3fsoFyFFTZPO0YzOQUNh0OW4MQOZl04A4Vu85OQFNKvgy+c1Ut3VDSsMjf0bQj+iudvk7pNPfR3rBtGtePBARyUtaPb/BJY/Ln6
+KL58XfiWNAvhr8H3rFJpcke3IIxiUkhceendIc5JeuFA3T0mYYZg96hr6/FjuKF97WtScXnF2tXgJHB8AKFU6AieqPT8frLzFe/F
+26ycgKHh3KqZKQezPH5JK+7dA4xjrkQiSr51VRw6JWfa3P0GTbYhmlbmuoyQ7gtbKCUIAj8cT31+xQrjdztWyLRmFoNXUOL4bJcd
+DGI320rtVhNKQEWl8w85DkTbU5wsyrRc8Lea4X18whpxUHw4fgdfSTPDRKgww3rO3k/Y5GZCQnGPFvkkcwW3lMvHhbLKRK4iS1T7
+2tfkk0ZWqLa4aLmee9j3ouD0aY1clBqmri4IYnBVU1ePW25wrZO6vhtSA9XV5XxiSF1U0sGG1EolEWUMqZ+CJIQ0AzVVedvJgZ5b
+uQ2IjSA1Wr1Yo1WkMRyzgOP8wn6Eu72JwHZ3HQb6kHa4yit43G5GN1R1XIer445Xgdpx3Xf4ka0wk8k2xOcNsQtq7WZrjdUFLbBS
+V1BLQf1i1eCeUA32FdXgrlANHgrV4HasGmwrhuooPifP5GJWbRiRi4ra8CK5WKA2VFXdvpA9m4nXe884Sgef48fUly13V5xRX9pg
+W8pMGWQNZeMb0+A5pelWKk3DB08Loc5Ue3JFPROlDbeFQ+SvaXB0lJxfueodXR7qt6x9TSOMurCmEEYT7+dXCGwJiV8O9Li8Kror
+E5YYVVWB7GSOuYnw+QJhh2NJ3V70eFRl5RzELpcNEp6ms7ONhblSKVyks3PT9YVSyV70WrpbgDw7N92oUarPzczWGyXXaAm3I7pe
+rzWmS6GxuFivGWX+5BrludnZ6TnD1AVaaBAIoGqY8XMBOduqgwWdkfyxXUJUdhq+MAuGJBWKHyNhmX5bZ6dPn67XSlCNIbYO6IuV
+H2LVmCHwkoAGKkUH6ixdmTm1WAdX0o2GCf6kFxtz3K80eJLmDwvz5kFKXZcKQN5olOdn39TZYmPOqOh6bZICnWbTujLVP2wNRVpG
+3dZlnU2tGyY7fbpOWJnCI5iInT775tXTp+tNv0wPDIbJZ2NSXtb9sn62XDfeZFM6K68aWQVQzCZ8R4ka5i7pkD6wzV4yAHZpjRzS
+JbJNl4W7EoaXXc8K89EVg7iLNXTOCPpKWmv6i26zXPaNFOP4xmlab5wqlSxdc72wsgOXWjSD9DkPZHC5OG9T93St5ZbrZq1pL+41
+OX97dJcEtE4iegAhe/ewSKGUqGAkXg1s2AZ6jnENojun6cFw6Jy+rOs7lV1jKoAAS5aueXvM7zrePlzlKFPnzYB0abRIt1v7ZnSa
+bpevtq6aUWWbTOrOYteA8PUHRoceVLokOH1Z35nq5IoJ3qSdZkj7go7lOtmmPX234oGrW0o9g1zWd6dC4/RO5TD77mGZ8kyy+1Ua
+kr60wdotl0mNHMbTclvvZ5Vg+W5tkwF0K9kju+SQbJNVaWfNKMwZal+HdAntYzy6LBRle81yOTD0PkXt2CL24Crvsm/rfdFPPrXp
+qizDjhFWjKYf91pEdwqLPA1mNP3FCPwL0z4vb5f65TqJKiHvJteY2s2R1y1TPaqExpu7JKRRqmi7Kwon/cWwVCqX3dM72df7cTDo
+NnXJgB40O3SwSL3Wvjk4TT3s70HFI5N6e7FjNAfQ39u0XemQQ3pQ6ZCYBl29U97+6iGpgVVcm17Wt6cOjaaS3eZZHu3pLtklPqU2
+xlYm5bI/KpddUi7HRjWrBbPQnjILRZmJUCRflCEIWx1dOsuYMaqhd87bh1CVAdMN9H2bUUodX+r5uFTtwK1UtDLwF5YCPHZIFeFd
+bitLJfgPwyFcwWtiWQxwtQ8y7ngMv1Ty+e53m+zQRn1mfubU9NzMPDmg03Nkn9bJVdqYI6t0+hRZp/O1Glmm8w2yBJMLCLUVEGSn
+3ocaT5HzdGrj/c2DRq2yeTC/ujVFrtCpjc2DxupmNF2rNTajtbXaKvydq29N7ZJL9EiyiKldlNAEzikTLmOdYGLf7jB/wnZDtsv8
+AEQoETFLI8q0ZmpnHYftWo549zSdqB2cqk3orhdOWBOINAGn7xMDz3ZDQyOZCcyMZTf+PCJn6UFln1xWAguQb1O+TUSjm2Ux1TW3
+6ZF0UqvVq9PVhkaidtCAcErwRdMhPD6D2R4RkdSRSX0Sekvry2fPmrsk9N52bUzdG5E4yIduF1xt2h7F8eNISHjMuuRY1gbJUQ0x
+N98wUNuh1+cM44RHwvVabZYfCtdrtRlpLNBQj3+PeLjCwPQIXkwxbRJrWkz/pGfPMzPT8B2hmUld9FJ0xsSjRxbE11nxwsCcrBN8
+uCSxeRJsTs7ZfTs0GzVBat/0hWca0mEO5DHf1Eoa6bBB2DNnoeZWn4XM5y/W2TQZOJbt8vpgubzGsAd6y3I7cNhrTtZHqfBwaVn2
+aIQBDXjsqJ+ImH94CaPvtNRAO5stvPsNZgI0rKarQeFwu9biTGBmc0lAfSGehtW4VdzTPJxtKe4+InG2BWsSDSAMRpcOkivbW1SD
+SMUVsL/ptpQMqhlmt1xvYk6vpTtUxv7w9QHxJAwTcFjN04dviqB16TfFVFkjPSNbSBanV66rOAaxOT+7xDFaLphgb+DN07YV6vBo
+yIe2YWJ2Own+RYL8NYHkOhOGmoitc+HOc7NSsTnhPGJRJi45axtboDaxDA8ENo968ou+sNv1qFtVeaeVviIMNDEgNI8Iir2BpUmf
+TjWjVNK2UklWXCujZQmy1EmlbpgWiSgOubNuqAekXjOak3ZwwbqgR7Bug4lCqSRMAiOD4mOEQTxc7s4btVCQuEjdajJuWjpv20a0
+RX3D9OB6hz/yqad4D4xytLS7iQm6W41Hqcrtm1V94/3qxlbZmNol2sZX6lvI+R7c/NjYeH9jc2vrzS1jiliZhF0SUa/KDlhb90HG
+jFp+zEMRZ1fD9EkbJCw4VeQHquluKJUE62SnGOKAqwu3mplKhHKrKZybOcnR8QBOYbixoB5RS9bLKJUGi24VZ5Qm1mAA/ptOXI0I
+Y8El3XuSWsE7sVQalUoiGY6tJYUkfeAU2yCB3sbeGo3GGvAIo+GWX7WCwN51UdlviGBSot2uHJFKHColreAUKs4tNl1f4Zl40Bl6
+4D0piZpV5Q4c3PxMSidr6Gsgl0HcZEakecvlJHM45GYgoM7UlXSjpTyYXgIT0cO0wHsWZrTEL740CHtEHVlFbynjTn0wPeWBpAYs
+neTXZZUkEtO3SDcZZ7ZiyIwnVaIMVhprHNUKxkNZgU0vgUmaxQsLSc2I6UfTSz2SHNOPr1WC08qlmF42hbiZJbSoO9IYrWyC6WUS
+iFuw8hXWuGiBLEg0vYJEomlp3b56iy0+YjvRuiOnMTs/MFjLwmjsYMN4slWMOCmzdRtW8VqzvegkwkdbnpY6ENSmSyN9QOyNwRZx
+jSaIMH3m7zI9IF2YluIjcLxH2Q71wHhFCZLLp8RT4lgW3fYvwzo+IrbbsdssMNNToIKklUOYOUfEZwNmhYVlwf1sCocmKSP4s+sX
++dpLAnqUljqF2D9ZExCIpxwSCe/AuVdw0XUOUfJkvo0xWeAbRTWw+JICV6qCq/YAGOc4kTWiapBB4oqYZaCJAqVFl/RIR7owHRdt
+3jH6FMSwvhG7p+znzpD6tKsrCIJ3+7g4ekaybE12WpHukkAQwTdMt9kH+8yiQPj94TA3bCEtN+L6fHLnUbVBaQKfjSTRNnp6p+Wa
+6e8aZY1q5Z4e6X01VbpN3ujprkQRclUfcoFUe0L4iIdlXzZwD1l0t5k7o3UMY5c6yZ3Tw9RY6hvNXdpuiYhZbcM8jCWQbVprbi/u
+JmNsm3fXDt3d2N6CeD+S1Bs7EG1zj6Y/3Ddae1JsZTogEVt3wWFnEScYZhbZLeuDFpyL75gwQna4bFH4bjyk914mceAO6Fipw5I9
+oka/TNIKpI44d8yFOfd4qYMbBMY9aiViQCtIYFNJJ+2Cqd8qmvqLEs2gIJEMiouUA72lwGaQwKRb+B4nSEsCpmRz0iuSHGICxi/4
+8Rs+6RS/Agzb4j/cl0WfKv0Viw2lkvJA9saUpc59rcyzGaSfye4xTU7m1FY+yQxySanBbFW5lsOQALVlbM94ept8ifKCT9K2KCBk
+fkDiYov5Uwan4EgT3oCfj/jent1JQguBcnmHquVuyHK3moVE5SHLW/o2lTBx6bauacQ1DDNjlSswQLmk4B/Sbf71AzHvJVZI4jNu
+LKy4sSECvrHf3Ac2gNLXeMNsd8JrpZJMTazMmu1OWC2rKh5bcbqp8RVZS1Cx9KvU29jfah4Oh3p6PnUNg3RKJTGfdhLLpVVaa64u
+Hiaz6Sqfktbp4cbqVnMQz6buxjrMpgf0QM6HkQ5pZJ1c5XNeq8cZflto/nfILkyB3GjggGuZHYMs8Y2LBRebVMWQ1tLM5HhtWZ5Z
+1lpL5WUI4PyKkYulmvokbAkvjIonaOLCAX4INkMgvLgY2YZqMr7WqKms0nHvs+GQ3/xKR521hNpjarM8tdtk8YGt8Mwf0TqbhsgL
+uRXeq/atg28w0FboEY2f5Bwdn7RGp2ulknM6QkctUdLJQixV5FG+QhE4smhvxTqKgGhfbdQ0OJ7px4ow12junaa1lj6g/cQrwB7c
+8Y+f98p1iKI5oH2YeFGhxjdYb18+uywNevWBQTpF6V2D+LpFekbL1q2N3pbRgr98N98xTHigG/CXdLb4U2yBZo1EDLbUuB0O83Ki
+tsHHKLdCQi1TjjekDVQsUb56tGwGDvuN2MwGvPeHhmLDurEljivjTvPLZUPcBQ7RwzI63IwVd5kY2SxrVseEOZG0pJOfLrScm2At
+LfQjppla13ICpjVTJnkCyQ7WbNfmwQzhjmrGqgjGYnPsaAnioZcbNETu39D7Al9ejILzmpavW6Csj0uO75owN8c8NhjTlOMguJ6O
+dsstH3+LjpiCcmEpzIgP5EPDHIcEZafwWoV4AVRp7HdgNmtyl5I/GrYlljr5p4vldgyq1ytu1XvCidIFh6nSNXozMTN7WUR/Puy5
+lj9g/EhlziBx4O4wsVDlmfPGK4XWFzUpmF1UFWwZVK9g6poKsZr21DClb7xPW6WtskFb+sb7pa03QQeL8fRD6nKFJxgSbvh6CBrI
+LQpAYysZtHZSuieu6ONQ0JpyDmjmlgwe9EVrKebWPu8Yub8lIIWIiaKAs3y+NyvIQUfrRtYYqBWWXXHyW9KQJfmS9hI+aKod5RHZ
+n/Zx5lKh+iB2PEmo8aZi9xuTY8B8FOJc5a56Kk15qLrefqvw/q5ocgZXN0ZmLJKHcfF4itzzQ7vPWvoxxemublQsY6rO5vgZF3+H
+uDkXAs342qavG6TOFt5kYHrFNupbIzxMhKulkBxWowGUAdaF1KsEhokKFWjXcTWZkFi6UbHBhW7ybJjHN4Ht48tGdZeFV/DLvISi
+nOQcFTzWqIeq043sWapxlHz2jUqlsulGvmNOaOJG5oCFQej5rBrsW7u7zK/a3tReY0o+fTvwXG3T7Xj9bbtjTmg/JjIqka1tuvGd
+0rfjIgNzaspzHdtNlygRtU3Xs6Kw17jM+C3Ut9XKOF7bcnpeEJrTjVptimNWfIFa7YV9R9t033idaQjtDba3WXDe60QOazHzSK6h
+bDQmvPPRKDvyw1gGA0Om2nB4wjkatysuBPNIxVhwc9MS3rxL2TaP24wtW67rhfzCrTWBQRnhqmqiMVCtR6ykdNVZz2XWZT5z27JM
+YCfQPrhvhBM7jME6ZIewnw1YZ6ICJqPM140UBnyfdeI7oJMwq2a3XmGhMiRsMdVyPIirWISbzAnF1FiHqoH5qs+CwPZE1Atmhz3m
+gyIF3kaPQzF5CF591sryC2BDMM6PMk5EcSY5UgyxzSO8FGCyVPDQOtn37VAGEs2EGQWfFbC4iG8ELIzPBC52W4WpSBsTGBhrsb1N
+Q2NU7J85JFrC5hoR1ZuswUerb7t9L3LDZccLWEfIe2KnI0rj6q60gJK4nqk3w0V5U6vIm2qcB45UU2PHPZk44/KhAqOEwniJ9XUM
+dPtjraBzzm2L/NmiG+Nq0k1UfRgOJyHiqNpV4ENQQ/LBrh88lPhV2a0Y/r+4AxjxQcgjfuzkeyJZdwk0MDY0R89RCmO5BvExjfgG
+CUcjHgZMr0GQYV9vwwbP1euwl/Nhi9bB04UZo6lnezbVe4kBPDOOPFwziAw9Ry3+rIcJb8Wz2m6aB0NDWXUIUwWY+Irou7bjXOYX
+8IAgQVGUvaodXBwwl3WacBgNWHEKWo5xjyUhDDzoVvC5i7PQkmv38YYpjKmg50VOR7QajhHAr3bVcy+zIMx7DhLfIerXiU+ZeKEJ
+QWKVr2YKh7EDBmlVazBwDoUWjsS8jl8O4E2afTPbPjKmPVm8EXFHsdVzSJhBYHnfOLrKDkHR5HaYrxE+snNtFQ7ccs11qS7bS2CZ
+29BklkY0nq5BRCbhWwwbhPesCuucuC1INZnb2AykMlJMoOLWg96pLnuOYw0CRqJxfesRWS10OALV4k66ODwCneBoBP6kRnrynUuR
+z2L52jCw/fzmwFFcXC/Ghj244m5BfiZBAKKe7KDvSIx7mwXmkWb5tlVpR77P3FAza4QndFho2U6gJNgBTCKdJKVndzrMTZ6FHWCS
+ANvGnueH7ShUCnKsHeYkj77nsA4L2r6Nqtkkw4pCD8aow0KWpOKNC7UW7GBguZ1Uvaxg4A2igfJJtqd+su91LPUxckIbneGnkwLm
+sDbOnEmG59vM5XfGk0TcF/Y8Bzg8SYRVXa2Wz6yO5zqHagrvyySFfzKV4vlKr+Dw6VsH2RTbzaS43n4mJWQHSkFW6PXtdvK8EwVK
+xRx7j6nVdNielWIO4J9ul7WVtF3f2tlRa261Q7jSzII2czup19ue0/aiTAoKqamUYGC5aoIb+l6KI5FrdlhnR6k6833PF75qklSw
+hg29DA866Te9fVcpfOAFthuwUGXU/UytfW8/U2vf20/XOmAhhALSwCXbysXzS2Ho2ztRyC5YfRh8mChXYZk2ar66M6q6OLifnp/D
+g/uu146ClYvn4/lFnXNt9F+NF6XWfK9/VgjufGeWtmvyXmPforNqO/Sdb7BDCOZgOaGA+iy0vsEOwSgrQSmVJEpWmyIUlFIzGXoD
+bAx3Bb0eWn5803FivdrOZDUL3ll1O8VvrLqdIvy3B/yeZNErPG+U2wtxXCjoG+xwxdt3Na6pRB0aGDlTSg9ze5x8U7/BDt+Ob2Wi
+a//dJASvLMtoKuj4MTO+sCxQJik9VLEuwawkrn6G3uC8FwWMvymTzjiRr9ycPuZecKDKR3y9kNH7C8zr4dKpBUJp2AqrAHO//OlL
+aFzUhDIj4sChyHYrosAN5pKwq8Gzi2zvGaY3JhOZwSCTUco7yn6ppC8Nh9EkLcBuRZTmvlAqLeFxyBIMnBUrtEA5skQ7KG96sITp
+fuyMvq+kRqJR3KiyjS1XDRYGFI7VpDHkoFTSBc4g3kZ0q1a7HfUjxwrZlX3vUs8K2IodDKyw3WMBiNjtnKuvYwePOhACOFjIM0jM
+R/s9u92bpPQql5L0ZdhhrCavXGEH4Vlu+C+sHrH6sZhNKV0tlZb52272ZjEPzaDenBP76SX8W1Bvbrg1uQ23YgBZ7uKUjpHf7lRh
+5bICpi/BeSEPhpK6wpqn0iUrUIY8MsvLiFMqTfp6aLQKbjToAsUwUyWN6Yp9TiRBwOMpNUiPFgyqBm4ADlpcvW628cfIBYHz6J7C
+nevVHdb1fIY9KDlVvOJxPrTJS/jPM4g34r47wNHdNGz1XL0xw/d69Ro4pu8jNHsKzgQBmoMwC3RjgdSnSWOeTDe2yCFtNBbgolm1
+bblvB2zl4vlSSVNJtcfcECYQWHS9fbLDnZem8TteGzc4570O7oDlc6mk71D5UFWx5BF8uhxg68wHwX1CqTSZ27vw3Cqs4da4+Y+p
+2qQktSquu5RKsQ16nAa63pqxSOsN2FLvp+unT24Phzul0s7pU6XSziKt1w1ylU43yGrRxRr9qkHW6ZHS1ebRAHqxc5nt2kHoozwr
+xI+daAfEfFPz3DPJCxppW4Mw8nMZyzxZgxs5A9jkuW3YVmwU8DlJjSKSnj1IMvq2RiQ9+Z6sttmvqRVO5x1XZ1z+yDG1x9WyoCl8
+xSbpVTXdFFxeXrkx+NbY5mDuKzdIlPnlm8TloFduE39tbKN49iu3Spb6es0akWVwlCwWihV6xGD844bcXCfsIPTBFwKkpW1dVfXY
+RiLFkGSK3kqJ1CsnNsad5zJ9Ay+N6a4+vWDAHbTZGvzUa3PzBvcuOj87z92Lzs/V4OqS7qLj0aAoRpeFV8bhBshkHSzt2kGw5nhW
+qPE4tfEcIy2O4zkzrRXROvaeZlSD8NBhzdA/PBpUu54bgsFp2xJrqkMna6PY/Cs3+YqieBlVWQ8QgKiGabxehlhbjngFzlv+1Wiw
+5vnrgJK1OuaygKZlz0DQpCLjO803pOevGkabiq9sVSqagbeV/C0hl0HMW7dMIzweNTW4wevpPrFISGyjrDUVzxjcYmtEAhaiHVxx
+TeVVeZsyQUNZYQsqHBoY7zlTYcuQBgzc8ild4Yh6ukVCMFdwhaslrYsU5EF7k56G5+FQt2jbIIFhC7W9+AaJEmPjyLA3rC0aqdKq
+AzpF1Or0LLcj31sFJUyAUaws4c4r49TK3uhuQcfwsqFYMABJm4C8oudmqSRfVgSaK3Dly91lhGMkGj88IdF4pkb2jxdp/JT3Ulvd
+57heBzfvpVICp68rN6WjJNTlaNxTlMYvw1I8IJYRainDaqU2kfJLvn6WMLKig8p6vbqDFevwCS6Afk4flBlHB1XmfidiEeMzlM4M
+clAVF3wx6ScgU5+s512HXKKMnKUhuVS1wtBq9xBd1zxXUssz1G2acXSpVNIv4Y6vEJlc4lPoWfwxcnsTKbBH2BocJWe7y/h6R/ir
+BwYPq4HNe6hTKq1Vt9HOdV0mXbKC4ErP96JdDCHhDoexY0NWtKHApYJXUb3vEeZEauMIUNdAf4KYLT2CE3Y9RMPOeO3h2/pIN3Ju
+M8aScyAGi6RUJ+VAYwxZ8y+NJS/6xhCHP8hcA0WxVCo5wFFGqQRMlvK8UNzqnm6QbnGre3r6kn98eRHw1pHtbc9VqD0cJutu6jnW
+kMj+gEq62cv+2bEnRzUMpWRcpUdhqaRrqCve8Q6SoTYcar7Vsb0kJe07MdWSZcduX83ULkzVbjvzxllZGdHCAobLFrGTCq41SVni
+h2d737cGA+bjAc9wGKYTkOvh4EkoRh0YJonNJw1588QcqWnlULiCwzOyWBGpC4YBp8sQdqwaFGTiueCI75Ngj9eADYnY7F0VEsqq
+0EKuo+hikGX4XcCNtz4zN22QFUCcnTbIRQRmDHIes2YNcoUecf4+oSApxkJKesS0E4qM4v2kk0nC/STpyEJBUoqOWT4HATI1Msll
+OllvXk1t2y7Ti7pchIBFJwu3pMNhYfLpU0LD9O18wd+GgvlYePVyF0S5aymZ9woZP+XCaea2HSCZcKpal+7YzW8fLypLP2YkIBEN
+W6vFimjD5LtqFEr1yGhdblm0bQZ0YJ6Hx2+3LLpt6hbdIwHtG+YuXtfWLXpoSJfiDrXE6BT3maUooDtYjdEoKJVgBYxIaJDMDLej
+hyRKa8LXTqqJFxEATp3irh4aM1JIn+ZC+nRdyOjzBjnqACv4XhQ4h5e5fSEQA8K+cEHXzIX695J+Hw59XZud0wwScnBeM4j21pXz
+5/j8lsgqkHkKMrM3weLl2NJDEhgbtS0wKbF85mKvSKPHZYjBgyHOuNtJO5UB1b3iM27VMBqpni2iE1FtQ7vMgoHndpiPXHPJiXZt
+GHLrNpz/pdOuWIN0wqoLF0mZtZdB5AMzUyCO2nSaos1QM7Ze5ximIc5h6tO89+tzGAHiqA8bTayoeeRnJjeYvs7H+VrB1IW5F+WM
+xJ/2mA97cSwYG39MwZj/agWDqwd1NrCOH9ZBvAYmhQgbbbfqM5w/rlj+LkOrBVBOie1fWkOZKiPCrQqozlLFpqIDSLVmBK8GVT5r
+UEoDI6JBsm9xaFD19l3mr4h5rxlRp+XIs/N3bLY/HDqC8d/FQsQMhKtemwxyVcNF/ahNw6bQfeabGXqikc0B7bZsmOrQ1iUI5UwH
+sx6MM73LVcR8dLX5+jHgN0fblNJBrsE9EUS03YpMu3ASbaMCFpEGY5EGoJv1lA2UVU04irT5VrIvdk+Y43Be6ldDbCftkX665bTT
+5Jc5C4pFDicDXqxo0p5aOuNDYE+W3iF7mdJ7xFc2b8nYV7ZvcG2oTQYG2eiTvbTyJXil80weo9b3vFCGPdkOQssPQW3JLU7AvBX9
+7orsruU4O1b7KmJgj8rgrfUGnxHmRUiYmbmG0YSTWMVGr8NiEz1FzazUAvkiWxElMf95ImqYd02amIklH2glIJcWzSRhw9KNrRER
+xyxqeaCsyH3eSAWPV3OQPZD4NNMU4lM3dsaWJbBH47jX3AVcrckWIbbVBtuilNobbKvJymXpuN+vcKUKNwAEDYi74VdCjupVwq1m
+KJEDGp6ut+qVUBh0N8dWndrCJQgjQVGXY2gvuJIGbA9ukD3QJbz6OnJqwcBY4Lb7bS5kVs+/vX5l++311e1Lly9eWr185T3ipfLf
+WlrfPnPx4rnVpQvb7yyde3uVWLn8C2+fX718dlnkB7n8SxfXz145+85qBjHKIV58Z/XyuYtLK6srmW86YLq0HAWh1483ESZ3DaLe
+NGFBKILFoEEvZOva+zqcNw0t37aMCnpfWbpy5fLZM29fWd2+sHR+dXv5raXLZW3rza9ohkFUEyar3WaD0KwRDsCxR8DkcxB8gx0i
+DNUHAITatchx1ts+Y67p8ZQrvuUGuAC0Ed3BAgL8c+i2AS0KvWVhkATJUehdcqxD05MbEYCY41yyOh24iVzDp/WB1RZPPctfx3q1
+e5YDrMwQRoMm0x56pG1jyWgYfXZFQriUg0UuXE6Gn/WB5WKCGzI3TKDVjrDfFSkH4XnmRuLJh9e9GDxnB/xNz+9AI9u+FwQ84LZZ
+I3g+jz8MrPcBFMeRHkDMh1/bh3RhFgYJ3r7reFbHjEjHt3Z3RVWY20YfuzUC1yfEz5LsDXhYTWGcZ2HP64iHC9473AwfiAsJfBWA
+XN/qszOeD9epa6THrA7zA4Qg4CYAaJ5meqRn7/bg2Wdd8XPOwg4B2/w1D18Pw8HqdyJ7z6wRu40Vs6EKuKOCPRLCIdv17RC4w4Yv
+XWWHlyzf6gtYNOGq7cKbaGGEv/gph5Pb8byB6RHwzVcjfcu1uwzT+5a/a7tvyarzx3ftTtjDpwP+9xzOfgCzjm3J36/7XjTAB0G3
+PnZh33YT/MgJ7YHDkMn6USjYzeWM5Xpum/8qtPYGSDsPbpRE0GsDvBcNJSvmbvzpMDiLtydMjwy8IOTJPkNeAMgDfadZI6h6kdX1
+mdXBa+Me8YGjfOZf8hwbB5+PpJPmcYgBp6Ic9HhZ3j6MBt/bx9FgkcByOzseUCpoewMmf+GVoO17GFoMEpnVd1gAQ0Ea2yEtuP/n
+GgFrLTPAH+jXAAoPSDBgjrMMIxXS/Db/u+IJQPBT4Lf5+MYFDeoUsgE+HmKdg6jft3xoYGjtoBNaBAVDh3aIWCHnoyiAmJ9mTZjM
+1si+YIf9PudHUAbBNLTjRaEYsuJV2xXsNsA70QhwTSBSVYR/5F/yYEzseW1rR0xpy9bADtEdgEzwfJ+1Q/G0DjsNnIxw4Ngh68NU
+LMB1JL2HsBgPAJ5dEcBlHII+CyInRPKydiSGVOQm9pZjrOXS07vGHytt/qwpk6WGoBYPcK3r+ZoyyPHuTgXYa08rNMGDtPMRt/Pk
+81EgL03kNuRcxA6T27o+63t7LK9ha0rt3GSijpys4069ZwV5/BYrVsaBLg8uVqC1rR0elkqTyUN1x+rgVhYOKFJbnio3yBRbEr6z
+Ou4L6cMZ5yWSS+qeXj7GpjzuSjwuwc0GrvIMSyUb70+4W9TTQwgeZ8Ri80JNeMycQz2KDgqVWTj/bMzOGiKo4ty80cQjz2bqQl7V
+1o8uXFxZ3V698I6pDXyvE2GtNPLu6plLS8vf2D574dzZC6vb61feO7e6jgb54mLJEb/MFNpWyFUcPnOzW15b6X1WYGdzFPuQBy2T
+T9BOhvBDj3Fl+sKbdVtETIQYI5x0XcLF3u7488GuYRz1sFchcoyIwlIq9arbwrpbdD3p01DEluEd0ANjqg7pG4ZdFZGIknufPdIn
+AWkbBN6hPb6nhoD7PjzjrvItL8Cdpd4ziF2NxK0OpYTJutAw7lFP70MHN7E04daH2tXMK3sKHZrituquMRrFFGDGJMu3fzjk1y/z
+6TqPp0NesdKjEZE5RT2mXOEZf87sGlIJjzxf8C2fZEdbdPz9yLH7hsZMXbqaBTWjR4/EEaCsvjhBNO2qonm8VIhD/EKFpF99mc7y
+9e2HM2euwuZUn1RuuQ2H6hNeiLDaCS2NzGHqiYqAixjZYvgmkqtzA7F9x8CtDbCdAErP84tOs3jTCRJOibtOoPHtJfYaM7PzBreF
+W6gJSzg4H9kTKmBXb9QWDDCH43MaOZT2G0dn+wO4IgJrmVkjl2K4TvAECMSYNdFayzEbI6OZDmrvdpifv1fUBe7ntt7ZmQEXJRJS
+bgouL+T0MPQ/7if4A5/DfOXiPvd6TetkhyYXDVNu1vDFzOdipYrveTgWz67QmkiSNkboa1tVcchrpmpazwvCS6i7y6Yue25o2S7z
+z7pdT83kbTgDGqMLuCqrmaAmtd1dWc18DtIfT9QLMsWgQBywskllrnl+m/ERluTxrmKdC8K3uFqozIt5U80U3aIm4dxyEbZFMS1D
+b3CO7THnXX58WFDlZaHDCFKF4zXZZfWanLgxRcEFXnoSK1AIp6sY5Ou3XS6LRKUDw/G91xQemGkRMwluHYhMOe/xr+sBDMnC15Dl
+O5TBqOD9gv2qwwi1YXHYpXFDOF+ryxRGKDCae9zsZ5Luyku/u2L8tTy9Z7SKePqwmozo4nx1/OsO3ZWeT3aF3Lg7HEZVO8Bdm2iO
+vmsMh5au1WuzGulVO3YA+7MLGPu0J2KganEDNJx22P6EDw0trkTBVGM0dzm1aZvsyqmBDgi0uRvQPtmVUwTtZEftLumCzKnvYgaX
+CnboLr+614zFw51SSReJlBv6GiTvH2tnOEx72toRMTHqtTmNSP0htF43hkMtmeOhfSoVfiSDmxsWy0nygO5WI6DCjsO2wXbK4ffA
+OW8I5xLiZuF5GCOwoCKG9GKnOziyQPVojntJwcGuEU1csTuYDRL/LguREJgAAa8PdUMaD+kF75BdgxyMSAH/jzWDnBg3YqBNXhRe
+hH1J/NKIvASz4Dt8ezB2QDdjR0XA3ba4w2xKYEROQu/cZ4niy9znsZ8wCIJuoAmkR8f2SlKAMI8E/0ZV3+Ozrm4ZmWFSyCl6MJZR
+we1LugA+dFJz4SXlrSy6stQniWJkG0auvePWprxsi5HAUy09GZlEB3qFXTW+Z2i6CQTC03rpq95iIOhFyfpxFPZeSlRP0NFLSGeQ
+ZMuLDqtUykl9W0JA3ZBHEj08+jgcMHATNEZMCMR2VW007lnT0iycVkxS2quunr905b1saTEajYQnuE52OxYRH+mcXlphuyDXVxvs
+LppxIG+ibLAKguJ1UhuwMdUxkr1XwYSjnDrl35SRI1O8APvdsNouEGdKpcnwOHGH77aPlYdqJDbcSi87Za3wk7qhNQdV293zrrKv
+R5bfYR0pg+kuKa4mPzIJDWGDEY4peFz3lkp6Jz8+xyATZnxZ2TQlqBs/Uvn5OCl2vCxfIDwXbEQKheeuUPJB7MMR2e5bwVUxEsy8
+84jxYqdLQzlTAGkDvMUlXX1O9OV1fcXhOHdL4m/YWzyaRhJNIiPxjq2HUleYWeItHCka1/lSiHtMe/yCIYdXjdUy0UVZLlU3DNCn
+5F2gVtsKFlJJSHjzryLhpSkYGvhTULYQnk+9QtnENhJF39GIoBFU4gFmG9dN9Ru5mwAjktW6FV4W8AspT2yaYufmS9heKCCTCYD4
+hBEbjFLlUsuH19nuBQb8ACcWKhsIpW3BV1qdvPowRM8tBciEpYchN4AB1W3xRDEcjpsHWoXtYqSQWsclqjqOpFrH6QtGJPPZE0sm
+Tb6X8zgzT7/SdoVLCCSCPUZ6KqPUbgU0Fj9MPSjeDtugzJqs8ZIcKjfPbel0pgnnAy5epwYBLiqVvPH+co7N1NskkEHPxwtOgES6
+dLLWHNfJw6HuCbctMSVE93fpmBwddudys5TZ2FJ1/10q6V06uac7pG0Mh5N7UtQjAxDgjlUYdVv6SdYnHFfZ6rmoPghg+IGrvUIV
+mZtZrGzixXtvKZAOEoGTgmefIhIXXpTKCMv+uLFH7HSOukI3YznsJMt3duGPo6RPCHdEUJxdKtXxSlba8bEP1qlyHvcoTrZ2C1JN
+8TJsVOwWxNy0FuXbTUu604qoD7eTAt0jBZdxo1Yk3Xl5OIebkboLGdOHBeM9ZeJMnCyR2/QM96arO6m9Ni/PaLbRjtkRIzKgjmDF
+iDrxroI4GdkP3y2VCpPFnpccq4GV7OMR8WUaEvFl6pL4y9RPjYbLWflPh9aTNkRoOlbNUNR0Lt46BOlmEAcYecxnjmHmvCTrU3fs
+mjl+G0Y8WgNe3NVhCjcKVjaX2PEaVrQlCg0jMUC1aHrP4xrNAmHchUOn3P7P/tL7P/tL7/9YTlE7Tk17LEWIl3y94ORIt4iDkbVI
+cX5OLHKqxeXw7BERjcx3sKpeuujnpN2UX7JkzVaO+KFY/bgv5ApLJsrUSjRJxyhZj9pVwbVYDVQxvUx9UNgsuK1lu5bjHCpFcn8S
+uJF8zUKbypG38MpR5785pTSTSumFV9LKshHhV+jAbGTskAcj42jHsduxUbMhhCuXC1f1mhCZfBoWYqOJejeAYC8tAR6NTA4ZYF3q
+w32ifEUMiEnEQjYxrh5YwgbjVrPQ4HEcVnwMl+a+UimvnpPmwglnpjT+eBqeSmFVN8nhYGwmnK79SQfDOOGqgKW5fxPYE42dqrgV
+vHKAvPOKDrDqtekFYTEyO8ctrRdq/Ah3ZoYf4dZrs+IQt16bO8VPcWfm6vwYt16bn5XWJLYwe9UNYeN/1LXdzsrF8xd4NFo+YExP
+TAc5ddUS13951eIMIoPdRiRW96Yv+ppB5uZvgsg/uR7thD5jZ93QiydhczAqdIa9vX15dWn5yvbK6jtXLl48t7799XMXzyyd237r
+4sVvbG8XOig5/hVBnVLpRGj6Udx8uCtkHo2/DWH6x1yVIAXXGApDihWrwBht68wwCGsV+0Bj4iLGiHBNs0cus7bntm2H+aY1Ml7z
+vroa2zOkLDfit9FUiw9GXCjiKS6ZKyE9jgMzcQX8Hq9cPD/heh02sW8FE7K5EzuHEx9oZbesfVDVpD9XTcs5jAZyfHODVbdDa3cL
+LurwjT1XivjMlWeaoWrxsc7Cs67LfLhlZpRKe7B5ndcIL4WMa1hLm0DryYmwx0Q9J7i96oTXndDK495L6VEhsItBXlKngnZgLecg
+IEneL9n4Yt5FRdG4/OEQyqyDJbr8HFp5lkp5T9IyB95oaAS4wTAynuP4qRY6cfJVT9qXjcRRRIGs5VG7ug0MUCoJII5PTil9iwTU
+a4kM065up+wBm9/BK3/EP15Utwgaw5mM5K6XhWjWzGDOcUfGSPVIoK4ZGFI+PCdQdVaF8girZssjrCrLSzkpSJV1sTrwAl5ToZHV
+WcY9gYJ96XhsJ419/njstm4crVVDCIkonNjHWYN0QUzVJHNWmRYySEjPgdpVRl/hmTOaIUaQ8ERmo3W7cMonGMrM3AiPiRXQjWWo
+1uAMvyItfAeA4tqzwHGRgz+hsdXc8Zl1lfshg/hXnviCFcGd+OM+kAn08XXj63kzt1JpbAHcgq+gli75+oa7RWCvpNSNWyi/Tovx
+oFQjGuO/mTbb/d3/kELJa9AblAev8/HLDI2bNZ//jv/4erTTtwEzEEC28dxLH8LCNwl/AHHe8pn1OrUTsf41oknvv/DZkeoUwzi6
+guOKizByYGUGEDoM+hZXwHyFwH5Bfw/vCeHeAcbIrAZnUl8BcXyylvZ6ocYbVbziaDzcgZyf7SDl9SJZi9FgoAe702NVJKG1m3Uy
+IXJAjg4GVhsCphQej4mFSM0b+GzP9qIAnQONzVj2BodZkzaYVV5u/FZwktXx+srTsdZxKheo6cdYknUdazegNRHIkxtO7grDSR6M
+pz5jkG0OzRlkR9yRP0DbSeFR4tQCdygxM7MgPEo0GtylRANC04JPifqsdCoxW+NOJerCp0S9Nt3gTiXqtelp8CoBSHV0mAJJcwY5
+m5hl1mszYEB+mW8Iagb5NubVMW+uYRhkLbHQnJ2RhpqEe7MwyAXIbcAeg1trLhnkDF2t8h2hXOzIObpSJGaS79BlMbqueOS7dD23
+GPJYCAF5mx7xO/vgcoHfHwDn7uRdqm1vY3AR8g54zxDWydghY2QWnhlEA3RNvZy+M/au5bvwFb4bfIvW6+Tr9Cj0Bks7ng+XLeBH
+I6E3WLZcvPamtS0XNpZqonQPIfNC/owoKxFvHb+Wb2od8Sw928BE2x+ENvjzYBzgiW7bPxyEmCxBkdHhiR2Z4Ptw2UNO03w+Zh28
+roqTMuugE9ok6zwLrY6S3RfPMQp32Ye5eKkHMy5ZUcBMbQA/PAHpERMDHoGWmALuFjDR93aB7ngTASFMvoy3AThFfCtkCjXWGYOL
+gVqAv3ESlhxwgCeGaJhgagEHeGIUgMrd1AIOYCLc5RN6ay20+4yrVzHrHc+J+nFN9vBJqcu7lh3ih/c5oI3Ij9MjWDWAL3esAIOG
+7ABvkrbnwA/r77AOAD1MtfvIwrgKAXCVHe4yDIvg2O5V+AXiwy8GBwdAXFSarBEUugDY3+Hs/w16BMNHjIuBz7HEQoYY36S7+lGf
+uZEdsj6m/LhB3qNT729Yle8uVb61JX7N7c3qZmWzs/XmV6bIV+jRiHyLHo0y0g35CVpv9lVVCuV6K9XFNlyKP28f2C49Sm/3C+zX
+cpP0T5TLqWnarW7bnWUohvmvYybrHWMkG0ufcl0zjlQxUBFX8sIoilAIQbdlJNSU8KZKmQWrylEsXIg55yWWiQNhMJqXaC5ydbUq
+WRAPBCWPXpRKd34WybOMl32qzT/1GjXyRAyD8+OrdL6wSmohUji7Mr6QK6/VroIKJ7LfpfFfu/SfQMWRHZOCH5515e2wlm7RMC1k
+kS4NOeOaLv7imZmbQ+KZBhG3tKzh0KKUHlSDvV3Qd3k+s3fdWCdAu9yH0AEG7TIIxwUYnIkFe+A2h8ZjpmXxgkytb4W9VJYoBdL7
+jlEoJfIgrui6jlWjgC2r3jW5aApxVd3snt3u6km1DIwai5EzUt/n70P01SKnnWS3eGpAMXivaktxgWqLWnm3rJ1enOK/GunQPWH4
+xB0C7VW7th9wOx5hldahXtUOWtlPj/0iAXTDPDG+8E7ZyTbuwrpukWPeWqkOfNa22j0W2xyCLz1Fdh3SCzDrLwMOF9kBM8hNvcPh
+1dStyTXPv+x5od5JWwokt0ptFiADEo8Ib9PbdAeiJ4vqYjOEeavcKuiMeMQn23BtYXsUHyPuU/UdiBFzxdrlZ15LbudSomUJoAAQ
+oVV8IfLFZ2QeePvu0cnVUunHN2Lu2Wrtl7Wp05q5D31eXi1rwABjSQtIozELipigXzIDBGKi8KpWFHrohe2lduqH1VyEi+Mmt5eU
+Fv2oapDaZ+9EYQiLw3/4V+Sq85KyHDHTiq1yb0QEXxzHR8UOdhePYYi0793iq6qJ790QvOzGN1FtwP5uAbYNfitx0Ppw7C5O1rmf
+YJj7INIZXCK26bhd9C6YiwhdrAFnRdvVQo/Cus3JJP3IoEZetXnDCb6T8DoJjdY7+Sqjh92r2W9kfKNw4wKzADEpiYBtNXdArE1o
+ZSsJ0SbPoa94IFLZbf5yyzX1/JwlX899COcuUGOPyT+7oityqWGMSNFsMsZgUdOIPV5Xr/a7+K3yTS1cL44fFDMKj769EavV5aZ3
+q5XAfI/Le22SejKigsxOvugZPv227iXOjnlyHCMa25y6+atbhKFLYRqIiLda0hXfUObPUknbRK+mcGfC8pdCvWa0IKnsm/6IFE/4
+4++4vA71dqo45AENGOhHS0gNHBp48UfRXZNPCkgrJ4ZjqEsiWmtGi3GcxqhcNkS5iKn7JNiItsBE5EvYzDaPU/QVG8iCNcnLbD1j
+S09hvGW9xh5MLJljty5WYYZVuB6M22wQi55/aSFy6Rq72bDolZcWkqzAY3cRVmGGZUiZwpYJx0hXHrEIyyHErIXZiQlcjtgXRZ+n
+dNNkrdhB9bFbqIJyimj6koW6KxbqESlqbTGfE5tYuPImHu8nC5ZdEDgKEvkIxTi/uKeIl9XUdJhfVfGLaEsfGEG2YJtvp6zh8GhE
+LLiyoGlGc0xJNHF/WLD+t6BqpdIZIQIYprr+sjHr71WhjJV++JNV95zoYt8w9X3pJttmwYa/NRzuV3MuzEACKZWy5cUfS4pLeiCU
+Nqcg4BCHKjRtjSGBMIBn2Fjpiq5YeiqVIHaVEx92R/KYwzGyHQh+/FvR8WJRZJjj8nEZcIyjuKMdY9LJ9/RwGJVKUVF6ngPioiIj
+KuIZZ8PemqQ02rC3sgwEaWKjadEoXmCKJMaoFQuMEXEN01HYJ34xLcYZY9hIieVQwENqxISTMtMRDxQmyhDiZdRKfSquRBu/YY5l
+vza657ZKpe1qLupEXE1LzCkkPz+OFT1iEYEpIgLLiAgnliTYeFuLsVlCcCHBcQYf4cvejoQUYwsDtLhqxBkn35C2fEeOLIsMJLJM
+CmTH8QmUUid1FUUuP9h+vH/cLpUmB6WSggTCkhCk9cS6xWvZXKQai1n25H2ZoGVNUhqkcMUOX9PKgURzSqWiujniPvTx9zUFE73k
+QuZ/ulZZvdZWfG7NTamOibMNAbblXT5j3Gl5ssKvVQM4RoHTCLiQn1vg8UCOvwQeCQW443UONRMOseU1HyTPCMGMfyGdGWSlGrlp
+VZVB5MnikuMkOh6edcID39yZ7kuMPjN9PyK7el9xVivOOshZ/qt6Oe2/UiAXqVQ4yp4um4wkZyBmnaRVoSbEkVSMnexWaIZpj2d8
+5uHWTyEBmscv5cO2SGRFS8ux1QRunSoo4wonZfIceMF4LY/haXfxcJht0Zd6znktm4Bjj/wFs4yavm6pPolfepQl1CSp86qmWqb1
+6qdXAdUmfJCPK3ASfGhOaGWlxLI2oR2nOY9yenPi0Kgq1RZ9SNEDJdBhXjXsGMTWHeMlmhZNM7XNg+n2ZKWilYOyVqlsHkwz7bi9
+qXH0ssnWGx+b97gJ2Djy8tMG+CNOeNI6WXTwLFHhaDUK2JqNtgf11wlPnPglQ7/4Ry91PlaodrTHWOxyt1dQinhbd9Gj2uv5Icsf
+zpZK3aKdXaG3shMFnWkJSb8qnf/KZ/S/WBgP6hiXRy51quyAtaOQXRTWFRh/vzmoWoE10H3F1Y5N+YyG7uzjWnHFrdwG2olbO4+2
+x3MjCajXDJRoEU0joKlnWUxEA9Dh+Ic8/ILnLzmOCFey4eJhevnH1y9eqHJLF7t7CKKLbZTf2NrgvuF5TbfegEuitWZ3MYo9gcvL
+fT0aCZ+GPS469aogbFBKPQQ4Voe3J+d+v2c0O3hFZQHMhGO6dcAtX2q2L3IJh8ZK3CfcHL9NUJ/mlwngxkE3tiJCn26qqqOQyZ3q
+rhDi9dAgPn9e5nwCsXqTq+ZAGrFN5J5mBQz+fyVoHQhwREJyJJw4i9IkkkjFb8o07uuU84PbcnOWgTbXXuI7JM3Ffssfhy4+TGIj
+oCyezBgZwr+YFAWKKBVW1Zo3WdbAIP1RMzELx+dWDJlxQbJ+auvi95AkLfFruiRtv5C0yeMXGZlBkshMps09RaRmkQIXDWzsEI9r
+K/YcLmy3C3eLYyZJookCNOLCVS15JWosvp1mxcwhDTi0sUsljeu5kSaG+KVaTYt3xGMiUnkUI95iQEJdvAfxbofDWlO3cY9l4zAW
+efBVOBSQX4DpgesBYgRMzOLweggXvTxDTnNp9oEvqc+8vCyOnkbKoxhEfktQO/e1ZZmu+5kkOjmZxQKfDBmT9FfimnGdG2ufRZfw
+LRa3G+Z7Fm5ubCp7G3T5LHJ5YPwYDO1+9rHieG1L7oX6nhv2BKzg7jN2VTPduL+IBN30yOaVEElmgoS/IyFj8GUNzyJ8jDrDFxbY
+U+fonE05CUq2ZN8YfYnbQLzjNC2RQaUABKvVqtXu6YzkvW4wtETJxHACASMeZTKtFZYpM6PhkLtwMEYGCXOxSMCZqSXWq0Bay8bx
+YyfrsKwdjZ2HkyCm8taSmJrkEHebcBSx63sRhv3zpHGMRz0u+vPdQKz1KJWUoJkxtk3xbiUXHZAh5BVUz4j9tKYqIFxuYEwgqfvJ
+zN9lTTN9PdH3AB0g+G/Km6BtJJKQQ2tNZzGOQOKUy6ho1cr2hgMxRSLjyAL/FcirI6mihHzIHOUWp9j5vDX6MmM8abRomHFkjRnz
+xa6+5WujeFsyXjSx9aTaQk6IlXyxlCG1mdmlXb6JI0g+jMUyhBFjqo/iwYJlyGTqGcR9JV/lRe7JRdGgSXBLpRBWtcIIsHEEvUDG
+/+OLM26YLqPsbaDLAeDQJJaNW+1EA8duWyFe5KuCwumKDA91hftiIhiKd9XtXEIne9qq28H9ph9qcO7MCWKrBQuCHPF4Ax4Bg1+v
+7I8KdxAiTn08lDDufEEiv2g4GQ6HPAqrD43CnX3OyTm03HLbPc/Hm5l2/Hix2w1YSDwacvsVzLbkk8gN+OVGJBoekuMV96CKrYm1
+ArGOhwRV5nby6UnAaaV6I+HwQ09VMFM9tXKpqsG8F7VqZlANPR5PXzdkX7ZpUG07nis7u9kWrADFCAUtht9ti97Usy0iIkF8SzjH
+8fV2Fq+t4pF2qvn8UVa3SwetmtkuqG6PdssO6WTjeMvKd6CSyGO6C4YmHVlpj1i8Zn3aqbY9x7EGAeukGa7f6pldZLp+q2v2UhcA
+k2mD+MQuGDbpiqSGR+wxMYQ2tnQXb1daPvryMEzxcFrJZW6H+BLLMFNvYK5Bxo45SOYUgMicvtXGCGTuscPRli8CqdTX/Ip40cF7
+47lYy3BGlB9ycoItHI0EHMe3dWMrmU3OW2Gv2rddPW4lrOcZstmmgoYE4gPbrbKDkLmdUsk+LV3VUK/pUZvY1Bpx9ZujM4JOohw0
+JIQXg1IpGhMVXnLTIOGmAMcnCaqeYFFXxsVwHEQPdIPYp72W7kK8LF7CwCCydnrEC4hkAYapDyRzZvNIqgwZqzYS0Tf5Tf/5hrzp
+34DNeaSGT9ViroRwaLJxpdKkrql9AZm8i2DEwVaej7/AHLRsDCijJFhm8CrBzbPhQ6WIhpozSyiIAyGroReDOXRikFxRmjPIiR2u
+cxESxgCIKOyVNcnpkwWUEDGeTZzUdrwAPDJyZav6apsnwXe4n8YRhMFzXlnlbGevSHixzhh9uUxoZVsqitVK20Xa6HGaZCevSW5T
+J6NJ9oCfsonaxFRSmQkNGMbSJZIsbM23dvvC66tYvCzV2KlLLL1tGLlOK5VyaLJoyFa8qibvGIZBcm8NDIMEBervtlHcjwPSHQlt
+W/4DzeOV5T1FV+7FuvJyryyTUxQ7gSpdTqgsdhqY5vZxY0CILsD4KD7HryttOcqmiIMJP/Y5E7tyMpqe9EK0why7b0OsSrSB26hB
+wJH6Fro0Gqv2T/mBUQYH1C2OK8O4TFbQJ/HpZsj3SWN0tbAiuuwgXLd3HNvdbTaV6DUuBgiekw41haUnOQWycCxmlUoZlo5zueLj
+qHjgiw2RS1318/G5Ct1ITz2FbLdVNHUwwo4/DnnliYgELztAcb7cKUL7BKcIJ9D2B8XafkFSJ6X0J1xt7YmVxRIrSyC11ZFYURyh
+rW6fWFutCNvHuuPwdG2hrhnEQvtrIqJoHaN6jreUmlbObg9Tauf/GC1ykNG3x6atVCoyrIyqkESKc5HEBESaufImNzQjo03ACOXy
+4GKR1odDwJvWoBOijdoW9I6mlSOpQrTQ+AkUWD61RuO025x0mlb2xyqkbamQ/lIK6GicnthPkzDRSMQLNdSuCRYtrtTA6gKidqIw
+zWha0zpAahujYrVtFjGdfzIF6ti2wawZJoY3TReP8MbzJPg2Fi1zM/rB9isaRWjxnAhiJ3hV07Vp4JZ0RhhnNBUzF9gzNP2mT/2U
+os0tl2Msm9Zgf970mll1nC2wmm7FPl1rGowyNZ+4lQrPtysu5Ic0TOXblUr8FYu6TatS4asNKFeSOHnNbLmZckbqdj7ru6iQOrPj
+qDMrqNMMeUVAvSOXVvA5W/jhyXphLKnCL89xBy5KGemtMPS2JIkP3lRY0/C5UxRmkAwh+EEtVtiOnaA27UplAqmtg/dvoomYsx3U
+DXHcWtNO3J7a5XKMu8PddgBqZkdKXO6wNOkv8DPX4jwoHAZgfUslELOsphGMrXZy2rux1QQHc/yFiL8QGtkOxnY6+BrXrgaqdtXV
+gw1HrbttCNT48NcRJHH1aMNJkcQz4h1gvQ7u3ObVoXhkB0tumwWh55s2iGVgBgSGKP2+58Y5PuTwmsbmUR4JfQtDkV7Z9y714Nq7
+FSclscjNYPTKkoLPxERif5dhLGIesFg3clr7mRmxkM+Jg+hp1NofJe9DmGDHC1JiZrdqB+i92HZ3hZkEhHEaEafwTa/adaKgdybl
+fo4vJJ4xIm264ZBoKxPA3EKJQam9mHKDAjOX9mgkVV8YgQh21LkampN1knGJd4zDXVrQRikWFTa/RqwW05NizIEMS6IzwkXFOG80
+er3DHuPo6nCoX4WPHVbRcc5q3w5DJmNq4zUATI89ZO0aEvWSE0Fc4GhHICuJGEJLDzKob4e2EwjklL89vXsMJiBc4VxsOXong1n4
++eAMukHQj9ZtiIit5Jj7JBkJanpEuECiplmEa1fUtANyhnU9n2EYUzXDG0HN3vKCpGmiYl8HutntOBm9euuDcejcljaF2wdcJRKs
+wFRSlj23a+/q9kkRnZMi7iC9wc4tW8904prVDj3/UC9wdggjCOZkJJAcrJK/hPtCdVLZzuPJwbEe+lbIdg/1PcDJ1cjds33PRaVF
+WwnTWq9xyy6EZsX8VKvPC51Vrb4gfW82alIj1xAauXqtMcPtZeq1xrwIojgNN58xZYEHT6zXpudF+MTa9CxEZ0PolPAyVJuJ/QzN
+TEs/QzOnuJ+hem12mnsaqtdmZ7iroXoNgi1eBR/66sqArTT90YnUdQUuM9cP+zueUyrxXzjMVWFdwx10lXHhWjOGw7lavTb9CiZ1
+Ga+S8tLQ6p48d7ClMRwm8WB2k3V1GcF15IhHlroibG6z86pnHPm6DcpYX85PQZz12rZ1jEsazbTgYBQJQCEdHCeVK1ZmYgwMjnEa
+qrtG3v0lrLrS5BiNiIX2wbUwTvKeYqhqCaEAZKHCw7WeztT3oI7H1idELW+z4x2xpHAuKNmQZYNKxjZG+z3bQQ/giUVerektqi8J
+YcgDmYkqGRveFulXt9PdrLNUk4lLUvUm2XYYKUHWOGJ6RxxgiMGvGsJNz89KQzg+sudFbNT6NB/VMzN8VDdmp/mgnp+dNZqBbqva
+3w6TXo1z2pxUbyWqHLXDktQshWgNlDltOCm45Hmghbri6TZpV8N9b8nfRYUsZDBfHnwdbTMXfGOhF6MMIbls/O7ZCysX391+a+nC
+yrlV00nOFFqcSBwrYOFb4wabcZTrI8pGBA86+LczuKJKdHKSjYgd5LDiNSHBHZGs776cHYd4x21F4vKE7pKQcI9HsDDgW1z8wyYx
+Q/jJxaKXheD90rKFhH7iwvuea4eev44B/N/JxF+XY89KvdqMW8C7gGg8/L8GJsEk9cm8KjmhWcrmGNmCOxrEM+puxi2z7hE3cbJu
+V33mMCvAWScd17l/wlMg8IOnzNUwooTQj3GHua2OGHXoEA/1d7Ni2M3gcpr4WzYtsXrbnqtGuzd9JT0ta5iBmpWSBU27OAsFStPL
+Zgpp14yUjJRMZjpKjpTy20maSr/BSU/RanNzQMGpzanW6Sni0an3FzcnNyublSli0aPlt1aXv7H+9vntpStXLm9fWDq/aoLhmlXh
++m003QuivkasTmdZPFzxcp4OJAP6SiAtT3qtbIFeUpwL6DZB1w3V/IfLb1DtjXJYfkP7SukN2FhZ7mUWBex83q1CyiV0YslTVGpc
+HReU+2hqeRa9rYJWF6oLyvsUZ1qvaDMjhzW3QtbOXlhfvXxl+/zS5W+8fUlDy1foWka6uHZ32AGfCrvSxTc+hZ7IIlY3ZGijYYaj
+zEqd+9b5i++sbq9+8+z6lbMXvp58Ky6fl8nUc8nku93U2Q0zXloHT1V3ixpcXsU6XLi4svqq3w+TDwJ+8k3udTy92qY+ur76+vQ9
+9lNB7lNXVr95ZXv54oUrqxeu/Ad/LEqdHnCP7Gw43NgiTOqJIPBDN3HRjPiDanEge8wd8du0/IhjICdJNLzHYOzS5Sd4+cRwAPHe
+AsWQxO1nbQ4isffFGcgRXmwzj7b92AP82SRuwZjbs3HTelU7j6wGJpFlvj3ojC8uE1CI1uJzYtrh7rt72SudoIbh7ymx1wpch8PL
+lgzjPa4xqg+NY8kgXmiO8Xzri9snG1sYWCdWN8I1cLyj7mevXluG9D0AUZvALwhxaDcbmSYg4fHNdOF6dtpGwSuXic2ZLbnhZcuz
+EeWe7dgYitnmNXu5a5whhvJJOc0OjbDIaXYbQpSc0oymw8/eN2A8bsXuRsauOv8ZVbFSVRnLJarjjTyrv+TlNIulW0NscMjpAdtY
+OS5MDxzdJ4x4xOZsaHd1aziU7ivEbW4yoDXSozXSoTXQJIAZI5AlAJJYwIVWliqBIZ3p+aWSvxFskV1qbQRbzT2Iv97S2zQSjg6r
+ils80icD0sNphttgWQf6Xmo96BkklUAHhqnvlUr6MW8YRPnadtLHSxxD3yXeRmcLvw00MEinXDbIoFwmfZpe9naNUdxu27DzjS6V
+1JZtqxylIxXsjWDLMDBamZMxGcmNfbBwzvDkl2NnZhznYHtE4p4oMB3Ca6MKXRfjAHR2zLHCkqeghHh293ELkyrJAFuV4k/Hr3Fh
+YkT4a2rvjZn3k2AmCq+IIGxKNXXYyWPBKrXGx0xSKop5zcLv4JhxR+lzzP4raXvEUZo+CZcUZFYSG4NV4wBPY/LjuEuKAqsOeyKx
+RoOEHofVCS6z7hUv32e6awyHNkxuC3gfJP4oMlPcb6lyQFVzTEkNGVTKo25hUCkPwmJi/KdwC0+NC5BKJTdpIN4QM15Br5Zgauur
+y5dXr2yvXNy+cPHK9qWl9fXtK2+dXd++eHn7vYtvb7979ty57TOr22tnL6+uaK/UgcefTRGRnTf3iiM/+mk3R9RTNtRKZOis/R1l
+Bedep/geeF4EdMKdL97AnJ0VtpDCt/tc3eBb4QbczRykTriilH0tCAl+Hx2Ri5OvqOqj3ojFOCPSTZWQM94KqrEiJomLFlQTJQ4o
+YMFeKXMqB04zVCw00eiN+1YROat4T0s3ckWPQXe90O4ewvVb+FSHbgxIl/S2SJ+e/OSug6ZsGfdVBXhFFcBX+cKNmunCg0F+oXTg
+2W5YkF/YruQNoIXv8UDgZs4gNkc+gQmkJ8WaRy9W5xSVYBRzOd/1ZI5HHdIHG8yUAtI3yOvp/kV2/gSCwZFm0WxjgOfR/HQJcyDJ
+6cePKRxHLUY9K541ZYGx4VltBiOxHY2aVjL1BjSnfZMBoJMgSvF3FeWHz7rJHVhYhxmR4aKApy0RC/htETo09yFeDq5tvurdk7KC
+76KBEWXwUeJTJr/TTO69Ee5Fo5myhytuA/dPimV5NK4zAQNUezjM3esDn6dw5xDaFK8S/zFks3Nkez1N0JdYH9iYeV+uG1EySaCB
+gPB2k1sV5mt8URDWEEp8j1lYBMAYBUwcxDFZyhEHmDGcfNqLTjjtOSeY47ItHDf3jJsKi6Y44yg34VgglXv/YROOCFDG1ABloWGE
+Pd/bxyNoOJfBIBy6tmy5rhdOtC3HmbAm2nDGPmEFE9ZEPKckXYmLtCevnSoNij/PICCecEovpf8w6TUaJo5a4tZX7QD7iXVo/sR8
+sj4iKq5gj2WLU5UW7nCVL1bt4KybYnWIwJYpJd5QFHxJjROeW6VO+iGlEJ0VfycVjTs9dbzSp9RyxCam4GvrLPzSX5JlyK+M4HbE
+a0nF9dnqXLWhnczrzQGGIdF6YTgwp6b29/er+9NVz9+dqi8sLExhrkYO+k4RyjfPnwO0U1OxsyhtBKoLq91mbvgWs3d7oanxp0oP
+HzVitdtRP3KskJk1OGiw4RATQMfeRQOLM1bAHNtlphYnVXZEmkYsx/H2LzPP7zDf1NQnyBv0rB0W2m0orz9w7DDqYNm+tWO31zy/
+b2ocrqBLNmIFUDfAkOcKGOFPSz1qSe4V1BOnHjViRaF3maEtnKkpDxqxvmv3o7Bn8lAxaz77TsTc9qGppR41zL3ke13bYaamPGhE
+Nny9Z3dDU5OPlQCeNbKz4x1A6QzshGpkx7YC+Dk0a6RtOe3zoInWJKSRtjWQvdK2BnGXtB17AG849uCSFUIYI8ceVAZW2ON5lyOo
+Fyb6EdRKYr7t2mFgaqlHUJw7nn/WDZk/8BzcYpjci0LFVhOLENdsJ4RlqAi/0uWZ4r2YXhx1ICmGjzx0Okbu4dm+TIj1+usYQIJ3
+aC4pwQoPHZZGkikaaUd+4PlAOeiENlC9A/9YmznM5xzeYZLFOna3C8u+x7XIppZJ0EjH9vnOC7Ex5g5Cezb/TMfr266ljhCZogyQ
+ToSoUKMOvM46u4zzgYQ0why2Z4kP8YPfM1b76q7vRS5GloKUyk6cpOFl1xphB1zkRTBkvms5lxn3UBhcZt+JbB8DU43L0kjXdhyz
+hj8XB1bbDg9NDR4qHn/iGJzdMJ2zG+94/mLI/MssMLUYlNmCF5UHjXQdz+ssAwOYGsIV5AaRkVQBs5I6eO0oABLAFz0wQOvbDqB5
+bljp4oOGGeuwU+XJgf1dliQudb4dBaGSVbEwRWCEPgvbPZnNn2TWoRMXCTBPfsfybWQZzNjjTzzrXTGeMWdfDGi+t4fqgzanRrrA
+DV3ght06/GnAH+dw0OOzHYIVF6c6hC/6NnND5JC3PN/+rueGliPxvCSz0otz82++w/zQbhe/tyfyxFsQX1yTkEZ2fatjowGn5QZd
+nLVzSQmW6PjUo0bA5BLGf41gHZc6e980NQQrVmevcqDx9Iu+vWu7cZaHj5Brd5i361uDHi4mdt/aZcqkgs/qpILTr+028G/I/DYb
+AP2vwj8g+VXIuToNf2bgD/Nd5py3Qt8+MDX1SRN50IxzaMBjatkUjsNbd5Ud4kXtwNRiEPIP1wcwH/BkAWM6BEPjqQhphJsJSZZV
+nyAvDJm/DiMDms0fKwF/1ogD7IbXzHCIyUc5yvBWIGa7bMndBc7OJWkEPeaY4LTLv8r8VZiBOFjB+G0cPm8nyX07ThZx6kSGCFXH
+n+RCpz7JPMEyyoPMedfuAMWVB8gJrmL9gqvihCx+P53CUZXMODXsMVC2iYZ6KJS4Uf9iO7T2oDcSWCP8crdZI2JCQgiWE1wDuOAD
+vzafiZVhxZ+4KODtMb/rePsChP6/5AU2omkypTIQSVqMdKVnt6+6GLAvxgplmkYGlu2GF7nwhXBFyF4Dy/UCVjc1DlTqkBT2JAsn
+MKaHzHfTtCxIjDGVeSCbEuOki5HvezgYuRmnqYnHCsNnkQ0CEweWwm+aWgxqcep7Sep7Seq3ktRvaWTgs4D5cM1/0LNMLfWo5AYD
+ME2GzjK1gkTAxAGyx2R7Us8aga6HiS6Cavus+01Tg78awO8h/J5G4nnpLD/P1eIElKhg6fDZgFkh3iI3NeVB5qxEvkxfiXxI5Wv4
+6kHI3MD23MDU8mkJ3hqzwshnCpZMARzuRwMaEEQOAl7IxSUfViofeD5oW7gCB4yB7BH0rIE6A+OzOgMHjjdAfDFZAcTakWP5icSV
+TdFinFUp2mjZFMThVRj4zILYmT0PAkwqTxpRPJaYmvIAOZ0VtmcLOVh9gjzWh90B/O7hrx22e1dsBwinPACmNxCzLIByhgU4FmMw
+I5ZigtC3rzIRiTQZ+qlkZfyn0pVJII2vzAQiPCtU2veushhYsYKeBTc/8V3vKqt0ZIqmoHgxrWIcL6YYJJ2zXda2BjGGw5/VbAhH
+k8qHBIlw3g5h+urbyUf6cZJEUmiHGCr1vKtMrAYic58vB0Hkd0ElgPypqU8aCQ6hK89Z7m5kQUDR9LNGMOYsGnQGpqY8QI6/y2AC
+EoBMeU+mvKdhoM8l9ORjonPuCnfrwzNWWNvzBZdhZidO4AjK4MF8ZezAs5ypE1gjoWdiEFIx+9ZIBLJMBLJMBC+nV5U4SWGrOE1h
+qQRPYafItdt8XRTQGbtjm5p4qOzYHTtGusyjtco89NSEmWFwifmrfcwKg8oA5vq+RvaWEsWAtldJ1AQa2XtLyInaXkWIjBrZO6sI
+f9peRZEFNbJ3Xl3Ntb2Kurpr3IMoTM576PF1tdtl7dDU+FOF4aNGQD/AF2yQhJc6e+8Bih+ibHqI+WEsmmJGIpkmee+l8+A9m+2f
+8Q5MTQA85QoykKklMKQH9o7tcPkCORsqve/5nVjag4dE1tv3UXDjW0nxUOnjdhLm7AMpbx3EaoUDYJYDYJYDuAHlMkf6wTW1bIpG
+UNW01A4jDNOLT6bFH2Wm3/Y9J8nkjyLzLR/2EDyn58MuAuHLygsK9nrP25fJQc/bF8lX7DBBD+FBZqAGQKTj3v+g78A23NRANwa7
+b0xygYj4+03E1XhaokaDqYC/41hA1YO+A/QW5XAdGoEuOQTiHQLxDnPEO8wR77tmjXzX8/oQxc5yTS2BNbAjOlLC6ByNwBZ6SVVw
+4WcD8yjVBX4VH9OkTyUiyVMpl3M4SOhUCqdxOulwoKQIwvrVg74Tkyx+4tTCx4KGmHAYcBFPg6pX2WGg20bsWFJVN3vVhCIQWr9G
+7A0GYVe8aq5MyIdcI+UT49Vu/2BwGnmuzp1p2S6csmEkmPjEfdkaWDgobRbozDBSbsdYNV0CKmWUxFW3M2oe52grLHa0JZ2bJQ7j
+zJT3ONV3nJlxJRc7kjNVp3KKSzkz5WBuNILgMzmfaPK07mXe0mRN+SUo6abdraae4fQNHCFxV4Qk9AamW90BNRYeBA2Iw7qhknSO
+dcPRKHdNyu7qhzzgCaX94bA/SWlbNwpcAfp6nzsY2x0OJ7v6LnEN42hX+M0RzoSF3UdHNIzsEdX6yK5yb988UyN2la/4tA+HWLG6
+XF69XhF3OViAd6ZGWfd/ePbcmJb3JtLus4TJiKvPLHBLken5eeG0e26W31bCcP89aqU8hcmuOS8cACTOwuJOU1EWIZB+R7r7NI8G
+UPHO5Uyw/8A8EtfcTc1z10Xz5XX2JE3cs9HguHAAUovbhplsQwu9wRkHticaisfoHvU8cyOegKFGOfgNdrji7bvxw9sDDp73ooAl
+Ofgo85JRyePBb41GwnqS7PGfXf5zCIe32/Bnhx7h3hImtMDskNQ1wmKrvMntHE95NGyN8WlkmHwIS0fG0odx3FpTH+ieMRxqoR8x
+7k9W6K1XOzYKnGDk2Kce2aOhaEA6rJsgqVnU1AxiQj3zMHYCG+eq/WGmXnl7oJmi0Ug7WwdqxC9mCW+Cd3sjXbjsUFNJUIrlJaaG
+BunYaoTXnI1bwoEUjQr0bXAgnLossvMaNoBaFd35JP6QisMniCC5lMKtABGFRzwkvoHhKQ7KA885J0d4FTGQE4Dq5ahem5Ve+muz
+csgviFvHtbmavBsw1+BXFOtzNXnteHZBXjuem5bXjudmxLXjxqy8dTw3y28dT9f5neMGeFXelwaLI7h0fDRqbmjWjueHGtEs1+YG
+aKtuR308GzK5bUkShQNKbYcP97blXnKswwS6wjeokODY7avwm5oO2t4AsSMopONFOw5bFogd39oVP7wmHEK/lhw+sEMB4uV+AV/c
+izFk7Tq+B5NHJ/IthX+JxvqD0IYDEI25bf9wEAq4w399H2Q4rSvmK9778LtnOaDr1K4Kbkfoko8bJgBxqnI8qyN+WGfFCq344TwL
+rU6SIGvZVyY9hM97e0zCF6MYRTSwH0+KAysAYVwbWFGAv7wL4Ae3CNrA93ZF7Xy00RXt517VkyuJWsAYuuRHgL8bhJbj8DTukB2A
+YIA6YPSezg/84cGL2r1lmBAd+cQ7DkHRGIRli3ELaye8tuc5UT+p3r6FuxmAeow52lahzCg8RW3Utqqh9/ZgICMolVk1cOw20+Ey
+DdU8VyuHxKcwKQFk05evfm6y6Lllbdxy52+NmvsojZKrG/4WtYUnkVW4LLCeWnr2X7r0cOnk6gbD4CGTdm4VsgrWmCUcuvGcuyxG
+YTZBDsY4fSU9IOL0VTEukoR4eChJndQjDpb48awarwwe+ZCJE855VuZJjJFMWjxUUumcf+KkS8j3yWOq7ZfEKEgS5GCIUy4nYyJJ
+4wEHkrUPB0bqOVXquhgmSYIavgCf+aCJE64kYydOe0cdAHHqu2IcmBYdNHPrLZ93TBEE40B309Lwy1Zmi/YK5YyM/GLRblaOwHka
+vttA34t8qSz89ooysZsFUkoqCSeKvGCSSrgYhdkUmBTNQhnHop1M1VdgaTHVp1W3k00ImZ9JOrDDdApfdlJJ6XqsxIuQkuQh1fuZ
+Ol1RZk8zlZiq25V4Lk0nia9YdC9T7pK6nJu51GRVz+fFZWYFzCupedu06G4Ggd+Qh5zDTM67OJObFt3OiaUDdcaKUuMb1jfTou2R
+hW4KT81pcLWe+9SylJ2cLabRxOj92H1aZJDopQIo6Ag8lzMvF0EnbZ3xmIkytAQIliQY67ivubrhbQ2HOvxQSzoECGKhaAdcmJB9
+23FWMKpiYV1OUhOXX/5eVcNIEh6pcQIS09f/118/MIFdBVNKYQEp8mIDSm4/eRQLiWjNwP0hcL/tV2yZMAhY1PFilQHGM7SrVrTb
+j10h+cT7Mkbpr1hpsJna8SwfVyMzb6mppRBQWwQxaFNvCXVOKnH0X9wuWET/S+mLGxdREX5MzPz/2grBFsmjRz6D2aEjlPH/F7Dg
+f31XccqIrSL3nSUvOTW4n4+jq+zQtNBGBL7AB3M79J1vMB44nKAlZPxkOQncZ6EVP/CzbA5DaY6YGHYZKKzsri38moInwB6cEHdY
+0ai8yg4HKAclQQ09nRlmbQS2Nce81QEBRI2NeJUdRoMkpcWqsoDaiOz37HbvVb7/yp8o6PPoP7nPMQrTEW7UmHDeyxWfV9SkNgqp
+nVTa2F4+hjNyHe0VkMD6Txx+ImT64f8rVq14VmVOaH2ziDN5jlyoBB7fUK8oWRVWVdKA1xH1vbFFvpcq8j21yPcKikwhFOTHX/wW
+JzHC52OPHf+B5E28y4a0Trh3YvA9zGRAE4tWZkpe01+0mglukEQ58csztYU5CEjjLwZNv0xnDLdMdYhwVpVz1FKo+4ZRzieW68XJ
+jeLkacNohl+lNnG/Sm28Jd/0F72mD97VyjT/SSn4xu+QcOguLtbnBMfMzc426s0vx5exQ/JwONR2PM9hlnIlDxI1xbOypokTFzu4
+YF0QHrn94dAWAZ2GQy974R904R6c7MkSymETT+oyN9LgKgcNq6Fv9/VYqRyWtcGBlgzqmXnlMo9dtQOw2HJYEFzAGHXG6/s6lGRg
+qT2v3dXrOLXLSAWJh2npH2yXhSClYzB4WWsaoEviljfugAELL7oCKe6ztWxdm5nRDBN+ZzWinrYyo+C6Onc/U582pB9+cSQ1Mydc
+FHOv+69An0QtFxpHYzin8FajVHsxNWyQveFuwbV14WcdbituuFsUrqUXHApmukJG1TgaNRVfBz5xkxAEECd9ZhZaOTM3bxhN3lr+
+eX5/0a3a+hF4VdpevfAO2Px5nYhTn7y7eubS0vI3ts9eOHf2wur2+pX3zq2uQzRqdVayR4aYu8HnT8MwXpW/UDCQGk5vAx+3hkP8
+xRHxtmt3mBvC6tmBgJAJP8GJboFUksTOS3yE1adBf9PShMqDh+yqgon6sphawHPcK4hM1kYs0WwNh+lKmloyNlG89OjRatA2tdWg
+bYEBBxoS7Fi+qU1oBA6BTW3J9719ADXy9kA8guL7MrdswWeENQL6JJHCNekrzDE1vqvWyLu2a2oX1zUCiiFTS51MLg0GQSaJazFM
+jf+e82Crft777iXfdvHIDmSYdOtGIDmdMjW4tiGMRhZM7Yq1o5F6w9SWHWb5GqlPm4LYpD5nauv8zlB9nn/e9xyN1E+Z2pIDqQum
+xvWbpFEzQQcd8Io05hOaTTeQWtPTgLuLhwLTMxzmVJiehS92NDI9Z2pveXChYHo+RdjpUwphpxfSVJ2ppWg6M2tqZ92A+ZA1l5C3
+Dm1cqwMwbWprDQBmTG1tGoBZU1ubAWDO1NZmAZg3tbU5AE6Z2to8AAumtnYKSFUztbUFAOpQYA0gLBrKbkDZdSh8ZsbULkR9To/6
+zGy6pxqNGVMD/bE2eu05XpwtwNxTKrENGwbfhreFc3fBXKwMP87jL/fIa4fchJx4VPva1+ST1vwysg26x692bT/gjle4R1vlWU6S
+mTNP/q4IjqDGx4lnVTURAyYkfm/zPuqSABBQM3C0RSxaa7r8A9OpcD6YZFGv7KoBLqRE5i3CdGydjue3IxclQ2mTH1a8UdOj1gg+
+ZYMGfDR6PZOg5EZ+snK4G6waeuCUX5wsbdEwnUDcDe1dtnPVDrUy26LavoBDyDjvfZen9r3viqR+wFPOr4uEi/zZ08q5kjN9ZHf1
+IBGNJgJ5UGQpibGkAZkZR1sgdBT52sLsSC2WhrAASxEsPtJuoOfPRLkH1k6+rsVKY41oKZWzQWJUW+qax76QaKOV17iZ1bhXuJLa
+IMlZoqhRoqTWiJbWWBsjEsDhXAS97KkGNXqUDSYozZe0jr2nGVW8/aU2EQ7ykkh8w6Eu1K1WVSVR8kDy+TFdjsVCMiSPRqpR2VrE
+r6fIojyl5ZRXt594Q3ujDAxZfkN7Q9mNNl5FXpTuUWdm51KvCYl2PdoJfQaeQr3Yid5r1PSo47VNcAdwhuw4XvtqYJ7nAHJOYF4h
+oT0w8U54x2sTz+mknkX8oXN4r1Mjju0yvnMwa3L6qZE2nNuB2bCL8e5cXPszj8sSx3Y7/IoQB8Cfc53sOJZ7FQDLcdBLN3p5dqwg
+PM99+8YkSKrms27fGoDlKOBBDYWxOBSNNuCWHzAfGx/BFNy13c4FtYaXidXZgx2GsAq8JJ9TWGcJHtVcjMKLXTiaCMxVuCGOJFnn
+TmnBvdcysd225w883wrxHoD5bYJOiOE65hoRHjPPuvz+2wXuIuhtt8/bd4b3zUWC7mHNc8QbINebbDg8Go1iHmvMzoto4XWjGrkM
+RSAusxJLJF+8tHrhytLXSSCel89dXF+FBO7z/xT6/N+Yqk6RqfcX9ZYZ4AXj4cBnQxzfht4yN4Ph6eFXjCkbcCYrFcTdaG3h7+TG
+UuVbAtzcWF5ZurK0ucExNqe2WnrLtDodkLyHlh/abYcNrcDusCEYJOOfrueGQ+TC70ReyIY7XudwCMYGtucO28AV/rDtOfAPA5YP
+O51hh4WW7QTDjm053u6wY/vDjr037DjDTjjs2szpBAyAXVlO196NfDbseh4UB1cFhl3f6jP+F5B79WGPWR38w/xhzx/2wr4ztDma
+w3aZ2xk69hAsgId9y3aHfeZG+McOWX8ISr6ha+0NXY+XOfScoQyyPuQ9OBwMB5Zv9YcBtx4b8uvHQ7TjHgZRv2/5h0MUqIch0iHs
+DEOo9DDsDUOsH8cN/WHoW+2rw8gRPYTU3ji9xTsK+Pwy2109GOja+3rL1MpWWRtq5aCsGcGbX9GIZmvGFmmLrl/cnCrq+9PQ5ZXK
+6SkytQnemqcQ2trcOj21RQZ0CkqGr7858aZxNE1GQ71lbitwRcDGxJtfmSJdOvX+xo998N/eLG/TxdO1ykJla4r06NTG+xOb4WZ3
+c2/T33S3pkgH8N4sQ24fvrHZOaqThZGhb1SNLWOK7NGp93/sqE7mRnrLnChDg8kunXr/A/iU3pqsvvmBMXz/v8VP/82YIoe8sogy
+xCxDb9GJN+HdbZ5Hy8NKmdd0h05BXYbwnz9FDorclPSkO+kR2aeFLgXDRalHa6VUU6FhVuojcjVValrg3Jaz2BmYC5N4WDIUHAjQ
+KHjDHKQJF0NnQ9ZHWBgiMioKwjkpiZ1FVmmB0RDhnpcg8AEvFcMVhxhfUnepDBLFpVwR/UDElJO1hzhyTQPnZDnZ6cKZULJWiOhU
+vJxmGtnNI2NCaA+oK98ZgSVRLv6APZAxQbeFNWmZO1RUFi1hBIXJIj5xWduE+wfLmR7E9vCgnnyh3Ii/AhTZAjlJrEKgPci0WeJm
+W1OpGzI4e7lOfHSeZOuMbGxkEIm7RTZqpLa1FWsm/GzzqKbFxKmCayS3I7yAKkTziT8iS/nulm4sKSugD9/ayCWPn4DA9Qd+/IGK
+hska2Ykch4WgFuEZXDSVCmsMtipyBuBCxd3lD/zaslhjWZWv+3gpIKReFRdAvWMYljA4P4Nf0YhVTb5Hw43a1kZtq8mcgHHHR8m7
+fSNt8yMLwgvBYLtn8cDcNHa9Hm7UIcJjNa41DTcaW6A0qtQnKdVduq+nyETSBCpDdcRAN4xSaWES47ZON+A3VRdWLRApdIPE6Zws
+ulIgmaxhmPEqF6uITZlgXRGhJPViHdGLKizYXYxbWVzFX5wtlfRptFoaDhfgx+A8GtAK6G7HlkQimpQimTQ6TWeHw2ixPhwGLd2q
+ip6nSoPKdSLfo35cHLXJdOO4z5VKhW01zOKPROA/e6V4WuazpzK7MaXrKQ2TJ8hS2A7ykscRuajOQnEQ4FhoFbMIJnJJWoYzLhJl
+m6lwwxx/zCypYBTMMETJpmyUrhOdrI1G5Dw9knGuzSOYTmy30BdabZQIrWnXZ/HsV3QmJtchNiJWu80GYXAOxVxYemBlefVvZnQo
+YXVb0eAIJcpVvnevbrs8SnR127GDEOxNqjhnQTgpHvE4CffopgryZUG+gcFLsaTh0BdFHlumTwUaxlPm0InoRIvphFuAn4i8kBVR
+SwkKq4yWxFWw3E0Nh3MwC+3rYWbOMlp1Uz/pdFQHL+VyfIbpMcnBcpnUjP94ZoG8wubL8OJV3CrKc5E0XxhjmqesGpJQp6nas+oK
+VVYzxEQTry/1Zm7ifoViYPoa/egG2lvMwkX3uLFWf5XPqv75cl+7Iq4Xn4HB8J/0TZCmcZiMZRE+W6RXFJ/KXkeZo7ptB2vMbcuw
+Qjb1F+k0zCMgsC+FWWGIci6DN2ARAMRCoUlII4coINulkp0sTqfjEriKItEoJzM8YSkhuKEEGZMvc85qeqdrpZIYm24yNptG8Sj2
+KpURDIAJOHk+TWdyaDPAl8kYEUOsgOXTo2r00um7iNo4lafFWhCLsYMOLnZ1EM4x3JIgco34eEIrHsGCoBlWbbfrUU+3xem3QXC0
+hcy3HGrx1iopuS/GoYCm9E134k2j/JUpgh9u5lCFF/tXY9XaiLwV9p3jWVW8LMg9HM7x6awnX7yCksp8QWqrZtbHEv2V260ZJIf4
+uq2+ZPkW+iQYs4TFfI9tbtXNE6z9xIc1d4GLpnnmqRnCK7Cq9KuiqH+ZdZnP0IIg9xp6Ee5bAwO2crnm5ynH2c8FyXyy1vRLpYN8
+oUapFFYjqMZV3Xh16o3IFbpRSK3c4n7c5sRo1V5zoX8VQRxcqFeLdJjiI2JnqiVSjZatJ6mnTAKEgNPkc5BsMgRxP8mWVcy+e4Zh
+HL3WpuuYBjXlqpI0S6y1uTbFp2ZVB6MH4if4JCW/5Oa4rbh5gvrJmH1/4s0fK6OyStOU5IlykpprYrpoXoNKXDZpqJP4f2xP7Bqx
+Vb5C5+axNPZTNI5X+7FU9pPVBUI6+6lFlrrEV9ZtqUKIEzmFYtmAnJRrXOSVYspJt8wq1eZq9KVDVlCKb/tfRmCUDFxab7qLdL7p
+lstwrOnApQdUT/KtzOL8cKjF07E2Kfe+ODyO6QI7zeZy2dEUOSO2zEyvStQljdFYqmD7chyl1DCtezw5n20bL2uS1Lolgzasci38
+wAsUXuIjVqNQFZczS6tuNoj/8uWBgEgCJiFLEGoN9XHxQkCY0Mu90tg8hskU0SGh5EDopl9ON6Oln2jmTgn4+cn7NVpjmKl2JGyR
+W+hKpUTNLeysJnWXLoEtH1/exjQg1mOzWHNbKq2ouzQCQWNwJkuaii/lWqi8RF2DpF/Bjdjxr+TWt6JmpoaoWmkpGLb0Qnn9ZKtv
+agqNJ33ohy1yiY4VuIiNNsFZZXqTwc5D96m3oSjUt4ymoW0i0f2W7tKZCn8PFX5fnSHKU5m6RHmzTOuEVWjYcs26Yeo2PGeyUy8j
+NhD1bO4wgL/Ba6x2iVpAPpcfSo/IZbU8SQdGwhwFiEuVzxGfKsU3NehCndFQbiRBsQpzszaBHGm45TLxy+V4qwVUg14Xhzc8m85U
+/K/OjMRBhOVepdpm7IJh05cQ/pJci6ibT1uWqlfM4bw3jhZq36n4rEOVp9N0ZkS+XXTGgMswlWf0qiaUpo9IRIfVUh1Uyyo3oc9R
+Jc+UDWJNM3ikykQG2qxN7RLt//fpXc0wSLbTKIv30nZTt0FtFx+Roa/7bW/AQKUorpb7lEdrrGYNBmQIDXE+5MuDIbHT4ZcUfIPf
+TJ+omckVz4m66caaQ57SkF5BwOZWj5XEiR1DbJJvNDusa4FLSgzuoMnvTfACWGfCdhy2azkT6OaNTPSjIJzYYRM1MlEnE54/0dDg
+bGMSYmZRP1YxczVmVl2MSu+curlAfS0ZSijk/MwJJoiLmJ02noC10Y95QQ2lnp4KfTENno/JrG6XZABCxZKFBNQSBGtOelyvO64X
+J1OcXSpNduXqqRwXphdNMc8U6xfTSuaI1prRYiDU+w61NqItyRvSQN6BrpBUEO+Lu+SpLOLFLmxGUbkMOBGlNDhBdUaSAHHfDodJ
+h+WkQ/WwU5Q+ZoFJ93o8lGBlSJ4y3ABea0goejXu9AFVy5pM7Rb57QhlBeN3fhWRfziM9elwGdhXVcGAGMt4XJaTfK1q2XrUb/aa
+Ri9b2wHp0V76LEYeCqcZsSVIzO2BQABJROa4wikx+TSt5xMX6Wyp1N7IJguJ/gSH2qB6SJ0Y+blzdcNUXojtFEqlSbUPdMF6iQiR
+cIk6cRtkPP+RNEkMoY9LJTaPm/BGZI0Wq3VljzA+Z4ObJqb0c32LboSkoOit1MTNxIySOl4jTLU8GJEL447QKavuW85V5ut89hJz
+iaJ84polGdwRYJJHEgZeYq7lD00IcQ/zjg5aKWEgDNMlVpi4VTSNst3d7Oj1S6V4dwOPw2H+g6gSg7iG5AzNK+nFFkkeEmpkY6NO
+6rFNwoicy0fG6Xhtyk0LY8LJdKI0HmxNM8xIa0W9RGsqi42RDYpWovRX1aVfWkwIAlfBZU+p1PbcwHMYPunawGcDC6g6wX0cGbHN
+TQDBWvQdOF+X1tjNeg13F4pxT7zbqePOG0TUl3101e0UfPflVUUGnoCehJ5OVk8fYlEvungzTvR8yhZRDzf8LY7ejFedcSYsbnKP
+7gStyFTp5W3gTJl9I20fqcsePRkli4vseO1Xufsw9ebkhIgm1A97QXWHTcEtIHDmiy7VJ/Zq1Ua1PrFzOPE18K5rW8HEm1P/H7ur
+q3eGvA7DcAdG8uHUuPEP5Ygregtsy9qW8G9kd9PRvdDfJHoaMgqKkJeV5mZnp6eNJo8DxkYjvtvgTFKAT2x+tROijcA1jGw4TSAU
+DxReqROLWv4uzg+BHA5ofZ9cdkwujGpas1z28BYpF4r4yNfjEuRNkkk7WLPBClyPDLAkqQ2H0el6vT5Tr9chvGtkTFIanaDJ0SJc
+8Jyebbk8nnhkmHpUwbQ5wujsbGNhrqxHp0/XURaJvlqvNWbKs3PTjRoRr8BiYxDdK4OMZg2HrjzAq89Nn5qB4R2UqQ+2V86hziPy
+GkQi0eR0NxilgmS9jt124HVDFORMbdPVCLf0Ne20YbBwqm+2EwthsPjlyN/sO2qHpiJ8a4taGc0JQzizEI2sGfE+CQPDx7cBIVIi
+dpfhl6k2oZXhbLP8BgUr+I36FljBE69cjpXfIEcA4pRmwI5WO41Xw6Y2FzfeP7315ubpKRLQqfe/be1Z3P7UHO7tSAjDJw3RC8SU
+TSI69T7CGNsETVYH7u5w1+4Ovz1gu8N9tjMA41enwF5zIpD2mqXSZJTYbrbHGscFJCJtMlBWeNKlmkZ6uAcXkcrVl7tl2jldayU7
+UYtommEy0oMT+j2+MPHeILvq2ILyJintlUp6t8xL5x8xRuQwLRNAnxwWzqSJr3SjadNBLDkc4S7BjqUFEoBYAYM9hKF8qGg/sfnb
+NEiSmtvAETgaNjQgfSXO0ggfffo2l6iNsmZqZTWtDmkVJa1egFdHvC1jJPbbgdhzwLZYg4j/mtnX9/SgKs4xQXmY8ga6LocGICKt
+4sGSwnvL8jsxnqdrO75GNrbQiIzs6inU1f6gx7Gilsb6mqlNsb6W+Wzoe+5ujBWIR21KQGls2Afw1QzeOKwGVpe1tM2D6fZkpTLh
+W/sTb105f27C69thyDoTlcrmwTTTzLjVqcKWoyD0+rK4qFQKqnCXJ2R+q6/HsGFOyqwDOyyVRNaBHaYKOwduv82oJSoFQeODKoS8
+tF1+t2Y4jBmAOy3fSyMABbcMAstx6DCFXfCZoyMoEJH4lgZzq8F7YsrKUOssDG+sFIhXHaj7uNq13li0+7sTgd+mmjZhOSHV3jCV
+tDfKBdUtvyFRIRI/rRsmfEfvgLBWKsn6wmff0CYQlgXFDYF2vKFNTJ1+I111WIIEg4GsoBnlDO+WocE8K/ViLGsriiJFrDeTaLJ6
+mwbisqH4MaSGnFLaFqqSNrrWugJWakKjGLX0XV3QfyDor/MOGGh8EKRqpGy/TfXd5KoGFoLvmUn2lJJfUCpu0s2ohbiOjUXIajh2
+0RvYMmi/H5sDg9KDtw8MILTI0UzNczQS8SlsR+SiLkjGEN4pler8J+ZQEYxppxp6Yj4CBpUt8WXr+JY1aaBW9vNzhtxwmT7Velo5
+4AdIRCGcnyJ5XEiOfZDumulC7HK367X4j9h+TG0G5SnD3NiKBQ3QwruKdVFNaSAGd9WI5ojoHhWtvKcDshiLCT/4wKqSabHh+VlX
+NF/wNYD4Wp4UsdLD5B94jfkuWyaf85RSX2Pay5aZPtMyY2L0fI2E2YUhrXV9273qevvuBKyjEzDgJqDLAYgPPQ/H7U+UhZp0X2VP
+EmNWRWwOqtWqjZlqTUsuMFbh/rG8Gpak8l0/9141p2ZAX/GoKzL7lJr9zUzuqYbxWuJrhG4uTE1L7gYIP0kDT4SJEtf3UL9oXiAD
+xq6aZ0gwcB3zHL8IB54EQhtuxn0nSQgcK+iZ3+UJS1HoYVCLt/kztO6KtWu+C6Ga3BX4dGC+A4H3Og7DR/MtjnkuDvvwzSRhJVk2
+zPeS5HPWDnPMr/CEiwPmnoGbWCw0v8WTUL8q0xiTdXV3zZ/g8KobQlCTUGTxucd0xeMFto+RM31G0oZLpi1S+NJvekxeJASBxQrs
+wPwG4a4OVySVza9n9ghYgGmx4luEwsPZKQzY7VVdCNII+oC3L58lEfWylwy51+75eeG1uz5jVCGkT4fB6G6CGylx42VAverq+vLS
+paUz51ZBjN7c3NzUygOUczd1feN9SNCNzc2DWq2yuXnQqG0NtXK3rA0hw3hzc9MAkdurrl64cvbKe6RPIcq96F9w/Z3cd5t6f2Mz
+atSgnKhRm1vbjBqr/GF1fm1z841J7cc2v/LV0qa+aWy+uVkmm5XN6uaU2VykpzdbX9vc2NzafH/7g82jzeHm6L9tTaE78aT0N/A2
+nf5GuVt+Y7jxvoY13jLe1Iabb2CqNtx4/w2Z+sYQWqeJZEMmb24ahobeyTPX9DYWt+Dv+xOLpzc3w81NdxP/d1DLkGPj9BYUsJ0v
+oICQZeXVoVbulTXjTQ0doasva+UBOkVPp3XwoiDZp1MflKfIVbhlV54iq3Rqswr/n9ol63SqUilP7ZJlCjdIN6zKd5cq36pVFqqT
+P/aVr5beeLO8OUVb729/cDQc/bfKVvlrCQa2NX6qbB3VyFx9pOQbcH10s/pKbxhvGqenyBJUBm6mWpXvbolfqFO5snVUJ9P1kbnx
+/uJpTiSg0Ztw1XGFglkVfBLsIVtT5CKden8zmCLnKa67u+QKnULrqkuIOUXOJm/gNcTLafptbm7EfbK5ubG5uZXqxqMaqddqtdHm
+5pZmkG/Dtcf3N90PkAU3JxdLb26/oW2Vp/opDbg0/Rc6WtwiJTq6xN6TkbBQb01hdmdtsVXiE7N6hgAbwbg87koIDuUjxzFjhDIN
++RGseveJADxGoSxfXEx9VVyOTKWp9yRldfC65LlxxXLLnxWDgM3pdwoarGBd5RcWRavkvcp6UzV3EKYOsPuVErerlrEvDvJdpYjE
+rGgZpSPiJx1RQGiPyE9UJBUMaRgolQfniTbBbfjSd/vA4DRNVOplsNb00ODk+G7hsbxao2xhcMiOWm2ewNhV3Wjpam7MesmOOltN
+kOl3uI4lNqRPmKulp7HXipAMonzTMLNvaJubmmjj28UaHNEwPdX/y/B1l8p+qJMwUdfHFzL5nhj7UNk40kDX+pbthJ6plV3MFVtD
+jfjZ9hiFHWeYk5Pp+iwZ3GD5NSv0mtUYkXePHyb9McOEn7zJ7k90Goqla8L2mU+7vLfeGX8ZlVhS50a6BM5/OslIBC85C9zaZXoG
+fo1euZywCDejmUiONDjjAmIziymqCjzek/MahadOS9t0NTM3HS2Feod3h1I0cfkFSZ+/5ABpI3pRMD0IRHsxPJDpIagQ92LYppN6
+NBy2S6XJAdgdgBg2qQ+Gwy7oKEulybZBFmahES3donappE96w2EXZTN4sIfDtmGYacII1EkPsAwTnhCKSUA75MiN+h0uDffAMh3P
+T00LQTznN4ORMSJvFR68cvrE8rRw3zfpptnEx2sb8XfUWTUZ2DbxKa99S/vhT/6BZoomaD/8yT/KdER24uRypUXXVE+PKYaz5MlP
+vOOgR+22yUjSeJug8ySLDHy2Z3tRYGbeQK8lwm+rJJNblaBCL7caw8RqQzRcvAIhL6alS63Kz0mzmIIs1N9mX+RD6OupISQ+wdRC
+WaYYbploEDGi+WMrS53kLZNjxM9K1oj8eIYrOC73QSDMSynYwCYvGyPyjTHDXg56hw97usG9NHU3Fma3KCPdjZkG/51eEL8zWzS2
+vEsqL5dqF25kyS9P4vB3aZLCDz0lrjiN6lG32m5zJk66cThcmOVK+ZkG/51eEL8z8IvlQmP5mRoUHCpfIgMw8ZL9XyoBgRj/6W70
+toRJUrXdRt9f7TZobSRfGUeDxNAHDIxj4qMWzCUzYBrUgxrCLwgjA+MoUsfb4jTobZXHlppJlSw1w1TSTSX9qw1Ka62GWYdhzA8P
+LGmQoLxSoRFx049esiAkYHybS0kSq14EN/HjV6z8K1bBKzgVdMS6BJNy1BI6fKmm56fdWAfsMqdUgn6xmkabOjyNOImRdic1kTjg
+Iqfd9FJW3R2D/LgO6g+CwlLSaozQGJckLB9Se3KUzmrY7+pbVvJWIFir+HU4ZaSBUEumuHACJ9NeS+d9kxASZ1cygPkmn/P7mkFk
+MQafgnt4ATqH+cepMpSMP1KLaA6GQx2YnPrJcPBj7h4OC1vlg6c6dXhmRniplEngo7uYwJlpc0S+mTvLVuWd3eyGh3HBIAIjwGgn
+CH0dbIsEw6H30veOLfAwX6CeQtg2SPpTgQ5uqQyDA8VfNUbkK8d+9nLus8OhLOB0vVart2pmYlf1rXFCYHqRlmLfmq5tJJJeTrgr
+WmsX6spiWyfCU+GrLLaTNWWRhTh8sOs0wx/5GvsTx9FG2lKKDRHeRlREQ+NIyU2INwnUOxHZpqd/RGQr13+UhOMTUm6nNqnF+gT4
+KmNjRQEUBKjYjqe3nPKJRONW/qhUWoADnggX0+lpATaNiEbJ+p9sayKjkJPXdG2L7yxRIIiqnGDHIxdOQpEsxKXT05TXhsxkttZG
+sjHhpYDCWzdilwbSBLNIJ63Hhp7yJf2iYiOa2cLEGoc6vKZ7mXJR+a1jw7OlzmT4WzKG6ByHxjfGoTPbSV8NErCb+Rzq0HWjGeDO
+qzscgkTTbRWI/BHXNJHQKNoQDMig3OWraVepF21zoaCn2l1uWHpgbDV7aNjaSx0Ke7QnznaxNSBnOcaRKla4LXksLXbjzU56N+6T
+jtyKe3AlA7/fJ3vIzH0aidUU1+p+09ijff5A+uPkjj5Et9xroqMLNb2TtpKTyn89YXODiM/FJU+6aM4zfvQ0jYW6YFJw/SnYnsJV
+rdQAigeyqjcqYP24J0IyboCNSFgwF8gvZDQlB8i12aLaIEsJnYZ78sK+bcRMLI0Jg77lh61s+ckl+VWi/fAn/1q5aruevyNLa8Sl
+tWSF5AvtV6dBeHZjg+ap6XgN5nJ1mGQ1TPWtRkvncRl0RQqYmjZAYdFIJc9AMtTwt7UqDyOju0ZZ++FPfhQ/h8YIxItiTaEBxmN5
+m15lCQtTzuSEsRjXPiuXNfnlpkQLvRHmxPX6llQknAC5sdVMuzCQ+bIXrsiLzuqqKgesYvKjmAkZhQsVfymF1sxrmS9xvrVZZg+s
+zkyUJczPPfZJ/VaQEiHQ5sQrnhdjHzNiAUnPfFw2rBHPILOnJtPrSVY3HJBaSl5JLTNSBn3JKsPDU/hSU130CW7VmZYeM9+wx6w4
+4FE8Ns2G8sSGDmZiVQ2Z9MJZeAcvvdktkBfMbAFwMKkIN/ItYHVnbPXb1NJja2csvd3SVSwYdxttCF4HPxBoJjke9glO/aY9Sqa+
+Cmw8vIJZCW4quGqvNdHZHE38xU3WZWhTV1wgq9fMUCGgOCQG5ZtyjWyhkUKKz8ezaHM5NDxXz6DNNEyOPivRlYNz3SVp7OkFjj09
+I7FTU2up9NISFuqpaikn65mKTU9n6u/uZluYxlBP5DOYc2m6SgsCnRnDYZIsDpqz9TiVepcf6+ssZzKi4Ag7I5bEaAF2UqSp7Pzs
+gBZfLJcWy7kMTU88OUcl/ExJmY9y+w10TJjUjyv0oQ1GM1ccIhfLHpBjjF7Z4fXUBrh+Bbev5SGc0m4enJndPFiGQ9mVuc2DlVMA
+rG1GtXqthn8b+HcG/87h31P4dwn/LuPfVfhbR/w64tcRv474dcSvI34d8euI30D8BuI3EL+B+A3EbyB+A/EbiD+N+NOIP43404g/
+vYB/z+DfFfyL9Z+p499p/DuLf+fxL+LPYPkzWP4Mlj+L5c9i+bNY/iyWP4v1mUX8WcSfRfw5xJ9D/DnEn0P8OcSfQ/w5xJ9D/HnE
+n0f8ecSfR/x5xJ/HWs1jK+axFfPYilPYilP41il86xS+dQrbcmqhgj/42qlVfFjANxaw3Qv4xsIcz8APLWCVFvATC/iJJazYEn5i
+CV9Ywk8s4SeWsGJL+NYSNmQJ3zpTx0LP4HfOIH3P4Atn8DNn8IVlLG4Zc5cxdxlzl5E6y1jtZazKMha6gnVfwTJX8K0VfGsFK7GC
++CtYiVWs9CpWehW/soqVXsXyV7H8VazDKuKv1bC6a/jCGr6wxsmyhm+s4Rtr+MYavNFA/m8g/zeQ/xvI/w3k/wbyfwP5v4H830D+
+byD/N5D/G8j/DeT/BvJ/A/m/gfzfQP5vIP83kP8byP8N5P8G8n8D+b+B/N9A/m8g/zemMRd5voE835hGHOT5xsx0BX+wuBksDtm9
+gezeQHafxiExjUw5jUw5jew4jWw3jUw2fepUBX+W8O8y/kUmm16q499p/gD1mD6DRS1jUctrmIHdOY0dOb2CJa6cwr9Y4AoWiN05
+jd05jd05jd05jd05jd05jd05jd05jd05vYb4a1g+9ub0Gn5lbR7/LuBffGttBaoy04BmzeCYncExO4NjdgbH7AyO2RkcszM4Zmdw
+zM4geWaQPDM4ZmeQSDM4ZmfmEX8e8ecR/xTiI71mkF4zpzB9AdMXsBwckzMLWA4OyZkFxMeBObOA+DgkZ3BIzuCQnMEhObOE+EuI
+j0NyBofkDNJ+5gzin0H8M4iPY3HmDOLjiJw5g/jLiL9cx7/T+HcW/87j3wX8ewb/AnfNrCD+Cpa/guVjd85gd85gd85gd85gd85g
+d85gd85gd85gd85gd85gd85gd85gd85gd87g4JzB7pxZQ3wcmzPYkTM4NmdwbM7i2JzFsTmLY3MWx+Ysjs1ZHJuzODZncWzO4tic
+xbE5i2NzFsfmLI7NWRybszg2Z3FszuLYnMWxOYtjcxbH5iyOzVkcm7M4NmdxbM5O42Q4iwvGLEzN9doSTDj1GtC1XlvGJKBlfRXq
+Xl+FutdXoe71Vah7fRXqXl+FutdXoe71Vah7fbWO+HXEryN+HfHriF9H/Dri1xG/gfgNxG8gfgPxG4jfQPwG4jcQfxrxpxF/GvGn
+EX8a8acRfxrxpxF/BvFnEH8G8WcQfwbxZxB/BvFnEH8W8WcRfxbxZxF/FvFnEX8W8WcRfw7x5xB/DvHnEH8O8ecQfw7x5xB/HvHn
+EX8e8ecRfx7x5xF/HvHnEf8U4p9C/FOIfwrxTyH+KcQ/hfinEH8B8RcQfwHxcT2try6cwb+ItIRIS4i0hEhLWOgSFrqEhS5hoUuI
+fwbxzyD+GcQ/g/hnEP8M4p9B/DOIv4z4y4i/jPjLiL+M+MuIv4z4y4i/gvgriL+C+CuIv4L4K4i/gvgriL+K+KuIv4r4q4i/ivir
+iL+K+KuIv4b4a4i/hvhriL+G+GuIv4b4MGDrazVYSeprtTX4W+cPdRgOaw3+0MCcaf4wjQ8z/GEG0ZCL1pCL1pCL1pCL1mYX8O8Z
+/Msx8eU5/vIcPpzCsbi2hA9nGviAVF87M8cflvEvVnWZ5yOV15Z5PpJ2Dcm5BtJJfW2FZ6zgh1f5K0i4tVWeg9RaW+M5SKK1NZ4D
+dGmgsNtAMbdRb5yBvzAEG/U5NJytoxVt/dT0ZtSYwUo2ZkBEaixzw9plGLuNZRgrjeW5BibBYGksz83j3wX8ewb/rmD2POLCOGks
+z8/i31XMOIUZpzDjFJYBA6KxDAOisQwDorEMA6KxfAo/uoD4C4i/gPgLiL+A+AuIv4D4C4i/hPhLiL+E+EuIv4T4S4i/hPhLiH8G
+8c8g/hnEP4P4ZxD/DOKfQfwziL+M+MuIv4z4y4i/jPjLiL+M+MuIv4L4K4i/gvgriL+C+CuIv4L4K4i/iviriL+KFF1dgb/A/Utz
+MB0uzc1weAb/zuHfU/h3Cf8u499V+DuL+LOIP4v4s4g/i/iziD+L+LOIP4f4c4g/h/hziD+H+HOIP4f4pxDzFGKeQsxTiHkKMU8h
+5imOiSUvIP4C4i8g/gLiLyA+9OPSPCwjS/OwjCzNA8cuzcMysjTf4LnL+BdKm59GzGnEnEbMacScRsxpxJxGTKTYPFJsHik2jxSb
+R4rNI8XmkWLzSLF5pNg8UmweKTaPFJtHis0jxeaRYvNIsXmk2DxSbB4pNo8Um0eKzSPF5pFi83OID1uvpXnYei3Nw9ZraX4e05Ge
+80jPeaTnPNJz/hRinkJMpOE80nAeqTeP1Jvn1FvAryxgaUuIuYSYS1jaEuIvIf7SUgV/sNAziHqmvhmtncHhvnYGRIS1M/VpfKjP
+b0Zraw2QOdbWppe2hpvRyqlafWMzWuHTw8pyY16kzmHqEk89s7Y1tUtserRkapZGzpjajkaWTa2tkRVT62hk1dSYRtZMrauRr5va
+rkbeMrWeRs6amq2RHze1b2vkG6Z2VSPnTM3RyHlT62vkgqm5Grloap5GLpnaQCM/YWrf0chlU/M1sm5qgUaumBpE0DS1SCPvmNqe
+Rt41tX2NfNPUDjTynqkdauRbpvZdjWj/33/RTO3hHY1o/89Paqb2//wZQD8F0J8D9NMA/QVAPwPQXwL0swD9FUAfAvTfAfo5gP4a
+oJ8H6G8A+gWA/hagXwTo7wD6JYD+HqBfBugfAPoVgP4RoF8F6H8A9GsA/U+Afh2gfwLoNwC6BtBvAvQxQL8F0HWAPgLoBkC/DdA/
+A/Q7AP0LQL8L0L8C9PsAfQLQHwB0E6DvAXQLoD8E6DZAfwQQ0uWPAboL0J8A9H2NaJ8BrT77KYCAQp/9DEBAl88+BAio8dnPAwQ0
++OwXAYKWf/bLAEF7P/tVgKCVn/06QNC2z34TIGjRZx8BBO347HcAgtp/9nsAQe0/+wOAoM6f/SFAUNPP/hggqN9nfwrQnwEEPfjZ
+XwAE/fbZXwEEvfXZXwMEffTZ3wIEPfPZ3wME/fHZPwIEvfDZ/wQIaP/ZdYCAup/9M0BA08/+FaD/BdBNgIB+n90GCKj22V2AkFaf
+aqZ27yc1ot0Dvrr30wABN937WYCAh+79HEDAOfd+ASCg1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g
+1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1T2g1b1rAAF3
+3gOq3QOq3QOq3QOq3QOq3QOq3fsEuOlTgIB+94B+94B+94B+94B+94B+94B+gUa0z4F8X0BzPwem+xyY7nNgus+B6T4HpvvitwEC
+Qn4OhPwcBt4XvwsQkPQLIMvnMPA+/xWAgKT3gRifwyD7Akj1ORD3CyDV5zDIPv8tgGBofQETw+dA5i+AQJ8Dmb8AYnz+ewDBMP8c
+CP45lgJk/gKI8TkMni9ggH7+pwABCT4Hgn8OBP8cCP45lgcE/xwI/jkQ/H8Du3z+N5CGJf8dpGF7geCfA8E/B4L/b2zlP0EaTAyf
+fwxpvwQQkP5/Awt9fgNyYTr4HKa6z2ES+Px/QS62DTrhc5gEPr8DEAzz+0DT+8CS9z+MIaDpfRjI938hhoCm94GS9385hmAKu/9r
+AAFN7/8GQEDJ+/C1+0DJ+9BH92Fqug89cx/od//3AYIJ6f73AIJp6P4fAQRseh/Y9D5Q7T5Q7T5Q7T5Q7T5Q7T5Q7T5Q7T6w6X1g
+0/vQM/eBTe8Dm94Hqt0Hqt0Hqt2HnrkPtLoPzHn/egwBm96HProPbPo5DKj7QKvPgU3vA63uA63u3wIIpsn7QLX7SLXvAwR4D2By
+fACT4wPg0wfQbw+Apg+ATx8Anz6AyfEBUPIBUPIBUPIB9NYDGPAPYMA/AO58AAP+AfDkAxjwD2DAP4AR8AA48QHU7wFw4gPg7AfA
+fw+A/x7AgH8AXPwAOPEB8PgDoOQDoOQD5L8/AQgo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAo+QAG/AMY
+8A+Akg+Akg+AQj/8GAuEMf0AxvQDGNOfQwc/+D5mw4L5BY5qmBS/gEnxc+D8L4Ba/xso8wVw4P8GzvoCxzfQ7Qug2xeYi6Ma6PYF
+0O0LoNsXQLcvgG4/gHcfQn/9AGr4A6jhD6CGP4Aa/gB6+AcwEf0AJphHkPYQvvEQluKH8I2H0M6HMIc8hKX4IXztIbT4IXztEdTq
+IXztEXz3IfD7I+D8h8DvD+FrD6G/HsLofwic/xC/AT33EMbjQxgDD4HbHkIfPoSR+RBGw0Oo1UPozYewUD/8A9mOh9CvD2GifAgj
+5CFw4MM/kgLMQ5hrHgIvPoS+fgiT50OYdR4CVz6EXn8E1H345wABfz78S4CAPx9C/z+CBenhfwcIOPUhcMIjGP0PYSZ6BLR/CDzx
+CGadhzAnPYJeeAjc8QhmnYf/ABD0x6OfliU/QroAfz76DUmNR78p2/bod+Q3Hv2urN8jaPkj4ONH0N5HwMePoJWPgI8fQdseAR8/
+whbBjPAI+PgR8PEjbAew3SOsPfDxI6wz8PEjrCnw8SPg40fQv4+Ajx9Brz66Jqn76GNJoUf/HNf0X2RvPYIZ4RGmwcL1CFsE/P4I
++uMRMPkPEAIm/wH0zCPgsB9AzzyGueEJzIqPge+fwKh+DLR6AvPjYxgBT2B8P4YR8ARmysfAxU9gpD8G7nwCc+ZjmI2fAE0fA58+
+gdnzMfDpE6DaY+DTJzDMHsO8/ATo9xg49gnMqI9hhn4ClHwMvPsE5oHH0EdPgKaPoY8ew1ryGProMXDxY+Dix9DKx8DFj4GLHwMX
+PwYufgw9+Bjo8hh68DFw8WPg4sfAxY+hLx8DrR4DFz8GLn4MvfoY6PwYuPgx0Oox9O9joNVj4OLHSCvo6cfAxY+Bix8DFz+GPn8C
+XPIYuPgJ9NFj6P0nMIM8Bn5+Alz3GPjgCfDzY+DnJ8DPj4EjngCvPQZ+fgL8/Bh44wnw82Pg5yfAz4+BS54APz8Gfn4C/PwY+OUJ
+jPjH/wMgGPGPgXOewIh/DCvwE5hznmD9gCefYK2AJ59gXYAnn2ANgCef4HeBJ5/g14Ann+A3gCefYMnAk0+AJ59ALzyBmesJ0P4J
+8OQToPgTmMOeAJ2fwNr0BKj7BDjxCdD0CaxNT4CST4ATnwD9ngL/PQWqPQUueQptewq88RRa9BQ44im04ynwwVPgzqfQ+0+BJ59C
+nz8FTnwKPf0U+O8p9O9T4Lqn0KtPgdeeQl8+BQ57Cj34FPjqKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDl
+KdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlKdDlGdDlGbT8GYzLZ8BNz2A0PgMe
+egZj8BlwzjMYec+AX57BeHsGXPIMRtkz4I1nMLaeAUc8A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9
+A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9
+A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9A/o9B/o9B756DrPZcxiDz2EOew4j7znMXM9hvD2H+eo5jLLnwH/Pgf+eA/89B/57Dvz3
+HHrhOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDv
+OdDvOdDvOdDvOdDvOdDvOdDvOdDvOdDvOawQL7A8oN8LUBE8B0n7BZYMlHwBKoLnsGq8wG8ATV+AxPMc1o8X+DWg7guQ8J/DSvIC
+vwt0fgEqgucgOL3AGgDFX4Bc8hxWlxdYF6D9C5BLnsPq8gJq9QJ64QXM3i+Ai19A/76A/ngBs/cL4OcX0NMvoGdewOz9Ajj7BfT5
+C+ijFzB7vwAefwG9/wJ66wXM3i+A218AH7yAfnsBs/cL4PsXwBEvoAdfwOz9AkbAC+CNF9CXL2D2fgGrywvgkhfQq/8Gs/cLWF3+
+DfjlBfTvv8F4ewGry78B57yAnv43GHkvYHX5N+ChF9Dn/wZj8N9/Gjrzh//8kwj/OcI/hfBfIPzTCP8lwj+D8F8h/LMI/3eEP0T4
+rxHmZf4Nwj+P8N8i/AsI/x3Cv4jw3yP8Swj/A8K/jPA/IvwrCP8PhH8V4f+J8K8h/E8I/zrC1xD+DYQ/Rvg3Eb6O8G8hfAPhjxD+
+Z4R/G+F/Qfh3EP5XhH8X4f+F8O8h/AnCv4/wTYT/AGEUyv/5ewjfRvgPEb6D8B8hfBfhP0YYpfR//hOEP0UYxtK//8xPIvxnCP8U
+wn+O8E8j/BcI/wzCf4nwzyL8Vwh/iPB/R/jnEf4bhH8VYeDsf/8Eyv/3T7BPP/lphLEfP/lZhLHvPvk5hLG/PvkFhLGPPvklhLFf
+PvkVhLEvPvk1hJH+n/wGwkjzT34LYaTzJ7+NMNL2k99FGOn5ye8jjDT85HsII90++SOEkVaf/AnCSJ9P/gxhpMknf4Ew0uGTv0IY
+2/7JXyOMbf/kbxH+O4T/HuF/QPgfEeY0+Z8I/xPC1xD+GOHrCN9A+J8R/heE/xXh/4XwJwjfRPgWwrcRvoPwXYS/jzCM0n+/ifS/
+ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/
+ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/
+ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/ifS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/
+hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/hfS/9YcJDW8hPW9xGOl5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5
+C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5C+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5
+G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G+l5G/n5NvLzbeTn28jPt5GfbyM/30Z+vo38fBv5+Tby
+822k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k/22k
+/22k/22k/22k/22k/22k/22k/22k/x2k5x1cr+/8IsLYF3eQtndwvb7zywhjv9xBOt/B9frOryKMfXQHaX4H1+s7v44w9tcdpOcd
+XE/v/AHC2Bd3kLZ3cD29g/x8B/vlDtL5Dq6nd/4YYeyjO0i3O7iu3fk7hJHmd5CGd3Bdu/MPCCP97yA97+C6dud/IIx9cQdpe+ev
+Ef4nhLFf7iDd7lxD+CbCSPM7SMM71xG+jTDS/w7S884/I3wXYeyLO0jbO/+K8KcIY7/cRTrfRTrfRTrfRTrfRTrfRTrfRTrfRTrf
+RTrfRTrfRTrfRTrfRRreRRreRbrdRbrdRVrdRVrd/VOEkZ/vIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3uIt3u
+It3uIt3uIt2+j3T7PtLt+0i37yPdvo90u8vTkW53efr3pcLs3z/lJEHSfspJgqT9lJMESfspJwmS9lNkpU+RlT5FMnyKZPgUWeku
+kvxTThIk+afY9E+x6Z9i0z/Fpn/Km34LYd50HCKfYtM/xeZ+is26+wnC2Ky7vM68WXcQ5s2CIfXDn/1rqXH74c9C8VcR+gd5BvnD
+n0Xx8UMQN3/4IYrIH15DGEXkDz9GGEXkD68jjCLyhzcQRhH5w39GGEXkD/8FYRSRP/xXhFFE/vB/IYwi8oefIIwi8oc3EUYR+cNb
+CKOI/OFthFFE/vAOwigif4ht+hBF5A+/jzCKyB/ClPHDn0Px8eeANX/4WyjmfvQbCKOY+9FvIoxi7ke/hTCKuR99hDCKuR/9NsIo
+5n70OwijmPvR7yKMYu5Hv4cwirkf/T7CKOZ+BFPzDz9CMfej7yGMYu5Hf4gwirkf/RHCWM+P/hhhFHM/+hOEUcz96E8R/jmE/wxh
+FHk/+nOEfwHhv0D4FxH+S4R/CeG/QviXEcb+/ehXEP5rhFFc/uhvEP41hP8W4V9HGKb+H17D+l9DHriG9b+GPHAN638NeeAa1v8a
+8sA1rP815IFrWP9ryAPXsP7XkAeuYf2vIQ9cw/pfQx64hvW/hjxwDet/DXngGtb/GvLANaz/NeSBa1j/a8gD17D+15AHrmH9ryEP
+XINl9Ycf/yTCv4nwTyH8Wwj/NMIfIfwzCP82wsgz134H4Q8R/l2Efw7h30P45xH+fYR/AeE/QBgH17XvIfxLCP8hwr+M8B8h/CsI
+/zHCv4rwnyCM4+7anyL86wjjGPwY+fYajsGPkW+v4Rj8GPn2Go7Bj5Fvr+EY/Bj59hqOwY+Rb6/hGPwY+fYajsGPkW+v4Rj8GPn2
+Go7Bj5Fvr+EY/Bj59hqOwY+Rb6/hGPwY+fYajsGPkW+v4Rj8GPn2Y15n5M+PoZ5f/AOCUM1//xfsro+hml9wkNcGOe9jXoO/R5h/
+9R8Rhi99gQ3/GD70BfLgxzDWv0DSfAzSxxdIjY9xBvsYufFj3Oh+jFz3MYzQB8gUH8MA/QJ54jry93XkievI09eRD64jH1/Hvr+O
+vHsd+/s68ut17OPryKPXsV+vI19ex768jrx4HfvvOvLfdaz6deS569hP15HPrmPfXEfeuo79cR356Tr2wXXkoetI9+vIN9eR1teR
+V67jvHAdaX0daX0deeI6jv/ryAfXccxfx76/juP8Ovb3dRzb17GPr2PnXMd+vf4/EMa+vP5PCKOa4TrS+zpS9jpS9jqqE67j2L6O
+c+t1HM/XcT69jmP4Os6h13HcXsd58zr2+HWcK69jV9xA+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A
++t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A+t9A7r2B3HsDx8mN
+/4kw0vAG0PD//AG0/f/8wU8h/NMI/wzCP4vwhwj/HMI/j/AvIPyLCP8Swr+M8K8g/KsI/xrCv47wbyD8mwj/FsIfIfzbCP8Owr+L
+8O8h/PsI/wHC30P4DxH+I4T/GOE/QfhPEf4zhP8c4b9A+C8R/iuE/zvCf43w3yD8twj/HcJ/j/A/IPyPCAPv/Z/vIU2+hzT5HtLk
+e0iT7yFNvoc0+R7S5HtIk+8hTb6HNPn/s/dv3Y0k2WEw+v79hfMC5vSgMhpBEGBdugpgEFPNYs+U1eysr1A9ozGIppNAgMhhIhOT
+F16agJdsXSxbsj/dbNnzTfX5PsmSLFuW75Ily9JaPuc8+Fs6j+e8W4/nxf4JZ+0dl4zITLBYNTPSyJrFtYjYOyJ2RMZlx44dO3Z8
+B9vkO9gm38E2+Q62yXewTb6DbfIdbJPvYJt8B9vkO9gm38E2+Q62yXewTb6DbfIdbJPvYJt8B9vkO9gm38Xv/S5+73fxe7+L3/td
+/N7v4vd+F7/3u/i938Xv/S5+73fxe7+L3/vd38Xwv8Lwv8Uwjo3v/nsM/wcM/x6Gfx/D/xHDf4DhP8Twf8LwH2H4P2P4jzEM8+v/
+9xrb8zW252tsz9fYnq+xPV9je77G9nyN7fka2/M1tudrbM/X2J6vsT1fY3u+xvZ8je35GtvzNbbna2zP19ier7E9X2N7vsb2fI3t
++Rrb8zW252tsz9fYnq9xjL3GMfYax9hrHGOvcYy9xjH2Gtv8Nbb5a2zz19jmr7HNX2Obv8Y2f41t/hrb/DW2+Wts89fY5q+xzV9j
+m7/+Awz/IYb/E4b/CMO4VfoP2M6vsT1fY3t+ge35BbbnF9ieX2B7foHt+QW25xfYnl9ge37xcxj+eQyDvPJfscpfYLN9gc32BTbb
+F9hsX2BTfYFN9QU21RfYVF9gU32BTfUFNtUX2FRfYFN9gU31BTbVF9hUX2BTfYFN9QU21ReiXGyqL7CpvsCm+gKb6gtoqv/6mxgE
+zvVfv4tBaLT/KgjiCvw7GIQl4f/6AoNoKwQf+t//CIST/457xf/+R7+G4b+N4V/H8E9i+J9i+Kcw/BsY/mkM/yaGfwbDv4VhQfOf
+YfhnMfzbGP67GP7nGP57GP4XGP45DP8Ohn8ew/8Sw38fw7+L4X+A4X+F4f8Nw/8aw7+A4X+D4V/E8L/F8C9h+N9h+Jcx/O8x/CsY
+/g8Y/ocY/j0M/yMM/z6GfxXD/xHD/xjDf4Dhf4LhP8QwCFf/4xd+AUbU//iFX/hnAvpbAvptAf1tAf1zAf2kgP6FgH5KQL8joJ8W
+0L8U0M8I6HcF9HcE9K8E9LMC+tcC+rsC+jcC+nsC+rcC+jkB/TsB/byA/r2A/r6A/oOA/oGAfk9A/5uAfl9AvyCg/yigXxTQHwjo
+lwT0hwL6ZQH9JwH9ioD+SED/UED/WUD/SEB/LKBfFdCfCOgfI/SLPyGgfyKgvyWg7wjobwvofxfQTwrouwL6KQG9FtBPC+gLAf2M
+gP7vAvo7Avo/BPSzAvo/BfR3BfRrAvp7Avp1Af2cgP6pgH5eQL8hoL8voN8U0D8Q0G8JCMbs//jFX8PyfvHXf0JA/6eA/paAfk1A
+f1tAvy6gnxTQPxXQTwnoNwT00wL6TQH9jIB+S0B/R0D/TEA/K6DfFtDfFdA/F9DfE9C/ENDPCeh3BPTzAvqXAvr7AvpdAf0DAf0r
+Acnv+9cC+gUB/RsB/aKA/q2AfklA/05Avyygfy+gXxHQfxDQPxTQ7wnoHwno9wX0qwL6jwL6xwL6AwH9EwH9oYC+I6D/JKD/XUB/
+JKDvCug/C+i1gP5YQF8I6E8EBFz6/wFjBx/c+H/CdwZ/Co38/4JJ+H/9UQQWgID91p+iWRiaOv3Bn/7sn6KZ1b9GkyoJ/X/xePU3
+8Mjzv/0hzIb5nwrVN8yF7E/x2PEPYSZc/ikeNf4hzINrGYZZ4P9ff4zhL1R9/tt//gVRglDT/ZIE/lRoAn9FgUIZ+I8k+P/GCvzx
+TwjdrFCX/fHfEtpZCf1toZ+V0E8KDa2EfkroaCX000JLK6GfEXpaCf0doamV0M9a5f1dq7y/Z5X3c1Z5P2+V9/et8v6BVd7/ZpX3
+C0KnK6FfFFpdCf2S0OtK6JeFZldCvyJ0uxL6h0K7K6F/JPS7EvpVoeGV0D+2yvsnVnnfscr7363yvmuV99oq7wurvP+7Vd7/IXSv
+Evo/hfZVQr8m9K8S+nWhgZXQPxU6WAn9htDCSug3hR5WQr8lNLES+mdWeb9tlffPrfL+hVXe71jl/UurvN+1yvtXVnn/Vuh4JfTv
+0LRSAmib9zsS+D2MkYP69yUg4/7IyPUnf1tofiX0k2hvKYGfQlNPCaAt6O8Lgn/ydyQg437ezPVLaq7jdPuTXzan/n/7EzTt/ANJ
+5ldlnAR/TfEFkfPXTTbx3/4ETRn/lpjTf4K635+W+X5LJpSgaKI/ktX5d6gllsC/R6NUCfwexshMvy8BGfdHRa7//jvAE2YzDAJD
+mAUYhIabhRj8SUwg0D+FYYEHNpBmGPyZIvjLaG2CtiX//Xd+RQC/gcA/FMC/QABa6v/zxzLZr4qYf+mYXkdq3v3j2rW68TRr2dl+
+QmtyBiM+Xq2chrMmP/hHG9O/DI821javfncf3/4YExccuYwJvMH/XvfkvV2HtLP44/iSJwd+yl2ypumtby6Gb/3q4ptfWAxmrnpk
+EXMYbyzqtx84m/b5fqfPt7cJJGk0nPW7vb9IZy12b29wtQgb+qWmbrvjNHg0ieGVMOZ8+uqj7cfOYP84uidSbz3zDl5988Vh4yBe
+LOLoyE/OG8NvDl8dHjWcAtWeZlMHMvUT44nHYOamLCleeAxYInwzT1ggHovOWdAOUv1GMg1Z6fUrxthktTI8pklE4Q8NEDRivjsh
+NCU30j0VviE5ITelN+z0C2RXizACAUS+pTvBL1nAl8TJ2c7VItyBhhnXPPgmHXgF+p0382nB6yV3aBFlD7AxoVZmfAau+uybdMVk
+pKnkxOfzzHIB1gUDMHCyJOfA4Pww5fAh4g1NkUR7ROwXz/edqDfqHKd/ypw2NOzJwFnyJIinTs/BJ/0cqsvUvpocejom6/rH4gJ8
+I86oKYBQUfgd1z9Up9PiS3VmewBMxjXPRJqvMxoV1G7QoEQDHBNafg0yEG42x7c8aFl9700TiaMTHORAR773ZpYB0VdBJmOvgmyM
+njwrT5xesaBA9a/MoVF53fSq5nXTq5rXTa9qXje9Kl43DWb6HcCIZjQkhOYE3p4UfrvR1WyzuSWdjl7i+EFPh/1LeMPuAl6d0c8P
+RuAqG/PBk5XUfJkwKtwW3u0BOsEL38Zzl73WBe2DeLGMIx5l7Ykfhur5fL2adcTjYt3OB0+IfIcOXxl7+MEuOjp3u6SftJdJnMUw
+rZknvdYm3M+4a5LXaQg1MrTh+7Ikn2RxwhIrRnzoBo/ZyyRepu2JYokvAMQFWbxv47tFKvRtzi8bsYET7tIST6wL+FaLQJlpxHAC
+b7CkcMKIMWJx06t1SnOW6BWvn7JcM/dKJqOZU5rbXirlR4V+mn7iQ+e7vIBYXRJCA9nWhyEH5i3fsw7oqK6ZXvlnkItyasaCr7oP
++SxO+BiSTvzMzWTruwlRqFE5C3rSH6Nfc+y2JfBycIGtKtdL28IDHbX7qZe2YxwmVLSwTtcO0pf823mQ8Ckt19kgVtS3oFTUqEz9
+RQwFnsZxKGU48AaoMOl5sLTgeJbhkloU54dhfAnvMvbSNoxFgeBT/Npe2vaTxL+m0yCtxWeJH6WzOFkAC/40CRQRjUd+bETk0WXi
+L59pcqpmiXzI0Wg9MaBURmtE60TQN9KTIbY9u6k0rDMNLpxS9ps1ZMywQ33xS5O2rgPzizBN2nkSvFLfwxPmlxD0XbmTElKbTd4+
+OeHpUTzNQz7gvRv5ST2+XussgXDmCszYfmw/I0Q8oQ9cALoHH953nQM/iuKsAROy4Tdw2Db8tOE3FEmHFNRjTd3R0VsMWgZK0B6/
+s/qihvmSJw1+tUx4ChJlY5GnWYMH2ZwnjVOODv8bcWKUTRtQN6elSgCHjpvYrBByVCS9MXhq7+bCD3Pe45RH+YIn/mmILuUvkyAT
+4Q5MtVlwlsu4zhqcoII3clFGyrMXirQ3G9RisW160ElYi5MTlpF1ZvQZOIMVL/7I/H6aBmfRalX2iHmBHla7/WzPT85QHE3l7qif
+tVrK97COG2VjvQNIGkHUiIgsoFhH5n7qXUYw+nmSXQv+G9EEHVKPkjGLRslYO/Tk1v6G3Khgg4v+V4WBp+hoT+3c+pGqW8KyUTTu
+J+2iuZkJrFZbXZq0zSZnWx3qYC85QdRIYO/WVt0D7syp/KIpnwURV9/hcpq0z/k1TYh+tFbXGzdkev5EzSZ3zQESEZogjiaEZuu1
+S2iOT4k+JjRk6AAcXwR99IDQJUtgzzCD+EcdQucscWeETgG+vws+zvE1lQuAH3Tguc3EvcDHMd3dh0/gkcvEvcbnKkGWuGKJewoP
+UoLgQM9Z4l4Sesi6/P7Oow4d2ltD1fSZq7fNsAb1A7F8ZoRyYzVVrzFc+unTKIDNUXQGrnoR6QtMHD1/ZrlB5RevggVXjlX9ySRf
+5KGf8amJzqOE+1No+GF2HXKDwCTkfvJpKXqDw+CknWZ+xvFhrUmeJDzKMD2NC8TXeRhPguwan5AJ/TR7PuV+KJLlJkol1IM/hMHP
+YS95p/HPQYaVDv/5KBz3nShfnPLEYYqlgR959G4LwzNgvnuzpgEIgxiKQfjDUApjB0M5ITQYhWM2oTH8dGgqoBwhss6azQQYxxDa
+wb0xG6EX0FIT9GJqN0AvpZXP7+Xw0Bl2BW4Ln+punn3CJzxN/eTanM2JNRDO1MroVrssUTIgFA3S34lOLDvS7sSMltCqitqnd2KN
+zGZTlRBHL3malWGXULuyOOiSyugGFZGbVEcu6ZfTduRbJny1WuhvITActxM9E+BLC4hFtEq6gmkF1VT73c77h8jJqjWjwh15KaJo
+pspXoxos2dzDrti2x6xa3PaRn83bszCOk2rkziF5/5DsHFKfvSEZTRkMcPgXwr8Ju1nrqbeEqZfdeepldCmn3oxlo+UYmrwy+2Yk
+HC3HbEYno6WYSQjlCBVPloknLtTIs+fLaDmmF9U4NS4h+ox1+md7fv9MrV7XbK6HxuFOl9+nU3pBZ+0LP6SzdpoFs1nE05TO2lN/
+sQTpeAaDZRKATEP6U3YNr9NfsOtRd7wWepLvhSC9YqdA8JKdjrrjPjbJtOVebU/J+7FomouWe7l9ASA20VQ00cV6XTt7yqOD+e8f
+0o08KazwpMkdeRLIxHWLxq2D2GRlGVdPfWDbidppISV2M8oJTWGdB7Kjm3N+3XP0HsyhQui70dmhrueKmtwaeDO3QIkBSGj65qRx
+xL0ZlOOOyvlpOeOYEGtTB7u0hEdGAcB6zSSC/ZUSrC3ptbMWaiM9zcxWuk1fQDNmJAa2HTEuWDxNWLZaLVWsGwFnDDWoX6m0R0hS
+GSFBeYQkNSMkWK+t+k+UkuRZMD2K8ygzP8KWVEzWffu655INhXwjCMOXfMKDCy62hNX3KOvEnmZzo8jj1qUn9cKTam/xLkRZONOP
+AL3L9972wZ9Gi3LDGp9q10DLBe0J7CBDt5yG1AuVpfLvor+S88Et5r0lVhTvtTWbV7pWBzJXO47Ca3iMgGZr14hW3036mUKyobEJ
+19gfbcb/ymzGddP5qqv1thnePNpTp6v9pNUCUWbrVE882CTTDP7hTrl440Q/JlW300//Euz087+KO/1Q7fQnLHFD2ODLnf6MJe4S
+Nvhypz9liTuHDb7c6V+wBB41O1M7/WuWuGewwZc7/VOWwMOYV2Knf8kS9wq2+LjTP2SJe07oUO30D74fO/3v56Y+fZtdfTF81aY+
+YpnFuFMaFBi57gcc39Euiekp9U2ckTaH/V3IOv2wmJ1hq2Vu28Uzxao6M5gWkztvRCZ0RtQTypPRrEYHAG/twWuw8J4xVKdDI9jK
+p7Dbh5Dc7yMcIBxrOEbY1zCECMFso9mYzWkgQx0ay9Cc+jLUIet1vllbkPYiWmnbiuiVmioEI53/vegMru+qM0jd+m1ZimdJfq0W
+Id2oRgh4St6wQ67XAug9/8Wfy55/+Oe451fD/u33/UPy/pDsDGnK3pCM5mAgEcK/Cfxbwr8Z6/RnxYIwU1NyzrLRbEwXoCA4g3/X
+8O/EVBWcwgyd33mGzukpUWfe89FpvargiixGp2N2Rc/gp0OvBXQyOq2oCi43qArS0Ww8Oh3T82p8Mf5kmkPW6R/upf1D9dkHbKqH
+1hC3+Jf0nF7hFv/K2OJf6S3+lakzuGQHsMU/ZwdKZ/D0eyJIn7GnQNBjT0FngG1z2XKfbV+CkgDb6LzletvnAGJbXYq2Ol+vgQuy
+BV3CzxnN4eeahvBzckd9Qvr+kG7mWpMarrWscK28lmuFdVqF9AehVsjfSq2Q9g4VOXHk6c3cw6r64LCsaZCqBjN7WRWgtQUbk/yg
+9AJpoRhIhWYgckl74S/dmUpH0E4LUVWzukkhPJN1reogregO6pewpHYwfA8KhIu/MAVC+rYahJRsENVU15jb5vIqe1cFw8VfgILh
++odGwZCaGobL2zUMl7drGA5+pGH4kYbB0jDIjZwaYWJCJgNe4prQzpJDwnrDca879TO/x9vwI3XS0Sgbr9ekV5ddm02zTj/YU0/d
+9gOhy0hGwRiIMsaQODFKU3GiQITKZfbvXrmiEVLRCLA3R/Fa1AhGjP0Or7F9u73ykFUG75p169DQ4QSgwwnGSvs6Cmo1Obr+uao/
+Pn3so62abubQ2AZBAqMzEtHtATN05rLng4EbuaLRjRbHVlwLdk2Kld6NR3xMA+qP+JjcIWN9tOydAMZ4IcDP4d8U/i1Yp7/YU+9V
+9xetVvGRFywcLeCMDAqgJ6zTPyla+ER1zokaWRfwS27O2ImwjV3rt4/PlMkwdy9IfzJajNkpneNPX8j2WqhwL0T/kP4S4q/oFH+E
+tSjmjEdnY5E5hRAm8yGEKfPR2VhKcyM0xKdzOh3X6ufCvwT6uclfRf3cUunnDH0c6udMfdyjjqWP63Yef2Ar5O7vWgq53Qe2Sg4V
+dlophwq7A5a4Q0KfCoXdM5a4Twn1lMLu6PuhsMuFjPSDMsx5Rx1e7irFzWUQhmiWTk3Mx9y/4BozDaYKIcSpBU/OOBaSyr0Dp/XK
+nY2qHbphW05v2Y4TGrEMNrpwNag7Bs3iaHcMysTR/TFNWTZ6AEqLTn9SaA8nam4sGR9N1FowM3WIc5i6yztrKJZ0LjUUU7YczWt0
+iNNm052tVu4M7Yjg5fVQqAsnqC5UcIywr2Ef4VTDKcIRwMjqIVRwe4QMhh8KDaX6RLImWOBoDhYCsQx1qC9DU5rKUMfIhTEwpTfu
+54OaLVxMKwOiF1W2dX7tti59W81kMaFWK9fWUFzWKipBKjCz1asuacQKqVf3ZDbIDL1lddhXtRObxjUhvQx0WekGLWh0h6lSKf/d
+9aMB6EdPjX1gzILN+tHgTvrRuF4/6v0F6EfDt9aPeuR9j+x4dMLekIwuv//cM/rz4Z4ztgTuOWdL4J7AvXZBHlwC9zxjS+Ce16zT
+v96bKe55rbjnCZuNrhX3vAIl7yX8O4d/h6a6dwjM9OTOzPSEDonSqZ6MhvXq3gNyNRqO2QG9hJ8OPRfQ4WhYUfc+ZYvR9Xg0HNNn
+7EyGjlinf7Q36R+pj3llaEA81LY+pc/oAWpbDwxt64HWth6Y6tun7BU04zP2SqlvX3xPBOlz9gIIvmQvQH2L3/q05T7ffkreD8U3
+P2u5L7efAYjf/lR8+7P1Gr6WnVP4VHZI56NrIUFfj9nlHdW3k/e9W9S38xp2P62w9kUtaz+rWRZm76zSXZO3VepO3lKp+6yi1C0w
+6dxfcrEhM5CVGzy4Mht0omu5Oj+rqoefldXDppK30BU/qzNCe2Zph+lfWM1rqyQ+hz671S5uTci43k7u2UbluOa2pTRUM91yhOK9
+JXxZh07FADEvDekxUhRao/QuTm24EruMyphyS6EVWBe1MhKs/5z0+txYswIJieUphoxqrfLrpKFoELkZ6UU0ZeKafl/MXMaygd/L
+KicDxX6jA1tqcysN58GjrE5F1QC81tCuidy563I2nT/UdAXpZW+Tmk7eXMrsrUqpSy3kB1QxpdRH3wEhnRBclTvj+nMTXK9rztFg
+/S7zYlzN69gxru9Vjjx/93OV0x/wucr36USlL8agmgT92lSbpX/jGKEqudXSorec0ZBedtdjmtMf3DFNRTXRoRtPbi5/sCc332sD
+20c7NKs9CiqsSrNm82DDmU8mznwObj/zOfoez3zKloigGEBVNjdV2cmIS833mAWG2YdOnZmp41FWk9oHdW9Nan8UjFGxXtKl87Iu
+/U4SfExVTaUgn7LIDRBJ+mJQpc2mL/wVpFpG89tpnJgbdXV6ErB4hOsBKHbiUSSCKUsUNmeJxBY+KgJ1OuWrVUQR2Y4riVOVOFeJ
+Fe3tGsrmEQDYpWU1dmnZKMQW6N9125PQCUHNRLAHqplmM99PQNsj6rPdBULBvozaM6IaXa1v7a5/kBXzZcXSfat0IOTLiqV7Vp1V
+xba768pFWFrMn+QHdmbaLo5M67T/gVAaP4DJfBQDOZa4gbpHhXEPIW6Y+WdnPOFTnSgm8iIvJnoEifCqdwDxOpVP+mr4dztPOpAq
+XYKYzRJXLkI5i9wHjx5D1DLhKc9SvHmqRBzUefeFdC7Vt4kbiuiJKPwJxCc8TqY8+TF+neJN1bu3Hrm5rWf63ytn030ToP6SZhTO
+Znt8TTb0yA/9eUzfbB41UqALN/iWuvUc38UrpNg2frFbir8Rn56GnN5ojUCv3emuSf9/TtuCLW6Qe8lnPOHRRNGEtbsx99PoXtY4
+5TxqBFGQBX4YpHza2G6k+ZInLrFSQPl86qgFfitbrRyx4bSMF+pMGga8l5XO8H9k/vDu3g4MViK3h4ofdsRl+6511f7BoyfWVfsH
+H+BVexfv2m86eUPjAnXgJrmycHJjn77RpHAr5LfTLE44i8Qv9bUMIvREJns44xkKheB4jF9ZMrPykQc0elIAjRO+fpOYKwtLy9Jm
+VUwV8mdqyJ2m4Dmnc9PljKiHvr1Xq0rRl/naXLrKMe0I5+2J8alvJLv+EfP5EfP5IbS9Ss3h1p4G6TL0r8HLz2rF2xH+OnpGOSVz
+H3KTJddFbuFJKiPriZ9N5gbhp238UMbpU2PYhrbZV1lI2b/fbGp/ljpydH88MIEe3rD/MI5D7gO/ozM4mzukU8VFpxt0E71s4Hao
+vrlJQDc37As7nmi1OgDjiPYyTzg9kaQYY9er1TVYSbQvg2z+ks/oJdNVPG02T6nHTprNiy3GDugRe1Y42NzAka0GuJCgypOshYTm
+HMRRxCeZ67Sgt1oOcajBIxNyYxuRaR6flni86UoM0pG+31YOK4+oYvRc/K5WmQhQt1Pc0iauTEbvHcR5OMWJMAuiacNBtINeRcTU
+yea8MRHsEaYPcutGPGs491pR657TbhyKdOC+ChMnMTAlNdiAkt/Ye5HEF8GUJ/sUiPCrZRhMgiy8biyBa6lSkXtBCY0s1gXcU/sG
+WWdYn+Rhh7m6+RmXnBsjezkImKghO/Anc+4Sc81LaUJoaixZ6RzaQU+RT5fTko5ZMreT1QoXrbl/waUMnR7M/eiMT3VMOtS1kFFr
+qyxomzwTCcoKP8WjkdQsiPzwyF9iylcxpiWm4zjFVvhHZkqkom4AGa1FE3lgFBdFP+NLHk29SH3LYGPBbgQM6JZoY8SXPreukqVv
+FrWduVZ9M6hynQ7e8p5XVxuWDKLejL7xc1l3i7GNVCQLo8lAtnap3wRPjmp791mQLoF/3qmDVeI79bFKbHfzVGKLTrZqcHs/l8qv
+6epKijv3tspZ2+FT16j523V3qUbY41N6l2+vdnqJVn2/WzRruz5HrlGMD9BO8ymfbjirqo6nQqI2HDWqlpZjJtXJV6stt0MXBVPn
+tJSCEKVQL3CM060Oqau49YF3q7vdJnep/tTMsekLrETFR1jozd9xVCjQb/mKcrvUlFzzFVsim6GjbzbBuKfuK4xExTcYyLovCNJh
+fppOkuDUrrQovU4GEmcDqcplk8uSa03PJBc2m1vlnKqGBooVPdrWSFcuc9E0lOtb+zSIpogmhFZi8WCmVKlPo7SuWneokktoGaVO
+WtK3OsEzm8bdlPvWo7mTDf0uBgz4UNsgKbCtzm0FbjqrsttNNYQp4pSI6pgKKXsmFeazBpsokOaQLbD130U3ykBG5IXB8z7kPHqR
+8IkfToRFjjYRLupixAdxhPs9oyLSI+lUesI1YjYtFdUUluwgDBRSS2FaDOeSg/ryYLS4ZFleVSdz+GntQliFEwUULbcYE9aaJ2qC
+3ia9qFU0l7XYsPzgp+L1861Iihb9iDH2tLo41LW03PaRO/ZeZ/3mIVBYWhkCO2jI13a7n/HsG4m/XPLpc6kOqfJEe19zSZ1XccOf
+wKEw7kYuRX6tT6GN6zhvRJxPYY+RLvkkmF03bhpyK9hrZEnOG+uGL7LP4jzJ5g21V23EM7Uhwr0cQe1H2yFqKM7S9qVdY/uL6k+A
+M6bbtjSlaMQ2NidNimy3dAkN2Jt7mcasbjJp/9e1s71722zvft9neyAVP4HcD291aArHKCfNZtxsuj5s+LNm843ThqasSHarpKh2
+nuiQA+5LDcAbRs9vNt2c3TrnXDBeazbd0ExWK2C5pJAttrbcfLUKV6uMNJtGxhqJxgWRIh7EPbeWCV6CWmRp+wiHBWoCBy9lrk5v
+Ej7rOaWB66wJ6dVTKRMgtZwYlqO1uzRNCLRhs6GiQs8TcprrpIyLOzoVrey8OAZKqqpgI9bt0KuCLyTg3L72AG7yl+oALuyLexx4
+njBTJwiGc95u58kDyztvt/PkoeWeF88YTuCM4ZrQyH3QfULgzpCLl4Yi94NHXWI57P3gySPDZW/1wA/M9oZ1eCVkgJbb8vwj1GXy
+83BERqjJWtOnTCpTxRr8jHW+/wr36omN5OYsays1lXl4I87Mn3ThfgAcllNxBv4EH0LAI28jX6x6yiCbvuM5cjBzOQN9t7qmKTf7
+cjid82tQJNLEQmS40EfFxdPklounbxiY+BhRvKeI9WNpExMoj6YRPE60WvERBMZ4VRVDb/YVZh65v0sX6xyZIQcEuBF5ihEHwLPi
+JEV2Rda3HvmrXn7w+H6NHYFQ62KXPyH9/7l82v/oLOh/mrOg+juqGXWK0eZQWb2tzpr8yFvfD7u3vo7tq8/21Ncteep71DVuBjt5
+JGo3LSZN5F8EZ8ASBzrUzlOePD3jUdZzjuLPgzD0dx62Ow33G0E0jS/TxievGo/aXdJ4ulyG/Bv89MeCbOfh/Q/auw33x7726uhj
+2giDc974Kp+cx6Qx9Gd+EogEDr3edHOYl60XInWy5WbFkF6tZDOf2UM/I8R6sahwNqDPgILPeS9SFxuDz/maRiVrh9zN1IUYIbOq
+mw5vulSgrArwPoF+8AavFCwTPguu5I2CdBkGGY2VvTX1rX1/8DkHD2POCz/iDg1oBL7GhPc8fMvoZhbyq16XLmNhXge1hN3QBXdo
+nGdhEPGeE8URiOjq84sz1mbTdS54kgUTP3QY+F8I25fBNJszv+eG7TmHF9CYT0MlhDMHynMIDdsQYII0oZPC6NncALji4RjjvZ+8
+/a04iFyn4ShH0olsDhctoMkab3Ks3UmdWfF1/SNCs1su6mjjjiJRFE+5mUR1h5FELaPezNVXH6zrNzhyZnUXe8qVobPSTR+4tAx9
+Xs7ujoq+oM48ToLP4yjzQ2esmuqikA6vS0/26G+A5U1X2b0ppu0Z3hxWMeya/she7kdiyv9UYgrN2i8Ph8//+uHLk2eHHz399ONX
+JwcfPx0OP3l6dGib2P0Vfb2nY7/dY7/c0y293POoa+gGfhikhDN2W/86Lznw5OQWacIwt1YuSN5SmpD2TpBFy7GElCSG9J0lBi0k
+gMgQRwdhMDlHgSGOnsX5acgFJkDMUZyn/Fl8GaHsEEev4nwyP4ym1C9AfPSUpqbIkTPQLGNTHejyJloOWWo5ZMZGOZ3Q4nJhuGmN
+T5d+ZC3ys8oin6pFfklQbDGqX3dPNECHcuZHWKnwcy7gxE5f+PLNHIfR9E3pY5keW9RKHOGbipUMEIc5jJ6wq16fL8F8ay3XhG8h
+1yxvkWtU1Zf2DV67fpXIotWXtzyvYTT7G5NBW29OpMWSZZ1oNa8XrVA4Wr6tcKQPV2l5eN/Wjm+QpOZ1ktTFmlTLOLOEqx8JUj8S
+pH44bX9l0/F2ykOOWGKE23yxzMAzDLqTA1tfXAeHKh5f1F/EF/xpGL6E07vUNYx/1++mWcr/EmiWwr+KMuNEyIyGlNi1ZMQHDx7Z
+rzt2S687Puparzt2O08+sB947Dx5bBwY/TBImcPN0qOtiuLvrIoqFFGWHGdLdcJQioOXPHNBLsl2VirMexRfcGZBVUo6jYaqdD5d
+MiNsxEvVmT8BJRMwMLEUTntb3TXlJVk4LGThiplVjVisLT6Cz81nto1jf0Kn8SQXz1hPp4cga30cpBmPeOI6C6hsvnREel35N+cB
+jmbngka5NV8GTWfl041J1mta+uaycVjl23np4037zA30pO1XTTPqagte/dattDnbGxuqNuud2soY+3Wtoxwx3qwppzdIkae90c0k
+DHiU/XiPt2WIit9vasw31+M16ZsF49RxM7PsAr+5cGNAwmMu+Pq22HXShIGc+izxz5CGsKQRWyo8YyU3qavaiF4im1I3qUsKWN6W
+3zbqjNvFx1WQ3+zXWHgmzWbikrLhlJqqnUJLHAsDKqvlj7CDfrAtD2XUNPztRb+h3Rf+FUwZbPFFEGE4xv4QBkbUV11BcwhlfElD
+Q8lOJyxsizaiSxa2VSNhxzWbk419N2POLEjSDDquqGN7mQQLP7mmczYTRuFLP+Ldng7uAuG5com5KPZOQTR95h3BO/LuHI/mp7CM
+fBjn0TSIzg6wSV/yibSIv2Abol0idPj07JYUQrVPr+3h5999+NGTctaL3hk9Zctt9J6Qo5SJvgH909Q9JXvKe0T/lP3Nv+me7uTk
+/XwtnRsPTnvbp+B8FrLq4wkYzXusIz73XPYY9CMchvQvy+Wf394craR3/obmaIkLWIfsZPuKDtly+7R/uBcMDlnQMyt1uH85OGSX
+vdI005NrWCyJoJWMm83YPazMymkCfhOmMFx7h2tC50ZcKpHrdWWWfrq8qzrJnCdCuAD+9FEQBem8sAhUL04nagrELGkbdetnzWYA
+50RVbhM1mxHc0d/Abrrggk7VXq5r1UleXPComUKJMcOi+slEA6XaBSEZD9SCz7mw7jO/A243Kj9fElTMIim3fLBGQSf4nKM3doOK
+NLWr78XA/OA7qP7oG1mbPkPEp8K0ui4uTmzwA3yD86XI+V4q9QToC0MTZSoSJyzDRiwUgTOJ6RaouUTtFqipRIkHUhcqjwAvVHoB
+gtZWdec1hqUi8oRlVUXkaYEU2Q+BY8N3DTUTP4AQRj5l0cBxes40SGFfNHXoM3YyOGk5Dad1uVlX3DuhnljPbuSxZk8ca1J5mCq4
+Qc/pdjpfdoyjVf80jcM8qxyt0viCJ7Mwvuw582A65ZFDj+LPP015IjbOPQdsEx36DX56HmRV/CKt4vIyZk0PQHVK+hbbGw5y1xOn
+wM+CRGzSe04SXzo05LOs16EJfktnTXqY8jTOsnjR69BSlkkc5ovIoYsg+pr1+Vm87HUoslCJktv2IzZyhoodOzSgQ/p0TF+xa6Xr
+xSaeQqXpAqtO6Iva2AsZ+1wedHcdOqGzsaFFfiljdiFmbsSondTyrgfQRwZVMGU1rcN4sbywbC31irrCHlnTTcVo81GzqOdU+NEU
+X1QtDCOwIOAeBac7GxyuVvFq5ctVR2pEh7JCr9Y0GXXGZGNlzusq81RrjNOSkji09MKmbP8MTxdMdXBFijbVwPZ+gko2KE9lqp8v
+o6ABKtrUZ6VvPoURsiZv1/4vi/bf3dT+u0X7p3wSR9M7dMAL6IDumGid/rJOpz80dfoGYy8Mj9uncRwWBgrzilfSuWWyYLv/1F85
+L9sbSG7bm1c06GKEUfWlY0LlqlFOKywZKpTnVUsGKXy/OwFjHXt3Iun3lht7d373Awdrp2dkVCcwhphVjRWbkireXLI3xprztpRG
+n1vM645azuqPWnA8n5RPToYltCUj1Aw4S2Coj999Q3xdkd065O6wvs630IcdX6/c72s6LB38mHN0q6OnxsMOtY6EzuqOhECAV/NO
+TTMxrIxRZDej45QbznHKTeU45qnSHR7oJjdFkpHjh8FZhP5qIpj2R/HnT22MEEhKyKNhCeGVYCT7POOL1CCqYIOkTjK0QM+CkNiQ
+hzODlgQNUirB0IQ8E/DDUFEIjbyhzBWK9PCjHW/K9AYoc5kJhibkmYCm84yH/nWJmMKVKOqkwwrKq2CKApSYVi7EwJcLMrMMa9Fe
+LbYoNE/qWslAl4s0MgzrsF4dUpf3URCGR/GUl8oz0KXyzAzDOqxXh9TlPc+4qMSB0KJapVYiS2VXMw83x3mbo3RtYLqX6iBRpZJV
+wmEZ45URmvaL0L/G7WqpABNfKsXKMqxFe7VYXeirYBFEZx8ppYFdciWyVHw183BznLc56tSfnM/8Cf96kAanQRhkcpp+WIcXdaiN
+OhrWor1aLBR6loCWqShMw0UhRZKhBXoWVBB7mmX+ZL7QrPzD+phyAXa24YYIbwO+KP7DkEfTYn5+WBtRLtzKNKzHe/XoouSDMFiW
+C5W4cnkq6bCC8ioYo4A4jJNKCRJZKUIlHlZxXhVVlPJ84Z9VGk8hy6XoxMMqzquiilK8JDgLonIxGlsup0g+rEF6NbiiqBdSL1Iu
+zMCXizOzDGvRXi22KPQlX3K/MgM0tlxgkXxYg/RqcEVRQl9pFyRx5WJU0mEF5VUwp2E8OTdoG6AkayYYmpBnAqfo1FYSUWFJQUcN
+ddDTIZERSR1qHlXGmYTMpMMKyqtg7ALM2VUbUVeUMctq8V492i4Zdwp1JauIupJ1pmE93qtH2yV/A7RldSWriLqSdaZhPd6rRxsl
+y/PLUrEKWylTJx/WIL0aXLmo+q61ouoLre1eK8bbFFGuQ30nW1H1dajtaCvG2xRRrkN9d1tR9XWo7XIrxtsUIeuA2lyraIWxStTJ
+hiWEV4JNstXutdDVAirdamG9OqRZ3sd8lr30p0GeVgu14qol21mHG6O8jTFmRV6C/ntTTezIalVKmYeb47zNUWZtqiPcQldrUBnZ
+FtarQ5rlVUezha6WVxnFFtarQ4ryDuIw9Jep9XEGzizJTDqsoLwKRhdgj+C6sVsetaXxao9UU4qzECZFQ3qzYM8GDYpenqU8q9DV
+6Ar1IsOwDuvVIY3yLDGqBl0pzxSkarBeHdIobxgGk2qzKWylNJ18WIP0anBmUXGe1JWl0NXCdIZhHdarQxrlVaaLha2UVp4sFtKr
+wcmiojCIeEleM5FWQWbiYRXnVVGlUioTpxJTW155KlUivA34UvEVlleJqS2+zPgqEd4GfKn4apeWY2qLr3RtOcLbgDeLr8hwNrpa
+cFmKs7FeHbJS3obu3ijJ1WUdbozyNsZUKrKh4zeKc3VZhxujvI0xlYpsGAIbZbq6rMONUd7GGFERkEzMwiVsFqiSDC3Qs6CCWKWD
+TWSZbLlLTZxXRRWlVHrPRJZLKfeXifOqqKKUSteYyHIp5c4wcV4VJUqpin618l5FyCtLdiVxDkU8i6pEWERVoqENezZoUKz0rYWt
+0C73roX0anBGUZUOtrCVospdbCG9GpxRVKWXLWylqHI/W0ivBieKGi79SRCdmeUUKLMQI+GwjPHKCEm73Fh17VRuolLr2A3zKl6a
+9ARoUpMJhibkmYCmUxk0Bq5EsTxgDJRXwegC6jdz5YhSUbXbuDLeq0frkjfs3ioxpbLr922VCG8DXhdf6XYDVyqy3PkGyqtgdAGV
+mWHgSgWUZ4WB8ioYUUCFeh3pMt0S0YKiqZ0o6SUMjUShi9BaiKtnfBKLs7IPE+6fKyI1eEWwLsuwFu3VYk/jq+Hcn8aXuiwN6iKK
+BEMT8kwA6ASfG1ylAAs6OsHQhDwTOIV6PZ1lWslrwpKSlWRogZ4FIbEP+SxOuEFNIwxyRaKhDXs2iBSfR2kwNSlqhEGxSDS0Yc8G
+J/4S+mOoKR5YCEHRxh0NbdizwYmfcHN9PDBhRc9MMrRAz4ImclocFLPhQEwC/PHwPzpSlulkUCZVEUMV8lRgog+4rGMtfZglA578
+heQvfDVJDwqoyKaihwbgGeGJnJQHxYQ8EJMRfzz8PzHazW6yorV0Q6k2QitS40T9wELo/GaioQ17NigowgG+SVDCJj2VZGiBngUJ
+Yl/1lyYtAZqkZIKhCXkmIOi8zBWjPzBhk5JKMrRAz4IKYgdWi5eQZbJWL5RwXhVVlGIsUAcVZLmUYomq4rwqqijFWEcOKshyKcV6
+UsV5VZQoZYhuJ4oCJGzSVkmGFuhZkCBWqW5dVcvVLFXRrl5qUktLlFKDSlpQSDG3Ya9VNtUyrbSKsFcEJzBtYGc5SfjCIFPBKnrV
+5MMapFeDk0W95FpzeWBjrCJ0smEJ4ZXgSZ6kehaosCSlo4Y66OnQlMsV9xkv1loRPhqKX0/8TG17qqoZlW09ZUKeCcjbA4qKAhQN
+HTkswl4RnC4VG34mQjKfRA9lwJO/02WgUgdG4kCmDUTSAFMur1TS5ZWRVqCHMuDJXy5rcVjU4VDUAH88/I9+AQ54GKYqsQGrTGaS
+oQV6FsRl9Q6Lyh2KquGPh/9nQahFn49UWCTW4NFQBz0dgosNH/ppIGv6kQHK7GaCoQl5JmBdkChoVUdLGXs0LGO8MgJofxQqUfOj
+AiooquihAXhGGEh8NTFJfDUpkfhqYpD4alKQ+GqiSQznSRCdF0Q0XJApkgwt0LMgIAauuQtSEioIqeihAXhGeBbG6lDiIxlUmWXE
+UIU8FZjFisl9FBuMTQJHQxnw5C8k/8hfBOF1kUnDRdYiydACPQtCYtzP8oQPeZYF0VlqUK1EGOSrmYb1eK8eDSX/GE8ivcv4yEIU
+JRmJhjbs2SBQ/NiPznI4MbrgSaKl8I/qY4oy6rINN0R4G/BQfGEU81EBFcWo6KEBeEZYkXg6/VaeZjYhjbPJFUmHFZRXwWABWcIz
+tRP4yEIYpItEQxv2bFBQ1OLYRwZoUpMJhibkmQDSuY6yOS/YXgll0DMTDssYr4wA2l/3k8A351mBKOgaiYY27NmgQfEp8OzIz3ha
+oW1FVUqxMw43xXibIow6HPjLaukSWSlXJR5WcV4VZZRy6KfZ0zRQIutH9TGV8qxsww0R3ga8UfzHwRnykOqXmjGV4q1sww0R3ga8
+Ufwn+YInwaRSeIGvFG1kGdaivVqsUahtoPhRbUSlWDPTsB7v1aOh5G/wQsf/kQkX5RRJhhboWdBMST6G1CMlHiHtwP+zxJfnwV8V
+IZFQAkdDGfDk71kS6OSBmTxQyQOZPFDJnybcL7JIqMimoocG4BlhJJFn8YG5I/pqBWkQtBMPqzivilKlFALVV22MTV8lG5YQXglW
+ZF/Gl6WaS4xNViUblhBeCQayB/KyrSKq4YJkkWRogZ4FFcS0acBXS6gySZlwWMZ4ZURBWytMvlpClWnLhMMyxisjCtrGefdXK8gy
+fZ14WMV5VRSUYtW9XGuzvkZNizq+NEfUy9JgemmMo5fFEHqpR8/L+NLqFg1bNMwO0aBnQZKY9S0atoiZX6RBz4IksVLDGxiLoN3k
+BsIrwUD2FV8sQ33R5Ks2piBrJhuWEF4JNskCV0mrtBW6WoDOMKzDenVIs7wK26pGVMsss68q3qtHmyXbPKeErZZp8Z4S0qvBzY1l
+6mv2EqXBo6EOejo0v17OuWqRr2lAZi0ih0XYK4Lzz2XGz4s8n4vkn2NK+B+gzVoS8Cgz7p89r2IFgZqIo2EN0qvBBcJcLZryRG+k
+npdxRjFW0mEF5VUwsgD0GmF/ioW0ijATD6s4r4oKFry4mPNcA4qqjhwWYa8Iqtsiz42ayMJFeREmmvMkyFRKBajkOnJYhL0iKJ2D
+qtwKULl15LAIe0UwEFYyenP43IQVDTPJ0AI9CwrSODSHlQFKUmaCoQl5JgBbwmB2bV2u/WtlnKBYQR8NKyivgjlXk+XH5sVsEeGj
+ofj1xE+ojYUsMyFtICQDnvwNeZaVDB8+LqEUgVLCYRnjlRHQ0MZx7ccGKGmaCYYm5JkA0PmawaM+NuGCUpFkaIGeBYVBam6yPzZA
+RcpIMDQhzwQ0HcMG+OMyrkRRJx1WUF4FowuwNy4f16BLxZgZhnVYrw6pywNXCaWyJKpUjko4LGO8MmLhJ/oe2pEKC2oaPBrqoKdD
+IqN9O+mojDMJmUmHFZRXwRgFGCLQURVbKUQnH9YgvRqcLMqwgjiyMVYROtmwhPBKsCBbsgg+qiBN4lbiYRXnVVFmKZWGqjNKrYs5
+GtZhvTqkKK+wfDwyYbMEzdgs0LMgQcwwuDuyECY5nWhow54NCoraAuvIAE1qMsHQhDwTWPjpuSKRnhu5BXooA578heTFBdijAiqy
+qeihAXhGGEnEC3RjxQ06BsogZiYcljFeGQG0DXZ4ZIAFzYIJmpBnAkCnkGGOCqigoqKHBuAZYSBh3n49MuGCTJFkaIGeBQExmwsf
+2ZiCoMV7SwivBANZ8+rFkQkXJIskQwv0LAiIFVLRUQEVhFT00AA8IwwkCuZ/VEAFCRU9NADPCC/8K3OlPjJARcRIMDQhzwQW/pVx
+Bn9UQJqKjh4agGeEF4r9Fo1iYySpUrJhCeGVYO0RTdMsf6GZYGhCngksNMcz61cjzZaxR8MyxisjFoFpwXAUlMwXDMTR0AA8I7wI
+rkp3/I9sjCJlJxuWEF4JXqiVz1jv5Con1jb4rzzJHBWbSQweDfHHw//isYCP1C7EM0CRxcQcDU3IMwFBx57dXhlnUrRmeAXlVTDx
+bJbyzBZhvDJOFlBJOqygvArGKMBYmb0qtlJIsS7XIL0anCiqJGt4FaRZkJV4WMV5VZRZSuWL6mSNupijYR3Wq0PGsGNRzkA8DUja
+ReSwCHtF0Lh471n37hV0NFQhTwXiZDn3leLE04DKpyOHRdgrgtLdpMytAZm7iBwWYa8IytyGdZlnYyw6OtmwhPBKsCQr2teiq1EW
+4SLhsIzxyghJ29i2eTbGoqyTDUsIrwRLsgar9GyMRbZgmSWEV4KV+09JsoAkOSN6aACeEVYkCgsKz8bYpFSyYQnhlWBF9sdtmj9e
+IfjjFrUfN0n9uEHnmzadb1bofNOi802TDgBLfzrVaocXGhA0CvhoWIS9Iihz25z1RQVpUbN4axXnVVFmKQYzelGDrpZUMKM6rFeH
+VOUZ28QXJZRdjk44LGO8MkLSLvHvF1WsVYLNwWuQXg3OKqrabnVcvDbqaFiL9mqxstBi1/jCQljFqERDG/ZsUFI0to4vbIxFUycb
+lhBeCZZk9f7xhQlbJGWSoQV6FrT0z/iHpdsBL8o4RbScdFhBeRWMLsC8MfCigiwVUSQeVnFeFaVLMW8RvKggS6UUiYdVnFdFLaXV
+wYvCygCDR0P88fD/kifpkk/Eu7mY2kLIbHaioQ17NmhQNHelL2rQFepFhmEd1qtDLuMgyniCL29IMeNFCSXLKSccljFeGbG0ZOWK
+lGzJxwbgGeGlmkzGFJITR0wX+K/MVl8UdqEYPBrij4f/vy3S/K86yf+KKeC/B/++ncfanOh/VWGZUkcNddDTIW1M8dKwpRDho6H4
+9cRPokxmX/KCO4vw0VD8euJHOCdViVNzl6fBo6EOejqU8AuuOOhLFVYZVdRQBz0dSgrGZXOsglWpkKcCSX56jb40ZT4DlHnNBEMT
+8kwA6BzxRGmDXhpgQUcnGJqQZwJAx96fvbQxBTVr7JUQXgmWg2KoCQwxF/z34F86SeIw/JDP/YtAScrDMk7mrCQdVlBeBSMKGEb+
+8iCOk2kQ6aPyYX2MWVg123BDhLcBXxT/jKcZYHX7DjdElStQyjjcFONtiijqUOidhmVcuVSVdFhBeRVMOveX4kTl1Tzh6TwO5awe
+1kbIouozDevxXj0aSz4yzj2GFsIoqUg0tGHPBpEiuG/Rq+PQxhg0jWTDEsIrwZl/WqigXmlAECvgo2ER9oogPkH3sX8d55mmYCA0
+FTPR0IY9G8z4VWawn1cGKKmZCYYm5JmApvOxr+x/X5VQJXoq4bCM8coIoH0QL06DiH+6NHjsqzp8UUo1y7AW7dViodDinmpRoIUr
+CrOTDisor4KxCzAUBK9qI+qK0pmG9XivHm2X/LFWbryqw9eVq7IMa9FeLdYu1FAuvKqNqCtWZxrW4716NJR8uFjOi2spr2xMUZaZ
+bFhCeCXYJFvqvTK6WoDdc2WsV4c0y7MX6Ff1MdVSrQV7Q4S3AW8WX+q9MrpasN1zZaxXh4TynkdTbeDxyoSLEookQwv0LAiIVcya
+XlWQBdlS4mEV51VRWIqljnplYwz6plqqhPBKMJAtGUq9KqEKwlbCYRnjlRFA27x4/8qEC6pFkqEFehYExF4lfpTO4mRR0DNRBUkr
+4bCM8coIoP0p1DuEJ1crw78uqiirNuNwU4y3KSJTCgRTc6BUBuLXEz/4ZNtT4/LaKwuhclqJhjbs2WBWatdqmxqYRWq3pQFoOh/G
+VyVSAlOiJpMNSwivBGuy5n77VQVZIm7stas4r4rSpZgMqIwrlWGwnjLKq2CwAHNombBB2BhEJuhZUEHMeKTgVQVZJqsTD6s4r4oy
+SrEeDXhVh6+UlZsySx3aq8UWharXj8uFGvhyoWaWYS3aq8UWhdb5t3+1ObZcgWr24S2R3i1xWZ6o4vPEKEqihzLgyd88CibxlH8Y
+TOV14k8thHyizE40tGHPBvNIn/98GpnnPgo6GqqQpwLqURRD7P96CSVolLFHwzLGKyMu5NHO14sDHQweDfHHw/8XpRcBal4CKL0A
+YIGeBV0sfMnIvi5CkoBED2XAk78XC8Wdvr4wWJIEjoYy4MnfC7kufr1YD78u1kH88fD/5TzIOBhiSpb0DRMWmSzU0dACPQu6DKba
+/vwbKiyJ6KihDno6dFkcrNknasVRmgp5KnAZhKF4E0llNGCV20wytEDPgi7jZGoYoH7DACUpM8HQhDwTADqWiew3LERBy0g0tGHP
+BoFicbj3jQIqaKnooQF4RvgyCbIgOisMNL5hISQhO9HQhj0b/BykUzl0/7oKCzoaPBrqoKdD34/ruqEq+aPQKFcC4o64uh9+1wtx
+6phSaIV053k1aPvY0sowrMN6VeTYfnuJRuTGyVPeSLMkmGROX0U2kuIt4xuHOT2HdRzq9CCw66zlE4bOe07LdZwWJ+2EL0N/wt2d
+EeuNd86o+SSySNzIRny8JmtdRFAUseOyzortkp0zGkF5HSjHoVAWFKnKg7Jcp+0wxvioM242nfdEuDse8Haan4rXs9xd0jPBLiFF
+BbO6qkWiauJ16xueTvwl7yU0j2QwWPeLR6riN7QhUElY5HZ3HxEaMDdyPyC0/oloeDu4ePPsRRyH7ZBHZ9lcPzBrRy7jpavfj8zE
+8/wR5YRG6hF7eO4rczmBR3zZpidrodhoc7EJi24pNhLFJhSI0sQsWBS0pj4rN5Agq8pONpcdsOSWshNRdkAFWRqYpSeysDVNS+XT
+RBFXNQg21yBmwS01CEQNYqoI09isQ6ALXNOcbeh03lD041kjW60S19l96BDK21OeZkk+yWJ8Er2ujntZexnHoXzYt1TRPJ1D19OQ
+BXRS2/u86EUzKxuNadQ+4xkAfMqy1SqkkS5ptXILgHU7hEbthIfcTznLabSmS3bjT6eQGQ98exMaR/xpcoYPkCPNpBfQ7DIu4WKa
+zRNeTunTWZwnJWRqTsHlW7GxgnkYjOqUOu81dxybH0FDQRfh84SMi2ei0bvRVcYyBeZRxjpFvtge5Bwz04BxlbOvh21GucjfahkF
++4oATMs8DBnj8uHxBpfcJCg6x4Wk/TOX0xiGX6B6wk0Mkmkx8LHSCU/zMFNfdM6vX+D7gOqb8HMj+3OTDZ+bm58LXylo05jxgjD1
+VTukRTvQnPmiJVKrJfpPk8S/bgcp/ro5GYRuTgMa0Yt2Nvezl9gWqRoQpAeNtMXyZtNdtIP0634YTNWrqjlpNt2cLdqTEIYgvpID
+/f1j/NrNadxyt3KopXxs+5xfM8YQA68yJy4GScvZcUgrItC6OKlyYrRtKNs2oLFoBJ85Tl/UKWo2XZ8lbiRoYO+FLLV6z8ec2IM5
+DQlNdQ+GRimTNw+K0VjNZqhSQiEZ5qFJQWep6MikkKiInYkxL+OgSkskY1RkXnAvu8BMFFjfRzTD1XQK62C3s0vogkXuk/uEXrDI
+vd8l9AxjuruEXrNpu8Ia6AmbtqtsgJ6yneOd1s5ZP2gvkziL4V13g20y49VyY2Rjw5iD28SI8U2nbZOFuQG9hq65UyFydhVEiwlW
+4N65Jik9EQPpit3M4uTQn8x7Pl34y94E/j+PsvgbQTb/MVXm8widhoS9kCLJ3oxmMU6t3txkold3lGOg12BZFs8Xf+RDE1zTmN34
+vcB1fIdQ//Q0wfDpaQLgdJrwNEWMCAIy4SJ9wjFLkgWTkAsMBgEJp32IggAg8mkQIwICDqGnAJxCwE8xJfwCOA0QmgYIxALAHMEZ
+AsEZAGDEhtYWiNMQRMXTa0TG02sA8YPwc07zLIsjBDHkEDrxowsfP1CEEIVePAUOg4AMREnwC2AsPg9+EQwFFAoA3txaSgyGHUKn
+fobNBr8SDIM0UygIA3qKiCkEORKd8hCBzA/CVCAwCMgZ1nI6gxpOAz+MsYVECFEXAr4AQFBDYqJUKI8vIMgXGDzlUwGdcqjALODh
+NOWYWIURfWY0UQGJqDzhEp0n0DSzOM44doEIISpZCEQC5c67AMy7ENzF4C4E72PwPgQfYPABBB9iEGSs+SMMPoIg97Hi8CtBUaQI
+AUr3yFz1x1ykwNhsgY0Dvw6hOABh+AWzBB7CBQhDgFpgEwcLaN8gWubYOhhARCpA6J3zU6zU+SnU6Zxfn3FsMBFyCA39U9HDGAAE
+P+MR5hEhQGFdwgCD0bkAonMH3r4OkBz8IrgU0BKB5FxACSblUY4gj3IJBpnoeBVGtBif8CtA0YYYcAiNfBxMkQ+DKYrTSRIs8eNV
+2CFU3G4ApAgBCj8RJ0a8zHQ3qLBAy7EUq3EU55lsWRFyCMVckHzpJz7WHQOACCaZHHUyCEiJkEB8pviYCjuEfhvgbzuEJkg8AeoJ
+lppAiWAIhEB+CnwEc0O21F9gevgFUDeEboZUuNpDnAgiMpRtI0KAWvghNg8GAIFPvCAGQ4Ba+oLQ0kcqWRJHOARFCFHXgv1iABA5
+Mtc0P0VggQ9DIwKDiBRfgO2PNhUAYgAQioNmkoVmOCgzGJCw0qkFQIUBDZMbcRAAxByhOQbl9Mzk/MwCMangF8FMlg8BQOC4yxIM
++pNzAfkTGMs4kGEU59hwObTahY8ZLnzIcRFMOS4aGHAIvRQLwCWuAJMgkWuVCAFKeiZGpAwjw51JbjuDTudhGCzFQiWDDqHYD8gI
+wBxH8AW4UoiTlcvJqkA/AU9IAY8yFVFgcM6mcs6m5zjKRY2WojZLP8t4EkkMBAEZh9dnYpjJoESq0lUYBrMPq4JZAxsDSeTwTOTg
+zGIxSODUj9D0Qoy6izM5CtQIAFAN0kyM0nfRsSjZxN4F0JhF7oMHHWJSdAPyBqJFYqf7sP2ovevcsRYPPuiKauA900jVACrns7hc
+uRRzPCA0Z5H76Mkjs5a5G1CfpuQd1HS82XSDZpOPgvFqxUfxmICiw1FJHcZAjAWNg9pHCBE9YDVphteL0zhsNsVvO8BHoOOExsz5
+ylcUZLZYcvca6+1G0GrJGnTfgpJodEMEX79bNQrtX7mHXE5Wq8B1ug9AjuCyjlKhJzu2D1q9dyzY2HHxZlMterrtebMpNpO4nx6E
+baGEdBEkvaydxUOh2Lz/qKy+oJHalM40ORwGeTTlsyDiU2eLsVmz6ZzCfsqPEFytXI4bEULFVpPx1coR2lNQsM5WKyfKF6c8UZCu
+M9Di7ffeE4UxxlI1uiJUkjmQJhtMWvDdHdLLCO1iF87plC5Yh14wlaSXtZZQV1sbwAmZxYkLWc5Yp3+2x6UmrH/WapE546OzMZ2y
+i1bizukZoYsWC1wgDi3R52HKsTmuWe5ynBHXon1O6Cm7FtoIid9ijLd5lCUBT3WZV6zT33JP2Gk74leZS0h7Gke8T+bspH3hhznX
+ZV+1WnWlN4BSLQmsxqWi079sNt05uxx1xefobr8cdcaktcQyOqUSyHqNZUAPG10iSJ8zx6GHTI4VTvq+69zvOtQZiaQNcQt0DFkO
+BzJ/4zLI5o1zfp02bpyWSAHjLnU5aX8rDiLXoQ2HtJy10zuk52SttJ+LGoWYoXJgjA86PRijjoORa6E5kbMqBTX5Q1Asug8+uE8E
+b+x2O48JDaUGHeEuIXQCCFBCOm1YueBw4vaVo4iM3A+6b8dbg5m75cL23NTZJoTYemfSl3phSEk5WRdFJn3FPh588IFgH91ul/Tj
+tnRQhMvBLqEFwk1oQGhi6B5OtAlHRbMeoXYIynxLDoRfZquiCcnmSXyJXwW2yYdJEieuc+BHUZw14AMbfmMS+mna8NOG31AknSob
+glafxMtrV/c1fvmjD0j7w3w244nRZQYv12Q4LBOqOYV+BORAQ10C21kDFEyBddR45EbzgQ6vRhl/g5trTmFaok5x3TdI7XcGuhyc
+uSzrFfXIjEpktNUyMq6pWXYepfNglr2peE153e8AOyzINZuuUZTZFreWWyoV+7uzZVEWFeFMU2xDjZSKr2tXY2CUazV/rxSBhOCb
+6Pa2kZ/ytV1BfHakokqrKaDUvxYR4EhW08JX2hWXU9Vx+oqnZ0VFacQcp5WJD89YhhXvk6jFuMKqmV6qfRxN/OxuRTfith+G8cTt
+4ELTrU9U6oWisjL3p1Hqz7jL9/f3gU8a35CwTj/qk8CNMCvNaEJo0mICVO0fsUh8nZb+KF+75HZ2ufvoPmm/8NP01TyJ87P5HVJr
+67A3pd19EyeWq48QXJRFk5tR5+SEp0fxFN8iucHVs7fVWRMtjz8GabzmmJeDrFJkHvDezZTP/DzMeny9dhPFnnd3ST9ry6iamWsv
+i6pBjaEsDtt8qbpWPb3HOqvVRRxMGzBOdOyoMx4EqrT2kb90Sc+MpImRtjsuTvBgqT7KhR1t6uriI3KTtaWiuMBm+sBmlI2pz/C4
+z81g6Q1cnyak73ZoDLJIMPUz/glYqGZ+xgke1iSERu0UMsDmBP7WtOhN3Vbf5w6lWXsiLhi85NN8wpOUieYrzti73Sfv2tn9KvXg
+jh9Su424vcB3GsyBGMwxS9wA9pKR++BxB7oscf36IWqeRJrDNEPWs5WUmOIwixPemMY8bcASP/cveMNv4CBoJKJV2o0j/5w30jzh
+jWzOG2osNpZ+mvJpI4sbpWZsBGnDjxpKoJzHKW/gh6UNP+GKbtp25De6HZqqTyFuJGoaK0z7ecYT0DG1g1QFYVcgv+DVnDecVtC6
+B6XGs0Ye8asln2RQM2DUjUMF6opnceOUQw2V7AP5ni8WOaqydHlCFIZPnsVhGF8G0VljKTou4Gmv4dxrJUIkvgcy8T3Suue07/WF
+XIubtSH/tkva8vDFJW3xPohbHaxbWXvup96ltttECw410/1CInE+LT7PaeFSomIHjqzctdNzino6pHUPquqXq9qYxXk0bQRR4x40
+n9FQWdyYBdG0EUfYMtAC51F8GameU61w3Yj8BReSMSjr6lukYVS5qFYDbOigG4KzKE74tH1PSGDvwFUccTDVCNDQ689jbtKsyidZ
+Bgy1+Nbn0UU8Qdb8AjTOPOPJEU9T/0ymxEyf+AtusrRAzXBjxne7u1015eW2aPc+bIsSNyf9Ei09a+jdq5MaeaqfFf7gePsm7hXM
+XL1KcmNLIrYj9ySbgdGWwTgWXcynDa3jaFzOedSY+9EUjlAhYdQGZgCpfSyq3XgVy6EHjEAgaeM6zhuLPM0a/GoZBpMgC68bWjzj
+jWXCL4I4TxspNE37np6h7zp0La2Ykvh4RXCgGVPKEBqBsLfH+1GrRbJRNDZkg2hcEUWKGaBREU2oL5aIlHEJ0hyGQZAu/Wwyp2gP
+NGE3amj1Uj3KqErUqykDVCxrZazXCFnWXvjLGnbX4O4ELNRyFrT9tr9chteyx2lI3KIihMbuzZqm9EaXmoPosc7avrmxftyFGSNH
+oJ+mwVm0WpnFFsJ0t5/tlZu3D0YoZZltlI21DJ4Al4yILKDYBNg8W5njJWCCwkfJmEWjZEz0hnT9bipCQ6yUmMzldqPpSoNCqGzP
+VK8E1sYkorC+oUHa0mlWK6UOrMxC5zSIpuKazQGw3zhJG5rNF4t/nBhqAqpWikbCJzy4EGuYKmLgQMjp6QrCmvQsmOKkBDti3nCC
+BcyvRqncWRIvHE07nul074Oaoi7x4B7RnRuVxHkasJs1jVmnH+9FaoTEaoT4LBrFY5oyPvLH/ZqGTUENP/LHLAHBmejuD3DQBm85
+BkQF0VYJ6Mup5Xw1uOCKa2EbNpv3BJdTqmFYeu+tVo5mbiAHUL2CO/davJ53fl84Y+P5THSbD+IWaM9UwVncgLvnjSgWIqGgPPGj
+hsFKzb4sKnavZDpcUk5u2vFEuOPpm4tKJIP0Bhq157dP288/ef5qTd6w2DjHtavNNIcmb0iX4cHnvlhhngvRCVvEEJYBp1ojSAsy
+b2xjSV818asyqrHwr1GKP+Vmd8qumMbRvUx2SNxIeSbk/BwV1FYfFV0CI3NzfzjktkZ1vvIVoHe18+Kl9+Hhyaef/Ngn3jc+OXl6
+8Oq598mJ0zrys3k78aNpvHBJMW7vPyKG5fYHpJ0uwyBzHUdpn9sOedd+QqlgmcSnfCpke78hKiB3Cs+wibLkGkcpSA+wtVCjo3UP
+uFmczXkiZwVIvg1HfOX7jhCG4ToK9s017nUmMfr0SVD2DS7E3BCNafS30cmTPElgjyJ6FLrGj64beSQEcFkubeRRyNO0EWSlIRRE
+jct5MJk3Jn7KawuwhgwwhDM/mSIxKepLriKa5G6DjDZO8wxHzKkYMO17xLT0j+01uMRvo4LfZjX8NhvFtYwWGHCz6UbAayEstMyp
+RT0CGUPK11lyfRO4EVlPQJCAGuWMr+t1N1URbL/TbApCWyXVjQn0btY0s7U1wczN5XDN9ZoTsK0uWNCBqXyn7+9pScRX3x2ydOSD
+EBaNwjFdMg4/MzZxl3K11vNOnvbMWeKGEFeeGnOyjkfhmM1owILVarbF2FIvSoMY9j+wMsV9dSDz4PEuHG/ef/wEj2MeP6jR01UO
+YQ0l5tolq5W67ec6RoxDXHFOnvAzHolD5Jd5lAUL3mzKfjvjmSFTwaYmdRPSxs2dN3OdalaH7LMOjVnQbNZRFhcOqng5LqiplOx2
+d0F3SGrTx+I8D8bRlIc84426ZMXwuqXQkihIbrbMVateIijdZYiFVKCFUfMgJxhkvYD6aiqI/bEbF4lhPwkjZO4mq9VobGgaToLo
+Ij7nLHQ5jWhKqL+2ZRLofWgCkUPy+ihOFn7ogCDa4/IkDM59jMYwk+MQlanXlsRKbkyuYUK+BQHjuBk5oM92qKInh5ozrpUFQAqo
+0xOiHl1+tpvhQRqxrN2NU6nMRbPvYv+UuHwUjSmngRChRU22GEtRXhPJYG/lJ2c0ZLk865VFhzWn/2GzKXcSIeyd/Us/yBwyeJHE
+iyBFY/s4vOBu2JZxpJ3NeWRtsjJXNgzHqq5pKVI2l4wlvQrtGpqy5ozT2M3hig9Z+y5+FllbQzQiN9au1jgoleUUdBMaQIVgpEFw
+XQjLLBgEohIJTUgvcQV3D/pmb7GoYpSvlNzDyk4YOy2YuQFj7Gl1O/NVNVFReRkm3J9eN5I8wqdGSV9mfIYkZAMyxmJJSJ8SLVyy
+BiYftRc8m8dTFtMIGon5/b4aM1Eb2MeZnyFrStUYmbgpFbrPHEvJGWMemcRRFkTFkMnX62Am+xeELlkOidopjzIWtU/kr5+c9bVt
+gK6wTq+aYii/IGDPRE37kd5+H15NONpWupHoaKCnJplBrNmM2v5pki8zxe0dQYv0A/ZU3oFQzAOnieQXjLFQzhOsToSWEYNnvQMa
+YqvVtIBUIWE8heQ9kWu9Lr5SEIWNGH6V7AoZLzskFJ+0Lt240HsGbfM0yiQBXMsjxtgFVjfT3ShOKYviM7ORC0Jt8QFwnqurpJor
+wzpdUFGHOlpqv+71s/IHicylQ3uU3GTJhYJ/mcRg4djwG/cw872GoOXoqedJc6fEjWhRd1GGyeMYY4HoO30dsbZaAfyn5cby+uqq
+HQw5SSEexGIAuNlIXSsCAWDMYmlrI85nGcefj+OJZvlbRUtZ7Sv4oGxdUq0G6cU9924NqhtTVKwRiBbVug6njrx1D0cf/GfJ9cfx
+pAd3WNf9LgjtHGuNq+XH8QRvtBK6W8TMgsgPw2sRtzuGGs4yngj4/lhaBWTJ9aGwXxLXljJi3fRR5cPp9WIZcsCvVjfrvlAuML2M
+S/EGm4GaqVlWuhdUKpaN1Lc5SRxnznpMuV6Ml1hJWdWEpzxztzpGDafyEL2o5+gU51xhsGjaa9VtCXBcFNek4BwpSD/xP3GV0RhR
+83u7Swv5tZFJ9Wu/1YoKAzMCVmGySBqRohZyKYSVP8MRC+J81i/FX+jIDs20VjQRQzhRhlM3APYWBh9a6FVT8roLwee2Omucmxf0
+jJVVkfSanZXUkfRks2HnQPzAduWUnehZvlqZ1p30ip20/fQ6mjw34y2MQ8GATe3eX/lnmMSAHXrOqhaO9JBlG0T1Q9XMICm756BK
+1QL6ISH9+pzsfKCTwVcdti8Tf8kiZDND5qR5uuTRlE+lF+YDA/XNgIdThz5lDr/ikzzDy/PPmCOHPZ861IOt2hG7WfePRqfjTfse
+LOwVKzYyL1T3eDP6gr1qNl+5r9ypOxoTQvovms0XW4ydaXnvBT0FzfEReyEOf58zv+hgZtx3K8n1R2BMVkQ+b4PSQV1V86lvwTH1
+R5fAUGGRD33cYLFC+imctBy2g7SCrrGFqJuGcNhmFCqH/tZWBrwMxKbVqqZIxpibmfVarbI2KFUIgQ6Fayl18rtsjNRq7kEt1uXU
+Jz0XTgKxvU5OmE8vkcuCCSw0TE29CKF8Y+M/BwNhetj2cbxVq3cjxXTY6NDUzc09mAGMrjaOKnrYfmpOOZZDcYCxD85QmJaGdvyy
+kbsFWu/vajvVzcgg7sXSOrUq+muDOlybueBuOv0a7ttDMzy3m8+hz2+ZKvS5ZhrVJNpAVRMbO9AMoNKpGYGj4qAmwr4kmVj+IsNV
+AjonTrlbeGJoRIrpa62T9lMg7/yD5kBQ1DY3grUnNNJ8v3C9oLl9tIbqYtKUTencGD03xrzozSkug72SFRkukKBRZx2xWOKKIcMo
+3otdEAYvBFqvQghYUgiiSgIRoITEWRYc9NaZ0C2uzaAzaAZISpxMiKWTuZ88zdwO0fwLomlGmk255raydhoGEw7+N6QRI2zBL8ha
+3BIpm/7J1usbRolFvcAOypZcbFlUiDByM5OZUiUSSi78cE0rGxur5Q1pIAMtizbeE8KRKIrG2G6cCmM6ltEElJCl9o2UwLm1laxV
+j8L3yQpy4/QJ4mhS/l45JLe7/WSfdfrb25YLC7NhkjEFObrUMCh+CRkdBTItvLgO3hHsoxMMEbfH9IhTStdr5bHAUTKpAzqjAl0I
+pIKW32ym9uDdC7Q8WxRe4OiWsIY00xdEzRwGVlu7+29dFmZFo+e0uvsHnT+qt9E+CM4GYlRoZ5M5KP1lDZx3qPB6vaZib9yzNX72
+AKjt+Uj0vOU+xez5aCx0m5Ve1FMysTuq2Swqn5jVlEMr6Z+CM6n1Omg24fIzOJaSl0HULhxh0mwaY6fZzPaY+dW48cbrJGqXNzDH
+Z+9m3bfnFpezKqPBwN3EriBoFQO7N3lxHklzNyZrqoBSc1vMgpvMghvMwvhmTFL+cEQOiupg3p6hEpEpXM10mGa1mNZmxnr3rwmK
+ydkzFCRc6jIyZQeO6TJCvTWdBVGQ2pYYJRvnumGViWFleQQyh5U4LI3MPafhfMJu8cjoVxrpTSmhMzeCKq7ppGIs8n2sYpbo6mnP
+RZtWiMTQyAYswV6HWhYH9OsKXwhCWEhDyQn8LOOLZeaQNVUrLO4deuXTEbOpVEp2ozZWPdjo0kK90cuoVGf0ojXVqj1jpKiul4uK
+t16v3Xc5e7n7BUg4Crn/6E3pRZPfrPsj7cSBSt8NVDlLoKabBSrcK1DlNoFqXwkU3SDQwvUBdaZT+BdcwH+ImmYOlV4GaOFZgJq+
+BKjyIECV3wDqSA+uc7j5NN+Ff/fh3wP49xD+PXKouvJP1UV/6swBkhf4qRMG+N7jUr/FBwGolLxpTh2IWmLJ+q44VXe7qb7QTeV1
+a+mTHn5li2RThxZ3o6m8Ek0ddDiYJRji+JRHHjpU3lOuOekAgX3Exwxt7L73+4mMt8WlcpqBVyjHMeVn3HLIlMnADVggE69WAeXo
+Gkg4W4KENCI9mFov+dnhFXqlIWupa9wZ+dufP93+6ye9sQx1tp/02ifb4/d3aMx2Rp8599je/t84vup0to+vdjvj1g712c690Wf3
+xu/f26Ep23FGnznj950dmrPE3XEHvTzCMTddpUF0FvITCU3j/FRDZIe4jkro0Ji4jpXaoT5xHSuLQ1M4xQxlKcdpy8+y5AT2qQi+
+z47T9xGF8j8ZYBk6jUMDBWK8Q3MgNwFye6On23/d3/58LH8720/GSCo4zTP+/nH6/vHOYF+RQ6RDQ8i+ZDt7xzt12Y/T9/d36Izt
+7G1tb7ujz7bHrdVoe4wB8v729v4OnbOdvdFg3H5/MBqM93foFBIDjXHrOG2NPtsfA4kFYI9HB8+evnp6PHJHnx2Px63V8RgDq+Mx
+hPbH5H0I7e/QC/igz9xBL17y6CTzz1aTME65CMULkLNWyySe8BQafDXlk9AXfnJXE7i1gq2m8jp0QlxHE3DoEkBBxaEz4joFKYfO
+ocsKeg6dQmog6tAFcY1bu+2vvTr6+OTV06+evDxkF3dmjiNnEiMrmMYBvKvvX/h6ovu+L/7D9PdPxWsR/gSTy/8BTOJJgj+iVs7U
+Ry4XIF+Z4vuJswDZwyyDPGc8hv/i33IuONX9XWRkWbaUP5AtQHetgSgqWOA/EY5mkD1YIpAEqfxpn3KuUO2r5cQI6iThJT7aP/XF
+28xBmAGpBX7BIk2W8gfSL7JvI5gvp+IFl4ijm9Vohv8D/AcsLYqw3vHS/3bOYYXI4nMuXoWSrBQyJFm6RPaZXATo5zWFXhaMVH55
+GnB8vSoNBITVSPF51jRaIC72l+ozdThFHiycuYfif4TLSSaaHNbMhbCQBii6v/tBBwJYSgbdJZwTX/DFAr5KOJNFrn81iSP5s52n
+XPT01SJMlhNVDQPCHAvsls/vP2k/7CQ6BFH+NMgXV1mCw2q2xP+Ix871xaDLMn8yF7PB8eEk/JSncx9Xo9Mgm8QBrsNxGOMoDEX/
+TeZJvOA6sM2vMh6lajFebPMLHmWnSZDxbT/LeDTlmDiOMlHQ5CLFpTnyt0FjJxiniYGkfhDhaJ1mQHZ6cepQh093z2Fo+xOO9hvU
+mXG5qEdnYs0OUhgjZ9A9Z0GG/z9fxMsklmvvWeaHQGM+wbErGjxY4v9EjOHJI/GDozjD8fAtH4h/C8Pn/HrpY9LQT7PZQg5wHMP+
+mRgKCwUn5wLW42GBNBbp9pyHYvhH2B+LfCGW9cUF1CWS73TFgXhuMBQPHC79xP/8cxg1y9DPpICyTOIrEASW6fUE3wTjybV4jkv8
+x4UVgjjcElAAwm8mhjifxNE0DGY4E8QITtG1dHouHxlaQNOncTjFl7jTZZwFMyggxaZOM+5DLVKsNwDpUvgVzuZBdAZ1yKdANY8S
+7sOEybPdTucBzoAoSwIcWxcBv9zWNb3kpxNMepn5OEWuJ8HlNfTp1SzAwXmVAP56kZ4lzvjO/PdGePxJezfogmmrQ68g4OUZhHEL
+l/a2ujT0ozPhEq3nQDj3z/i2gy6Rgtk1pgii81d+csaznuPQ7HoZnyU+8FeIFK+y9Zw/+4nv/tlPvP6zn/jHf/YT/8Sh8+BsHsJj
+N3hfhS78q0/wtaiz3m5H7D7RAUjau5nECe/dJHnI095ISMHIh+UTtwmf8YRHEy5WitNkF5oAk5VyKdl5Ijwgz1QmkAdxxRPCKrSB
+KiUs4tBFGEW/R/hxUIqoRFGMn2cxtAVwCX9yngWT8xSFbf1sC48y4ThceCWQxQkGCjlTwerD4tUaZ4zKj3fs067Rp92/nH0qhd+F
+fJFQVhGnop9k+s2/mnEgdi/APE4yPwjfdlyozO8+RNT25O5DZcrDNw0Y/U0Jn/3VHj+6O4vmffO31pjh4XlAMlHOCHh0oZy4ytZg
+XIUEGmWsFG73ICgKB8fq+uhAoBiXAYHE2jIufgUqwddweMK4DsoiigZj3IQqF1OUg5kt49zQzRinnDny+pRDrFrB9jE0qwSInEqV
+VCISpKUKAs6XuDyUiIndTDdrAU/iSCgQ3OWIK6sKvPYMdhKFH4vdR0TeM5JX9B7grVzUmjxWt/TufwDbRAw9gR0euL94BHs1ffUQ
+4x7AkVQYCuAhgeGyiCN0xoeo+2RtOTtNuX0lPXbNTwH7RRrYjgrEJ7HNLsi3au73fCOJo7PG30g41ASYwd+ASx4pz2hjMueTczR3
+31HSIGyqZA3AKhWaTCOINCERc0Ab+grzbyOm5gqHdCOiUoyiMfYhlKHCSZtH4kpwXULU/JdaJE95yTe2vpUmj7CoOKM0My39pJJN
+6CaFCxjMCdi+rSdNeFvuTd2I0EjOQJu2GKr1dUKtixqeakzLgDyKgJphHmtMoyP2yheIp6jf5jsii0t06Ft/V22Rb/d1gsSGbyzW
+gKAIt/MsCIWbCfI2dzBLbCLoF5cyO32+p+5a9zncvtRpxXGzP+JjOKbE3+6Y3vhh1hPY3TGaWcvzUCI5SaBYgvA68RDdxY1Gcj1H
+tnKfjOlIr+yIekjoyFqqLVkAl/IxZDLRmHH3jhnniczw+I4ZMIRZHnZvyYJpC9kEi3hkpxflK7kEU3zwJoqhlf5hB1vMEHSQypM3
+UZHqWCTxoKZSUz4rPvNBXYoCFFR2yXjcN5044RQBB/rl4ajGWEIDZgyqM569BB7mOg7BI14x9qjPMpqyrW7f34vQPArnlw+a2vNg
+ebhYZtfweGTq+oRuuf4+i+D8fcvl7Ww4D2bZyB/vwVp+Lt75I31RA/Aik+zFcHw/SsYupz6N6FaX9BNw0Y6WrBnIOGwrpbwdpFiQ
+LH27Cyf8KdvqEOr6TCAJVK9I6RM8hYNEtNXCuouRg4dcSz/hUfYKj7qsPCi69dVHrtfCyiplO6Pj6DiDp0ZytnOcAJR3Oo8fjlej
+43z3we6D43y3s/tYInfA+n4HAL+zc2Z1S5WzGw9GwP0cOmEduUoKtjUa903ldkidhkMsfXdOneMIcMW9leMM76lAhxkJ04I3Ffdq
+tAcmvACPlhYH8ZQ/zdyIDFyfRa0u1IlmpOcmzGk0Gg1HshY32va3J+TLD1Dg2PZbXZrAfTkaID9Dz2y4xuE3GkJh8Dl3AxpgM8uf
+I/+KrN/tCIHcmGxai1ebGWt8G2ONJWONBWOtMM+R3onhxPsAOQD6IheIh4gw91mAftRFtNq7YconxORRYvMlYh5rorsS80hSNTd5
+SHcXI6xNHuIFL9fbQMR1ykxCLqpVYQ06rC/m6SYeATZCEVMuXvrZXoQ325NRNnb599iVRdfFpa7Lb+u6XHZdLrtO3N9QXiY+DqJz
+FtS+CDRyLk61Ut3SsEvFOGrOxzSCTUYSiDukH8eXPDnwU268VsNSNdkOoyzIAo4Cy3YX7y/o2dlzyGqFyEwjI3X3tOeQUUcOu1gN
+Ox+FiwdC7AcXhjmMw0yY/7jd7gdiLOk9LeLEmFE7YhwBIpmxlUasGLK4qUb4kRyrMv4DMZbQU7dAiAzF/htziZkAHp1FIgUvJfxY
+j0hF94E9AczJ8UHHjsNdPEaIiVQoBrDsB3odxk0+JhQzQ2kGMNnj8gSANewVcKT6KbBx8Ad64ONLJMsYdzYujI+JP5nzr/LMjQnZ
+71j2xZiQRcIbQMY6/WwvwEkTzFwxb2AHYWZpSHpDoEcxP2x+lnHaatGauDV9gwxgGES85Qce+Vei5L1YruHCOUgAq33GklGE9e8S
+gt5ChCm9uJOyjNN9FovFVRhV8TZYQwfRWQsEiWQykt80XusYWJlhVr8QoFv6tlsXUrENt5effmn5+T6wqJQFcmMOjkNiucKd8Qxf
+yWOxDuo1ZFfL3w+6lkNf69s2bmUs+a3ouxTf/9Hd1aH4Rkywvd0nfoslI/DPjPYo0ENxq4UtArmLq5jrmgq8Q9HbXVF2qxXvBX2i
+1jt8Nk3WYeC3mLEDU7stiJ7Mg3Ca8AhL69VUva7i72Rq0Gpl+7re27uDrFdI1CdwECyqnI2lPZQIgkDabFoflbW6Kk2nQEhFhfap
+aRDHc2WVd9eg39qVJQywpq1d0svMcQMXLqhSB6m34o6mcmkg5XVHrRTSse/XskVIc7CeydvFbgQ/tcYkeq9Isn8cOWtqZcJPqN1i
+O3s7Rk6nFcon2vI27DI36EGgaTHXwNlbJnx/D9LuO63UxSjZlqTl7O1gzN7OMimI95w3pcfq46a2wi5oLhjGhC7pjM6xx+mUOQ5d
+sKhdaG/RbzRwtHkbX3QQxqcTpkC5du8cp62dM1C6TaT/CBDVAzcXU0VU4mSSp1m8oBNY5RWvr0sxghRjo6r9C5a6vhu7S0IInbJ7
+wjsuc+61Fq0LcMGiHQywqK3VxAMjLD35GBg6mqsmG0s/o+6EkNUqdXUE6ZkANbrJaU1bzr7Tmm3oHd308ptAOM/bcg9dGn3WOJo7
+LezN+cf8godQhpnx1hFYySpH8LzK0EQeN2or9f3A2ZsnjZ19pweBfYcY33GahyHPTmAXuXHe5KGeL0bqW6ubh1ZrYYYg44uNZYTB
+vmOnLNE3iIeBqk+coGOQuuqbF1HB2FHljkNw9iNy7ncH98BoOckYuv5BJAy5ngP+uWtLufWzY/uzbe57C6cQLNKBDlruO3bWugLF
+d225JgevMPFtzcS3JKQGu2wMt1z2zhIGhxsNFBdyZO9F59UvMJ7rE2SykA/uNfAXmhNmdRFDiGxX2Ea3iwMiyIEB0QNFhEwuq3pv
+z2/MEz4ThMVMSPgMibaSVqDmElZ188jxRSp8LGTDx9xrwHFQUUyaTLAUGrzdd+oBBy8WtZLWvYYf4keKxH6YDQTfUyAh2OD4RUHL
+mr6NHUeOSKw+Kto2ziSMVUNXJN3cIHZi4ESb6UKsnfQWulZisM/cTBdi7aS30LUSJ5uJJvsqyS3EkuJz7sg9sjlyDx8eHUfecQ2D
+ALas24jrwSDGkMFGVBG3NZdMNL1rPabvXI9bu20qEoknjja2roi2km6maSa+ZQHgC51kMy2VaMrDjYSmPCwSbSalkwVRupFWEKVF
+os20dDLQJmwkBpFGss3kioRpfrphqUnz03rpECNE3uXGvMtNeeXqM/eTKW5sNwgXDUu4OAXh4jiCFeRUT6k0nmVvIIHR6eB2Wj1H
+zVG4tFG7hJa+BD9AHV7csujK9Cp55p/dLTFoMDcv6Pf2IP7W5WH/niZzy1J1eip5mKkuquUMn+C7MqKUYNrqGr7gCE2YM4tA0dSK
++uYHpfnp8ym45HKTFrz/0SqwhN6DMaIEcVX+NlDZ1wvxl2bRvRawl0YwZbgK33P2R4ByxrDSGsNJfwF2Sb0oUZVa74HUWqpDup3y
+pYND5F5vcwKIJq17e/LOQCURJNiLw2pmPE2B3DXV3txXMQjIezuyNDUDdPZbxkoYYOtBU97Wi9DKle7A5wP37ZreRWjWif1oMo+T
+P68h1ShGjhgslQ8CDS6OsT/7md+GEYSfNr2F0+t9yXTz5mWaydVgs2Qzlave9Nb1wizslmSZTnbbQjsVwpF07JMX6rUNZ/yJPLnX
+njXkyZ+xVbKUOyjPYwnGw2h3tgTbdJIXU5+mNKchlboFfICKt0+P/OQcrpS19AFpNqYXjLe5igE9w2L/QqoGtroAP9rF96LSZGIe
+0C1aLWIlg0++4OF+YYnVLszDrJSJgjqojL6/y+rok2Zz0WrRnBknuNQIsw5N2cj4qjE1ALagC7bYuxgIPftw6U946i5Ib0Fjtthn
+F2CAYDTDmBoAW2wbpOiSSZVvUtFWWw/rBixrdfsBnlUb7R0Y7R1Y7R2M6ZYLlSGkHwhV/C2NDWofqcjGdpuCKduMdeicLdXJ1Gxv
+3p8JSsvRDBQ4AZxtdwi5mcKNc8wN97SnkpD0n2NUllBf4XSlCTUAtt29f/8DoUu/ve/qiBvAHfroDTUx+ikQvhFC64SdmgAze4vK
+EwpZRemur6SdRGtJnvYmbJTRzpjiAO/Jgd5q4bUzOTC0bh/mYkDeSF7wAklxe1vSXBO7xiGdjLpjaWUAfd2fFZY50M/682etbMzS
+0cwYx4jyRzPtK92cPDnd6qzfkdPIPTgNxLTX02bbKGDvQTHloV8CluDsSPYi9DZUWD4khCStVl9fWi8oJhbFffZA2UgkrRYcEhUP
+YOFhRVLf6MLGSAqGPQ6TVxiNZDSgD1omdwGjDmGvutWRXT8CngPBUu+vyR0asNbHNU3w2KKeGccWM5bfF+yzeLDd7XV3H20x5ibV
+CQfMgzSbDx9vMZZAUjwNNCZVANyJsQjiIkkuIhVDVbOCYpDv9sVpW9bapQnTDawOL/rRXoJHbioG7BExJ2MssA4f9MmGkVI6rytQ
++iACbGeslAoZtdiu+epcaRGUb0LWLID0gp7Ra3rSRzYqB6fL29OpHLHg4EN01L4wg5nBiDUsgGak2Wy1ZvtRad1T8802NjLTuD6K
+BjNC9jrvsmguyy1fP9Sn4R3ZVo6GVbM+70Hv9uWZ6gm07zWsKvXUM4v6KKd5PUusyauO12umYU5zbGVjGnaJtHQoke9aRasTu95o
+vKnYabaR0cJHi96ryTa1vjRko1l9O54xaSpGp6wYSXCYY3zOBdNDJB2jIGYtUDqhQcHM0dqlBsT8YslLx1QWL2aLudRpK766FSoV
+MoHK3WxuXa9W0P1dQq/larOd7ndNAzVt/mbV5kLX4MyuwML6sjk1vm1KNzX6xkUxhFVwptdBMPTDlUB4vts0A40k1gSUkg+bgSBU
+EOujK9KZMeFzUsToQvKaQrCIlOWtLrHIFaRS0myC3URaitdNuYGoqHda1LtY9OoZwMY2REliJlczNqMnzSZw/SX5HiSBmj0HsI/J
+xvVtWd5sTFr395cWS5SrXFBd5Sawxj2B2MDKkbMJncj17mDuJ6k7ARHMjdlkOyd7963EvqQrrAgndKl5jV8YSf0NtGC8bdOSsqy/
+5bZa2J/NpjthOTMmZsuc8sZHpyDxT/aWzaaRwOp6IsWjyreLBXXLHDMl8Wi1cqvNQLbzvdiIkQLBhJC9JSE3YbErkAMrZmaHyeGS
+ttxw0O11NvBZPGZ1qDiQ7vm10larS1MaI9/5PohWbzMiNw3GSXkwhq0H+xOr159067Zjob3zffKgNlGr+04bZDG6QPJK9yb9FNzk
+B7MNm+S0VJH7GxJZHZxuMSDfbLpbbtrq7rPJaoWSYyVnq5XClHO3tpLVyhVmXzy60KqpFHwJ2hh2g2uxhWsnfFaTFNGYPrfmZNja
+pen2bj2ZESisctiDbhiJhhZWGMGqpdw/5WEvr1nELWmc2sPfL6/WOsqeTinZTi31Q2qufy32oLQ8F5cDDJImHxBSsYpplZUgqpxt
+M2LTdlQt9kYFfLN621i94sti60uCOzf0xqUH9A/k+zSjN87mvDyb032WW9Pj/sMNC0uKNidWcpiFMevSoH5W9O8/FPw43cubzXiP
+PeqTuNXamFzSdeP9R6sV5rm/i+uYnl25ybk/9Cfnbk7v7+K7BHUxDzFmPwVCdZPeFxcVcuYTxcPFZqamK02TGIcK05Ve/GYujd9x
+R3G/mN4pzd8k3hdF3kHGt+xyzOpX1pQfyBisjjs3bZk8hOzbo/DB7qZRKDbxD2CUBs3mk4e2oCNHZD/dy8XWxfU30BD5cYD5RX5f
+DNi41Vrr0bh3vxiAbxwliXOXhZu81fPsygj+/u5KO/7P9tmTD9DVXnd3t7gB0b2Pr0XufLbnStdJ45su7T5ck9FxeryzP0ZHSZ/t
+He/UxO+PdzZ3rnqYQOgLrM6dmZ1L52a/Ql8uW2xOt4r1HM7ErM6e799frZat3X02s3Xqnbr1dmkv5/dhOXfzmnQgWqxWjyBePKGQ
+aulUe3988MEWY3mzuZXAPsYagR/ofFtuKMmLybmkM9Je4FMmPilyaW+Ut6SPiV3KFnxiMIKtm31hYWwlM2oOI3yCWsLJHlcXc8As
+Su+iJqRPJq1W31b8TTYM2OJmnDVMa7hMnXJwQjviTu33Q1MKfAO/TE09X+4bNl9WK0dawj7OW0Nw8Y210LfUiD7sOECDCDncBw/Z
+Bg0iDii4FpIo0sU+IoAbVFuuG9hCT0DIXlyqSGlRlj3kv9XSI6ox6PZ238xw3nr5wauzqq53XYi2u9/DUmR+Tg27JOTtWKalp1Uz
+IdnYA4HFwZJ9FhT6YXsIwO1HiqtTJFch+L2/JdXGyZ5aVKo5CSbYqE++W+Vwlw37EbXobXf1xZaaqpK9B49Xq2j/4QdFcqXNBEpl
+OlubKe2zB4+bzWiPPfxAHLM9gC6LVqsHj+BXnTpIYuo9g8abW8R6GO5/DgW7ehBqk3qdntIreknP6SEd0gP6lD6jHugYoCenTIwL
+VK6Attk49XGnLCkiiyUCtJLrtzpevq52xxT0lr6twbmq6NRPBu5841A908YWgpngy43unE6359tdsoEhVAyfHYqo3pnkMOebVPSk
+V3+CWLIDd95Eh6Yso5eglBtuPsUGcg7pb4FCYC8iq5V7anP6KaGLYqqmcIh9us8Wg27vdHtKL/YfNJvuBesSerHXVcGcTU1Vdeui
+volsm3P1PYcbv2dibYWXWgEfWvv22eZ9+6l1tm+fiW5Up2/WpWcVXfrlauV6qEu/VLr0bLMuvSh+YlUz1JVZ2nWZvakdN27DU5bJ
+CtFDUAan9NQSdlGXuVqZSuvVStcprb1L/wyG1gHr0KdsqA6mD/ae9g+EEcJwdDAuDhzIzTPLCOFZoeg+ITcFhzDV3UrylPzBiMK5
+vsXqZ/ubleUnA6d6Y8Cxp9jGpjwX7ae0o9RrNoHDX727sGisDUp1KcxJZrhsSWmY5nuRJRHnhAiF4cbJXXhrIP1q7n4uOmrLPOAo
+iZqip+VLnnDBTz/iGe/5+IApyPGjGHo6Vz0dWD0d2P2RMEvUzjcd/8n2zWmi7anqT+xKqyDFmdPbqghy7yhAJt8XTUX5Ip5RS7tS
+b29bIN8VxDFkmX+J677KfZb0ciVGinKmpXwXRIVvrcRyohUISDAK7VKL26AYPBo0zbQQgR1phI/8KwVKntvVldNsF992EGh9fLgt
+0wkhqKMf2crh3oYD2yz5teg5Ygl0U5YzAEKmjWfyvRCHPhpVMd9kHzmhW8tC6R6TG9hyKu/46yU4nO7C5jZuNnPQpW93VytXY+CK
+a9FcYgCkxGwzgcuJ2XACNyGiwhM0boPjxHWFlvqEGpKlKJNyh9hNb5JVzujX5jVcySI2viMqlw0+bhlljfh4nxnVGvGxfbnX9tvC
+bnGWLyva53sZXvK+rcw9u0iCDhq0kqBaAyHV3FY6rChypGAFcCToGGOw8DcWhltoduuTGFZpUbOpkVZBUIM7FQY64ooiAkToPRYV
+T8bhXonvC3OsbKv+87a3ub4nylvdDSUrVn6Xszg8Cdtnyj5GXG0NW+iQgugjSLO7w7F4Qn0RRK7Z7+GYRqAdTwZm94fjVrdnI6j+
+MqECiKlP5DEb3NV/miT+tZttczgK6vTDvawfgiq/1SI5KxWY70eoVY8Izfc6GEStZKm+OfVZ2OruZavVm2uHppB1VVTndfI+r/Ou
+XgOsxz7N3U2x5iZ1dmfWTieiyXZ0y76wbvFRe0Po4qy1WzKcCpWuWbXuZvMpQ+MeGtuycEz2i4qH45IdARzLunHtcY9UcMTSZi4u
+mQiIW/BdQrd2Phtt91aNceu9nXbG0wzUolbVJsxX3lNWDiF7bNdW2c9gScxZp5/v6UmuFp4td84mo3wsxR2he4Cr/PlqlTPGJpo1
+l9+GFXvirZ3PeoPtVm+gKjc3KvfwMWNsbn73vCA3mIlloZqoQwbOhEcZT5yek8DK7JBebTJJwgn5DNIo0CHrog21JIceZwwLi5Vj
+VBXvs/vaVdTOZ8er1fHqvZ0z6jjEaF06k1+wVbSNRSWwt/i1UlhxLVNtNqe3mPfWEdD3L40DEXC9cGcCyR1z1wybDTWSBMWlwtko
+H7+R9p3kXmNw3kbwLsJvNt9sire5r5LbDKU39s1b5tH3XlWPLBi4xKgfEsC1dvshbqTcTcyr2ZQTIDQmwFZlAsA6I4SBuw3/N4ym
+dx8/05rxU6G2FLxKrAdwxai7+4AxgbQ5Q7fXofWRCBdcyAJ7BmTsAO8wTJfvMBw3j5L+2w7FW03yjKvSdx+RxXXwujxTUD4sRl2h
+JMKNVfhOO8aKrbQ+n+Z2j1qK9CfdcoKunQB5veEQcNxz7AS+8IynN66jMaFuymLXp13Q72h7JOtk2yxFXPXwpW8omrO0tQt7umYT
+92F+ecmXig4l2aAxHApaaGGx1GDa2jXME2ApVldr8NQkweug/CLA91bApqmEQXMmdJ7VwYe4rEi0XZqM4eJdbQRbEpoTw+/OA+mw
+6dFDUqs1KryFiQNGNZiED0fDnS0RTr+6NGK+8faYdJsHj4dVjxF80zWF4bLCLzwT1bkW8gu3REpv5Bs3c/uB1uRsuW7MEjyQE9Rp
+4cMbTb9Q1dcn+NiSkIpVz/RNkiygiuhq5apqKz2uqo+EyfrtzBP01Nbc2R1tu+R4dDy+WbcG77ffO/5sRXtf2ts6Ph4TYNnHx+91
+HdWLTuM4cslofO/YadOtwbbzhn7cdHWv6FdsGXv4ELF+Krx4dmq1cqfMcT9bjZxWoBYRBw7rl25C9Jai5YyJ67RsT9XVEjBb7VO5
+cnvQJWvSTuMkc+uvRqpRpy5Grks1WUFViPveHarr0PK3MuO1rSl1zuAh4xkrp6IR68DFMrUcRntxP5LDvxjeC3Vshq3qC7hwDmbN
+H7gnkm1vE3T2CkNS+vNnjKXGDABtTs5SNV5BivXTDESFK9RQpcouAR74nbNZG17nBpZFjJT7k2YztFcJKEqvgbm2aJ4Lztuag+WF
+UgiJVWQJAoxFRN+SB21kFvJeTe8je5qPdsdKKli2WhVCdm0g9e1llla3JR7tGN+7PR/dV9Xvh4X6d6LdcN6xPcxvtzqT+Wyk3U75
+Mjn4mqUhVSBsAglZv4tKn9yYHHjwFoYKmtUcR8BQGk7JTEHfTevQsl1CTz5doHylA70yW9UyB3m3z9IOHKl11VgpW2fg6E0zKm29
+a2jui4PXWRBmPKlhLBvteI2XR0ERC75Ix3j2h/a9cHZwi2mqnblLZziywzHchuySnrtsNnPRPxx1wDWmzHAsJA9EfFYX+QZr2cK1
+ghr7E5xMwqOtX/Zoe7Nxa2S5LXBoMO1lFkGxTGO+gYtq+ruemBhEbh+tjv6EYhAaxa7JxiLrzz+2tydw8I0ksDvR07bopAI5LoTm
+YiDJWZyCwFTr8FAmHJUNLKSoYkzPeOkS8bqJllzyKNvvDAqg16XCh3iALsNv7x/hvkH2EHpZ0MdJkzXpx2UT2pi8YQiVOOdkTda3
+ZzD8YtjZvuf5X0gm0icrTbT3dm25krFgFI2pscZmyvOkZJCSW2V6hSxzrEwzbfIu4tvOZ3v+aP84He8EQk/GSckVs053vOPjQ5I1
+CWNXfDvHQy7p5vUGyl3Kd3a2ujRP8JkuDm8HQiC7DLJMvKgj2fpHxlvsWP30MgD7yQjU6HDs5RJyM/FT7uRJ6PS46lN+lfUiYWvJ
+p6/4VYZlYa5PkxCeWhDHrX3MixV4Y275xGHPaSGdQwBdYmgiZPxOAKoIvdPd6q7Xai91g06Ve5wqr8g86WXiuVOfRe7DDjqO3rm8
+vFx9ZXXcO9453tl5w8Dy622L9GCjJ/jmtlhk5EBpS2/jRA1EeNldD0S/RtC7tgS9XMBa0FvAySLL64W9kOUo7BVu7Bw4B5QiHxSj
+vEEzhYZNnxuqAQ7+EcADy2J7mwY2GozwwCkE3EbW0qSikYpRWWTA0k7w8mbsEnrBTrAhUnrGTtpFj4BspTPRC/lVrEPPMDkISVsK
+W6iaoV2WMNinLJQiqnBBcGG7IJCT2PR17l6MZuN2noSgCpuziVYGIB4+i1CQ5qZsSpe3SHETLZZpGXYK5+PLku1LpNZUcF3TU4VL
+kVavU1Ohx7qlPF29orC6skq8dIrCq6rrvKWpqCYl/YkWWm/9XKNYazyy3JBVc1NWXdLclFXXwmAG9/vb2/0cl02giQMUQ82m0WBg
+I6429H2SbW+/qxapMmHrVUWdt1QVhXWqogmL3ZB2NqmKJhVV0YyFSlW0ZJPWbn+5N2s2xfm+O2dhjSH+aoWPUsxJfyk9im/5bkiX
+JcoLaNUgOj+Qc2spSqJTtqTLFuu+fUk46yDTdIuxZbOZYqkD96K+JNJzwUEwXbIpEYVhWTUF9cmyMJ3HlKgrq0mJuq4zlrtKLdal
+E2LptYonJkZnQqFlYdiNmHkXYj4uYBZtUGyhD+kHjx+L5wUePH4iHhV78PiDt9d5CXm8qAkrI/AJprfUjL1J81WvL/srpBnTk9d1
+yF5nwHuFsiymtdogf5SVroTod6J2jlvbq+P2cXt1PBB/q62tra0VpavtbXwi/dh1J6tssUpWS3JMds4C6rObSc/5L7/t0KTn/Jff
+deiy5/yX33Jotug5f/ZTv2Z519qodLOlCeM1P21fV9o49FOQCNLt7dIoUQlHqaVHMtFvUCdFJV1SpCSImEV6EYcBEhMq5VS8hhGz
+2NAdtLZBefBf/o1jyHPH7Ztdugb8n/3Eb5oR7miwNSZ/9hO/CXHvddvtauTNA5HzvS78mfFUEaVWrs9W8PY62Zavsa/eIztnC8j/
+Zz/xK+/tlpIep2R72z1OzVS/XEk1+mz7OB0TQfE4HVdSE6pbiMXk3fzzb7kZrCuGk354VEqKXhxujZTuOdCoqg+FtbkVtTQMi7N8
+VmVndM8Rl9UwcAbi8Wj7OBVa5fGbpONbLe9vH8VXIMyd1AzlExh6J5uH8okxlE8ttB7KV4VQKV4XOdW6DMFII3ZaO6q3YtGykRAL
+BWtcYmIpdJ6xq6KqZ/i01JZ7NTqT8XtsSfpn29ukr+twBs4GjMkS4ttW6oOV25twbwIHtYY2NqQ584X6NZWeGGZsK4E7q4LNgc12
+yCTQ6tJr5tyDj8nh6R93LtKGsKbPUDRfsK05vWBbM2y6mg9xpwy+hFoftCTig8BPW1u8Ng4blmazSAMnU+DTDTNfD9zT0VT0isH8
+a5B0itJJMUTEu02j3bE5cwJXh9WHV7PcHxPS+17L7bx9ud0xIcVoO9OGmQ2+XgyulHANpfcyuozTnqIkGrJ3XeiDexfN5nWz6d5e
+AwdeviVECNZ3T/4m5iP8mOPF1l18tcx98PhR6YZrZ/tJe+tL7325ee/91vEOG3x28jduVuu/uT1ufaVIMXYHvQLaHt906KPu2ogn
+A3fQO26/VQ7yPtmXF2pVZdrH23CjdvfhmvTc0Wd7+8dXnc728dVuZwyJ32DXrtmWfLJIMsxHHVZ7ERaa2b5pCtti4x2rfRA2IJmb
+MqnYANuswZab6ERp5e6pzBMyGHpauIVZPWGBGxK6taUN6a39LG5kM7wmfdvOc1JjX755uxeWzcor6au7TcNIHBuyJT5FXmEC0/ve
+1pab6zaJifjgvO6DC/3PLZ8++eH99Lz06e96w79y+aJ4Vwv9Dd3qeEVonFIK/lGS4r2qdC8Bb0X1Hln6JG21pGNEc5RHFFXYPkv7
+213TfNFwTUR9om7f+CxuweuYG0vyCTzD1IIP8bdjuDJom801stXKbvayu0TTcUNsSGOjxnE0bpXOp6TzxG7dUIhT5oOVSlGwa7zE
+Feg+DYoO/b52prAT0f0q3EzxqqGIZVBo26HmrQf7LK3xVlUl847Of/A2Qr7fGVQpbndJD5+4qilsl9Du7iNWNmQVON/CoXbAlzoH
+3x7FCctbu/1kL202Rdaae6199NaJF2zzlnKt1ZB9l2zntNSx5gjKaYJ3W6TBHwyJvLVLA9aV78p191Jxe3dD8ZiKNJu3Rbe6Yi9U
+HwucT/QZ7skg/e5eOthAaheaXJQnrIVdvO4bS9VJPAi2t3uI8iXKbzYDMB7fg0us5CYqbkFp5qrf/YPTBdl+0cBVg1TUihbNU2W9
+6l0F524eaQmtZt9kXKamqqoNeh+UFcM3xnuq4+DI9N00BuK6NYfr1qsV32ePHiL0pIMQ+P7gwvdH/XVy6UyBpuL8F1y1FjN8Uu33
+jKgr1nXzKhPzqu+D8VgN/wQjRsFDZfX9fSYOJuGGZ8z87YzG++zBAC4ZbXV7oNXZCwc1lGD24lgJ5FgJVitJ5r68dY/oCL0BAfoJ
+eGuY4NFBRHSZiYtugzAJIfRm4kc4GnophSD2bC+nUx4Gi7QXr9dvYpPmIT4v9KQYRDuaqioRFkYwoJ81m2gvOLuFa4ZM+hmMWNgW
+taJbYVtVu8RCotsYyFIMRsFE3s6lmhi1y1YEJgPRWD4nOan3tidKwe+qnbb63rr4NpGcJsX3hW3dFWqlzsXRMk1Z0ve3GJOcLt3z
+yY20OvC3U1LcmUy3mY8miOoUSr8QjQ3lU01zra4NqIQ3EfNpbPEeyJIUt9iK9tc2D4legrVn5XgDW1q2RDfJYXtfjFqbyxiP09Tx
+qe4tWYvL4Hdjb2+gtdGQ9g7VvzOfjAo+OTH55PIufFLtBR88fii2guU3F4P06yCS4wOL1zBI9dOLsyReAOJFHETAE3c+a37JdQe9
+q5G/PYO93E2XPl6vRjpISH8ngKfEP2vizm4M/2Ts/e4aYt8gWGk+QZeFuAuiRa0TwUnpokeru7ck6I2tbgnHsxsxs8yJP1FehrTg
+jQJkxJwrPB8ddeHxZXuvN8CR8jzKXIhWex9Cu49Iz4qiXeEeT3Ec343h/fGo9+jhw/v3i91GaO02ipvpG6qaw84rcBP0XmtV2ygr
+QQdJ4w1l9OszOU1HZWjdxfBbP506GuMVt2Bv9+EjfHEgUbdD+87x8daxg7oGl7zfou2dXn+P7Q++MhorrYNj2GXO4uTQn8xt061R
+6ahvzMS27rbBFMihFFtD6UmtV5XAHkqtVrAX42Cp8+wC3ol2Hz5qNmFNhReCN/QAZpQvC2AX7MrFBSVk+fJsZZukn16qbE7h4mA/
+2Is3eeYLQK9o+rACLhFgN27o7ePjt+purVjaZDZeEqXqNkhKE56D6zKQuN0nD1jtdgmiumzDFkj6sLptkUZFD4i56F8KFPGQUepp
+sqrX0Du6HA2DtCYzohmcIce1xnuFd3XoFIOlByUR2n4bPJjW+BvEmxnn4h3QlnFQaZRRJ6hXKzWK4TwV8xZOYWAmTrjrk7VZ0Pa2
+WpeCVreofaocTX1fNtTFaDHUJGnr/r690dyqdNxWnUPXO7jbTe/ibjf9HtztJnh9JNnz0Zxvs7vdxC6h27lDonqfvEnJQUkCAqH0
+yZvsM5/g60wtautA4XZKst1VJ/HYDBtc5Ebj+unzxolxC8W9zgDVVbfNm2oc3G6+Ed53IyrMJzvr24phAekFt33Zhsk7CqR5Jr01
+ttV601wOpJlmvEHviC+NyKnl38257Tv7vOyS9tdeHX188urpV09eHr5hgpqqSzkGS84p8WKinr9bG/xQgt+ftLUrx+GWex8kZDfa
+MOmazUf3pbO4D8TvFuxXMeuWG9sjWAlHAZH8nVd8NoLd3AYFZNqKC/mI1GsZWyz+wWqFS4pE9JD3PesRN1D5c1cjPrhf0SIi6p2U
+iA/uv0mHCOZnrd0flBaxvnylRLwl9s06ROyvt1EhPrj/w6RBVK+pvqMGUb+z+gPXIJrCrDwkfSyMx9BUzL/NVKzm8FHYTMDdj4Vs
+pYtiUp9JVI0DwTOiHfJe46H9VqcuWat1RghFKeb6HedthMYLboqi8FnlwSB3yWB07l00mw82HJ1KC4pWq7+EZNr2kN/JynEJz9FZ
+E/qMLanQ4A1wq2sbIYrZ1AvBBvGMLd+tTMhzJswdYyyJiHtztYW9fRG4U58wx1EfuFo96N7qgFluyxYUHl9qtbRTZUPW3u/YzfTu
+X/6ku/EM/IwtW126xMGwJHS5zzoD+7WEMwq0ekt2BmwpX61cbaaZN5tyuNC8csoIx+mzioHkyHdzMq5rhZDN8C13vKWH1p2l7aq9
+YUrpdFDiGfCau0PTZNJThtkT6od6iVduZtLtqLKy995w9lwQrGNn5j5sA3MzdlBvPIY2eZ1idsviyy9+wAeXj7rfD3njUfeHQ954
+1K3IG4+6tlBxd3njUfcvVt6oL1/JG4+634O88aj7lvLGo+4Pk7yhX1x/R4GjeIr9By5xvHFuGjYEnTtoKSOmx5U2/jPsROhWhtaR
+aA0o1Q4quUE0IjJV99ZU2125/AtT02h7tx+DbWcsrAmls+qanDEhNzrCSFJ4e+nQuNUlxdC4kzJUKMlrCcsrLMILrklNP0lfodZX
+1O6WGtpBKGOTd1DG3kUu3Tk+dkeN4+MtU3F+rDXnx1p1Dq4h3qyLLQaF/0ZDFf82hu/D5j1+V3WYaAW/JfnbXnwHm4y3YBzNpt8S
+q88yTgclAyhf6S3BtF2oCoThN7jXcI+Pj4/J+8fpDhm42qSoq+Z3mRUBrYqGIc1PnbK7IKlrCLR1UwIm5Q5ZVziLoVSNhbGdWY8f
+nkHz5MEPx5ipPzX4Szdkln9hQ8ZWHMqrwlxcEG50Oz38fbIrfx+J3wcKfih/H8jfrvy9L37vq9/H4veRpPdI5u/u3lcBSem+LOH+
+BzLlA5VAFS1zPJJFPXzc0y75pnzm52HWKy4RbxrchX9UMaKjPdWGqFysDKgILCKjgo9HaqwUlqt1kp3o+UgLEOi6+m0vjcCVCbFE
+OlsMBkw8a3CSzZP4sgF3F+FK92GSxInr8Ksln2R82vAbMgduAi3fp+ggddfCtHhfNYYQIN7PIFEAH7la6e1eQALGaQzbXHU0HcsM
++4Xj17i4oRKJJbIf7cf66lm23+2TbhPen4tbjBOa7e+zLuUtpnXVgKcxswitd97f+l8a7zcSvuR+ti2+rrE3z7Jl2tvZOQuyeX7a
+nsSLnW/FUTqZi2vJ2Y6Vfv9/abwPRA7i5TV6nmy4E9LY7XQfbO92ug9p46/FUWOoM7ch7cfBhEcpnzbyaMqTRjbnjaPnrxQakuz8
+L9IHLTbMnf2nbhqawDdZpk7Cew6Bmx9bLmctbnBXOVEzeZMf2sHBCeFcpo6cAI3HKD72dYpUJynSPHhwv0g0y5Y6Yrdb4M/i5Zwn
+OuoDg+4sCLlTzDiZAhPUDPTy2ObNpuUZqubcPyI3cCsqAme86+oLMKLAmzVYs3iX0YskXvIku25P/DDEFPVPpIAnJFlXqIppZZC5
+nDSbkfLTsiY0Kmj4lS85M6lm8yDtZ+jS1z9T3tD9LEuC05RleDtUxb6I0wAIsEN1lCBi+BW6GUcgX079jL9Ah+WfxFMOfhWK4YJj
+5YU2yXoxemE6HeFXWYuJO0/rdfEB14YPnApXAR86LeagNUBxp68JRthNf7Hsm7fl9hAbZhbyeB+xZyWsg1i4X9N3jA45cTk0ZcSi
+Iu2ouPTREg4qqRW/d7x1vH283X5/AD+iPIcoNa5anj/TT7S1yHFvR1yv1i/sbhXpRsc7x8fjm931DtzBy9p+GMaXL5I4iydx+JKH
+fhZcCO4Ys6Ry20MNocCVOfl0OJnzBU8/vH7ln1EON53RH0lN7IiPtdV9THpb5USrVW1eI0/Rkqead2wZjF69jjRxuXiFNIqneCdK
+L2MsGwXtlId8ksXJuNnMRs77zngwcyeuFUOoiKEVXrV0DQfeyoFARtakZxFYrSSBCC9zq4qIAAvEbzvh03zC3Us3InARn1DjgaMr
+606wTUBlrL0MLGWtrL1M4mULTngzuH+S85bTh5G+hqIKF9i6wEujQNuhCkquNr9xIyQPm9yRCI7babzg9T7t5H1ErAVZk2ZTOtGP
+tESaGVP23PqcbOByxuX6sHOctnZgtm50dIW3PDI9aKA4+a0NBx4BwrPgQ1i6soGbsdz121KMSmlGaCbF5oEKsNy90FjS0+gL0nMz
+ZmYuYnAg4mNNLGtHcQRs7JV/lq5WIyedJMEyc6iTZtchd8T1TT/hvjPu66H/FDloLrx8DdnNmh7Av8StSVEaokN462Y07muuz2tN
+y4xb5u/j29wDuQCEsCgVnOz4+H3gOO33ofGAtrFMHEBRhqdA5zMX/N6Y/gffAwFetMczcGNmfMFB6Kdptfrw+p07pBk8miS/hdCi
+YGcC2eBe8jOI5GtB3KNHinyW+FE6i5MFtDit2eP1HYV0mF4JBhHjPbVAMHOBiJjfToPFMuSvFGVgAdR5Hz0vDTwW9Y6wJWRdXrEO
+fQGWgc+hz17Cv2/BAdlHrEM/wfZK2y9wqLg3cQQ6vMw/6xkVFTPuW4rLoDz6UavVly6A+CWuwRHpvxBt4otyU7Dd78KD8Vs+LoP9
+wD2iXNi5H434GDNRX6/PEdNrdWEAI1ZkvMYbRBFPcAkWSEK5SOCffeIvOKaJ/AVnvEDSl6NX4wIkBF7ycVPm3Vb4u5DV4wi6udm0
+lw7AGSxAGPd3hMvop3bEtyDiI3hf6zkUAS9PvWq18NznsMWcPafFqbs1XK1gVHKyWg2Rr5NmM3GjYnjho6ewHC0EsyvMarFlpzzk
+GW/ozx+lYobmuFBr6vAdW4wNzaUy1WXqWACs+MA9wNwHkFGWv1odyFziV9cLquk6cMwDAzhdrZw0mWCQNJtCRHlj3XGPlkxSLnxz
+kiy5xsmVs7n0PRahDJK4uSVrnuhslCtvRrzNLwJ4Np+gh+24lEXKMCIVPPGYl27cbaxkxOZtMaOD2bX7BrrG2BylMJknvtQMvKEY
+uCsguRI0BXKMczeiz+DhFLoVqdrerU1xUdBNitruK/fUncpW5S2nceO0opazdow5MIRscASJFrZ3bJ+7fyFMhIbTSmlUuA09bLF7
+zLnXunYj0rrn3JOa6brs0ld7BtLR7CCM4d64MQkHSH5n3+nhjNt36JbBe1arcLXKkP98hGu+mJhGCjBHonEEKXpmB8PM+lbxZKa1
+XcCxiV2VAE+hnGkGmBSUB0a4xwlVi7d4y7LorIgcwnYez7SFqRnsOvpmreEjTdgNSO+wxYL1G7c0HC6+xxGe1dgLhVwm4P/29key
+u/uw1qzF6v9C3GaBj5VrCiwQr7a3kdep8SG7DFBiGYhq92GCDvBhmLMMAlRmhTCMRn41CfM0uODiG5vNCsq1eYt7yA4NrYe5TSSk
+v6keVDL7+uEkxqazt+O0eAtutUP7aQFO7QQ+aV8mQYYHY5+0eTR1CT3EVkvRTPCRcJrU3e08JDRkkft49zHc8IbAB+DfHBI9vg9e
+kSP3MTykPReoDwidssh98viRaULjwz2hNGss2M5no8+OO8fZcXQ8O04axzt7bB/eQsHV4ILdTDncF8ZrKQFPe1uddb+QMdmNsb71
+Rs78vkOd+QP49xD+PXKogzeG0bODQ52lQx3foU4eOtSJ4V8E/8IAEjrUCRwq7+U41OELAQXnkFVcW8ZHyp1T+DcNLhwqPOk7VLwL
+Acl81GM7VPjlh98Eo+EfJFgm3BnTitTau/F7I7EIUQdWe6SdnPHMGdNgcdYb4ao0XlOjl3sjJ1icqQph1VByps4pqGbEzyyOQLwO
+omWeOcKloEOdBc/8oh5ya9kbCUUSxZ/UoagQovISfyU57mJ7N2tau2mGvqIVUbHybJTeiWo1Y7RaRTST2hKdvHh9TkxffK2+EUSN
+jARgwA5W7FItyTLlOlKKR+A8UrDfXrAu+81Qqht8pakdpPhrjNXoTVos/Qo3m8aTfMGjrA1dT4MCVoHDkC+EbyV80+rDOEel8UEY
+8Ch7yScwmX0WtCeI+BrHh0dTJl6n8q/cpJ1OkjgMZUzSjmezlKuEpXyBnTiwEpM+tnBHSl05c07jLIsXyL4HcVtA237PWQTTacjL
++J3d7bg9R1I7u724ncVLJBWydNtXTEW/qpW3stZlEE3jy/bSP+Pf9LAmNCSGJfLjh+QNOlEcELV7El409bdznlwPpdoBNyacaC1N
+h2KPyZZAeSE4iwhoCe/SywaG3PhuQmguWbJL1hEM3Ju12HqwwBWyW0raHFRFEf6sVk6cZ9uTIJk4pJ3F7k0WL9Gv8izr8TVpT/ME
+XaK7kQ6uVl1+X/NpXaC57MmmFd39KnY7K94GkhQCWYx+pvN2HLkOj6YONRSIialNXK8JTaDeppJVa1ihptU+XK02DXJdnaX4PCPv
+j98x78d8Jj29xuDp9fF9YY/58PEuuV3RXlIEbdmbyVvOv4qtsK0UxV3mR4qK44Auvl886Ve88uYnZ/ghhe8r0F92+tGeusMgXBaP
+ojHTaUdRqzuWKswbUFyf+pNzYFrJWdrL1qrvw1E+ZglN3ZzQvNUqeRiWskcITyKaKm91G4C3FWU0DgHa6hQh0QIXHql1eiDcFL5+
+G91e5ibgSslE7kokBbWoFXPfjKHJaFdHqwO6rO0vl+G1G9GErC3lOs71CUl59ipY8DjPXJ92qLoQLb4EPlEcL99M4IAXdgYx6Bxn
+QeSH4fUNtAedgNy3Xq/FTaGUZ88XCz4N/IzrB8YZaAdu1pgUjT/VMKQzJo8lznj2Qj1D6M2azVo0CLczuKo+axcVH8x6nDqjGDM0
+lkk84Wk6Bj56s25n8RDZmDymaMtoMjCmo+2dLWtHoDULJueukcZHzdN67ZKefSyA543ZEU9T/4yLJ3kXMF2GKLGnalDAc+xw+B4t
+RMrCTkWjTA4Bj6iuqUXbdRxQ/RBqZoloBnUyv0aU55j98J4j3l1M/GgaL1zSct5zaMTMk5+oncZ5MuFwyN9sVnh/1J76md9sim0e
+hLXgC76OfbclsdKBrd57gsdGfzo9vOBR9nGQZjziyaCKch35RQ68OtyF1w/8LPMnc0zlOvqLHVDmpFbV7VbKWhE2E3YVb0v0wdyP
+Ih6W20mwGzsNmj0kWbeuYzgOBPxSsjarkYlqJNmuXRlRjWWz6cRRwv3pdZr5GZ/M/eiMO0HUWLYnCfczLtmxqzZ6pFrTZZl39+3y
+hXC1kWA/alerwKwRnhFamwhd6PN2whfxBT8AN4FwbBAhHgapv4QzeRVRniP27KrwG0hOZxbbYAmdtSch95MCFazXrgMnw7Mg4tNi
+YIKQPtASLccnOnu8B2hQwOOszyjcOiA0cu/vwpb9XVyj4oM4pKL9NyXrvzb0PjHUPhl4nSjh+Cja7o7JuhDHnjzeFZcgHnZw0d35
+7HgKuzK5b6x7tDdx1aGEQ+W7OhXvkqiTVnUXLp2sg4wa1bzU/oqr0jJvBqoyxuWKyS0bQm4svDHjowIe05Tpa/kJ3snPBeKjMPbR
+jBdUTpfAouNm0xcKwoSQrH0ZTLM5S7XZgzOvSSSkYZkK6uxcgffrGNSROTEMNqSxxvMIvaA1hAKwMeViTsRJr4Fb9bYDKpMpj9Ig
+u2Y5SGfZGgZKVnRdXUfELq+0vlgEQcFYqcanLz9uBGkj4d/Og4RP2/IwNWMjzGA81oqtoM+qJNxyLpH5i683YgWi5cwxWn6GES8x
+LecKNXf6TGpN1AEVBag6L4ytGWwbnrxp6piDKSE3cgUXU1Yf32XUOTnh6VE8zWE/f4NHc7CFJX1l3QGlPenAbKhpdHxsoqAw4L0b
+Jezw9dqNST9gBqPYshgF/OvVxQqZeSB+esWl3AHvJXKj4XaoVogQMDfNFMTSKqf5YJfAnujtL5OimDC8XpzGYV+9oFM5NYoGUTs+
+TXlyAVqRQcZMEI4HI9cpEA7ydQ2yjPQy5nzlK0YKmq3ftrto8f13t8DZ7T744MHj+48efHDH22Lqsli3+6S7yY04HCtwhnvQ/aDW
+Xgu01/xqwvk0bSz8q2CRLxrLOE2D05A3MrEeOcZr3Hce6Jm9eyU3HAy5snYYT+SeUm5UI5qAABzozQ6ywNMwPu0J/7xLqdUhiXyP
+JI94OvGXaNvmZ3NQVRF6o0ynDVs1NBi7Udk4pkE3WaC0mRK5XQG/GdrRiSOEZZlVJ+ZE5IHXEGHfBMZApG84i09DP53j2bAOs5ng
+zBzvFBF9rp6Udk2C1S2FS16u2vpGfXYvG3XHg6xi+AFPPsiSeltbGTxGlvA062Wj++N1xeKosDYEY0Fnp3h0FYOFobqyn+BGPKGJ
+PsKAMTdKYCmLYcviwxtB29t94rShzaJRMh5EynNDQuGpqnYR41pR1IdbXX6ziUtrgrdD4LCvlGh7W7VJIwajqDxK58EMXbRQp+0I
+Z2NOW4RWq0i/0UzVwfeOaVPhG6/Fizf3G0GUZn40gdGnzYcbatBAWnWYi5cr0R01nGNhHQk9UyM3o9dofUVPmLhIowbTFmNnxdZB
+QG7EMpqhpEjAIqVm2x9hqomQdwjNWAKCaM4CF7Q4Dkj7W7meHs3mVq6GHr1WIaZxq1UKS1+RQmVkBQ2YoEXYcShneRtGFTXIuBej
+3TEb7bjt98kOddQUdMakf1I8m3LSapEZuxidjOmSzWArvmAz2Iwv4Q7k4Hq0GNcd7y8Hf9OdGq9lLPEOvRPli1OeFMlmo93xwBVE
+9OidEso1OG1BGrBEtlJZaSALpJgWsw9KwwzwAK2dVNSJwKslizGDf+Dbrtmcje7D/ATQcXrQL7PRg7GiA//siUv60LHXQk3IIhUi
+hJod1Gw6OzBUrjWPQ2Ng9DkELWLHQdF4nqcQ+B0aYrEBUCMVoSHExElmDAiw87huz+M0Y+IHc4lkaJl33c5TniBhIJuml3EyBYvB
+67af41HkjImgYUNq5oLxgOPLyA6DQwzr63acBGdBxK6NwS1qAvMkgOdutpgROyiCLWdnx2mJxD0HZhcUAgyYXWuVh2uwg9TWn6P1
+pG2C7mD3ONWxiqKmPn3NmButVmq2uhlwzREfs8x8sgqa0OkJPAWpKClafZDh6oHtnuh2F9ZipFeNEeTB8tAoQEXqQhLsNqhfiwEp
+ARMqyWXlzDrjTg/3W2INy8igZBIsCbNMHGjSol5MSdLwzk3PNSNUplKlVRM4vaI5WOn5DFqsq1uRlVnxH2FYPPfTudMTqjn5Pq1O
+gftgZ8fpOV9y+uIzi0kFq8cgaGW9TAgDVudps35ErovbZ51+vDdXPC8GzzrCJGgOZ0G+4APJyAf/Z0z+lriBFgvUqE+MUZ9URn0R
+O0hKoz6xRn0iRn1ijHrTljFHmcxcd4rjDLhNwCbFFk9ux6gwLkZhQJXcT5pNR1ZMNmVSbH/h43HYCQoBS1pupPpxAJUGfqlNQTWD
+AIuzFitgGmk+IaIcfM5N4cAfGXO+4uBvhK2AsYrfsUKq01o7nNQD7soQ6ckAzWQJA8FQ9fgYOAMHRgeIFTDIVB0hTGiwFkdPuB/s
+inPpbqfzBA6mdz6znBC2W9vj93tk4MI7cWTgjo6Hx+n4fbIT0BkcRj/d/uuYWvx2tp+0ttvj98WrcnTORiPnSw4V43xMR87AoZJD
+AbTjmCsyHTlfgRPZHA5/u2M6+sT/hIppLs3LaBfxOz33eNoi7+1QwaJ0rJkHaZr54NW0G6hIr0uxCr0unI0vlVqagVKrl1I1Ans5
+HMfyqyzxJ5k6rGUB9fWugCXUb387ZRPDo55f2kO+xdaR3KANu+YpqK9DlOIlBQYayQCROxYgsi07FlmagfJTM3/K/WRiIuRyX1BU
+a7ONMinC/AWw5i0W0K/D48BBKnanaGXBTUk2sY2wA9wFJdpcXFkvITkalM5rZCqgL5kH0Hc5C8R5plXQAO1ZF37mkl5S9L5Eir7j
+ZQlclwGftNWFW0kgacbhBSqH7fVZJ+aDanLZALB3XwuDN5x6u8JKpNt98pBopWFAM5WN+TSzSbCUZrLSLKZZ+9MkZELHMVFzWE7f
+Vg9m65Lt9NDn6Pvv7eDUxRk9cAdbxztk9NnxAKe1ezwYfYahwXs4f509h4JJ1T3nHgVX907Doc5xAv8i+AfmFlM2cm4c6qwd6qwA
+eexQ5zMH0usn8uaELtjIuVdgpvA64sj5skORDwBn6DvU+VKRYgHblZGO/ZIzptfAc1ri255u//UT8VTG/fV7O/QEP7smjoDs/94O
+PWU33/IvfKE1hKdAHQN00Pzi6s1JLtnNPMuWECkuU2116EzA4tYPImABhOyY1NHBVIZnGivzSDRkE8Wci3HxmPTNQVpWJhd7Q2vw
+1192e+En/oJnPGncy5PwXmORp1njlOu7b7QRxVnDaekrc1pfrE29B7BqMbTGS5rNZM+I+pKDiw5IK2iuIYSvAJXhx8c7Z31wAiY8
+gSnrcB96HrY4sZDBAiJ1dKhVSVmqXjHYAk/cjGmqUJg+goUsS/VGeyqcuGjFt2ZUqcGl0hJPW+JODzdpJjcEjMkNs4F6xdtIVTy3
+T3qb0CDPGnQdx6QKvjIBRG4wZxPzO+bkZs7mcP8EIqdsXrpNY68XUwpNJgtWYh66oo5Wq/lqlepLPDDzR5995XgHnq0RvztEtOQh
+A0GHsYJSh+6S/tbhajWH547muI8sYneJvUSBFS6MR0gJlnSYbetyNB8bfhCGbLtLD1inf7CnXDD0D5Q4+pQVptdno4MxEQ9vPG02
+xeOUw9Xq6d6Q4MWGp+IE5hn1+h4TsYO0eD4KxuVXHNKroOhQ2pSCcfkzlup9s0doqkGv1ZVfh8utMK379OXzg3ixjCMeZe4zQih8
+jDZtEB91cetHXbz5owQOIWUYQYyVvqjv0KzvkKiBnaT8a3GauaQkAFgQ7F9xYB0xZwRdbsWOOuNm0xlX8RZkW8RuHRHVEK/sXPrU
+qr1DoOfpC/ZKN9Lei6KhnrNXowMk9rzZ3Houh+y1MXhewgT6FgOj++eKxLf2Pup/q9Uiz807yN8i+93dDwYvW+CTuveyxZ6PviWq
++dIgCyQ/Ya90ix4Q+qEGD2AEfMxUNU5I/+Nm0/1EKO0+BjsO+qFW8X2MKhz6YbHLTpmz47Q+lDvMtkNaablHPiki5cX79bquhfd3
+Hz4c2Fkdp3dL75Y2pEerlWunzttZ/HR48Py5jZe3bb7NtCQ5wE29gkCT+3nNQNIY9nnr2wWzbRVJ6ZFihBtqrHgmrR1iu4QKJVOK
+Q1M2bkoEvzkdTcfEmIX0BVvUDrBP2UIOMJyBxbT8VA6GbzAeVSb6p6T/DcbYp82m+w0mFfqfEjn5cGh/Kk/hvkGEBdbXDdKwXokJ
+/3XdBCCAay76dXMad+jXZSd8zaQxEHfTkczX7JVK0/kaMZeWAo2MTBUtIq21TOv0jEp87U5LV6pS6NU0JfRyNIW7kWYvwmVRM1kl
+G4oCFmq1MoomN+aoNBSJ9KvMSFYMRUjDvt366toUBXD5x5DaA4i1l1Z3AqxiuaFXAixEmLnVDBUulLb6CtyX7z+F19pBC8XFxl+e
+F1urN35KVPN5CdPjBeEADjLw6rxu30HAeEtDvVK7uxArVhkrphhZPYfYrKXnjJyWhWk5Y909caK0DiZfIObQszZ7BZrYt9eNiIJn
+xuzctL4okhB5f83q7bjZBE1HjF2ielopeTI1/oVyR+gUbXlltXK3stXqEh4RbTa3uqhXcwMUglpuIFS8kVJvR5ZWO0IGFBHSg3dj
+AlQ2g4LpS5aCCZMmzPmS00oI9bG+4OTLivdBg9PyS1e3R4MvwWukdafndcNuDbK2r7Oj4uXLu/fhi1tBK2r5rcQe6GpvWVMAtpG9
+ZS32skTPnlp6In35aNfeoNwUdqCJ2u9q+pQbWstIJKIBKw8dQoVGM6jRaIKJez8a+WMcLiMfb1kJ9RfDq8hz6ogDWzzu1Dq9OWox
+Cu4QCa87+phjyzjh1RJJymyfDDRnnX6+p183zVW9Jiwd5eN+oULeEq8JRaMJnNJOxlq/ejkqtJZjOEc0+GhkMlEBsKjESGu+ZI1f
+UmhrizCO7OKzYDm9HBXR4+JLl+UvncHh4d5SfelMfSnsnmbQA3P4sPl4vbmFRc+owowjdMrxq1erK6syxqcWR+rChkhVc8qM03ac
+w8b5cH9qPOstimDTthDhwGtNXxWrIoV7h0LYcbkpgxGKh1lTFEqm1nmvKmhvtxxjfMPUOPbFlpBLLZcBKlW9jItfKsYCU7WEZUPs
+Tzj+0GKsMLPWIkwjoRwUVo5GPeAo2lxpFyyy16ELFplrrIhli9ZF0bVaTVlwVz11akckFHTGzPHs7IiXiKvHiOjdV3wyr2TgdRlO
+2PVqdQYfJo4likT0lJ2AhsWgYxRZDJXVagTaLbM8vjHdoTGJcMtbgChuHuJsNUaO6gvUoapKusiWzked8QD+yQOC3rkePgKB72rL
+0UnNWW0OTmnwWRTCrUJgyA7gn2zYXjFGuSqEF6plaNATM+9qpesK58ykMjAFfwUBRRYQqTFYO0KNDIAYFMFekYO+eYKcs6k2rlFT
+kJyvVu45+gI4l2eA5+zcUEW+kawmiaq2T/Iw9JJPlQWaq3KJ29GH5KaosexCEGsEjxHeHdjWlqs6XfwWAtlXHLLfIRovBxpIjkMc
+RDjdh4qeGglGkTqOGLPzDc2mPsuNzHN5Ayu/cLWSKZiRclAE4ZCspZMPVADQG9elrfPSneOodOJgkMOiUfJSlCPjLKJuCS+2hedy
+d7PdRS9RT5kbaZ4iflVF9lFkFHZCB6uVtAs6IHKYHtBnrEM9ppL3PXDq6W1vkwN2PvLGVOYcnCvzIM8wLzoYuBaePgPbomfNZhkN
+1kTQPCfN5tYpbm/7z7a3+89IwQ+AJOlvnRizcbU6F9qbHYUpuOJqZWR1CH2qZNvzYh2yxOZzbaGkdEV3Kaa/aRYcDRynp1ptoOdE
+z3H+QqbFCTspFghVLQrNfdRs2i2lK22MTaPRem51zOphSf68ZpchCMB3SYHgrRdmWjl0AHXihi0x8nTTJhC1BiyDGSY3Ynq3L4ww
+CuW4MFpSimat6ykunlBeURrxt/AYfKM2Hb3qJqd6M3JN1aa1Lnn5WB6khjwM0XMbFf1Yk60BaZiRxlg2Nifnda/V19urKg+NBSxE
++AB/EtfBw6BnfJlwcWztVG3rM9KHhIk/4VbCwSSO0jjkbYyCU0uFuPQTqAaoI/QL0lzeUoPuovqyHoq1ietEsV2H0oHvVldbVqxt
+W1zpyGJLmOOGwyxO/DOuXfuV3VBo7wV2ergDZzTxFjyL42RJjoY2cmMaEVtrujZvNd96rm/ehNQDRO6VxE2ewaa7ujD+lzw5YZkS
+5CA7szK7WRFDb6APsiSfwNUHacnNKY/yBU/QcHyrS8E5gQh36CSOZsFZLuM66zVZ995cGekAyrzu2o+M+pk1MusNgyoyMW2juhUf
+h+bthPpbAnXTrqatoZjl9YaoWRCGG6IS7k8/fR5lj9e334pNjBkWiFs28v5pynnUG40pePMIPuc9X1/ZKd9t3Wf30WhuypfZ3LjN
+ujsmtJr2AaadxGGcpEbi+2NCF/AaZtJO5/Hl14LplEcs6kVg33fCrzIeTd2ERoReuWYSVEOZWba6IgnWhhgV2xV4UTIxa6GyTPI0
+ixfPo3TJJ5lMYaLQMFrlEuWKxmExobmbUE5VuRu8TQaYg6ejrJi1A+f/NnJagSQ7iuCB0ZazwLs/5ZguxvT4JjsObtls1N22qr9Z
+lYFJnbg2YVmn0QhGCGpYrJZoNqNm88CN2oFuLB2WfuZkOncrMmfKamWBxXQCZQ1R1oKaFrSpNk07cQOCGkmoWICPJQTyVniIn4HP
+Q2j/s1Kvamp2IrDWSMExGH5SMWpQV1nc6TUc+oHfCMhI6FC8jV1cLdWXQsFP3GplRKg7XLgi7MN7B7JSE+n/CZ+X0Ef9wcw9cNXX
+g0IChSMH7n+JcK/QAnM15lxnpC6iO60LUGRTB9os8EOhcrk0nMoUuYRPOoON2VeQI0KdhJ/xq6U4ljmsJfLMz/itJKZ+xgWBoRtZ
+Xy+VI2B2DBr/U21jg8qEqWjja7iQDFEjhzpjZ0yoaJ8z5jSMr3ZrW4pAW6gGwKdtwEnTG7+b0EMzfeUTP311UM4wNDNMEGV2LAz+
+69WqY7ieUi1xCnP8rHU6ki6X9ONLDaj04J26q2cODTGWrTHRhy0yj5SHSZweV2pYXbHrwVLMd7qgPun59t3BgsXMdKqMXgs35IIs
+ah/m7hU9o6cG/wu1644rN6sZS8Z9N2qEcfCcuJmcFRFz7jmtyh3W4lDhM2flvCecwBbIe4A4Pr7nWK4bwQsteOZqOfdqplWkXYvo
+3RSUZPSJ47Qyqm5DkN6iLvY0jkPuRw7pXZSihWGw+CHyLl/RWhNDMB85LRRjN/Y+F0NdZ16KFZ6ij5zCPHo0hhtCNGXa9YS/l/Zb
+LZ+8cjMqCLo+IYNYjI2ZJqPjhD/5uNgzawvGynoC8nlhkQPW62S1qlAOkOKa0LiovhkbyxMPmtJQvO1fy52f6cuybkZhdZByI7zC
+uaYhpB6kLGynPDP7YPRVnmU82RnijzVLetVkdjwSw3N6M2UNJUJfobei1QrOwWAhF6eN4mVhnDNqwQilC1h4+DRlF8ACYI2TaIpb
+7Z6FgWvNxiH6Mawz212sVjxQZ/eIrtwBlqOrAbeLtRNYTKltn3oAt+5IpkKHkJ7dOAdBMslDv9w8VzDogDHEzWZ5yCg+kfb98i11
+x2mB+Z3O4CgfzydjFUB7UOK8B5bdcHaoLTB8w+rCN+roS2dbcFfCPGx8AwcpcC7yHwKp7zkV2oqhaI7it3DNSovBP7evwHQKxnSb
+T2N4ujcrDQN43wfwvJUZdc47ne7p8eh4ejwdLCSfFG3R6q5ph+w/6gwiWJWEIjwDfVbWco6jBiyo0Meyh+lx1FC4aNQd9zBXZqcx
+ExTfODW2QJabLcsyeVGk0mzU0GXodBd1ygjTHXtF91Dr/Lx8q41bfslvUaqYTreLZHg1ujbZVdmlZMeuseln+hxNvbXXGCEFoNHa
+M6utzu+ox9EZDjcXAhJPTRHDSg5XZ8HlSedZrSyTdIw0CB0Yda3ZshYJn9Z07GpVMxZWq2rvrVbVrlqtqv1ivGdhFP3MKFouOJsX
+3yKbZ2Tje93OwOk4rSKD2+2Qng0WeY8sZy/QCTRjI89Fz3Bfi/MkdcFbsICPgijPuIkZ8kkcTQEzLm58SeYxwhTP0C8Z/ZaAjuIo
+m7tkTLOx4WtB1+aVxV4qTbDxcQX4ghds58ujdPqtL493zvpZxd5Iup84QTuJwmfWaCwdY5VVBcJBlhAcgsKhFlz61vKH6S+isKyA
+Z890ehozdRmK+kybamjW+IKWauh8+ct4YieXIOfL+JpnVLxZ1ODly4lfLt7RkAUko6jVGhNxO+7LUx39CY7XUvS3nB4oA+sdtcik
+ZXWguaqu7QdvwEMA2LzBJfl+tBf3IdhqRWNygf6Pt87dlAx86by2JwMBGGer5WmN7hOEWtMwpIlQsCw0G6JHffxJ2mWFbEUdG4DV
+f1kbW9LFBoUulstM1DeUsdFmZeyV4UCLlB326zFtfBh+zwZ6eD4l3ApYGl5FOJLqhUK/GwtXCs/RtTm63zjNz8L4rDwDrtznwESf
+g5bDvfnEe3Z4cvjJ13tgPTPNJ8Jr6DcOP3zx9ODHTp5/8vHzTw5Phq+++fHhsLfVXZM2Znh2+OGnXxVWXJzh7nS51DaxWy/BxTKM
+WcMH/fHxKWqUjo+Fg1Mi7pQ+1zqXpL0Mpn3IWj4PiZiazKqtjIbq273lfDltfHnaa3w5dSgoacDhEp4wlwlrlRRErKnWGLGAKoUX
+uzmNw2lv1KW7u2MaZH4YTHqj+3T3/pjiKzxhEPHe6AHdfTCmQXTBk5T3Rh/Q3Q/G9HIeZLw3uv8Bvf9kTM8Sft0bPekgcBqCV73R
+fQXlkO4BApNrP+qN7j9SmUADen8XIXhUNsr83uj+Q4QTPu2N7ncxfM3B8WlvdP8+gGuqtHzsRkq7PQcoO1SsVj1HZHCoXNIKhN7/
+9hyos4Oif8+BdnCo4AkYwyOHgo6l58h6OVSoAXpOwqcOtqcQrdgUwx+KktgCITivYRc6aBzdsDOJhYqyawQES2MnAsCFlJ0iUGS7
+QlgMNnaJgLSXO0cAFiF2iEHkBWyIYaXLYQcIvkiCRZAFF5w9FbXOZzOeiAtkj4XG4lts5Pw1aEvnIw4j+chPHOo8XSYYBke7fy2P
+8D/ssp/m4BZ3yMF7rTfJHOp8EoO73md8gu9KWNOT3KixHMZnOJK3cRwfuf9/9r6mt5EkS+xowH/BFzFqmpXZDFKkVF9KKsWtrepe
+D9Cz0zvVDdimOJwUMyjmVDIyJz9U0iq1GKy9Bny1fbANf4x98MkHH20D6wXGaF/2X+zd9l8w3ouIzIj8oKgqdc8YMNCtYsbHixcv
+Xny9eB823TEDbMG9G5YE0hfRyUublkLrpgtT0MzsvdeFIdNq7zK0AXWfH8LDB5uDDw9wc4v/aj5h9vAm1voqYd/Wl2kiBvVg5XEe
+oSlVDoGvAu4HCZwwHxhLTu0pFuu6RJaPPRgGL4CjVS3CUwklB8foRPo9/sa7JM4KU34aM65SQkx5V/lHxlQCTrJJ6UV0MnnV6cNJ
+WXVYp+eHs/nPzxaDM/vw0u4OroKqcsqT2Z3pe68sdHg6//lZbzE4k+b8zL6jeWu588OBaFUrGXZBXAzOD/WCq7YupXhw7+XiHzy8
+dngrEoeyxrPNpN8v3U6VmeATR/8AH9BcBpnhWZTQQPp2Bx/l6U/5O2/Lvgo4k+KoOYQiAIfxByBEmAqz0aTJJjSDRwiRzaQAJARy
+38RsqvOC67phv+8Nh9JeNLI49cD4ezXAgBI6l8iyEJBYwF67yTwDlzob/HG0aAEteMh13TU23u/XIG5kshW7hAxWg43AdrCW/+Y0
+lUGIhkc0gxi66lgT35WHr7Q1MI3MzGEbRXNkm+bdDNnrmSKZxvOk/sxuOi+T5+KZpupvzfvk9OwphAml7WKIeba4U0GEEveWgKa6
+iBQGchJHxfwC4QhkQKhFQskpcUTkMDDFdUS4sLvdLrc7fVvVPTXbt9uZ5iuV2Q6zmlHjgrVVsjUI9cCuB3UzeiC9D92wKGBdXNEN
+vv2xfn+jzkTraaJ7YA1HbAuyMt+DzZiDaHPtEtJ0ApVaAYVHO5/6s4kzto3QdLk4UofqSrizEWZTlcC4T+ALnn3RiVpvUuaJaNbo
+HrbyhWR4N7oVE9ZRkXpCl8MFhHnbGfbeQb0f6A7QYUJ9l8s5PusJl6vyK3bUTzCn2oJTXsUheje24D7Wpnzks1XoCffW/X5zFWIj
+NAgI+GVRkG+/+XL4ilDu3sJBL4i4QyajMaGqCDhIZqM087jvhREXSuTVp6vn2TSybsnsehsS53bpZVni8DscsXVlZ3B4BgbaECjB
+MjBFNigfUmctT6qVHG/KB8LAt3wRStwcWocl7c52gCdym4YzSx+7MQ1tZ2163xb8IKRm1X0c1f7Exl8dSGjm3i5ZyLYOugWu7sqw
+9DTu48L8B32kNp1Cwv7vpWlwyZl/kEUH3kGMQSd6hrWPMvDBRiUDTFNLg4wcz6leahXlPBtYHCeBrTsjz2QlC0PfwOkKWdjcptSs
+ZdIgS4bfEk0w7stkAAMgGtNQczAmRJDgBV8umUK3flewR1xg7otYCSuUwCu3MsrAFgf6g3veWLqTTN0I2YWuhHV4U4qHoxTUDBRA
+B81auWwegH3PStC0fGYVnyJYVEBXBsVdTlfGQLlZLSF1Uy0lY0mSx5m7Umm4xsd0DYKbDezhKgSpwHclRSKyH+AESMrwVrZ0GDTC
+GQeBouRPG2CvhAvnjaCYRU578zdvX3/zek4GKleXwS/OFxjzcbFYLM7Ksmf4+rs4IwBSDghux70J3VQ+6VatW2wH+QniSNzmEMwS
+i8keOBK6ZJUBbO14793Ih9EYpvWmiiUHG0RcFBVSdd/oZdlVeUq4xRfugJajAipRXhU7ZF2Gi95QMdoOp3JQnVT+crK7mmOPRkgD
+VMjNRhKYun+U3rnKDKX4re+iQl0ksBJ1u5mmqGZ0d8es3oRa0dlkpk6IqdD6zOQrPoSmEb8HeB4QeaIo2AfN4CgjNUL7fW5u51l1
+9MkqtgUly+pLrisuo3oipCR6CjALg8WHSqv/xrZZxdaEPmW2DAyqwZhNnAYBg7Ulyqvua11XPVe9rkZV1p6BjM5ILyWfok40U8CQ
+do76OmySEsagomUvMnujNnNVYgrELYrEsk3VoGoRZQMMeRVaGYa8qrwwHY2PhBemZydje/QOjxQ0duWBvzroJZWnoRETrsHdKkU6
+C3cD/Y7bdrs1dUc139fJ6Ca/CK3MApcR8LGGF1WYhfZdNlqiF//gSoRiMq7GJUSmn0rE9fA9OJCQPJeh3vgRqj6prV5t+vCaBkrk
+chxRogMJFTUz9fBdV8ajAfWqrWpmcVfKj+HN7dQlJ2TGHXhr4DZNZq8tbjuTo1cYooX8zZ//J+JMjsfy69f/Cr4m4ut//lP4OFJZ
+/xa+jtXXf4SvZ+rrN/D1XH39e/h6Ib7++h/Dx0uV9Z/hS7b9HdY6UVn/lTiTZxKR76DpZ7Lp7/4SPkro/wK+XqivfwlfJfh/DV+q
+a7/+N/BVwv8PxJk8Lzv6z+Bror7+OXzJ5v4agDxXHf1HWE129Dvo2fMSk/8GXxKT7xCg6tl/hw/Z8nf/hTj8zH3+/OjkRb/PT93n
+L4+fHRfFRDbxf/7qL4kTjdbJm+hrjB0PeglF4S2KgpX22xDPZkvxUOBTzVW8pgvjqFewmVAb0d+Sqj1xRbHBKoHTQJuyurji/Bye
+pzSfEmNbe516UVMXhpfcuw7H86qtZYs5LxleD1E3QF+nMc5zJKegJSLqi30FYyOqCdvvf5gnJhqLGXnyhAy4IwzsIvDAA8GnU/cQ
+3uNzFx/rQ/cQ4lSv3EMIIH14CQ6irJnz8x8VGFH6/Hp8Mjy/Hr89IE9/4Z6eiTutezjvn56Rp78Au+QNVoDCxc+Hn/dmZ8VwKP7O
+erMfFeeLs+J8gU/9vnvYt2bOE2t+/fcWc3TP9KU3XC8GBfhxm84K6xvvovhj9gGEHgUItXmRsm1QhLGXFAn8gatwkUZhsYq2W6+A
+sHCFl2bFNvDhH8bTuGDbNC6yTcDT2J4WFr9I48LbxsXrn3xdhFnx1TfFZVb80TcF3LGLP/n2p9/Y0xmgt3Vvv/EuHHBmRSUSqNhB
+EROHOIQCNg74pwKMHGIRmuAPm1DAzCFPCU2j0AFnVYihQyihgKVDeoR64Onwc0IFtvgTMHbI3/z6zwllW/HzH6J3BZn+TwiFDjjk
+t78h1NvGDukTGmYOOOS6zBzwyQX9cMAxF/QFftzRK3CCNXOsWW/4ObuOE5bCDdB+MpsPzz8sBsV8MFzMYNR8oP3n5yMYAGvmJDO2
+Ldh1sdoUq22x3RYBL+LrIs6KeFV8VlxtiqsPxdU24MXV1ru2Z0VPhEfxeFbYPzoM6KVbRiKffHl+/fLL8/n54vbu/JwAq9x05T6F
+3KV7mCfh/Nw6z4/GL9/C31dvMZr5hXs4f3pundtQ7No9BMdFnz2f/+HFwpoLHhoPT5zFwP7s+fytvzgE71yax64JyP49Z0KvLsqU
+7Sbbhs6EkuthCmHZPOJMwN0W8LJT9J8Ai44/P557rxfTWdF/Mv78+Sv4gbwwtQ/pF2Ke6HHXizkG5EsgaTEAlnrXwVIQiLkjotun
+LGkA546+lq+2o3USbWHB+joKeFYULeIxaKcuuwRNFnbqvnj+/Pj5TIcklz+QDVlsiAVe0LYCuMAPLHZ2BsEi2GeT8dGzwfMXx0eg
+wlY+/7m3YqFvs36piFAUVc+J0wvSLwOOARfV1s5sUKQ4BY1idjaZTJ5NJhP46U76fXbqvhK/n+HHscw5eomfk+cn+P3i2cnLF5jy
+4vl4/LIoJhPRNNLAdS38tw8aG/DrmZYiN63XKEr2nYze5LGjh7Mp4/Qxi+tbD57KZ5nF6Tt8tJLOAUX+F5Bf85wuwrPctFHrjcXo
+um1D6UvvsUK0CGuG/AYhIqwd8hMEibBwyE8hfITFTCY8OT6B7/6TkxdTAiKGG68Lj5DK1qCU31XKowIHKLTqKrRp69L5WCKFdCfD
+YY+IsSLDYflL/ViIshAvgDhS+iM8xg/IwZnsyVUnlilVtBAF066COZU0EuXyrnJxa4+yksxI5XNefk/GmHBVJUwwYV0lHGFCUiUc
+Q8JBNXJYwC2/X0wegRF+UWH8Ar5hLJCYuVO6JKE3+cppOiihcLNoo8+HOZxyYovBwQUPQg67o+Lc01YeSut6KdetonZyeAg+bFDv
+FxG8CNdd0C5CqzxoYbCPmxVrHUrmonufK/WINGMOmQ6vnadkkFjVGe+GchuUpqfDK4cgtFZOS6xM78cl1MLS6R6lb8rSeSuqgV74
+oo391Nienx+9PCBwphHfnx29Ig757OhEEG7Fcr+rAZGd3tt+TmUrEEt3qqQjy1i7SzZul+DecBTwv/vNT75662Wem4xufKpS3kRb
+vOkmo5sVJr4L+GXI/iSPMhEkFnK8qxTz3kb5RTNPAPuW19Nzmo3yJPhxE2RLL4HlREv2narXaG5XPb+q9y3fs05e1dGpg/yrZygi
+tTHT6GZlJQbPY8WvvWzz0J7DP7kJ4GEkaAGwPy1aKhtEqWfsQxSNIH+Ss+SmhSI7qGVUbFBiB5WMigYFOihjVCh73UKJRkFFhXbi
+iOLlyr0fQwhmQPqtNAJqUPbhCsERu6B8y/eAkO+CYPDHqjV7Hy7RoX+ZeJfbh5AK9pw6xZrA9qRYCczfBexbvj+gfBcgk9NaCNtW
+weC4dmrvkFJWerAMwi9l7niaNfVgM+V9imuqF9miVBoKDgJ+wO1EmoTRAL2Ez4OFy+F9qDRj182+5W51j4Lvrvd3gRIko70O9dz5
+QpxIpxFExprYlAkZ8EsZI+sIbk+ZSDtB1Sbr6MVL+WS39d6zpZ9vY5bo+qYpzal8DV9NDZ1w8XTNRl9sgyxjiU1Fcoo2MMHoHUsC
+D+wvypy837dyNxv9jMUJSxnXKoX42J+MfiY8vcGDlDuXjS9ohzy4fOSF7uNg6IiBE8kV+g6BQYEuUqhBI3clz+0TIMGYBm6kBjo5
+DaYJKD270TxZ0EyrKw0ns/K2ORLaZVIfLaJzzVqx9DTuCQiNMeM0QYQTeMRjLgR/XSkk2CmfMkRiNWcLmkiFlQp6JaS7s2zbtim7
+wydWGMW3YgDrI2pVwS43QWrvw1aKqXbMHWzFtMJXUyIREeFT2ShNxJRIQHEuWWjBLvY3/F8uhQuBpVkSdL1u72rzhuYwGaRZjimo
+qBTfx+rZXE3zU45TPVhbGaK/CVLxij3PFppCeskEw8ndVEyslzZVcw5m1bPn9ujvv/7JV/iILwZGzhJMMVZJ7RFCU5EuO6vTt0NT
+utTvyygD+3ErsY02W99T9JD7wuOnUDhxmXrBF1odejCHzMsYaI1pn4KA7Dpmq2wpICzTzEsyCeUKUCyr4KcGUb13zxf6t1ZgHUYf
+liG7YqE7FilJFGVLfNe7xndCgQr7Vc74ijUytl4cB/yyWSHYxiFbvmc3jaww4Ew1torCfMvVF6o0p7G3Qg0RDWERtEOlRTHjS8Z9
+5pcwVx6PeLDyQjerfossD/SOlzkP4F7rZua3XB1NGMpvTQUU3CdoJQ0QqrTZDrytYvIFS7OlJPvktHqhVL/Alkb9do60OiISq4zI
+enb0uUaOmUx1Xo21CkDXJb6wu1K8YKErlTIZXGAJUQWoJJ4n8vdMLyPE5wg08y6XccLWwbURwCROWOwlzF96fLWJkraczNO52uNe
+eJMGqcHoN6HwtST06ahHw3LNdcn5+OA8A/x++xcgUj56hX9PCPXcW9IjKJgnmXfp3HjbcBQll/RoPD4CcX+vR0CR9BaETvBsSMnf
+Ig7xILTFBXEIqFOfZ8QhGaHi8RdDYVwRh4DO9PmaOGRNMEyGQxJCyd8hDmFCww/jaJyfE0dEyfjtXxCH/DH8+A1xyBIqIarEIV+p
+jxPikK/JHdUW4ZEfpLGh6mN6bm1dAoQgUYfCtkHWVGrFHU9bFiqVIdBiEXpQnDF/uY0SthRlLHtqV1VcvbZyx1VhAh8CaG21qcyV
+TDzrrTUcUU01sbbettgxMEKUlgxKQYYFXjB6K2Nyv4M1EYOVz6p+yi5COHOz1ju5nu2qddSo9ROx2FWV+v1GrWPbbiFBvfdVsFma
+wCEARy5yx9QzR0Ado2CJTEq3C1N+mky53Ehdb84XNLuPKkVRK/ImCkOG2GhEiAYDpwPUF9y/D5AqMouGwzoYoRqhSqC7aAxcXfqF
+qMyMmnxwygYTk6oBXyXMS5laWrv9caG3bxWrQ22IGhOLFGN9V6ts9dtluFPO6ku6M3aYLBKyNJWB0B2t5sCt16nN5ObO3rU2IEna
+qKoNoNWriuq6peonCcqFBmqq2AIq222pLSn3IQkyZuBpLA3GOWUdJGm2VOHyRWkZZUUaUYmCzD+o94AeXOTZwWWUEe1EY7fSjEfZ
+BnDuIletJVn8AQ20daOzNVHFLIz6nW2Qu2Aa60JOQ7qi8bRUMBS83JvYGub3zXsRnVkbVqlbXBRaWuZdgr+o2ulKnWzEyAfcD1Ze
+BiQdjUaEliecMpvxzLJt2myr39cKyrSlMLUJrpilHx5UdgvGtt1yLolAc5Yzy7NpvT+wpMZu8zY/ZW6tqNgfoXwuojCnyu43t8s4
+7rm2x2k3vVEa4UyAi27oxtVFN8SLLndjuOiu6k3iml3vDTgT5zpRIbODTpC18bgfMovbtJElQIJmZYm122PGoLPrOAxWQVYU5mm3
+KJq0b3CLqrRhq/dLto2zm5KnLS1ihckbtJ2dhsOhxk7aMbybvZpLTjmj4P5yt9eyWW5X1iMyvrFMom52F8ZyPepYF5sz+QELV0kM
+wzrv3t2kvtXPugfRZKFPIdg6zNONJJh1//Du2kvq+H8MxYB9dh/O9QNwk9w21XM4KFfcAkwM2d+xjfls7/OLeTvvMUzouqP3WJnY
+cVvvMZXWfW3vMS25c+d5HQZeqp2kZec8SLbUeLXMxJUXeok6WHYUaj2qquUQreHlZdQi/TLYiczIvEvLpns0b6CdYvouvDsuEJU8
+ZeeyisXwFtyykKoxs2wDJ1GnzHP0vIswWr1vZjbxblxhHg9tyUhtWJdZLUhXeeLw3DG3wcDyoZMamW/n+jcSfFMUepsi7SBIMawi
+GrivA+YfrKPkAEHWeUwx3+ekuX6pNQNMJNpP/shqXVjW7jnWLdDTEX4sdRQUwxpiPvPGE8Uq+yHoGVzXhWVj3Z/Dqk9vK4GehnLF
+a4OBSLmvk10XDAO3ZZCx7Y5jfLPwQ+5Zsl5tZ7yHzlVPh8OOs8+C0N6k2U19WGzHslrnpBCentVEhnbruYnu3MCatGnbxlQBHJk9
+uOV3ReSGILdBdapRfccp7sHjtLO1/7cGUS7Le8/42x9uxqtTzHt2c99814o+gBHlJvnok/3uh5rsvdablNisq7OcZc8sA4FWjlIk
+lPWEG8cWxpLlwG951YTgsq6JMdPuBnvhsEfjd/YeXP274YfvY126+71Yl/4/v93Db3pn9l5SnfrY3ofue3ZzH7L3o9qB42PtXq29
+HP8QvTTvSJ0vUcxtuyqrKKjae3THTla9Bjjsvu3MROm+E2xL6Xuk0S01OkXSO6G3SyWAO3vKPcGjHej2XcZ2ihTFaUQbrfrhooXL
+WijwkSco42a779VuL17Z7+zTKLsXn2jl7+GSVsgfxyMftbd+BIs8eDMye/p97UYfx6OPsT919+9RN6gGw3zC2n3PFnXwwE3n02j/
+4K60vZV07kL7P/D1++jaqfFm3u9bDeUJu0v4KRwpuy6TArHqO/MuwdA/2CpJvwz2KFivu4f3iYz2kqn2+yb+O3eXblzuWYj3EZPu
+xKS2hrUhUi0KOw4e4045dSn9VB6vGyJMUMmtNBhqSlplFDcz2Sgr06wGZBvDn7fVl5xm0wZOyDXWowv97Y5eas3paW79WdQyUWzr
+WeZdlt3aA38TIaXqppBR31runzIlq9VfWXEyVdioeiNRtESInU6OXu0gbPUIUxR7Id8zm8Pp0kjd5mEWhAHf/Vax60Wgtk6YcvPu
+E0SDFVG/xupUQ4RQjt/bHGiH2b69gB/itvLVTacV/XYqASM31oxgbdXVGPZhV9QG0WiEbzmKMvjhylGMolRxqnjxARmE1avfAgUs
+4TOfuCZUDT21fcjgw737C04WpfPYlkFXGqb2tL2Rfl8/g4K+aEf9OxUceFfXWtrYC7f6cVh/ZMq8y8YLEzG00B6+urEuMVGjbgsj
+KrTbmbD5SiX4ULpGe8Q18OO5k7m9jkdsTetYOG8DhV6NUj6aay1/hWZWVtsSDEHapHM3L2Xg5FOrnqLp2AOqnxnV11Hod9QzahVG
+rTDIWOKFO6spT2tatTj0At6Fo6E3s1tvu3bGaQxI5zHnEVlFmL1rGcbTtJrJs3KwjUK9ruWpg4fqSIrNsijMxHKvhCgEVk0Q3O+b
+hYXJAObjsCik7qshLiJYxZ5p3VDzhTVoQnOhskaKM0IZBuzr95uN9Tr73olGo32niRB5Snpt49Rrg2pMph2j0UF3HG2Yn4TUVzJD
+wbBd57JSlmOga85dNp8saOJmAzIiA04nGDgoMVQUcp7mcRwloKUANkkHsgUCPgFbUah099qxoAEqI7KiqO8YotbBNk+zA+m5HNmQ
+2JQItxoisLf4XcVM3w0JdaoOPO4fMO4ffAiyzcHT3lMHOIUGJZgJHZaa6EFdE52MyalrZW4AwUv6/QxdtxUFeU1O3Ux8/wP49qrv
+PyVFodhyuCQ0E0EmdUQDfuWFgX+w2niJt8pYcvAUXUM8FdZj7KDqCWJbOYvvpLtQjHwo3UWtVrpzMNYIkCoDqxoEe5qcKv94Uxv4
+KVlQMq66/yDyTA9nPecP+u7gR3Q5+rPPn1r2fCEpNksGA8cKTuHOLg0+5IDJAKJuMnAnVOZllUJodxUuPS+Sbv7tIiAYMwi1Zbqm
+G+rjQbVJzx0MbIYMCMTus3EZ3aXLq2vRVtq8Xk2b16u0eb3d2ryRqc0bnYbTaDCwV248jxYYApOVMclWePhF5FdFsSpNFuQPyA3c
+Nl1f6JWku6pl24g48tTaxSBppxuNizbfFxcVhehBhotHz3UDwVdrjUk2Etk1Msm6i6+6q/huxVk0mEGYu4HvkN4pGfjgELWd21pu
+idV63TJfRfmOuQrTlHUtXuyHXbwEorsWLvMI5Op+l4EEXM63VJ9xdEuv6CW9oUt6Qa/pB/qefkHf0Tf0NX2L5MqEx3dmZSAD7U3w
+v7H6b2JTsHeka/izhD9vXFHmEv69gj9v4c9r+LOCP+/gz1gGRRZzAnW67X6/lgrqwBAtMgRbzjUaSV7Az42LG2tl9fXehRtgeWrp
+NAh8j0cZ+gEwuIY/W3dMt67vjulNCW/qn95Mt+5g4Nuxm823YgJvZwr6Ezpf3N71P+8VZ0/PyWd/8AtCYzkn0C9V3O9vZhagC6QZ
+246qOHNkyX5fZAuX+hhJVJWhMwAuy82gFNgpAtRZow55Ipu7UAAxoyJDo/+q9SWWk9adMQScIKcAKD51yZ+RorDIeb5m6zXM7BiW
+q9/+hSr5299URf/Xv/sr4PP//etfV2nocXNmKeNbw8y1KKw3AvU3AoED2TMkMAYOhuStNrgYwvMtJl/3+9ZK/IKh+2DQtqOvFeir
+dtCvMflDv2+9U6DHyB+2Y6lmJsB2sqFEwKUbdzs4OtN58AvglcFRyYUJ/QK5Tc1ViNlFA/iTwp8I/qCRsnVZFFdF8bYoXmM4bTfA
+Jt+i1wT4tZKpIi679a4o3thlkiiy1Cqu8Tf8CnEvgV/lDJ7QJfVoQFMaoStBfflommzpW2iwNm2/+v3KCFMmVTM3z9bDyQtSymQ0
+GzLRTMlhpnX7Lox2GCeYRgFtMErhRsMrowhL0OJJA5TopbyvUlODWwh4A4PYnk0r9B7XPhsW6epKaBip97j+bet27oMqTl+XIbtB
+0mQPWtbtHyunJRzOXsI8t4em4BWq9ow7YyXN07CtaXUYn+Jw0KuRyFT1qOzILaPjp5nBN6adP3O1EAVDnVwTu3IBroNzsxZisT2I
+VeHXJnR+iA8CgATHnxYvBgZWgvpsxpxKR6bCYg+UG+ZynZibs/EzvIqWTN1qzKkPV1vbhvmZ2xoXp63lb17/0QFG4gN/7p/QvCEJ
+qDUvjj9J/QCkOalBgY3btD1SMvqnQu8IAyck4sTjueOpd1qdD2VgCjb3FjS2xVAG/b7Y5YKisNYY+8Tr9zU2qCtD9fvyiXbd73u9
+KlLKrOW12rGi8kqwpl5t8YiMxcMgelQjMxzPPXuqhNuJJvcPiqJ7jw3s07Hw4KKcWs7Xi+4pvjKxTfHWVPrZSU/DaYo2kKt5ulCn
+Ez7rgOa0p3N7qmWU7/pr1xNxF5vdOmjrlzjPoetE8B61PvXQBv1RqC2ILYGX3kMknKMWAOTpU9ICYzBRT6mBiLAlGI0m7q4RwxOw
+Nxjc7dTVeIq6Gm3TzBB+d04zb/+pBZ4yIBPOL+NpoE8ojgFdqITDyzF7Ss7PzZ6J08RTysXI9Sw80nJ0Qo9H2lZPLOI0K0sZp1mZ
+hqdZG09bpwEGK1Ic4NFg52a9c2eGvqqx4wiWwy0vnKEj+nDOFw60/j/+ikDKNRlEo9jzlyFbZ1Y0yqLlhl1b3IarPT2yHYGpKJzv
+LPwMItmff7uzDARL/JSOQagXMOkdA8WCU6YftwWL8qLwznBe6e1YwdCzm8thjeYQsJVQMRpIxY9GtVv9R9vTJy17+kReXGChR/wQ
+pY/FA2Rug8F012zEGdI+G8Vj0E7xmnHdF4IucbjzWcaSLS6d+EKwCXiWliGjGkvCGRl4OFHJAHrvVeJitYbpB9Rx595Nw/LqI4On
+gfgMwidNo/pumrgMJGhc25CSXRtSUm5I5YtsgtdbV/w45xiYcu6rh5tW9GR5Gpec59NI7FerSsqXnq5wv8rcWNuvsgfuV5k9FetA
+0q4F7buRDBiMEW8goFC/b/l4koh2niTaTwyB2SeDa4Nurg0aJwbfjWynOSYHbYMiHpAeq2355pt0HzQAt4qsFnd3cYy4zssxt6mM
+UAbyW7VFrtsvB/igut/U+4RpVzzWtONCigaTLX7kyaYf7KLOg13mrr7HibJWE2U/1BssuS5Z8hOZb/1A5rNp3GS5uI3l8Al3v5sN
+3VR+YKrzl3S32HANsJOTNNmHFcE8+YRrxp7767q8ZW1cT/Fr11VrbYt1EU4Em/1uWDs8VdyLnHHr2nzarWvTvHU96LK12euytfmh
+L1t7jvLm4Xcy27iFfRL1BfGr+9P6IfcnOWM9Y8bWPPe1LfPdu0UpgXQJoVKfoHp7Gh7RCN6EBwN4PJY/dhArk4J+3vQnZtPdLDbj
+A5cMiWPpcVp3raWRGhioOIBnM5MQunC2S82HqPQy0KRVGmxAPVtAmUlopfcHsyVWc+aqOSSeykiuuCBLGWfiljcwmRK4XPfvas8C
+EYNQNGbPEicaBTyN2QrjdKFYPTNcyVoMIriCKItjMME7yu8sm7KuGHrUOC5IF77y/U7J4uA1UskwS8UV5fmprhTkJnq6pvrjBnqG
+KSyL9Czzgu81wLlp5UD7I/wYZ9pmlVcuslPprjfA4Igv4SYJP15JB74nL4T37Gcnz4RrX+EoG1Oe6x6zw8jzTY/Z5Y4oMPDbZP3B
+6GcM6pn+rz3QheW8Sl7hE1Qy+tpL0io1RukLG6G3cy19jatKpDnRFulwqd242eiNxmnUd+caqp0+trUNH8+Ufs2jNhDQrzuCbKjf
+CMUb3Z92zZt2anrTTtu9aef7e9P2m960/Yd70/5KDG59tD/Om/anedKOfghP2lHTk/YUpsPJMzkbmn6tNWfu36Nv66D0ba3uLX/o
+pUxr+56YodPMrZ46buXoSvVTqRCamd9mkcqzkJu1JIrCSYmOvxQBa1NXtSW+l+8Zi1lS+s9Fdz1odYNKq2VQx2qLARfCywpwki4h
+GT3w7i4m1M6S9pK4qu8LtqWwCdzz/WXSNhSNl5iuMAcW6egnqmPUqnaTRK0T+1WojCb2R0p1fD+8NDLtjZqq08Suoxtm4PV9QCuV
+qDs5do0B/vgR7GKsTnrt4MRdJOuqtueY7mDph2C65/juqNkxyl3d2znWO5rRR7yqVRZtcdVdBcc1Vral72VeiUCqQnxYmf1IS2A7
+gths612mPNlNlb5IcMmjhAnnaiy1mG23tFNegoeTnltqHxgYlsolzLYrbRQNTA1/FTGijQaGekFL/twEtRAC2FqDLSga2hpGjmQU
+oIqykHDrET9VhH1aBd2Xshtm3EqoQaSuZSj00uzHkmQZPmGlXRUUW4I+o4oaY2sSy51zgHJ4t6NR5W48OI2m3B0MAnA4nguH44Zr
+9Ny+TXdD1jAStil3xqEdTECaROicpTop5FXOnu2PgfNgeqtG9iW6egtMZ6lxmHfghJhIizww6kVJCQWDwa61o6H8qak5BW1Xn+bR
+Cy9h9YYRDDQu/hhGvOWcUHLEPaYUPN0FnX1oWIPrvVBLjPR3LRw3zxc0V1jL2hLv+HHxzm2hxQdyybRSGvVO0ymIgyI3A6Fk2LpQ
+RzYN9YmgU7goQmXoIpX2pJAptJvDlmu+NZU4Y+V2HJHt2coJbJp3Ubth724QWyM1vGPohJaG7JLOa6rU1z6FrpUmvnbH8uRM6qas
+Z9NVa0b6IJKvuouuakXlO9E8pKtFpc3Oi6J1cOLuwYlbBsfcNFt0uHqTO7wYNy597fc9TWGSf9x9j8N9j7fyz0UUhczjnZpm9bXJ
+agnGAjAIZTOSJTmDsCpemLK6QUkFCGfCp7SHOy4VG++OViAAdds5pzqPtcAmA4t9NnHd8YwEHMLHrMPIy9CegPVwvx9xjxPnx3wd
+8CC7EWeAUcDXxBmaiUORqscCp+19zBrqvFoRrPpJ9EqzhFDW2YIH6pj7NKDs/9uaYL/a1YTvZeyTupAFW5Zm3jYmFOj543c/VSTt
+bFOsVXu0qlwXtDS79eJd3SojwButSGk1ipfrEh2LgFAyyg5KIAceP4g44uockEG9sR2LiXG5ENsLrHBETmniulbmqnMpBIQSUwJP
+pkVBBGPhl5B0t4mCmmtUXUJRtdcsumPB2QewmOd7QYWi+4FEEuwLFArvA1bScj+wovAeYFFPej+YOIf3APnWy9h+EGHK7gFQxNzc
+D6SYkXsArazQ7wNZzsCPfsv4NEmy90NIkr0WSXKmQiUqkXKghSltES1XEUS/R8ly1JAsV81+jFC5ClVU/izdcKERv7R0yWoJtUKM
++3oRxn1RQJmHl6FPyjAtcDfIMFqMIZrx0fFXJYURVn3VN1wjpQ3jMvDLwIdhlDK/UyodxYzXbHWkcEGrbQg92DbILLG/1EMqWa1K
+GxKD3kS+pGqJM4BTYw6LlD1G1/iiJLGdPcp6YcI8/+YAekVq91yEc39Xq/3znrZgH4V2ADcQzvT2Jphy31Wjz9jEt2zNmC2PjPRH
+AVEjou/+Pbf0n6L1t+lETgo4qPynZT4Zs6OaE4qbJINzvDnWpZdmcgsiivQ70WDct237E2efOZga1ruFn6aBLQwRG+U8+FXOloFf
+CiZl47YxAFbmGpnz0NXqLmbZPFw48EcUu2ScJV5WuqJiFUNICHO9uhA9stotV5OXVPp86DWFbuC+z1HUsapekVMRTtBdgWhvo7k+
+1Mc1qG7FmzsggdmqJjwQjcZloz40CnqKuW5Tn6NN/VrY1HvuGjRktu4aDH8bbXs29TvQ2lZo+Xfm+NaI2XLuJ4FPBkGlOz8YtLCN
+1LTvWAjq7AN8I1iolXmEdTYMauR2DittY68603ctZ5UXOCuyK/apVzf5SBm2+SxdMQ5HL6HjgNHWGwxWilHsVAlpsLil51E1/HM0
+LF/Y1NurMJijL0Bte47uJ13XTan65S0ai4jm3syKREEaluDMFYVJJ1CVkt69Uyd0Vdt13LWiZXOllqWOYMOvpYZnHT9NkATWzQpu
+4ObCSFlNn/x0Mw3cwSC3V+4WbGraltwVZTSwp10IlTveXQc1GlO6kxhayZ20aDjW3JcUVyUpYndMffdKESI+9afxYGBfulfzGLxm
+XMI6cuNeziftRFlTEfa5fZe6oYyu7WkH3hXF9Lnn1aaMLUR5H6PDMpXXC6F4pPSjGncOqSUlVepECHo8/I+VrQ+qzqXi3G+ECi+v
+CJgotauw6itZUOyo4gaBCTFqRymtLTnysh6qdunMkJS6XFLzjXNZUMHPoveMp1jsVXVHKYu36kMHDfOzRCoPgRQOx8jCd475Ysql
+E0psB+IbB2Ln4KNLlqnUyg3OndbJR2sbid1oW6Y22pbj3dZ6zW1ad/OiBakGiKxsm9CXXhi2tfBR/RMN1LonEhu9Ax57vK7hI4Ct
+gX7UfgnotX6JxEa/zCDcMpazaLzc96eldQGavb3FmareZCNUfga3BCmY0AFGGJsSF0i4klAP0UyslEb2NEtu5CIMZkvlQ1V4upqG
+g4Gdu3CKpJ5YtnL7bh1wLwxvbj0VdNwqly1eFKiJG/DLu9oyqGtaojCk5pQ1kEYWXV3JtBUVVj84UQo49ZbMgfvh6Ofh9dqyaY4q
+9Fm1pYbTHL08ZfMcCFliaq3sqScuZNYD6Qpr86eTFKC0U1Pl/E4JeR9HlrI5K/8IQt6zj6rvg0R3IGwFFrPtuzIzKO91bjRnCxQN
+WBmYtlYXbHmtfiOk8euA+wfbyM9DBp6b2IA8HRHNbRgAi9xbMjr0wnD0y5Q4Ry+PKHymGfwvk45VEqq7yMRnKvGX+TYeZtEw9rLN
+6JfpNWQ+x8w82xx6iHuKlSYvXpXpOqgXZWrC/HzFklRmvCwzUhYyODaonApQGrPV8EPixUO9qaOXJ1DCZywehgF/H/DLww0L4xL2
+q3EjX0Pp1aSRG3o3UZ61tPTqqFG2A6dXSEg/+sBh0R/miaT5K6QlSxKTWkevVDKehIZZ4vF0HSVblqSHmyh6L2s/31HM+OBRNozW
+Q7z7iaov9q0ae4m3ZRlLhhFn0VpWf7lvdZgG8VAdzGXtsns64U9UoskKJ2OVXuOEExwpMTg1ZjvRcrQmTo609Forx1pWvSEcpDC6
+TA1gSPzIS4/Nxl+Oq/Q82wxRdT2F/EPkizrwF2VxPOpwxrP0EJaMC2/1PpXT6uRlW6lNlsVDaESVetVWSsf5pK1AFLMEzaCQiwWs
+4/G4rWgCl680G15E/s2Q+UEWJar85L7yquBRW8GUJVdiMLDMcVlGn7rHVXLVqePxszLVGNPjcTVCJtGPxxXRccJqY1Qv+bKzZMto
+Ho+rEcBsrYfIDEHGtqqPJ51Ftc5Nxp2ltl7yHlYUWXDSXTDyWShbnRx1Fot4GHA2RL9/XhYlwwvPv2QS+nFntXJ9UIM3edZZVkpg
+h/jwrYrjKKXeNg5ZerjmchZN9FSdIDh0MBa1aXdUpuulX5apJm9MXpUZtSGcnJQ5jXX8+GgsMsMgG8YeZ0Ogbftkm7w8vqdsLUvS
+42jSVk3r05Ho6Qfv8pIlw18a1DnCNvMsCI1UHJGrgH0wUp+XqUkUZcOAoyqUzHxxN01G79lNWwhi8USKuRac5RJ1fXYDilFvoiRL
+3YQmo8B3J0eTo9/Hc0/Hlrhju2vfyh6RTse/j3S6j6NLtn1EQjwzCWHffto3EJJbz8cTW2sNEsb23cK+s6d/+/DwyUEa5cmKSVnZ
+tz/7ylVTLA+GFzn3Q+gqhNb7vwMAnYCrBrovFwA=
+`,
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build ignore
+
+// gen writes assets.go from the files of the given swagger-ui-dist release,
+// downloaded from the npm registry:
+//
+//   go run gen.go 3.5.0
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+// The files of swagger-ui-dist used by the docs page
+var _assets = []string{"swagger-ui.css", "swagger-ui-bundle.js"}
+
+const (
+	_registry   = "https://registry.npmjs.org/swagger-ui-dist/-/swagger-ui-dist-%s.tgz"
+	_lineLength = 100
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatal("usage: go run gen.go version")
+	}
+	version := os.Args[1]
+	files, err := download(version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The bundle reports the version it was built from
+	if !bytes.Contains(files["swagger-ui-bundle.js"], []byte(fmt.Sprintf("PACKAGE_VERSION:%q", version))) {
+		log.Fatalf("swagger-ui-bundle.js is not built from swagger-ui %s", version)
+	}
+	header, err := ioutil.ReadFile("swaggerui.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var out bytes.Buffer
+	// Reuse the license header of the package
+	out.Write(header[:bytes.Index(header, []byte("\n\n"))+2])
+	out.WriteString("// Code generated by gen.go. DO NOT EDIT.\n\npackage swaggerui\n\n")
+	fmt.Fprintf(&out, "// Version is the swagger-ui-dist release of the assets\nconst Version = %q\n\n", version)
+	out.WriteString("// The gzipped and base64 encoded assets, by name\nvar _assets = map[string]string{\n")
+	for _, name := range _assets {
+		content, ok := files[name]
+		if !ok {
+			log.Fatalf("swagger-ui-dist %s has no %s", version, name)
+		}
+		fmt.Fprintf(&out, "\t%q: `\n%s`,\n", name, encode(content))
+	}
+	out.WriteString("}\n")
+	if err := ioutil.WriteFile("assets.go", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// download returns the assets in the npm package of the release
+func download(version string) (map[string][]byte, error) {
+	resp, err := http.Get(fmt.Sprintf(_registry, version))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to download swagger-ui-dist %s: %s", version, resp.Status)
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte, len(_assets))
+	r := tar.NewReader(gz)
+	for {
+		h, err := r.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		name := path.Base(h.Name)
+		for _, asset := range _assets {
+			if name == asset {
+				if files[name], err = ioutil.ReadAll(r); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+// encode gzips the content and wraps its base64 encoding into lines, which the
+// decoder ignores
+func encode(content []byte) string {
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	w.Write(content)
+	w.Close()
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	var lines []string
+	for len(encoded) > _lineLength {
+		lines = append(lines, encoded[:_lineLength])
+		encoded = encoded[_lineLength:]
+	}
+	lines = append(lines, encoded)
+	return strings.Join(lines, "\n") + "\n"
+}
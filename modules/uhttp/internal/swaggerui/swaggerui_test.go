@@ -0,0 +1,47 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package swaggerui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsset(t *testing.T) {
+	css, ok := Asset("swagger-ui.css")
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(string(css), ".swagger-ui"))
+
+	js, ok := Asset("swagger-ui-bundle.js")
+	assert.True(t, ok)
+	assert.Contains(t, string(js), "SwaggerUIBundle")
+
+	_, ok = Asset("index.html")
+	assert.False(t, ok)
+}
+
+func TestVersion(t *testing.T) {
+	js, ok := Asset("swagger-ui-bundle.js")
+	assert.True(t, ok)
+	assert.Contains(t, string(js), `PACKAGE_VERSION:"`+Version+`"`, "Version should match the bundle")
+}
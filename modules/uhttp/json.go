@@ -0,0 +1,268 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"go.uber.org/fx"
+
+	"github.com/go-validator/validator"
+	"github.com/pkg/errors"
+)
+
+// ContentTypeProblemJSON is the content type of problem details
+const ContentTypeProblemJSON = "application/problem+json"
+
+var (
+	_typeContext  = reflect.TypeOf((*context.Context)(nil)).Elem()
+	_typeError    = reflect.TypeOf((*error)(nil)).Elem()
+	_typeResponse = reflect.TypeOf(Response{})
+)
+
+// Problem is an error rendered as a problem details body, see RFC 7807
+type Problem struct {
+	// Type is a URI that identifies the kind of problem
+	Type string `json:"type,omitempty"`
+	// Title is a short summary of the kind of problem
+	Title string `json:"title"`
+	// Status is the HTTP status code of the response
+	Status int `json:"status"`
+	// Detail explains this occurrence of the problem
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI that identifies this occurrence of the problem
+	Instance string `json:"instance,omitempty"`
+	// Errors lists the validation errors of every invalid request field
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// NewProblem creates a problem for the status code, titled with the status text
+func NewProblem(status int, detail string) *Problem {
+	return &Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// Error implements the error interface
+func (p *Problem) Error() string {
+	if p.Detail == "" {
+		return p.Title
+	}
+	return p.Title + ": " + p.Detail
+}
+
+// JSONHandler adapts a typed function to a Handler. The function has the form
+//
+//   func(ctx context.Context, req *Request) (*Reply, error)
+//
+// where Request and Reply are any types, and the request argument is optional.
+// The request body is decoded from JSON into a new Request and validated with its
+// validate struct tags. The reply is encoded as JSON with status 200, unless it is
+// a Response, whose status, headers and body are used instead: a string or []byte
+// body is written as is and other bodies are encoded as JSON. Errors are rendered
+// as problem details: a *Problem as is, validation errors with status 400 and other
+// errors with status 500 and no detail, so that internal errors are not exposed.
+//
+// JSONHandler panics if fn does not have this form.
+func JSONHandler(fn interface{}) Handler {
+	fnType := reflect.TypeOf(fn)
+	if err := validateJSONHandler(fnType); err != nil {
+		panic(err)
+	}
+	return jsonHandler{fn: reflect.ValueOf(fn), fnType: fnType}
+}
+
+func validateJSONHandler(fnType reflect.Type) error {
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		return fmt.Errorf("expected a func as input but was %v", fnType)
+	}
+	if fnType.NumIn() < 1 || fnType.NumIn() > 2 || fnType.In(0) != _typeContext {
+		return errors.New("expected a context.Context followed by an optional request argument")
+	}
+	if fnType.NumOut() != 2 || fnType.Out(1) != _typeError {
+		return errors.New("expected a reply followed by an error as return values")
+	}
+	return nil
+}
+
+type jsonHandler struct {
+	fn     reflect.Value
+	fnType reflect.Type
+}
+
+func (h jsonHandler) ServeHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	if !acceptsJSON(r.Header.Get("Accept")) {
+		writeProblem(ctx, w, NewProblem(http.StatusNotAcceptable, "responses are only available as "+ContentTypeJSON))
+		return
+	}
+	args := []reflect.Value{reflect.ValueOf(ctx)}
+	if h.fnType.NumIn() == 2 {
+		req, err := decodeRequest(r, h.fnType.In(1))
+		if err != nil {
+			writeProblem(ctx, w, err)
+			return
+		}
+		args = append(args, req)
+	}
+
+	results := h.fn.Call(args)
+	if err, _ := results[1].Interface().(error); err != nil {
+		writeProblem(ctx, w, err)
+		return
+	}
+	writeReply(ctx, w, results[0])
+}
+
+// decodeRequest decodes and validates the body into a new value of the argument type
+func decodeRequest(r *http.Request, argType reflect.Type) (reflect.Value, error) {
+	elemType := argType
+	if argType.Kind() == reflect.Ptr {
+		elemType = argType.Elem()
+	}
+	req := reflect.New(elemType)
+	if r.Body != nil && r.ContentLength != 0 {
+		if contentType := r.Header.Get(ContentType); contentType != "" && !isJSON(contentType) {
+			return req, NewProblem(http.StatusUnsupportedMediaType, "request body must be "+ContentTypeJSON)
+		}
+		if err := json.NewDecoder(r.Body).Decode(req.Interface()); err != nil && err != io.EOF {
+			return req, NewProblem(http.StatusBadRequest, "unable to decode the request body: "+err.Error())
+		}
+	}
+	if elemType.Kind() == reflect.Struct {
+		if err := validator.Validate(req.Interface()); err != nil {
+			return req, err
+		}
+	}
+	if argType.Kind() != reflect.Ptr {
+		req = req.Elem()
+	}
+	return req, nil
+}
+
+func writeReply(ctx context.Context, w http.ResponseWriter, reply reflect.Value) {
+	if reply.Kind() == reflect.Ptr && reply.Type().Elem() == _typeResponse {
+		if reply.IsNil() {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		reply = reply.Elem()
+	}
+	if reply.Type() != _typeResponse {
+		writeJSON(ctx, w, http.StatusOK, ContentTypeJSON, reply.Interface())
+		return
+	}
+
+	resp := reply.Interface().(Response)
+	if resp.Error != nil {
+		writeProblem(ctx, w, resp.Error)
+		return
+	}
+	for k, v := range resp.Headers {
+		w.Header().Set(k, v)
+	}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	if resp.ContentType == "" {
+		resp.ContentType = ContentTypeJSON
+	}
+	switch body := resp.Body.(type) {
+	case nil:
+		w.WriteHeader(resp.Status)
+	case string:
+		writeRaw(w, resp.Status, resp.ContentType, []byte(body))
+	case []byte:
+		writeRaw(w, resp.Status, resp.ContentType, body)
+	default:
+		writeJSON(ctx, w, resp.Status, resp.ContentType, body)
+	}
+}
+
+// writeProblem renders the error as problem details
+func writeProblem(ctx context.Context, w http.ResponseWriter, err error) {
+	var problem *Problem
+	switch cause := errors.Cause(err).(type) {
+	case *Problem:
+		problem = cause
+	case validator.ErrorMap:
+		problem = NewProblem(http.StatusBadRequest, "request validation failed")
+		problem.Errors = make(map[string][]string, len(cause))
+		for field, errs := range cause {
+			for _, fieldErr := range errs {
+				problem.Errors[field] = append(problem.Errors[field], fieldErr.Error())
+			}
+		}
+	default:
+		fx.Logger(ctx).Error("JSON handler failed", "error", err)
+		problem = NewProblem(http.StatusInternalServerError, "")
+	}
+	if problem.Status == 0 {
+		problem.Status = http.StatusInternalServerError
+	}
+	writeJSON(ctx, w, problem.Status, ContentTypeProblemJSON, problem)
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, status int, contentType string, body interface{}) {
+	w.Header().Set(ContentType, contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		fx.Logger(ctx).Warn("Unable to encode the JSON response", "error", err)
+	}
+}
+
+func writeRaw(w http.ResponseWriter, status int, contentType string, body []byte) {
+	w.Header().Set(ContentType, contentType)
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// acceptsJSON checks the Accept header for a media range that includes JSON
+func acceptsJSON(accept string) bool {
+	if accept == "" {
+		return true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || params["q"] == "0" {
+			continue
+		}
+		switch mediaType {
+		case "*/*", "application/*", ContentTypeJSON, ContentTypeProblemJSON:
+			return true
+		}
+	}
+	return false
+}
+
+// isJSON checks for application/json and the +json structured syntax suffix
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == ContentTypeJSON || strings.HasSuffix(mediaType, "+json"))
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type createUser struct {
+	Name string `json:"name" validate:"nonzero"`
+	Age  int    `json:"age" validate:"min=0"`
+}
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func createUserFn(ctx context.Context, req *createUser) (*user, error) {
+	switch req.Name {
+	case "taken":
+		return nil, pkgerrors.Wrap(&Problem{Status: http.StatusConflict, Title: "Conflict", Detail: "name is taken"}, "create")
+	case "broken":
+		return nil, errors.New("database password is hunter2")
+	}
+	return &user{ID: 1, Name: req.Name}, nil
+}
+
+func serveJSON(h Handler, method, body string, headers map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/users", strings.NewReader(body))
+	if body == "" {
+		r = httptest.NewRequest(method, "/users", nil)
+	}
+	for k, v := range headers {
+		r.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(context.Background(), w, r)
+	return w
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) Problem {
+	assert.Equal(t, ContentTypeProblemJSON, w.Header().Get(ContentType))
+	var p Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &p))
+	assert.Equal(t, w.Code, p.Status)
+	return p
+}
+
+func TestJSONHandler_OK(t *testing.T) {
+	w := serveJSON(JSONHandler(createUserFn), http.MethodPost, `{"name":"alice"}`, map[string]string{
+		ContentType: ContentTypeJSON,
+		"Accept":    "text/html, application/json;q=0.9",
+	})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, ContentTypeJSON, w.Header().Get(ContentType))
+	assert.JSONEq(t, `{"id":1,"name":"alice"}`, w.Body.String())
+}
+
+func TestJSONHandler_Errors(t *testing.T) {
+	h := JSONHandler(createUserFn)
+	tests := []struct {
+		name    string
+		body    string
+		headers map[string]string
+		status  int
+		detail  string
+	}{
+		{"malformed", `{"name":`, nil, http.StatusBadRequest, "unable to decode the request body"},
+		{"content type", `name=alice`, map[string]string{ContentType: "application/x-www-form-urlencoded"},
+			http.StatusUnsupportedMediaType, "request body must be application/json"},
+		{"not acceptable", `{"name":"alice"}`, map[string]string{"Accept": "text/html"}, http.StatusNotAcceptable, ""},
+		{"problem", `{"name":"taken"}`, nil, http.StatusConflict, "name is taken"},
+		{"internal", `{"name":"broken"}`, nil, http.StatusInternalServerError, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveJSON(h, http.MethodPost, tt.body, tt.headers)
+			assert.Equal(t, tt.status, w.Code)
+			p := decodeProblem(t, w)
+			assert.Equal(t, http.StatusText(tt.status), p.Title)
+			assert.Contains(t, p.Detail, tt.detail)
+			assert.NotContains(t, w.Body.String(), "hunter2", "Internal errors should not be exposed")
+		})
+	}
+}
+
+func TestJSONHandler_Validation(t *testing.T) {
+	w := serveJSON(JSONHandler(createUserFn), http.MethodPost, `{"age":1}`, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	p := decodeProblem(t, w)
+	assert.Equal(t, "request validation failed", p.Detail)
+	assert.Contains(t, p.Errors, "Name")
+
+	w = serveJSON(JSONHandler(createUserFn), http.MethodPost, "", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "Missing body should be validated as an empty request")
+}
+
+func TestJSONHandler_Response(t *testing.T) {
+	h := JSONHandler(func(ctx context.Context, id int) (*Response, error) {
+		switch id {
+		case 0:
+			return nil, nil
+		case 1:
+			return &Response{Status: http.StatusCreated, Headers: map[string]string{"Location": "/users/1"}, Body: user{ID: 1}}, nil
+		case 2:
+			return &Response{ContentType: ContentTypeText, Body: "plain"}, nil
+		}
+		return &Response{Error: NewProblem(http.StatusNotFound, fmt.Sprintf("user %d", id))}, nil
+	})
+
+	w := serveJSON(h, http.MethodPost, "0", nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	w = serveJSON(h, http.MethodPost, "1", nil)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "/users/1", w.Header().Get("Location"))
+	assert.JSONEq(t, `{"id":1,"name":""}`, w.Body.String())
+
+	w = serveJSON(h, http.MethodPost, "2", nil)
+	assert.Equal(t, ContentTypeText, w.Header().Get(ContentType))
+	assert.Equal(t, "plain", w.Body.String())
+
+	w = serveJSON(h, http.MethodPost, "3", nil)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "user 3", decodeProblem(t, w).Detail)
+}
+
+func TestJSONHandler_NoRequest(t *testing.T) {
+	h := JSONHandler(func(ctx context.Context) ([]string, error) {
+		return []string{"alice"}, nil
+	})
+	w := serveJSON(h, http.MethodGet, "", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `["alice"]`, w.Body.String())
+}
+
+func TestJSONHandler_BadSignature(t *testing.T) {
+	for _, fn := range []interface{}{
+		nil,
+		"not a func",
+		func() (int, error) { return 0, nil },
+		func(ctx context.Context, a, b int) (int, error) { return 0, nil },
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) (int, int) { return 0, 0 },
+	} {
+		assert.Panics(t, func() { JSONHandler(fn) }, "%T should be rejected", fn)
+	}
+}
+
+func TestProblem_Error(t *testing.T) {
+	assert.Equal(t, "Not Found", NewProblem(http.StatusNotFound, "").Error())
+	assert.Equal(t, "Not Found: user 1", NewProblem(http.StatusNotFound, "user 1").Error())
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"go.uber.org/fx/modules/uhttp/internal/swaggerui"
+	"go.uber.org/fx/modules/uhttp/openapi"
+	"go.uber.org/fx/service"
+)
+
+const (
+	defaultOpenAPIPath    = "/openapi.json"
+	defaultOpenAPIVersion = "1.0.0"
+)
+
+// Methods documented for routes that are not restricted to any
+var _defaultOpenAPIMethods = []string{http.MethodGet}
+
+// The docs page renders the document with the Swagger UI assets served next
+// to it. It has no inline script, so that it works under a default-src 'self'
+// content security policy.
+var _openAPIDocsPage = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <title>{{.Title}}</title>
+  <meta charset="utf-8">
+  <link rel="stylesheet" href="{{.DocsPath}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{.DocsPath}}/swagger-ui-bundle.js"></script>
+  <script src="{{.DocsPath}}/init.js"></script>
+</body>
+</html>
+`))
+
+// Content types of the Swagger UI assets
+var _swaggerUIAssets = []struct {
+	name        string
+	contentType string
+}{
+	{"swagger-ui.css", "text/css; charset=utf-8"},
+	{"swagger-ui-bundle.js", "application/javascript"},
+}
+
+// OpenAPIConfig handles config for serving the OpenAPI document of the routes
+type OpenAPIConfig struct {
+	// Path serves the document, defaults to /openapi.json
+	Path string `yaml:"path"`
+	// DocsPath serves a page that renders the document when set, such as /docs
+	DocsPath string `yaml:"docsPath"`
+	// Title of the API, defaults to the service name
+	Title string `yaml:"title"`
+	// Description of the API
+	Description string `yaml:"description"`
+	// Version of the API, defaults to 1.0.0
+	Version string `yaml:"version"`
+}
+
+func (c OpenAPIConfig) withDefaults(serviceName string) OpenAPIConfig {
+	if c.Path == "" {
+		c.Path = defaultOpenAPIPath
+	}
+	if c.Title == "" {
+		c.Title = serviceName
+	}
+	if c.Version == "" {
+		c.Version = defaultOpenAPIVersion
+	}
+	return c
+}
+
+// NewOpenAPI describes the routes as an OpenAPI document. Routes served by
+// JSONHandler get the schemas of their request and reply types, and the routes
+// that are not restricted to methods are documented as GET.
+func NewOpenAPI(info openapi.Info, routes []RouteHandler) *openapi.Document {
+	doc := openapi.New(info)
+	problem := &openapi.Response{
+		Description: "Problem details",
+		Content: map[string]openapi.MediaType{
+			ContentTypeProblemJSON: {Schema: doc.Schema(reflect.TypeOf(Problem{}))},
+		},
+	}
+	for _, rh := range routes {
+		methods := rh.Methods
+		if len(methods) == 0 {
+			methods = _defaultOpenAPIMethods
+		}
+		for _, method := range methods {
+			op := &openapi.Operation{
+				Responses: map[string]*openapi.Response{
+					"default": {Description: "Response"},
+				},
+			}
+			if h, ok := rh.Handler.(jsonHandler); ok {
+				describeJSONHandler(doc, op, method, h.fnType)
+				op.Responses["default"] = problem
+			}
+			doc.AddOperation(method, rh.Path, op)
+		}
+	}
+	return doc
+}
+
+// describeJSONHandler adds the request and reply schemas of a JSON handler to the operation
+func describeJSONHandler(doc *openapi.Document, op *openapi.Operation, method string, fnType reflect.Type) {
+	if fnType.NumIn() == 2 && method != http.MethodGet && method != http.MethodHead {
+		op.RequestBody = &openapi.RequestBody{
+			Required: true,
+			Content: map[string]openapi.MediaType{
+				ContentTypeJSON: {Schema: doc.Schema(fnType.In(1))},
+			},
+		}
+	}
+	reply := fnType.Out(0)
+	for reply.Kind() == reflect.Ptr {
+		reply = reply.Elem()
+	}
+	if reply == _typeResponse || reply.Kind() == reflect.Interface {
+		// The status and body of the reply are only known at runtime
+		return
+	}
+	op.Responses["200"] = &openapi.Response{
+		Description: "OK",
+		Content: map[string]openapi.MediaType{
+			ContentTypeJSON: {Schema: doc.Schema(reply)},
+		},
+	}
+}
+
+// DumpOpenAPI writes the OpenAPI document of the routes registered by hookup,
+// without starting a server. Services can call it from a command to generate
+// clients from the document.
+func DumpOpenAPI(w io.Writer, hookup GetHandlersFunc, info openapi.Info) error {
+	doc := NewOpenAPI(info, addHealth(hookup(service.NopHost())))
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// addOpenAPI adds the routes serving the document of the other routes
+func addOpenAPI(handlers []RouteHandler, cfg OpenAPIConfig) []RouteHandler {
+	doc, err := json.Marshal(NewOpenAPI(openapi.Info{
+		Title:       cfg.Title,
+		Description: cfg.Description,
+		Version:     cfg.Version,
+	}, handlers))
+	if err != nil {
+		// The document is built from plain structs and maps, which always encode
+		panic(fmt.Sprintf("unable to encode the OpenAPI document: %v", err))
+	}
+	handlers = append(handlers, NewRouteHandler(cfg.Path, HandlerFunc(
+		func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(ContentType, ContentTypeJSON)
+			w.Write(doc)
+		})).WithMethods(http.MethodGet))
+	if cfg.DocsPath != "" {
+		handlers = append(handlers, openAPIDocsHandlers(cfg)...)
+	}
+	return handlers
+}
+
+// openAPIDocsHandlers serve the docs page and the Swagger UI assets it loads
+func openAPIDocsHandlers(cfg OpenAPIConfig) []RouteHandler {
+	docsPath := strings.TrimSuffix(cfg.DocsPath, "/")
+	// The document URL is JSON encoded, which makes it a valid JavaScript string
+	documentURL, _ := json.Marshal(cfg.Path)
+	initScript := []byte(fmt.Sprintf("SwaggerUIBundle({url: %s, dom_id: \"#swagger-ui\"});\n", documentURL))
+	page := cfg
+	page.DocsPath = docsPath
+	handlers := []RouteHandler{
+		NewRouteHandler(cfg.DocsPath, HandlerFunc(
+			func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(ContentType, "text/html; charset=utf-8")
+				_openAPIDocsPage.Execute(w, page)
+			})).WithMethods(http.MethodGet),
+		NewRouteHandler(docsPath+"/init.js", HandlerFunc(
+			func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(ContentType, "application/javascript")
+				w.Write(initScript)
+			})).WithMethods(http.MethodGet),
+	}
+	for _, asset := range _swaggerUIAssets {
+		content, _ := swaggerui.Asset(asset.name)
+		contentType := asset.contentType
+		handlers = append(handlers, NewRouteHandler(docsPath+"/"+asset.name, HandlerFunc(
+			func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(ContentType, contentType)
+				w.Header().Set("Cache-Control", "public, max-age=86400")
+				w.Write(content)
+			})).WithMethods(http.MethodGet))
+	}
+	return handlers
+}
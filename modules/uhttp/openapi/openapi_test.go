@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Base struct {
+	ID int64 `json:"id"`
+}
+
+type Node struct {
+	Base
+	Name     string            `json:"name" validate:"nonzero"`
+	Children []*Node           `json:"children,omitempty"`
+	Labels   map[string]string `json:"labels"`
+	Created  time.Time         `json:"created"`
+	Raw      []byte            `json:"raw"`
+	Score    float64
+	Ignored  string `json:"-"`
+	hidden   string
+}
+
+func TestSchema_Struct(t *testing.T) {
+	doc := New(Info{Title: "test", Version: "1"})
+	s := doc.Schema(reflect.TypeOf(&Node{}))
+	assert.Equal(t, "#/components/schemas/go.uber.org.fx.modules.uhttp.openapi.Node", s.Ref)
+
+	node := doc.Components.Schemas["go.uber.org.fx.modules.uhttp.openapi.Node"]
+	require.NotNil(t, node)
+	assert.Equal(t, "object", node.Type)
+	assert.Equal(t, []string{"name"}, node.Required)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, node.Properties["id"], "Embedded fields should be inlined")
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: "#/components/schemas/go.uber.org.fx.modules.uhttp.openapi.Node"}}, node.Properties["children"])
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, node.Properties["labels"])
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, node.Properties["created"])
+	assert.Equal(t, &Schema{Type: "string", Format: "byte"}, node.Properties["raw"])
+	assert.Equal(t, &Schema{Type: "number", Format: "double"}, node.Properties["Score"])
+	assert.NotContains(t, node.Properties, "Ignored")
+	assert.NotContains(t, node.Properties, "hidden")
+}
+
+type Cookie struct {
+	Flavor string `json:"flavor"`
+}
+
+func TestSchema_SameNameInOtherPackage(t *testing.T) {
+	doc := New(Info{})
+	local := doc.Schema(reflect.TypeOf(Cookie{}))
+	other := doc.Schema(reflect.TypeOf(http.Cookie{}))
+	assert.Equal(t, "#/components/schemas/go.uber.org.fx.modules.uhttp.openapi.Cookie", local.Ref)
+	assert.Equal(t, "#/components/schemas/net.http.Cookie", other.Ref)
+	assert.Contains(t, doc.Components.Schemas["go.uber.org.fx.modules.uhttp.openapi.Cookie"].Properties, "flavor")
+}
+
+func TestSchema_Primitives(t *testing.T) {
+	doc := New(Info{})
+	assert.Equal(t, &Schema{Type: "boolean"}, doc.Schema(reflect.TypeOf(true)))
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32"}, doc.Schema(reflect.TypeOf(1)))
+	assert.Equal(t, &Schema{Type: "number", Format: "float"}, doc.Schema(reflect.TypeOf(float32(1))))
+	assert.Equal(t, &Schema{}, doc.Schema(reflect.TypeOf((*interface{})(nil)).Elem()))
+	anonymous := doc.Schema(reflect.TypeOf(struct {
+		A string `json:"a"`
+	}{}))
+	assert.Equal(t, "object", anonymous.Type, "Anonymous structs should be inlined")
+	assert.Empty(t, doc.Components.Schemas)
+}
+
+func TestAddOperation(t *testing.T) {
+	doc := New(Info{})
+	doc.AddOperation("GET", "/users/{id:[0-9]+}/posts/{post}", &Operation{})
+	op := doc.Paths["/users/{id}/posts/{post}"]["get"]
+	require.NotNil(t, op)
+	assert.Equal(t, "getUsersIdPostsPost", op.OperationID)
+	require.Len(t, op.Parameters, 2)
+	assert.Equal(t, Parameter{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "string"}}, op.Parameters[0])
+	assert.Equal(t, "post", op.Parameters[1].Name)
+}
+
+func TestPathParameters(t *testing.T) {
+	path, params := PathParameters("/health")
+	assert.Equal(t, "/health", path)
+	assert.Empty(t, params)
+}
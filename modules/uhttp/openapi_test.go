@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"go.uber.org/fx/modules/uhttp/openapi"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func registerUsers(service.Host) []RouteHandler {
+	return []RouteHandler{
+		NewRouteHandler("/users", JSONHandler(createUserFn)).WithMethods(http.MethodPost),
+		NewRouteHandler("/users/{id}", JSONHandler(func(ctx context.Context) (*Response, error) {
+			return nil, nil
+		})).WithMethods(http.MethodGet, http.MethodDelete),
+		NewRouteHandler("/", HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {})),
+	}
+}
+
+func TestNewOpenAPI(t *testing.T) {
+	doc := NewOpenAPI(openapi.Info{Title: "users", Version: "2"}, registerUsers(nil))
+	assert.Equal(t, "users", doc.Info.Title)
+
+	create := doc.Paths["/users"]["post"]
+	require.NotNil(t, create)
+	assert.Equal(t, "#/components/schemas/go.uber.org.fx.modules.uhttp.createUser", create.RequestBody.Content[ContentTypeJSON].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/go.uber.org.fx.modules.uhttp.user", create.Responses["200"].Content[ContentTypeJSON].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/go.uber.org.fx.modules.uhttp.Problem", create.Responses["default"].Content[ContentTypeProblemJSON].Schema.Ref)
+	assert.Equal(t, []string{"name"}, doc.Components.Schemas["go.uber.org.fx.modules.uhttp.createUser"].Required)
+
+	get := doc.Paths["/users/{id}"]["get"]
+	require.NotNil(t, get)
+	assert.Nil(t, get.RequestBody)
+	assert.NotContains(t, get.Responses, "200", "Response replies are only known at runtime")
+	assert.Len(t, get.Parameters, 1)
+	assert.NotNil(t, doc.Paths["/users/{id}"]["delete"])
+
+	home := doc.Paths["/"]["get"]
+	require.NotNil(t, home, "Routes without methods should be documented as GET")
+	assert.Equal(t, "Response", home.Responses["default"].Description)
+}
+
+func TestOpenAPI_Module(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    openAPI:
+      docsPath: /docs
+      version: 2.1.0
+`, registerUsers)
+	defer startModule(t, m)()
+
+	resp, err := _defaultHTTPClient.Get(getURL(m) + "/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, ContentTypeJSON, resp.Header.Get(ContentType))
+	var doc openapi.Document
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Equal(t, "dummy", doc.Info.Title, "Title should default to the service name")
+	assert.Equal(t, "2.1.0", doc.Info.Version)
+	assert.Contains(t, doc.Paths, "/users")
+	assert.Contains(t, doc.Paths, "/health")
+	assert.NotContains(t, doc.Paths, "/openapi.json", "Document should not describe itself")
+
+	resp, err = _defaultHTTPClient.Get(getURL(m) + "/docs")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	page, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(page), "swagger-ui")
+	assert.NotContains(t, string(page), "https://", "Docs page should only load local assets")
+
+	for path, contentType := range map[string]string{
+		"/docs/init.js":              "application/javascript",
+		"/docs/swagger-ui.css":       "text/css; charset=utf-8",
+		"/docs/swagger-ui-bundle.js": "application/javascript",
+	} {
+		resp, err := _defaultHTTPClient.Get(getURL(m) + path)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "Unable to get %s", path)
+		assert.Equal(t, contentType, resp.Header.Get(ContentType))
+		assert.NotEmpty(t, body)
+		if path == "/docs/init.js" {
+			assert.Contains(t, string(body), `"/openapi.json"`)
+		}
+	}
+}
+
+func TestDumpOpenAPI(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, DumpOpenAPI(&buf, registerUsers, openapi.Info{Title: "users", Version: "1"}))
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Contains(t, doc.Paths, "/users/{id}")
+	assert.Contains(t, doc.Paths, "/health")
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/uhttp/internal/stats"
+)
+
+// rateLimitFilter rejects requests over the rate limit of their route and authorized
+// caller, and sheds requests while too many are in flight
+type rateLimitFilter struct {
+	limiter *modules.Limiter
+}
+
+func (f rateLimitFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	route := routeTemplate(ctx)
+	if route == "" {
+		route = unknownRoute
+	}
+	release, err := f.limiter.Acquire(route, modules.RateLimitCaller(ctx))
+	stats.HTTPConcurrencyLimitGauge.Update(float64(f.limiter.ConcurrencyLimit()))
+	if err != nil {
+		if err == modules.ErrOverloaded {
+			stats.HTTPLoadShedCounter.Inc(1)
+		} else {
+			stats.HTTPRateLimitCounter.Inc(1)
+		}
+		w.Header().Set(ContentType, ContentTypeText)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprintf(w, "Too many requests: %v", err)
+		return
+	}
+	defer release()
+	next.ServeHTTP(ctx, w, r)
+}
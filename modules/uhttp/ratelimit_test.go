@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/modules"
+	"go.uber.org/fx/modules/uhttp/internal/stats"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func newRateLimitedModule(t *testing.T, yaml string, hookup GetHandlersFunc) (*Module, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	mi := service.ModuleCreateInfo{
+		Host: configHost{
+			Host:     metricsHost{Host: service.NopHost(), scope: scope},
+			provider: config.NewYAMLProviderFromBytes([]byte(yaml)),
+		},
+	}
+	m, err := newModule(mi, hookup, nil)
+	require.NoError(t, err)
+	return m, scope
+}
+
+func getStatus(t *testing.T, url, caller string) int {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if caller != "" {
+		req.Header.Set("Rpc-Caller", caller)
+	}
+	resp, err := _defaultHTTPClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestRateLimitFilter_RateLimit(t *testing.T) {
+	m, scope := newRateLimitedModule(t, `
+modules:
+  http:
+    rateLimit:
+      rate: 1
+      rules:
+        - route: /batch
+          rate: 2
+`, func(service.Host) []RouteHandler {
+		hello := HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, "hello")
+		})
+		return []RouteHandler{NewRouteHandler("/users/{id}", hello), NewRouteHandler("/batch", hello)}
+	})
+	defer startModule(t, m)()
+	url := getURL(m)
+
+	assert.Equal(t, http.StatusOK, getStatus(t, url+"/users/1", "alice"))
+	assert.Equal(t, http.StatusTooManyRequests, getStatus(t, url+"/users/2", "alice"),
+		"Requests should be limited per route template")
+	assert.Equal(t, http.StatusTooManyRequests, getStatus(t, url+"/users/1", "bob"),
+		"Callers should not get their own bucket by naming themselves")
+	assert.Equal(t, http.StatusOK, getStatus(t, url+"/batch", ""))
+	assert.Equal(t, http.StatusOK, getStatus(t, url+"/batch", ""))
+	assert.Equal(t, http.StatusTooManyRequests, getStatus(t, url+"/batch", ""))
+
+	ratelimit := map[string]string{"middleware": "ratelimit"}
+	assert.Equal(t, int64(3), counterValue(scope, "rejected", ratelimit))
+}
+
+func TestRateLimitFilter_LoadShedding(t *testing.T) {
+	entered := make(chan struct{})
+	unblock := make(chan struct{})
+	m, scope := newRateLimitedModule(t, `
+modules:
+  http:
+    rateLimit:
+      loadShedding:
+        maxConcurrency: 1
+`, func(service.Host) []RouteHandler {
+		return makeSingleHandler("/slow", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			entered <- struct{}{}
+			<-unblock
+		})
+	})
+	defer startModule(t, m)()
+	url := getURL(m) + "/slow"
+
+	done := make(chan int)
+	go func() { done <- getStatus(t, url, "") }()
+	<-entered
+	assert.Equal(t, http.StatusTooManyRequests, getStatus(t, url, ""))
+	close(unblock)
+	assert.Equal(t, http.StatusOK, <-done)
+
+	shed := map[string]string{"middleware": "loadshedding"}
+	assert.Equal(t, int64(1), counterValue(scope, "rejected", shed))
+}
+
+func TestRateLimitFilter_AfterAuthorization(t *testing.T) {
+	host := service.NopHostAuthFailure()
+	stats.SetupHTTPMetrics(host.Metrics())
+	cfg := Config{RateLimit: modules.RateLimitConfig{Rate: 1}}
+	chain := defaultFilterChainBuilder(host, cfg, newRouteMetrics(host.Metrics(), 0)).Build(getNopHandler(host))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusUnauthorized, testServeHTTP(chain, host).Code,
+			"Unauthorized requests should be rejected before they are rate limited")
+	}
+}
+
+func TestRateLimitFilter_BadConfig(t *testing.T) {
+	mi := service.ModuleCreateInfo{
+		Host: configHost{
+			Host:     service.NopHost(),
+			provider: config.NewYAMLProviderFromBytes([]byte("modules:\n  http:\n    rateLimit:\n      rate: -1\n")),
+		},
+	}
+	_, err := newModule(mi, registerNothing, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/fx/modules/uhttp/internal/stats"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// Route reported for paths that matched no route and for routes over the limit
+	unknownRoute = "unknown"
+
+	// Default number of routes that get their own metrics
+	defaultMaxRoutes = 100
+)
+
+// MetricsConfig handles config for per-route metrics
+type MetricsConfig struct {
+	// MaxRoutes caps the number of route templates reported, the remaining
+	// routes are reported as unknown
+	MaxRoutes int `yaml:"maxRoutes"`
+}
+
+// routeMetrics holds the metrics of every route template served by the module
+type routeMetrics struct {
+	scope     tally.Scope
+	maxRoutes int
+
+	sync.RWMutex
+	routes map[string]*routeStats
+	known  int
+}
+
+type routeStats struct {
+	scope    tally.Scope
+	latency  tally.Timer
+	bytes    tally.Counter
+	inFlight tally.Gauge
+	active   int64
+}
+
+func newRouteMetrics(scope tally.Scope, maxRoutes int) *routeMetrics {
+	if maxRoutes <= 0 {
+		maxRoutes = defaultMaxRoutes
+	}
+	return &routeMetrics{
+		scope:     scope,
+		maxRoutes: maxRoutes,
+		routes:    make(map[string]*routeStats),
+	}
+}
+
+// get returns the metrics of the route, creating them on first use
+func (m *routeMetrics) get(route string) *routeStats {
+	if route == "" {
+		route = unknownRoute
+	}
+	m.RLock()
+	rs, ok := m.routes[route]
+	m.RUnlock()
+	if ok {
+		return rs
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.routes[route]; !ok && route != unknownRoute && m.known >= m.maxRoutes {
+		route = unknownRoute
+	}
+	if rs, ok := m.routes[route]; ok {
+		return rs
+	}
+	scope := m.scope.Tagged(map[string]string{stats.TagRoute: route})
+	rs = &routeStats{
+		scope:    scope,
+		latency:  scope.Timer("latency"),
+		bytes:    scope.Counter("response.bytes"),
+		inFlight: scope.Gauge("inflight"),
+	}
+	m.routes[route] = rs
+	if route != unknownRoute {
+		m.known++
+	}
+	return rs
+}
+
+func (rs *routeStats) start() {
+	rs.inFlight.Update(float64(atomic.AddInt64(&rs.active, 1)))
+}
+
+func (rs *routeStats) finish(status int, bytes int64, latency time.Duration) {
+	rs.inFlight.Update(float64(atomic.AddInt64(&rs.active, -1)))
+	rs.latency.Record(latency)
+	rs.bytes.Inc(bytes)
+	statusClass := fmt.Sprintf("%dxx", status/100)
+	rs.scope.Tagged(map[string]string{stats.TagStatus: statusClass}).Counter("requests").Inc(1)
+}
+
+// routeMetricsFilter reports the metrics of the route template serving the request.
+// It runs before the panic filter, so panics are reported with the status written there.
+type routeMetricsFilter struct {
+	routes *routeMetrics
+}
+
+func (f routeMetricsFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	rs := f.routes.get(routeTemplate(ctx))
+	rs.start()
+	start := time.Now()
+	rw := newResponseWriter(w)
+	defer func() {
+		rs.finish(rw.status, rw.bytes, time.Since(start))
+	}()
+	next.ServeHTTP(ctx, rw, r)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type metricsHost struct {
+	service.Host
+	scope tally.Scope
+}
+
+func (h metricsHost) Metrics() tally.Scope {
+	return h.scope
+}
+
+func counterValue(scope tally.TestScope, name string, tags map[string]string) int64 {
+	var total int64
+	for _, c := range scope.Snapshot().Counters() {
+		if c.Name() == name && hasTags(c.Tags(), tags) {
+			total += c.Value()
+		}
+	}
+	return total
+}
+
+func timerCount(scope tally.TestScope, name string, tags map[string]string) int {
+	for _, tm := range scope.Snapshot().Timers() {
+		if tm.Name() == name && hasTags(tm.Tags(), tags) {
+			return len(tm.Values())
+		}
+	}
+	return 0
+}
+
+func hasTags(actual, expected map[string]string) bool {
+	for k, v := range expected {
+		if actual[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRouteMetrics_Cap(t *testing.T) {
+	m := newRouteMetrics(tally.NewTestScope("", nil), 2)
+	a, b := m.get("/a"), m.get("/b")
+	assert.NotEqual(t, a, b)
+	assert.Equal(t, a, m.get("/a"))
+
+	unknown := m.get("")
+	assert.Equal(t, unknown, m.get("/c"), "Routes over the limit should be reported as unknown")
+	assert.Equal(t, unknown, m.get(unknownRoute))
+	assert.Equal(t, b, m.get("/b"))
+	assert.Len(t, m.routes, 3)
+
+	assert.Equal(t, defaultMaxRoutes, newRouteMetrics(tally.NoopScope, 0).maxRoutes)
+}
+
+func TestRouteMetrics_Module(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	mi := service.ModuleCreateInfo{Host: metricsHost{Host: service.NopHost(), scope: scope}}
+	hookup := func(service.Host) []RouteHandler {
+		return append(
+			makeSingleHandler("/users/{id}", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, "hello")
+			}),
+			makeSingleHandler("/panic", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				panic("oops")
+			})...,
+		)
+	}
+	m, err := newModule(mi, hookup, nil)
+	require.NoError(t, err)
+	defer startModule(t, m)()
+
+	for _, path := range []string{"/users/1", "/users/2", "/panic", "/nope"} {
+		r, err := _defaultHTTPClient.Get(getURL(m) + path)
+		require.NoError(t, err)
+		r.Body.Close()
+	}
+
+	users := map[string]string{"route": "/users/{id}"}
+	assert.Equal(t, int64(2), counterValue(scope, "route.requests", map[string]string{"route": "/users/{id}", "status": "2xx"}))
+	assert.Equal(t, int64(10), counterValue(scope, "route.response.bytes", users))
+	assert.Equal(t, 2, timerCount(scope, "route.latency", users))
+	assert.Equal(t, int64(1), counterValue(scope, "route.requests", map[string]string{"route": "/panic", "status": "5xx"}))
+	assert.Equal(t, int64(1), counterValue(scope, "route.requests", map[string]string{"route": unknownRoute, "status": "4xx"}))
+	assert.Equal(t, int64(0), counterValue(scope, "route.requests", map[string]string{"route": "/users/1"}))
+
+	for _, g := range scope.Snapshot().Gauges() {
+		if g.Name() == "route.inflight" {
+			assert.Equal(t, 0.0, g.Value(), "No requests should be in flight")
+		}
+	}
+}
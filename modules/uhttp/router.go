@@ -45,6 +45,10 @@ func NewRouter(host service.Host) *Router {
 }
 
 // Handle wraps and calls the http.Handler underneath
-func (h *Router) Handle(path string, handler Handler) {
-	h.Router.Handle(path, Wrap(h.host, handler))
+func (h *Router) Handle(path string, handler Handler) Route {
+	return FromGorilla(h.Router.Handle(path, &handlerWrapper{
+		host:    h.host,
+		handler: handler,
+		route:   path,
+	}))
 }
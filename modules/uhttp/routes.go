@@ -20,7 +20,15 @@
 
 package uhttp
 
-import "github.com/gorilla/mux"
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+type pathParamsKey struct{}
 
 // FromGorilla turns a gorilla mux route into an UberFx route
 func FromGorilla(r *mux.Route) Route {
@@ -31,8 +39,15 @@ func FromGorilla(r *mux.Route) Route {
 
 // A RouteHandler is an HTTP handler for a single route
 type RouteHandler struct {
+	// Path is the route template, such as /users/{id}
 	Path    string
 	Handler Handler
+	// Methods restricts the route to these HTTP methods when set
+	Methods []string
+	// Headers restricts the route to requests with these header key and value pairs
+	Headers []string
+	// Filters are applied to this route only, after the module filters
+	Filters []Filter
 }
 
 // NewRouteHandler creates a route handler
@@ -43,6 +58,108 @@ func NewRouteHandler(path string, handler Handler) RouteHandler {
 	}
 }
 
+// WithMethods restricts the route to the HTTP methods
+func (rh RouteHandler) WithMethods(methods ...string) RouteHandler {
+	rh.Methods = append(rh.Methods[:len(rh.Methods):len(rh.Methods)], methods...)
+	return rh
+}
+
+// WithHeaders restricts the route to requests with the header key and value pairs
+func (rh RouteHandler) WithHeaders(headerPairs ...string) RouteHandler {
+	rh.Headers = append(rh.Headers[:len(rh.Headers):len(rh.Headers)], headerPairs...)
+	return rh
+}
+
+// WithFilters applies the filters to the route
+func (rh RouteHandler) WithFilters(filters ...Filter) RouteHandler {
+	rh.Filters = append(rh.Filters[:len(rh.Filters):len(rh.Filters)], filters...)
+	return rh
+}
+
+// A RouteGroup collects routes that share a path prefix and filters
+type RouteGroup struct {
+	prefix  string
+	filters []Filter
+	routes  []RouteHandler
+	groups  []*RouteGroup
+}
+
+// NewRouteGroup creates a group of routes under the path prefix, such as /api/v1.
+// The filters are applied to the routes of the group before their own filters.
+func NewRouteGroup(prefix string, filters ...Filter) *RouteGroup {
+	return &RouteGroup{
+		prefix:  strings.TrimSuffix(prefix, "/"),
+		filters: filters,
+	}
+}
+
+// Group creates a nested group under the prefix of this group
+func (g *RouteGroup) Group(prefix string, filters ...Filter) *RouteGroup {
+	sub := NewRouteGroup(prefix, filters...)
+	g.groups = append(g.groups, sub)
+	return sub
+}
+
+// Add adds routes to the group, their paths are relative to the group prefix
+func (g *RouteGroup) Add(routes ...RouteHandler) *RouteGroup {
+	g.routes = append(g.routes, routes...)
+	return g
+}
+
+// Handle adds a route for the methods to the group, or for all methods if none are given
+func (g *RouteGroup) Handle(path string, handler Handler, methods ...string) *RouteGroup {
+	return g.Add(NewRouteHandler(path, handler).WithMethods(methods...))
+}
+
+// Routes returns the routes of the group and its nested groups with their full
+// paths and filters, ready to be returned from a GetHandlersFunc
+func (g *RouteGroup) Routes() []RouteHandler {
+	var routes []RouteHandler
+	for _, rh := range g.routes {
+		rh.Path = g.prefix + rh.Path
+		rh.Filters = append(g.filters[:len(g.filters):len(g.filters)], rh.Filters...)
+		routes = append(routes, rh)
+	}
+	for _, sub := range g.groups {
+		for _, rh := range sub.Routes() {
+			rh.Path = g.prefix + rh.Path
+			rh.Filters = append(g.filters[:len(g.filters):len(g.filters)], rh.Filters...)
+			routes = append(routes, rh)
+		}
+	}
+	return routes
+}
+
+// PathParams returns the path parameters of the route serving the request,
+// such as the id of /users/{id}
+func PathParams(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(pathParamsKey{}).(map[string]string)
+	return params
+}
+
+// PathParam returns the path parameter with the name, or an empty string if there is none
+func PathParam(ctx context.Context, name string) string {
+	return PathParams(ctx)[name]
+}
+
+func withPathParams(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, pathParamsKey{}, params)
+}
+
+// routeTable lists the methods and path of every route, one route per line
+func routeTable(routes []RouteHandler) []string {
+	table := make([]string, 0, len(routes))
+	for _, rh := range routes {
+		methods := "*"
+		if len(rh.Methods) > 0 {
+			methods = strings.Join(rh.Methods, ",")
+		}
+		table = append(table, methods+" "+rh.Path)
+	}
+	sort.Strings(table)
+	return table
+}
+
 // A Route represents a handler for HTTP requests, with restrictions
 type Route struct {
 	r *mux.Route
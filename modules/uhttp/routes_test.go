@@ -23,11 +23,16 @@ package uhttp
 import (
 	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
+	"go.uber.org/fx/service"
+
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFromGorilla_OK(t *testing.T) {
@@ -66,3 +71,77 @@ func TestMethods_OK(t *testing.T) {
 	withMethods := route.Methods("GET")
 	assert.NotNil(t, withMethods.r)
 }
+
+func TestRouteHandler_With(t *testing.T) {
+	base := NewRouteHandler("/users", nil).WithMethods("GET")
+	a := base.WithMethods("POST").WithHeaders("X-Api", "1").WithFilters(FilterFunc(nil))
+	b := base.WithMethods("PUT")
+	assert.Equal(t, []string{"GET", "POST"}, a.Methods)
+	assert.Equal(t, []string{"GET", "PUT"}, b.Methods, "Routes derived from the same base should not share methods")
+	assert.Equal(t, []string{"X-Api", "1"}, a.Headers)
+	assert.Len(t, a.Filters, 1)
+	assert.Empty(t, base.Filters)
+}
+
+func TestRouteGroup_Routes(t *testing.T) {
+	apiFilter, adminFilter, routeFilter := FilterFunc(nil), FilterFunc(nil), FilterFunc(nil)
+	api := NewRouteGroup("/api/", apiFilter)
+	api.Handle("/users", nil, "GET", "POST")
+	admin := api.Group("/admin", adminFilter)
+	admin.Add(NewRouteHandler("/stats", nil).WithFilters(routeFilter))
+
+	routes := api.Routes()
+	require.Len(t, routes, 2)
+	assert.Equal(t, "/api/users", routes[0].Path)
+	assert.Equal(t, []string{"GET", "POST"}, routes[0].Methods)
+	assert.Len(t, routes[0].Filters, 1)
+	assert.Equal(t, "/api/admin/stats", routes[1].Path)
+	assert.Len(t, routes[1].Filters, 3, "Group filters should run before route filters")
+}
+
+func TestRouteTable(t *testing.T) {
+	table := routeTable([]RouteHandler{
+		NewRouteHandler("/users/{id}", nil).WithMethods("GET", "PUT"),
+		NewRouteHandler("/health", nil),
+	})
+	assert.Equal(t, []string{"* /health", "GET,PUT /users/{id}"}, table)
+}
+
+func TestPathParams_Module(t *testing.T) {
+	var groupFiltered []string
+	groupFilter := FilterFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+		groupFiltered = append(groupFiltered, r.URL.Path)
+		next.ServeHTTP(ctx, w, r)
+	})
+	hookup := func(service.Host) []RouteHandler {
+		api := NewRouteGroup("/api", groupFilter)
+		api.Handle("/users/{id}", HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "user %s", PathParam(ctx, "id"))
+		}), http.MethodGet)
+		return append(api.Routes(), NewRouteHandler("/ping", HandlerFunc(
+			func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, PathParams(ctx))
+				io.WriteString(w, "pong")
+			})))
+	}
+	m, err := newModule(service.ModuleCreateInfo{Host: service.NopHost()}, hookup, nil)
+	require.NoError(t, err)
+	defer startModule(t, m)()
+
+	resp, err := _defaultHTTPClient.Get(getURL(m) + "/api/users/42")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "user 42", string(body))
+
+	resp, err = _defaultHTTPClient.Post(getURL(m)+"/api/users/42", ContentTypeJSON, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode, "Methods outside of the route should not be served")
+
+	resp, err = _defaultHTTPClient.Get(getURL(m) + "/ping")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"/api/users/42"}, groupFiltered, "Group filters should only apply to the group")
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SecurityHeadersConfig handles config for the security headers added to every response
+type SecurityHeadersConfig struct {
+	// HSTS sets Strict-Transport-Security on responses served over TLS
+	HSTS HSTSConfig `yaml:"hsts"`
+	// ContentTypeOptions sets X-Content-Type-Options, defaults to nosniff
+	ContentTypeOptions string `yaml:"contentTypeOptions"`
+	// FrameOptions sets X-Frame-Options, defaults to DENY
+	FrameOptions string `yaml:"frameOptions"`
+	// ContentSecurityPolicy sets Content-Security-Policy when not empty
+	ContentSecurityPolicy string `yaml:"contentSecurityPolicy"`
+	// ReferrerPolicy sets Referrer-Policy when not empty
+	ReferrerPolicy string `yaml:"referrerPolicy"`
+}
+
+// HSTSConfig handles config for HTTP strict transport security
+type HSTSConfig struct {
+	// MaxAge is how long browsers only use HTTPS for the host, zero disables HSTS
+	MaxAge            time.Duration `yaml:"maxAge"`
+	IncludeSubdomains bool          `yaml:"includeSubdomains"`
+	Preload           bool          `yaml:"preload"`
+}
+
+// securityHeadersFilter sets the security headers before the request is served,
+// so that handlers can still override them
+type securityHeadersFilter struct {
+	headers map[string]string
+	hsts    string
+}
+
+func newSecurityHeadersFilter(cfg SecurityHeadersConfig) securityHeadersFilter {
+	if cfg.ContentTypeOptions == "" {
+		cfg.ContentTypeOptions = "nosniff"
+	}
+	if cfg.FrameOptions == "" {
+		cfg.FrameOptions = "DENY"
+	}
+	headers := map[string]string{
+		"X-Content-Type-Options": cfg.ContentTypeOptions,
+		"X-Frame-Options":        cfg.FrameOptions,
+	}
+	if cfg.ContentSecurityPolicy != "" {
+		headers["Content-Security-Policy"] = cfg.ContentSecurityPolicy
+	}
+	if cfg.ReferrerPolicy != "" {
+		headers["Referrer-Policy"] = cfg.ReferrerPolicy
+	}
+
+	f := securityHeadersFilter{headers: headers}
+	if cfg.HSTS.MaxAge > 0 {
+		f.hsts = fmt.Sprintf("max-age=%d", int(cfg.HSTS.MaxAge/time.Second))
+		if cfg.HSTS.IncludeSubdomains {
+			f.hsts += "; includeSubDomains"
+		}
+		if cfg.HSTS.Preload {
+			f.hsts += "; preload"
+		}
+	}
+	return f
+}
+
+func (f securityHeadersFilter) Apply(ctx context.Context, w http.ResponseWriter, r *http.Request, next Handler) {
+	for k, v := range f.headers {
+		w.Header().Set(k, v)
+	}
+	// Browsers ignore HSTS over plain HTTP
+	if f.hsts != "" && r.TLS != nil {
+		w.Header().Set("Strict-Transport-Security", f.hsts)
+	}
+	next.ServeHTTP(ctx, w, r)
+}
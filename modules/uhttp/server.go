@@ -0,0 +1,87 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// HTTP2Config handles config for HTTP/2. HTTP/2 is negotiated over TLS unless
+// disabled; cleartext HTTP/2 has to be enabled explicitly.
+type HTTP2Config struct {
+	Disabled bool `yaml:"disabled"`
+	// Cleartext serves HTTP/2 without TLS (h2c) next to HTTP/1.1. Only use it for
+	// internal traffic that stays within a trusted network.
+	Cleartext            bool   `yaml:"cleartext"`
+	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams"`
+}
+
+// nextProtos returns the protocols offered during the TLS handshake
+func (c HTTP2Config) nextProtos() []string {
+	if c.Disabled {
+		return []string{"http/1.1"}
+	}
+	return []string{http2.NextProtoTLS, "http/1.1"}
+}
+
+// newServer creates the server with the configured limits and protocols
+func (m *Module) newServer(handler http.Handler, tlsConfig *tls.Config) (*http.Server, error) {
+	cfg := m.config
+	srv := &http.Server{
+		Handler:           handler,
+		TLSConfig:         tlsConfig,
+		ReadTimeout:       durationOrDefault(cfg.ReadTimeout, cfg.Timeout),
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      durationOrDefault(cfg.WriteTimeout, cfg.Timeout),
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+	}
+	srv.SetKeepAlivesEnabled(!cfg.DisableKeepAlives)
+
+	if cfg.HTTP2.Disabled {
+		return srv, nil
+	}
+	h2 := &http2.Server{
+		MaxConcurrentStreams: cfg.HTTP2.MaxConcurrentStreams,
+		IdleTimeout:          srv.IdleTimeout,
+	}
+	if tlsConfig != nil {
+		if err := http2.ConfigureServer(srv, h2); err != nil {
+			return nil, errors.Wrap(err, "unable to configure HTTP/2 for HTTP module")
+		}
+	} else if cfg.HTTP2.Cleartext {
+		srv.Handler = h2c.NewHandler(handler, h2)
+	}
+	return srv, nil
+}
+
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
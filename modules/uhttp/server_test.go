@@ -0,0 +1,196 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/config"
+	"go.uber.org/fx/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+)
+
+type configHost struct {
+	service.Host
+	provider config.Provider
+}
+
+func (h configHost) Config() config.Provider {
+	return h.provider
+}
+
+func newConfiguredModule(t *testing.T, yaml string, hookup GetHandlersFunc) *Module {
+	mi := service.ModuleCreateInfo{
+		Host: configHost{
+			Host:     service.NopHost(),
+			provider: config.NewYAMLProviderFromBytes([]byte(yaml)),
+		},
+	}
+	m, err := newModule(mi, hookup, nil)
+	require.NoError(t, err)
+	return m
+}
+
+func startModule(t *testing.T, m *Module) func() {
+	m.config.Port = 0
+	ready := make(chan struct{}, 1)
+	errs := m.Start(ready)
+	select {
+	case <-ready:
+	case err := <-errs:
+		require.FailNow(t, "unable to start module", "%v", err)
+	}
+	return func() { assert.NoError(t, m.Stop()) }
+}
+
+func registerEcho(_ service.Host) []RouteHandler {
+	return makeSingleHandler("/", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		fmt.Fprintf(w, "%s %s", r.Proto, body)
+	})
+}
+
+func TestServer_Limits(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    timeout: 5s
+    writeTimeout: 10s
+    readHeaderTimeout: 1s
+    idleTimeout: 30s
+    maxHeaderBytes: 4096
+`, registerNothing)
+	srv, err := m.newServer(http.NotFoundHandler(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, srv.ReadTimeout)
+	assert.Equal(t, 10*time.Second, srv.WriteTimeout)
+	assert.Equal(t, time.Second, srv.ReadHeaderTimeout)
+	assert.Equal(t, 30*time.Second, srv.IdleTimeout)
+	assert.Equal(t, 4096, srv.MaxHeaderBytes)
+}
+
+func TestServer_DefaultTimeouts(t *testing.T) {
+	m := newConfiguredModule(t, "", registerNothing)
+	srv, err := m.newServer(http.NotFoundHandler(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultTimeout, srv.ReadTimeout)
+	assert.Equal(t, defaultTimeout, srv.WriteTimeout)
+}
+
+func TestServer_MaxBodyBytes(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    maxBodyBytes: 8
+`, registerEcho)
+	defer startModule(t, m)()
+
+	r, err := _defaultHTTPClient.Post(getURL(m)+"/", ContentTypeText, strings.NewReader("small"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, r.StatusCode)
+
+	r, err = _defaultHTTPClient.Post(getURL(m)+"/", ContentTypeText, strings.NewReader("far too large"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, r.StatusCode)
+
+	// Without a content length the body fails to read past the limit
+	body := io.MultiReader(strings.NewReader("far too "), strings.NewReader("large"))
+	r, err = _defaultHTTPClient.Post(getURL(m)+"/", ContentTypeText, body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, r.StatusCode)
+}
+
+func TestServer_DisableKeepAlives(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    disableKeepAlives: true
+`, registerNothing)
+	defer startModule(t, m)()
+
+	r, err := _defaultHTTPClient.Get(getURL(m) + "/health")
+	require.NoError(t, err)
+	assert.True(t, r.Close, "Server should close the connection")
+}
+
+func TestServer_HTTP2OverTLS(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	withTLSModule(t, f.config, registerEcho, func(m *Module, base string) {
+		client := f.client()
+		client.Transport = &http2.Transport{TLSClientConfig: client.Transport.(*http.Transport).TLSClientConfig}
+		assert.Equal(t, "HTTP/2.0 ", get(t, client, base+"/"))
+	})
+}
+
+func TestServer_HTTP2Disabled(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	withModule(t, registerEcho, nil, nil, false, func(m *Module) {
+		m.config.HTTP2.Disabled = true
+		srv, err := m.newServer(http.NotFoundHandler(), &tls.Config{})
+		require.NoError(t, err)
+		assert.Empty(t, srv.TLSNextProto)
+	})
+	assert.Equal(t, []string{"http/1.1"}, HTTP2Config{Disabled: true}.nextProtos())
+	withTLSModule(t, f.config, registerEcho, func(m *Module, base string) {
+		assert.Equal(t, "HTTP/1.1 ", get(t, f.client(), base+"/"))
+	})
+}
+
+func TestServer_H2C(t *testing.T) {
+	m := newConfiguredModule(t, `
+modules:
+  http:
+    http2:
+      cleartext: true
+`, registerEcho)
+	defer startModule(t, m)()
+
+	client := &http.Client{
+		Timeout: 2 * time.Second,
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		},
+	}
+	assert.Equal(t, "HTTP/2.0 ", get(t, client, getURL(m)+"/"))
+	// HTTP/1.1 keeps working on the same port
+	assert.Equal(t, "HTTP/1.1 ", get(t, _defaultHTTPClient, getURL(m)+"/"))
+}
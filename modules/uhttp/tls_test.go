@@ -0,0 +1,278 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package uhttp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/auth"
+	"go.uber.org/fx/service"
+	"go.uber.org/fx/ulog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.IPv6loopback},
+		DNSNames:     []string{"localhost"},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw})
+}
+
+func (c *testCert) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *testCert) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(c.certPEM(), c.keyPEM(t))
+	require.NoError(t, err)
+	return cert
+}
+
+// writeCert writes the certificate and key and moves their modification time
+// forward, so that a reload notices the change even on coarse file systems
+func writeCert(t *testing.T, dir string, c *testCert, modTime time.Time) (string, string) {
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(certFile, c.certPEM(), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, c.keyPEM(t), 0600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+	return certFile, keyFile
+}
+
+type tlsFixture struct {
+	dir    string
+	ca     *testCert
+	server *testCert
+	config TLSConfig
+}
+
+func newTLSFixture(t *testing.T) (*tlsFixture, func()) {
+	dir, err := ioutil.TempDir("", "uhttp-tls")
+	require.NoError(t, err)
+	ca := newTestCert(t, "ca", nil)
+	server := newTestCert(t, "server", ca)
+	certFile, keyFile := writeCert(t, dir, server, time.Now())
+	return &tlsFixture{
+		dir:    dir,
+		ca:     ca,
+		server: server,
+		config: TLSConfig{CertFile: certFile, KeyFile: keyFile},
+	}, func() { os.RemoveAll(dir) }
+}
+
+func (f *tlsFixture) requireClientCerts(t *testing.T) {
+	caFile := filepath.Join(f.dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, f.ca.certPEM(), 0600))
+	f.config.ClientCAFile = caFile
+}
+
+func (f *tlsFixture) client(certs ...tls.Certificate) *http.Client {
+	roots := x509.NewCertPool()
+	roots.AddCert(f.ca.cert)
+	return &http.Client{
+		Timeout: 2 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: roots, Certificates: certs},
+			DisableKeepAlives: true,
+		},
+	}
+}
+
+func withTLSModule(t *testing.T, cfg TLSConfig, hookup GetHandlersFunc, fn func(m *Module, base string)) {
+	withModule(t, hookup, nil, nil, false, func(m *Module) {
+		require.NoError(t, m.Stop())
+		m.config.TLS = &cfg
+		ready := make(chan struct{}, 1)
+		errs := m.Start(ready)
+		select {
+		case <-ready:
+		case err := <-errs:
+			require.FailNow(t, "unable to start TLS module", "%v", err)
+		}
+		port := m.accessListener().Addr().(*net.TCPAddr).Port
+		fn(m, fmt.Sprintf("https://localhost:%d", port))
+	})
+}
+
+func registerPeerEcho(_ service.Host) []RouteHandler {
+	return makeSingleHandler("/", func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		peer, ok := auth.PeerIdentityFromContext(ctx)
+		if !ok {
+			io.WriteString(w, "anonymous")
+			return
+		}
+		io.WriteString(w, peer.CommonName)
+	})
+}
+
+func get(t *testing.T, client *http.Client, url string) string {
+	r, err := client.Get(url)
+	require.NoError(t, err)
+	defer r.Body.Close()
+	body, err := ioutil.ReadAll(r.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestTLS_Serve(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	withTLSModule(t, f.config, registerPeerEcho, func(m *Module, base string) {
+		assert.Equal(t, "anonymous", get(t, f.client(), base+"/"))
+	})
+}
+
+func TestTLS_MutualTLS(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	f.requireClientCerts(t)
+	withTLSModule(t, f.config, registerPeerEcho, func(m *Module, base string) {
+		_, err := f.client().Get(base + "/")
+		assert.Error(t, err, "Client certificate should be required")
+
+		untrusted := newTestCert(t, "intruder", newTestCert(t, "other-ca", nil))
+		_, err = f.client(untrusted.tlsCertificate(t)).Get(base + "/")
+		assert.Error(t, err, "Client certificate should be verified against the CA")
+
+		caller := newTestCert(t, "caller", f.ca)
+		assert.Equal(t, "caller", get(t, f.client(caller.tlsCertificate(t)), base+"/"))
+	})
+}
+
+func TestTLS_ReloadsCertificates(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	f.config.ReloadInterval = time.Millisecond
+	withTLSModule(t, f.config, registerNothing, func(m *Module, base string) {
+		serverCert := func() *x509.Certificate {
+			r, err := f.client().Get(base + "/health")
+			require.NoError(t, err)
+			defer r.Body.Close()
+			return r.TLS.PeerCertificates[0]
+		}
+		assert.Equal(t, f.server.cert.SerialNumber, serverCert().SerialNumber)
+
+		rotated := newTestCert(t, "server", f.ca)
+		writeCert(t, f.dir, rotated, time.Now().Add(time.Minute))
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, rotated.cert.SerialNumber, serverCert().SerialNumber)
+
+		// A broken file keeps the previous certificate in use
+		require.NoError(t, ioutil.WriteFile(f.config.KeyFile, []byte("garbage"), 0600))
+		future := time.Now().Add(2 * time.Minute)
+		require.NoError(t, os.Chtimes(f.config.KeyFile, future, future))
+		time.Sleep(5 * time.Millisecond)
+		assert.Equal(t, rotated.cert.SerialNumber, serverCert().SerialNumber)
+	})
+}
+
+func TestTLS_ConfigErrors(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	tests := []struct {
+		name   string
+		modify func(*TLSConfig)
+		err    string
+	}{
+		{"missing key", func(c *TLSConfig) { c.KeyFile = "" }, "both certFile and keyFile"},
+		{"min version", func(c *TLSConfig) { c.MinVersion = "0.9" }, "unsupported TLS minVersion"},
+		{"cipher suite", func(c *TLSConfig) { c.CipherSuites = []string{"TLS_NOPE"} }, "unsupported TLS cipher suite"},
+		{"missing file", func(c *TLSConfig) { c.CertFile = filepath.Join(f.dir, "nope.pem") }, "unable to read TLS file"},
+		{"bad client CA", func(c *TLSConfig) { c.ClientCAFile = c.KeyFile }, "no certificates found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := f.config
+			tt.modify(&cfg)
+			_, err := newCertReloader(cfg, nil, ulog.NopLogger)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestTLS_Settings(t *testing.T) {
+	f, cleanup := newTLSFixture(t)
+	defer cleanup()
+	cfg := f.config
+	cfg.MinVersion = "1.1"
+	cfg.CipherSuites = []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}
+	r, err := newCertReloader(cfg, nil, ulog.NopLogger)
+	require.NoError(t, err)
+	config, err := r.TLSConfig().GetConfigForClient(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS11), config.MinVersion)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, config.CipherSuites)
+	assert.Equal(t, tls.NoClientCert, config.ClientAuth)
+	assert.Len(t, config.Certificates, 1)
+}
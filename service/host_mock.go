@@ -63,5 +63,6 @@ func NopHostConfigured(client auth.Client, logger ulog.Log, tracer opentracing.T
 		tracerCore: tracerCore{
 			tracer: tracer,
 		},
+		resources: map[string]interface{}{},
 	}
 }
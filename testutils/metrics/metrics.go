@@ -64,6 +64,16 @@ func (r *TestStatsReporter) ReportTimer(name string, tags map[string]string, int
 	r.m.Unlock()
 }
 
+// ReportHistogramValueSamples is a nop
+func (r *TestStatsReporter) ReportHistogramValueSamples(name string, tags map[string]string, buckets tally.Buckets,
+	bucketLowerBound, bucketUpperBound float64, samples int64) {
+}
+
+// ReportHistogramDurationSamples is a nop
+func (r *TestStatsReporter) ReportHistogramDurationSamples(name string, tags map[string]string, buckets tally.Buckets,
+	bucketLowerBound, bucketUpperBound time.Duration, samples int64) {
+}
+
 // Capabilities is a nop in this case
 func (r *TestStatsReporter) Capabilities() tally.Capabilities {
 	return nil
@@ -84,6 +94,6 @@ func NewTestStatsReporter() *TestStatsReporter {
 // NewTestScope returns a pair of scope and reporter that can be used for testing
 func NewTestScope() (tally.Scope, *TestStatsReporter) {
 	r := NewTestStatsReporter()
-	scope, _ := tally.NewRootScope("", nil, r, 100*time.Millisecond, tally.DefaultSeparator)
+	scope, _ := tally.NewRootScope(tally.ScopeOptions{Reporter: r}, 100*time.Millisecond)
 	return scope, r
 }